@@ -54,63 +54,88 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0xc7, 0x33, 0x97, 0xd5, 0x6a, 0xd4, 0x7d, 0xf3, 0xae, 0xf6, 0xa5, 0x07, 0xef, 0x6e, 0xf7,
-	0xb2, 0xa7, 0x84, 0x16, 0x28, 0xf4, 0xbd, 0x69, 0x12, 0x52, 0x89, 0xa4, 0xd0, 0x84, 0x17, 0x89,
-	0x0b, 0x9a, 0xc4, 0x4f, 0x5b, 0xab, 0x4e, 0x6c, 0x66, 0xc6, 0x29, 0x3d, 0xc1, 0x05, 0x09, 0x09,
-	0x09, 0x81, 0x84, 0x84, 0x84, 0xc4, 0x09, 0x09, 0x81, 0xc4, 0x67, 0x40, 0xea, 0xad, 0xc7, 0x1e,
-	0x7b, 0xa4, 0xe9, 0x85, 0x63, 0x3f, 0x02, 0x72, 0x9d, 0x99, 0xda, 0xc9, 0x50, 0xc6, 0x4e, 0x6f,
-	0x4d, 0x3d, 0xbf, 0xff, 0xfc, 0xfc, 0xc4, 0x33, 0xcf, 0x38, 0x78, 0x9c, 0x43, 0xcb, 0x73, 0x29,
-	0x71, 0x72, 0x0c, 0x68, 0x07, 0x68, 0x8e, 0x78, 0x76, 0x8e, 0x58, 0x2d, 0xbb, 0x1d, 0x7c, 0xb6,
-	0x9b, 0x90, 0xeb, 0x8c, 0xe7, 0x7a, 0x7f, 0x66, 0x3d, 0xea, 0x72, 0xd7, 0xf8, 0x4f, 0x20, 0xd9,
-	0x10, 0xc9, 0x12, 0xcf, 0xce, 0x46, 0x91, 0x6c, 0x67, 0x7c, 0x74, 0x5a, 0x27, 0x97, 0xc2, 0x3d,
-	0x1f, 0x18, 0xbf, 0x4b, 0x81, 0x79, 0x6e, 0x9b, 0xf5, 0x26, 0x98, 0xd8, 0x19, 0xc3, 0x23, 0xf9,
-	0x60, 0x68, 0x3d, 0x1c, 0x6a, 0xbc, 0x42, 0xf8, 0xd7, 0x1a, 0x34, 0x7c, 0xdb, 0xb1, 0xaa, 0x3e,
-	0x27, 0x0d, 0x07, 0xea, 0x9c, 0x70, 0x30, 0x16, 0xb2, 0x1a, 0x2a, 0x59, 0x05, 0x59, 0x0b, 0x27,
-	0x1e, 0x5d, 0x4c, 0x1f, 0x10, 0x1a, 0x8f, 0x65, 0x8c, 0xd7, 0x08, 0xff, 0x56, 0x04, 0xd6, 0xa4,
-	0x76, 0x03, 0x62, 0x76, 0x7a, 0xe1, 0x2a, 0x54, 0xe8, 0xe5, 0x87, 0x48, 0x90, 0x7e, 0x41, 0xf1,
-	0xc4, 0x90, 0x65, 0x9b, 0x71, 0x97, 0x6e, 0x2f, 0xbb, 0x8c, 0x6b, 0x16, 0x4f, 0x41, 0x26, 0x2b,
-	0x9e, 0x32, 0x40, 0xca, 0x6d, 0xe3, 0xef, 0xcb, 0xc0, 0xeb, 0x1b, 0x84, 0x5a, 0xc6, 0x05, 0xad,
-	0x3c, 0x31, 0x5c, 0x58, 0x5c, 0x4c, 0x48, 0xc9, 0xa9, 0x1f, 0x60, 0x5c, 0x70, 0x5c, 0x06, 0xe1,
-	0xe4, 0x93, 0x5a, 0x31, 0x27, 0x80, 0x98, 0xfe, 0x52, 0x62, 0x4e, 0x0a, 0x3c, 0x47, 0xf8, 0xe7,
-	0x8a, 0xcd, 0x78, 0xaf, 0x32, 0x37, 0x08, 0xdb, 0x64, 0xc6, 0xac, 0x56, 0x5e, 0x3f, 0x26, 0x6c,
-	0xe6, 0x52, 0xd2, 0xd1, 0xa2, 0xd4, 0xa0, 0xe5, 0x76, 0x20, 0xb8, 0xa0, 0x59, 0x94, 0x13, 0x20,
-	0x59, 0x51, 0xa2, 0x9c, 0x14, 0xd8, 0x41, 0xf8, 0x9f, 0x32, 0xf0, 0xdb, 0x2e, 0xdd, 0x5c, 0x73,
-	0xdc, 0xad, 0xd2, 0x7d, 0x68, 0xfa, 0xdc, 0x76, 0xdb, 0x35, 0xb2, 0xd5, 0x53, 0xbe, 0x35, 0x61,
-	0x54, 0x74, 0xbf, 0xf3, 0x53, 0x63, 0x84, 0x6d, 0xf5, 0x8c, 0xd2, 0xe4, 0x3d, 0xbc, 0x41, 0xf8,
-	0xf7, 0x32, 0xf0, 0x1a, 0x78, 0x8e, 0xdd, 0x24, 0xc1, 0xc0, 0x2a, 0x30, 0x46, 0xd6, 0x81, 0x19,
-	0x4b, 0xba, 0x73, 0x29, 0x60, 0xe1, 0x5b, 0x18, 0x2a, 0x43, 0x5a, 0x7e, 0x44, 0xf8, 0xef, 0x32,
-	0xf0, 0x15, 0xd2, 0x02, 0xe6, 0x91, 0x26, 0xa8, 0x74, 0xaf, 0xea, 0x4e, 0x75, 0x5a, 0x8a, 0xf0,
-	0xae, 0x9c, 0x4d, 0x98, 0xbc, 0x81, 0x0f, 0x08, 0xff, 0x55, 0x06, 0x5e, 0xac, 0xac, 0xaa, 0xd4,
-	0x4b, 0xba, 0xb3, 0xa9, 0x79, 0x21, 0x7d, 0x65, 0xd8, 0x18, 0xa9, 0xfb, 0x18, 0xe1, 0x1f, 0x6a,
-	0x40, 0x3c, 0xcf, 0xd9, 0x2e, 0x75, 0xa0, 0xcd, 0x99, 0x31, 0xa5, 0xb9, 0x4c, 0x22, 0x8c, 0xd0,
-	0x9a, 0x4e, 0x83, 0xc6, 0x5a, 0x42, 0xde, 0xb2, 0xea, 0x40, 0x68, 0x73, 0x23, 0xcf, 0x39, 0xb5,
-	0x1b, 0x3e, 0x07, 0xa6, 0xd9, 0x12, 0x14, 0x64, 0xb2, 0x96, 0xa0, 0x0c, 0x88, 0xad, 0x9e, 0x70,
-	0x6b, 0x18, 0xf0, 0x5b, 0x4a, 0xb0, 0xaf, 0x7c, 0x4d, 0xb1, 0x30, 0x54, 0x46, 0xac, 0x84, 0x41,
-	0x53, 0x49, 0x57, 0x42, 0x05, 0x99, 0xac, 0x84, 0xca, 0x00, 0x29, 0xf7, 0x14, 0xe1, 0x9f, 0x44,
-	0xdf, 0x2d, 0x38, 0x3e, 0xe3, 0x40, 0x8d, 0x99, 0x44, 0xdd, 0xba, 0x47, 0x09, 0xa9, 0xd9, 0x74,
-	0xb0, 0x14, 0x7a, 0x84, 0xf0, 0x48, 0xd0, 0x75, 0x7a, 0x57, 0x98, 0x71, 0x59, 0xbb, 0x51, 0x09,
-	0x44, 0xa8, 0x4c, 0xa5, 0x20, 0xa5, 0xc7, 0x4b, 0x84, 0x8d, 0xc8, 0xa5, 0x2a, 0xb4, 0x1a, 0x81,
-	0xcd, 0x7c, 0xd2, 0xcc, 0x1e, 0x28, 0x9c, 0x16, 0x52, 0xf3, 0xd2, 0xec, 0x3d, 0xc2, 0x7f, 0xe6,
-	0x2d, 0xeb, 0x1a, 0xbd, 0xe9, 0x59, 0xc7, 0xe7, 0xb7, 0x96, 0xcb, 0xe5, 0x77, 0x57, 0xd4, 0x5d,
-	0x56, 0x4a, 0x5c, 0x58, 0x96, 0x86, 0x4c, 0x89, 0x3d, 0xfb, 0xe1, 0x02, 0x89, 0x6b, 0x2e, 0x24,
-	0x58, 0x5a, 0x4a, 0xc3, 0xc5, 0xf4, 0x01, 0x52, 0xee, 0x09, 0xc2, 0x3f, 0x86, 0xdb, 0xb1, 0x6c,
-	0x05, 0xd3, 0x09, 0xf6, 0xf0, 0xfe, 0xfd, 0x7f, 0x26, 0x15, 0x1b, 0x3b, 0xe3, 0x5d, 0xf7, 0xe9,
-	0x3a, 0x44, 0x7d, 0xf4, 0x56, 0x53, 0x3f, 0x96, 0xec, 0x8c, 0x37, 0x48, 0xc7, 0x9c, 0xaa, 0x90,
-	0xca, 0xa9, 0x1f, 0x4b, 0xe6, 0x34, 0x48, 0xc7, 0x5e, 0xa2, 0x6a, 0xb0, 0x46, 0x81, 0x6d, 0x88,
-	0x53, 0x56, 0x78, 0x1e, 0xd6, 0x7d, 0x24, 0x06, 0xd1, 0x64, 0x2f, 0x51, 0xea, 0x84, 0xbe, 0xa6,
-	0xc4, 0xa0, 0x6d, 0x45, 0x9a, 0x7c, 0x68, 0xa8, 0xdb, 0x94, 0x54, 0x70, 0xd2, 0xa6, 0xa4, 0xce,
-	0x90, 0x96, 0x2f, 0x10, 0xfe, 0xa5, 0x0c, 0x3c, 0xf8, 0xf7, 0xaa, 0x0f, 0x3e, 0x84, 0x82, 0x73,
-	0xba, 0x8f, 0x70, 0x9c, 0x13, 0x6e, 0xf3, 0x69, 0x71, 0xa9, 0xf5, 0x16, 0xe1, 0x3f, 0x8a, 0xe0,
-	0x00, 0x87, 0x81, 0x13, 0xb4, 0x51, 0xd0, 0xec, 0x2c, 0x4a, 0x5a, 0x28, 0x16, 0x87, 0x0b, 0x91,
-	0xa2, 0xbb, 0x08, 0xff, 0x5b, 0xe7, 0x14, 0x48, 0x4b, 0x8c, 0x52, 0x9d, 0x2c, 0xf5, 0xde, 0x17,
-	0xbe, 0x99, 0x23, 0xe4, 0x57, 0xce, 0x2a, 0x4e, 0xdc, 0xc6, 0xff, 0xe8, 0x1c, 0x5a, 0x72, 0xf6,
-	0x0e, 0xcc, 0xcc, 0xfe, 0x81, 0x99, 0x39, 0x3a, 0x30, 0xd1, 0xc3, 0xae, 0x89, 0xde, 0x75, 0x4d,
-	0xb4, 0xdb, 0x35, 0xd1, 0x5e, 0xd7, 0x44, 0x9f, 0xba, 0x26, 0xfa, 0xdc, 0x35, 0x33, 0x47, 0x5d,
-	0x13, 0x3d, 0x3b, 0x34, 0x33, 0x7b, 0x87, 0x66, 0x66, 0xff, 0xd0, 0xcc, 0xdc, 0x99, 0x5c, 0x77,
-	0x4f, 0x6c, 0x6c, 0xf7, 0x94, 0xdf, 0x6e, 0x66, 0xa2, 0x9f, 0x1b, 0xdf, 0x1d, 0xff, 0x70, 0x73,
-	0xfe, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xf7, 0xa0, 0x23, 0x4e, 0x12, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x17, 0x84, 0x86, 0xf2, 0x6b, 0xf9, 0x5d, 0xd0, 0x02, 0xe5, 0xc2, 0xc9, 0x69,
+	0x0b, 0xb4, 0x69, 0xd2, 0x36, 0xb1, 0x9d, 0xd4, 0x31, 0x89, 0x93, 0xd4, 0xa6, 0x20, 0x71, 0x41,
+	0xe3, 0xf5, 0x6b, 0xb2, 0x64, 0xbd, 0xbb, 0xcc, 0xcc, 0xba, 0xcd, 0x09, 0x84, 0x84, 0x84, 0x84,
+	0x84, 0x40, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x20, 0x71, 0x42, 0xe2, 0x08, 0x12, 0x27,
+	0x7a, 0xcc, 0xb1, 0x47, 0xe2, 0x5c, 0x38, 0xf6, 0x3f, 0x00, 0xad, 0x77, 0x67, 0xe2, 0xb5, 0x67,
+	0x93, 0x99, 0xb5, 0x6f, 0x71, 0xfc, 0xbe, 0xdf, 0xf9, 0xcc, 0xdb, 0x37, 0xef, 0xed, 0xae, 0xf1,
+	0x05, 0x0e, 0xbd, 0x30, 0xa0, 0xc4, 0x9b, 0x63, 0x40, 0xfb, 0x40, 0xe7, 0x48, 0xe8, 0xce, 0x91,
+	0x6e, 0xcf, 0xf5, 0xe3, 0xcf, 0xae, 0x03, 0x73, 0xfd, 0x0b, 0x73, 0xe9, 0x9f, 0xe5, 0x90, 0x06,
+	0x3c, 0xb0, 0x5e, 0x13, 0x92, 0x72, 0x22, 0x29, 0x93, 0xd0, 0x2d, 0x8f, 0x4a, 0xca, 0xfd, 0x0b,
+	0x67, 0x17, 0x74, 0x7c, 0x29, 0x7c, 0x14, 0x01, 0xe3, 0x1f, 0x50, 0x60, 0x61, 0xe0, 0xb3, 0x74,
+	0x81, 0x8b, 0xff, 0xcd, 0xe3, 0x33, 0x95, 0x38, 0xb4, 0x9d, 0x84, 0x5a, 0xdf, 0x21, 0xfc, 0x54,
+	0x0b, 0x3a, 0x91, 0xeb, 0x75, 0x9b, 0x11, 0x27, 0x1d, 0x0f, 0xda, 0x9c, 0x70, 0xb0, 0x96, 0xca,
+	0x1a, 0x28, 0x65, 0x85, 0xb2, 0x95, 0x2c, 0x7c, 0x76, 0xb9, 0xb8, 0x41, 0x42, 0x7c, 0xae, 0x64,
+	0x7d, 0x8f, 0xf0, 0xd3, 0x2b, 0xc0, 0x1c, 0xea, 0x76, 0x20, 0x43, 0xa7, 0x67, 0xae, 0x92, 0x0a,
+	0xbc, 0xca, 0x14, 0x0e, 0x92, 0x2f, 0x4e, 0x9e, 0x08, 0x59, 0x73, 0x19, 0x0f, 0xe8, 0xfe, 0x5a,
+	0xc0, 0xb8, 0x66, 0xf2, 0x14, 0x4a, 0xb3, 0xe4, 0x29, 0x0d, 0x24, 0xdc, 0x3e, 0x7e, 0xb8, 0x0e,
+	0xbc, 0xbd, 0x4b, 0x68, 0xd7, 0x7a, 0x53, 0xcb, 0x4f, 0x84, 0x0b, 0x8a, 0xb7, 0x0c, 0x55, 0x72,
+	0xe9, 0x8f, 0x31, 0xae, 0x79, 0x01, 0x83, 0x64, 0xf1, 0x4b, 0x5a, 0x36, 0xc7, 0x02, 0xb1, 0xfc,
+	0x65, 0x63, 0x9d, 0x04, 0xf8, 0x1a, 0xe1, 0x27, 0x36, 0x5c, 0xc6, 0xd3, 0xcc, 0xbc, 0x43, 0xd8,
+	0x1e, 0xb3, 0xae, 0x6a, 0xf9, 0x8d, 0xcb, 0x04, 0xcd, 0xb5, 0x82, 0xea, 0xd1, 0xa4, 0xb4, 0xa0,
+	0x17, 0xf4, 0x21, 0xfe, 0x42, 0x33, 0x29, 0xc7, 0x02, 0xb3, 0xa4, 0x8c, 0xea, 0x24, 0xc0, 0x5f,
+	0x08, 0xbf, 0x52, 0x07, 0xfe, 0x5e, 0x40, 0xf7, 0x6e, 0x7b, 0xc1, 0x9d, 0xd5, 0xbb, 0xe0, 0x44,
+	0xdc, 0x0d, 0xfc, 0x16, 0xb9, 0x93, 0x22, 0xbf, 0x7b, 0xd1, 0xda, 0xd0, 0xbd, 0xe6, 0x27, 0xda,
+	0x08, 0xda, 0xe6, 0x8c, 0xdc, 0xe4, 0x1e, 0x7e, 0x44, 0xf8, 0xd9, 0x3a, 0xf0, 0x16, 0x84, 0x9e,
+	0xeb, 0x90, 0x38, 0xb0, 0x09, 0x8c, 0x91, 0x1d, 0x60, 0x56, 0x55, 0x77, 0x2d, 0x85, 0x58, 0xf0,
+	0xd6, 0xa6, 0xf2, 0x90, 0x94, 0x7f, 0x22, 0xfc, 0x72, 0x1d, 0xf8, 0x26, 0xe9, 0x01, 0x0b, 0x89,
+	0x03, 0x2a, 0xdc, 0x75, 0xdd, 0xa5, 0x4e, 0x72, 0x11, 0xdc, 0x1b, 0xb3, 0x31, 0x93, 0x1b, 0xf8,
+	0x15, 0xe1, 0x17, 0xea, 0xc0, 0x57, 0x36, 0x6e, 0xaa, 0xd0, 0x57, 0x75, 0x57, 0x53, 0xeb, 0x05,
+	0xf4, 0x8d, 0x69, 0x6d, 0x24, 0xee, 0xe7, 0x08, 0x3f, 0xda, 0x02, 0x12, 0x86, 0xde, 0xfe, 0x6a,
+	0x1f, 0x7c, 0xce, 0xac, 0x2b, 0x9a, 0xc7, 0x64, 0x44, 0x23, 0xb0, 0x16, 0x8a, 0x48, 0x33, 0x23,
+	0xa1, 0xd2, 0xed, 0xb6, 0x81, 0x50, 0x67, 0xb7, 0xc2, 0x39, 0x75, 0x3b, 0x11, 0x07, 0xa6, 0x39,
+	0x12, 0x14, 0x4a, 0xb3, 0x91, 0xa0, 0x34, 0xc8, 0x9c, 0x9e, 0xa4, 0x35, 0x4c, 0xf0, 0x55, 0x0d,
+	0xfa, 0x4a, 0x1e, 0x62, 0x6d, 0x2a, 0x8f, 0x4c, 0x0a, 0xe3, 0xa1, 0x52, 0x2c, 0x85, 0x0a, 0xa5,
+	0x59, 0x0a, 0x95, 0x06, 0x12, 0xee, 0x4b, 0x84, 0x1f, 0x17, 0x73, 0xb7, 0xe6, 0x45, 0x8c, 0x03,
+	0xb5, 0x16, 0x8d, 0xa6, 0x75, 0xaa, 0x12, 0x50, 0x57, 0x8b, 0x89, 0x25, 0xd0, 0x67, 0x08, 0x9f,
+	0x89, 0xa7, 0x4e, 0xfa, 0x0d, 0xb3, 0xe6, 0xb5, 0x07, 0x95, 0x90, 0x08, 0x94, 0x2b, 0x05, 0x94,
+	0x92, 0xe3, 0x5b, 0x84, 0xad, 0x91, 0xaf, 0x9a, 0xd0, 0xeb, 0xc4, 0x34, 0xd7, 0x4d, 0x3d, 0x53,
+	0xa1, 0x60, 0x5a, 0x2a, 0xac, 0x97, 0x64, 0xbf, 0x20, 0xfc, 0x7c, 0xa5, 0xdb, 0xdd, 0xa2, 0xb7,
+	0xc2, 0xee, 0xf0, 0xfe, 0xad, 0x17, 0x70, 0x79, 0xed, 0x56, 0x74, 0x8f, 0x95, 0x52, 0x2e, 0x28,
+	0x57, 0xa7, 0x74, 0xc9, 0xd4, 0x7e, 0x72, 0x40, 0xb2, 0x98, 0x4b, 0x06, 0x47, 0x4b, 0x49, 0xb8,
+	0x5c, 0xdc, 0x40, 0xc2, 0x7d, 0x81, 0xf0, 0x63, 0x49, 0x3b, 0x96, 0xa3, 0x60, 0xc1, 0xa0, 0x87,
+	0x8f, 0xf7, 0xff, 0xc5, 0x42, 0xda, 0xcc, 0x3d, 0xde, 0x76, 0x44, 0x77, 0x60, 0x94, 0x47, 0xef,
+	0x34, 0x8d, 0xcb, 0xcc, 0xee, 0xf1, 0x26, 0xd5, 0x19, 0xa6, 0x26, 0x14, 0x62, 0x6a, 0xc2, 0x34,
+	0x4c, 0x4d, 0xc8, 0x65, 0x8a, 0x1f, 0xa2, 0x5a, 0x70, 0x9b, 0x02, 0xdb, 0x15, 0x77, 0x59, 0xc9,
+	0xfd, 0xb0, 0x6e, 0x49, 0x4c, 0x4a, 0xcd, 0x1e, 0xa2, 0xd4, 0x0e, 0x63, 0x43, 0x89, 0x81, 0xdf,
+	0x1d, 0x19, 0xf2, 0x09, 0xa1, 0xee, 0x50, 0x52, 0x89, 0x4d, 0x87, 0x92, 0xda, 0x43, 0x52, 0x7e,
+	0x83, 0xf0, 0x93, 0x75, 0xe0, 0xf1, 0xbf, 0x6f, 0x46, 0x10, 0x41, 0x02, 0x78, 0x4d, 0xb7, 0x84,
+	0xb3, 0x3a, 0xc1, 0x76, 0xbd, 0xa8, 0x3c, 0xd3, 0xdb, 0xc4, 0x6c, 0x90, 0x41, 0x55, 0xe2, 0xec,
+	0x79, 0xc1, 0x8e, 0x66, 0x6f, 0xcb, 0x93, 0x9b, 0xf5, 0xb6, 0x7c, 0x97, 0xcc, 0x84, 0x68, 0x06,
+	0xfd, 0xe3, 0x90, 0x24, 0x87, 0x7a, 0x49, 0x98, 0x14, 0x9a, 0x4d, 0x08, 0x95, 0x3e, 0xd3, 0x75,
+	0x87, 0xa7, 0x7a, 0x0c, 0x6d, 0x49, 0xbf, 0x1f, 0xa8, 0xd9, 0x96, 0x8b, 0x1b, 0x48, 0xb8, 0x4f,
+	0x11, 0x7e, 0x24, 0x9e, 0x6f, 0xf1, 0xf9, 0x89, 0x27, 0xea, 0x65, 0xed, 0x89, 0x98, 0x2a, 0x04,
+	0xcc, 0xbc, 0xb9, 0x30, 0x53, 0xfe, 0xc9, 0xe4, 0xaa, 0xc6, 0xef, 0x6b, 0x1a, 0xdd, 0x16, 0xe9,
+	0x85, 0x9a, 0xe5, 0x3f, 0xa1, 0x33, 0x2b, 0x7f, 0x85, 0x5c, 0x62, 0xfd, 0x84, 0xf0, 0x73, 0x2b,
+	0xe0, 0x01, 0x87, 0x89, 0x07, 0x48, 0xab, 0xa6, 0x59, 0xb7, 0x4a, 0xb5, 0x40, 0x5c, 0x99, 0xce,
+	0x24, 0xd3, 0xe4, 0xb6, 0x49, 0xc4, 0x14, 0x9c, 0x7a, 0x4d, 0x4e, 0x2d, 0x36, 0x6b, 0x72, 0x79,
+	0x1e, 0x99, 0x6e, 0x72, 0xcb, 0x0f, 0xd5, 0x9c, 0x7a, 0xa9, 0xc8, 0x93, 0x9b, 0x75, 0x93, 0x7c,
+	0x17, 0xc9, 0xfa, 0x03, 0xc2, 0xcf, 0x24, 0xa5, 0x51, 0x71, 0xb8, 0xdb, 0x77, 0xf9, 0xfe, 0x56,
+	0x18, 0x87, 0x30, 0xab, 0x62, 0x50, 0x56, 0x63, 0x5a, 0x41, 0x59, 0x9d, 0xc6, 0x62, 0xec, 0xb1,
+	0x94, 0x01, 0x17, 0x21, 0xda, 0x8f, 0xa5, 0x23, 0x1a, 0xd3, 0xc7, 0xd2, 0x8c, 0x54, 0xa2, 0xfc,
+	0x8d, 0xf0, 0xb9, 0x36, 0x27, 0x94, 0x8f, 0x06, 0xb8, 0xc0, 0xaa, 0x84, 0x3b, 0xbb, 0x5b, 0x21,
+	0xd0, 0xe1, 0xd0, 0xb3, 0x36, 0xb5, 0x16, 0x39, 0xdd, 0x48, 0x40, 0x6f, 0xcd, 0xcc, 0x2f, 0x73,
+	0xe4, 0x57, 0xef, 0x86, 0x01, 0xe5, 0x45, 0x8f, 0x7c, 0x8e, 0xda, 0xec, 0xc8, 0xe7, 0x9a, 0x64,
+	0x40, 0x1b, 0xbd, 0x69, 0x40, 0x1b, 0xbd, 0x19, 0x80, 0x36, 0x7a, 0xa7, 0x81, 0xde, 0x43, 0xf8,
+	0xd5, 0x36, 0xa7, 0x40, 0x7a, 0x22, 0x4a, 0xf5, 0xd2, 0xa7, 0xa9, 0x79, 0x29, 0x4f, 0xf1, 0x11,
+	0xf0, 0x9b, 0xb3, 0xb2, 0x13, 0xdb, 0x78, 0x1d, 0x9d, 0x47, 0xd6, 0x1f, 0x08, 0xdb, 0x59, 0x8d,
+	0xdc, 0x70, 0xfa, 0x36, 0xd1, 0x7a, 0xbb, 0xc0, 0xc2, 0xe3, 0x26, 0x62, 0x13, 0xeb, 0x33, 0xf1,
+	0x12, 0x3b, 0x38, 0x8f, 0xac, 0xdf, 0x10, 0x7e, 0x31, 0x1e, 0xc0, 0x37, 0x48, 0xe4, 0xf1, 0x86,
+	0xff, 0x21, 0x38, 0x71, 0x64, 0xdb, 0x01, 0x9f, 0x50, 0x37, 0x60, 0x56, 0x5d, 0x7b, 0x84, 0xe7,
+	0x38, 0x08, 0xf2, 0xb5, 0xe9, 0x8d, 0x64, 0xfd, 0xfc, 0x8e, 0xf0, 0x4b, 0x49, 0x2b, 0x54, 0xc7,
+	0x5a, 0x6b, 0x06, 0xdd, 0x54, 0x6d, 0x21, 0xb0, 0x1b, 0x33, 0x70, 0xca, 0xbc, 0xa5, 0x1d, 0xb6,
+	0x9e, 0xf4, 0x4a, 0x0c, 0x7f, 0x44, 0xa8, 0x05, 0x91, 0xcf, 0x9b, 0xee, 0x4e, 0xda, 0x10, 0xd7,
+	0xf5, 0x1b, 0x58, 0xbe, 0x8b, 0xd9, 0x5b, 0xda, 0x53, 0xcd, 0xe4, 0x06, 0xe2, 0x6a, 0xa9, 0xed,
+	0x82, 0xb3, 0xb7, 0x0d, 0x94, 0xb9, 0x8c, 0x83, 0xef, 0x40, 0x2d, 0xf0, 0xd3, 0x3f, 0xf7, 0x35,
+	0xab, 0xe5, 0x04, 0x07, 0xb3, 0x6a, 0x39, 0xd1, 0x48, 0x40, 0x57, 0xbd, 0x83, 0x43, 0xbb, 0x74,
+	0xff, 0xd0, 0x2e, 0x3d, 0x38, 0xb4, 0xd1, 0x27, 0x03, 0x1b, 0xfd, 0x3c, 0xb0, 0xd1, 0xbd, 0x81,
+	0x8d, 0x0e, 0x06, 0x36, 0xfa, 0x67, 0x60, 0xa3, 0x7f, 0x07, 0x76, 0xe9, 0xc1, 0xc0, 0x46, 0x5f,
+	0x1d, 0xd9, 0xa5, 0x83, 0x23, 0xbb, 0x74, 0xff, 0xc8, 0x2e, 0xbd, 0x7f, 0x69, 0x27, 0x38, 0x66,
+	0x70, 0x83, 0x13, 0x7e, 0xf9, 0x5c, 0x1c, 0xfd, 0xdc, 0x79, 0x68, 0xf8, 0xb3, 0xe7, 0x1b, 0xff,
+	0x0f, 0x00, 0xd9, 0xcb, 0x9c, 0x41, 0x8c, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -182,9 +207,61 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// DescribeTaskQueueBacklog summarizes the backlog of a task queue.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
+	// MoveTaskQueueTasks moves backlog tasks matching a filter to another task queue.
+	MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error)
+	// PurgeTaskQueueTasks deletes backlog tasks matching a filter.
+	PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error)
+	// ListWorkers lists the workers which polled a task queue, or any task queue of the cluster, recently.
+	ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error)
+	// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions
+	// of a task queue.
+	UpdateBuildIdRamp(ctx context.Context, in *UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*UpdateBuildIdRampResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops the dispatch of workflow and activity tasks of a running workflow
+	// and defers its user timers, signals and updates are still accepted.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// UpdateActivityOptions updates the task queue, timeouts and retry policy of a pending activity.
+	UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	// ResetActivity retries a pending activity immediately, skipping the remaining retry backoff.
+	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// StartResetActivitiesBatchOperation starts a batch operation which resets the pending activities
+	// waiting for their next retry of a set of workflow executions.
+	StartResetActivitiesBatchOperation(ctx context.Context, in *StartResetActivitiesBatchOperationRequest, opts ...grpc.CallOption) (*StartResetActivitiesBatchOperationResponse, error)
+	// ExportWorkflowExecution exports the history and mutable state of a workflow execution, and of the
+	// other runs of its continued as new chain, into a bundle which can be imported by ImportWorkflowExecution.
+	// The bundle is returned in pages of history batches of one run, the number of exported runs is bounded
+	// by frontend.exportWorkflowMaxRunCount.
+	ExportWorkflowExecution(ctx context.Context, in *ExportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionResponse, error)
+	// ImportWorkflowExecution creates the workflow executions of a bundle exported by ExportWorkflowExecution.
+	// Either all runs of the bundle are imported or none. Imported runs are deleted after the namespace retention,
+	// runs which were still running when exported are terminated, as they cannot make progress after the import.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
+	// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are
+	// committed. The stream is closed once all events of a closed workflow execution are sent.
+	// It is an operator API only served by the admin service, it is not exposed to SDK clients which keep
+	// following history by long polling GetWorkflowExecutionHistory. History hosts close streams with a
+	// DeadlineExceeded error after a maximum duration, they are resumed with the last received next_event_id.
+	StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error)
+	// ListFaultInjectionScenarios returns the persistence fault injection scenarios of a history host.
+	ListFaultInjectionScenarios(ctx context.Context, in *ListFaultInjectionScenariosRequest, opts ...grpc.CallOption) (*ListFaultInjectionScenariosResponse, error)
+	// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of a history host.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	UpdateFaultInjectionScenario(ctx context.Context, in *UpdateFaultInjectionScenarioRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionScenarioResponse, error)
+	// StartHistoryShardCountMigration starts the system workflow which increases the history shard count
+	// of the cluster while it stays available.
+	StartHistoryShardCountMigration(ctx context.Context, in *StartHistoryShardCountMigrationRequest, opts ...grpc.CallOption) (*StartHistoryShardCountMigrationResponse, error)
+	// CheckPersistenceConsistency checks one page of persisted data for inconsistencies between stores
+	// and optionally repairs them.
+	CheckPersistenceConsistency(ctx context.Context, in *CheckPersistenceConsistencyRequest, opts ...grpc.CallOption) (*CheckPersistenceConsistencyResponse, error)
 }
 
 type adminServiceClient struct {
@@ -429,6 +506,51 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error) {
+	out := new(MoveTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/MoveTaskQueueTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error) {
+	out := new(PurgeTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PurgeTaskQueueTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error) {
+	out := new(ListWorkersResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateBuildIdRamp(ctx context.Context, in *UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*UpdateBuildIdRampResponse, error) {
+	out := new(UpdateBuildIdRampResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateBuildIdRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
@@ -438,6 +560,69 @@ func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	out := new(UpdateActivityOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error) {
+	out := new(ResetActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartResetActivitiesBatchOperation(ctx context.Context, in *StartResetActivitiesBatchOperationRequest, opts ...grpc.CallOption) (*StartResetActivitiesBatchOperationResponse, error) {
+	out := new(StartResetActivitiesBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartResetActivitiesBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportWorkflowExecution(ctx context.Context, in *ExportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionResponse, error) {
+	out := new(ExportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ExportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages", opts...)
 	if err != nil {
@@ -469,6 +654,74 @@ func (x *adminServiceStreamWorkflowReplicationMessagesClient) Recv() (*StreamWor
 	return m, nil
 }
 
+func (c *adminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[1], "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowExecutionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamWorkflowExecutionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamWorkflowExecutionHistoryClient interface {
+	Recv() (*StreamWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryClient) Recv() (*StreamWorkflowExecutionHistoryResponse, error) {
+	m := new(StreamWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) ListFaultInjectionScenarios(ctx context.Context, in *ListFaultInjectionScenariosRequest, opts ...grpc.CallOption) (*ListFaultInjectionScenariosResponse, error) {
+	out := new(ListFaultInjectionScenariosResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListFaultInjectionScenarios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateFaultInjectionScenario(ctx context.Context, in *UpdateFaultInjectionScenarioRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionScenarioResponse, error) {
+	out := new(UpdateFaultInjectionScenarioResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateFaultInjectionScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartHistoryShardCountMigration(ctx context.Context, in *StartHistoryShardCountMigrationRequest, opts ...grpc.CallOption) (*StartHistoryShardCountMigrationResponse, error) {
+	out := new(StartHistoryShardCountMigrationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartHistoryShardCountMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CheckPersistenceConsistency(ctx context.Context, in *CheckPersistenceConsistencyRequest, opts ...grpc.CallOption) (*CheckPersistenceConsistencyResponse, error) {
+	out := new(CheckPersistenceConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CheckPersistenceConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// RebuildMutableState attempts to rebuild mutable state according to persisted history events.
@@ -528,9 +781,61 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// DescribeTaskQueueBacklog summarizes the backlog of a task queue.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
+	// MoveTaskQueueTasks moves backlog tasks matching a filter to another task queue.
+	MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error)
+	// PurgeTaskQueueTasks deletes backlog tasks matching a filter.
+	PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error)
+	// ListWorkers lists the workers which polled a task queue, or any task queue of the cluster, recently.
+	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
+	// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions
+	// of a task queue.
+	UpdateBuildIdRamp(context.Context, *UpdateBuildIdRampRequest) (*UpdateBuildIdRampResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution stops the dispatch of workflow and activity tasks of a running workflow
+	// and defers its user timers, signals and updates are still accepted.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// UpdateActivityOptions updates the task queue, timeouts and retry policy of a pending activity.
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	// ResetActivity retries a pending activity immediately, skipping the remaining retry backoff.
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// StartResetActivitiesBatchOperation starts a batch operation which resets the pending activities
+	// waiting for their next retry of a set of workflow executions.
+	StartResetActivitiesBatchOperation(context.Context, *StartResetActivitiesBatchOperationRequest) (*StartResetActivitiesBatchOperationResponse, error)
+	// ExportWorkflowExecution exports the history and mutable state of a workflow execution, and of the
+	// other runs of its continued as new chain, into a bundle which can be imported by ImportWorkflowExecution.
+	// The bundle is returned in pages of history batches of one run, the number of exported runs is bounded
+	// by frontend.exportWorkflowMaxRunCount.
+	ExportWorkflowExecution(context.Context, *ExportWorkflowExecutionRequest) (*ExportWorkflowExecutionResponse, error)
+	// ImportWorkflowExecution creates the workflow executions of a bundle exported by ExportWorkflowExecution.
+	// Either all runs of the bundle are imported or none. Imported runs are deleted after the namespace retention,
+	// runs which were still running when exported are terminated, as they cannot make progress after the import.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
+	// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are
+	// committed. The stream is closed once all events of a closed workflow execution are sent.
+	// It is an operator API only served by the admin service, it is not exposed to SDK clients which keep
+	// following history by long polling GetWorkflowExecutionHistory. History hosts close streams with a
+	// DeadlineExceeded error after a maximum duration, they are resumed with the last received next_event_id.
+	StreamWorkflowExecutionHistory(*StreamWorkflowExecutionHistoryRequest, AdminService_StreamWorkflowExecutionHistoryServer) error
+	// ListFaultInjectionScenarios returns the persistence fault injection scenarios of a history host.
+	ListFaultInjectionScenarios(context.Context, *ListFaultInjectionScenariosRequest) (*ListFaultInjectionScenariosResponse, error)
+	// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of a history host.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	UpdateFaultInjectionScenario(context.Context, *UpdateFaultInjectionScenarioRequest) (*UpdateFaultInjectionScenarioResponse, error)
+	// StartHistoryShardCountMigration starts the system workflow which increases the history shard count
+	// of the cluster while it stays available.
+	StartHistoryShardCountMigration(context.Context, *StartHistoryShardCountMigrationRequest) (*StartHistoryShardCountMigrationResponse, error)
+	// CheckPersistenceConsistency checks one page of persisted data for inconsistencies between stores
+	// and optionally repairs them.
+	CheckPersistenceConsistency(context.Context, *CheckPersistenceConsistencyRequest) (*CheckPersistenceConsistencyResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -615,12 +920,63 @@ func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Conte
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) DescribeTaskQueueBacklog(ctx context.Context, req *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
+func (*UnimplementedAdminServiceServer) MoveTaskQueueTasks(ctx context.Context, req *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) PurgeTaskQueueTasks(ctx context.Context, req *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTaskQueueTasks not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListWorkers(ctx context.Context, req *ListWorkersRequest) (*ListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateBuildIdRamp(ctx context.Context, req *UpdateBuildIdRampRequest) (*UpdateBuildIdRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuildIdRamp not implemented")
+}
 func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
+func (*UnimplementedAdminServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateActivityOptions(ctx context.Context, req *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityOptions not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResetActivity(ctx context.Context, req *ResetActivityRequest) (*ResetActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartResetActivitiesBatchOperation(ctx context.Context, req *StartResetActivitiesBatchOperationRequest) (*StartResetActivitiesBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartResetActivitiesBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) ExportWorkflowExecution(ctx context.Context, req *ExportWorkflowExecutionRequest) (*ExportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
 func (*UnimplementedAdminServiceServer) StreamWorkflowReplicationMessages(srv AdminService_StreamWorkflowReplicationMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
 }
+func (*UnimplementedAdminServiceServer) StreamWorkflowExecutionHistory(req *StreamWorkflowExecutionHistoryRequest, srv AdminService_StreamWorkflowExecutionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutionHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListFaultInjectionScenarios(ctx context.Context, req *ListFaultInjectionScenariosRequest) (*ListFaultInjectionScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFaultInjectionScenarios not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateFaultInjectionScenario(ctx context.Context, req *UpdateFaultInjectionScenarioRequest) (*UpdateFaultInjectionScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaultInjectionScenario not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartHistoryShardCountMigration(ctx context.Context, req *StartHistoryShardCountMigrationRequest) (*StartHistoryShardCountMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartHistoryShardCountMigration not implemented")
+}
+func (*UnimplementedAdminServiceServer) CheckPersistenceConsistency(ctx context.Context, req *CheckPersistenceConsistencyRequest) (*CheckPersistenceConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPersistenceConsistency not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -1094,6 +1450,96 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MoveTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MoveTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/MoveTaskQueueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MoveTaskQueueTasks(ctx, req.(*MoveTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PurgeTaskQueueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeTaskQueueTasks(ctx, req.(*PurgeTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkers(ctx, req.(*ListWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateBuildIdRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBuildIdRampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateBuildIdRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateBuildIdRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateBuildIdRamp(ctx, req.(*UpdateBuildIdRampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1112,6 +1558,132 @@ func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateActivityOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateActivityOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateActivityOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateActivityOptions(ctx, req.(*UpdateActivityOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResetActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetActivity(ctx, req.(*ResetActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartResetActivitiesBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartResetActivitiesBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartResetActivitiesBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartResetActivitiesBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartResetActivitiesBatchOperation(ctx, req.(*StartResetActivitiesBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ExportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportWorkflowExecution(ctx, req.(*ExportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_StreamWorkflowReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(AdminServiceServer).StreamWorkflowReplicationMessages(&adminServiceStreamWorkflowReplicationMessagesServer{stream})
 }
@@ -1138,6 +1710,99 @@ func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWor
 	return m, nil
 }
 
+func _AdminService_StreamWorkflowExecutionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamWorkflowExecutionHistory(m, &adminServiceStreamWorkflowExecutionHistoryServer{stream})
+}
+
+type AdminService_StreamWorkflowExecutionHistoryServer interface {
+	Send(*StreamWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamWorkflowExecutionHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamWorkflowExecutionHistoryServer) Send(m *StreamWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_ListFaultInjectionScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaultInjectionScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListFaultInjectionScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListFaultInjectionScenarios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListFaultInjectionScenarios(ctx, req.(*ListFaultInjectionScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateFaultInjectionScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaultInjectionScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateFaultInjectionScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateFaultInjectionScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateFaultInjectionScenario(ctx, req.(*UpdateFaultInjectionScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartHistoryShardCountMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartHistoryShardCountMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartHistoryShardCountMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartHistoryShardCountMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartHistoryShardCountMigration(ctx, req.(*StartHistoryShardCountMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CheckPersistenceConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPersistenceConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckPersistenceConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CheckPersistenceConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckPersistenceConsistency(ctx, req.(*CheckPersistenceConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -1246,10 +1911,74 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _AdminService_DescribeTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "MoveTaskQueueTasks",
+			Handler:    _AdminService_MoveTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "PurgeTaskQueueTasks",
+			Handler:    _AdminService_PurgeTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _AdminService_ListWorkers_Handler,
+		},
+		{
+			MethodName: "UpdateBuildIdRamp",
+			Handler:    _AdminService_UpdateBuildIdRamp_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UpdateActivityOptions",
+			Handler:    _AdminService_UpdateActivityOptions_Handler,
+		},
+		{
+			MethodName: "ResetActivity",
+			Handler:    _AdminService_ResetActivity_Handler,
+		},
+		{
+			MethodName: "StartResetActivitiesBatchOperation",
+			Handler:    _AdminService_StartResetActivitiesBatchOperation_Handler,
+		},
+		{
+			MethodName: "ExportWorkflowExecution",
+			Handler:    _AdminService_ExportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _AdminService_ImportWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ListFaultInjectionScenarios",
+			Handler:    _AdminService_ListFaultInjectionScenarios_Handler,
+		},
+		{
+			MethodName: "UpdateFaultInjectionScenario",
+			Handler:    _AdminService_UpdateFaultInjectionScenario_Handler,
+		},
+		{
+			MethodName: "StartHistoryShardCountMigration",
+			Handler:    _AdminService_StartHistoryShardCountMigration_Handler,
+		},
+		{
+			MethodName: "CheckPersistenceConsistency",
+			Handler:    _AdminService_CheckPersistenceConsistency_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1258,6 +1987,11 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamWorkflowExecutionHistory",
+			Handler:       _AdminService_StreamWorkflowExecutionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
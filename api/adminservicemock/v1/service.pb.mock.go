@@ -101,6 +101,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddSearchAttributes(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceClient)(nil).AddSearchAttributes), varargs...)
 }
 
+// CheckPersistenceConsistency mocks base method.
+func (m *MockAdminServiceClient) CheckPersistenceConsistency(ctx context.Context, in *adminservice.CheckPersistenceConsistencyRequest, opts ...grpc.CallOption) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckPersistenceConsistency", varargs...)
+	ret0, _ := ret[0].(*adminservice.CheckPersistenceConsistencyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPersistenceConsistency indicates an expected call of CheckPersistenceConsistency.
+func (mr *MockAdminServiceClientMockRecorder) CheckPersistenceConsistency(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPersistenceConsistency", reflect.TypeOf((*MockAdminServiceClient)(nil).CheckPersistenceConsistency), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +221,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *adminservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
+// ExportWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ExportWorkflowExecution(ctx context.Context, in *adminservice.ExportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecution indicates an expected call of ExportWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ExportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ExportWorkflowExecution), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +421,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ImportWorkflowExecution(ctx context.Context, in *adminservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +481,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListFaultInjectionScenarios mocks base method.
+func (m *MockAdminServiceClient) ListFaultInjectionScenarios(ctx context.Context, in *adminservice.ListFaultInjectionScenariosRequest, opts ...grpc.CallOption) (*adminservice.ListFaultInjectionScenariosResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFaultInjectionScenarios", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListFaultInjectionScenariosResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFaultInjectionScenarios indicates an expected call of ListFaultInjectionScenarios.
+func (mr *MockAdminServiceClientMockRecorder) ListFaultInjectionScenarios(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFaultInjectionScenarios", reflect.TypeOf((*MockAdminServiceClient)(nil).ListFaultInjectionScenarios), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +521,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListWorkers mocks base method.
+func (m *MockAdminServiceClient) ListWorkers(ctx context.Context, in *adminservice.ListWorkersRequest, opts ...grpc.CallOption) (*adminservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockAdminServiceClientMockRecorder) ListWorkers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListWorkers), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -441,6 +561,46 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) MoveTaskQueueTasks(ctx context.Context, in *adminservice.MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) MoveTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MoveTaskQueueTasks), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +621,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQMessages(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *adminservice.PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) PurgeTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeTaskQueueTasks), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +781,86 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResetActivity mocks base method.
+func (m *MockAdminServiceClient) ResetActivity(ctx context.Context, in *adminservice.ResetActivityRequest, opts ...grpc.CallOption) (*adminservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockAdminServiceClientMockRecorder) ResetActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetActivity), varargs...)
+}
+
+// StartHistoryShardCountMigration mocks base method.
+func (m *MockAdminServiceClient) StartHistoryShardCountMigration(ctx context.Context, in *adminservice.StartHistoryShardCountMigrationRequest, opts ...grpc.CallOption) (*adminservice.StartHistoryShardCountMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartHistoryShardCountMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartHistoryShardCountMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartHistoryShardCountMigration indicates an expected call of StartHistoryShardCountMigration.
+func (mr *MockAdminServiceClientMockRecorder) StartHistoryShardCountMigration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartHistoryShardCountMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).StartHistoryShardCountMigration), varargs...)
+}
+
+// StartResetActivitiesBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartResetActivitiesBatchOperation(ctx context.Context, in *adminservice.StartResetActivitiesBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartResetActivitiesBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartResetActivitiesBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartResetActivitiesBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResetActivitiesBatchOperation indicates an expected call of StartResetActivitiesBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartResetActivitiesBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResetActivitiesBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartResetActivitiesBatchOperation), varargs...)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *adminservice.StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamWorkflowExecutionHistoryClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowExecutionHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowExecutionHistory), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -621,6 +881,86 @@ func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockAdminServiceClient) UpdateActivityOptions(ctx context.Context, in *adminservice.UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*adminservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockAdminServiceClientMockRecorder) UpdateActivityOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateActivityOptions), varargs...)
+}
+
+// UpdateBuildIdRamp mocks base method.
+func (m *MockAdminServiceClient) UpdateBuildIdRamp(ctx context.Context, in *adminservice.UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*adminservice.UpdateBuildIdRampResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBuildIdRamp", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateBuildIdRampResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuildIdRamp indicates an expected call of UpdateBuildIdRamp.
+func (mr *MockAdminServiceClientMockRecorder) UpdateBuildIdRamp(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuildIdRamp", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateBuildIdRamp), varargs...)
+}
+
+// UpdateFaultInjectionScenario mocks base method.
+func (m *MockAdminServiceClient) UpdateFaultInjectionScenario(ctx context.Context, in *adminservice.UpdateFaultInjectionScenarioRequest, opts ...grpc.CallOption) (*adminservice.UpdateFaultInjectionScenarioResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFaultInjectionScenario", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateFaultInjectionScenarioResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjectionScenario indicates an expected call of UpdateFaultInjectionScenario.
+func (mr *MockAdminServiceClientMockRecorder) UpdateFaultInjectionScenario(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjectionScenario", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateFaultInjectionScenario), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -758,51 +1098,174 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Trailer))
 }
 
-// MockAdminServiceServer is a mock of AdminServiceServer interface.
-type MockAdminServiceServer struct {
+// MockAdminService_StreamWorkflowExecutionHistoryClient is a mock of AdminService_StreamWorkflowExecutionHistoryClient interface.
+type MockAdminService_StreamWorkflowExecutionHistoryClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockAdminServiceServerMockRecorder
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder
 }
 
-// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
-type MockAdminServiceServerMockRecorder struct {
-	mock *MockAdminServiceServer
+// MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryClient.
+type MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryClient
 }
 
-// NewMockAdminServiceServer creates a new mock instance.
-func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
-	mock := &MockAdminServiceServer{ctrl: ctrl}
-	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+// NewMockAdminService_StreamWorkflowExecutionHistoryClient creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryClient(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryClient {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder {
 	return m.recorder
 }
 
-// AddOrUpdateRemoteCluster mocks base method.
-func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
-func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).CloseSend))
 }
 
-// AddSearchAttributes mocks base method.
-func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
-	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Recv() (*adminservice.StreamWorkflowExecutionHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamWorkflowExecutionHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryClient)(nil).Trailer))
+}
+
+// MockAdminServiceServer is a mock of AdminServiceServer interface.
+type MockAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceServerMockRecorder
+}
+
+// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
+type MockAdminServiceServerMockRecorder struct {
+	mock *MockAdminServiceServer
+}
+
+// NewMockAdminServiceServer creates a new mock instance.
+func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
+	mock := &MockAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddOrUpdateRemoteCluster mocks base method.
+func (m *MockAdminServiceServer) AddOrUpdateRemoteCluster(arg0 context.Context, arg1 *adminservice.AddOrUpdateRemoteClusterRequest) (*adminservice.AddOrUpdateRemoteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrUpdateRemoteCluster", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddOrUpdateRemoteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrUpdateRemoteCluster indicates an expected call of AddOrUpdateRemoteCluster.
+func (mr *MockAdminServiceServerMockRecorder) AddOrUpdateRemoteCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrUpdateRemoteCluster", reflect.TypeOf((*MockAdminServiceServer)(nil).AddOrUpdateRemoteCluster), arg0, arg1)
+}
+
+// AddSearchAttributes mocks base method.
+func (m *MockAdminServiceServer) AddSearchAttributes(arg0 context.Context, arg1 *adminservice.AddSearchAttributesRequest) (*adminservice.AddSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AddSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddSearchAttributes indicates an expected call of AddSearchAttributes.
@@ -811,6 +1274,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddSearchAttributes(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttributes), arg0, arg1)
 }
 
+// CheckPersistenceConsistency mocks base method.
+func (m *MockAdminServiceServer) CheckPersistenceConsistency(arg0 context.Context, arg1 *adminservice.CheckPersistenceConsistencyRequest) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPersistenceConsistency", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CheckPersistenceConsistencyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPersistenceConsistency indicates an expected call of CheckPersistenceConsistency.
+func (mr *MockAdminServiceServerMockRecorder) CheckPersistenceConsistency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPersistenceConsistency", reflect.TypeOf((*MockAdminServiceServer)(nil).CheckPersistenceConsistency), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -886,6 +1364,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueBacklogRequest) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
+// ExportWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ExportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ExportWorkflowExecutionRequest) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecution indicates an expected call of ExportWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ExportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ExportWorkflowExecution), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1006,6 +1514,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ImportWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ImportWorkflowExecution(arg0 context.Context, arg1 *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ImportWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowExecution indicates an expected call of ImportWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1036,6 +1559,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListFaultInjectionScenarios mocks base method.
+func (m *MockAdminServiceServer) ListFaultInjectionScenarios(arg0 context.Context, arg1 *adminservice.ListFaultInjectionScenariosRequest) (*adminservice.ListFaultInjectionScenariosResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFaultInjectionScenarios", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListFaultInjectionScenariosResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFaultInjectionScenarios indicates an expected call of ListFaultInjectionScenarios.
+func (mr *MockAdminServiceServerMockRecorder) ListFaultInjectionScenarios(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFaultInjectionScenarios", reflect.TypeOf((*MockAdminServiceServer)(nil).ListFaultInjectionScenarios), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1051,6 +1589,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListWorkers mocks base method.
+func (m *MockAdminServiceServer) ListWorkers(arg0 context.Context, arg1 *adminservice.ListWorkersRequest) (*adminservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockAdminServiceServerMockRecorder) ListWorkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListWorkers), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1066,6 +1619,36 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) MoveTaskQueueTasks(arg0 context.Context, arg1 *adminservice.MoveTaskQueueTasksRequest) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) MoveTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MoveTaskQueueTasks), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1081,6 +1664,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQMessages(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) PurgeTaskQueueTasks(arg0 context.Context, arg1 *adminservice.PurgeTaskQueueTasksRequest) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) PurgeTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeTaskQueueTasks), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1186,6 +1784,65 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResetActivity mocks base method.
+func (m *MockAdminServiceServer) ResetActivity(arg0 context.Context, arg1 *adminservice.ResetActivityRequest) (*adminservice.ResetActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetActivity indicates an expected call of ResetActivity.
+func (mr *MockAdminServiceServerMockRecorder) ResetActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetActivity), arg0, arg1)
+}
+
+// StartHistoryShardCountMigration mocks base method.
+func (m *MockAdminServiceServer) StartHistoryShardCountMigration(arg0 context.Context, arg1 *adminservice.StartHistoryShardCountMigrationRequest) (*adminservice.StartHistoryShardCountMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartHistoryShardCountMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartHistoryShardCountMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartHistoryShardCountMigration indicates an expected call of StartHistoryShardCountMigration.
+func (mr *MockAdminServiceServerMockRecorder) StartHistoryShardCountMigration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartHistoryShardCountMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).StartHistoryShardCountMigration), arg0, arg1)
+}
+
+// StartResetActivitiesBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartResetActivitiesBatchOperation(arg0 context.Context, arg1 *adminservice.StartResetActivitiesBatchOperationRequest) (*adminservice.StartResetActivitiesBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartResetActivitiesBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartResetActivitiesBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartResetActivitiesBatchOperation indicates an expected call of StartResetActivitiesBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartResetActivitiesBatchOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartResetActivitiesBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartResetActivitiesBatchOperation), arg0, arg1)
+}
+
+// StreamWorkflowExecutionHistory mocks base method.
+func (m *MockAdminServiceServer) StreamWorkflowExecutionHistory(arg0 *adminservice.StreamWorkflowExecutionHistoryRequest, arg1 adminservice.AdminService_StreamWorkflowExecutionHistoryServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkflowExecutionHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkflowExecutionHistory indicates an expected call of StreamWorkflowExecutionHistory.
+func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowExecutionHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowExecutionHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowExecutionHistory), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1200,6 +1857,66 @@ func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
 }
 
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
+
+// UpdateActivityOptions mocks base method.
+func (m *MockAdminServiceServer) UpdateActivityOptions(arg0 context.Context, arg1 *adminservice.UpdateActivityOptionsRequest) (*adminservice.UpdateActivityOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateActivityOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockAdminServiceServerMockRecorder) UpdateActivityOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateActivityOptions), arg0, arg1)
+}
+
+// UpdateBuildIdRamp mocks base method.
+func (m *MockAdminServiceServer) UpdateBuildIdRamp(arg0 context.Context, arg1 *adminservice.UpdateBuildIdRampRequest) (*adminservice.UpdateBuildIdRampResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuildIdRamp", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateBuildIdRampResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuildIdRamp indicates an expected call of UpdateBuildIdRamp.
+func (mr *MockAdminServiceServerMockRecorder) UpdateBuildIdRamp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuildIdRamp", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateBuildIdRamp), arg0, arg1)
+}
+
+// UpdateFaultInjectionScenario mocks base method.
+func (m *MockAdminServiceServer) UpdateFaultInjectionScenario(arg0 context.Context, arg1 *adminservice.UpdateFaultInjectionScenarioRequest) (*adminservice.UpdateFaultInjectionScenarioResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFaultInjectionScenario", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateFaultInjectionScenarioResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaultInjectionScenario indicates an expected call of UpdateFaultInjectionScenario.
+func (mr *MockAdminServiceServerMockRecorder) UpdateFaultInjectionScenario(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaultInjectionScenario", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateFaultInjectionScenario), arg0, arg1)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesServer is a mock of AdminService_StreamWorkflowReplicationMessagesServer interface.
 type MockAdminService_StreamWorkflowReplicationMessagesServer struct {
 	ctrl     *gomock.Controller
@@ -1333,3 +2050,122 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesServerMockRecorder)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesServer)(nil).SetTrailer), arg0)
 }
+
+// MockAdminService_StreamWorkflowExecutionHistoryServer is a mock of AdminService_StreamWorkflowExecutionHistoryServer interface.
+type MockAdminService_StreamWorkflowExecutionHistoryServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder
+}
+
+// MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder is the mock recorder for MockAdminService_StreamWorkflowExecutionHistoryServer.
+type MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder struct {
+	mock *MockAdminService_StreamWorkflowExecutionHistoryServer
+}
+
+// NewMockAdminService_StreamWorkflowExecutionHistoryServer creates a new mock instance.
+func NewMockAdminService_StreamWorkflowExecutionHistoryServer(ctrl *gomock.Controller) *MockAdminService_StreamWorkflowExecutionHistoryServer {
+	mock := &MockAdminService_StreamWorkflowExecutionHistoryServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) EXPECT() *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) Send(arg0 *adminservice.StreamWorkflowExecutionHistoryResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamWorkflowExecutionHistoryServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamWorkflowExecutionHistoryServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamWorkflowExecutionHistoryServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowExecutionHistoryServer)(nil).SetTrailer), arg0)
+}
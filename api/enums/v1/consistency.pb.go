@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/enums/v1/consistency.proto
+
+package enums
+
+import (
+	fmt "fmt"
+	math "math"
+	strconv "strconv"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConsistencyCheckType int32
+
+const (
+	CONSISTENCY_CHECK_TYPE_UNSPECIFIED ConsistencyCheckType = 0
+	// History branches whose workflow execution no longer exists.
+	CONSISTENCY_CHECK_TYPE_HISTORY_BRANCH ConsistencyCheckType = 1
+	// Current execution records pointing to a run which no longer exists.
+	CONSISTENCY_CHECK_TYPE_CURRENT_EXECUTION ConsistencyCheckType = 2
+	// History tasks referencing a workflow execution which no longer exists.
+	CONSISTENCY_CHECK_TYPE_HISTORY_TASK ConsistencyCheckType = 3
+	// Visibility records whose workflow execution no longer exists.
+	CONSISTENCY_CHECK_TYPE_VISIBILITY ConsistencyCheckType = 4
+	// Task queue rows belonging to a namespace which no longer exists.
+	CONSISTENCY_CHECK_TYPE_TASK_QUEUE ConsistencyCheckType = 5
+)
+
+var ConsistencyCheckType_name = map[int32]string{
+	0: "Unspecified",
+	1: "HistoryBranch",
+	2: "CurrentExecution",
+	3: "HistoryTask",
+	4: "Visibility",
+	5: "TaskQueue",
+}
+
+var ConsistencyCheckType_value = map[string]int32{
+	"Unspecified":      0,
+	"HistoryBranch":    1,
+	"CurrentExecution": 2,
+	"HistoryTask":      3,
+	"Visibility":       4,
+	"TaskQueue":        5,
+}
+
+func (ConsistencyCheckType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95cbe9fcac016465, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("temporal.server.api.enums.v1.ConsistencyCheckType", ConsistencyCheckType_name, ConsistencyCheckType_value)
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/enums/v1/consistency.proto", fileDescriptor_95cbe9fcac016465)
+}
+
+var fileDescriptor_95cbe9fcac016465 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd1, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc7, 0xf1, 0xbb, 0xfa, 0x67, 0xb8, 0x29, 0x04, 0x47, 0x79, 0x40, 0xa5, 0x5a, 0x45, 0x2e,
+	0x14, 0x47, 0xa7, 0xf6, 0x3c, 0xe9, 0x51, 0x49, 0x6b, 0x72, 0x11, 0xe3, 0xe0, 0x51, 0xcb, 0xa1,
+	0x41, 0xdb, 0x0b, 0x49, 0x2d, 0x74, 0xf3, 0x15, 0x88, 0x2f, 0xc3, 0x97, 0xe2, 0xd8, 0xb1, 0xa3,
+	0xbd, 0x2e, 0x8e, 0x7d, 0x09, 0x62, 0x45, 0x5d, 0x8c, 0x6e, 0xcf, 0xf0, 0x79, 0x7e, 0xcb, 0x97,
+	0xd0, 0x81, 0xee, 0xa5, 0x26, 0xeb, 0xdc, 0x79, 0xb9, 0xce, 0x86, 0x3a, 0xf3, 0x3a, 0x69, 0xe2,
+	0xe9, 0xfe, 0x7d, 0x2f, 0xf7, 0x86, 0x55, 0xaf, 0x6b, 0xfa, 0x79, 0x92, 0x0f, 0x74, 0xbf, 0x3b,
+	0xa2, 0x69, 0x66, 0x06, 0xc6, 0x5d, 0xff, 0xf2, 0xf4, 0xd3, 0xd3, 0x4e, 0x9a, 0xd0, 0x85, 0xa7,
+	0xc3, 0xea, 0xde, 0x63, 0x89, 0xac, 0xb1, 0x9f, 0x1f, 0x76, 0xa3, 0xbb, 0xb7, 0x72, 0x94, 0x6a,
+	0x77, 0x9b, 0x6c, 0xb2, 0x96, 0x1f, 0x8a, 0x50, 0x72, 0x9f, 0xc5, 0x8a, 0x35, 0x38, 0x6b, 0x2a,
+	0x19, 0xb7, 0xb9, 0x8a, 0xfc, 0xb0, 0xcd, 0x99, 0x38, 0x16, 0xfc, 0xc8, 0x41, 0xee, 0x2e, 0x29,
+	0x17, 0xb8, 0x86, 0x08, 0x65, 0x2b, 0x88, 0x55, 0x3d, 0xa8, 0xf9, 0xac, 0xe1, 0x60, 0x77, 0x9f,
+	0x54, 0x0a, 0x28, 0x8b, 0x82, 0x80, 0xfb, 0x52, 0xf1, 0x73, 0xce, 0x22, 0x29, 0x5a, 0xbe, 0x53,
+	0x72, 0x77, 0xc8, 0xd6, 0x3f, 0xc3, 0xb2, 0x16, 0x36, 0x9d, 0x25, 0xb7, 0x4c, 0x36, 0x0a, 0xe0,
+	0x99, 0x08, 0x45, 0x5d, 0x9c, 0x08, 0x19, 0x3b, 0xcb, 0x7f, 0xb0, 0x8f, 0x1d, 0x75, 0x1a, 0xf1,
+	0x88, 0x3b, 0x2b, 0xf5, 0xcb, 0xf1, 0x14, 0xd0, 0x64, 0x0a, 0x68, 0x3e, 0x05, 0xfc, 0x60, 0x01,
+	0x3f, 0x5b, 0xc0, 0x2f, 0x16, 0xf0, 0xd8, 0x02, 0x7e, 0xb5, 0x80, 0xdf, 0x2c, 0xa0, 0xb9, 0x05,
+	0xfc, 0x34, 0x03, 0x34, 0x9e, 0x01, 0x9a, 0xcc, 0x00, 0x5d, 0x54, 0xae, 0xcd, 0x77, 0x17, 0x9a,
+	0x98, 0xdf, 0xd2, 0x1c, 0x2e, 0x8e, 0xab, 0xd5, 0x45, 0x95, 0x83, 0xf7, 0x01, 0x00, 0x39, 0xa7,
+	0xee, 0xd6, 0xc7, 0x01, 0x00, 0x00,
+}
+
+func (x ConsistencyCheckType) String() string {
+	s, ok := ConsistencyCheckType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
@@ -142,6 +142,8 @@ const (
 	TASK_TYPE_TRANSFER_DELETE_EXECUTION       TaskType = 24
 	TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE TaskType = 25
 	TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION      TaskType = 26
+	TASK_TYPE_TRANSFER_COMPLETION_CALLBACK    TaskType = 27
+	TASK_TYPE_COMPLETION_CALLBACK_BACKOFF     TaskType = 28
 )
 
 var TaskType_name = map[int32]string{
@@ -169,6 +171,8 @@ var TaskType_name = map[int32]string{
 	24: "TransferDeleteExecution",
 	25: "ReplicationSyncWorkflowState",
 	26: "ArchivalArchiveExecution",
+	27: "TransferCompletionCallback",
+	28: "CompletionCallbackBackoff",
 }
 
 var TaskType_value = map[string]int32{
@@ -196,6 +200,8 @@ var TaskType_value = map[string]int32{
 	"TransferDeleteExecution":      24,
 	"ReplicationSyncWorkflowState": 25,
 	"ArchivalArchiveExecution":     26,
+	"TransferCompletionCallback":   27,
+	"CompletionCallbackBackoff":    28,
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
@@ -213,48 +219,49 @@ func init() {
 }
 
 var fileDescriptor_36a3d3674ca3cfa6 = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc7, 0x6d, 0x08, 0x10, 0x0e, 0xdc, 0x7b, 0xe7, 0x0e, 0x1f, 0x01, 0x0a, 0xd3, 0x12, 0xa0,
-	0x7c, 0xa8, 0x4d, 0x84, 0xba, 0xec, 0xca, 0x99, 0x4c, 0x60, 0x84, 0xb1, 0xa3, 0x99, 0x49, 0x68,
-	0xba, 0xc0, 0x4a, 0x2b, 0x0b, 0x21, 0x4a, 0x1d, 0x25, 0x01, 0x89, 0x5d, 0x1f, 0xa1, 0x8f, 0xd1,
-	0x77, 0xe8, 0x0b, 0x74, 0xc9, 0x12, 0xa9, 0x9b, 0x62, 0x36, 0x5d, 0xf2, 0x08, 0x55, 0x4c, 0xe2,
-	0x0f, 0x70, 0xba, 0xb3, 0xf4, 0xff, 0xf9, 0x7f, 0xce, 0xfc, 0xcf, 0x9c, 0x81, 0xcd, 0xae, 0x7b,
-	0xde, 0xf2, 0xda, 0xcd, 0x4f, 0xc5, 0x8e, 0xdb, 0xbe, 0x74, 0xdb, 0xc5, 0x66, 0xeb, 0xb4, 0xe8,
-	0x7e, 0xbe, 0x38, 0xef, 0x14, 0x2f, 0x77, 0x8b, 0xdd, 0x66, 0xe7, 0xac, 0xd0, 0x6a, 0x7b, 0x5d,
-	0x0f, 0x2f, 0x0f, 0xc0, 0xc2, 0x03, 0x58, 0x68, 0xb6, 0x4e, 0x0b, 0x01, 0x58, 0xb8, 0xdc, 0xdd,
-	0x39, 0x06, 0x50, 0xcd, 0xce, 0x99, 0xf4, 0x2e, 0xda, 0x1f, 0x5d, 0xfc, 0x0c, 0x72, 0xca, 0x90,
-	0x07, 0x8e, 0xb4, 0x6b, 0x82, 0x32, 0xa7, 0x66, 0xc9, 0x2a, 0xa3, 0xbc, 0xc2, 0x59, 0x19, 0x69,
-	0x38, 0x07, 0x33, 0x71, 0x71, 0x9f, 0x4b, 0x65, 0x8b, 0x06, 0xd2, 0xf1, 0x12, 0xcc, 0xc7, 0x85,
-	0x72, 0xc9, 0x29, 0x19, 0xf4, 0xc0, 0xb4, 0xf7, 0xd0, 0xc8, 0xce, 0x4f, 0x1d, 0xa6, 0x7b, 0x05,
-	0x68, 0xb3, 0xeb, 0x9e, 0x78, 0xed, 0x2b, 0xbc, 0x02, 0x8b, 0x01, 0x4c, 0x0d, 0xc5, 0xf6, 0x6c,
-	0xd1, 0x78, 0x54, 0x64, 0xe0, 0x15, 0xca, 0x4a, 0x18, 0x96, 0xac, 0x30, 0x81, 0xf4, 0xb0, 0x81,
-	0x48, 0xe3, 0x87, 0x4c, 0xa0, 0x91, 0xa7, 0x9e, 0x82, 0x55, 0x4d, 0x4e, 0x0d, 0xc5, 0x6d, 0x0b,
-	0x8d, 0xe2, 0x65, 0x58, 0x48, 0xca, 0x75, 0x2e, 0x79, 0x89, 0x9b, 0x5c, 0x35, 0x50, 0xe6, 0x69,
-	0x45, 0x43, 0xd0, 0x7d, 0x5e, 0x37, 0x4c, 0x34, 0x86, 0x09, 0x2c, 0x25, 0xb5, 0x43, 0x76, 0x18,
-	0x15, 0x1e, 0xdf, 0xf9, 0x3e, 0x01, 0xd9, 0xde, 0xe9, 0xd4, 0x55, 0xcb, 0xc5, 0x8b, 0x30, 0x17,
-	0xc0, 0xaa, 0x51, 0x7d, 0x1c, 0xdd, 0x2a, 0xac, 0x44, 0x52, 0xac, 0xb9, 0x58, 0x88, 0x9b, 0xb0,
-	0x96, 0x8e, 0xc8, 0x86, 0x45, 0x1d, 0x83, 0x2a, 0x5e, 0xef, 0xf5, 0x3b, 0x82, 0xd7, 0xe1, 0x45,
-	0x04, 0x0e, 0xd2, 0x71, 0x8e, 0x6c, 0x71, 0x50, 0x31, 0xed, 0x23, 0xa7, 0xa7, 0xa1, 0xd1, 0x21,
-	0xd4, 0xc0, 0xe6, 0x81, 0xca, 0xe0, 0x97, 0x90, 0x4f, 0xa1, 0xa8, 0x69, 0x4b, 0xe6, 0xb0, 0x77,
-	0x8c, 0xd6, 0x82, 0x04, 0xc7, 0x92, 0xcd, 0x45, 0x9c, 0x61, 0x51, 0x66, 0xc6, 0xc0, 0x71, 0xfc,
-	0x0a, 0xb6, 0x52, 0x40, 0xa9, 0x0c, 0xa1, 0x1c, 0xba, 0xcf, 0xcd, 0x72, 0x8c, 0x9e, 0x18, 0x62,
-	0x2b, 0xf9, 0x9e, 0x65, 0xc4, 0x6d, 0xb3, 0x78, 0x03, 0x56, 0x53, 0x40, 0xc1, 0x24, 0x53, 0xe1,
-	0xc9, 0x11, 0xe0, 0x35, 0x78, 0x1e, 0x61, 0x89, 0x44, 0x82, 0x89, 0xd9, 0x35, 0x85, 0xa6, 0xc3,
-	0x99, 0x06, 0x50, 0x14, 0x48, 0x5f, 0xff, 0x07, 0x2f, 0xc0, 0x6c, 0x6c, 0x8c, 0x92, 0x89, 0xfe,
-	0xb4, 0xff, 0xc5, 0x79, 0x20, 0x29, 0xf6, 0xa2, 0x66, 0x85, 0x7f, 0xff, 0x97, 0x64, 0xca, 0xcc,
-	0x64, 0x2a, 0xdc, 0x14, 0x87, 0xd5, 0x99, 0xa5, 0x10, 0x4a, 0x32, 0x61, 0x07, 0x82, 0xa9, 0xf0,
-	0x66, 0xfd, 0x9f, 0x9c, 0x5f, 0x58, 0xab, 0xb7, 0x57, 0x76, 0xa5, 0xd2, 0xa7, 0x30, 0xde, 0x82,
-	0xf5, 0x88, 0x8a, 0x6e, 0x75, 0x3f, 0xf0, 0x28, 0xc1, 0x19, 0xbc, 0x0d, 0x1b, 0xa9, 0x64, 0xad,
-	0x2a, 0x59, 0x02, 0x9d, 0x1d, 0x6a, 0xfa, 0xf8, 0x5a, 0xcc, 0x0d, 0x35, 0xed, 0x9f, 0x3b, 0x42,
-	0xe7, 0x87, 0x8c, 0xfa, 0x09, 0xb8, 0x80, 0x5f, 0xc3, 0xf6, 0x5f, 0xf6, 0x20, 0x4c, 0x42, 0x2a,
-	0x43, 0x31, 0xb4, 0x98, 0x6c, 0x76, 0xb0, 0xb9, 0xfd, 0x8f, 0xb8, 0xf1, 0x52, 0x3e, 0x93, 0x9d,
-	0x44, 0x93, 0xf9, 0x4c, 0x76, 0x0a, 0x4d, 0xe5, 0x33, 0xd9, 0x1c, 0xca, 0x95, 0x8e, 0xaf, 0x6f,
-	0x89, 0x76, 0x73, 0x4b, 0xb4, 0xfb, 0x5b, 0xa2, 0x7f, 0xf1, 0x89, 0xfe, 0xcd, 0x27, 0xfa, 0x0f,
-	0x9f, 0xe8, 0xd7, 0x3e, 0xd1, 0x7f, 0xf9, 0x44, 0xff, 0xed, 0x13, 0xed, 0xde, 0x27, 0xfa, 0xd7,
-	0x3b, 0xa2, 0x5d, 0xdf, 0x11, 0xed, 0xe6, 0x8e, 0x68, 0xef, 0xb7, 0x4e, 0xbc, 0x42, 0xf8, 0xa4,
-	0x9e, 0x7a, 0x69, 0xcf, 0xef, 0xdb, 0xe0, 0xe3, 0xc3, 0x78, 0xf0, 0x00, 0xbf, 0xf9, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x28, 0xe0, 0x87, 0x8e, 0xab, 0x05, 0x00, 0x00,
+	// 671 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcb, 0x4e, 0xdb, 0x4e,
+	0x14, 0xc6, 0x6d, 0x08, 0xfc, 0xc3, 0xc0, 0xbf, 0x9d, 0x0e, 0x97, 0x70, 0x9d, 0x96, 0x70, 0x8f,
+	0xda, 0x44, 0xa8, 0xcb, 0xae, 0x26, 0x93, 0x09, 0x8c, 0x70, 0xec, 0x68, 0x66, 0x12, 0x9a, 0x2e,
+	0xb0, 0xd2, 0xca, 0x42, 0x88, 0x52, 0x47, 0x49, 0x40, 0x62, 0xd7, 0x47, 0xa8, 0xfa, 0x14, 0x7d,
+	0x94, 0x2e, 0x59, 0x22, 0x75, 0x53, 0xcc, 0xa6, 0x4b, 0x1e, 0xa1, 0x8a, 0x49, 0x7c, 0x21, 0x4e,
+	0x77, 0x96, 0xbe, 0x9f, 0xbf, 0x73, 0xe6, 0x3b, 0x73, 0x06, 0xec, 0x74, 0x9d, 0x8b, 0x96, 0xdb,
+	0x6e, 0x7e, 0x2e, 0x74, 0x9c, 0xf6, 0x95, 0xd3, 0x2e, 0x34, 0x5b, 0x67, 0x05, 0xe7, 0xcb, 0xe5,
+	0x45, 0xa7, 0x70, 0xb5, 0x5f, 0xe8, 0x36, 0x3b, 0xe7, 0xf9, 0x56, 0xdb, 0xed, 0xba, 0x68, 0x75,
+	0x00, 0xe6, 0x1f, 0xc1, 0x7c, 0xb3, 0x75, 0x96, 0xf7, 0xc1, 0xfc, 0xd5, 0x7e, 0xee, 0x04, 0x00,
+	0xd5, 0xec, 0x9c, 0x4b, 0xf7, 0xb2, 0xfd, 0xc9, 0x41, 0x2b, 0x20, 0xa3, 0x88, 0x3c, 0xb2, 0xa5,
+	0x55, 0x13, 0x94, 0xd9, 0x35, 0x53, 0x56, 0x19, 0xe5, 0x65, 0xce, 0x4a, 0x50, 0x43, 0x19, 0x30,
+	0x1b, 0x15, 0x0f, 0xb9, 0x54, 0x96, 0x68, 0x40, 0x1d, 0x2d, 0x83, 0x85, 0xa8, 0x50, 0x2a, 0xda,
+	0x45, 0x42, 0x8f, 0x0c, 0xeb, 0x00, 0x8e, 0xe5, 0x7e, 0xe9, 0x60, 0xa6, 0x57, 0x80, 0x36, 0xbb,
+	0xce, 0xa9, 0xdb, 0xbe, 0x46, 0x6b, 0x60, 0xc9, 0x87, 0x29, 0x51, 0xec, 0xc0, 0x12, 0x8d, 0x27,
+	0x45, 0x06, 0x5e, 0x81, 0xac, 0x04, 0x31, 0x65, 0x99, 0x09, 0xa8, 0x07, 0x0d, 0x84, 0x1a, 0xaf,
+	0x30, 0x01, 0xc7, 0x86, 0x3d, 0x05, 0xab, 0x1a, 0x9c, 0x12, 0xc5, 0x2d, 0x13, 0x8e, 0xa3, 0x55,
+	0xb0, 0x18, 0x97, 0xeb, 0x5c, 0xf2, 0x22, 0x37, 0xb8, 0x6a, 0xc0, 0xd4, 0x70, 0x45, 0x22, 0xe8,
+	0x21, 0xaf, 0x13, 0x03, 0x4e, 0x20, 0x0c, 0x96, 0xe3, 0x5a, 0x85, 0x55, 0xc2, 0xc2, 0x93, 0xb9,
+	0xef, 0x69, 0x90, 0xee, 0x9d, 0x4e, 0x5d, 0xb7, 0x1c, 0xb4, 0x04, 0xe6, 0x7d, 0x58, 0x35, 0xaa,
+	0x4f, 0xa3, 0x5b, 0x07, 0x6b, 0xa1, 0x14, 0x69, 0x2e, 0x12, 0xe2, 0x0e, 0xd8, 0x48, 0x46, 0x64,
+	0xc3, 0xa4, 0x36, 0xa1, 0x8a, 0xd7, 0x7b, 0xfd, 0x8e, 0xa1, 0x4d, 0xf0, 0x2a, 0x04, 0x07, 0xe9,
+	0xd8, 0xc7, 0x96, 0x38, 0x2a, 0x1b, 0xd6, 0xb1, 0xdd, 0xd3, 0xe0, 0xf8, 0x08, 0x6a, 0x60, 0xf3,
+	0x48, 0xa5, 0xd0, 0x36, 0xc8, 0x26, 0x50, 0xd4, 0xb0, 0x24, 0xb3, 0xd9, 0x7b, 0x46, 0x6b, 0x7e,
+	0x82, 0x13, 0xf1, 0xe6, 0x42, 0x8e, 0x98, 0x94, 0x19, 0x11, 0x70, 0x12, 0xbd, 0x06, 0xbb, 0x09,
+	0xa0, 0x54, 0x44, 0x28, 0x9b, 0x1e, 0x72, 0xa3, 0x14, 0xa1, 0xff, 0x1b, 0x61, 0x2b, 0xf9, 0x81,
+	0x49, 0xa2, 0xb6, 0x69, 0xb4, 0x05, 0xd6, 0x13, 0x40, 0xc1, 0x24, 0x53, 0xc1, 0xc9, 0x21, 0x40,
+	0x1b, 0xe0, 0x65, 0x88, 0xc5, 0x12, 0xf1, 0x27, 0x66, 0xd5, 0x14, 0x9c, 0x09, 0x66, 0xea, 0x43,
+	0x61, 0x20, 0x7d, 0xfd, 0x7f, 0xb4, 0x08, 0xe6, 0x22, 0x63, 0x94, 0x4c, 0xf4, 0xa7, 0xfd, 0x0c,
+	0x65, 0x01, 0x4e, 0xb0, 0x17, 0x35, 0x33, 0xf8, 0xfb, 0x79, 0x9c, 0x29, 0x31, 0x83, 0xa9, 0x60,
+	0x53, 0x6c, 0x56, 0x67, 0xa6, 0x82, 0x30, 0xce, 0x04, 0x1d, 0x08, 0xa6, 0x82, 0x9b, 0xf5, 0x22,
+	0x3e, 0xbf, 0xa0, 0x56, 0x6f, 0xaf, 0xac, 0x72, 0xb9, 0x4f, 0x21, 0xb4, 0x0b, 0x36, 0x43, 0x2a,
+	0xbc, 0xd5, 0xfd, 0xc0, 0xc3, 0x04, 0x67, 0xd1, 0x1e, 0xd8, 0x4a, 0x24, 0x6b, 0x55, 0xc9, 0x62,
+	0xe8, 0xdc, 0x48, 0xd3, 0xa7, 0xd7, 0x62, 0x7e, 0xa4, 0x69, 0xff, 0xdc, 0x21, 0xba, 0x30, 0x62,
+	0xd4, 0x43, 0xe0, 0x22, 0x7a, 0x03, 0xf6, 0xfe, 0xb1, 0x07, 0x41, 0x12, 0x52, 0x11, 0xc5, 0xe0,
+	0x52, 0xbc, 0xd9, 0xc1, 0xe6, 0xf6, 0x3f, 0xa2, 0xc6, 0xcb, 0x28, 0x07, 0xb6, 0x93, 0xee, 0xb0,
+	0x55, 0xa9, 0x1a, 0xcc, 0x2f, 0x40, 0x89, 0x61, 0xf4, 0x02, 0x86, 0x2b, 0xf1, 0x83, 0x25, 0x20,
+	0x83, 0x41, 0xc0, 0xd5, 0x6c, 0x2a, 0x3d, 0x05, 0xa7, 0xb2, 0xa9, 0xf4, 0x34, 0x9c, 0xce, 0xa6,
+	0xd2, 0x19, 0x98, 0x29, 0x9e, 0xdc, 0xdc, 0x61, 0xed, 0xf6, 0x0e, 0x6b, 0x0f, 0x77, 0x58, 0xff,
+	0xea, 0x61, 0xfd, 0x87, 0x87, 0xf5, 0x9f, 0x1e, 0xd6, 0x6f, 0x3c, 0xac, 0xff, 0xf6, 0xb0, 0xfe,
+	0xc7, 0xc3, 0xda, 0x83, 0x87, 0xf5, 0x6f, 0xf7, 0x58, 0xbb, 0xb9, 0xc7, 0xda, 0xed, 0x3d, 0xd6,
+	0x3e, 0xec, 0x9e, 0xba, 0xf9, 0xe0, 0xa5, 0x3e, 0x73, 0x93, 0x5e, 0xf5, 0x77, 0xfe, 0xc7, 0xc7,
+	0x49, 0xff, 0x5d, 0x7f, 0xfb, 0x77, 0x00, 0x75, 0x9c, 0x69, 0xd3, 0x02, 0x06, 0x00, 0x00,
 }
 
 func (x TaskSource) String() string {
@@ -109,9 +109,76 @@ func (WorkflowBackoffType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_004b7fefe981a755, []int{1}
 }
 
+type CompletionCallbackState int32
+
+const (
+	COMPLETION_CALLBACK_STATE_UNSPECIFIED CompletionCallbackState = 0
+	// Workflow execution is not closed yet or the callback is not attempted yet.
+	COMPLETION_CALLBACK_STATE_STANDBY CompletionCallbackState = 1
+	// Last attempt failed with a retryable error, the callback is retried with backoff.
+	COMPLETION_CALLBACK_STATE_BACKING_OFF CompletionCallbackState = 2
+	// Callback failed with a non retryable error or exhausted its attempts.
+	COMPLETION_CALLBACK_STATE_FAILED    CompletionCallbackState = 3
+	COMPLETION_CALLBACK_STATE_SUCCEEDED CompletionCallbackState = 4
+)
+
+var CompletionCallbackState_name = map[int32]string{
+	0: "Unspecified",
+	1: "Standby",
+	2: "BackingOff",
+	3: "Failed",
+	4: "Succeeded",
+}
+
+var CompletionCallbackState_value = map[string]int32{
+	"Unspecified": 0,
+	"Standby":     1,
+	"BackingOff":  2,
+	"Failed":      3,
+	"Succeeded":   4,
+}
+
+func (CompletionCallbackState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_004b7fefe981a755, []int{2}
+}
+
+// Defines what to do when starting a workflow execution while a workflow with the same ID is running.
+type WorkflowIdConflictPolicy int32
+
+const (
+	// Falls back to FAIL, or TERMINATE_EXISTING if the workflow ID reuse policy is TERMINATE_IF_RUNNING.
+	WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED WorkflowIdConflictPolicy = 0
+	// Fail the start request with WorkflowExecutionAlreadyStarted.
+	WORKFLOW_ID_CONFLICT_POLICY_FAIL WorkflowIdConflictPolicy = 1
+	// Do not start a new run and return the run ID of the running workflow.
+	WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING WorkflowIdConflictPolicy = 2
+	// Terminate the running workflow and start a new run.
+	WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING WorkflowIdConflictPolicy = 3
+)
+
+var WorkflowIdConflictPolicy_name = map[int32]string{
+	0: "Unspecified",
+	1: "Fail",
+	2: "UseExisting",
+	3: "TerminateExisting",
+}
+
+var WorkflowIdConflictPolicy_value = map[string]int32{
+	"Unspecified":       0,
+	"Fail":              1,
+	"UseExisting":       2,
+	"TerminateExisting": 3,
+}
+
+func (WorkflowIdConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_004b7fefe981a755, []int{3}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowExecutionState", WorkflowExecutionState_name, WorkflowExecutionState_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowBackoffType", WorkflowBackoffType_name, WorkflowBackoffType_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.CompletionCallbackState", CompletionCallbackState_name, CompletionCallbackState_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.WorkflowIdConflictPolicy", WorkflowIdConflictPolicy_name, WorkflowIdConflictPolicy_value)
 }
 
 func init() {
@@ -119,31 +186,41 @@ func init() {
 }
 
 var fileDescriptor_004b7fefe981a755 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd2, 0x3d, 0xef, 0xd2, 0x40,
-	0x1c, 0x07, 0xf0, 0x1e, 0x28, 0xc3, 0x4d, 0x97, 0x33, 0x71, 0xf0, 0xe1, 0x10, 0x45, 0x43, 0x30,
-	0x69, 0x43, 0x1c, 0x9d, 0xfa, 0x70, 0x35, 0x0d, 0xd0, 0x6b, 0x8e, 0xab, 0x08, 0x83, 0x4d, 0x25,
-	0xc5, 0x34, 0x3c, 0x5c, 0x53, 0x0a, 0xe8, 0xe6, 0x4b, 0xf0, 0x45, 0x18, 0xe3, 0x4b, 0x71, 0x64,
-	0x64, 0x94, 0xb2, 0x38, 0xf2, 0x12, 0x0c, 0x28, 0x0c, 0x86, 0xfe, 0xff, 0xdb, 0x0d, 0x9f, 0xdf,
-	0xef, 0x7b, 0xf9, 0xe5, 0x0b, 0x5f, 0x66, 0xd1, 0x2c, 0x91, 0x69, 0x38, 0xd5, 0x16, 0x51, 0xba,
-	0x8a, 0x52, 0x2d, 0x4c, 0x62, 0x2d, 0x9a, 0x2f, 0x67, 0x0b, 0x6d, 0xd5, 0xd2, 0xd6, 0x32, 0x9d,
-	0x8c, 0xa7, 0x72, 0xad, 0x26, 0xa9, 0xcc, 0x24, 0x7e, 0x74, 0xc6, 0xea, 0x5f, 0xac, 0x86, 0x49,
-	0xac, 0x9e, 0xb0, 0xba, 0x6a, 0x35, 0xbf, 0x97, 0xe0, 0xfd, 0xfe, 0xbf, 0x01, 0xfa, 0x29, 0x1a,
-	0x2d, 0xb3, 0x58, 0xce, 0x7b, 0x59, 0x98, 0x45, 0xb8, 0x01, 0xeb, 0x7d, 0xc6, 0xdb, 0x76, 0x87,
-	0xf5, 0x03, 0xfa, 0x8e, 0x9a, 0xbe, 0x70, 0x98, 0x1b, 0xf4, 0x84, 0x2e, 0x68, 0xe0, 0xbb, 0x3d,
-	0x8f, 0x9a, 0x8e, 0xed, 0x50, 0x0b, 0x29, 0xb8, 0x0e, 0x9f, 0x14, 0x4a, 0x93, 0x53, 0x5d, 0x50,
-	0x0b, 0x81, 0x1b, 0x15, 0xf7, 0x5d, 0xd7, 0x71, 0xdf, 0xa0, 0x12, 0x7e, 0x01, 0x9f, 0x16, 0xef,
-	0x62, 0x5d, 0xaf, 0x43, 0x8f, 0xdb, 0xca, 0xf8, 0x19, 0xac, 0x16, 0xba, 0x21, 0xeb, 0x1a, 0x0e,
-	0x45, 0x77, 0x70, 0x0d, 0x3e, 0x2e, 0x44, 0x6f, 0x99, 0x63, 0xa1, 0xbb, 0xb7, 0xe4, 0x71, 0xee,
-	0x7b, 0xc7, 0xbc, 0x4a, 0xf3, 0x1b, 0x80, 0xf7, 0xce, 0x87, 0x32, 0xc2, 0xd1, 0x44, 0x8e, 0xc7,
-	0xe2, 0x73, 0x12, 0xe1, 0xe7, 0xb0, 0x76, 0x99, 0x37, 0x74, 0xb3, 0xcd, 0x6c, 0x3b, 0x10, 0x03,
-	0xef, 0xff, 0x13, 0x55, 0xe1, 0xc3, 0xeb, 0x8c, 0x53, 0xc1, 0x07, 0x08, 0x60, 0x02, 0x1f, 0x5c,
-	0x07, 0x26, 0x67, 0x2e, 0x2a, 0x15, 0xe7, 0x58, 0xb4, 0xa3, 0x0f, 0x8e, 0x1f, 0xe6, 0x02, 0x95,
-	0x8d, 0xf7, 0x9b, 0x1d, 0x51, 0xb6, 0x3b, 0xa2, 0x1c, 0x76, 0x04, 0x7c, 0xc9, 0x09, 0xf8, 0x91,
-	0x13, 0xf0, 0x33, 0x27, 0x60, 0x93, 0x13, 0xf0, 0x2b, 0x27, 0xe0, 0x77, 0x4e, 0x94, 0x43, 0x4e,
-	0xc0, 0xd7, 0x3d, 0x51, 0x36, 0x7b, 0xa2, 0x6c, 0xf7, 0x44, 0x19, 0x36, 0x3e, 0x4a, 0xf5, 0x52,
-	0x93, 0x58, 0x5e, 0xab, 0xd5, 0xeb, 0xd3, 0xe3, 0x43, 0xe5, 0x54, 0xaa, 0x57, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0x16, 0x44, 0x81, 0x83, 0x02, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0x49, 0xe9, 0x70, 0x93, 0x65, 0x24, 0x40, 0xfc, 0xb9, 0x12, 0xda, 0xd0, 0x90,
+	0x22, 0x47, 0x85, 0x91, 0xc9, 0x39, 0x9f, 0xab, 0x53, 0x1d, 0x9f, 0x65, 0x5f, 0x48, 0xd3, 0x01,
+	0xcb, 0x0d, 0x0e, 0xb2, 0xe2, 0xe4, 0xac, 0xd4, 0x4d, 0xe9, 0xc6, 0x47, 0xe0, 0x43, 0x20, 0xc4,
+	0x47, 0x61, 0x23, 0x63, 0x47, 0xe2, 0x08, 0x89, 0xb1, 0x1f, 0x01, 0x39, 0x69, 0x22, 0x84, 0x62,
+	0xb3, 0xdd, 0xf0, 0x7b, 0x9f, 0xe7, 0xf5, 0xf3, 0x9c, 0x0f, 0x1e, 0x24, 0xc1, 0x30, 0x16, 0x63,
+	0x3f, 0x6a, 0x9c, 0x07, 0xe3, 0x49, 0x30, 0x6e, 0xf8, 0x71, 0xd8, 0x08, 0x46, 0x17, 0xc3, 0xf3,
+	0xc6, 0xe4, 0xb0, 0x71, 0x29, 0xc6, 0x83, 0x7e, 0x24, 0x2e, 0xd5, 0x78, 0x2c, 0x12, 0xa1, 0x3c,
+	0x5e, 0xc1, 0xea, 0x12, 0x56, 0xfd, 0x38, 0x54, 0x17, 0xb0, 0x3a, 0x39, 0xac, 0x7f, 0x2d, 0xc1,
+	0x7b, 0x9d, 0xdb, 0x01, 0xf2, 0x31, 0xe8, 0x5d, 0x24, 0xa1, 0x18, 0xb9, 0x89, 0x9f, 0x04, 0x4a,
+	0x0d, 0xee, 0x75, 0x98, 0x73, 0x6c, 0x98, 0xac, 0xe3, 0x91, 0x13, 0x82, 0xdb, 0x9c, 0x32, 0xcb,
+	0x73, 0xb9, 0xc6, 0x89, 0xd7, 0xb6, 0x5c, 0x9b, 0x60, 0x6a, 0x50, 0xa2, 0xcb, 0x92, 0xb2, 0x07,
+	0x9f, 0xe6, 0x92, 0xd8, 0x21, 0x1a, 0x27, 0xba, 0x0c, 0x0a, 0x29, 0xa7, 0x6d, 0x59, 0xd4, 0x3a,
+	0x92, 0x4b, 0xca, 0x73, 0xf8, 0x2c, 0x5f, 0x8b, 0xb5, 0x6c, 0x93, 0x64, 0x6a, 0x65, 0x65, 0x17,
+	0xee, 0xe4, 0x72, 0xa7, 0xac, 0xd5, 0xa4, 0x44, 0xde, 0x52, 0x2a, 0xf0, 0x49, 0x2e, 0xf4, 0x96,
+	0x51, 0x5d, 0xbe, 0xf3, 0x1f, 0x3f, 0xc7, 0x69, 0xdb, 0x99, 0xdf, 0x76, 0xfd, 0x0b, 0x80, 0x77,
+	0x57, 0x41, 0x35, 0xfd, 0xde, 0x40, 0xf4, 0xfb, 0xfc, 0x2a, 0x0e, 0x94, 0x2a, 0xac, 0xac, 0xe7,
+	0x9b, 0x1a, 0x3e, 0x66, 0x86, 0xe1, 0xf1, 0xae, 0xfd, 0x6f, 0x44, 0x3b, 0xf0, 0xd1, 0x66, 0xcc,
+	0x21, 0xdc, 0xe9, 0xca, 0x40, 0x41, 0xf0, 0xe1, 0x66, 0x00, 0x3b, 0xcc, 0x92, 0x4b, 0xf9, 0x3e,
+	0x3a, 0x31, 0xb5, 0x6e, 0xb6, 0xb0, 0xc3, 0xe5, 0x72, 0xfd, 0x17, 0x80, 0xf7, 0xb1, 0x18, 0xc6,
+	0x51, 0x90, 0x15, 0x89, 0xfd, 0x28, 0x3a, 0xf3, 0x7b, 0x83, 0x65, 0xa1, 0x2f, 0x60, 0xf5, 0x36,
+	0xc1, 0xec, 0x13, 0xb1, 0x66, 0x9a, 0x99, 0xd0, 0xc6, 0x46, 0xab, 0xb0, 0x92, 0x8f, 0xba, 0x5c,
+	0xb3, 0xf4, 0x66, 0xb6, 0x74, 0xa1, 0x62, 0x76, 0xa4, 0xd6, 0x91, 0xc7, 0x0c, 0x43, 0x2e, 0x65,
+	0xed, 0xe7, 0xa3, 0x86, 0x46, 0xcd, 0x45, 0xab, 0xfb, 0x70, 0xb7, 0xc0, 0xb7, 0x8d, 0x31, 0x21,
+	0x3a, 0xd1, 0xe5, 0xad, 0xfa, 0x0f, 0x00, 0x1f, 0xac, 0xea, 0xa0, 0xef, 0xb1, 0x18, 0xf5, 0xa3,
+	0xb0, 0x97, 0xd8, 0x22, 0x0a, 0x7b, 0x57, 0xca, 0x01, 0xdc, 0x5f, 0x67, 0x45, 0x75, 0x0f, 0x33,
+	0xcb, 0x30, 0x29, 0xe6, 0x9e, 0xcd, 0x4c, 0x8a, 0xbb, 0x05, 0x97, 0x77, 0x03, 0x9c, 0xad, 0x26,
+	0x03, 0xe5, 0x25, 0xac, 0x15, 0x4a, 0xba, 0xc4, 0x23, 0x27, 0xd4, 0xe5, 0xcb, 0x4b, 0xfc, 0x0a,
+	0xaa, 0x45, 0x34, 0x27, 0x4e, 0x8b, 0x5a, 0x1a, 0xff, 0x6b, 0xa6, 0xdc, 0x7c, 0x37, 0x9d, 0x21,
+	0xe9, 0x7a, 0x86, 0xa4, 0x9b, 0x19, 0x02, 0x9f, 0x52, 0x04, 0xbe, 0xa5, 0x08, 0x7c, 0x4f, 0x11,
+	0x98, 0xa6, 0x08, 0xfc, 0x4c, 0x11, 0xf8, 0x9d, 0x22, 0xe9, 0x26, 0x45, 0xe0, 0xf3, 0x1c, 0x49,
+	0xd3, 0x39, 0x92, 0xae, 0xe7, 0x48, 0x3a, 0xad, 0x7d, 0x10, 0xea, 0xfa, 0x07, 0x0f, 0xc5, 0xa6,
+	0x07, 0xe1, 0xcd, 0xe2, 0x70, 0xb6, 0xbd, 0x78, 0x0e, 0x5e, 0xff, 0x19, 0x00, 0x36, 0xd5, 0xc4,
+	0x91, 0x3d, 0x04, 0x00, 0x00,
 }
 
 func (x WorkflowExecutionState) String() string {
@@ -160,3 +237,17 @@ func (x WorkflowBackoffType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x CompletionCallbackState) String() string {
+	s, ok := CompletionCallbackState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x WorkflowIdConflictPolicy) String() string {
+	s, ok := WorkflowIdConflictPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
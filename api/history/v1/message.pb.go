@@ -41,7 +41,9 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v12 "go.temporal.io/api/common/v1"
 	v1 "go.temporal.io/api/history/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -401,6 +403,228 @@ func (m *HistoryEventPointer) GetEventId() int64 {
 	return 0
 }
 
+// HistoryDLQTaskInfo describes a history task in the history task DLQ.
+type HistoryDLQTaskInfo struct {
+	MessageId      int64        `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ShardId        int32        `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	CategoryId     int32        `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TaskType       v11.TaskType `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	NamespaceId    string       `protobuf:"bytes,5,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId     string       `protobuf:"bytes,6,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId          string       `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	TaskId         int64        `protobuf:"varint,8,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	VisibilityTime *time.Time   `protobuf:"bytes,9,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
+	Attempt        int32        `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError      string       `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	EnqueueTime    *time.Time   `protobuf:"bytes,12,opt,name=enqueue_time,json=enqueueTime,proto3,stdtime" json:"enqueue_time,omitempty"`
+}
+
+func (m *HistoryDLQTaskInfo) Reset()      { *m = HistoryDLQTaskInfo{} }
+func (*HistoryDLQTaskInfo) ProtoMessage() {}
+func (*HistoryDLQTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{7}
+}
+func (m *HistoryDLQTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryDLQTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryDLQTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryDLQTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryDLQTaskInfo.Merge(m, src)
+}
+func (m *HistoryDLQTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryDLQTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryDLQTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryDLQTaskInfo proto.InternalMessageInfo
+
+func (m *HistoryDLQTaskInfo) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetTaskType() v11.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v11.TASK_TYPE_UNSPECIFIED
+}
+
+func (m *HistoryDLQTaskInfo) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *HistoryDLQTaskInfo) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *HistoryDLQTaskInfo) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *HistoryDLQTaskInfo) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetVisibilityTime() *time.Time {
+	if m != nil {
+		return m.VisibilityTime
+	}
+	return nil
+}
+
+func (m *HistoryDLQTaskInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryDLQTaskInfo) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryDLQTaskInfo) GetEnqueueTime() *time.Time {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return nil
+}
+
+// ActivityOptions are the options of a pending activity which can be updated after the activity is scheduled.
+type ActivityOptions struct {
+	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToCloseTimeout *time.Duration `protobuf:"bytes,2,opt,name=schedule_to_close_timeout,json=scheduleToCloseTimeout,proto3,stdduration" json:"schedule_to_close_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	ScheduleToStartTimeout *time.Duration `protobuf:"bytes,3,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3,stdduration" json:"schedule_to_start_timeout,omitempty"`
+	// (-- api-linter: core::0140::prepositions=disabled
+	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
+	StartToCloseTimeout *time.Duration   `protobuf:"bytes,4,opt,name=start_to_close_timeout,json=startToCloseTimeout,proto3,stdduration" json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout    *time.Duration   `protobuf:"bytes,5,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3,stdduration" json:"heartbeat_timeout,omitempty"`
+	RetryPolicy         *v12.RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+}
+
+func (m *ActivityOptions) Reset()      { *m = ActivityOptions{} }
+func (*ActivityOptions) ProtoMessage() {}
+func (*ActivityOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{8}
+}
+func (m *ActivityOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivityOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivityOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivityOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivityOptions.Merge(m, src)
+}
+func (m *ActivityOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivityOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivityOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivityOptions proto.InternalMessageInfo
+
+func (m *ActivityOptions) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *ActivityOptions) GetScheduleToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetScheduleToStartTimeout() *time.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetStartToCloseTimeout() *time.Duration {
+	if m != nil {
+		return m.StartToCloseTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetHeartbeatTimeout() *time.Duration {
+	if m != nil {
+		return m.HeartbeatTimeout
+	}
+	return nil
+}
+
+func (m *ActivityOptions) GetRetryPolicy() *v12.RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
 	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
@@ -409,6 +633,8 @@ func init() {
 	proto.RegisterType((*TaskKey)(nil), "temporal.server.api.history.v1.TaskKey")
 	proto.RegisterType((*TaskRange)(nil), "temporal.server.api.history.v1.TaskRange")
 	proto.RegisterType((*HistoryEventPointer)(nil), "temporal.server.api.history.v1.HistoryEventPointer")
+	proto.RegisterType((*HistoryDLQTaskInfo)(nil), "temporal.server.api.history.v1.HistoryDLQTaskInfo")
+	proto.RegisterType((*ActivityOptions)(nil), "temporal.server.api.history.v1.ActivityOptions")
 }
 
 func init() {
@@ -416,44 +642,69 @@ func init() {
 }
 
 var fileDescriptor_670cd05c700ece14 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4b, 0x1b, 0x41,
-	0x14, 0xc7, 0x33, 0x89, 0x9a, 0x38, 0xb1, 0x22, 0x2b, 0xd8, 0x18, 0xe8, 0xa8, 0x0b, 0x52, 0x0f,
-	0x32, 0x5b, 0xd3, 0x63, 0xe9, 0xa1, 0x42, 0xc1, 0x58, 0x85, 0xb2, 0x0d, 0x2d, 0x14, 0x61, 0x99,
-	0x24, 0x2f, 0xeb, 0x90, 0xec, 0x4c, 0x98, 0xd9, 0xa4, 0xf1, 0x50, 0xe8, 0x47, 0xf0, 0x3b, 0xf4,
-	0xd2, 0x6f, 0xd2, 0x1e, 0x3d, 0x7a, 0x6b, 0x5d, 0x2f, 0x3d, 0xfa, 0x11, 0xca, 0xce, 0xce, 0x26,
-	0xb5, 0x2d, 0x16, 0x6f, 0x33, 0x6f, 0xfe, 0xef, 0x37, 0xff, 0x37, 0xef, 0x31, 0x78, 0x37, 0x86,
-	0x68, 0x28, 0x15, 0x1b, 0x78, 0x1a, 0xd4, 0x18, 0x94, 0xc7, 0x86, 0xdc, 0x3b, 0xe5, 0x3a, 0x96,
-	0xea, 0xcc, 0x1b, 0xef, 0x79, 0x11, 0x68, 0xcd, 0x42, 0xa0, 0x43, 0x25, 0x63, 0xe9, 0x90, 0x5c,
-	0x4d, 0x33, 0x35, 0x65, 0x43, 0x4e, 0xad, 0x9a, 0x8e, 0xf7, 0xea, 0x1b, 0xa1, 0x94, 0xe1, 0x00,
-	0x3c, 0xa3, 0x6e, 0x8f, 0x7a, 0x5e, 0xcc, 0x23, 0xd0, 0x31, 0x8b, 0x86, 0x19, 0xa0, 0xbe, 0xd5,
-	0x85, 0x21, 0x88, 0x2e, 0x88, 0x0e, 0x07, 0xed, 0x85, 0x32, 0x94, 0x26, 0x6e, 0x56, 0x56, 0xb2,
-	0x3d, 0x75, 0x74, 0x97, 0x15, 0x77, 0x84, 0xd7, 0x5b, 0x8a, 0x09, 0xcd, 0x41, 0xc4, 0xef, 0xa4,
-	0xea, 0xf7, 0x06, 0xf2, 0x43, 0x8b, 0xe9, 0x7e, 0x53, 0xf4, 0xa4, 0x73, 0x84, 0x97, 0x6d, 0x62,
-	0xa0, 0x47, 0xbd, 0x1e, 0x9f, 0xd4, 0x4a, 0x9b, 0xa5, 0x9d, 0x6a, 0x63, 0x9b, 0x4e, 0x0b, 0xb8,
-	0xed, 0x9c, 0x1e, 0x64, 0xcb, 0x97, 0x63, 0x10, 0xb1, 0xff, 0xc0, 0x1e, 0xbc, 0x31, 0xb9, 0x87,
-	0x73, 0x15, 0xb4, 0x52, 0x3c, 0x9c, 0xab, 0x14, 0x57, 0x4a, 0x6e, 0x13, 0x3b, 0x6f, 0x41, 0x69,
-	0x2e, 0x85, 0xcd, 0x68, 0xc6, 0x10, 0x39, 0xeb, 0xb8, 0x02, 0x69, 0x66, 0xc0, 0xbb, 0x35, 0xb4,
-	0x89, 0x76, 0x4a, 0x7e, 0xd9, 0xec, 0x9b, 0x5d, 0xa7, 0x86, 0xcb, 0xe3, 0x2c, 0xa1, 0x56, 0xcc,
-	0x4e, 0xec, 0xd6, 0xfd, 0x88, 0x97, 0x6f, 0xa3, 0x9c, 0x2d, 0xbc, 0xd4, 0x56, 0x4c, 0x74, 0x4e,
-	0x83, 0x58, 0xf6, 0x41, 0x18, 0xd4, 0x92, 0x5f, 0xcd, 0x62, 0xad, 0x34, 0xe4, 0x1c, 0xe0, 0x79,
-	0x1e, 0x43, 0xa4, 0x6b, 0x45, 0x53, 0x50, 0x83, 0xde, 0xdd, 0x11, 0xfa, 0xb7, 0x59, 0x3f, 0x03,
-	0xb8, 0x9f, 0x11, 0x5e, 0xb9, 0x75, 0xca, 0x41, 0x3b, 0x2f, 0xf0, 0xa3, 0xce, 0x48, 0xa9, 0xb4,
-	0x14, 0x6b, 0x33, 0xc8, 0x1f, 0x92, 0x8b, 0x2e, 0x4c, 0x8c, 0xa5, 0x79, 0xbf, 0x6e, 0x45, 0x7f,
-	0xd0, 0x53, 0x85, 0x73, 0x84, 0x17, 0x4f, 0x73, 0x9e, 0x75, 0x49, 0xef, 0xe7, 0xd2, 0x9f, 0x01,
-	0x5c, 0x86, 0xcb, 0x69, 0x57, 0x5f, 0xc1, 0x99, 0xf3, 0x10, 0x97, 0x63, 0xa6, 0xfb, 0xb3, 0x37,
-	0x5e, 0x48, 0xb7, 0xcd, 0xae, 0xf3, 0x1c, 0x2f, 0xf6, 0xb8, 0x82, 0x20, 0x1d, 0x36, 0xf3, 0xc8,
-	0xd5, 0x46, 0x9d, 0x66, 0x93, 0x48, 0xf3, 0x49, 0xa4, 0xad, 0x7c, 0x12, 0xf7, 0xe7, 0xce, 0xbf,
-	0x6f, 0x20, 0xbf, 0x92, 0xa6, 0xa4, 0x41, 0xf7, 0x2b, 0xc2, 0x8b, 0xe9, 0x1d, 0x3e, 0x13, 0x21,
-	0x38, 0x27, 0x78, 0x8d, 0x8b, 0xce, 0x60, 0xa4, 0xf9, 0x18, 0x82, 0x88, 0x8b, 0xc0, 0xdc, 0xd9,
-	0x87, 0x33, 0x73, 0x69, 0xb5, 0xf1, 0xf8, 0x7f, 0xb5, 0x58, 0xbb, 0xfe, 0xea, 0x14, 0x73, 0xcc,
-	0x45, 0x5e, 0xc3, 0x09, 0x5e, 0x83, 0xc9, 0x94, 0xce, 0x26, 0x33, 0x7a, 0xf1, 0x9e, 0xf4, 0x29,
-	0xe6, 0x98, 0x4d, 0x6c, 0xd0, 0x7d, 0x82, 0x57, 0x7f, 0x9f, 0xe3, 0xd7, 0x92, 0x8b, 0x18, 0xd4,
-	0x1d, 0xd3, 0xb9, 0xdf, 0xbe, 0xb8, 0x22, 0x85, 0xcb, 0x2b, 0x52, 0xb8, 0xb9, 0x22, 0xe8, 0x53,
-	0x42, 0xd0, 0x97, 0x84, 0xa0, 0x6f, 0x09, 0x41, 0x17, 0x09, 0x41, 0x3f, 0x12, 0x82, 0x7e, 0x26,
-	0xa4, 0x70, 0x93, 0x10, 0x74, 0x7e, 0x4d, 0x0a, 0x17, 0xd7, 0xa4, 0x70, 0x79, 0x4d, 0x0a, 0xef,
-	0x77, 0x43, 0x39, 0xf3, 0xc9, 0xe5, 0xbf, 0xbf, 0x8e, 0x67, 0x76, 0xd9, 0x5e, 0x30, 0x3d, 0x78,
-	0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x9b, 0x7d, 0x47, 0x6b, 0x04, 0x00, 0x00,
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x18, 0xb5, 0x6c, 0x27, 0xb6, 0xa9, 0x2c, 0xcd, 0x14, 0x2c, 0x73, 0x0c, 0x54, 0x49, 0xbd, 0x75,
+	0xcd, 0xa1, 0x90, 0x57, 0xef, 0x38, 0xec, 0xd0, 0xa4, 0x1d, 0xaa, 0x2e, 0xc5, 0x5a, 0xd5, 0xd8,
+	0x80, 0xa2, 0x80, 0x40, 0x4b, 0x9f, 0x6d, 0x22, 0x16, 0xa9, 0x91, 0x94, 0x1b, 0x1f, 0x06, 0xec,
+	0x27, 0xf4, 0xb8, 0xfb, 0x2e, 0xfb, 0x27, 0xdb, 0x31, 0x87, 0x1d, 0x7a, 0xdb, 0xe2, 0x5c, 0x76,
+	0xec, 0xfe, 0xc1, 0x40, 0x8a, 0xb2, 0xeb, 0x24, 0xeb, 0x92, 0x9b, 0xf8, 0x7d, 0xef, 0x3d, 0xbe,
+	0x8f, 0x7a, 0xb4, 0x8c, 0xee, 0x4a, 0x48, 0x52, 0xc6, 0xf1, 0xb8, 0x23, 0x80, 0x4f, 0x80, 0x77,
+	0x70, 0x4a, 0x3a, 0x23, 0x22, 0x24, 0xe3, 0xd3, 0xce, 0xe4, 0x5e, 0x27, 0x01, 0x21, 0xf0, 0x10,
+	0xbc, 0x94, 0x33, 0xc9, 0x1c, 0xb7, 0x40, 0x7b, 0x39, 0xda, 0xc3, 0x29, 0xf1, 0x0c, 0xda, 0x9b,
+	0xdc, 0x6b, 0xb9, 0x43, 0xc6, 0x86, 0x63, 0xe8, 0x68, 0x74, 0x3f, 0x1b, 0x74, 0xe2, 0x8c, 0x63,
+	0x49, 0x18, 0xcd, 0xf9, 0xad, 0x9d, 0xf3, 0x7d, 0x49, 0x12, 0x10, 0x12, 0x27, 0xa9, 0x01, 0xdc,
+	0x8a, 0x21, 0x05, 0x1a, 0x03, 0x8d, 0x08, 0x88, 0xce, 0x90, 0x0d, 0x99, 0xae, 0xeb, 0x27, 0x03,
+	0xf9, 0x74, 0xee, 0x58, 0x59, 0x8d, 0x58, 0x92, 0x30, 0x7a, 0xc1, 0x69, 0xeb, 0xf6, 0x12, 0xea,
+	0xbf, 0x06, 0x6a, 0xdd, 0xb9, 0x6c, 0x7c, 0xa0, 0x59, 0x22, 0x14, 0x56, 0x62, 0x71, 0x94, 0x03,
+	0xdb, 0x19, 0xda, 0xee, 0x71, 0x4c, 0x05, 0x01, 0x2a, 0xbf, 0x67, 0xfc, 0x68, 0x30, 0x66, 0xaf,
+	0x7a, 0x58, 0x1c, 0xf9, 0x74, 0xc0, 0x9c, 0x43, 0xb4, 0x6e, 0x76, 0x08, 0x45, 0x36, 0x18, 0x90,
+	0xe3, 0x66, 0x65, 0xb7, 0xb2, 0x67, 0x77, 0x6f, 0x7b, 0xf3, 0xf3, 0x5a, 0x3e, 0x28, 0xef, 0x51,
+	0xfe, 0xf8, 0x70, 0x02, 0x54, 0x06, 0x1f, 0x98, 0xc6, 0x73, 0xcd, 0x7d, 0x5c, 0xad, 0x5b, 0x1b,
+	0xe5, 0xc7, 0xd5, 0x7a, 0x79, 0xa3, 0xd2, 0xf6, 0x91, 0xf3, 0x1d, 0x70, 0x41, 0x18, 0x35, 0x0c,
+	0x5f, 0x42, 0xe2, 0x6c, 0xa3, 0x3a, 0x28, 0x66, 0x48, 0xe2, 0xa6, 0xb5, 0x6b, 0xed, 0x55, 0x82,
+	0x9a, 0x5e, 0xfb, 0xb1, 0xd3, 0x44, 0xb5, 0x49, 0x4e, 0x68, 0x96, 0xf3, 0x8e, 0x59, 0xb6, 0x7f,
+	0x44, 0xeb, 0xcb, 0x52, 0xce, 0x2d, 0xb4, 0xd6, 0xe7, 0x98, 0x46, 0xa3, 0x50, 0xb2, 0x23, 0xa0,
+	0x5a, 0x6a, 0x2d, 0xb0, 0xf3, 0x5a, 0x4f, 0x95, 0x9c, 0x47, 0x68, 0x85, 0x48, 0x48, 0x44, 0xb3,
+	0xac, 0x07, 0xea, 0x7a, 0xef, 0x0f, 0x80, 0x77, 0xd1, 0x6c, 0x90, 0x0b, 0xb4, 0x7f, 0xb1, 0xd0,
+	0xc6, 0x52, 0x97, 0x80, 0x70, 0xee, 0xa3, 0x9b, 0x51, 0xc6, 0xb9, 0x1a, 0xc5, 0xd8, 0x0c, 0x8b,
+	0x83, 0x24, 0x34, 0x86, 0x63, 0x6d, 0x69, 0x25, 0x68, 0x19, 0xd0, 0x39, 0x75, 0x85, 0x70, 0x0e,
+	0x51, 0x63, 0x54, 0xe8, 0x19, 0x97, 0xde, 0xf5, 0x5c, 0x06, 0x0b, 0x81, 0x36, 0x46, 0x35, 0xf5,
+	0x56, 0xbf, 0x81, 0xa9, 0xf3, 0x31, 0xaa, 0xa9, 0xf7, 0xbf, 0x38, 0xe3, 0x55, 0xb5, 0xf4, 0x63,
+	0xe7, 0x2b, 0xd4, 0x18, 0x10, 0x0e, 0xa1, 0xca, 0xae, 0x3e, 0x64, 0xbb, 0xdb, 0xf2, 0xf2, 0x60,
+	0x7b, 0x45, 0xb0, 0xbd, 0x5e, 0x11, 0xec, 0xfd, 0xea, 0xeb, 0x3f, 0x77, 0xac, 0xa0, 0xae, 0x28,
+	0xaa, 0xd8, 0xfe, 0xcd, 0x42, 0x0d, 0xb5, 0x47, 0x80, 0xe9, 0x10, 0x9c, 0x97, 0x68, 0x8b, 0xd0,
+	0x68, 0x9c, 0x09, 0x32, 0x81, 0x30, 0x21, 0x34, 0xd4, 0x7b, 0x1e, 0xc1, 0x54, 0x6f, 0x6a, 0x77,
+	0xef, 0xfc, 0xdf, 0x2c, 0xc6, 0x6e, 0xb0, 0x39, 0x97, 0x79, 0x42, 0x68, 0x31, 0xc3, 0x4b, 0xb4,
+	0x05, 0xc7, 0x73, 0x75, 0x7c, 0xbc, 0x50, 0x2f, 0x5f, 0x53, 0x7d, 0x2e, 0xf3, 0x04, 0x1f, 0x9b,
+	0x62, 0xfb, 0x73, 0xb4, 0xf9, 0x6e, 0x8e, 0x9f, 0x32, 0x42, 0x25, 0xf0, 0xf7, 0xa4, 0xb3, 0xfd,
+	0x4f, 0x05, 0x39, 0x86, 0xf2, 0xe0, 0xf0, 0xd9, 0xfc, 0xfe, 0xdc, 0x44, 0xc8, 0x5c, 0xcb, 0x05,
+	0xa7, 0x61, 0x2a, 0x7e, 0xac, 0x04, 0xc5, 0x08, 0xf3, 0x58, 0x35, 0xcb, 0x3a, 0x10, 0x35, 0xbd,
+	0xf6, 0x63, 0x67, 0x07, 0xd9, 0x11, 0x96, 0x30, 0xd4, 0x89, 0x89, 0x9b, 0x15, 0xdd, 0x45, 0x45,
+	0xc9, 0x8f, 0x9d, 0x03, 0xd4, 0xd0, 0x33, 0xcb, 0x69, 0x0a, 0xcd, 0xea, 0xae, 0xb5, 0xb7, 0xde,
+	0xfd, 0xec, 0xd2, 0xa1, 0xf5, 0xa5, 0x2f, 0x46, 0xee, 0x4d, 0x53, 0x08, 0xea, 0xd2, 0x3c, 0xa9,
+	0x8b, 0x42, 0x71, 0x02, 0x22, 0xc5, 0x91, 0x76, 0xb8, 0xb2, 0x6b, 0xed, 0x35, 0x02, 0x7b, 0x5e,
+	0xcb, 0x8d, 0xbc, 0x32, 0x3f, 0x0b, 0x0a, 0xb1, 0xaa, 0x11, 0xa8, 0x28, 0xf9, 0xb1, 0xf3, 0x11,
+	0x5a, 0xe5, 0x19, 0x55, 0xbd, 0x9a, 0xee, 0xad, 0xf0, 0x8c, 0xfa, 0xf1, 0xbb, 0x29, 0xab, 0x2f,
+	0xa5, 0xcc, 0x47, 0x37, 0x26, 0x44, 0x90, 0x3e, 0x19, 0x13, 0x39, 0xcd, 0xb3, 0xd6, 0xb8, 0x62,
+	0xd6, 0xd6, 0x17, 0x44, 0xd5, 0x52, 0xbf, 0x09, 0x58, 0xaa, 0x99, 0x65, 0x13, 0xe5, 0xc7, 0x67,
+	0x96, 0xea, 0xe0, 0xc7, 0x58, 0xc8, 0x10, 0x38, 0x67, 0xbc, 0x69, 0x6b, 0x63, 0x0d, 0x55, 0x79,
+	0xa8, 0x0a, 0xce, 0x01, 0x5a, 0x03, 0xfa, 0x43, 0x06, 0x99, 0x09, 0xfb, 0xda, 0x15, 0x0d, 0xd8,
+	0x86, 0xa5, 0xf3, 0xfe, 0x47, 0x05, 0xdd, 0xb8, 0x1f, 0x49, 0x32, 0x21, 0x72, 0xfa, 0x6d, 0xaa,
+	0x3e, 0x06, 0x42, 0xed, 0xab, 0xa7, 0xd6, 0x28, 0xfd, 0xc2, 0x1b, 0x81, 0x7e, 0x4f, 0xcf, 0x54,
+	0xc1, 0x79, 0x81, 0xb6, 0x45, 0x34, 0x82, 0x38, 0x1b, 0x43, 0x28, 0x59, 0x18, 0x8d, 0x99, 0xc8,
+	0x1d, 0xb0, 0x4c, 0x9a, 0xe4, 0x6e, 0x5f, 0x30, 0xf1, 0xc0, 0x7c, 0x6a, 0xf6, 0xab, 0x3f, 0x2b,
+	0x0f, 0x5b, 0x85, 0x42, 0x8f, 0x1d, 0x28, 0x7e, 0x2f, 0xa7, 0x9f, 0xd7, 0x16, 0x12, 0x73, 0x39,
+	0xd7, 0xae, 0x5c, 0x5b, 0xfb, 0xb9, 0xe2, 0x17, 0xda, 0x3d, 0xb4, 0x65, 0xf4, 0xce, 0x9b, 0xae,
+	0x5e, 0x4d, 0x78, 0x53, 0xd3, 0xcf, 0x39, 0x3e, 0x44, 0x1f, 0x8e, 0x00, 0x73, 0xd9, 0x07, 0xbc,
+	0x70, 0xba, 0x72, 0x35, 0xc1, 0x8d, 0x39, 0xb3, 0x50, 0xfb, 0x1a, 0xad, 0x71, 0x90, 0x7c, 0x1a,
+	0xa6, 0x6c, 0x4c, 0xa2, 0xa9, 0x4e, 0xaa, 0xdd, 0xfd, 0x64, 0xf9, 0x4b, 0x95, 0x7f, 0x55, 0xd5,
+	0x6d, 0x08, 0x14, 0xf6, 0xa9, 0x86, 0x06, 0x36, 0x5f, 0x2c, 0xf6, 0xfb, 0x27, 0xa7, 0x6e, 0xe9,
+	0xcd, 0xa9, 0x5b, 0x7a, 0x7b, 0xea, 0x5a, 0x3f, 0xcd, 0x5c, 0xeb, 0xd7, 0x99, 0x6b, 0xfd, 0x3e,
+	0x73, 0xad, 0x93, 0x99, 0x6b, 0xfd, 0x35, 0x73, 0xad, 0xbf, 0x67, 0x6e, 0xe9, 0xed, 0xcc, 0xb5,
+	0x5e, 0x9f, 0xb9, 0xa5, 0x93, 0x33, 0xb7, 0xf4, 0xe6, 0xcc, 0x2d, 0xbd, 0xb8, 0x3b, 0x64, 0x8b,
+	0x9d, 0x08, 0xbb, 0xfc, 0x4f, 0xc7, 0x97, 0xe6, 0xb1, 0xbf, 0xaa, 0xc7, 0xfa, 0xe2, 0xdf, 0x01,
+	0x00, 0x2a, 0x5f, 0xfc, 0x03, 0xa5, 0x08, 0x00, 0x00,
 }
 
 func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
@@ -658,6 +909,134 @@ func (this *HistoryEventPointer) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryDLQTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryDLQTaskInfo)
+	if !ok {
+		that2, ok := that.(HistoryDLQTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MessageId != that1.MessageId {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.CategoryId != that1.CategoryId {
+		return false
+	}
+	if this.TaskType != that1.TaskType {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if that1.EnqueueTime == nil {
+		if this.EnqueueTime != nil {
+			return false
+		}
+	} else if !this.EnqueueTime.Equal(*that1.EnqueueTime) {
+		return false
+	}
+	return true
+}
+func (this *ActivityOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActivityOptions)
+	if !ok {
+		that2, ok := that.(ActivityOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
+			return false
+		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
+		return false
+	}
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	return true
+}
 func (this *TransientWorkflowTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -743,6 +1122,44 @@ func (this *HistoryEventPointer) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryDLQTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&history.HistoryDLQTaskInfo{")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "CategoryId: "+fmt.Sprintf("%#v", this.CategoryId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "EnqueueTime: "+fmt.Sprintf("%#v", this.EnqueueTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActivityOptions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&history.ActivityOptions{")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1020,30 +1437,213 @@ func (m *HistoryEventPointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HistoryDLQTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TransientWorkflowTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HistoryDLQTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryDLQTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.HistorySuffix) > 0 {
-		for _, e := range m.HistorySuffix {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
+	if m.EnqueueTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EnqueueTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VisibilityTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MessageId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MessageId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivityOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivityOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivityOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HeartbeatTimeout != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartToCloseTimeout != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMessage(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMessage(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransientWorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HistorySuffix) > 0 {
+		for _, e := range m.HistorySuffix {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *VersionHistoryItem) Size() (n int) {
@@ -1143,6 +1743,90 @@ func (m *HistoryEventPointer) Size() (n int) {
 	return n
 }
 
+func (m *HistoryDLQTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageId != 0 {
+		n += 1 + sovMessage(uint64(m.MessageId))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovMessage(uint64(m.ShardId))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovMessage(uint64(m.CategoryId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskType))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovMessage(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.EnqueueTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ActivityOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1239,6 +1923,42 @@ func (this *HistoryEventPointer) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryDLQTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryDLQTaskInfo{`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`CategoryId:` + fmt.Sprintf("%v", this.CategoryId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`EnqueueTime:` + strings.Replace(fmt.Sprintf("%v", this.EnqueueTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActivityOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActivityOptions{`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(fmt.Sprintf("%v", this.RetryPolicy), "RetryPolicy", "v12.RetryPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1957,6 +2677,638 @@ func (m *HistoryEventPointer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryDLQTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryDLQTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryDLQTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			m.MessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueTime == nil {
+				m.EnqueueTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EnqueueTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &v12.RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
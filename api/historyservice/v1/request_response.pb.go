@@ -45,18 +45,18 @@ import (
 	v14 "go.temporal.io/api/common/v1"
 	v12 "go.temporal.io/api/enums/v1"
 	v13 "go.temporal.io/api/failure/v1"
-	v111 "go.temporal.io/api/history/v1"
-	v110 "go.temporal.io/api/protocol/v1"
-	v19 "go.temporal.io/api/query/v1"
-	v16 "go.temporal.io/api/taskqueue/v1"
-	v112 "go.temporal.io/api/workflow/v1"
+	v112 "go.temporal.io/api/history/v1"
+	v111 "go.temporal.io/api/protocol/v1"
+	v110 "go.temporal.io/api/query/v1"
+	v17 "go.temporal.io/api/taskqueue/v1"
+	v113 "go.temporal.io/api/workflow/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v116 "go.temporal.io/server/api/adminservice/v1"
-	v15 "go.temporal.io/server/api/clock/v1"
-	v17 "go.temporal.io/server/api/enums/v1"
+	v16 "go.temporal.io/server/api/clock/v1"
+	v15 "go.temporal.io/server/api/enums/v1"
 	v18 "go.temporal.io/server/api/history/v1"
 	v114 "go.temporal.io/server/api/namespace/v1"
-	v113 "go.temporal.io/server/api/persistence/v1"
+	v19 "go.temporal.io/server/api/persistence/v1"
 	v115 "go.temporal.io/server/api/replication/v1"
 	v11 "go.temporal.io/server/api/workflow/v1"
 )
@@ -82,9 +82,11 @@ type StartWorkflowExecutionRequest struct {
 	ContinueAsNewInitiator          v12.ContinueAsNewInitiator        `protobuf:"varint,6,opt,name=continue_as_new_initiator,json=continueAsNewInitiator,proto3,enum=temporal.api.enums.v1.ContinueAsNewInitiator" json:"continue_as_new_initiator,omitempty"`
 	// History service should use the values of continued_failure and last_completion_result
 	// here, not the ones in start_request (those are moved into here in the frontend).
-	ContinuedFailure         *v13.Failure   `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
-	LastCompletionResult     *v14.Payloads  `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
-	FirstWorkflowTaskBackoff *time.Duration `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	ContinuedFailure         *v13.Failure                 `protobuf:"bytes,7,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
+	LastCompletionResult     *v14.Payloads                `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	FirstWorkflowTaskBackoff *time.Duration               `protobuf:"bytes,9,opt,name=first_workflow_task_backoff,json=firstWorkflowTaskBackoff,proto3,stdduration" json:"first_workflow_task_backoff,omitempty"`
+	CompletionCallbacks      []*v11.CompletionCallback    `protobuf:"bytes,10,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
+	WorkflowIdConflictPolicy v15.WorkflowIdConflictPolicy `protobuf:"varint,11,opt,name=workflow_id_conflict_policy,json=workflowIdConflictPolicy,proto3,enum=temporal.server.api.enums.v1.WorkflowIdConflictPolicy" json:"workflow_id_conflict_policy,omitempty"`
 }
 
 func (m *StartWorkflowExecutionRequest) Reset()      { *m = StartWorkflowExecutionRequest{} }
@@ -182,9 +184,23 @@ func (m *StartWorkflowExecutionRequest) GetFirstWorkflowTaskBackoff() *time.Dura
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetCompletionCallbacks() []*v11.CompletionCallback {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
+func (m *StartWorkflowExecutionRequest) GetWorkflowIdConflictPolicy() v15.WorkflowIdConflictPolicy {
+	if m != nil {
+		return m.WorkflowIdConflictPolicy
+	}
+	return v15.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId string           `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Clock *v15.VectorClock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock *v16.VectorClock `protobuf:"bytes,2,opt,name=clock,proto3" json:"clock,omitempty"`
 	// Set if request_eager_execution is set on the start request
 	EagerWorkflowTask *v1.PollWorkflowTaskQueueResponse `protobuf:"bytes,3,opt,name=eager_workflow_task,json=eagerWorkflowTask,proto3" json:"eager_workflow_task,omitempty"`
 }
@@ -228,7 +244,7 @@ func (m *StartWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
-func (m *StartWorkflowExecutionResponse) GetClock() *v15.VectorClock {
+func (m *StartWorkflowExecutionResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -315,18 +331,19 @@ type GetMutableStateResponse struct {
 	NextEventId            int64                  `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	PreviousStartedEventId int64                  `protobuf:"varint,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
 	LastFirstEventId       int64                  `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskQueue              *v16.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	StickyTaskQueue        *v16.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
+	TaskQueue              *v17.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	StickyTaskQueue        *v17.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	StickyTaskQueueScheduleToStartTimeout *time.Duration              `protobuf:"bytes,11,opt,name=sticky_task_queue_schedule_to_start_timeout,json=stickyTaskQueueScheduleToStartTimeout,proto3,stdduration" json:"sticky_task_queue_schedule_to_start_timeout,omitempty"`
 	CurrentBranchToken                    []byte                      `protobuf:"bytes,13,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
-	WorkflowState                         v17.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
+	WorkflowState                         v15.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v18.VersionHistories       `protobuf:"bytes,17,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                        `protobuf:"varint,18,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
 	LastFirstEventTxnId                   int64                       `protobuf:"varint,19,opt,name=last_first_event_txn_id,json=lastFirstEventTxnId,proto3" json:"last_first_event_txn_id,omitempty"`
 	FirstExecutionRunId                   string                      `protobuf:"bytes,20,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
+	BuildIdAssignment                     *v19.BuildIdAssignment      `protobuf:"bytes,21,opt,name=build_id_assignment,json=buildIdAssignment,proto3" json:"build_id_assignment,omitempty"`
 }
 
 func (m *GetMutableStateResponse) Reset()      { *m = GetMutableStateResponse{} }
@@ -396,14 +413,14 @@ func (m *GetMutableStateResponse) GetLastFirstEventId() int64 {
 	return 0
 }
 
-func (m *GetMutableStateResponse) GetTaskQueue() *v16.TaskQueue {
+func (m *GetMutableStateResponse) GetTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.TaskQueue
 	}
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetStickyTaskQueue() *v16.TaskQueue {
+func (m *GetMutableStateResponse) GetStickyTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.StickyTaskQueue
 	}
@@ -424,11 +441,11 @@ func (m *GetMutableStateResponse) GetCurrentBranchToken() []byte {
 	return nil
 }
 
-func (m *GetMutableStateResponse) GetWorkflowState() v17.WorkflowExecutionState {
+func (m *GetMutableStateResponse) GetWorkflowState() v15.WorkflowExecutionState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v17.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v15.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
 func (m *GetMutableStateResponse) GetWorkflowStatus() v12.WorkflowExecutionStatus {
@@ -466,6 +483,13 @@ func (m *GetMutableStateResponse) GetFirstExecutionRunId() string {
 	return ""
 }
 
+func (m *GetMutableStateResponse) GetBuildIdAssignment() *v19.BuildIdAssignment {
+	if m != nil {
+		return m.BuildIdAssignment
+	}
+	return nil
+}
+
 type PollMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -539,14 +563,14 @@ type PollMutableStateResponse struct {
 	NextEventId            int64                  `protobuf:"varint,3,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
 	PreviousStartedEventId int64                  `protobuf:"varint,4,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
 	LastFirstEventId       int64                  `protobuf:"varint,5,opt,name=last_first_event_id,json=lastFirstEventId,proto3" json:"last_first_event_id,omitempty"`
-	TaskQueue              *v16.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	StickyTaskQueue        *v16.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
+	TaskQueue              *v17.TaskQueue         `protobuf:"bytes,6,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	StickyTaskQueue        *v17.TaskQueue         `protobuf:"bytes,7,opt,name=sticky_task_queue,json=stickyTaskQueue,proto3" json:"sticky_task_queue,omitempty"`
 	// (-- api-linter: core::0140::prepositions=disabled
 	//     aip.dev/not-precedent: "to" is used to indicate interval. --)
 	StickyTaskQueueScheduleToStartTimeout *time.Duration              `protobuf:"bytes,11,opt,name=sticky_task_queue_schedule_to_start_timeout,json=stickyTaskQueueScheduleToStartTimeout,proto3,stdduration" json:"sticky_task_queue_schedule_to_start_timeout,omitempty"`
 	CurrentBranchToken                    []byte                      `protobuf:"bytes,12,opt,name=current_branch_token,json=currentBranchToken,proto3" json:"current_branch_token,omitempty"`
 	VersionHistories                      *v18.VersionHistories       `protobuf:"bytes,14,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	WorkflowState                         v17.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
+	WorkflowState                         v15.WorkflowExecutionState  `protobuf:"varint,15,opt,name=workflow_state,json=workflowState,proto3,enum=temporal.server.api.enums.v1.WorkflowExecutionState" json:"workflow_state,omitempty"`
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	LastFirstEventTxnId                   int64                       `protobuf:"varint,17,opt,name=last_first_event_txn_id,json=lastFirstEventTxnId,proto3" json:"last_first_event_txn_id,omitempty"`
 	FirstExecutionRunId                   string                      `protobuf:"bytes,18,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
@@ -619,14 +643,14 @@ func (m *PollMutableStateResponse) GetLastFirstEventId() int64 {
 	return 0
 }
 
-func (m *PollMutableStateResponse) GetTaskQueue() *v16.TaskQueue {
+func (m *PollMutableStateResponse) GetTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.TaskQueue
 	}
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetStickyTaskQueue() *v16.TaskQueue {
+func (m *PollMutableStateResponse) GetStickyTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.StickyTaskQueue
 	}
@@ -654,11 +678,11 @@ func (m *PollMutableStateResponse) GetVersionHistories() *v18.VersionHistories {
 	return nil
 }
 
-func (m *PollMutableStateResponse) GetWorkflowState() v17.WorkflowExecutionState {
+func (m *PollMutableStateResponse) GetWorkflowState() v15.WorkflowExecutionState {
 	if m != nil {
 		return m.WorkflowState
 	}
-	return v17.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
+	return v15.WORKFLOW_EXECUTION_STATE_UNSPECIFIED
 }
 
 func (m *PollMutableStateResponse) GetWorkflowStatus() v12.WorkflowExecutionStatus {
@@ -776,7 +800,7 @@ type RecordWorkflowTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollWorkflowTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	Clock       *v15.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock       *v16.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedRequest) Reset()      { *m = RecordWorkflowTaskStartedRequest{} }
@@ -853,7 +877,7 @@ func (m *RecordWorkflowTaskStartedRequest) GetPollRequest() *v1.PollWorkflowTask
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedRequest) GetClock() *v15.VectorClock {
+func (m *RecordWorkflowTaskStartedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -869,13 +893,13 @@ type RecordWorkflowTaskStartedResponse struct {
 	Attempt                    int32                          `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	StickyExecutionEnabled     bool                           `protobuf:"varint,7,opt,name=sticky_execution_enabled,json=stickyExecutionEnabled,proto3" json:"sticky_execution_enabled,omitempty"`
 	TransientWorkflowTask      *v18.TransientWorkflowTaskInfo `protobuf:"bytes,8,opt,name=transient_workflow_task,json=transientWorkflowTask,proto3" json:"transient_workflow_task,omitempty"`
-	WorkflowExecutionTaskQueue *v16.TaskQueue                 `protobuf:"bytes,9,opt,name=workflow_execution_task_queue,json=workflowExecutionTaskQueue,proto3" json:"workflow_execution_task_queue,omitempty"`
+	WorkflowExecutionTaskQueue *v17.TaskQueue                 `protobuf:"bytes,9,opt,name=workflow_execution_task_queue,json=workflowExecutionTaskQueue,proto3" json:"workflow_execution_task_queue,omitempty"`
 	BranchToken                []byte                         `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
-	Queries                    map[string]*v19.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Clock                      *v15.VectorClock               `protobuf:"bytes,15,opt,name=clock,proto3" json:"clock,omitempty"`
-	Messages                   []*v110.Message                `protobuf:"bytes,16,rep,name=messages,proto3" json:"messages,omitempty"`
+	Queries                    map[string]*v110.WorkflowQuery `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clock                      *v16.VectorClock               `protobuf:"bytes,15,opt,name=clock,proto3" json:"clock,omitempty"`
+	Messages                   []*v111.Message                `protobuf:"bytes,16,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedResponse) Reset()      { *m = RecordWorkflowTaskStartedResponse{} }
@@ -966,7 +990,7 @@ func (m *RecordWorkflowTaskStartedResponse) GetTransientWorkflowTask() *v18.Tran
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetWorkflowExecutionTaskQueue() *v16.TaskQueue {
+func (m *RecordWorkflowTaskStartedResponse) GetWorkflowExecutionTaskQueue() *v17.TaskQueue {
 	if m != nil {
 		return m.WorkflowExecutionTaskQueue
 	}
@@ -994,21 +1018,21 @@ func (m *RecordWorkflowTaskStartedResponse) GetStartedTime() *time.Time {
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v19.WorkflowQuery {
+func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v110.WorkflowQuery {
 	if m != nil {
 		return m.Queries
 	}
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetClock() *v15.VectorClock {
+func (m *RecordWorkflowTaskStartedResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetMessages() []*v110.Message {
+func (m *RecordWorkflowTaskStartedResponse) GetMessages() []*v111.Message {
 	if m != nil {
 		return m.Messages
 	}
@@ -1023,7 +1047,7 @@ type RecordActivityTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollActivityTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	Clock       *v15.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock       *v16.VectorClock                 `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedRequest) Reset()      { *m = RecordActivityTaskStartedRequest{} }
@@ -1100,7 +1124,7 @@ func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollActivityTask
 	return nil
 }
 
-func (m *RecordActivityTaskStartedRequest) GetClock() *v15.VectorClock {
+func (m *RecordActivityTaskStartedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -1108,14 +1132,14 @@ func (m *RecordActivityTaskStartedRequest) GetClock() *v15.VectorClock {
 }
 
 type RecordActivityTaskStartedResponse struct {
-	ScheduledEvent              *v111.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
+	ScheduledEvent              *v112.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                 *time.Time         `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Attempt                     int32              `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	CurrentAttemptScheduledTime *time.Time         `protobuf:"bytes,4,opt,name=current_attempt_scheduled_time,json=currentAttemptScheduledTime,proto3,stdtime" json:"current_attempt_scheduled_time,omitempty"`
 	HeartbeatDetails            *v14.Payloads      `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
 	WorkflowType                *v14.WorkflowType  `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	WorkflowNamespace           string             `protobuf:"bytes,7,opt,name=workflow_namespace,json=workflowNamespace,proto3" json:"workflow_namespace,omitempty"`
-	Clock                       *v15.VectorClock   `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                       *v16.VectorClock   `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedResponse) Reset()      { *m = RecordActivityTaskStartedResponse{} }
@@ -1150,7 +1174,7 @@ func (m *RecordActivityTaskStartedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RecordActivityTaskStartedResponse proto.InternalMessageInfo
 
-func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v111.HistoryEvent {
+func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v112.HistoryEvent {
 	if m != nil {
 		return m.ScheduledEvent
 	}
@@ -1199,7 +1223,7 @@ func (m *RecordActivityTaskStartedResponse) GetWorkflowNamespace() string {
 	return ""
 }
 
-func (m *RecordActivityTaskStartedResponse) GetClock() *v15.VectorClock {
+func (m *RecordActivityTaskStartedResponse) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2254,6 +2278,442 @@ func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
 
+type PauseWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity          string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()      { *m = PauseWorkflowExecutionRequest{} }
+func (*PauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{34}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *PauseWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *PauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *PauseWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PauseWorkflowExecutionResponse struct {
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()      { *m = PauseWorkflowExecutionResponse{} }
+func (*PauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{35}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity          string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{36}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{37}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UpdateActivityOptionsRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	ActivityId        string                 `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	// Only the options which are set are updated, retry policy is replaced as a whole.
+	Options  *v18.ActivityOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	Identity string               `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UpdateActivityOptionsRequest) Reset()      { *m = UpdateActivityOptionsRequest{} }
+func (*UpdateActivityOptionsRequest) ProtoMessage() {}
+func (*UpdateActivityOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{38}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsRequest.Merge(m, src)
+}
+func (m *UpdateActivityOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsRequest proto.InternalMessageInfo
+
+func (m *UpdateActivityOptionsRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *UpdateActivityOptionsRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *UpdateActivityOptionsRequest) GetOptions() *v18.ActivityOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *UpdateActivityOptionsRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type UpdateActivityOptionsResponse struct {
+	// The options of the activity after the update.
+	Options *v18.ActivityOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *UpdateActivityOptionsResponse) Reset()      { *m = UpdateActivityOptionsResponse{} }
+func (*UpdateActivityOptionsResponse) ProtoMessage() {}
+func (*UpdateActivityOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{39}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateActivityOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateActivityOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateActivityOptionsResponse.Merge(m, src)
+}
+func (m *UpdateActivityOptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateActivityOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateActivityOptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateActivityOptionsResponse proto.InternalMessageInfo
+
+func (m *UpdateActivityOptionsResponse) GetOptions() *v18.ActivityOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ResetActivityRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// If not set, all pending activities waiting for their next retry are reset.
+	ActivityId    string `protobuf:"bytes,3,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ResetAttempts bool   `protobuf:"varint,4,opt,name=reset_attempts,json=resetAttempts,proto3" json:"reset_attempts,omitempty"`
+	Identity      string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *ResetActivityRequest) Reset()      { *m = ResetActivityRequest{} }
+func (*ResetActivityRequest) ProtoMessage() {}
+func (*ResetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{40}
+}
+func (m *ResetActivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityRequest.Merge(m, src)
+}
+func (m *ResetActivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityRequest proto.InternalMessageInfo
+
+func (m *ResetActivityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ResetActivityRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ResetActivityRequest) GetActivityId() string {
+	if m != nil {
+		return m.ActivityId
+	}
+	return ""
+}
+
+func (m *ResetActivityRequest) GetResetAttempts() bool {
+	if m != nil {
+		return m.ResetAttempts
+	}
+	return false
+}
+
+func (m *ResetActivityRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+type ResetActivityResponse struct {
+	// The IDs of the activities which are retried immediately.
+	ActivityIds []string `protobuf:"bytes,1,rep,name=activity_ids,json=activityIds,proto3" json:"activity_ids,omitempty"`
+}
+
+func (m *ResetActivityResponse) Reset()      { *m = ResetActivityResponse{} }
+func (*ResetActivityResponse) ProtoMessage() {}
+func (*ResetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{41}
+}
+func (m *ResetActivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetActivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetActivityResponse.Merge(m, src)
+}
+func (m *ResetActivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetActivityResponse proto.InternalMessageInfo
+
+func (m *ResetActivityResponse) GetActivityIds() []string {
+	if m != nil {
+		return m.ActivityIds
+	}
+	return nil
+}
+
 type ResetWorkflowExecutionRequest struct {
 	NamespaceId  string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	ResetRequest *v1.ResetWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=reset_request,json=resetRequest,proto3" json:"reset_request,omitempty"`
@@ -2262,7 +2722,7 @@ type ResetWorkflowExecutionRequest struct {
 func (m *ResetWorkflowExecutionRequest) Reset()      { *m = ResetWorkflowExecutionRequest{} }
 func (*ResetWorkflowExecutionRequest) ProtoMessage() {}
 func (*ResetWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{34}
+	return fileDescriptor_b8c78c1d460a3711, []int{42}
 }
 func (m *ResetWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2772,7 @@ type ResetWorkflowExecutionResponse struct {
 func (m *ResetWorkflowExecutionResponse) Reset()      { *m = ResetWorkflowExecutionResponse{} }
 func (*ResetWorkflowExecutionResponse) ProtoMessage() {}
 func (*ResetWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{35}
+	return fileDescriptor_b8c78c1d460a3711, []int{43}
 }
 func (m *ResetWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2359,7 +2819,7 @@ type RequestCancelWorkflowExecutionRequest struct {
 func (m *RequestCancelWorkflowExecutionRequest) Reset()      { *m = RequestCancelWorkflowExecutionRequest{} }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{36}
+	return fileDescriptor_b8c78c1d460a3711, []int{44}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2431,7 +2891,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{37}
+	return fileDescriptor_b8c78c1d460a3711, []int{45}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,14 +2924,14 @@ type ScheduleWorkflowTaskRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	IsFirstWorkflowTask bool                   `protobuf:"varint,3,opt,name=is_first_workflow_task,json=isFirstWorkflowTask,proto3" json:"is_first_workflow_task,omitempty"`
-	ChildClock          *v15.VectorClock       `protobuf:"bytes,4,opt,name=child_clock,json=childClock,proto3" json:"child_clock,omitempty"`
-	ParentClock         *v15.VectorClock       `protobuf:"bytes,5,opt,name=parent_clock,json=parentClock,proto3" json:"parent_clock,omitempty"`
+	ChildClock          *v16.VectorClock       `protobuf:"bytes,4,opt,name=child_clock,json=childClock,proto3" json:"child_clock,omitempty"`
+	ParentClock         *v16.VectorClock       `protobuf:"bytes,5,opt,name=parent_clock,json=parentClock,proto3" json:"parent_clock,omitempty"`
 }
 
 func (m *ScheduleWorkflowTaskRequest) Reset()      { *m = ScheduleWorkflowTaskRequest{} }
 func (*ScheduleWorkflowTaskRequest) ProtoMessage() {}
 func (*ScheduleWorkflowTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{38}
+	return fileDescriptor_b8c78c1d460a3711, []int{46}
 }
 func (m *ScheduleWorkflowTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2521,14 +2981,14 @@ func (m *ScheduleWorkflowTaskRequest) GetIsFirstWorkflowTask() bool {
 	return false
 }
 
-func (m *ScheduleWorkflowTaskRequest) GetChildClock() *v15.VectorClock {
+func (m *ScheduleWorkflowTaskRequest) GetChildClock() *v16.VectorClock {
 	if m != nil {
 		return m.ChildClock
 	}
 	return nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) GetParentClock() *v15.VectorClock {
+func (m *ScheduleWorkflowTaskRequest) GetParentClock() *v16.VectorClock {
 	if m != nil {
 		return m.ParentClock
 	}
@@ -2541,7 +3001,7 @@ type ScheduleWorkflowTaskResponse struct {
 func (m *ScheduleWorkflowTaskResponse) Reset()      { *m = ScheduleWorkflowTaskResponse{} }
 func (*ScheduleWorkflowTaskResponse) ProtoMessage() {}
 func (*ScheduleWorkflowTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{39}
+	return fileDescriptor_b8c78c1d460a3711, []int{47}
 }
 func (m *ScheduleWorkflowTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2573,7 +3033,7 @@ var xxx_messageInfo_ScheduleWorkflowTaskResponse proto.InternalMessageInfo
 type VerifyFirstWorkflowTaskScheduledRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	Clock             *v15.VectorClock       `protobuf:"bytes,3,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock             *v16.VectorClock       `protobuf:"bytes,3,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *VerifyFirstWorkflowTaskScheduledRequest) Reset() {
@@ -2581,7 +3041,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledRequest) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{40}
+	return fileDescriptor_b8c78c1d460a3711, []int{48}
 }
 func (m *VerifyFirstWorkflowTaskScheduledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2624,7 +3084,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) GetWorkflowExecution() *v14.Wo
 	return nil
 }
 
-func (m *VerifyFirstWorkflowTaskScheduledRequest) GetClock() *v15.VectorClock {
+func (m *VerifyFirstWorkflowTaskScheduledRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2639,7 +3099,7 @@ func (m *VerifyFirstWorkflowTaskScheduledResponse) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledResponse) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{41}
+	return fileDescriptor_b8c78c1d460a3711, []int{49}
 }
 func (m *VerifyFirstWorkflowTaskScheduledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2679,15 +3139,15 @@ type RecordChildExecutionCompletedRequest struct {
 	WorkflowExecution      *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	ParentInitiatedId      int64                  `protobuf:"varint,3,opt,name=parent_initiated_id,json=parentInitiatedId,proto3" json:"parent_initiated_id,omitempty"`
 	CompletedExecution     *v14.WorkflowExecution `protobuf:"bytes,4,opt,name=completed_execution,json=completedExecution,proto3" json:"completed_execution,omitempty"`
-	CompletionEvent        *v111.HistoryEvent     `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
-	Clock                  *v15.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
+	CompletionEvent        *v112.HistoryEvent     `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
+	Clock                  *v16.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
 	ParentInitiatedVersion int64                  `protobuf:"varint,7,opt,name=parent_initiated_version,json=parentInitiatedVersion,proto3" json:"parent_initiated_version,omitempty"`
 }
 
 func (m *RecordChildExecutionCompletedRequest) Reset()      { *m = RecordChildExecutionCompletedRequest{} }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage() {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{42}
+	return fileDescriptor_b8c78c1d460a3711, []int{50}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2744,14 +3204,14 @@ func (m *RecordChildExecutionCompletedRequest) GetCompletedExecution() *v14.Work
 	return nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v111.HistoryEvent {
+func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v112.HistoryEvent {
 	if m != nil {
 		return m.CompletionEvent
 	}
 	return nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetClock() *v15.VectorClock {
+func (m *RecordChildExecutionCompletedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2771,7 +3231,7 @@ type RecordChildExecutionCompletedResponse struct {
 func (m *RecordChildExecutionCompletedResponse) Reset()      { *m = RecordChildExecutionCompletedResponse{} }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage() {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{43}
+	return fileDescriptor_b8c78c1d460a3711, []int{51}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2806,7 +3266,7 @@ type VerifyChildExecutionCompletionRecordedRequest struct {
 	ChildExecution         *v14.WorkflowExecution `protobuf:"bytes,3,opt,name=child_execution,json=childExecution,proto3" json:"child_execution,omitempty"`
 	ParentInitiatedId      int64                  `protobuf:"varint,4,opt,name=parent_initiated_id,json=parentInitiatedId,proto3" json:"parent_initiated_id,omitempty"`
 	ParentInitiatedVersion int64                  `protobuf:"varint,5,opt,name=parent_initiated_version,json=parentInitiatedVersion,proto3" json:"parent_initiated_version,omitempty"`
-	Clock                  *v15.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
+	Clock                  *v16.VectorClock       `protobuf:"bytes,6,opt,name=clock,proto3" json:"clock,omitempty"`
 }
 
 func (m *VerifyChildExecutionCompletionRecordedRequest) Reset() {
@@ -2814,7 +3274,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedRequest) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{44}
+	return fileDescriptor_b8c78c1d460a3711, []int{52}
 }
 func (m *VerifyChildExecutionCompletionRecordedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2878,7 +3338,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) GetParentInitiatedVersio
 	return 0
 }
 
-func (m *VerifyChildExecutionCompletionRecordedRequest) GetClock() *v15.VectorClock {
+func (m *VerifyChildExecutionCompletionRecordedRequest) GetClock() *v16.VectorClock {
 	if m != nil {
 		return m.Clock
 	}
@@ -2893,7 +3353,7 @@ func (m *VerifyChildExecutionCompletionRecordedResponse) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedResponse) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{45}
+	return fileDescriptor_b8c78c1d460a3711, []int{53}
 }
 func (m *VerifyChildExecutionCompletionRecordedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3390,7 @@ type DescribeWorkflowExecutionRequest struct {
 func (m *DescribeWorkflowExecutionRequest) Reset()      { *m = DescribeWorkflowExecutionRequest{} }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage() {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{46}
+	return fileDescriptor_b8c78c1d460a3711, []int{54}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2974,17 +3434,18 @@ func (m *DescribeWorkflowExecutionRequest) GetRequest() *v1.DescribeWorkflowExec
 }
 
 type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfig       *v112.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
-	WorkflowExecutionInfo *v112.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
-	PendingActivities     []*v112.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
-	PendingChildren       []*v112.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
-	PendingWorkflowTask   *v112.PendingWorkflowTaskInfo     `protobuf:"bytes,5,opt,name=pending_workflow_task,json=pendingWorkflowTask,proto3" json:"pending_workflow_task,omitempty"`
+	ExecutionConfig       *v113.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
+	WorkflowExecutionInfo *v113.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
+	PendingActivities     []*v113.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingChildren       []*v113.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	PendingWorkflowTask   *v113.PendingWorkflowTaskInfo     `protobuf:"bytes,5,opt,name=pending_workflow_task,json=pendingWorkflowTask,proto3" json:"pending_workflow_task,omitempty"`
+	CompletionCallbacks   []*v11.CompletionCallbackInfo     `protobuf:"bytes,6,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage() {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{47}
+	return fileDescriptor_b8c78c1d460a3711, []int{55}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3013,41 +3474,48 @@ func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionResponse) GetExecutionConfig() *v112.WorkflowExecutionConfig {
+func (m *DescribeWorkflowExecutionResponse) GetExecutionConfig() *v113.WorkflowExecutionConfig {
 	if m != nil {
 		return m.ExecutionConfig
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v112.WorkflowExecutionInfo {
+func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v113.WorkflowExecutionInfo {
 	if m != nil {
 		return m.WorkflowExecutionInfo
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v112.PendingActivityInfo {
+func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v113.PendingActivityInfo {
 	if m != nil {
 		return m.PendingActivities
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v112.PendingChildExecutionInfo {
+func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v113.PendingChildExecutionInfo {
 	if m != nil {
 		return m.PendingChildren
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingWorkflowTask() *v112.PendingWorkflowTaskInfo {
+func (m *DescribeWorkflowExecutionResponse) GetPendingWorkflowTask() *v113.PendingWorkflowTaskInfo {
 	if m != nil {
 		return m.PendingWorkflowTask
 	}
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() []*v11.CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
 type ReplicateEventsV2Request struct {
 	NamespaceId         string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -3061,7 +3529,7 @@ type ReplicateEventsV2Request struct {
 func (m *ReplicateEventsV2Request) Reset()      { *m = ReplicateEventsV2Request{} }
 func (*ReplicateEventsV2Request) ProtoMessage() {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{48}
+	return fileDescriptor_b8c78c1d460a3711, []int{56}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3138,7 +3606,7 @@ type ReplicateEventsV2Response struct {
 func (m *ReplicateEventsV2Response) Reset()      { *m = ReplicateEventsV2Response{} }
 func (*ReplicateEventsV2Response) ProtoMessage() {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{49}
+	return fileDescriptor_b8c78c1d460a3711, []int{57}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3168,15 +3636,15 @@ func (m *ReplicateEventsV2Response) XXX_DiscardUnknown() {
 var xxx_messageInfo_ReplicateEventsV2Response proto.InternalMessageInfo
 
 type ReplicateWorkflowStateRequest struct {
-	WorkflowState *v113.WorkflowMutableState `protobuf:"bytes,1,opt,name=workflow_state,json=workflowState,proto3" json:"workflow_state,omitempty"`
-	RemoteCluster string                     `protobuf:"bytes,2,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
-	NamespaceId   string                     `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowState *v19.WorkflowMutableState `protobuf:"bytes,1,opt,name=workflow_state,json=workflowState,proto3" json:"workflow_state,omitempty"`
+	RemoteCluster string                    `protobuf:"bytes,2,opt,name=remote_cluster,json=remoteCluster,proto3" json:"remote_cluster,omitempty"`
+	NamespaceId   string                    `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 }
 
 func (m *ReplicateWorkflowStateRequest) Reset()      { *m = ReplicateWorkflowStateRequest{} }
 func (*ReplicateWorkflowStateRequest) ProtoMessage() {}
 func (*ReplicateWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{50}
+	return fileDescriptor_b8c78c1d460a3711, []int{58}
 }
 func (m *ReplicateWorkflowStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3205,7 +3673,7 @@ func (m *ReplicateWorkflowStateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicateWorkflowStateRequest proto.InternalMessageInfo
 
-func (m *ReplicateWorkflowStateRequest) GetWorkflowState() *v113.WorkflowMutableState {
+func (m *ReplicateWorkflowStateRequest) GetWorkflowState() *v19.WorkflowMutableState {
 	if m != nil {
 		return m.WorkflowState
 	}
@@ -3232,7 +3700,7 @@ type ReplicateWorkflowStateResponse struct {
 func (m *ReplicateWorkflowStateResponse) Reset()      { *m = ReplicateWorkflowStateResponse{} }
 func (*ReplicateWorkflowStateResponse) ProtoMessage() {}
 func (*ReplicateWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{51}
+	return fileDescriptor_b8c78c1d460a3711, []int{59}
 }
 func (m *ReplicateWorkflowStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3270,7 +3738,7 @@ type SyncShardStatusRequest struct {
 func (m *SyncShardStatusRequest) Reset()      { *m = SyncShardStatusRequest{} }
 func (*SyncShardStatusRequest) ProtoMessage() {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{52}
+	return fileDescriptor_b8c78c1d460a3711, []int{60}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3326,7 +3794,7 @@ type SyncShardStatusResponse struct {
 func (m *SyncShardStatusResponse) Reset()      { *m = SyncShardStatusResponse{} }
 func (*SyncShardStatusResponse) ProtoMessage() {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{53}
+	return fileDescriptor_b8c78c1d460a3711, []int{61}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,12 +3839,15 @@ type SyncActivityRequest struct {
 	LastWorkerIdentity string                 `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
 	VersionHistory     *v18.VersionHistory    `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 	BaseExecutionInfo  *v11.BaseExecutionInfo `protobuf:"bytes,15,opt,name=base_execution_info,json=baseExecutionInfo,proto3" json:"base_execution_info,omitempty"`
+	// Options of the activity, which can be updated after the activity is scheduled.
+	Options             *v18.ActivityOptions `protobuf:"bytes,16,opt,name=options,proto3" json:"options,omitempty"`
+	RetryExpirationTime *time.Time           `protobuf:"bytes,17,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 }
 
 func (m *SyncActivityRequest) Reset()      { *m = SyncActivityRequest{} }
 func (*SyncActivityRequest) ProtoMessage() {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{54}
+	return fileDescriptor_b8c78c1d460a3711, []int{62}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3510,13 +3981,27 @@ func (m *SyncActivityRequest) GetBaseExecutionInfo() *v11.BaseExecutionInfo {
 	return nil
 }
 
+func (m *SyncActivityRequest) GetOptions() *v18.ActivityOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *SyncActivityRequest) GetRetryExpirationTime() *time.Time {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
 type SyncActivityResponse struct {
 }
 
 func (m *SyncActivityResponse) Reset()      { *m = SyncActivityResponse{} }
 func (*SyncActivityResponse) ProtoMessage() {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{55}
+	return fileDescriptor_b8c78c1d460a3711, []int{63}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3553,7 +4038,7 @@ type DescribeMutableStateRequest struct {
 func (m *DescribeMutableStateRequest) Reset()      { *m = DescribeMutableStateRequest{} }
 func (*DescribeMutableStateRequest) ProtoMessage() {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{56}
+	return fileDescriptor_b8c78c1d460a3711, []int{64}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3597,14 +4082,14 @@ func (m *DescribeMutableStateRequest) GetExecution() *v14.WorkflowExecution {
 }
 
 type DescribeMutableStateResponse struct {
-	CacheMutableState    *v113.WorkflowMutableState `protobuf:"bytes,1,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
-	DatabaseMutableState *v113.WorkflowMutableState `protobuf:"bytes,2,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	CacheMutableState    *v19.WorkflowMutableState `protobuf:"bytes,1,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
+	DatabaseMutableState *v19.WorkflowMutableState `protobuf:"bytes,2,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
 }
 
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
 func (*DescribeMutableStateResponse) ProtoMessage() {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{57}
+	return fileDescriptor_b8c78c1d460a3711, []int{65}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3633,14 +4118,14 @@ func (m *DescribeMutableStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DescribeMutableStateResponse proto.InternalMessageInfo
 
-func (m *DescribeMutableStateResponse) GetCacheMutableState() *v113.WorkflowMutableState {
+func (m *DescribeMutableStateResponse) GetCacheMutableState() *v19.WorkflowMutableState {
 	if m != nil {
 		return m.CacheMutableState
 	}
 	return nil
 }
 
-func (m *DescribeMutableStateResponse) GetDatabaseMutableState() *v113.WorkflowMutableState {
+func (m *DescribeMutableStateResponse) GetDatabaseMutableState() *v19.WorkflowMutableState {
 	if m != nil {
 		return m.DatabaseMutableState
 	}
@@ -3659,7 +4144,7 @@ type DescribeHistoryHostRequest struct {
 func (m *DescribeHistoryHostRequest) Reset()      { *m = DescribeHistoryHostRequest{} }
 func (*DescribeHistoryHostRequest) ProtoMessage() {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{58}
+	return fileDescriptor_b8c78c1d460a3711, []int{66}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3726,7 +4211,7 @@ type DescribeHistoryHostResponse struct {
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
 func (*DescribeHistoryHostResponse) ProtoMessage() {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{59}
+	return fileDescriptor_b8c78c1d460a3711, []int{67}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3790,7 +4275,7 @@ type CloseShardRequest struct {
 func (m *CloseShardRequest) Reset()      { *m = CloseShardRequest{} }
 func (*CloseShardRequest) ProtoMessage() {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{60}
+	return fileDescriptor_b8c78c1d460a3711, []int{68}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3832,7 +4317,7 @@ type CloseShardResponse struct {
 func (m *CloseShardResponse) Reset()      { *m = CloseShardResponse{} }
 func (*CloseShardResponse) ProtoMessage() {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{61}
+	return fileDescriptor_b8c78c1d460a3711, []int{69}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3868,7 +4353,7 @@ type GetShardRequest struct {
 func (m *GetShardRequest) Reset()      { *m = GetShardRequest{} }
 func (*GetShardRequest) ProtoMessage() {}
 func (*GetShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{62}
+	return fileDescriptor_b8c78c1d460a3711, []int{70}
 }
 func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3905,13 +4390,13 @@ func (m *GetShardRequest) GetShardId() int32 {
 }
 
 type GetShardResponse struct {
-	ShardInfo *v113.ShardInfo `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
+	ShardInfo *v19.ShardInfo `protobuf:"bytes,1,opt,name=shard_info,json=shardInfo,proto3" json:"shard_info,omitempty"`
 }
 
 func (m *GetShardResponse) Reset()      { *m = GetShardResponse{} }
 func (*GetShardResponse) ProtoMessage() {}
 func (*GetShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{63}
+	return fileDescriptor_b8c78c1d460a3711, []int{71}
 }
 func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3940,7 +4425,7 @@ func (m *GetShardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetShardResponse proto.InternalMessageInfo
 
-func (m *GetShardResponse) GetShardInfo() *v113.ShardInfo {
+func (m *GetShardResponse) GetShardInfo() *v19.ShardInfo {
 	if m != nil {
 		return m.ShardInfo
 	}
@@ -3949,7 +4434,7 @@ func (m *GetShardResponse) GetShardInfo() *v113.ShardInfo {
 
 type RemoveTaskRequest struct {
 	ShardId        int32            `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Category       v17.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	Category       v15.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
 	TaskId         int64            `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	VisibilityTime *time.Time       `protobuf:"bytes,4,opt,name=visibility_time,json=visibilityTime,proto3,stdtime" json:"visibility_time,omitempty"`
 }
@@ -3957,7 +4442,7 @@ type RemoveTaskRequest struct {
 func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
 func (*RemoveTaskRequest) ProtoMessage() {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{64}
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,11 +4478,11 @@ func (m *RemoveTaskRequest) GetShardId() int32 {
 	return 0
 }
 
-func (m *RemoveTaskRequest) GetCategory() v17.TaskCategory {
+func (m *RemoveTaskRequest) GetCategory() v15.TaskCategory {
 	if m != nil {
 		return m.Category
 	}
-	return v17.TASK_CATEGORY_UNSPECIFIED
+	return v15.TASK_CATEGORY_UNSPECIFIED
 }
 
 func (m *RemoveTaskRequest) GetTaskId() int64 {
@@ -4020,7 +4505,7 @@ type RemoveTaskResponse struct {
 func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
 func (*RemoveTaskResponse) ProtoMessage() {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{65}
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4057,7 +4542,7 @@ type GetReplicationMessagesRequest struct {
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{66}
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4107,7 +4592,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{67}
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4150,7 +4635,7 @@ type GetDLQReplicationMessagesRequest struct {
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{68}
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4193,7 +4678,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{69}
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4237,7 +4722,7 @@ type QueryWorkflowRequest struct {
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
 func (*QueryWorkflowRequest) ProtoMessage() {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{70}
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4287,7 +4772,7 @@ type QueryWorkflowResponse struct {
 func (m *QueryWorkflowResponse) Reset()      { *m = QueryWorkflowResponse{} }
 func (*QueryWorkflowResponse) ProtoMessage() {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{71}
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4331,7 +4816,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{72}
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4380,7 +4865,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{73}
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4410,7 +4895,7 @@ func (m *ReapplyEventsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ReapplyEventsResponse proto.InternalMessageInfo
 
 type GetDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4421,7 +4906,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{74}
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4450,11 +4935,11 @@ func (m *GetDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *GetDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *GetDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetDLQMessagesRequest) GetShardId() int32 {
@@ -4493,16 +4978,17 @@ func (m *GetDLQMessagesRequest) GetNextPageToken() []byte {
 }
 
 type GetDLQMessagesResponse struct {
-	Type                 v17.DeadLetterQueueType     `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                 v15.DeadLetterQueueType     `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ReplicationTasks     []*v115.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	NextPageToken        []byte                      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	ReplicationTasksInfo []*v115.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	HistoryTasks         []*v18.HistoryDLQTaskInfo   `protobuf:"bytes,5,rep,name=history_tasks,json=historyTasks,proto3" json:"history_tasks,omitempty"`
 }
 
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{75}
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4531,11 +5017,11 @@ func (m *GetDLQMessagesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDLQMessagesResponse proto.InternalMessageInfo
 
-func (m *GetDLQMessagesResponse) GetType() v17.DeadLetterQueueType {
+func (m *GetDLQMessagesResponse) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *GetDLQMessagesResponse) GetReplicationTasks() []*v115.ReplicationTask {
@@ -4559,8 +5045,15 @@ func (m *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v115.ReplicationTa
 	return nil
 }
 
+func (m *GetDLQMessagesResponse) GetHistoryTasks() []*v18.HistoryDLQTaskInfo {
+	if m != nil {
+		return m.HistoryTasks
+	}
+	return nil
+}
+
 type PurgeDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4569,7 +5062,7 @@ type PurgeDLQMessagesRequest struct {
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{76}
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4598,11 +5091,11 @@ func (m *PurgeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PurgeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *PurgeDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *PurgeDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *PurgeDLQMessagesRequest) GetShardId() int32 {
@@ -4632,7 +5125,7 @@ type PurgeDLQMessagesResponse struct {
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{77}
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4662,7 +5155,7 @@ func (m *PurgeDLQMessagesResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_PurgeDLQMessagesResponse proto.InternalMessageInfo
 
 type MergeDLQMessagesRequest struct {
-	Type                  v17.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
+	Type                  v15.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string                  `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId int64                   `protobuf:"varint,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
@@ -4673,7 +5166,7 @@ type MergeDLQMessagesRequest struct {
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{78}
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4702,11 +5195,11 @@ func (m *MergeDLQMessagesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MergeDLQMessagesRequest proto.InternalMessageInfo
 
-func (m *MergeDLQMessagesRequest) GetType() v17.DeadLetterQueueType {
+func (m *MergeDLQMessagesRequest) GetType() v15.DeadLetterQueueType {
 	if m != nil {
 		return m.Type
 	}
-	return v17.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
+	return v15.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *MergeDLQMessagesRequest) GetShardId() int32 {
@@ -4751,7 +5244,7 @@ type MergeDLQMessagesResponse struct {
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{79}
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4795,7 +5288,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{80}
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4844,7 +5337,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{81}
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4883,7 +5376,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{82}
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
 }
 func (m *GenerateLastHistoryReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4934,7 +5427,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{83}
+	return fileDescriptor_b8c78c1d460a3711, []int{91}
 }
 func (m *GenerateLastHistoryReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4971,7 +5464,7 @@ type GetReplicationStatusRequest struct {
 func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
 func (*GetReplicationStatusRequest) ProtoMessage() {}
 func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{84}
+	return fileDescriptor_b8c78c1d460a3711, []int{92}
 }
 func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5014,7 +5507,7 @@ type GetReplicationStatusResponse struct {
 func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
 func (*GetReplicationStatusResponse) ProtoMessage() {}
 func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{85}
+	return fileDescriptor_b8c78c1d460a3711, []int{93}
 }
 func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5064,7 +5557,7 @@ type ShardReplicationStatus struct {
 func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
 func (*ShardReplicationStatus) ProtoMessage() {}
 func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{86}
+	return fileDescriptor_b8c78c1d460a3711, []int{94}
 }
 func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5144,7 +5637,7 @@ type HandoverNamespaceInfo struct {
 func (m *HandoverNamespaceInfo) Reset()      { *m = HandoverNamespaceInfo{} }
 func (*HandoverNamespaceInfo) ProtoMessage() {}
 func (*HandoverNamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{87}
+	return fileDescriptor_b8c78c1d460a3711, []int{95}
 }
 func (m *HandoverNamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5190,7 +5683,7 @@ type ShardReplicationStatusPerCluster struct {
 func (m *ShardReplicationStatusPerCluster) Reset()      { *m = ShardReplicationStatusPerCluster{} }
 func (*ShardReplicationStatusPerCluster) ProtoMessage() {}
 func (*ShardReplicationStatusPerCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{88}
+	return fileDescriptor_b8c78c1d460a3711, []int{96}
 }
 func (m *ShardReplicationStatusPerCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5241,7 +5734,7 @@ type RebuildMutableStateRequest struct {
 func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
 func (*RebuildMutableStateRequest) ProtoMessage() {}
 func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{89}
+	return fileDescriptor_b8c78c1d460a3711, []int{97}
 }
 func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5290,7 +5783,7 @@ type RebuildMutableStateResponse struct {
 func (m *RebuildMutableStateResponse) Reset()      { *m = RebuildMutableStateResponse{} }
 func (*RebuildMutableStateResponse) ProtoMessage() {}
 func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{90}
+	return fileDescriptor_b8c78c1d460a3711, []int{98}
 }
 func (m *RebuildMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5329,7 +5822,7 @@ type DeleteWorkflowVisibilityRecordRequest struct {
 func (m *DeleteWorkflowVisibilityRecordRequest) Reset()      { *m = DeleteWorkflowVisibilityRecordRequest{} }
 func (*DeleteWorkflowVisibilityRecordRequest) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{91}
+	return fileDescriptor_b8c78c1d460a3711, []int{99}
 }
 func (m *DeleteWorkflowVisibilityRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5394,7 +5887,7 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Reset() {
 }
 func (*DeleteWorkflowVisibilityRecordResponse) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{92}
+	return fileDescriptor_b8c78c1d460a3711, []int{100}
 }
 func (m *DeleteWorkflowVisibilityRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5434,7 +5927,7 @@ type UpdateWorkflowExecutionRequest struct {
 func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
 func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
 func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{93}
+	return fileDescriptor_b8c78c1d460a3711, []int{101}
 }
 func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5484,7 +5977,7 @@ type UpdateWorkflowExecutionResponse struct {
 func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
 func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
 func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{94}
+	return fileDescriptor_b8c78c1d460a3711, []int{102}
 }
 func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5522,7 +6015,6 @@ func (m *UpdateWorkflowExecutionResponse) GetResponse() *v1.UpdateWorkflowExecut
 
 type StreamWorkflowReplicationMessagesRequest struct {
 	// Types that are valid to be assigned to Attributes:
-	//
 	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
 	Attributes isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
 }
@@ -5532,7 +6024,7 @@ func (m *StreamWorkflowReplicationMessagesRequest) Reset() {
 }
 func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
 func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{95}
+	return fileDescriptor_b8c78c1d460a3711, []int{103}
 }
 func (m *StreamWorkflowReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5598,7 +6090,6 @@ func (*StreamWorkflowReplicationMessagesRequest) XXX_OneofWrappers() []interface
 
 type StreamWorkflowReplicationMessagesResponse struct {
 	// Types that are valid to be assigned to Attributes:
-	//
 	//	*StreamWorkflowReplicationMessagesResponse_Messages
 	Attributes isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
 }
@@ -5608,7 +6099,7 @@ func (m *StreamWorkflowReplicationMessagesResponse) Reset() {
 }
 func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
 func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{96}
+	return fileDescriptor_b8c78c1d460a3711, []int{104}
 }
 func (m *StreamWorkflowReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5672,6 +6163,140 @@ func (*StreamWorkflowReplicationMessagesResponse) XXX_OneofWrappers() []interfac
 	}
 }
 
+type StreamWorkflowExecutionHistoryRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Run ID is optional, the current run is streamed if it is not set.
+	Execution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// ID of the first event to stream. The stream can be resumed with the next_event_id of the last
+	// received response. All events are streamed if it is not set.
+	FirstEventId int64 `protobuf:"varint,3,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
+	// Only events of these types are streamed. All events are streamed if it is empty.
+	EventTypes []v12.EventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=temporal.api.enums.v1.EventType" json:"event_types,omitempty"`
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) Reset()      { *m = StreamWorkflowExecutionHistoryRequest{} }
+func (*StreamWorkflowExecutionHistoryRequest) ProtoMessage() {}
+func (*StreamWorkflowExecutionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{105}
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.Merge(m, src)
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamWorkflowExecutionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamWorkflowExecutionHistoryRequest proto.InternalMessageInfo
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetFirstEventId() int64 {
+	if m != nil {
+		return m.FirstEventId
+	}
+	return 0
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) GetEventTypes() []v12.EventType {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+type StreamWorkflowExecutionHistoryResponse struct {
+	History *v112.History `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
+	// ID of the event following the last event of this batch, including filtered events.
+	NextEventId int64 `protobuf:"varint,2,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	// Run ID of the streamed workflow execution.
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) Reset() {
+	*m = StreamWorkflowExecutionHistoryResponse{}
+}
+func (*StreamWorkflowExecutionHistoryResponse) ProtoMessage() {}
+func (*StreamWorkflowExecutionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{106}
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.Merge(m, src)
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamWorkflowExecutionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamWorkflowExecutionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamWorkflowExecutionHistoryResponse proto.InternalMessageInfo
+
+func (m *StreamWorkflowExecutionHistoryResponse) GetHistory() *v112.History {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
 type PollWorkflowExecutionUpdateRequest struct {
 	NamespaceId string                                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Request     *v1.PollWorkflowExecutionUpdateRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
@@ -5680,7 +6305,7 @@ type PollWorkflowExecutionUpdateRequest struct {
 func (m *PollWorkflowExecutionUpdateRequest) Reset()      { *m = PollWorkflowExecutionUpdateRequest{} }
 func (*PollWorkflowExecutionUpdateRequest) ProtoMessage() {}
 func (*PollWorkflowExecutionUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{97}
+	return fileDescriptor_b8c78c1d460a3711, []int{107}
 }
 func (m *PollWorkflowExecutionUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5730,7 +6355,7 @@ type PollWorkflowExecutionUpdateResponse struct {
 func (m *PollWorkflowExecutionUpdateResponse) Reset()      { *m = PollWorkflowExecutionUpdateResponse{} }
 func (*PollWorkflowExecutionUpdateResponse) ProtoMessage() {}
 func (*PollWorkflowExecutionUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{98}
+	return fileDescriptor_b8c78c1d460a3711, []int{108}
 }
 func (m *PollWorkflowExecutionUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5766,511 +6391,1116 @@ func (m *PollWorkflowExecutionUpdateResponse) GetResponse() *v1.PollWorkflowExec
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
-	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
-	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
-	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
-	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v19.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
-	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
-	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
-	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
-	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
-	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
-	proto.RegisterType((*VerifyFirstWorkflowTaskScheduledRequest)(nil), "temporal.server.api.historyservice.v1.VerifyFirstWorkflowTaskScheduledRequest")
-	proto.RegisterType((*VerifyFirstWorkflowTaskScheduledResponse)(nil), "temporal.server.api.historyservice.v1.VerifyFirstWorkflowTaskScheduledResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*VerifyChildExecutionCompletionRecordedRequest)(nil), "temporal.server.api.historyservice.v1.VerifyChildExecutionCompletionRecordedRequest")
-	proto.RegisterType((*VerifyChildExecutionCompletionRecordedResponse)(nil), "temporal.server.api.historyservice.v1.VerifyChildExecutionCompletionRecordedResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*ReplicateWorkflowStateRequest)(nil), "temporal.server.api.historyservice.v1.ReplicateWorkflowStateRequest")
-	proto.RegisterType((*ReplicateWorkflowStateResponse)(nil), "temporal.server.api.historyservice.v1.ReplicateWorkflowStateResponse")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
-	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.historyservice.v1.GetShardRequest")
-	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.historyservice.v1.GetShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v115.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*GenerateLastHistoryReplicationTasksRequest)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksRequest")
-	proto.RegisterType((*GenerateLastHistoryReplicationTasksResponse)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksResponse")
-	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusRequest")
-	proto.RegisterType((*GetReplicationStatusResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusResponse")
-	proto.RegisterType((*ShardReplicationStatus)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus")
-	proto.RegisterMapType((map[string]*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.HandoverNamespacesEntry")
-	proto.RegisterMapType((map[string]*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.RemoteClustersEntry")
-	proto.RegisterType((*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.HandoverNamespaceInfo")
-	proto.RegisterType((*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster")
-	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateRequest")
-	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateResponse")
-	proto.RegisterType((*DeleteWorkflowVisibilityRecordRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowVisibilityRecordRequest")
-	proto.RegisterType((*DeleteWorkflowVisibilityRecordResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowVisibilityRecordResponse")
-	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionRequest")
-	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionResponse")
-	proto.RegisterType((*StreamWorkflowReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowReplicationMessagesRequest")
-	proto.RegisterType((*StreamWorkflowReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowReplicationMessagesResponse")
-	proto.RegisterType((*PollWorkflowExecutionUpdateRequest)(nil), "temporal.server.api.historyservice.v1.PollWorkflowExecutionUpdateRequest")
-	proto.RegisterType((*PollWorkflowExecutionUpdateResponse)(nil), "temporal.server.api.historyservice.v1.PollWorkflowExecutionUpdateResponse")
+type ListFaultInjectionScenariosRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+func (m *ListFaultInjectionScenariosRequest) Reset()      { *m = ListFaultInjectionScenariosRequest{} }
+func (*ListFaultInjectionScenariosRequest) ProtoMessage() {}
+func (*ListFaultInjectionScenariosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{109}
 }
-
-var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x49, 0x6c, 0x1c, 0xd9,
-	0x75, 0x2a, 0x76, 0x37, 0xd9, 0x7c, 0x6c, 0xf6, 0x52, 0xdc, 0x5a, 0x94, 0xd4, 0xa2, 0x6a, 0xb4,
-	0x50, 0x9a, 0x51, 0x6b, 0xb3, 0x3d, 0xb2, 0xe2, 0x99, 0xb1, 0x48, 0x6a, 0xa1, 0x20, 0xc9, 0x9c,
-	0x22, 0x47, 0x9a, 0x8c, 0x2d, 0xd7, 0x14, 0xab, 0x3e, 0xc9, 0x0a, 0xbb, 0xab, 0x5a, 0xf5, 0xab,
-	0x49, 0xf6, 0xe4, 0xe0, 0x00, 0x46, 0x16, 0x3b, 0x40, 0x32, 0x40, 0x2e, 0x46, 0xe0, 0xe4, 0x10,
-	0x20, 0x89, 0x11, 0x20, 0xc8, 0x21, 0x07, 0xc3, 0x87, 0x5c, 0x12, 0x20, 0x08, 0x82, 0x1c, 0x06,
-	0xb9, 0x64, 0x90, 0x00, 0x71, 0x46, 0x83, 0x20, 0x0e, 0x92, 0x83, 0x8f, 0x41, 0x90, 0x43, 0xf0,
-	0xb7, 0xea, 0xda, 0x7a, 0x63, 0x4b, 0xd1, 0xd8, 0x99, 0x1b, 0xfb, 0xff, 0xf7, 0xde, 0xff, 0x6f,
-	0xff, 0xff, 0xfd, 0x57, 0x84, 0xaf, 0x78, 0xa8, 0xde, 0x70, 0x5c, 0xbd, 0x76, 0x09, 0x23, 0x77,
-	0x0f, 0xb9, 0x97, 0xf4, 0x86, 0x75, 0x69, 0xc7, 0xc2, 0x9e, 0xe3, 0xb6, 0xc8, 0x88, 0x65, 0xa0,
-	0x4b, 0x7b, 0x57, 0x2e, 0xb9, 0xe8, 0x69, 0x13, 0x61, 0x4f, 0x73, 0x11, 0x6e, 0x38, 0x36, 0x46,
-	0xd5, 0x86, 0xeb, 0x78, 0x8e, 0x7c, 0x46, 0x60, 0x57, 0x19, 0x76, 0x55, 0x6f, 0x58, 0xd5, 0x30,
-	0x76, 0x75, 0xef, 0xca, 0x7c, 0x65, 0xdb, 0x71, 0xb6, 0x6b, 0xe8, 0x12, 0x45, 0xda, 0x6c, 0x6e,
-	0x5d, 0x32, 0x9b, 0xae, 0xee, 0x59, 0x8e, 0xcd, 0xc8, 0xcc, 0x9f, 0x8c, 0xce, 0x7b, 0x56, 0x1d,
-	0x61, 0x4f, 0xaf, 0x37, 0x38, 0xc0, 0x29, 0x13, 0x35, 0x90, 0x6d, 0x22, 0xdb, 0xb0, 0x10, 0xbe,
-	0xb4, 0xed, 0x6c, 0x3b, 0x74, 0x9c, 0xfe, 0xc5, 0x41, 0x4e, 0xfb, 0x8c, 0x10, 0x0e, 0x0c, 0xa7,
-	0x5e, 0x77, 0x6c, 0xb2, 0xf3, 0x3a, 0xc2, 0x58, 0xdf, 0xe6, 0x1b, 0x9e, 0x3f, 0x13, 0x82, 0xe2,
-	0x3b, 0x8d, 0x83, 0x9d, 0x0b, 0x81, 0x79, 0x3a, 0xde, 0x7d, 0xda, 0x44, 0x4d, 0x14, 0x07, 0x0c,
-	0xaf, 0x8a, 0xec, 0x66, 0x1d, 0x13, 0xa0, 0x7d, 0xc7, 0xdd, 0xdd, 0xaa, 0x39, 0xfb, 0x1c, 0xea,
-	0x6c, 0x08, 0x4a, 0x4c, 0xc6, 0xa9, 0xbd, 0x12, 0x82, 0x7b, 0xda, 0x44, 0x49, 0x7b, 0x0b, 0x13,
-	0xa3, 0x63, 0x86, 0x53, 0xeb, 0xc5, 0xea, 0x96, 0x6e, 0xd5, 0x9a, 0x6e, 0x02, 0x07, 0x17, 0x92,
-	0x0c, 0xc0, 0xa8, 0x39, 0xc6, 0x6e, 0x1c, 0xf6, 0xb5, 0x2e, 0xc6, 0x12, 0x87, 0x3e, 0x9f, 0x04,
-	0xed, 0x8b, 0x88, 0x69, 0x88, 0x83, 0xbe, 0xda, 0x15, 0x34, 0x22, 0xcd, 0x73, 0x5d, 0x81, 0x89,
-	0xb2, 0x38, 0xe0, 0xc5, 0x24, 0xc0, 0xce, 0xd2, 0xaf, 0x26, 0x81, 0xdb, 0x7a, 0x1d, 0xe1, 0x86,
-	0x6e, 0x24, 0x48, 0xee, 0x72, 0x12, 0xbc, 0x8b, 0x1a, 0x35, 0xcb, 0xa0, 0xc6, 0x1d, 0xc7, 0xb8,
-	0x96, 0x84, 0xd1, 0x40, 0x2e, 0xb6, 0xb0, 0x87, 0x6c, 0xb6, 0x06, 0x3a, 0x40, 0x46, 0x93, 0xa0,
-	0x63, 0x8e, 0xf4, 0x56, 0x1f, 0x48, 0x82, 0x29, 0xad, 0xde, 0xf4, 0xf4, 0xcd, 0x1a, 0xd2, 0xb0,
-	0xa7, 0x7b, 0x62, 0xd5, 0x2f, 0x25, 0x5a, 0x5f, 0x4f, 0xe7, 0x9e, 0xbf, 0x91, 0xb4, 0xb0, 0x6e,
-	0xd6, 0x2d, 0xbb, 0x27, 0xae, 0xf2, 0x9b, 0xa3, 0x70, 0x62, 0xdd, 0xd3, 0x5d, 0xef, 0x31, 0x5f,
-	0xee, 0x96, 0x60, 0x4b, 0x65, 0x08, 0xf2, 0x29, 0xc8, 0xf9, 0xb2, 0xd5, 0x2c, 0xb3, 0x2c, 0x2d,
-	0x48, 0x8b, 0xe3, 0xea, 0x84, 0x3f, 0xb6, 0x6a, 0xca, 0x06, 0x4c, 0x62, 0x42, 0x43, 0xe3, 0x8b,
-	0x94, 0x47, 0x16, 0xa4, 0xc5, 0x89, 0xab, 0x6f, 0xfa, 0x8a, 0xa2, 0xe1, 0x26, 0xc2, 0x50, 0x75,
-	0xef, 0x4a, 0xb5, 0xeb, 0xca, 0x6a, 0x8e, 0x12, 0x15, 0xfb, 0xd8, 0x81, 0x99, 0x86, 0xee, 0x22,
-	0xdb, 0xd3, 0x7c, 0xc9, 0x6b, 0x96, 0xbd, 0xe5, 0x94, 0x53, 0x74, 0xb1, 0x2f, 0x54, 0x93, 0x42,
-	0x9c, 0x6f, 0x91, 0x7b, 0x57, 0xaa, 0x6b, 0x14, 0xdb, 0x5f, 0x65, 0xd5, 0xde, 0x72, 0xd4, 0xa9,
-	0x46, 0x7c, 0x50, 0x2e, 0xc3, 0x98, 0xee, 0x11, 0x6a, 0x5e, 0x39, 0xbd, 0x20, 0x2d, 0x66, 0x54,
-	0xf1, 0x53, 0xae, 0x83, 0xe2, 0x6b, 0xb0, 0xbd, 0x0b, 0x74, 0xd0, 0xb0, 0x58, 0x98, 0xd4, 0x48,
-	0x3c, 0x2c, 0x67, 0xe8, 0x86, 0xe6, 0xab, 0x2c, 0x58, 0x56, 0x45, 0xb0, 0xac, 0x6e, 0x88, 0x60,
-	0xb9, 0x94, 0xfe, 0xf0, 0xc7, 0x27, 0x25, 0xf5, 0xe4, 0x7e, 0x94, 0xf3, 0x5b, 0x3e, 0x25, 0x02,
-	0x2b, 0xef, 0xc0, 0x51, 0xc3, 0xb1, 0x3d, 0xcb, 0x6e, 0x22, 0x4d, 0xc7, 0x9a, 0x8d, 0xf6, 0x35,
-	0xcb, 0xb6, 0x3c, 0x4b, 0xf7, 0x1c, 0xb7, 0x3c, 0xba, 0x20, 0x2d, 0xe6, 0xaf, 0x5e, 0x0c, 0xcb,
-	0x98, 0x7a, 0x17, 0x61, 0x76, 0x99, 0xe3, 0xdd, 0xc4, 0x0f, 0xd1, 0xfe, 0xaa, 0x40, 0x52, 0x67,
-	0x8d, 0xc4, 0x71, 0xf9, 0x01, 0x94, 0xc4, 0x8c, 0xa9, 0xf1, 0x10, 0x54, 0x1e, 0xa3, 0x7c, 0x2c,
-	0x84, 0x57, 0xe0, 0x93, 0x64, 0x8d, 0xdb, 0xec, 0x4f, 0xb5, 0xe8, 0xa3, 0xf2, 0x11, 0xf9, 0x11,
-	0xcc, 0xd6, 0x74, 0xec, 0x69, 0x86, 0x53, 0x6f, 0xd4, 0x10, 0x95, 0x8c, 0x8b, 0x70, 0xb3, 0xe6,
-	0x95, 0xb3, 0x49, 0x34, 0x79, 0x88, 0xa1, 0x3a, 0x6a, 0xd5, 0x1c, 0xdd, 0xc4, 0xea, 0x34, 0xc1,
-	0x5f, 0xf6, 0xd1, 0x55, 0x8a, 0x2d, 0x7f, 0x13, 0x8e, 0x6d, 0x59, 0x2e, 0xf6, 0x34, 0x5f, 0x0b,
-	0x24, 0x8a, 0x68, 0x9b, 0xba, 0xb1, 0xeb, 0x6c, 0x6d, 0x95, 0xc7, 0x29, 0xf1, 0xa3, 0x31, 0xc1,
-	0xaf, 0xf0, 0x2c, 0xb6, 0x94, 0xfe, 0x1e, 0x91, 0x7b, 0x99, 0xd2, 0x10, 0x66, 0xb7, 0xa1, 0xe3,
-	0xdd, 0x25, 0x46, 0x40, 0xf9, 0x89, 0x04, 0x95, 0x4e, 0x36, 0xc9, 0xdc, 0x46, 0x9e, 0x81, 0x51,
-	0xb7, 0x69, 0xb7, 0x1d, 0x21, 0xe3, 0x36, 0xed, 0x55, 0x53, 0x7e, 0x0b, 0x32, 0x34, 0x16, 0x73,
-	0xd3, 0x3f, 0x9f, 0x68, 0x8d, 0x14, 0x82, 0xb0, 0xf9, 0x08, 0x19, 0x9e, 0xe3, 0x2e, 0x93, 0x9f,
-	0x2a, 0xc3, 0x93, 0x6d, 0x98, 0x42, 0xfa, 0x36, 0x72, 0xc3, 0xac, 0x71, 0xe3, 0xee, 0xed, 0x49,
-	0x6b, 0x4e, 0xad, 0x16, 0xe4, 0xe8, 0x6d, 0x92, 0x06, 0xc5, 0xa6, 0xd5, 0x12, 0x25, 0x1d, 0x9c,
-	0x57, 0xfe, 0x43, 0x82, 0xd9, 0x3b, 0xc8, 0x7b, 0xc0, 0xe2, 0xd0, 0x3a, 0x09, 0x43, 0x03, 0x78,
-	0xfc, 0x1d, 0x18, 0xf7, 0xed, 0x3f, 0xce, 0x72, 0x58, 0xa7, 0x71, 0x59, 0xb6, 0x71, 0xe5, 0x6b,
-	0x30, 0x8b, 0x0e, 0x1a, 0xc8, 0xf0, 0x90, 0xa9, 0xd9, 0xe8, 0xc0, 0xd3, 0xd0, 0x1e, 0x71, 0x71,
-	0xcb, 0xa4, 0x9c, 0xa7, 0xd4, 0x29, 0x31, 0xfb, 0x10, 0x1d, 0x78, 0xb7, 0xc8, 0xdc, 0xaa, 0x29,
-	0x5f, 0x86, 0x69, 0xa3, 0xe9, 0xd2, 0x58, 0xb0, 0xe9, 0xea, 0xb6, 0xb1, 0xa3, 0x79, 0xce, 0x2e,
-	0xb2, 0xa9, 0xb7, 0xe6, 0x54, 0x99, 0xcf, 0x2d, 0xd1, 0xa9, 0x0d, 0x32, 0xa3, 0xfc, 0x38, 0x0b,
-	0x73, 0x31, 0x6e, 0xb9, 0x46, 0x43, 0xbc, 0x48, 0x43, 0xf0, 0xb2, 0x0a, 0x93, 0x6d, 0xe5, 0xb5,
-	0x1a, 0x88, 0x0b, 0xe6, 0x74, 0x2f, 0x62, 0x1b, 0xad, 0x06, 0x52, 0x73, 0xfb, 0x81, 0x5f, 0xb2,
-	0x02, 0x93, 0x49, 0xd2, 0x98, 0xb0, 0x03, 0x52, 0xf8, 0x32, 0x1c, 0x6d, 0xb8, 0x68, 0xcf, 0x72,
-	0x9a, 0x58, 0xa3, 0x91, 0x12, 0x99, 0x6d, 0xf8, 0x34, 0x85, 0x9f, 0x15, 0x00, 0xeb, 0x6c, 0x5e,
-	0xa0, 0x5e, 0x84, 0x29, 0xea, 0x9f, 0xcc, 0x99, 0x7c, 0xa4, 0x0c, 0x45, 0x2a, 0x92, 0xa9, 0xdb,
-	0x64, 0x46, 0x80, 0x2f, 0x03, 0x50, 0x3f, 0xa3, 0x67, 0x2b, 0x1a, 0x78, 0x62, 0x5c, 0xf9, 0x47,
-	0x2f, 0xc2, 0x58, 0xdb, 0x00, 0xc7, 0x3d, 0xf1, 0xa7, 0xbc, 0x06, 0x25, 0xec, 0x59, 0xc6, 0x6e,
-	0x4b, 0x0b, 0xd0, 0x1a, 0x1b, 0x80, 0x56, 0x81, 0xa1, 0xfb, 0x03, 0xf2, 0x2f, 0xc3, 0xab, 0x31,
-	0x8a, 0x1a, 0x36, 0x76, 0x90, 0xd9, 0xac, 0x21, 0xcd, 0x73, 0x98, 0x54, 0x68, 0x4c, 0x76, 0x9a,
-	0x5e, 0x79, 0xa2, 0xbf, 0xe8, 0x70, 0x26, 0xb2, 0xcc, 0x3a, 0x27, 0xb8, 0xe1, 0x50, 0x21, 0x6e,
-	0x30, 0x6a, 0x1d, 0x6d, 0x70, 0xb2, 0x93, 0x0d, 0xca, 0x5f, 0x87, 0xbc, 0x6f, 0x1e, 0x34, 0xed,
-	0x97, 0x0b, 0x34, 0x84, 0x27, 0x67, 0x2e, 0x3f, 0x92, 0xc7, 0x4c, 0x8e, 0x59, 0xaf, 0x6f, 0x6a,
-	0xf4, 0xa7, 0xfc, 0x18, 0x0a, 0x21, 0xe2, 0x4d, 0x5c, 0x2e, 0x52, 0xea, 0xd5, 0x0e, 0x09, 0x22,
-	0x91, 0x6c, 0x13, 0xab, 0xf9, 0x20, 0xdd, 0x26, 0x96, 0x9f, 0x40, 0x69, 0x8f, 0x9c, 0x61, 0x1c,
-	0x5b, 0x63, 0x07, 0x48, 0x0b, 0xe1, 0x72, 0x89, 0x8a, 0xf2, 0x72, 0xb5, 0xcb, 0xad, 0x82, 0x85,
-	0x39, 0x8a, 0x78, 0x57, 0xe0, 0xa9, 0xc5, 0xbd, 0xc8, 0x88, 0xfc, 0x26, 0x1c, 0xb7, 0x88, 0xf9,
-	0x46, 0xd5, 0x88, 0x6c, 0xe2, 0xa8, 0x66, 0x59, 0x5e, 0x90, 0x16, 0xb3, 0x6a, 0xd9, 0xc2, 0xeb,
-	0x61, 0xad, 0xdc, 0x62, 0xf3, 0xf2, 0x17, 0x60, 0x2e, 0x66, 0xc9, 0xde, 0x01, 0x8d, 0xcf, 0x53,
-	0x2c, 0x80, 0x84, 0xad, 0x79, 0xe3, 0x80, 0x44, 0xeb, 0x6b, 0x30, 0xcb, 0x11, 0xfc, 0x24, 0xce,
-	0x83, 0xfa, 0x34, 0x8d, 0x75, 0x53, 0x74, 0xb6, 0xed, 0xe4, 0x24, 0xc4, 0xdf, 0x4b, 0x67, 0xb3,
-	0xc5, 0xf1, 0x7b, 0xe9, 0xec, 0x78, 0x11, 0xee, 0xa5, 0xb3, 0x50, 0x9c, 0xb8, 0x97, 0xce, 0xe6,
-	0x8a, 0x93, 0xf7, 0xd2, 0xd9, 0x7c, 0xb1, 0xa0, 0xfc, 0xa7, 0x04, 0x73, 0x24, 0x08, 0xff, 0x3f,
-	0x09, 0xa8, 0xbf, 0x9b, 0x85, 0x72, 0x9c, 0xdd, 0xcf, 0x23, 0xea, 0xe7, 0x11, 0xf5, 0xb9, 0x47,
-	0xd4, 0x5c, 0xc7, 0x88, 0x9a, 0x18, 0x9b, 0xf2, 0xcf, 0x2d, 0x36, 0xfd, 0x6c, 0x06, 0xec, 0x2e,
-	0x11, 0xb1, 0x74, 0x98, 0x88, 0x28, 0x0f, 0x16, 0x11, 0x27, 0x8b, 0x79, 0xe5, 0x3b, 0x12, 0x1c,
-	0x53, 0x11, 0x46, 0x5e, 0x24, 0x68, 0xbf, 0x84, 0x78, 0xa8, 0x54, 0xe0, 0x78, 0xf2, 0x56, 0x58,
-	0xac, 0x52, 0x7e, 0x90, 0x82, 0x05, 0x15, 0x19, 0x8e, 0x6b, 0x06, 0x8f, 0xc7, 0xdc, 0xbb, 0x07,
-	0xd8, 0xf0, 0xbb, 0x20, 0xc7, 0xaf, 0x86, 0x83, 0xef, 0xbc, 0x14, 0xbb, 0x13, 0xca, 0xaf, 0x81,
-	0x2c, 0x5c, 0xd0, 0x8c, 0x86, 0xaf, 0xa2, 0x3f, 0x23, 0x22, 0xcb, 0x1c, 0x8c, 0x51, 0xdf, 0xf5,
-	0x23, 0xd6, 0x28, 0xf9, 0xb9, 0x6a, 0xca, 0x27, 0x00, 0x44, 0x0d, 0x80, 0x07, 0xa6, 0x71, 0x75,
-	0x9c, 0x8f, 0xac, 0x9a, 0xf2, 0xfb, 0x90, 0x6b, 0x38, 0xb5, 0x9a, 0x7f, 0x85, 0x67, 0x31, 0xe9,
-	0x8d, 0xc3, 0x5e, 0x3c, 0xd8, 0x0d, 0x7e, 0x82, 0x90, 0x14, 0x42, 0xf4, 0xaf, 0x48, 0x63, 0x87,
-	0xbb, 0x22, 0x91, 0x43, 0xfc, 0xa9, 0x2e, 0xaa, 0xe2, 0xc9, 0x27, 0x96, 0x33, 0xa4, 0x43, 0xe7,
-	0x8c, 0xae, 0xf9, 0x60, 0xa4, 0x6b, 0x3e, 0x18, 0x4c, 0x69, 0x8b, 0x50, 0xec, 0x90, 0x6f, 0xf2,
-	0x38, 0x4c, 0x37, 0x96, 0xc6, 0x32, 0xf1, 0x34, 0x16, 0xa8, 0x5f, 0x8c, 0x86, 0xeb, 0x17, 0xd7,
-	0xa1, 0xcc, 0xe3, 0x7b, 0xa0, 0x7a, 0xc1, 0x4f, 0x5a, 0x63, 0xf4, 0xa4, 0x35, 0xcb, 0xe6, 0xdb,
-	0x15, 0x09, 0x7e, 0xce, 0x7a, 0x0a, 0x73, 0x9e, 0xab, 0xdb, 0xd8, 0x22, 0xcb, 0x86, 0xaf, 0xa8,
-	0xec, 0x4a, 0xff, 0xe5, 0x5e, 0x01, 0x77, 0x43, 0xa0, 0x07, 0x95, 0x47, 0x8b, 0x30, 0x33, 0x5e,
-	0xd2, 0x94, 0xbc, 0x0d, 0x27, 0x12, 0x8a, 0x2d, 0x81, 0x54, 0x37, 0x3e, 0x40, 0xaa, 0x9b, 0x8f,
-	0xf9, 0x55, 0x3b, 0xeb, 0x9d, 0x82, 0x5c, 0x28, 0xe1, 0x4c, 0xd0, 0x84, 0x33, 0xb1, 0x19, 0xc8,
-	0x34, 0x77, 0x20, 0xdf, 0x56, 0x27, 0x2d, 0xf2, 0xe4, 0xfa, 0x2c, 0xf2, 0x4c, 0xfa, 0x78, 0xb4,
-	0xa4, 0xb3, 0x0c, 0x39, 0xa1, 0x69, 0x4a, 0x66, 0xb2, 0x4f, 0x32, 0x13, 0x1c, 0x8b, 0x12, 0x71,
-	0x60, 0xec, 0x69, 0x13, 0xf1, 0x6c, 0x97, 0x5a, 0x9c, 0xb8, 0xfa, 0x4e, 0xb5, 0xaf, 0xfa, 0x7e,
-	0xb5, 0xa7, 0xf7, 0x54, 0xdf, 0x66, 0x74, 0x6f, 0xd9, 0x9e, 0xdb, 0x52, 0xc5, 0x2a, 0x6d, 0xd7,
-	0x2d, 0x1c, 0xb2, 0xba, 0xf1, 0x06, 0x64, 0x79, 0x85, 0x95, 0xa4, 0x39, 0xb2, 0xe5, 0x53, 0x61,
-	0xb5, 0x89, 0xf2, 0x38, 0xc1, 0x7f, 0xc0, 0x20, 0x55, 0x1f, 0x65, 0xfe, 0x7d, 0xc8, 0x05, 0x37,
-	0x26, 0x17, 0x21, 0xb5, 0x8b, 0x5a, 0x3c, 0x0c, 0x93, 0x3f, 0xe5, 0x1b, 0x90, 0xd9, 0xd3, 0x6b,
-	0xcd, 0x0e, 0x27, 0x44, 0x5a, 0xa1, 0x0f, 0x3a, 0x3b, 0xa1, 0xd6, 0x52, 0x19, 0xca, 0x8d, 0x91,
-	0xeb, 0x12, 0x4b, 0x5f, 0x81, 0x64, 0x70, 0xd3, 0xf0, 0xac, 0x3d, 0xcb, 0x6b, 0x7d, 0x9e, 0x0c,
-	0x06, 0x4d, 0x06, 0x41, 0xc9, 0xbd, 0xc0, 0x64, 0xf0, 0x57, 0x69, 0x91, 0x0c, 0x12, 0x55, 0xc5,
-	0x93, 0xc1, 0x43, 0x28, 0x44, 0xc4, 0xc5, 0xd3, 0xc1, 0x99, 0x30, 0x2f, 0x81, 0x38, 0xc5, 0xce,
-	0x7f, 0x2d, 0x2a, 0x42, 0x35, 0x1f, 0x16, 0x69, 0xcc, 0x7d, 0x47, 0x0e, 0xe3, 0xbe, 0x81, 0xf8,
-	0x9c, 0x0a, 0xc7, 0x67, 0x04, 0x15, 0x71, 0x04, 0xe6, 0x43, 0x5a, 0x24, 0xec, 0xa4, 0xfb, 0x5c,
-	0xf0, 0x18, 0xa7, 0x73, 0x93, 0x91, 0x59, 0x0f, 0x05, 0xa1, 0x07, 0x50, 0xda, 0x41, 0xba, 0xeb,
-	0x6d, 0x22, 0xdd, 0xd3, 0x4c, 0xe4, 0xe9, 0x56, 0x0d, 0xf3, 0xaa, 0x75, 0xef, 0xca, 0x6c, 0xd1,
-	0x47, 0x5d, 0x61, 0x98, 0xf1, 0x8c, 0x3b, 0x7a, 0xe8, 0x8c, 0x7b, 0x31, 0xe0, 0x38, 0xbe, 0x43,
-	0x51, 0x1b, 0x19, 0x6f, 0x7b, 0xc3, 0x43, 0x31, 0xd1, 0xb6, 0xa2, 0xec, 0x21, 0xad, 0xe8, 0x47,
-	0x12, 0xbc, 0xc2, 0x8c, 0x25, 0x14, 0x15, 0x79, 0xe1, 0x79, 0x20, 0x9f, 0x77, 0xa0, 0xc8, 0xcb,
-	0xdd, 0x28, 0xf2, 0x0e, 0xb2, 0xd2, 0xd3, 0x6f, 0xfa, 0xd8, 0x82, 0x5a, 0x10, 0xd4, 0xf9, 0x80,
-	0xf2, 0xc3, 0x11, 0x38, 0xdd, 0x1d, 0x91, 0x3b, 0x01, 0x6e, 0x9f, 0x2e, 0xc4, 0xeb, 0x0f, 0xf7,
-	0x82, 0xbb, 0xcf, 0x2b, 0x6f, 0x90, 0xab, 0x64, 0xd8, 0xf3, 0x10, 0xe4, 0x75, 0xee, 0x98, 0x34,
-	0x67, 0xe3, 0xf2, 0x08, 0x8d, 0xfb, 0x6f, 0x1e, 0x36, 0x88, 0xf0, 0x85, 0x26, 0xf5, 0xc0, 0x14,
-	0x26, 0xf7, 0x16, 0x97, 0x1c, 0xef, 0xf9, 0x05, 0xb0, 0x15, 0x2b, 0x77, 0xd0, 0xd9, 0xa0, 0x4f,
-	0xaf, 0x9a, 0xca, 0x9f, 0x49, 0x24, 0xcc, 0xc7, 0x24, 0x77, 0x5b, 0xb7, 0x6a, 0x03, 0xa9, 0x7c,
-	0x07, 0xf2, 0x5b, 0x14, 0x27, 0xa2, 0xf0, 0x9b, 0x87, 0x51, 0x78, 0x68, 0x75, 0x75, 0x72, 0x2b,
-	0xf8, 0x53, 0x79, 0x85, 0x04, 0xbb, 0x8e, 0x28, 0xfc, 0x2a, 0xf3, 0x23, 0x09, 0x94, 0x78, 0x48,
-	0xbc, 0x2b, 0xdc, 0x75, 0x00, 0xc6, 0x1a, 0xc1, 0x00, 0x11, 0xe6, 0x6d, 0xb9, 0x0f, 0xde, 0x7a,
-	0x6d, 0x21, 0x10, 0x43, 0x04, 0x83, 0x6b, 0xc4, 0x0f, 0xbb, 0xe0, 0x71, 0xab, 0x3a, 0x0f, 0x45,
-	0x43, 0xb7, 0x0d, 0xe4, 0xa7, 0x26, 0xc4, 0xf6, 0x9f, 0x55, 0x0b, 0x6c, 0x5c, 0x15, 0xc3, 0x41,
-	0xd7, 0x0e, 0xd2, 0x7c, 0x49, 0xae, 0xdd, 0x6d, 0x0b, 0x71, 0xd7, 0x3e, 0xeb, 0x7b, 0x76, 0x07,
-	0x3c, 0xae, 0xf1, 0x80, 0x21, 0x07, 0x01, 0xff, 0xef, 0x0d, 0xb9, 0xe3, 0xea, 0x9d, 0x0d, 0x39,
-	0x09, 0x85, 0xb3, 0xf5, 0xe7, 0xd4, 0x90, 0xe3, 0xfc, 0x53, 0x0d, 0x0f, 0xc4, 0xd8, 0x2f, 0x41,
-	0x3e, 0x6c, 0x2f, 0x03, 0x58, 0x71, 0xaf, 0xf5, 0xd5, 0xc9, 0x90, 0xc9, 0x29, 0x67, 0x92, 0xed,
-	0xcd, 0x47, 0xe2, 0xcc, 0xfd, 0xf5, 0x08, 0x54, 0xd6, 0xad, 0x6d, 0x5b, 0xaf, 0x0d, 0xf3, 0xe4,
-	0xbe, 0x05, 0x79, 0x4c, 0x89, 0x44, 0x18, 0x7b, 0xab, 0xf7, 0x9b, 0x7b, 0xd7, 0xb5, 0xd5, 0x49,
-	0x46, 0x56, 0x6c, 0xc5, 0x82, 0x63, 0xe8, 0xc0, 0x43, 0x2e, 0x59, 0x29, 0xe1, 0x48, 0x9b, 0x1a,
-	0xf4, 0x48, 0x7b, 0x54, 0x50, 0x8b, 0x4d, 0xc9, 0x55, 0x98, 0x32, 0x76, 0xac, 0x9a, 0xd9, 0x5e,
-	0xc7, 0xb1, 0x6b, 0x2d, 0x7a, 0xe2, 0xc9, 0xaa, 0x25, 0x3a, 0x25, 0x90, 0xbe, 0x66, 0xd7, 0x5a,
-	0xca, 0x29, 0x38, 0xd9, 0x91, 0x17, 0x2e, 0xeb, 0xbf, 0x97, 0xe0, 0x1c, 0x87, 0xb1, 0xbc, 0x9d,
-	0xa1, 0xfb, 0x1c, 0xbe, 0x2d, 0xc1, 0x51, 0x2e, 0xf5, 0x7d, 0xcb, 0xdb, 0xd1, 0x92, 0x9a, 0x1e,
-	0xee, 0xf6, 0xab, 0x80, 0x5e, 0x1b, 0x52, 0x67, 0x71, 0x18, 0x50, 0xd8, 0xd9, 0x4d, 0x58, 0xec,
-	0x4d, 0xa2, 0xeb, 0x6b, 0xb5, 0xf2, 0x17, 0x12, 0x9c, 0x54, 0x51, 0xdd, 0xd9, 0x43, 0x8c, 0xd2,
-	0x21, 0x1f, 0x2d, 0x5e, 0xdc, 0x35, 0x27, 0x7c, 0x3f, 0x49, 0x45, 0xee, 0x27, 0x8a, 0x42, 0xc2,
-	0x5e, 0xa7, 0xed, 0x0b, 0xdd, 0x8f, 0xc0, 0xa9, 0x0d, 0xe4, 0xd6, 0x2d, 0x5b, 0xf7, 0xd0, 0x30,
-	0x5a, 0x77, 0xa0, 0xe4, 0x09, 0x3a, 0x11, 0x65, 0x2f, 0xf5, 0x54, 0x76, 0xcf, 0x1d, 0xa8, 0x45,
-	0x9f, 0xf8, 0xcf, 0x80, 0xcf, 0x9d, 0x06, 0xa5, 0x1b, 0x47, 0x5c, 0xf4, 0xff, 0x2d, 0x41, 0x65,
-	0x05, 0x91, 0x54, 0x35, 0x8c, 0xdc, 0x5f, 0x9c, 0x75, 0x9d, 0x87, 0xa2, 0x4f, 0x99, 0x57, 0xfd,
-	0xf9, 0x71, 0xd1, 0xaf, 0xc9, 0xf3, 0xe7, 0x01, 0xfa, 0x28, 0x51, 0x73, 0x30, 0x4a, 0x96, 0x90,
-	0xcc, 0xe6, 0xa2, 0x61, 0xa9, 0x23, 0xef, 0x5c, 0x3e, 0x7f, 0x2c, 0xc1, 0x09, 0x5a, 0x94, 0x1e,
-	0xb2, 0xe9, 0x8a, 0x9d, 0x7c, 0x07, 0x6d, 0xba, 0xea, 0xba, 0xb2, 0x9a, 0xa3, 0x44, 0x45, 0xac,
-	0x79, 0x1d, 0x2a, 0x9d, 0xc0, 0xbb, 0x47, 0x98, 0xdf, 0x49, 0xc1, 0x19, 0x4e, 0x84, 0x65, 0xc0,
-	0x61, 0x58, 0xad, 0x77, 0xc8, 0xe2, 0xb7, 0xfb, 0xe0, 0xb5, 0x8f, 0x2d, 0x44, 0x12, 0xb9, 0xfc,
-	0x46, 0xc0, 0xff, 0x78, 0xbf, 0x55, 0xbc, 0xd8, 0x52, 0x16, 0x20, 0xab, 0x02, 0x42, 0x14, 0x5d,
-	0x7a, 0xb8, 0x6f, 0xfa, 0xc5, 0xbb, 0x6f, 0xa6, 0x93, 0xfb, 0x2e, 0xc2, 0xd9, 0x5e, 0x12, 0xe1,
-	0x26, 0xfa, 0xef, 0x23, 0x70, 0x4c, 0x14, 0x0d, 0x82, 0x57, 0x8e, 0xcf, 0x84, 0xff, 0x5e, 0x83,
-	0x59, 0x0b, 0x6b, 0x09, 0x9d, 0x60, 0x54, 0x37, 0x59, 0x75, 0xca, 0xc2, 0xb7, 0xa3, 0x2d, 0x5e,
-	0xf2, 0x3d, 0x98, 0x60, 0xb2, 0x62, 0x15, 0x83, 0xf4, 0xa0, 0x15, 0x03, 0xa0, 0xd8, 0xf4, 0x6f,
-	0xf9, 0x3e, 0xe4, 0x78, 0x2f, 0x22, 0x23, 0x96, 0x19, 0x94, 0xd8, 0x04, 0x43, 0xa7, 0x3f, 0x94,
-	0x0a, 0x1c, 0x4f, 0x16, 0x35, 0xd7, 0xc5, 0xbf, 0x49, 0x70, 0xee, 0x11, 0x72, 0xad, 0xad, 0x56,
-	0x8c, 0x2b, 0xbf, 0xae, 0xf3, 0x99, 0xd0, 0x8b, 0x5f, 0x8e, 0x49, 0x1d, 0xb2, 0x1c, 0x73, 0x01,
-	0x16, 0x7b, 0x33, 0xca, 0xa5, 0xf2, 0x3f, 0x29, 0x72, 0x49, 0x22, 0x57, 0xc6, 0x65, 0xa2, 0x18,
-	0x7f, 0x17, 0x87, 0xb9, 0xe0, 0xbd, 0x38, 0x91, 0x54, 0x81, 0xb7, 0x98, 0x06, 0x22, 0x89, 0x1f,
-	0x43, 0x4a, 0x6c, 0xca, 0x8f, 0x20, 0xab, 0xa6, 0xfc, 0x1e, 0x4c, 0x89, 0xcb, 0xa0, 0x39, 0x4c,
-	0xd0, 0x90, 0x7d, 0x2a, 0xed, 0xbd, 0xac, 0xf9, 0xd7, 0x58, 0xfa, 0xee, 0x43, 0xab, 0xa1, 0x99,
-	0x41, 0xaa, 0xa1, 0x85, 0x36, 0x3a, 0x2b, 0x87, 0xfa, 0x0a, 0x1f, 0x3d, 0xe4, 0xbb, 0xc0, 0x75,
-	0x28, 0xc7, 0xc4, 0x23, 0x32, 0xf2, 0x18, 0x7f, 0x60, 0x0b, 0xcb, 0x88, 0x27, 0x66, 0xe5, 0x1c,
-	0xc9, 0x2f, 0x5d, 0xb5, 0x2f, 0x92, 0x6d, 0x0a, 0x2e, 0x32, 0xa3, 0x4a, 0x84, 0xa4, 0x41, 0x8f,
-	0xd0, 0x19, 0xc8, 0x60, 0x36, 0xa0, 0x18, 0x6d, 0x46, 0x1e, 0xdc, 0x5c, 0x0a, 0x91, 0xe6, 0x63,
-	0x59, 0x85, 0x02, 0x0b, 0x51, 0x43, 0x1c, 0xf6, 0xf2, 0x46, 0x88, 0xcb, 0x4e, 0x06, 0x98, 0xee,
-	0x64, 0x80, 0xdd, 0x34, 0x92, 0xe9, 0xa6, 0x91, 0xa1, 0x8d, 0x41, 0xb9, 0x0c, 0xd5, 0x7e, 0x15,
-	0xc5, 0x75, 0xfb, 0x07, 0x12, 0x2c, 0xac, 0x20, 0x6c, 0xb8, 0xd6, 0xe6, 0x50, 0x47, 0xcd, 0xaf,
-	0xc3, 0xd8, 0xa0, 0x85, 0x8f, 0x5e, 0xcb, 0xaa, 0x82, 0xa2, 0xf2, 0xdb, 0x69, 0x38, 0xd5, 0x05,
-	0x9a, 0x9f, 0xa3, 0xbe, 0x01, 0xc5, 0xf6, 0x23, 0xa7, 0xe1, 0xd8, 0x5b, 0xd6, 0x36, 0x2f, 0xd2,
-	0x5e, 0x49, 0xde, 0x4b, 0xa2, 0xfa, 0x97, 0x29, 0xa2, 0x5a, 0x40, 0xe1, 0x01, 0x79, 0x1b, 0xe6,
-	0x12, 0xde, 0x52, 0x69, 0xfb, 0x3c, 0x63, 0xf8, 0xd2, 0x00, 0x8b, 0xb0, 0x47, 0xdb, 0xfd, 0xa4,
-	0x61, 0xf9, 0x1b, 0x20, 0x37, 0x90, 0x6d, 0x5a, 0xf6, 0xb6, 0xc6, 0x0b, 0xb5, 0x16, 0xc2, 0xe5,
-	0x14, 0x2d, 0xfd, 0x5e, 0xec, 0xbc, 0xc6, 0x1a, 0xc3, 0x11, 0x85, 0x13, 0xba, 0x42, 0xa9, 0x11,
-	0x1a, 0xb4, 0x10, 0x96, 0xbf, 0x09, 0x45, 0x41, 0x9d, 0x9a, 0xb9, 0x4b, 0x7b, 0xd4, 0x08, 0xed,
-	0x6b, 0x3d, 0x69, 0x87, 0x8d, 0x8a, 0xae, 0x50, 0x68, 0x04, 0xa6, 0x5c, 0x64, 0xcb, 0x08, 0x66,
-	0x04, 0xfd, 0xf0, 0xb9, 0x22, 0xd3, 0x4b, 0x13, 0x7c, 0x91, 0xd8, 0xdb, 0xf6, 0x54, 0x23, 0x3e,
-	0xa1, 0xfc, 0x6b, 0x0a, 0xca, 0x2a, 0xff, 0xfe, 0x04, 0xd1, 0x48, 0x8a, 0x1f, 0x5d, 0xfd, 0x4c,
-	0xa4, 0xab, 0x2d, 0x98, 0x09, 0x77, 0x54, 0xb5, 0x34, 0xcb, 0x43, 0x75, 0xa1, 0xc1, 0xab, 0x03,
-	0x75, 0x55, 0xb5, 0x56, 0x3d, 0x54, 0x57, 0xa7, 0xf6, 0x62, 0x63, 0x58, 0xbe, 0x0e, 0xa3, 0x34,
-	0xff, 0x60, 0x9e, 0xd9, 0x3a, 0x3e, 0x3b, 0xad, 0xe8, 0x9e, 0xbe, 0x54, 0x73, 0x36, 0x55, 0x0e,
-	0x2f, 0xdf, 0x86, 0xbc, 0x8d, 0xf6, 0x69, 0x73, 0x12, 0xa7, 0x90, 0xe9, 0x93, 0x42, 0xce, 0x46,
-	0xfb, 0x6a, 0x93, 0x65, 0x2e, 0x2c, 0x6f, 0xc2, 0xd4, 0xa6, 0x8e, 0x51, 0xd4, 0x1b, 0x58, 0xec,
-	0xba, 0xda, 0xf3, 0x63, 0x92, 0x25, 0x1d, 0xa3, 0xb0, 0x31, 0x95, 0x36, 0xa3, 0x43, 0xca, 0x31,
-	0x38, 0x9a, 0xa0, 0x66, 0x1e, 0xbb, 0xfe, 0x96, 0x5e, 0x02, 0xf9, 0xec, 0xe3, 0x60, 0x6f, 0x98,
-	0xb0, 0x04, 0x2d, 0xd6, 0x7f, 0xc6, 0x02, 0xc2, 0xf5, 0xc4, 0xdd, 0x05, 0xbe, 0x34, 0x0a, 0xaa,
-	0x3b, 0x54, 0x1b, 0x89, 0xf4, 0xa0, 0x9d, 0x81, 0xbc, 0x8b, 0xea, 0x8e, 0x87, 0x34, 0xa3, 0xd6,
-	0xc4, 0x1e, 0x72, 0xa9, 0x0d, 0x8d, 0xab, 0x93, 0x6c, 0x74, 0x99, 0x0d, 0xc6, 0x2c, 0x32, 0x15,
-	0xb3, 0x48, 0x65, 0x81, 0xdc, 0x13, 0x93, 0x79, 0xe1, 0xec, 0xfe, 0x9e, 0x04, 0xb3, 0xeb, 0x2d,
-	0xdb, 0x58, 0xdf, 0xd1, 0x5d, 0x93, 0xb7, 0xae, 0x71, 0x3e, 0xcf, 0x40, 0x1e, 0x3b, 0x4d, 0xd7,
-	0x68, 0x6f, 0x83, 0xd9, 0xfc, 0x24, 0x1b, 0x15, 0xdb, 0x38, 0x0a, 0x59, 0x4c, 0x90, 0x45, 0xf3,
-	0x4d, 0x46, 0x1d, 0xa3, 0xbf, 0x57, 0x4d, 0xf9, 0x26, 0x4c, 0xb0, 0x1e, 0x3a, 0xf6, 0x48, 0x9a,
-	0xea, 0xf3, 0x91, 0x14, 0x18, 0x12, 0x19, 0x56, 0x8e, 0xc2, 0x5c, 0x6c, 0x7b, 0x7c, 0xeb, 0x7f,
-	0x37, 0x0a, 0x53, 0x64, 0x4e, 0x44, 0xa7, 0x01, 0x3c, 0xf5, 0x24, 0x4c, 0xf8, 0x2a, 0xe4, 0xdb,
-	0x1e, 0x57, 0x41, 0x0c, 0xad, 0x9a, 0x81, 0xeb, 0x73, 0x2a, 0xf8, 0x39, 0x49, 0x19, 0xc6, 0x44,
-	0xd2, 0x65, 0x99, 0x5a, 0xfc, 0xec, 0xd0, 0x00, 0x90, 0xe9, 0xd0, 0x00, 0x10, 0xef, 0x5b, 0x19,
-	0x3d, 0x5c, 0xdf, 0x4a, 0x52, 0x87, 0xd2, 0x58, 0x62, 0x87, 0x52, 0xf4, 0x89, 0x3c, 0x7b, 0x98,
-	0x27, 0xf2, 0x35, 0xde, 0x4e, 0xdb, 0x7e, 0x85, 0xa2, 0xb4, 0xc6, 0xfb, 0xa4, 0x55, 0x22, 0xc8,
-	0xfe, 0xeb, 0x11, 0xa5, 0x78, 0x03, 0xc6, 0xc4, 0x4b, 0x37, 0xf4, 0xf9, 0xd2, 0x2d, 0x10, 0x82,
-	0x0f, 0xf6, 0x13, 0xe1, 0x07, 0xfb, 0x65, 0xc8, 0xb1, 0x66, 0x4b, 0xfe, 0xc9, 0x54, 0xae, 0xcf,
-	0x4f, 0xa6, 0x26, 0x68, 0x0f, 0x26, 0xff, 0x5a, 0xea, 0x32, 0xd0, 0xaf, 0x9d, 0x68, 0xca, 0x41,
-	0xae, 0x66, 0x99, 0xc8, 0xf6, 0x2c, 0xaf, 0x45, 0x7b, 0x83, 0xc6, 0x55, 0x99, 0xcc, 0x3d, 0xa6,
-	0x53, 0xab, 0x7c, 0x46, 0x7e, 0x0c, 0x85, 0x48, 0x98, 0xe6, 0x6d, 0xaf, 0xd5, 0xc1, 0x02, 0xb4,
-	0x9a, 0x0f, 0x07, 0xe7, 0x4e, 0x51, 0xb1, 0xf0, 0x3c, 0xa3, 0xe2, 0x2c, 0x4c, 0x87, 0xbd, 0x89,
-	0xbb, 0xd9, 0x77, 0x24, 0x38, 0x26, 0xce, 0x49, 0x2f, 0xb9, 0x8b, 0x5e, 0xf9, 0x2f, 0x09, 0x8e,
-	0x27, 0xef, 0x85, 0x1f, 0xd7, 0x76, 0x60, 0xca, 0xd0, 0x8d, 0x1d, 0x14, 0xfe, 0x90, 0x73, 0xe8,
-	0x00, 0x5d, 0xa2, 0x44, 0x83, 0x43, 0xb2, 0x0d, 0xb3, 0xa6, 0xee, 0xe9, 0x54, 0x2d, 0xe1, 0xc5,
-	0x46, 0x86, 0x5c, 0x6c, 0x5a, 0xd0, 0x0d, 0x8e, 0x2a, 0xff, 0x20, 0xc1, 0xbc, 0x60, 0x9d, 0x9b,
-	0xc5, 0x5d, 0x07, 0x07, 0x5f, 0x8f, 0x77, 0x1c, 0xec, 0x69, 0xba, 0x69, 0xba, 0x08, 0x63, 0xa1,
-	0x05, 0x32, 0x76, 0x93, 0x0d, 0x75, 0x0b, 0xd4, 0xbd, 0x53, 0x49, 0x87, 0xc3, 0x4d, 0x7a, 0xf8,
-	0xc3, 0x8d, 0xf2, 0xcf, 0x01, 0x03, 0x0b, 0x71, 0xc6, 0x75, 0xfa, 0x0a, 0x4c, 0xd2, 0x7d, 0x62,
-	0xcd, 0x6e, 0xd6, 0x37, 0x79, 0x1a, 0xca, 0xa8, 0x39, 0x36, 0xf8, 0x90, 0x8e, 0xc9, 0xc7, 0x60,
-	0x5c, 0x30, 0xc7, 0x5a, 0x1a, 0x32, 0x6a, 0x96, 0x73, 0x87, 0xe5, 0x27, 0x50, 0x68, 0xb3, 0x47,
-	0x55, 0xd9, 0xf5, 0xeb, 0x54, 0x1f, 0x96, 0xb0, 0xe0, 0x77, 0xb5, 0x2c, 0x13, 0x3c, 0xea, 0x3c,
-	0x79, 0x3b, 0x34, 0x46, 0xe3, 0x10, 0x17, 0x3b, 0x6b, 0xd9, 0x12, 0x3f, 0xef, 0xa5, 0xb3, 0xe9,
-	0x62, 0x46, 0xa9, 0x42, 0x69, 0xb9, 0xe6, 0x60, 0x44, 0x93, 0x98, 0x50, 0x58, 0x50, 0x1b, 0x52,
-	0x48, 0x1b, 0xca, 0x34, 0xc8, 0x41, 0x78, 0xee, 0x87, 0xaf, 0x41, 0xe1, 0x0e, 0xf2, 0xfa, 0xa5,
-	0xf1, 0x3e, 0x14, 0xdb, 0xd0, 0x5c, 0x90, 0xf7, 0x01, 0x38, 0x38, 0x09, 0x1e, 0xcc, 0x27, 0x2e,
-	0xf6, 0x63, 0xa6, 0x94, 0x0c, 0x65, 0x9d, 0x09, 0x99, 0xc6, 0x8b, 0x7f, 0x94, 0xa0, 0xc4, 0x5e,
-	0x7b, 0x82, 0x05, 0xc8, 0xce, 0x5b, 0x92, 0x6f, 0x43, 0x96, 0x9c, 0x43, 0xb6, 0x49, 0x58, 0x1c,
-	0xa1, 0x3d, 0xf5, 0x17, 0xba, 0x77, 0xec, 0xb3, 0x77, 0x5a, 0x86, 0xa1, 0xfa, 0xb8, 0xc1, 0xee,
-	0xb9, 0x54, 0xa8, 0x7b, 0x6e, 0x15, 0x0a, 0x7b, 0x16, 0xb6, 0x36, 0xad, 0x1a, 0xed, 0x6e, 0x19,
-	0xa4, 0x2f, 0x2b, 0xdf, 0x46, 0xa4, 0xc7, 0x8e, 0x69, 0x90, 0x83, 0xbc, 0x71, 0x15, 0x7c, 0x28,
-	0xc1, 0x89, 0x3b, 0xc8, 0x53, 0xdb, 0xdf, 0xa8, 0xf3, 0x9e, 0x48, 0xff, 0xcc, 0x74, 0x1f, 0x46,
-	0x69, 0xb3, 0x2a, 0x71, 0xc0, 0x54, 0x47, 0x03, 0x0b, 0x7c, 0xe4, 0xce, 0xaa, 0xe1, 0xfe, 0x4f,
-	0xda, 0xd6, 0xaa, 0x72, 0x1a, 0xc4, 0x2d, 0xf9, 0xd1, 0x8b, 0x76, 0x5d, 0xf1, 0x73, 0xca, 0x04,
-	0x1f, 0x23, 0x96, 0xa9, 0x7c, 0x7f, 0x04, 0x2a, 0x9d, 0xb6, 0xc4, 0xd5, 0xfe, 0x2d, 0xc8, 0x33,
-	0x95, 0xf8, 0xad, 0x9e, 0x6c, 0x6f, 0xef, 0xf6, 0xd9, 0x65, 0xd4, 0x9d, 0x3c, 0x33, 0x0e, 0x31,
-	0xca, 0x1a, 0x54, 0x99, 0xbf, 0x8a, 0xb1, 0xf9, 0x16, 0xc8, 0x71, 0xa0, 0x60, 0xb3, 0x68, 0x86,
-	0x35, 0x8b, 0x3e, 0x08, 0x37, 0x8b, 0xbe, 0x3e, 0xa0, 0xec, 0xfc, 0x9d, 0xb5, 0xfb, 0x47, 0x95,
-	0x0f, 0x60, 0xe1, 0x0e, 0xf2, 0x56, 0xee, 0xbf, 0xdd, 0x45, 0x67, 0x8f, 0xf8, 0x47, 0x3f, 0xc4,
-	0x2b, 0x84, 0x6c, 0x06, 0x5d, 0xdb, 0xbf, 0x58, 0xd2, 0xef, 0x80, 0xc8, 0x5f, 0x58, 0xf9, 0x55,
-	0x09, 0x4e, 0x75, 0x59, 0x9c, 0x6b, 0xe7, 0x7d, 0x28, 0x05, 0xc8, 0xf2, 0x9e, 0x2c, 0x29, 0x7a,
-	0x79, 0xee, 0x7b, 0x13, 0x6a, 0xd1, 0x0d, 0x0f, 0x60, 0xe5, 0xbb, 0x12, 0x4c, 0xd3, 0xc6, 0x5a,
-	0x11, 0x8d, 0x07, 0xc8, 0xdc, 0x5f, 0x8b, 0x56, 0x60, 0xbe, 0xd8, 0xb3, 0x02, 0x93, 0xb4, 0x54,
-	0xbb, 0xea, 0xb2, 0x0b, 0x33, 0x11, 0x00, 0x2e, 0x07, 0x15, 0xb2, 0x91, 0x2e, 0xb8, 0x2f, 0x0d,
-	0xba, 0x14, 0x6f, 0x45, 0xf3, 0xe9, 0x28, 0xbf, 0x25, 0xc1, 0xb4, 0x8a, 0xf4, 0x46, 0xa3, 0xc6,
-	0x2a, 0xa5, 0x78, 0x00, 0xce, 0xd7, 0xa3, 0x9c, 0x27, 0x77, 0xd2, 0x07, 0xff, 0x9f, 0x03, 0x53,
-	0x47, 0x7c, 0xb9, 0x36, 0xf7, 0x73, 0x30, 0x13, 0x01, 0xe0, 0x3b, 0xfd, 0xd3, 0x11, 0x98, 0x61,
-	0xb6, 0x12, 0xb5, 0xce, 0x5b, 0x90, 0xf6, 0x3f, 0x97, 0xc8, 0x07, 0x4b, 0x1d, 0x49, 0x11, 0x73,
-	0x05, 0xe9, 0xe6, 0x7d, 0xe4, 0x79, 0xc8, 0xa5, 0xdd, 0x79, 0xb4, 0x93, 0x93, 0xa2, 0x77, 0x4b,
-	0xfe, 0xf1, 0x7b, 0x5e, 0x2a, 0xe9, 0x9e, 0xf7, 0x3a, 0x94, 0x2d, 0x9b, 0x40, 0x58, 0x7b, 0x48,
-	0x43, 0xb6, 0x1f, 0x4e, 0xda, 0x65, 0xcb, 0x19, 0x7f, 0xfe, 0x96, 0x2d, 0x9c, 0x7d, 0xd5, 0x94,
-	0x2f, 0x40, 0xa9, 0xae, 0x1f, 0x58, 0xf5, 0x66, 0x5d, 0x6b, 0x10, 0x78, 0x6c, 0x7d, 0xc0, 0xfe,
-	0x19, 0x43, 0x46, 0x2d, 0xf0, 0x89, 0x35, 0x7d, 0x1b, 0xad, 0x5b, 0x1f, 0x20, 0xf9, 0x2c, 0x14,
-	0xe8, 0x77, 0x14, 0x14, 0x90, 0xb5, 0xfd, 0x8f, 0xd2, 0xb6, 0x7f, 0xfa, 0x79, 0x05, 0x01, 0x63,
-	0xdf, 0x39, 0x7e, 0x3c, 0x42, 0x3f, 0x93, 0x0f, 0xc9, 0x8b, 0x1b, 0xd2, 0x73, 0x12, 0x58, 0xa2,
-	0x5f, 0x8e, 0x3c, 0x47, 0xbf, 0x4c, 0xe2, 0x35, 0x95, 0xc0, 0xab, 0x5c, 0x87, 0xd9, 0xd8, 0x4e,
-	0x58, 0x0a, 0x4f, 0x0f, 0x17, 0xab, 0xa6, 0xa3, 0x5b, 0xa2, 0x79, 0xfd, 0x9f, 0x24, 0x98, 0x5b,
-	0x6b, 0xba, 0xdb, 0xe8, 0xe7, 0xd1, 0x18, 0x95, 0x79, 0x28, 0xc7, 0x99, 0x13, 0x6d, 0x7b, 0x23,
-	0x30, 0xf7, 0x00, 0xfd, 0x9c, 0x72, 0xfe, 0x42, 0xdc, 0x70, 0x09, 0xca, 0x71, 0x81, 0x71, 0x3f,
-	0x4c, 0xa0, 0x21, 0x25, 0xd1, 0xf8, 0x3e, 0xfd, 0x2a, 0x71, 0xcb, 0x45, 0x78, 0x27, 0x58, 0x8d,
-	0x1d, 0x24, 0x56, 0xbf, 0x17, 0x8d, 0xd5, 0x5f, 0xed, 0x33, 0x56, 0x77, 0x5c, 0xb5, 0x1d, 0xb2,
-	0xe9, 0x87, 0x8a, 0x49, 0x70, 0xdc, 0x68, 0xbe, 0x27, 0xc1, 0x85, 0x3b, 0xc8, 0x46, 0xae, 0xee,
-	0xa1, 0xfb, 0x3a, 0x16, 0x3d, 0xcd, 0x11, 0x7f, 0xc3, 0x2f, 0xe3, 0xb6, 0x7c, 0x11, 0x5e, 0xed,
-	0x6b, 0x67, 0x9c, 0x93, 0xdb, 0x70, 0x2c, 0x7c, 0xd4, 0x0b, 0x97, 0x03, 0xcf, 0x41, 0x21, 0x5c,
-	0x95, 0x64, 0xc7, 0x94, 0x71, 0x35, 0x1f, 0x2a, 0x4b, 0x62, 0xa5, 0x09, 0xc7, 0x93, 0xe9, 0x70,
-	0xc3, 0x78, 0x07, 0x46, 0xd9, 0xd5, 0x8d, 0x1f, 0x73, 0xde, 0xe8, 0xf3, 0x1c, 0xca, 0x2f, 0x33,
-	0x51, 0xb2, 0x9c, 0x98, 0xf2, 0x97, 0xa3, 0x30, 0x9b, 0x0c, 0xd2, 0xed, 0x52, 0xf2, 0x45, 0x98,
-	0xab, 0xeb, 0x07, 0x5a, 0x34, 0xc0, 0xb6, 0xbf, 0x24, 0x9c, 0xae, 0xeb, 0x07, 0xd1, 0xe0, 0x69,
-	0xca, 0xf7, 0xa1, 0xc8, 0x28, 0xd6, 0x1c, 0x43, 0xaf, 0xf5, 0x5b, 0xde, 0x1c, 0x25, 0x77, 0x8d,
-	0xb2, 0xa4, 0xb2, 0xf3, 0xf8, 0x7d, 0x82, 0x4a, 0x8b, 0x60, 0x1f, 0xc4, 0x45, 0xcb, 0x42, 0xfb,
-	0xdb, 0x43, 0x89, 0xa6, 0xaa, 0x86, 0x14, 0xc3, 0xce, 0xe6, 0x11, 0x6d, 0xc9, 0xbf, 0x26, 0xc1,
-	0xd4, 0x8e, 0x6e, 0x9b, 0xce, 0x1e, 0xbf, 0x65, 0x50, 0x33, 0x24, 0x37, 0xd9, 0x41, 0xbe, 0x60,
-	0xeb, 0xb0, 0x81, 0xbb, 0x9c, 0xb0, 0x7f, 0x89, 0xe6, 0x9b, 0x90, 0x77, 0x62, 0x13, 0x72, 0x03,
-	0x4e, 0x27, 0x6a, 0x22, 0x7a, 0xa5, 0xeb, 0xb7, 0x52, 0xba, 0x10, 0x57, 0xdc, 0xa3, 0xd0, 0x25,
-	0x6f, 0xfe, 0xbb, 0x12, 0x4c, 0x25, 0x88, 0x28, 0xe1, 0x33, 0xb6, 0x27, 0xe1, 0x9b, 0xc9, 0x9d,
-	0xa1, 0xa4, 0xb2, 0x86, 0x5c, 0xbe, 0x5e, 0xe0, 0xa6, 0x32, 0xff, 0x6d, 0x09, 0xe6, 0x3a, 0x88,
-	0x2b, 0x61, 0x43, 0x6a, 0x78, 0x43, 0x5f, 0xe9, 0x73, 0x43, 0xb1, 0x05, 0xe8, 0x39, 0x20, 0x70,
-	0x5f, 0x7a, 0x17, 0x66, 0x12, 0x61, 0xe4, 0xb7, 0xe0, 0xb8, 0x6f, 0x25, 0x49, 0xce, 0x22, 0x51,
-	0x67, 0x39, 0x2a, 0x60, 0x62, 0x1e, 0xa3, 0xfc, 0xa1, 0x04, 0x0b, 0xbd, 0xe4, 0x21, 0x2b, 0x30,
-	0xa9, 0x1b, 0xbb, 0xc8, 0x8c, 0x90, 0x9d, 0xa0, 0x83, 0xdc, 0xf5, 0x9e, 0xc0, 0x7c, 0x00, 0x26,
-	0x6a, 0x1d, 0xfd, 0x7e, 0xf9, 0x35, 0xe7, 0x93, 0x0c, 0x1b, 0x85, 0xf2, 0x1b, 0x12, 0xcc, 0xab,
-	0x68, 0xb3, 0x69, 0xd5, 0xcc, 0x97, 0x5d, 0xed, 0x3c, 0x41, 0x12, 0x63, 0xc2, 0x4e, 0x78, 0xbc,
-	0xfe, 0xe1, 0x08, 0x9c, 0x09, 0xb7, 0x34, 0xb6, 0x59, 0x61, 0x4f, 0xf2, 0x2f, 0xe3, 0x1f, 0x9d,
-	0xac, 0xc1, 0x54, 0xf0, 0x75, 0x8c, 0xff, 0x5b, 0x8a, 0xbe, 0xdf, 0x7e, 0x4a, 0x81, 0xa7, 0x30,
-	0xf6, 0x3f, 0x28, 0x42, 0x14, 0x69, 0x63, 0xe7, 0x60, 0xa5, 0x1d, 0x9f, 0x22, 0xad, 0xa9, 0x51,
-	0x1d, 0x2f, 0xc2, 0xd9, 0x5e, 0x82, 0xe3, 0x32, 0xfe, 0x7d, 0x09, 0x2a, 0xef, 0x34, 0xcc, 0x21,
-	0x5b, 0x95, 0x7f, 0x31, 0x7a, 0x3e, 0xe9, 0xfd, 0x39, 0x40, 0xf7, 0x45, 0xdb, 0xc7, 0x93, 0x6f,
-	0xc1, 0xc9, 0x8e, 0xa0, 0x7e, 0x0b, 0x43, 0xf4, 0x66, 0xfd, 0xd5, 0xc3, 0x2f, 0x1f, 0xbb, 0x63,
-	0xff, 0x89, 0x04, 0x8b, 0xeb, 0x9e, 0x8b, 0xf4, 0x7a, 0xfb, 0x22, 0xde, 0xb1, 0xd4, 0xd2, 0x80,
-	0x59, 0xdc, 0xb2, 0x8d, 0x50, 0x04, 0xe9, 0x5d, 0xa1, 0x8f, 0x5c, 0x65, 0xd6, 0x5b, 0xb6, 0x11,
-	0x09, 0x22, 0xe8, 0xee, 0x11, 0x75, 0x1a, 0x27, 0x8c, 0x2f, 0xe5, 0x00, 0x74, 0xcf, 0x73, 0xad,
-	0xcd, 0xa6, 0x87, 0x30, 0x39, 0xac, 0x9d, 0xef, 0x63, 0xb3, 0x5c, 0x70, 0x4f, 0x02, 0x5f, 0x47,
-	0x4b, 0x51, 0xbd, 0x75, 0xde, 0x5f, 0x17, 0xd2, 0x77, 0x8f, 0xb4, 0xbf, 0x9e, 0x8e, 0x6c, 0xed,
-	0x8f, 0x24, 0x50, 0x82, 0xff, 0xb4, 0xc1, 0x97, 0x39, 0x53, 0xc5, 0x00, 0xd6, 0xf6, 0x24, 0x6a,
-	0x6d, 0xcb, 0x03, 0xfd, 0xb7, 0x88, 0xe4, 0x85, 0xdb, 0x16, 0xf7, 0xeb, 0x12, 0xbc, 0xd2, 0x15,
-	0xde, 0x2f, 0x6c, 0x45, 0xcd, 0x6e, 0x65, 0xb8, 0x7d, 0x44, 0x4d, 0x6f, 0xa9, 0xf1, 0xd1, 0x27,
-	0x95, 0x23, 0x1f, 0x7f, 0x52, 0x39, 0xf2, 0xd3, 0x4f, 0x2a, 0xd2, 0xaf, 0x3c, 0xab, 0x48, 0x3f,
-	0x78, 0x56, 0x91, 0xfe, 0xe6, 0x59, 0x45, 0xfa, 0xe8, 0x59, 0x45, 0xfa, 0x97, 0x67, 0x15, 0xe9,
-	0x27, 0xcf, 0x2a, 0x47, 0x7e, 0xfa, 0xac, 0x22, 0x7d, 0xf8, 0x69, 0xe5, 0xc8, 0x47, 0x9f, 0x56,
-	0x8e, 0x7c, 0xfc, 0x69, 0xe5, 0xc8, 0x7b, 0x37, 0xb6, 0x9d, 0xf6, 0x3e, 0x2c, 0xa7, 0xeb, 0xff,
-	0xed, 0xfd, 0x85, 0xf0, 0xc8, 0xe6, 0x28, 0x8d, 0x32, 0xd7, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x86, 0xec, 0xaf, 0x23, 0xf6, 0x57, 0x00, 0x00,
+func (m *ListFaultInjectionScenariosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFaultInjectionScenariosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFaultInjectionScenariosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListFaultInjectionScenariosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFaultInjectionScenariosRequest.Merge(m, src)
+}
+func (m *ListFaultInjectionScenariosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFaultInjectionScenariosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFaultInjectionScenariosRequest.DiscardUnknown(m)
 }
 
-func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_ListFaultInjectionScenariosRequest proto.InternalMessageInfo
+
+func (m *ListFaultInjectionScenariosRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
+	return 0
+}
 
-	that1, ok := that.(*StartWorkflowExecutionRequest)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListFaultInjectionScenariosResponse struct {
+	Scenarios []*v116.FaultInjectionScenario `protobuf:"bytes,1,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	Address   string                         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ListFaultInjectionScenariosResponse) Reset()      { *m = ListFaultInjectionScenariosResponse{} }
+func (*ListFaultInjectionScenariosResponse) ProtoMessage() {}
+func (*ListFaultInjectionScenariosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{110}
+}
+func (m *ListFaultInjectionScenariosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFaultInjectionScenariosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFaultInjectionScenariosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.StartRequest.Equal(that1.StartRequest) {
-		return false
-	}
-	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
-		return false
+}
+func (m *ListFaultInjectionScenariosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFaultInjectionScenariosResponse.Merge(m, src)
+}
+func (m *ListFaultInjectionScenariosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFaultInjectionScenariosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFaultInjectionScenariosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFaultInjectionScenariosResponse proto.InternalMessageInfo
+
+func (m *ListFaultInjectionScenariosResponse) GetScenarios() []*v116.FaultInjectionScenario {
+	if m != nil {
+		return m.Scenarios
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return nil
+}
+
+func (m *ListFaultInjectionScenariosResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if that1.WorkflowExecutionExpirationTime == nil {
-		if this.WorkflowExecutionExpirationTime != nil {
-			return false
+	return ""
+}
+
+// (-- api-linter: core::0134::request-mask-required=disabled
+//
+//	aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+//
+// (-- api-linter: core::0134::request-resource-required=disabled
+//
+//	aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+type UpdateFaultInjectionScenarioRequest struct {
+	ShardId  int32          `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Name     string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled  bool           `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Duration *time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty"`
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) Reset()      { *m = UpdateFaultInjectionScenarioRequest{} }
+func (*UpdateFaultInjectionScenarioRequest) ProtoMessage() {}
+func (*UpdateFaultInjectionScenarioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{111}
+}
+func (m *UpdateFaultInjectionScenarioRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateFaultInjectionScenarioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateFaultInjectionScenarioRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
-		return false
-	}
-	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
-		return false
+		return b[:n], nil
 	}
-	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
-		return false
+}
+func (m *UpdateFaultInjectionScenarioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateFaultInjectionScenarioRequest.Merge(m, src)
+}
+func (m *UpdateFaultInjectionScenarioRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateFaultInjectionScenarioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateFaultInjectionScenarioRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateFaultInjectionScenarioRequest proto.InternalMessageInfo
+
+func (m *UpdateFaultInjectionScenarioRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
-		return false
+	return 0
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
-		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
-			return false
-		}
-	} else if this.FirstWorkflowTaskBackoff != nil {
-		return false
-	} else if that1.FirstWorkflowTaskBackoff != nil {
-		return false
+	return ""
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return true
+	return false
 }
-func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateFaultInjectionScenarioRequest) GetDuration() *time.Duration {
+	if m != nil {
+		return m.Duration
 	}
+	return nil
+}
 
-	that1, ok := that.(*StartWorkflowExecutionResponse)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateFaultInjectionScenarioResponse struct {
+	Scenario *v116.FaultInjectionScenario `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Address  string                       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *UpdateFaultInjectionScenarioResponse) Reset()      { *m = UpdateFaultInjectionScenarioResponse{} }
+func (*UpdateFaultInjectionScenarioResponse) ProtoMessage() {}
+func (*UpdateFaultInjectionScenarioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{112}
+}
+func (m *UpdateFaultInjectionScenarioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateFaultInjectionScenarioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateFaultInjectionScenarioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RunId != that1.RunId {
-		return false
-	}
-	if !this.Clock.Equal(that1.Clock) {
-		return false
-	}
-	if !this.EagerWorkflowTask.Equal(that1.EagerWorkflowTask) {
-		return false
-	}
-	return true
 }
-func (this *GetMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *UpdateFaultInjectionScenarioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateFaultInjectionScenarioResponse.Merge(m, src)
+}
+func (m *UpdateFaultInjectionScenarioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateFaultInjectionScenarioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateFaultInjectionScenarioResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateFaultInjectionScenarioResponse proto.InternalMessageInfo
+
+func (m *UpdateFaultInjectionScenarioResponse) GetScenario() *v116.FaultInjectionScenario {
+	if m != nil {
+		return m.Scenario
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetMutableStateRequest)
+func (m *UpdateFaultInjectionScenarioResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type MigrateHistoryShardRequest struct {
+	// Source shard whose executions are copied to their target shards.
+	ShardId          int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TargetShardCount int32  `protobuf:"varint,2,opt,name=target_shard_count,json=targetShardCount,proto3" json:"target_shard_count,omitempty"`
+	PageSize         int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken    []byte `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *MigrateHistoryShardRequest) Reset()      { *m = MigrateHistoryShardRequest{} }
+func (*MigrateHistoryShardRequest) ProtoMessage() {}
+func (*MigrateHistoryShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{113}
+}
+func (m *MigrateHistoryShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateHistoryShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateHistoryShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateHistoryShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateHistoryShardRequest.Merge(m, src)
+}
+func (m *MigrateHistoryShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateHistoryShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateHistoryShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateHistoryShardRequest proto.InternalMessageInfo
+
+func (m *MigrateHistoryShardRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *MigrateHistoryShardRequest) GetTargetShardCount() int32 {
+	if m != nil {
+		return m.TargetShardCount
+	}
+	return 0
+}
+
+func (m *MigrateHistoryShardRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *MigrateHistoryShardRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type MigrateHistoryShardResponse struct {
+	// Empty once all executions of the source shard are migrated.
+	NextPageToken          []byte `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	MigratedExecutionCount int64  `protobuf:"varint,2,opt,name=migrated_execution_count,json=migratedExecutionCount,proto3" json:"migrated_execution_count,omitempty"`
+}
+
+func (m *MigrateHistoryShardResponse) Reset()      { *m = MigrateHistoryShardResponse{} }
+func (*MigrateHistoryShardResponse) ProtoMessage() {}
+func (*MigrateHistoryShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{114}
+}
+func (m *MigrateHistoryShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateHistoryShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateHistoryShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateHistoryShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateHistoryShardResponse.Merge(m, src)
+}
+func (m *MigrateHistoryShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateHistoryShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateHistoryShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateHistoryShardResponse proto.InternalMessageInfo
+
+func (m *MigrateHistoryShardResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+func (m *MigrateHistoryShardResponse) GetMigratedExecutionCount() int64 {
+	if m != nil {
+		return m.MigratedExecutionCount
+	}
+	return 0
+}
+
+type CopyMigratedExecutionRequest struct {
+	// Target shard the execution is copied to.
+	ShardId         int32                     `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	MutableState    *v19.WorkflowMutableState `protobuf:"bytes,2,opt,name=mutable_state,json=mutableState,proto3" json:"mutable_state,omitempty"`
+	DbRecordVersion int64                     `protobuf:"varint,3,opt,name=db_record_version,json=dbRecordVersion,proto3" json:"db_record_version,omitempty"`
+	IsCurrent       bool                      `protobuf:"varint,4,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+}
+
+func (m *CopyMigratedExecutionRequest) Reset()      { *m = CopyMigratedExecutionRequest{} }
+func (*CopyMigratedExecutionRequest) ProtoMessage() {}
+func (*CopyMigratedExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{115}
+}
+func (m *CopyMigratedExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CopyMigratedExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CopyMigratedExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CopyMigratedExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyMigratedExecutionRequest.Merge(m, src)
+}
+func (m *CopyMigratedExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CopyMigratedExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyMigratedExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyMigratedExecutionRequest proto.InternalMessageInfo
+
+func (m *CopyMigratedExecutionRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *CopyMigratedExecutionRequest) GetMutableState() *v19.WorkflowMutableState {
+	if m != nil {
+		return m.MutableState
+	}
+	return nil
+}
+
+func (m *CopyMigratedExecutionRequest) GetDbRecordVersion() int64 {
+	if m != nil {
+		return m.DbRecordVersion
+	}
+	return 0
+}
+
+func (m *CopyMigratedExecutionRequest) GetIsCurrent() bool {
+	if m != nil {
+		return m.IsCurrent
+	}
+	return false
+}
+
+type CopyMigratedExecutionResponse struct {
+}
+
+func (m *CopyMigratedExecutionResponse) Reset()      { *m = CopyMigratedExecutionResponse{} }
+func (*CopyMigratedExecutionResponse) ProtoMessage() {}
+func (*CopyMigratedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{116}
+}
+func (m *CopyMigratedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CopyMigratedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CopyMigratedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CopyMigratedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyMigratedExecutionResponse.Merge(m, src)
+}
+func (m *CopyMigratedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CopyMigratedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyMigratedExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyMigratedExecutionResponse proto.InternalMessageInfo
+
+type ImportWorkflowExecutionRequest struct {
+	NamespaceId string                        `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Bundle      *v116.WorkflowExecutionBundle `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (m *ImportWorkflowExecutionRequest) Reset()      { *m = ImportWorkflowExecutionRequest{} }
+func (*ImportWorkflowExecutionRequest) ProtoMessage() {}
+func (*ImportWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{117}
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *ImportWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *ImportWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ImportWorkflowExecutionRequest) GetBundle() *v116.WorkflowExecutionBundle {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
+type ImportWorkflowExecutionResponse struct {
+}
+
+func (m *ImportWorkflowExecutionResponse) Reset()      { *m = ImportWorkflowExecutionResponse{} }
+func (*ImportWorkflowExecutionResponse) ProtoMessage() {}
+func (*ImportWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{118}
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *ImportWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportWorkflowExecutionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
+	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
+	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
+	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
+	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v110.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
+	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
+	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
+	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionResponse")
+	proto.RegisterType((*UpdateActivityOptionsRequest)(nil), "temporal.server.api.historyservice.v1.UpdateActivityOptionsRequest")
+	proto.RegisterType((*UpdateActivityOptionsResponse)(nil), "temporal.server.api.historyservice.v1.UpdateActivityOptionsResponse")
+	proto.RegisterType((*ResetActivityRequest)(nil), "temporal.server.api.historyservice.v1.ResetActivityRequest")
+	proto.RegisterType((*ResetActivityResponse)(nil), "temporal.server.api.historyservice.v1.ResetActivityResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
+	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
+	proto.RegisterType((*VerifyFirstWorkflowTaskScheduledRequest)(nil), "temporal.server.api.historyservice.v1.VerifyFirstWorkflowTaskScheduledRequest")
+	proto.RegisterType((*VerifyFirstWorkflowTaskScheduledResponse)(nil), "temporal.server.api.historyservice.v1.VerifyFirstWorkflowTaskScheduledResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*VerifyChildExecutionCompletionRecordedRequest)(nil), "temporal.server.api.historyservice.v1.VerifyChildExecutionCompletionRecordedRequest")
+	proto.RegisterType((*VerifyChildExecutionCompletionRecordedResponse)(nil), "temporal.server.api.historyservice.v1.VerifyChildExecutionCompletionRecordedResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*ReplicateWorkflowStateRequest)(nil), "temporal.server.api.historyservice.v1.ReplicateWorkflowStateRequest")
+	proto.RegisterType((*ReplicateWorkflowStateResponse)(nil), "temporal.server.api.historyservice.v1.ReplicateWorkflowStateResponse")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
+	proto.RegisterType((*GetShardRequest)(nil), "temporal.server.api.historyservice.v1.GetShardRequest")
+	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.historyservice.v1.GetShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v115.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*GenerateLastHistoryReplicationTasksRequest)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksRequest")
+	proto.RegisterType((*GenerateLastHistoryReplicationTasksResponse)(nil), "temporal.server.api.historyservice.v1.GenerateLastHistoryReplicationTasksResponse")
+	proto.RegisterType((*GetReplicationStatusRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusRequest")
+	proto.RegisterType((*GetReplicationStatusResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationStatusResponse")
+	proto.RegisterType((*ShardReplicationStatus)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus")
+	proto.RegisterMapType((map[string]*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.HandoverNamespacesEntry")
+	proto.RegisterMapType((map[string]*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatus.RemoteClustersEntry")
+	proto.RegisterType((*HandoverNamespaceInfo)(nil), "temporal.server.api.historyservice.v1.HandoverNamespaceInfo")
+	proto.RegisterType((*ShardReplicationStatusPerCluster)(nil), "temporal.server.api.historyservice.v1.ShardReplicationStatusPerCluster")
+	proto.RegisterType((*RebuildMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateRequest")
+	proto.RegisterType((*RebuildMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RebuildMutableStateResponse")
+	proto.RegisterType((*DeleteWorkflowVisibilityRecordRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowVisibilityRecordRequest")
+	proto.RegisterType((*DeleteWorkflowVisibilityRecordResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowVisibilityRecordResponse")
+	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionRequest")
+	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionResponse")
+	proto.RegisterType((*StreamWorkflowReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowReplicationMessagesRequest")
+	proto.RegisterType((*StreamWorkflowReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowReplicationMessagesResponse")
+	proto.RegisterType((*StreamWorkflowExecutionHistoryRequest)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowExecutionHistoryRequest")
+	proto.RegisterType((*StreamWorkflowExecutionHistoryResponse)(nil), "temporal.server.api.historyservice.v1.StreamWorkflowExecutionHistoryResponse")
+	proto.RegisterType((*PollWorkflowExecutionUpdateRequest)(nil), "temporal.server.api.historyservice.v1.PollWorkflowExecutionUpdateRequest")
+	proto.RegisterType((*PollWorkflowExecutionUpdateResponse)(nil), "temporal.server.api.historyservice.v1.PollWorkflowExecutionUpdateResponse")
+	proto.RegisterType((*ListFaultInjectionScenariosRequest)(nil), "temporal.server.api.historyservice.v1.ListFaultInjectionScenariosRequest")
+	proto.RegisterType((*ListFaultInjectionScenariosResponse)(nil), "temporal.server.api.historyservice.v1.ListFaultInjectionScenariosResponse")
+	proto.RegisterType((*UpdateFaultInjectionScenarioRequest)(nil), "temporal.server.api.historyservice.v1.UpdateFaultInjectionScenarioRequest")
+	proto.RegisterType((*UpdateFaultInjectionScenarioResponse)(nil), "temporal.server.api.historyservice.v1.UpdateFaultInjectionScenarioResponse")
+	proto.RegisterType((*MigrateHistoryShardRequest)(nil), "temporal.server.api.historyservice.v1.MigrateHistoryShardRequest")
+	proto.RegisterType((*MigrateHistoryShardResponse)(nil), "temporal.server.api.historyservice.v1.MigrateHistoryShardResponse")
+	proto.RegisterType((*CopyMigratedExecutionRequest)(nil), "temporal.server.api.historyservice.v1.CopyMigratedExecutionRequest")
+	proto.RegisterType((*CopyMigratedExecutionResponse)(nil), "temporal.server.api.historyservice.v1.CopyMigratedExecutionResponse")
+	proto.RegisterType((*ImportWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ImportWorkflowExecutionRequest")
+	proto.RegisterType((*ImportWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ImportWorkflowExecutionResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+}
+
+var fileDescriptor_b8c78c1d460a3711 = []byte{
+	// 5480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5b, 0x6c, 0x1c, 0xd7,
+	0x75, 0x1a, 0xee, 0x2e, 0xb9, 0x3c, 0x24, 0x97, 0xcb, 0xe1, 0x6b, 0x45, 0x4a, 0x2b, 0x6a, 0x24,
+	0x4a, 0xf4, 0x43, 0x2b, 0x5b, 0xf2, 0x2b, 0x72, 0x1c, 0x47, 0xa4, 0x5e, 0x2b, 0x48, 0x36, 0x3d,
+	0xa4, 0x25, 0xc7, 0x89, 0xb2, 0x1e, 0xce, 0x5c, 0x92, 0x63, 0xed, 0xce, 0xac, 0xe7, 0xce, 0x52,
+	0x5c, 0xf7, 0xc3, 0x05, 0x82, 0xbe, 0xf2, 0x51, 0x18, 0xe8, 0x4f, 0x12, 0xa4, 0x2d, 0xd0, 0xa2,
+	0x4d, 0x90, 0xa2, 0x28, 0x8a, 0x7e, 0x04, 0x29, 0xd0, 0x9f, 0x16, 0x08, 0x8a, 0x7e, 0x19, 0xfd,
+	0x69, 0xd0, 0x02, 0x4d, 0x2d, 0xa3, 0x68, 0xfa, 0x02, 0xf2, 0xd7, 0xa2, 0xcd, 0x47, 0x71, 0x5f,
+	0xb3, 0xf3, 0xde, 0x5d, 0xae, 0x14, 0xc9, 0xa9, 0xff, 0xb8, 0xf7, 0x9e, 0x73, 0xee, 0x79, 0xdd,
+	0x73, 0xef, 0x3d, 0xf7, 0xdc, 0x21, 0x7c, 0xde, 0x45, 0x8d, 0xa6, 0xed, 0x68, 0xf5, 0xb3, 0x18,
+	0x39, 0x7b, 0xc8, 0x39, 0xab, 0x35, 0xcd, 0xb3, 0xbb, 0x26, 0x76, 0x6d, 0xa7, 0x4d, 0x5a, 0x4c,
+	0x1d, 0x9d, 0xdd, 0x7b, 0xf6, 0xac, 0x83, 0xde, 0x6b, 0x21, 0xec, 0xd6, 0x1c, 0x84, 0x9b, 0xb6,
+	0x85, 0x51, 0xa5, 0xe9, 0xd8, 0xae, 0x2d, 0x2f, 0x0b, 0xec, 0x0a, 0xc3, 0xae, 0x68, 0x4d, 0xb3,
+	0x12, 0xc4, 0xae, 0xec, 0x3d, 0xbb, 0x50, 0xde, 0xb1, 0xed, 0x9d, 0x3a, 0x3a, 0x4b, 0x91, 0xb6,
+	0x5a, 0xdb, 0x67, 0x8d, 0x96, 0xa3, 0xb9, 0xa6, 0x6d, 0x31, 0x32, 0x0b, 0xc7, 0xc2, 0xfd, 0xae,
+	0xd9, 0x40, 0xd8, 0xd5, 0x1a, 0x4d, 0x0e, 0x70, 0xdc, 0x40, 0x4d, 0x64, 0x19, 0xc8, 0xd2, 0x4d,
+	0x84, 0xcf, 0xee, 0xd8, 0x3b, 0x36, 0x6d, 0xa7, 0x7f, 0x71, 0x90, 0x93, 0x9e, 0x20, 0x44, 0x02,
+	0xdd, 0x6e, 0x34, 0x6c, 0x8b, 0x70, 0xde, 0x40, 0x18, 0x6b, 0x3b, 0x9c, 0xe1, 0x85, 0xe5, 0x00,
+	0x14, 0xe7, 0x34, 0x0a, 0x76, 0x3a, 0x00, 0xe6, 0x6a, 0xf8, 0xee, 0x7b, 0x2d, 0xd4, 0x42, 0x51,
+	0xc0, 0x53, 0x01, 0x40, 0x64, 0xb5, 0x1a, 0x98, 0x00, 0xa1, 0x3d, 0x64, 0xb9, 0x35, 0xb7, 0xdd,
+	0x44, 0xb1, 0xdc, 0x79, 0x70, 0xf7, 0x6c, 0xe7, 0xee, 0x76, 0xdd, 0xbe, 0x17, 0x4b, 0x4d, 0x74,
+	0x46, 0x47, 0x3d, 0x11, 0x80, 0x7b, 0xaf, 0x85, 0xe2, 0x64, 0x08, 0x12, 0xa3, 0x6d, 0xba, 0x5d,
+	0xef, 0xa6, 0x92, 0x6d, 0xcd, 0xac, 0xb7, 0x9c, 0x18, 0x49, 0x9f, 0x8c, 0x73, 0x14, 0xbd, 0x6e,
+	0xeb, 0x77, 0xa3, 0xb0, 0x4f, 0xa7, 0x38, 0x55, 0x14, 0xfa, 0x89, 0x38, 0x68, 0x4f, 0x45, 0xcc,
+	0x92, 0x1c, 0xf4, 0xa9, 0x54, 0xd0, 0x90, 0x36, 0x4f, 0xa7, 0x02, 0x13, 0xa3, 0x72, 0xc0, 0x33,
+	0x71, 0x80, 0xc9, 0xda, 0xaf, 0xc4, 0x81, 0x5b, 0x5a, 0x03, 0xe1, 0xa6, 0xa6, 0xc7, 0x68, 0xee,
+	0x99, 0x38, 0x78, 0x07, 0x35, 0xeb, 0xa6, 0x4e, 0x27, 0x41, 0x14, 0xe3, 0x7c, 0x1c, 0x46, 0x13,
+	0x39, 0xd8, 0xc4, 0x2e, 0xb2, 0xd8, 0x18, 0x68, 0x1f, 0xe9, 0x2d, 0x82, 0x8e, 0xd3, 0xd8, 0x0a,
+	0x21, 0x11, 0xa1, 0x05, 0xfc, 0xab, 0x3d, 0xc0, 0x0b, 0x25, 0xd4, 0x1a, 0x2d, 0x57, 0xdb, 0xaa,
+	0xa3, 0x1a, 0x76, 0x35, 0x57, 0x70, 0xf9, 0x42, 0xac, 0xb7, 0x76, 0x0d, 0x1a, 0x0b, 0x17, 0xe2,
+	0x06, 0xd6, 0x8c, 0x86, 0x69, 0x75, 0xc5, 0x55, 0xfe, 0x6d, 0x04, 0x8e, 0x6e, 0xb8, 0x9a, 0xe3,
+	0xde, 0xe6, 0xc3, 0x5d, 0x16, 0x6a, 0x50, 0x19, 0x82, 0x7c, 0x1c, 0xc6, 0x3d, 0x5b, 0xd4, 0x4c,
+	0xa3, 0x24, 0x2d, 0x49, 0x2b, 0xa3, 0xea, 0x98, 0xd7, 0x56, 0x35, 0x64, 0x1d, 0x26, 0x30, 0xa1,
+	0x51, 0xe3, 0x83, 0x94, 0x86, 0x96, 0xa4, 0x95, 0xb1, 0x73, 0x5f, 0xf0, 0x34, 0x48, 0xc3, 0x58,
+	0x48, 0xa0, 0xca, 0xde, 0xb3, 0x95, 0xd4, 0x91, 0xd5, 0x71, 0x4a, 0x54, 0xf0, 0xb1, 0x0b, 0xb3,
+	0x4d, 0xcd, 0x21, 0x61, 0xc0, 0xb3, 0x54, 0xcd, 0xb4, 0xb6, 0xed, 0x52, 0x86, 0x0e, 0xf6, 0x5c,
+	0x25, 0x2e, 0x74, 0x7a, 0x1e, 0xbc, 0xf7, 0x6c, 0x65, 0x9d, 0x62, 0x7b, 0xa3, 0x54, 0xad, 0x6d,
+	0x5b, 0x9d, 0x6e, 0x46, 0x1b, 0xe5, 0x12, 0x8c, 0x68, 0x2e, 0xa1, 0xe6, 0x96, 0xb2, 0x4b, 0xd2,
+	0x4a, 0x4e, 0x15, 0x3f, 0xe5, 0x06, 0x28, 0x9e, 0x05, 0x3b, 0x5c, 0xa0, 0xfd, 0xa6, 0xc9, 0xc2,
+	0x6f, 0x8d, 0xc4, 0xd9, 0x52, 0x8e, 0x32, 0xb4, 0x50, 0x61, 0x41, 0xb8, 0x22, 0x82, 0x70, 0x65,
+	0x53, 0x04, 0xe1, 0xd5, 0xec, 0x87, 0x3f, 0x3e, 0x26, 0xa9, 0xc7, 0xee, 0x85, 0x25, 0xbf, 0xec,
+	0x51, 0x22, 0xb0, 0xf2, 0x2e, 0x1c, 0xd6, 0x6d, 0xcb, 0x35, 0xad, 0x16, 0xaa, 0x69, 0xb8, 0x66,
+	0xa1, 0x7b, 0x35, 0xd3, 0x32, 0x5d, 0x53, 0x73, 0x6d, 0xa7, 0x34, 0xbc, 0x24, 0xad, 0x14, 0xce,
+	0x9d, 0x09, 0xea, 0x98, 0xce, 0x46, 0x22, 0xec, 0x1a, 0xc7, 0xbb, 0x88, 0x5f, 0x43, 0xf7, 0xaa,
+	0x02, 0x49, 0x9d, 0xd3, 0x63, 0xdb, 0xe5, 0x9b, 0x30, 0x25, 0x7a, 0x8c, 0x1a, 0x0f, 0x59, 0xa5,
+	0x11, 0x2a, 0xc7, 0x52, 0x70, 0x04, 0xde, 0x49, 0xc6, 0xb8, 0xc2, 0xfe, 0x54, 0x8b, 0x1e, 0x2a,
+	0x6f, 0x91, 0x6f, 0xc1, 0x5c, 0x5d, 0xc3, 0x6e, 0x4d, 0xb7, 0x1b, 0xcd, 0x3a, 0xa2, 0x9a, 0x71,
+	0x10, 0x6e, 0xd5, 0xdd, 0x52, 0x3e, 0x8e, 0x26, 0x0f, 0x49, 0xd4, 0x46, 0xed, 0xba, 0xad, 0x19,
+	0x58, 0x9d, 0x21, 0xf8, 0x6b, 0x1e, 0xba, 0x4a, 0xb1, 0xe5, 0xaf, 0xc2, 0xe2, 0xb6, 0xe9, 0x60,
+	0xb7, 0xe6, 0x59, 0x81, 0x4c, 0xc0, 0xda, 0x96, 0xa6, 0xdf, 0xb5, 0xb7, 0xb7, 0x4b, 0xa3, 0x94,
+	0xf8, 0xe1, 0x88, 0xe2, 0x2f, 0xf1, 0xd5, 0x71, 0x35, 0xfb, 0x0d, 0xa2, 0xf7, 0x12, 0xa5, 0x21,
+	0xdc, 0x6e, 0x53, 0xc3, 0x77, 0x57, 0x19, 0x01, 0x79, 0x1b, 0x66, 0x7c, 0x2c, 0xeb, 0x5a, 0xbd,
+	0x4e, 0x48, 0xe3, 0x12, 0x2c, 0x65, 0x56, 0xc6, 0xce, 0x9d, 0xef, 0xea, 0x62, 0x1d, 0x86, 0xd7,
+	0x38, 0xae, 0x3a, 0xad, 0x47, 0xda, 0xb0, 0xdc, 0x82, 0x45, 0x4f, 0x02, 0xd3, 0xa8, 0xe9, 0xb6,
+	0xb5, 0x5d, 0x37, 0x75, 0xb7, 0xd6, 0xb4, 0xeb, 0xa6, 0xde, 0x2e, 0x8d, 0x51, 0xd3, 0xbe, 0x10,
+	0x3b, 0x9c, 0x67, 0x61, 0xc1, 0x7f, 0xd5, 0x58, 0xe3, 0xe8, 0xeb, 0x14, 0x5b, 0x2d, 0xdd, 0x4b,
+	0xe8, 0x51, 0x7e, 0x22, 0x41, 0x39, 0x69, 0xca, 0xb1, 0xa8, 0x20, 0xcf, 0xc2, 0xb0, 0xd3, 0xb2,
+	0x3a, 0xf3, 0x3c, 0xe7, 0xb4, 0xac, 0xaa, 0x21, 0xbf, 0x0a, 0x39, 0xba, 0x34, 0xf1, 0x99, 0xfd,
+	0x44, 0x2c, 0x6b, 0x14, 0x82, 0xb0, 0x76, 0x0b, 0xe9, 0xae, 0xed, 0xac, 0x91, 0x9f, 0x2a, 0xc3,
+	0x93, 0x2d, 0x98, 0x46, 0xda, 0x0e, 0x72, 0x82, 0x96, 0x2b, 0x65, 0x7a, 0x0c, 0x14, 0xeb, 0x76,
+	0xbd, 0xee, 0x37, 0xd8, 0x1b, 0x64, 0xf7, 0x20, 0x98, 0x56, 0xa7, 0x28, 0x69, 0x7f, 0xbf, 0xf2,
+	0xef, 0x12, 0xcc, 0x5d, 0x45, 0xee, 0x4d, 0x16, 0x66, 0x37, 0x5c, 0xcd, 0x45, 0x7d, 0x04, 0xb4,
+	0xab, 0x30, 0xea, 0x4d, 0xef, 0xa8, 0xc8, 0x41, 0x97, 0x8d, 0xea, 0xb2, 0x83, 0x2b, 0x9f, 0x87,
+	0x39, 0xb4, 0xdf, 0x44, 0xba, 0x8b, 0x8c, 0x9a, 0x85, 0xf6, 0xdd, 0x1a, 0xdb, 0xc8, 0x98, 0x06,
+	0x95, 0x3c, 0xa3, 0x4e, 0x8b, 0xde, 0xd7, 0xd0, 0xbe, 0x7b, 0x99, 0xf4, 0x55, 0x0d, 0xf9, 0x19,
+	0x98, 0xd1, 0x5b, 0x0e, 0x0d, 0x75, 0x5b, 0x8e, 0x66, 0xe9, 0xbb, 0x35, 0xd7, 0xbe, 0x8b, 0x2c,
+	0x1a, 0x8c, 0xc6, 0x55, 0x99, 0xf7, 0xad, 0xd2, 0xae, 0x4d, 0xd2, 0xa3, 0xfc, 0x70, 0x14, 0xe6,
+	0x23, 0xd2, 0x72, 0x8b, 0x06, 0x64, 0x91, 0x06, 0x90, 0xa5, 0x0a, 0x13, 0x1d, 0xe3, 0xb5, 0x9b,
+	0x88, 0x2b, 0xe6, 0x64, 0x37, 0x62, 0x9b, 0xed, 0x26, 0x52, 0xc7, 0xef, 0xf9, 0x7e, 0xc9, 0x0a,
+	0x4c, 0xc4, 0x69, 0x63, 0xcc, 0xf2, 0x69, 0xe1, 0x73, 0x70, 0xb8, 0xe9, 0xa0, 0x3d, 0xd3, 0x6e,
+	0xe1, 0x1a, 0x5d, 0x08, 0x90, 0xd1, 0x81, 0xcf, 0x52, 0xf8, 0x39, 0x01, 0xb0, 0xc1, 0xfa, 0x05,
+	0xea, 0x19, 0x98, 0xa6, 0xe1, 0x87, 0xc5, 0x0a, 0x0f, 0x29, 0x47, 0x91, 0x8a, 0xa4, 0xeb, 0x0a,
+	0xe9, 0x11, 0xe0, 0x6b, 0x00, 0x34, 0x8c, 0xd0, 0x2d, 0x69, 0x69, 0x38, 0x4e, 0x2a, 0x6f, 0xc7,
+	0x4a, 0x04, 0xeb, 0x38, 0xe0, 0xa8, 0x2b, 0xfe, 0x94, 0xd7, 0x61, 0x0a, 0xbb, 0xa6, 0x7e, 0xb7,
+	0x5d, 0xf3, 0xd1, 0x1a, 0xe9, 0x83, 0xd6, 0x24, 0x43, 0xf7, 0x1a, 0xe4, 0x5f, 0x82, 0xa7, 0x22,
+	0x14, 0x6b, 0x58, 0xdf, 0x45, 0x46, 0xab, 0x8e, 0x6a, 0xae, 0xcd, 0xb4, 0x42, 0x97, 0x1c, 0xbb,
+	0xe5, 0x96, 0xc6, 0x7a, 0x0b, 0x7e, 0xcb, 0xa1, 0x61, 0x36, 0x38, 0xc1, 0x4d, 0x9b, 0x2a, 0x71,
+	0x93, 0x51, 0x4b, 0xf4, 0xc1, 0x89, 0x24, 0x1f, 0x94, 0xbf, 0x0c, 0x05, 0xcf, 0x3d, 0xe8, 0xae,
+	0xa6, 0x34, 0x49, 0xc3, 0xd8, 0x73, 0xbd, 0x85, 0x31, 0xcf, 0xe5, 0x98, 0xf7, 0x7a, 0xae, 0x46,
+	0x7f, 0xca, 0xb7, 0x61, 0x32, 0x40, 0xbc, 0x85, 0x4b, 0x45, 0x4a, 0xbd, 0x92, 0xb0, 0xfe, 0xc5,
+	0x92, 0x6d, 0x61, 0xb5, 0xe0, 0xa7, 0xdb, 0xc2, 0xf2, 0x1d, 0x98, 0xda, 0x43, 0x0e, 0x26, 0xe1,
+	0x9e, 0xed, 0xa7, 0x4d, 0x84, 0x4b, 0x53, 0x54, 0x95, 0xcf, 0x54, 0x52, 0x0e, 0x63, 0x2c, 0xcc,
+	0x51, 0xc4, 0x6b, 0x02, 0x4f, 0x2d, 0xee, 0x85, 0x5a, 0xe4, 0x2f, 0xc0, 0x11, 0x13, 0xd7, 0x98,
+	0xca, 0xfd, 0x66, 0x44, 0x16, 0x99, 0xa8, 0x46, 0x49, 0x5e, 0x92, 0x56, 0xf2, 0x6a, 0xc9, 0xc4,
+	0x1b, 0x41, 0xab, 0x5c, 0x66, 0xfd, 0xf2, 0x73, 0x30, 0x1f, 0xf1, 0x64, 0x77, 0x9f, 0xc6, 0xe7,
+	0x69, 0x16, 0x40, 0x82, 0xde, 0xbc, 0xb9, 0x4f, 0xa2, 0xf5, 0x79, 0x98, 0xe3, 0x08, 0xde, 0x1e,
+	0x85, 0x07, 0xf5, 0x19, 0x1a, 0xeb, 0xa6, 0x69, 0x6f, 0x67, 0x92, 0xd3, 0x10, 0x8f, 0x60, 0x7a,
+	0xab, 0x65, 0xd6, 0x0d, 0xb2, 0x20, 0x69, 0x18, 0x9b, 0x3b, 0x56, 0x03, 0x59, 0x6e, 0x69, 0x96,
+	0xea, 0xe2, 0xf9, 0x58, 0x5d, 0xf8, 0x36, 0xb7, 0x44, 0x1f, 0xab, 0x04, 0xbd, 0x6a, 0x5c, 0xf4,
+	0x90, 0xd5, 0xa9, 0xad, 0x70, 0xd3, 0xf5, 0x6c, 0x3e, 0x5f, 0x1c, 0xbd, 0x9e, 0xcd, 0x8f, 0x16,
+	0xe1, 0x7a, 0x36, 0x0f, 0xc5, 0xb1, 0xeb, 0xd9, 0xfc, 0x78, 0x71, 0xe2, 0x7a, 0x36, 0x5f, 0x28,
+	0x4e, 0x2a, 0xff, 0x21, 0xc1, 0x3c, 0x89, 0xf5, 0xff, 0x4f, 0xe2, 0xf6, 0xb7, 0xf2, 0x50, 0x8a,
+	0x8a, 0xfb, 0x59, 0xe0, 0xfe, 0x2c, 0x70, 0x3f, 0xf0, 0xc0, 0x3d, 0x9e, 0x18, 0xb8, 0x63, 0x43,
+	0x60, 0xe1, 0x81, 0x85, 0xc0, 0x4f, 0xe7, 0xba, 0x90, 0x12, 0x78, 0xa7, 0x0e, 0x12, 0x78, 0xe5,
+	0xc4, 0xc0, 0x1b, 0x1b, 0x11, 0x27, 0x8a, 0x05, 0xe5, 0x37, 0x24, 0x58, 0x54, 0x11, 0x46, 0x6e,
+	0x68, 0x6d, 0x78, 0x04, 0xf1, 0x50, 0x29, 0xc3, 0x91, 0x78, 0x56, 0x58, 0xac, 0x52, 0xbe, 0x9b,
+	0x81, 0x25, 0x15, 0xe9, 0xb6, 0x63, 0xf8, 0x77, 0xe1, 0x7c, 0x76, 0xf7, 0xc1, 0xf0, 0x5b, 0x20,
+	0x47, 0x0f, 0xd8, 0xfd, 0x73, 0x3e, 0x15, 0x39, 0x59, 0xcb, 0x4f, 0x83, 0x2c, 0xa6, 0xa0, 0x11,
+	0x0e, 0x5f, 0x45, 0xaf, 0x47, 0x44, 0x96, 0x79, 0x18, 0xa1, 0x73, 0xd7, 0x8b, 0x58, 0xc3, 0xe4,
+	0x67, 0xd5, 0x90, 0x8f, 0x02, 0x88, 0x4c, 0x0a, 0x0f, 0x4c, 0xa3, 0xea, 0x28, 0x6f, 0xa9, 0x1a,
+	0xf2, 0x3b, 0x30, 0xde, 0xb4, 0xeb, 0x75, 0x2f, 0x11, 0xc2, 0x62, 0xd2, 0x2b, 0x07, 0x3d, 0xdf,
+	0x50, 0x22, 0xea, 0x18, 0x21, 0x29, 0x94, 0xe8, 0x9d, 0xc4, 0x46, 0x0e, 0x76, 0x12, 0x53, 0x7e,
+	0x9c, 0x87, 0xe3, 0x29, 0xa6, 0xe2, 0x8b, 0x4f, 0x64, 0xcd, 0x90, 0x0e, 0xbc, 0x66, 0xa4, 0xae,
+	0x07, 0x43, 0xa9, 0xeb, 0x41, 0x7f, 0x46, 0x5b, 0x81, 0x62, 0xc2, 0x7a, 0x53, 0xc0, 0x41, 0xba,
+	0x91, 0x65, 0x2c, 0x17, 0x5d, 0xc6, 0x7c, 0x59, 0xa0, 0xe1, 0x60, 0x16, 0xe8, 0x25, 0x28, 0xf1,
+	0xf8, 0xde, 0x99, 0xe6, 0x62, 0x43, 0x37, 0x42, 0x37, 0x74, 0x73, 0xac, 0xbf, 0x93, 0xd7, 0x61,
+	0xbd, 0xf2, 0x7b, 0x30, 0xef, 0x3a, 0x9a, 0x85, 0x4d, 0x32, 0x6c, 0xf0, 0x24, 0xcc, 0x12, 0x23,
+	0x9f, 0xeb, 0x16, 0x70, 0x37, 0x05, 0xba, 0xdf, 0x78, 0x34, 0x95, 0x35, 0xeb, 0xc6, 0x75, 0xc9,
+	0x3b, 0x70, 0x34, 0x26, 0x65, 0xe5, 0x5b, 0xea, 0x46, 0xfb, 0x58, 0xea, 0x16, 0x22, 0xf3, 0xca,
+	0xeb, 0x23, 0xb3, 0x3b, 0xb0, 0xe0, 0x8c, 0xd1, 0x05, 0x67, 0x6c, 0xcb, 0xb7, 0xd2, 0x5c, 0x85,
+	0x42, 0xc7, 0x9c, 0x34, 0x55, 0x36, 0xde, 0x63, 0xaa, 0x6c, 0xc2, 0xc3, 0x23, 0x3d, 0xf2, 0x1a,
+	0x8c, 0x0b, 0x4b, 0x53, 0x32, 0x13, 0x3d, 0x92, 0x19, 0xe3, 0x58, 0x94, 0x88, 0x0d, 0x23, 0x24,
+	0xd3, 0xcf, 0x56, 0x3b, 0x92, 0xdf, 0x79, 0xb3, 0xd2, 0xd3, 0xed, 0x4b, 0xa5, 0xeb, 0xec, 0xa9,
+	0xbc, 0xc1, 0xe8, 0x5e, 0xb6, 0x5c, 0xa7, 0xad, 0x8a, 0x51, 0x3a, 0x53, 0x77, 0xf2, 0x80, 0x49,
+	0x94, 0x57, 0x20, 0xcf, 0xf3, 0xda, 0x64, 0x99, 0x23, 0x2c, 0x1f, 0x0f, 0x9a, 0x4d, 0x5c, 0x4a,
+	0x10, 0xfc, 0x9b, 0x0c, 0x52, 0xf5, 0x50, 0x16, 0xde, 0x81, 0x71, 0x3f, 0x63, 0x72, 0x11, 0x32,
+	0x77, 0x51, 0x9b, 0x87, 0x61, 0xf2, 0xa7, 0x7c, 0x01, 0x72, 0x7b, 0x5a, 0xbd, 0x95, 0xb0, 0x43,
+	0xa4, 0xf7, 0x22, 0xfe, 0xc9, 0x4e, 0xa8, 0xb5, 0x55, 0x86, 0x72, 0x61, 0xe8, 0x25, 0x89, 0x2d,
+	0x5f, 0xbe, 0xc5, 0xe0, 0xa2, 0xee, 0x9a, 0x7b, 0xa6, 0xdb, 0xfe, 0x6c, 0x31, 0xe8, 0x77, 0x31,
+	0xf0, 0x6b, 0xee, 0x21, 0x2e, 0x06, 0x7f, 0x95, 0x15, 0x8b, 0x41, 0xac, 0xa9, 0xf8, 0x62, 0xf0,
+	0x1a, 0x4c, 0x86, 0xd4, 0xc5, 0x97, 0x83, 0xe5, 0xa0, 0x2c, 0xbe, 0x38, 0xc5, 0xf6, 0x7f, 0x6d,
+	0xaa, 0x42, 0xb5, 0x10, 0x54, 0x69, 0x64, 0xfa, 0x0e, 0x1d, 0x64, 0xfa, 0xfa, 0xe2, 0x73, 0x26,
+	0x18, 0x9f, 0x11, 0x94, 0xc5, 0x16, 0x98, 0x37, 0xd5, 0x42, 0x61, 0x27, 0xdb, 0xe3, 0x80, 0x8b,
+	0x9c, 0xce, 0x45, 0x46, 0x66, 0x23, 0x10, 0x84, 0x6e, 0xc2, 0xd4, 0x2e, 0xd2, 0x1c, 0x77, 0x0b,
+	0x69, 0x6e, 0xcd, 0x40, 0xae, 0x66, 0xd6, 0x71, 0x29, 0xd7, 0x63, 0x7e, 0xbb, 0xe8, 0xa1, 0x5e,
+	0x62, 0x98, 0xd1, 0x15, 0x77, 0xf8, 0xc0, 0x2b, 0xee, 0x19, 0xdf, 0xc4, 0xf1, 0x26, 0x14, 0xf5,
+	0x91, 0xd1, 0xce, 0x6c, 0x78, 0x4d, 0x74, 0x74, 0xbc, 0x28, 0x7f, 0x40, 0x2f, 0xfa, 0x81, 0x04,
+	0x27, 0x98, 0xb3, 0x04, 0xa2, 0x22, 0xcf, 0x86, 0xf7, 0x35, 0xe7, 0x6d, 0x28, 0xf2, 0x84, 0x39,
+	0x0a, 0xdd, 0x26, 0x5d, 0xea, 0x3a, 0x6f, 0x7a, 0x60, 0x41, 0x9d, 0x14, 0xd4, 0x79, 0x83, 0xf2,
+	0xfd, 0x21, 0x38, 0x99, 0x8e, 0xc8, 0x27, 0x01, 0xee, 0xec, 0x2e, 0xc4, 0x1d, 0x1a, 0x9f, 0x05,
+	0xd7, 0x1e, 0xd4, 0xba, 0x41, 0x8e, 0x92, 0xc1, 0x99, 0x87, 0xa0, 0xa0, 0xf1, 0x89, 0x49, 0xd7,
+	0x6c, 0x5c, 0x1a, 0x5a, 0xca, 0xf4, 0x9c, 0x31, 0x8f, 0x09, 0x22, 0x7c, 0xa0, 0x09, 0xcd, 0xd7,
+	0x85, 0xc9, 0xb9, 0xc5, 0x41, 0x18, 0xb9, 0xfc, 0x00, 0xd8, 0x8e, 0xa4, 0x3b, 0x68, 0xaf, 0x7f,
+	0x4e, 0x57, 0x0d, 0xe5, 0x4f, 0x24, 0x58, 0x62, 0x04, 0x03, 0x32, 0x91, 0x3b, 0xa0, 0xbe, 0x4c,
+	0xbe, 0x0b, 0x85, 0x6d, 0x8a, 0x13, 0x32, 0xf8, 0xc5, 0x83, 0x18, 0x3c, 0x30, 0xba, 0x3a, 0xb1,
+	0xed, 0xff, 0xa9, 0x9c, 0x80, 0xe3, 0x29, 0x28, 0xfc, 0x28, 0xf3, 0x03, 0x09, 0x94, 0x68, 0x48,
+	0xbc, 0x26, 0xa6, 0x6b, 0x1f, 0x82, 0x35, 0xfd, 0x01, 0x22, 0x28, 0xdb, 0x5a, 0x0f, 0xb2, 0x75,
+	0x63, 0xc1, 0x17, 0x43, 0x84, 0x80, 0xeb, 0x70, 0x22, 0x15, 0x8f, 0x7b, 0xd5, 0x13, 0x50, 0xd4,
+	0x35, 0x4b, 0x47, 0xde, 0xd2, 0x84, 0x18, 0xff, 0x79, 0x75, 0x92, 0xb5, 0xab, 0xa2, 0xd9, 0x3f,
+	0xb5, 0xfd, 0x34, 0x1f, 0xd1, 0xd4, 0x4e, 0x63, 0x21, 0x3a, 0xb5, 0x4f, 0xc1, 0xc9, 0x74, 0x3c,
+	0x6e, 0x71, 0x9f, 0x23, 0xfb, 0x01, 0x7f, 0xfe, 0x8e, 0x9c, 0x38, 0x7a, 0xb2, 0x23, 0xc7, 0xa1,
+	0x70, 0xb1, 0xfe, 0x8c, 0x3a, 0x72, 0x54, 0x7e, 0x6a, 0xe1, 0xbe, 0x04, 0x7b, 0x17, 0x0a, 0x41,
+	0x7f, 0xe9, 0xc3, 0x8b, 0xbb, 0x8d, 0xaf, 0x4e, 0x04, 0x5c, 0x4e, 0x59, 0x8e, 0xf7, 0x37, 0x0f,
+	0x89, 0x0b, 0xf7, 0xc3, 0x21, 0x28, 0x6f, 0x98, 0x3b, 0x96, 0x56, 0x1f, 0xa4, 0x70, 0x61, 0x1b,
+	0x0a, 0x98, 0x12, 0x09, 0x09, 0xf6, 0x6a, 0xf7, 0xca, 0x85, 0xd4, 0xb1, 0xd5, 0x09, 0x46, 0x56,
+	0xb0, 0x62, 0xc2, 0x22, 0xda, 0x77, 0x91, 0x43, 0x46, 0x8a, 0xd9, 0xd2, 0x66, 0xfa, 0xdd, 0xd2,
+	0x1e, 0x16, 0xd4, 0x22, 0x5d, 0x72, 0x05, 0xa6, 0xf5, 0x5d, 0x92, 0xc6, 0xf7, 0xc6, 0xb1, 0xad,
+	0x7a, 0x9b, 0xee, 0x78, 0xf2, 0xea, 0x14, 0xed, 0x12, 0x48, 0xaf, 0x5b, 0xf5, 0xb6, 0x72, 0x1c,
+	0x8e, 0x25, 0xca, 0xc2, 0x75, 0xfd, 0xb7, 0x12, 0x9c, 0xe6, 0x30, 0xa6, 0xbb, 0x3b, 0x70, 0xb5,
+	0xc8, 0xd7, 0x24, 0x38, 0xcc, 0xb5, 0x7e, 0xcf, 0x74, 0x77, 0x6b, 0x71, 0xa5, 0x23, 0xd7, 0x7a,
+	0x35, 0x40, 0x37, 0x86, 0xd4, 0x39, 0x1c, 0x04, 0x14, 0x7e, 0x76, 0x11, 0x56, 0xba, 0x93, 0x48,
+	0xbd, 0x14, 0x57, 0xfe, 0x42, 0x82, 0x63, 0x2a, 0x6a, 0xd8, 0x7b, 0x88, 0x51, 0x3a, 0xe0, 0xa5,
+	0xc5, 0xc3, 0x3b, 0xe6, 0x04, 0xcf, 0x27, 0x99, 0xd0, 0xf9, 0x44, 0x51, 0x60, 0x29, 0x99, 0x7d,
+	0x61, 0xfb, 0x21, 0x38, 0xbe, 0x89, 0x9c, 0x86, 0x69, 0x69, 0x2e, 0x1a, 0xc4, 0xea, 0x36, 0x4c,
+	0xb9, 0x82, 0x4e, 0xc8, 0xd8, 0xab, 0x5d, 0x8d, 0xdd, 0x95, 0x03, 0xb5, 0xe8, 0x11, 0xff, 0x14,
+	0xcc, 0xb9, 0x93, 0xa0, 0xa4, 0x49, 0xc4, 0x55, 0xff, 0x3f, 0x12, 0x94, 0x2f, 0xa1, 0x3a, 0x1a,
+	0x4c, 0xef, 0x0f, 0xcf, 0xbb, 0x9e, 0x80, 0xa2, 0x47, 0x99, 0x67, 0xfd, 0xf9, 0x76, 0xd1, 0xcb,
+	0xc9, 0xf3, 0xeb, 0x01, 0x7a, 0x29, 0x51, 0xb7, 0x31, 0x8a, 0xd7, 0x90, 0xcc, 0xfa, 0xc2, 0x61,
+	0x29, 0x51, 0x76, 0xae, 0x9f, 0x8f, 0x24, 0x38, 0xba, 0xae, 0xb5, 0xf0, 0x63, 0xaa, 0x9e, 0x05,
+	0xc8, 0x9b, 0x06, 0xb2, 0x5c, 0xd3, 0x6d, 0xf3, 0xa9, 0xe7, 0xfd, 0x96, 0xe7, 0x60, 0xd8, 0x41,
+	0x1a, 0xb6, 0xd9, 0xdd, 0xe0, 0xa8, 0xca, 0x7f, 0x29, 0x4b, 0x50, 0x4e, 0x92, 0x88, 0x0b, 0xfd,
+	0xe7, 0x12, 0x1c, 0x7b, 0xd3, 0x6a, 0x7e, 0x2a, 0xc5, 0x26, 0x01, 0x27, 0x99, 0x77, 0x2e, 0xe0,
+	0xef, 0x0f, 0xc1, 0x91, 0x37, 0x9b, 0x86, 0xe6, 0x22, 0xb1, 0xfe, 0xbf, 0xde, 0x24, 0x00, 0xf8,
+	0xb1, 0x90, 0xee, 0x18, 0x8c, 0x79, 0xe7, 0x31, 0x2f, 0xa4, 0x82, 0x68, 0xaa, 0x1a, 0x72, 0x15,
+	0x46, 0x6c, 0xc6, 0x2f, 0x4f, 0x32, 0x9c, 0xed, 0x96, 0xd1, 0x0d, 0x8b, 0x29, 0xf0, 0x03, 0x9a,
+	0xcc, 0x85, 0x34, 0xf9, 0x2e, 0x1c, 0x4d, 0x50, 0x92, 0x97, 0xbf, 0xf7, 0xf8, 0x90, 0x06, 0xe3,
+	0x43, 0xf9, 0x2f, 0x09, 0x66, 0xe8, 0xe5, 0x8f, 0x80, 0xf8, 0x74, 0x58, 0x62, 0x19, 0x0a, 0xec,
+	0x4c, 0xcb, 0x73, 0x40, 0x98, 0x47, 0x9b, 0x09, 0xda, 0xca, 0x33, 0x3a, 0xe9, 0x5a, 0xbe, 0x00,
+	0xb3, 0x21, 0xc1, 0xb9, 0x76, 0x8f, 0xc3, 0xb8, 0x6f, 0x70, 0xa2, 0xe2, 0x0c, 0x91, 0xbc, 0x33,
+	0x3a, 0x56, 0xbe, 0x23, 0xc1, 0x51, 0x8a, 0x3c, 0x60, 0x61, 0x2d, 0x93, 0xa1, 0xdf, 0xc2, 0xda,
+	0xd4, 0x91, 0xd5, 0x71, 0x4a, 0x94, 0xff, 0x52, 0x5e, 0x84, 0x72, 0x12, 0x78, 0xfa, 0xfe, 0xe7,
+	0xb7, 0x32, 0xb0, 0xcc, 0x89, 0xb0, 0xfd, 0xf9, 0x20, 0xa2, 0x36, 0x12, 0xce, 0x18, 0x57, 0x7a,
+	0x90, 0xb5, 0x07, 0x16, 0x42, 0xc7, 0x0c, 0xf9, 0x15, 0xdf, 0xee, 0x80, 0xd7, 0xd4, 0x46, 0x53,
+	0xc1, 0x25, 0x01, 0x52, 0x15, 0x10, 0x22, 0x25, 0xdc, 0x65, 0x73, 0x91, 0x7d, 0xf8, 0x9b, 0x8b,
+	0x5c, 0xd2, 0xe6, 0x62, 0x05, 0x4e, 0x75, 0xd3, 0x08, 0x0f, 0xb5, 0xff, 0x3a, 0x04, 0x8b, 0x22,
+	0xa5, 0xe9, 0x4f, 0x88, 0x3c, 0x16, 0xf3, 0xfb, 0x3c, 0xcc, 0x99, 0xb8, 0x16, 0x53, 0xed, 0x4b,
+	0x6d, 0x93, 0x57, 0xa7, 0x4d, 0x7c, 0x25, 0x5c, 0xc6, 0x2b, 0x5f, 0x87, 0x31, 0xa6, 0x2b, 0x96,
+	0xcf, 0xcc, 0xf6, 0x9b, 0xcf, 0x04, 0x8a, 0x4d, 0xff, 0x96, 0x6f, 0xc0, 0x38, 0xaf, 0x37, 0x67,
+	0xc4, 0x72, 0xfd, 0x12, 0x1b, 0x63, 0xe8, 0xf4, 0x07, 0xb9, 0x40, 0x8f, 0x57, 0x35, 0xb7, 0xc5,
+	0xbf, 0x48, 0x70, 0xfa, 0x16, 0x72, 0xcc, 0xed, 0x76, 0x44, 0x2a, 0x81, 0xf7, 0x78, 0x5c, 0x9d,
+	0x78, 0xc9, 0xe2, 0xcc, 0x01, 0x93, 0xc5, 0x4f, 0xc2, 0x4a, 0x77, 0x41, 0xb9, 0x56, 0x7e, 0x96,
+	0x81, 0x93, 0x2c, 0xa1, 0xb5, 0x46, 0x0c, 0xe3, 0x71, 0x71, 0x90, 0xf4, 0xd3, 0xc3, 0x53, 0x49,
+	0x05, 0xf8, 0x33, 0x02, 0x5f, 0x24, 0xf1, 0x62, 0xc8, 0x14, 0xeb, 0xf2, 0x22, 0x48, 0xd5, 0x90,
+	0xdf, 0x06, 0x51, 0x14, 0x4e, 0x42, 0xce, 0xc1, 0x83, 0x86, 0xec, 0x51, 0xe9, 0xf0, 0xb2, 0xee,
+	0x25, 0xd9, 0xe8, 0xad, 0x34, 0xbd, 0xab, 0xc9, 0xf5, 0x73, 0x57, 0x33, 0xd9, 0x41, 0xa7, 0x0d,
+	0x1d, 0x83, 0x0f, 0x1f, 0xf0, 0xd6, 0xf2, 0x25, 0x28, 0x45, 0xd4, 0x23, 0xce, 0x0b, 0x23, 0xfc,
+	0xfa, 0x3f, 0xa8, 0x23, 0x7e, 0x6c, 0x50, 0x4e, 0xc3, 0x72, 0x17, 0xeb, 0x73, 0x3f, 0xf9, 0x4e,
+	0x06, 0xce, 0x30, 0xa7, 0x8a, 0x85, 0xa4, 0x41, 0x8f, 0xd0, 0xe9, 0xcb, 0x61, 0x36, 0xa1, 0x18,
+	0x7e, 0x70, 0xd2, 0xbf, 0xbb, 0x4c, 0x86, 0x1e, 0x98, 0xc8, 0x2a, 0x4c, 0xb2, 0x10, 0x35, 0xc0,
+	0x51, 0xb4, 0xa0, 0x07, 0xa4, 0x4c, 0x72, 0xc0, 0x6c, 0x92, 0x03, 0xa6, 0x59, 0x24, 0x97, 0x66,
+	0x91, 0x81, 0x9d, 0x41, 0x79, 0x06, 0x2a, 0xbd, 0x1a, 0x8a, 0xdb, 0xf6, 0xf7, 0x24, 0x58, 0xba,
+	0x84, 0xb0, 0xee, 0x98, 0x5b, 0x03, 0x1d, 0x79, 0xbe, 0x0c, 0x23, 0xfd, 0xa6, 0x65, 0xbb, 0x0d,
+	0xab, 0x0a, 0x8a, 0xca, 0xff, 0x66, 0xe1, 0x78, 0x0a, 0x34, 0xdf, 0x47, 0x7d, 0x05, 0x8a, 0x9d,
+	0x12, 0x0c, 0xf2, 0xe8, 0xc3, 0xdc, 0xe1, 0xbb, 0xf3, 0x67, 0xe3, 0x79, 0x89, 0x35, 0xff, 0x1a,
+	0x45, 0x54, 0x27, 0x51, 0xb0, 0x41, 0xde, 0x81, 0xf9, 0x98, 0x4a, 0x0f, 0xfa, 0x44, 0x6a, 0x28,
+	0x7c, 0x04, 0xe8, 0x3a, 0x08, 0x2b, 0x29, 0xb9, 0x17, 0xd7, 0x2c, 0x7f, 0x05, 0xe4, 0x26, 0xb2,
+	0x0c, 0xd3, 0xda, 0xa9, 0xf1, 0x1d, 0xaf, 0x89, 0x70, 0x29, 0x43, 0x2f, 0xa6, 0xce, 0x24, 0x8f,
+	0xb1, 0xce, 0x70, 0xc4, 0x66, 0x9a, 0x8e, 0x30, 0xd5, 0x0c, 0x34, 0x9a, 0x08, 0xcb, 0x5f, 0x85,
+	0xa2, 0xa0, 0x4e, 0xdd, 0xdc, 0xa1, 0x15, 0xb4, 0xa1, 0xf7, 0x37, 0x09, 0xb4, 0x83, 0x4e, 0x45,
+	0x47, 0x98, 0x6c, 0xfa, 0xba, 0x1c, 0x64, 0xc9, 0x08, 0x66, 0x05, 0xfd, 0xe0, 0xbe, 0x22, 0xd7,
+	0xcd, 0x12, 0x7c, 0x90, 0x48, 0xe5, 0xcd, 0x74, 0x33, 0xda, 0x21, 0xbf, 0x9b, 0xf0, 0x94, 0x68,
+	0x98, 0x8a, 0xf2, 0xe2, 0x01, 0x9e, 0x12, 0xb1, 0xb1, 0x62, 0x9e, 0x13, 0x29, 0xff, 0x9c, 0x81,
+	0x92, 0xca, 0xdf, 0x3f, 0x22, 0x1a, 0xb5, 0xf1, 0xad, 0x73, 0x8f, 0xc5, 0xd2, 0xb8, 0x0d, 0xb3,
+	0xc1, 0xda, 0xd2, 0x76, 0xcd, 0x74, 0x51, 0x43, 0x78, 0xcb, 0xb9, 0xbe, 0xea, 0x4b, 0xdb, 0x55,
+	0x17, 0x35, 0xd4, 0xe9, 0xbd, 0x48, 0x1b, 0x96, 0x5f, 0x82, 0x61, 0xba, 0xd6, 0x89, 0x53, 0x77,
+	0xe2, 0x05, 0xfc, 0x25, 0xcd, 0xd5, 0x56, 0xeb, 0xf6, 0x96, 0xca, 0xe1, 0xe5, 0x2b, 0x50, 0x20,
+	0xef, 0xea, 0xc8, 0xf9, 0x86, 0x53, 0xc8, 0xf5, 0x48, 0x61, 0xdc, 0x42, 0xf7, 0xd4, 0x16, 0x5b,
+	0x25, 0xb1, 0xbc, 0x05, 0xd3, 0x5b, 0x1a, 0x46, 0xe1, 0x99, 0xc7, 0xe2, 0xe4, 0xb9, 0xae, 0xe6,
+	0x5e, 0xd5, 0x30, 0x0a, 0x3a, 0xee, 0xd4, 0x56, 0xb8, 0x49, 0x59, 0x84, 0xc3, 0x31, 0x66, 0xe6,
+	0x71, 0xf2, 0x6f, 0xe8, 0x81, 0x93, 0xf7, 0xde, 0xf6, 0x57, 0xc9, 0x0a, 0x4f, 0xa8, 0x45, 0x2a,
+	0x71, 0x59, 0xf0, 0x79, 0xa9, 0x97, 0xe2, 0x7e, 0x41, 0x31, 0x90, 0x25, 0x0e, 0x55, 0xe3, 0xd2,
+	0x23, 0x77, 0xc3, 0x76, 0x51, 0x4d, 0xaf, 0xb7, 0xb0, 0x8b, 0x1c, 0xea, 0x43, 0xa3, 0xea, 0x04,
+	0x6b, 0x5d, 0x63, 0x8d, 0x11, 0x8f, 0xcc, 0x44, 0x3c, 0x92, 0x24, 0xc2, 0x92, 0x64, 0xe1, 0xe2,
+	0xfe, 0xb6, 0x04, 0x73, 0x1b, 0x6d, 0x4b, 0xdf, 0xd8, 0xd5, 0x1c, 0x83, 0x17, 0xf1, 0x72, 0x39,
+	0x97, 0xa1, 0x80, 0xed, 0x96, 0xa3, 0x77, 0xd8, 0x60, 0x3e, 0x3f, 0xc1, 0x5a, 0x05, 0x1b, 0x87,
+	0x21, 0x8f, 0x09, 0xb2, 0x28, 0x43, 0xcc, 0xa9, 0x23, 0xf4, 0x77, 0xd5, 0x90, 0x2f, 0xc2, 0x18,
+	0xab, 0x26, 0x66, 0xe5, 0x22, 0x99, 0x1e, 0xcb, 0x45, 0x80, 0x21, 0x91, 0x66, 0xe5, 0x30, 0xcc,
+	0x47, 0xd8, 0xe3, 0xac, 0xff, 0xe7, 0x08, 0x4c, 0x93, 0xbe, 0x03, 0xe4, 0x53, 0x8e, 0xc1, 0x98,
+	0xef, 0xe1, 0x20, 0x57, 0x2f, 0x74, 0x1e, 0xfc, 0xf9, 0x8e, 0xea, 0x19, 0xff, 0xfb, 0xbd, 0x12,
+	0x8c, 0x88, 0x05, 0x9e, 0xed, 0x0a, 0xc4, 0xcf, 0x84, 0x52, 0xa8, 0x5c, 0x42, 0x29, 0x54, 0xb4,
+	0x82, 0x6f, 0xf8, 0x60, 0x15, 0x7c, 0x71, 0xb5, 0x9a, 0x23, 0xb1, 0xb5, 0x9a, 0xe1, 0x62, 0xa1,
+	0xfc, 0x41, 0x8a, 0x85, 0xd6, 0xf9, 0xc3, 0x82, 0xce, 0x7d, 0x3c, 0xa5, 0x35, 0xda, 0x23, 0xad,
+	0x29, 0x82, 0xec, 0xdd, 0xa3, 0x53, 0x8a, 0x17, 0x60, 0x44, 0xd4, 0xfc, 0x40, 0x8f, 0x35, 0x3f,
+	0x02, 0xc1, 0x5f, 0xba, 0x34, 0x16, 0x2c, 0x5d, 0x5a, 0x83, 0x71, 0xca, 0xa7, 0x78, 0x82, 0x3b,
+	0xde, 0xe3, 0x13, 0xdc, 0x31, 0x5a, 0x8d, 0xce, 0x7e, 0x90, 0x6c, 0x3b, 0x25, 0x42, 0xdc, 0x02,
+	0x39, 0x35, 0x2f, 0xbd, 0x35, 0x41, 0x3d, 0x42, 0x26, 0x7d, 0xb7, 0x69, 0x57, 0x95, 0xf7, 0x90,
+	0x32, 0xfa, 0x50, 0x98, 0xe6, 0x0f, 0x00, 0x2a, 0xfd, 0x05, 0x68, 0xb5, 0x10, 0x0c, 0xce, 0x49,
+	0x51, 0x71, 0xf2, 0x01, 0x46, 0x45, 0x7f, 0xaa, 0xb3, 0x38, 0x60, 0xca, 0x75, 0x13, 0x66, 0x1d,
+	0xe4, 0x92, 0xfa, 0x97, 0xd0, 0x93, 0xee, 0xa9, 0x1e, 0x1d, 0x65, 0x9a, 0xa2, 0x07, 0x9f, 0x71,
+	0x2b, 0x73, 0x30, 0x13, 0x9c, 0xee, 0x3c, 0x0e, 0x90, 0x02, 0x7f, 0xb1, 0x69, 0x7c, 0xc4, 0x0f,
+	0x9e, 0x94, 0xff, 0x96, 0xe0, 0x48, 0x3c, 0x2f, 0x7c, 0xef, 0xba, 0x0b, 0xd3, 0xba, 0xa6, 0xef,
+	0xa2, 0xe0, 0x97, 0x0b, 0x06, 0x5e, 0x41, 0xa6, 0x28, 0x51, 0x7f, 0x93, 0x6c, 0xc1, 0x9c, 0xa1,
+	0xb9, 0x1a, 0xf5, 0x9b, 0xe0, 0x60, 0x43, 0x03, 0x0e, 0x36, 0x23, 0xe8, 0xfa, 0x5b, 0x95, 0xbf,
+	0x93, 0x60, 0x41, 0x88, 0xce, 0xfd, 0xf6, 0x9a, 0x8d, 0xfd, 0x85, 0x3e, 0xbb, 0x36, 0x76, 0x6b,
+	0x9a, 0x61, 0x38, 0x08, 0x63, 0x61, 0x05, 0xd2, 0x76, 0x91, 0x35, 0xa5, 0xad, 0x24, 0xdd, 0xd7,
+	0xba, 0x84, 0xdd, 0x57, 0x76, 0xf0, 0xdd, 0x97, 0xf2, 0x8f, 0x3e, 0x07, 0x0b, 0x48, 0xc6, 0x6d,
+	0x7a, 0x02, 0x26, 0x28, 0x9f, 0xb8, 0x66, 0xb5, 0x1a, 0x5b, 0x7c, 0x9d, 0xcc, 0xa9, 0xe3, 0xac,
+	0xf1, 0x35, 0xda, 0x26, 0x2f, 0xc2, 0xa8, 0x10, 0x8e, 0x55, 0x9f, 0xe5, 0xd4, 0x3c, 0x97, 0x8e,
+	0x3c, 0x9f, 0x9c, 0xec, 0x88, 0x47, 0x4d, 0x99, 0xfa, 0x39, 0x06, 0x0f, 0x96, 0x88, 0xe0, 0x15,
+	0x20, 0xae, 0x11, 0x3c, 0x3a, 0xbb, 0x0b, 0x56, 0xa0, 0x8d, 0x06, 0x4a, 0xae, 0x76, 0x96, 0x9b,
+	0x17, 0x3f, 0xaf, 0x67, 0xf3, 0xd9, 0x62, 0x4e, 0xa9, 0xc0, 0xd4, 0x5a, 0xdd, 0xc6, 0x88, 0xae,
+	0xb2, 0xc2, 0x60, 0x7e, 0x6b, 0x48, 0x01, 0x6b, 0x28, 0x33, 0x20, 0xfb, 0xe1, 0xf9, 0x3c, 0x7c,
+	0x1a, 0x26, 0xaf, 0x22, 0xb7, 0x57, 0x1a, 0xef, 0x40, 0xb1, 0x03, 0xcd, 0x15, 0x79, 0x03, 0x80,
+	0x83, 0x93, 0xe8, 0xc6, 0xe6, 0xc4, 0x99, 0x5e, 0xdc, 0x94, 0x92, 0xa1, 0xa2, 0x8f, 0x62, 0xf1,
+	0xa7, 0xf2, 0xf7, 0x12, 0x4c, 0xb1, 0x8b, 0x79, 0x7f, 0x36, 0x36, 0x99, 0x25, 0xf9, 0x0a, 0xe4,
+	0x75, 0xcd, 0x45, 0x3b, 0x24, 0x6e, 0x0f, 0xd1, 0xe7, 0x4f, 0x4f, 0xa6, 0x3f, 0xae, 0x62, 0x25,
+	0x35, 0x0c, 0x43, 0xf5, 0x70, 0xfd, 0x85, 0xce, 0x99, 0x40, 0xa1, 0x73, 0x15, 0x26, 0xf7, 0x4c,
+	0x6c, 0x6e, 0x99, 0x75, 0x5a, 0x88, 0xd8, 0x4f, 0x09, 0x6d, 0xa1, 0x83, 0x48, 0x83, 0xe1, 0x0c,
+	0xc8, 0x7e, 0xd9, 0xb8, 0x09, 0x3e, 0x94, 0xe0, 0xe8, 0x55, 0xe4, 0xaa, 0x9d, 0x8f, 0xb8, 0xf0,
+	0xf2, 0x75, 0x6f, 0x53, 0x77, 0x03, 0x86, 0xe9, 0xbb, 0x02, 0x76, 0xd9, 0x92, 0xe4, 0x60, 0xbe,
+	0xaf, 0xc0, 0xb0, 0xab, 0x01, 0xef, 0x27, 0x7d, 0x81, 0xa0, 0x72, 0x1a, 0x64, 0x5a, 0xf2, 0xbd,
+	0x21, 0x2d, 0x90, 0xe5, 0x1b, 0xa9, 0x31, 0xde, 0x46, 0x3c, 0x53, 0xf9, 0xf6, 0x10, 0x94, 0x93,
+	0x58, 0xe2, 0x66, 0xff, 0x00, 0x0a, 0xcc, 0x24, 0x5e, 0x55, 0x3e, 0xe3, 0xed, 0xad, 0x1e, 0x0b,
+	0x42, 0xd3, 0xc9, 0x33, 0xe7, 0x10, 0xad, 0xec, 0x2d, 0xc1, 0x04, 0xf6, 0xb7, 0x2d, 0xb4, 0x41,
+	0x8e, 0x02, 0xf9, 0xeb, 0xfa, 0x73, 0xac, 0xae, 0xff, 0x66, 0xb0, 0xae, 0xff, 0xc5, 0x3e, 0x75,
+	0xe7, 0x71, 0xd6, 0x29, 0xf5, 0x57, 0xde, 0x87, 0xa5, 0xab, 0xc8, 0xbd, 0x74, 0xe3, 0x8d, 0x14,
+	0x9b, 0xdd, 0xe2, 0xef, 0x33, 0xc9, 0xac, 0x10, 0xba, 0xe9, 0x77, 0x6c, 0xef, 0x94, 0x3d, 0xea,
+	0xf2, 0xbf, 0xb0, 0xf2, 0x2b, 0x12, 0x1c, 0x4f, 0x19, 0x9c, 0x5b, 0xe7, 0x1d, 0x98, 0xf2, 0x91,
+	0xe5, 0xe5, 0xb3, 0x52, 0xca, 0x97, 0x3c, 0xd2, 0x99, 0x50, 0x8b, 0x4e, 0xb0, 0x01, 0x2b, 0x5f,
+	0x97, 0x60, 0x86, 0xbe, 0x81, 0x10, 0xd1, 0xb8, 0x8f, 0x95, 0xfb, 0xf5, 0x70, 0x3a, 0xea, 0xf9,
+	0xae, 0xe9, 0xa8, 0xb8, 0xa1, 0x3a, 0x29, 0xa8, 0xbb, 0x30, 0x1b, 0x02, 0xe0, 0x7a, 0x50, 0x21,
+	0x1f, 0x2a, 0x58, 0x7e, 0xa1, 0xdf, 0xa1, 0x18, 0xb6, 0xea, 0xd1, 0x51, 0x7e, 0x93, 0xde, 0x09,
+	0x6b, 0xcd, 0x66, 0x9d, 0xa5, 0x8d, 0xfb, 0xb9, 0x9d, 0xdf, 0x08, 0x4b, 0x1e, 0xff, 0xe8, 0xc9,
+	0xff, 0x01, 0x23, 0x66, 0x8e, 0xe8, 0x70, 0x1d, 0xe9, 0xe7, 0x61, 0x36, 0x04, 0xc0, 0x39, 0xfd,
+	0xe3, 0x21, 0x98, 0x65, 0xbe, 0x12, 0xf6, 0xce, 0xcb, 0x90, 0xf5, 0x5e, 0xb6, 0x15, 0xfc, 0x79,
+	0x9f, 0xb8, 0x88, 0x79, 0x09, 0x69, 0xc6, 0x0d, 0xe4, 0xba, 0xc8, 0xa1, 0x85, 0xd4, 0xb4, 0xe8,
+	0x9e, 0xa2, 0xa7, 0x2d, 0xfe, 0xd1, 0x83, 0x68, 0x26, 0xee, 0x20, 0xfa, 0x22, 0x94, 0x4c, 0x8b,
+	0x40, 0x98, 0x7b, 0xa8, 0x86, 0x2c, 0x2f, 0x9c, 0x74, 0x72, 0xb8, 0xb3, 0x5e, 0xff, 0x65, 0x4b,
+	0x4c, 0xf6, 0xaa, 0x21, 0x3f, 0x09, 0x53, 0x0d, 0x6d, 0xdf, 0x6c, 0xb4, 0x1a, 0xb5, 0x26, 0x81,
+	0xc7, 0xe6, 0xfb, 0xec, 0xeb, 0x43, 0x39, 0x75, 0x92, 0x77, 0xac, 0x6b, 0x3b, 0x68, 0xc3, 0x7c,
+	0x1f, 0xc9, 0xa7, 0x60, 0x92, 0x3e, 0x79, 0xa3, 0x80, 0xec, 0x85, 0xd6, 0x30, 0x7d, 0xa1, 0x45,
+	0x5f, 0xc2, 0x11, 0x30, 0xf6, 0x24, 0xfd, 0x4f, 0x33, 0x30, 0x17, 0xd6, 0x17, 0x77, 0xa4, 0x07,
+	0xa4, 0xb0, 0xd8, 0x79, 0x39, 0xf4, 0x00, 0xe7, 0x65, 0x9c, 0xac, 0x99, 0x18, 0x59, 0xe5, 0x06,
+	0xcc, 0xf9, 0x70, 0x19, 0x27, 0x6c, 0x09, 0xcf, 0x0e, 0x16, 0xab, 0x66, 0xc2, 0x2c, 0x91, 0x56,
+	0xf9, 0x36, 0x4c, 0x88, 0x24, 0x18, 0x13, 0x3a, 0xd7, 0x5b, 0x12, 0x8c, 0x6f, 0xdd, 0x2e, 0xdd,
+	0x78, 0xc3, 0x1b, 0x60, 0x9c, 0x77, 0xb3, 0x38, 0xf4, 0x0f, 0xe4, 0xab, 0x09, 0x2d, 0x67, 0x07,
+	0xfd, 0x22, 0x7a, 0xb9, 0xb2, 0x00, 0xa5, 0xa8, 0x70, 0xa2, 0x74, 0x7b, 0x08, 0xe6, 0x6f, 0xa2,
+	0x5f, 0x50, 0xc9, 0x1f, 0xca, 0xfc, 0x5e, 0x85, 0xd2, 0x4d, 0x14, 0xaf, 0xcd, 0x38, 0x1a, 0x52,
+	0x1c, 0x8d, 0x6f, 0xd3, 0x97, 0xe9, 0xdb, 0x0e, 0xc2, 0xbb, 0xfe, 0x9c, 0x77, 0x3f, 0x8b, 0xc0,
+	0xdb, 0xe1, 0x45, 0xe0, 0x8b, 0x3d, 0x2e, 0x02, 0x89, 0xa3, 0x76, 0xd6, 0x02, 0xfa, 0x58, 0x3d,
+	0x0e, 0x8e, 0x3b, 0xcd, 0x37, 0x24, 0x78, 0xf2, 0x2a, 0xb2, 0x90, 0xa3, 0xb9, 0xe8, 0x06, 0x49,
+	0xec, 0xf0, 0xe4, 0x45, 0x68, 0xce, 0x3e, 0x8a, 0x63, 0xf8, 0x19, 0x78, 0xaa, 0x27, 0xce, 0xb8,
+	0x24, 0x57, 0x60, 0x31, 0xb8, 0x87, 0x0c, 0x26, 0x42, 0x4f, 0xc3, 0x64, 0x30, 0x1f, 0x2b, 0x2a,
+	0x95, 0x0a, 0x81, 0x84, 0x2c, 0x56, 0x5a, 0x70, 0x24, 0x9e, 0x0e, 0x77, 0x8c, 0x37, 0x61, 0x98,
+	0x9d, 0x09, 0xf9, 0xfe, 0xe9, 0x95, 0x1e, 0x37, 0xb8, 0xfc, 0x94, 0x14, 0x26, 0xcb, 0x89, 0x29,
+	0x7f, 0x39, 0x0c, 0x73, 0xf1, 0x20, 0x69, 0xa7, 0x9d, 0xe7, 0x61, 0xbe, 0xa1, 0xed, 0xd7, 0xc2,
+	0x91, 0xbb, 0xf3, 0x9a, 0x7c, 0xa6, 0xa1, 0xed, 0x87, 0xa3, 0xb2, 0x21, 0xdf, 0x80, 0x22, 0xa3,
+	0x58, 0xb7, 0x75, 0xad, 0xde, 0x6b, 0x62, 0x77, 0x98, 0x1c, 0x62, 0x4a, 0x92, 0xca, 0x36, 0xfa,
+	0x37, 0x08, 0x2a, 0xe9, 0x94, 0xdf, 0x8f, 0xaa, 0x96, 0xad, 0x19, 0x6f, 0x0c, 0xa4, 0x9a, 0x8a,
+	0x1a, 0x30, 0x0c, 0xdb, 0xf4, 0x87, 0xac, 0x25, 0xff, 0xaa, 0x04, 0xd3, 0xbb, 0x9a, 0x65, 0xd8,
+	0x7b, 0xfc, 0xf8, 0x42, 0xdd, 0x50, 0x2c, 0x27, 0x6f, 0x0e, 0xc6, 0xc0, 0x35, 0x4e, 0xd8, 0x3b,
+	0x9d, 0x73, 0x26, 0xe4, 0xdd, 0x48, 0x87, 0xdc, 0x84, 0x93, 0xb1, 0x96, 0x08, 0x9f, 0x15, 0x7b,
+	0xcd, 0x11, 0x2f, 0x45, 0x0d, 0x77, 0x2b, 0x70, 0x7a, 0x5c, 0xf8, 0xba, 0x04, 0xd3, 0x31, 0x2a,
+	0x8a, 0x79, 0xca, 0x7c, 0x27, 0x78, 0xe4, 0xb9, 0x3a, 0x90, 0x56, 0xd6, 0x91, 0xc3, 0xc7, 0xf3,
+	0x1d, 0x81, 0x16, 0xbe, 0x26, 0xc1, 0x7c, 0x82, 0xba, 0x62, 0x18, 0x52, 0x83, 0x0c, 0x7d, 0xbe,
+	0x47, 0x86, 0x22, 0x03, 0xd0, 0xf5, 0xdf, 0x77, 0x10, 0x7b, 0x0b, 0x66, 0x63, 0x61, 0xe4, 0x57,
+	0xe1, 0x88, 0xe7, 0x25, 0x71, 0x93, 0x45, 0xa2, 0x93, 0xe5, 0xb0, 0x80, 0x89, 0xcc, 0x18, 0xe5,
+	0x0f, 0x24, 0x58, 0xea, 0xa6, 0x0f, 0xf2, 0x29, 0x05, 0x4d, 0xbf, 0x8b, 0x8c, 0x10, 0xd9, 0x31,
+	0xda, 0xc8, 0xa7, 0xde, 0x1d, 0x58, 0xf0, 0xc1, 0x84, 0xbd, 0xa3, 0xd7, 0xd7, 0xbf, 0xf3, 0x1e,
+	0xc9, 0xa0, 0x53, 0x28, 0xbf, 0x2e, 0xc1, 0x82, 0x8a, 0xe8, 0xa7, 0xa6, 0x1e, 0x75, 0x1a, 0xf5,
+	0x28, 0x2c, 0xc6, 0x72, 0xc2, 0xe3, 0xf5, 0xf7, 0x87, 0x60, 0x39, 0x58, 0xd6, 0xde, 0x11, 0x85,
+	0x15, 0x3e, 0x3c, 0x02, 0xa6, 0xc9, 0xe5, 0x88, 0xff, 0x5e, 0xd0, 0x71, 0x7b, 0x0d, 0x8e, 0xfc,
+	0x72, 0xc4, 0x77, 0x09, 0xc8, 0xbe, 0x43, 0x14, 0xa0, 0x48, 0x8b, 0xfb, 0xfb, 0xcb, 0x19, 0x79,
+	0x14, 0x69, 0xb2, 0x8e, 0xda, 0x78, 0x05, 0x4e, 0x75, 0x53, 0x1c, 0xd7, 0xf1, 0xef, 0x48, 0x50,
+	0x66, 0xb5, 0xd1, 0x83, 0x54, 0x8b, 0x7c, 0x09, 0x46, 0xfa, 0x7d, 0x12, 0x96, 0x3e, 0x68, 0x67,
+	0x7b, 0xf2, 0x01, 0x1c, 0x4b, 0x04, 0xf5, 0x0a, 0x45, 0xc2, 0x47, 0xf6, 0x2f, 0x1e, 0x7c, 0xf8,
+	0xc8, 0xe1, 0xfd, 0x7b, 0x12, 0xac, 0x6c, 0xb8, 0x0e, 0xd2, 0x1a, 0x9d, 0x13, 0x7e, 0x62, 0x0e,
+	0xa7, 0x09, 0x73, 0xb8, 0x6d, 0xe9, 0x81, 0x08, 0xd2, 0x3d, 0xf5, 0x1f, 0x3a, 0x23, 0x91, 0xeb,
+	0x8f, 0x50, 0x10, 0x41, 0xd7, 0x0e, 0xa9, 0x33, 0x38, 0xa6, 0x7d, 0x75, 0x1c, 0x40, 0x73, 0x5d,
+	0xc7, 0xdc, 0x6a, 0xb9, 0x08, 0x93, 0xcd, 0xda, 0x13, 0x3d, 0x30, 0xcb, 0x15, 0x77, 0xc7, 0xf7,
+	0x85, 0x0c, 0x29, 0x6c, 0xb7, 0x64, 0xfe, 0x52, 0x48, 0x5f, 0x3b, 0xd4, 0xf9, 0x82, 0x46, 0x88,
+	0xb5, 0x9f, 0x49, 0xb0, 0x1c, 0x64, 0xcd, 0xd3, 0xba, 0xb7, 0x71, 0xfb, 0xf9, 0xcf, 0xe6, 0x93,
+	0x50, 0x08, 0x7d, 0x3e, 0x8d, 0xe5, 0x72, 0xc7, 0xb7, 0xfd, 0x9f, 0x4e, 0xbb, 0x08, 0x63, 0x9d,
+	0x6f, 0xaa, 0xb3, 0xbd, 0x4b, 0x21, 0x7c, 0xcf, 0xe8, 0x1d, 0x8e, 0x28, 0x12, 0x3d, 0x12, 0x01,
+	0x12, 0x7f, 0x62, 0xe5, 0x77, 0x25, 0x38, 0xd5, 0x4d, 0x7c, 0x6e, 0x96, 0x0b, 0x30, 0x22, 0xee,
+	0x15, 0xa5, 0xb8, 0x1b, 0xcd, 0xe8, 0x61, 0x57, 0x15, 0x08, 0xd1, 0x6f, 0xf5, 0x0c, 0x45, 0xbf,
+	0xd5, 0x13, 0x7f, 0xeb, 0xad, 0xfc, 0xa1, 0x04, 0x8a, 0xff, 0xcb, 0x4a, 0x1e, 0x7f, 0x6c, 0xae,
+	0xf4, 0x61, 0x9d, 0x3b, 0x30, 0xd2, 0xef, 0xd3, 0xd7, 0xee, 0x03, 0x77, 0x42, 0xc2, 0xaf, 0x49,
+	0x70, 0x22, 0x15, 0xde, 0x4b, 0x69, 0x86, 0xe3, 0xc2, 0xa5, 0xc1, 0xf8, 0x88, 0xc4, 0x86, 0x57,
+	0x41, 0xb9, 0x61, 0x92, 0xab, 0xe4, 0x56, 0xdd, 0xad, 0x5a, 0xef, 0x22, 0x9d, 0xce, 0x4b, 0x1d,
+	0x59, 0x9a, 0x63, 0xda, 0xb8, 0x87, 0xeb, 0x91, 0x6f, 0x49, 0x70, 0x22, 0x95, 0x02, 0x17, 0xe5,
+	0x4b, 0x30, 0x8a, 0x45, 0x23, 0x3f, 0x55, 0xbc, 0xdc, 0xd3, 0x11, 0x30, 0x9e, 0xb0, 0xda, 0xa1,
+	0xe6, 0xbf, 0x35, 0x1a, 0x0a, 0xdc, 0x1a, 0x29, 0x7f, 0x24, 0xc1, 0x09, 0x26, 0x7a, 0x02, 0x95,
+	0xee, 0x77, 0x2d, 0x32, 0x64, 0x7d, 0x37, 0x06, 0xf4, 0x6f, 0x32, 0xa0, 0xf8, 0xfe, 0x13, 0x2b,
+	0x4e, 0x17, 0x3f, 0xe5, 0x97, 0x21, 0x2f, 0xfe, 0x35, 0x43, 0x29, 0xdb, 0xdb, 0x77, 0xfe, 0x3c,
+	0x04, 0xe5, 0x9b, 0x12, 0x9c, 0x4c, 0xe7, 0x96, 0xeb, 0xf2, 0x36, 0xe4, 0x85, 0xf4, 0xdc, 0x2d,
+	0x06, 0x52, 0xa5, 0x47, 0x2c, 0x45, 0x93, 0xdf, 0x93, 0x60, 0xe1, 0xa6, 0xb9, 0xe3, 0x90, 0x40,
+	0xce, 0x26, 0x6a, 0x8f, 0xf7, 0x67, 0xa4, 0xe0, 0xc4, 0xd5, 0x9c, 0x1d, 0xe4, 0xd6, 0x18, 0x84,
+	0x6e, 0xb7, 0x2c, 0x97, 0x67, 0x56, 0x8a, 0xac, 0x87, 0x92, 0x5a, 0x23, 0xed, 0xe4, 0xf6, 0xb1,
+	0x93, 0xfa, 0x60, 0xdf, 0x79, 0xc9, 0x37, 0x53, 0x72, 0x1e, 0xd9, 0xb8, 0x7c, 0xc5, 0x07, 0xb0,
+	0x18, 0xcb, 0x6b, 0x7f, 0x69, 0x0f, 0x52, 0x36, 0xdb, 0x60, 0x64, 0x7c, 0xd5, 0xbb, 0x3e, 0xfe,
+	0x33, 0xea, 0x9c, 0xe8, 0xf7, 0x15, 0x6b, 0xb6, 0x2c, 0x57, 0xf9, 0x44, 0x82, 0x23, 0x6b, 0x76,
+	0xb3, 0x7d, 0x33, 0xdc, 0xdd, 0x83, 0xbe, 0xee, 0xc0, 0xc4, 0x83, 0xbd, 0x05, 0x1f, 0x6f, 0xf8,
+	0x7e, 0x91, 0x1c, 0x93, 0xb1, 0x55, 0x73, 0xe8, 0x1e, 0x2a, 0xfc, 0x8c, 0xd3, 0xd8, 0x62, 0x7b,
+	0x2b, 0x51, 0xfd, 0x7b, 0x14, 0xc0, 0xc4, 0x35, 0xfe, 0x4d, 0x1c, 0xfe, 0x9c, 0x6a, 0xd4, 0xc4,
+	0x6b, 0xac, 0x41, 0x39, 0x06, 0x47, 0x13, 0x84, 0xe4, 0xc1, 0xe5, 0x9b, 0x12, 0x94, 0xab, 0x84,
+	0xe9, 0x81, 0x1e, 0x45, 0x6d, 0xc2, 0xf0, 0x56, 0xcb, 0x32, 0xea, 0xe9, 0x27, 0xa9, 0xb0, 0xaf,
+	0x47, 0x46, 0x5c, 0xa5, 0x34, 0x54, 0x4e, 0x8b, 0x3c, 0x38, 0x4d, 0x64, 0x8d, 0xb1, 0xbf, 0xda,
+	0xfc, 0xe8, 0xe3, 0xf2, 0xa1, 0x1f, 0x7d, 0x5c, 0x3e, 0xf4, 0xd3, 0x8f, 0xcb, 0xd2, 0x2f, 0xdf,
+	0x2f, 0x4b, 0xdf, 0xbd, 0x5f, 0x96, 0xfe, 0xfa, 0x7e, 0x59, 0xfa, 0xe8, 0x7e, 0x59, 0xfa, 0xa7,
+	0xfb, 0x65, 0xe9, 0x27, 0xf7, 0xcb, 0x87, 0x7e, 0x7a, 0xbf, 0x2c, 0x7d, 0xf8, 0x49, 0xf9, 0xd0,
+	0x47, 0x9f, 0x94, 0x0f, 0xfd, 0xe8, 0x93, 0xf2, 0xa1, 0xb7, 0x2f, 0xec, 0xd8, 0x1d, 0x06, 0x4d,
+	0x3b, 0xf5, 0xdf, 0xc2, 0xbc, 0x1c, 0x6c, 0xd9, 0x1a, 0xa6, 0x41, 0xe2, 0xfc, 0xff, 0x0d, 0x00,
+	0x76, 0x5d, 0x92, 0x1a, 0x55, 0x66, 0x00, 0x00,
+}
+
+func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(StartWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.StartRequest.Equal(that1.StartRequest) {
+		return false
+	}
+	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if that1.WorkflowExecutionExpirationTime == nil {
+		if this.WorkflowExecutionExpirationTime != nil {
+			return false
+		}
+	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
+		return false
+	}
+	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
+		return false
+	}
+	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
+		return false
+	}
+	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
+		return false
+	}
+	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
+		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
+			return false
+		}
+	} else if this.FirstWorkflowTaskBackoff != nil {
+		return false
+	} else if that1.FirstWorkflowTaskBackoff != nil {
+		return false
+	}
+	if len(this.CompletionCallbacks) != len(that1.CompletionCallbacks) {
+		return false
+	}
+	for i := range this.CompletionCallbacks {
+		if !this.CompletionCallbacks[i].Equal(that1.CompletionCallbacks[i]) {
+			return false
+		}
+	}
+	if this.WorkflowIdConflictPolicy != that1.WorkflowIdConflictPolicy {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(StartWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if !this.Clock.Equal(that1.Clock) {
+		return false
+	}
+	if !this.EagerWorkflowTask.Equal(that1.EagerWorkflowTask) {
+		return false
+	}
+	return true
+}
+func (this *GetMutableStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetMutableStateRequest)
 	if !ok {
 		that2, ok := that.(GetMutableStateRequest)
 		if ok {
@@ -6368,6 +7598,9 @@ func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	if this.FirstExecutionRunId != that1.FirstExecutionRunId {
 		return false
 	}
+	if !this.BuildIdAssignment.Equal(that1.BuildIdAssignment) {
+		return false
+	}
 	return true
 }
 func (this *PollMutableStateRequest) Equal(that interface{}) bool {
@@ -7310,6 +8543,236 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *UpdateActivityOptionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateActivityOptionsRequest)
+	if !ok {
+		that2, ok := that.(UpdateActivityOptionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *UpdateActivityOptionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateActivityOptionsResponse)
+	if !ok {
+		that2, ok := that.(UpdateActivityOptionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	return true
+}
+func (this *ResetActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetActivityRequest)
+	if !ok {
+		that2, ok := that.(ResetActivityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.ActivityId != that1.ActivityId {
+		return false
+	}
+	if this.ResetAttempts != that1.ResetAttempts {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *ResetActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetActivityResponse)
+	if !ok {
+		that2, ok := that.(ResetActivityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ActivityIds) != len(that1.ActivityIds) {
+		return false
+	}
+	for i := range this.ActivityIds {
+		if this.ActivityIds[i] != that1.ActivityIds[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -7720,6 +9183,14 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if !this.PendingWorkflowTask.Equal(that1.PendingWorkflowTask) {
 		return false
 	}
+	if len(this.CompletionCallbacks) != len(that1.CompletionCallbacks) {
+		return false
+	}
+	for i := range this.CompletionCallbacks {
+		if !this.CompletionCallbacks[i].Equal(that1.CompletionCallbacks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
@@ -7969,6 +9440,16 @@ func (this *SyncActivityRequest) Equal(that interface{}) bool {
 	if !this.BaseExecutionInfo.Equal(that1.BaseExecutionInfo) {
 		return false
 	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if that1.RetryExpirationTime == nil {
+		if this.RetryExpirationTime != nil {
+			return false
+		}
+	} else if !this.RetryExpirationTime.Equal(*that1.RetryExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *SyncActivityResponse) Equal(that interface{}) bool {
@@ -8566,70 +10047,78 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PurgeDLQMessagesRequest)
-	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if len(this.HistoryTasks) != len(that1.HistoryTasks) {
 		return false
 	}
-	return true
-}
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PurgeDLQMessagesResponse)
-	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.HistoryTasks {
+		if !this.HistoryTasks[i].Equal(that1.HistoryTasks[i]) {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
 	return true
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
+	that1, ok := that.(*PurgeDLQMessagesRequest)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	return true
+}
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -9220,6 +10709,74 @@ func (this *StreamWorkflowReplicationMessagesResponse_Messages) Equal(that inter
 	}
 	return true
 }
+func (this *StreamWorkflowExecutionHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamWorkflowExecutionHistoryRequest)
+	if !ok {
+		that2, ok := that.(StreamWorkflowExecutionHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.FirstEventId != that1.FirstEventId {
+		return false
+	}
+	if len(this.EventTypes) != len(that1.EventTypes) {
+		return false
+	}
+	for i := range this.EventTypes {
+		if this.EventTypes[i] != that1.EventTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *StreamWorkflowExecutionHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamWorkflowExecutionHistoryResponse)
+	if !ok {
+		that2, ok := that.(StreamWorkflowExecutionHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.History.Equal(that1.History) {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	return true
+}
 func (this *PollWorkflowExecutionUpdateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -9271,11 +10828,295 @@ func (this *PollWorkflowExecutionUpdateResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ListFaultInjectionScenariosRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListFaultInjectionScenariosRequest)
+	if !ok {
+		that2, ok := that.(ListFaultInjectionScenariosRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	return true
+}
+func (this *ListFaultInjectionScenariosResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListFaultInjectionScenariosResponse)
+	if !ok {
+		that2, ok := that.(ListFaultInjectionScenariosResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Scenarios) != len(that1.Scenarios) {
+		return false
+	}
+	for i := range this.Scenarios {
+		if !this.Scenarios[i].Equal(that1.Scenarios[i]) {
+			return false
+		}
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *UpdateFaultInjectionScenarioRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateFaultInjectionScenarioRequest)
+	if !ok {
+		that2, ok := that.(UpdateFaultInjectionScenarioRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	return true
+}
+func (this *UpdateFaultInjectionScenarioResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateFaultInjectionScenarioResponse)
+	if !ok {
+		that2, ok := that.(UpdateFaultInjectionScenarioResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Scenario.Equal(that1.Scenario) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *MigrateHistoryShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrateHistoryShardRequest)
+	if !ok {
+		that2, ok := that.(MigrateHistoryShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.TargetShardCount != that1.TargetShardCount {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *MigrateHistoryShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrateHistoryShardResponse)
+	if !ok {
+		that2, ok := that.(MigrateHistoryShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if this.MigratedExecutionCount != that1.MigratedExecutionCount {
+		return false
+	}
+	return true
+}
+func (this *CopyMigratedExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CopyMigratedExecutionRequest)
+	if !ok {
+		that2, ok := that.(CopyMigratedExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if !this.MutableState.Equal(that1.MutableState) {
+		return false
+	}
+	if this.DbRecordVersion != that1.DbRecordVersion {
+		return false
+	}
+	if this.IsCurrent != that1.IsCurrent {
+		return false
+	}
+	return true
+}
+func (this *CopyMigratedExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CopyMigratedExecutionResponse)
+	if !ok {
+		that2, ok := that.(CopyMigratedExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ImportWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(ImportWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Bundle.Equal(that1.Bundle) {
+		return false
+	}
+	return true
+}
+func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(ImportWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 15)
 	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.StartRequest != nil {
@@ -9294,6 +11135,10 @@ func (this *StartWorkflowExecutionRequest) GoString() string {
 		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
 	}
 	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
+	if this.CompletionCallbacks != nil {
+		s = append(s, "CompletionCallbacks: "+fmt.Sprintf("%#v", this.CompletionCallbacks)+",\n")
+	}
+	s = append(s, "WorkflowIdConflictPolicy: "+fmt.Sprintf("%#v", this.WorkflowIdConflictPolicy)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9332,7 +11177,7 @@ func (this *GetMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 20)
 	s = append(s, "&historyservice.GetMutableStateResponse{")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
@@ -9359,6 +11204,9 @@ func (this *GetMutableStateResponse) GoString() string {
 	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
 	s = append(s, "LastFirstEventTxnId: "+fmt.Sprintf("%#v", this.LastFirstEventTxnId)+",\n")
 	s = append(s, "FirstExecutionRunId: "+fmt.Sprintf("%#v", this.FirstExecutionRunId)+",\n")
+	if this.BuildIdAssignment != nil {
+		s = append(s, "BuildIdAssignment: "+fmt.Sprintf("%#v", this.BuildIdAssignment)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9483,7 +11331,7 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 		keysForQueries = append(keysForQueries, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v19.WorkflowQuery{"
+	mapStringForQueries := "map[string]*v110.WorkflowQuery{"
 	for _, k := range keysForQueries {
 		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
 	}
@@ -9809,6 +11657,109 @@ func (this *DeleteWorkflowExecutionResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *PauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.PauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.PauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateActivityOptionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.UpdateActivityOptionsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateActivityOptionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.UpdateActivityOptionsResponse{")
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.ResetActivityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	s = append(s, "ResetAttempts: "+fmt.Sprintf("%#v", this.ResetAttempts)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetActivityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.ResetActivityResponse{")
+	s = append(s, "ActivityIds: "+fmt.Sprintf("%#v", this.ActivityIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ResetWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -9993,7 +11944,7 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
 	if this.ExecutionConfig != nil {
 		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
@@ -10010,6 +11961,9 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this.PendingWorkflowTask != nil {
 		s = append(s, "PendingWorkflowTask: "+fmt.Sprintf("%#v", this.PendingWorkflowTask)+",\n")
 	}
+	if this.CompletionCallbacks != nil {
+		s = append(s, "CompletionCallbacks: "+fmt.Sprintf("%#v", this.CompletionCallbacks)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -10095,7 +12049,7 @@ func (this *SyncActivityRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 21)
 	s = append(s, "&historyservice.SyncActivityRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -10120,6 +12074,10 @@ func (this *SyncActivityRequest) GoString() string {
 	if this.BaseExecutionInfo != nil {
 		s = append(s, "BaseExecutionInfo: "+fmt.Sprintf("%#v", this.BaseExecutionInfo)+",\n")
 	}
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "RetryExpirationTime: "+fmt.Sprintf("%#v", this.RetryExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -10378,7 +12336,7 @@ func (this *GetDLQMessagesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.GetDLQMessagesResponse{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	if this.ReplicationTasks != nil {
@@ -10388,6 +12346,9 @@ func (this *GetDLQMessagesResponse) GoString() string {
 	if this.ReplicationTasksInfo != nil {
 		s = append(s, "ReplicationTasksInfo: "+fmt.Sprintf("%#v", this.ReplicationTasksInfo)+",\n")
 	}
+	if this.HistoryTasks != nil {
+		s = append(s, "HistoryTasks: "+fmt.Sprintf("%#v", this.HistoryTasks)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -10675,6 +12636,35 @@ func (this *StreamWorkflowReplicationMessagesResponse_Messages) GoString() strin
 		`Messages:` + fmt.Sprintf("%#v", this.Messages) + `}`}, ", ")
 	return s
 }
+func (this *StreamWorkflowExecutionHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.StreamWorkflowExecutionHistoryRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "EventTypes: "+fmt.Sprintf("%#v", this.EventTypes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamWorkflowExecutionHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.StreamWorkflowExecutionHistoryResponse{")
+	if this.History != nil {
+		s = append(s, "History: "+fmt.Sprintf("%#v", this.History)+",\n")
+	}
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *PollWorkflowExecutionUpdateRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -10700,6 +12690,125 @@ func (this *PollWorkflowExecutionUpdateResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ListFaultInjectionScenariosRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.ListFaultInjectionScenariosRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListFaultInjectionScenariosResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ListFaultInjectionScenariosResponse{")
+	if this.Scenarios != nil {
+		s = append(s, "Scenarios: "+fmt.Sprintf("%#v", this.Scenarios)+",\n")
+	}
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateFaultInjectionScenarioRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.UpdateFaultInjectionScenarioRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Duration: "+fmt.Sprintf("%#v", this.Duration)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateFaultInjectionScenarioResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UpdateFaultInjectionScenarioResponse{")
+	if this.Scenario != nil {
+		s = append(s, "Scenario: "+fmt.Sprintf("%#v", this.Scenario)+",\n")
+	}
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MigrateHistoryShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.MigrateHistoryShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "TargetShardCount: "+fmt.Sprintf("%#v", this.TargetShardCount)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MigrateHistoryShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.MigrateHistoryShardResponse{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "MigratedExecutionCount: "+fmt.Sprintf("%#v", this.MigratedExecutionCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CopyMigratedExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.CopyMigratedExecutionRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	if this.MutableState != nil {
+		s = append(s, "MutableState: "+fmt.Sprintf("%#v", this.MutableState)+",\n")
+	}
+	s = append(s, "DbRecordVersion: "+fmt.Sprintf("%#v", this.DbRecordVersion)+",\n")
+	s = append(s, "IsCurrent: "+fmt.Sprintf("%#v", this.IsCurrent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CopyMigratedExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.CopyMigratedExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ImportWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Bundle != nil {
+		s = append(s, "Bundle: "+fmt.Sprintf("%#v", this.Bundle)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ImportWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -10728,6 +12837,25 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.WorkflowIdConflictPolicy != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowIdConflictPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.FirstWorkflowTaskBackoff != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
 		if err1 != nil {
@@ -10944,6 +13072,20 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.BuildIdAssignment != nil {
+		{
+			size, err := m.BuildIdAssignment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.FirstExecutionRunId) > 0 {
 		i -= len(m.FirstExecutionRunId)
 		copy(dAtA[i:], m.FirstExecutionRunId)
@@ -11006,12 +13148,12 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x6a
 	}
 	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err11 != nil {
-			return 0, err11
+		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n11
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n11))
+		i -= n12
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -11203,12 +13345,12 @@ func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		dAtA[i] = 0x62
 	}
 	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err18 != nil {
-			return 0, err18
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n18
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
+		i -= n19
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -11501,22 +13643,22 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		}
 	}
 	if m.StartedTime != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err29 != nil {
-			return 0, err29
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n29
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n29))
+		i -= n30
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.ScheduledTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err30 != nil {
-			return 0, err30
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n30
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n30))
+		i -= n31
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -11748,12 +13890,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x2a
 	}
 	if m.CurrentAttemptScheduledTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err40 != nil {
-			return 0, err40
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n40
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n40))
+		i -= n41
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -11763,12 +13905,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x18
 	}
 	if m.StartedTime != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err41 != nil {
-			return 0, err41
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n41
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n41))
+		i -= n42
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -12616,7 +14758,7 @@ func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12626,19 +14768,33 @@ func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResetRequest != nil {
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -12658,7 +14814,7 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12668,27 +14824,20 @@ func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12698,46 +14847,26 @@ func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalWorkflowExecution != nil {
-		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ExternalInitiatedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExternalInitiatedEventId))
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.CancelRequest != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -12757,7 +14886,7 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12767,12 +14896,12 @@ func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -12780,7 +14909,7 @@ func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateActivityOptionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12790,31 +14919,26 @@ func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateActivityOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ParentClock != nil {
-		{
-			size, err := m.ParentClock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.ChildClock != nil {
+	if m.Options != nil {
 		{
-			size, err := m.ChildClock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -12824,15 +14948,385 @@ func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.IsFirstWorkflowTask {
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
 		i--
-		if m.IsFirstWorkflowTask {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateActivityOptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateActivityOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ResetAttempts {
+		i--
+		if m.ResetAttempts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetActivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActivityIds) > 0 {
+		for iNdEx := len(m.ActivityIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivityIds[iNdEx])
+			copy(dAtA[i:], m.ActivityIds[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetRequest != nil {
+		{
+			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExternalWorkflowExecution != nil {
+		{
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExternalInitiatedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExternalInitiatedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CancelRequest != nil {
+		{
+			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParentClock != nil {
+		{
+			size, err := m.ParentClock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChildClock != nil {
+		{
+			size, err := m.ChildClock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsFirstWorkflowTask {
+		i--
+		if m.IsFirstWorkflowTask {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.WorkflowExecution != nil {
 		{
@@ -13228,6 +15722,20 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.PendingWorkflowTask != nil {
 		{
 			size, err := m.PendingWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
@@ -13503,12 +16011,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n81, err81 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err81 != nil {
-			return 0, err81
+		n88, err88 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err88 != nil {
+			return 0, err88
 		}
-		i -= n81
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n81))
+		i -= n88
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n88))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -13570,6 +16078,32 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryExpirationTime != nil {
+		n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err89 != nil {
+			return 0, err89
+		}
+		i -= n89
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n89))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BaseExecutionInfo != nil {
 		{
 			size, err := m.BaseExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -13631,22 +16165,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n86, err86 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err86 != nil {
-			return 0, err86
+		n95, err95 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err95 != nil {
+			return 0, err95
 		}
-		i -= n86
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n86))
+		i -= n95
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n95))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n87, err87 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err87 != nil {
-			return 0, err87
+		n96, err96 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err96 != nil {
+			return 0, err96
 		}
-		i -= n87
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n87))
+		i -= n96
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n96))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13656,12 +16190,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n88, err88 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err88 != nil {
-			return 0, err88
+		n97, err97 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err97 != nil {
+			return 0, err97
 		}
-		i -= n88
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n88))
+		i -= n97
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n97))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13905,21 +16439,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA95 := make([]byte, len(m.ShardIds)*10)
-		var j94 int
+		dAtA104 := make([]byte, len(m.ShardIds)*10)
+		var j103 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA104[j103] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j94++
+				j103++
 			}
-			dAtA95[j94] = uint8(num)
-			j94++
+			dAtA104[j103] = uint8(num)
+			j103++
 		}
-		i -= j94
-		copy(dAtA[i:], dAtA95[:j94])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j94))
+		i -= j103
+		copy(dAtA[i:], dAtA104[:j103])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j103))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14066,12 +16600,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n97, err97 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err97 != nil {
-			return 0, err97
+		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err106 != nil {
+			return 0, err106
 		}
-		i -= n97
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n97))
+		i -= n106
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n106))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -14500,6 +17034,20 @@ func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistoryTasks) > 0 {
+		for iNdEx := len(m.HistoryTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ReplicationTasksInfo) > 0 {
 		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -14918,12 +17466,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.MaxReplicationTaskVisibilityTime != nil {
-		n104, err104 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
-		if err104 != nil {
-			return 0, err104
+		n113, err113 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
+		if err113 != nil {
+			return 0, err113
 		}
-		i -= n104
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n104))
+		i -= n113
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n113))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -14980,12 +17528,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if m.ShardLocalTime != nil {
-		n107, err107 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
-		if err107 != nil {
-			return 0, err107
+		n116, err116 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
+		if err116 != nil {
+			return 0, err116
 		}
-		i -= n107
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n107))
+		i -= n116
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n116))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -15051,12 +17599,12 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if m.AckedTaskVisibilityTime != nil {
-		n108, err108 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
-		if err108 != nil {
-			return 0, err108
+		n117, err117 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err117 != nil {
+			return 0, err117
 		}
-		i -= n108
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n108))
+		i -= n117
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n117))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15154,22 +17702,22 @@ func (m *DeleteWorkflowVisibilityRecordRequest) MarshalToSizedBuffer(dAtA []byte
 	var l int
 	_ = l
 	if m.WorkflowCloseTime != nil {
-		n110, err110 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
-		if err110 != nil {
-			return 0, err110
+		n119, err119 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
+		if err119 != nil {
+			return 0, err119
 		}
-		i -= n110
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n110))
+		i -= n119
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n119))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.WorkflowStartTime != nil {
-		n111, err111 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
-		if err111 != nil {
-			return 0, err111
+		n120, err120 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
+		if err120 != nil {
+			return 0, err120
 		}
-		i -= n111
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n111))
+		i -= n120
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n120))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -15401,6 +17949,118 @@ func (m *StreamWorkflowReplicationMessagesResponse_Messages) MarshalToSizedBuffe
 	}
 	return len(dAtA) - i, nil
 }
+func (m *StreamWorkflowExecutionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamWorkflowExecutionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		dAtA127 := make([]byte, len(m.EventTypes)*10)
+		var j126 int
+		for _, num := range m.EventTypes {
+			for num >= 1<<7 {
+				dAtA127[j126] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j126++
+			}
+			dAtA127[j126] = uint8(num)
+			j126++
+		}
+		i -= j126
+		copy(dAtA[i:], dAtA127[:j126])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j126))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FirstEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.History != nil {
+		{
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PollWorkflowExecutionUpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15478,6 +18138,398 @@ func (m *PollWorkflowExecutionUpdateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *ListFaultInjectionScenariosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFaultInjectionScenariosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFaultInjectionScenariosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFaultInjectionScenariosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFaultInjectionScenariosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFaultInjectionScenariosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scenarios) > 0 {
+		for iNdEx := len(m.Scenarios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scenarios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != nil {
+		n132, err132 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration):])
+		if err132 != nil {
+			return 0, err132
+		}
+		i -= n132
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n132))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateFaultInjectionScenarioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateFaultInjectionScenarioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateFaultInjectionScenarioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Scenario != nil {
+		{
+			size, err := m.Scenario.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateHistoryShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateHistoryShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateHistoryShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetShardCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TargetShardCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateHistoryShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateHistoryShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateHistoryShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedExecutionCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MigratedExecutionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyMigratedExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyMigratedExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyMigratedExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsCurrent {
+		i--
+		if m.IsCurrent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DbRecordVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.DbRecordVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MutableState != nil {
+		{
+			size, err := m.MutableState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CopyMigratedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyMigratedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyMigratedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bundle != nil {
+		{
+			size, err := m.Bundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestResponse(v)
 	base := offset
@@ -15529,6 +18581,15 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.WorkflowIdConflictPolicy != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowIdConflictPolicy))
+	}
 	return n
 }
 
@@ -15636,6 +18697,10 @@ func (m *GetMutableStateResponse) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRequestResponse(uint64(l))
 	}
+	if m.BuildIdAssignment != nil {
+		l = m.BuildIdAssignment.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -16262,6 +19327,155 @@ func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateActivityOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateActivityOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ResetAttempts {
+		n += 2
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivityIds) > 0 {
+		for _, s := range m.ActivityIds {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16526,6 +19740,12 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 		l = m.PendingWorkflowTask.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16694,6 +19914,14 @@ func (m *SyncActivityRequest) Size() (n int) {
 		l = m.BaseExecutionInfo.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if m.RetryExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime)
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -17049,6 +20277,12 @@ func (m *GetDLQMessagesResponse) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	if len(m.HistoryTasks) > 0 {
+		for _, e := range m.HistoryTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17422,6 +20656,53 @@ func (m *StreamWorkflowReplicationMessagesResponse_Messages) Size() (n int) {
 	}
 	return n
 }
+func (m *StreamWorkflowExecutionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FirstEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.FirstEventId))
+	}
+	if len(m.EventTypes) > 0 {
+		l = 0
+		for _, e := range m.EventTypes {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *StreamWorkflowExecutionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
 func (m *PollWorkflowExecutionUpdateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17452,6 +20733,172 @@ func (m *PollWorkflowExecutionUpdateResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListFaultInjectionScenariosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *ListFaultInjectionScenariosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scenarios) > 0 {
+		for _, e := range m.Scenarios {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateFaultInjectionScenarioRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Duration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Duration)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateFaultInjectionScenarioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scenario != nil {
+		l = m.Scenario.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateHistoryShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.TargetShardCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TargetShardCount))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateHistoryShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MigratedExecutionCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MigratedExecutionCount))
+	}
+	return n
+}
+
+func (m *CopyMigratedExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.MutableState != nil {
+		l = m.MutableState.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DbRecordVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.DbRecordVersion))
+	}
+	if m.IsCurrent {
+		n += 2
+	}
+	return n
+}
+
+func (m *CopyMigratedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ImportWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Bundle != nil {
+		l = m.Bundle.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -17462,6 +20909,11 @@ func (this *StartWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCompletionCallbacks := "[]*CompletionCallback{"
+	for _, f := range this.CompletionCallbacks {
+		repeatedStringForCompletionCallbacks += strings.Replace(fmt.Sprintf("%v", f), "CompletionCallback", "v11.CompletionCallback", 1) + ","
+	}
+	repeatedStringForCompletionCallbacks += "}"
 	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
@@ -17472,6 +20924,8 @@ func (this *StartWorkflowExecutionRequest) String() string {
 		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
 		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
 		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`CompletionCallbacks:` + repeatedStringForCompletionCallbacks + `,`,
+		`WorkflowIdConflictPolicy:` + fmt.Sprintf("%v", this.WorkflowIdConflictPolicy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17482,7 +20936,7 @@ func (this *StartWorkflowExecutionResponse) String() string {
 	}
 	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`EagerWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.EagerWorkflowTask), "PollWorkflowTaskQueueResponse", "v1.PollWorkflowTaskQueueResponse", 1) + `,`,
 		`}`,
 	}, "")
@@ -17511,8 +20965,8 @@ func (this *GetMutableStateResponse) String() string {
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
 		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
 		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
@@ -17521,6 +20975,7 @@ func (this *GetMutableStateResponse) String() string {
 		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
 		`LastFirstEventTxnId:` + fmt.Sprintf("%v", this.LastFirstEventTxnId) + `,`,
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
+		`BuildIdAssignment:` + strings.Replace(fmt.Sprintf("%v", this.BuildIdAssignment), "BuildIdAssignment", "v19.BuildIdAssignment", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17548,8 +21003,8 @@ func (this *PollMutableStateResponse) String() string {
 		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
 		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
 		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
 		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
 		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v18.VersionHistories", 1) + `,`,
@@ -17592,7 +21047,7 @@ func (this *RecordWorkflowTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17603,7 +21058,7 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 	}
 	repeatedStringForMessages := "[]*Message{"
 	for _, f := range this.Messages {
-		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v110.Message", 1) + ","
+		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v111.Message", 1) + ","
 	}
 	repeatedStringForMessages += "}"
 	keysForQueries := make([]string, 0, len(this.Queries))
@@ -17611,7 +21066,7 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		keysForQueries = append(keysForQueries, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v19.WorkflowQuery{"
+	mapStringForQueries := "map[string]*v110.WorkflowQuery{"
 	for _, k := range keysForQueries {
 		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
 	}
@@ -17625,12 +21080,12 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
 		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v18.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v16.TaskQueue", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v17.TaskQueue", 1) + `,`,
 		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`Messages:` + repeatedStringForMessages + `,`,
 		`}`,
 	}, "")
@@ -17647,7 +21102,7 @@ func (this *RecordActivityTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17657,14 +21112,14 @@ func (this *RecordActivityTaskStartedResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
-		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v111.HistoryEvent", 1) + `,`,
+		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v112.HistoryEvent", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
 		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
 		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17906,6 +21361,97 @@ func (this *DeleteWorkflowExecutionResponse) String() string {
 	}, "")
 	return s
 }
+func (this *PauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityOptionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityOptionsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "ActivityOptions", "v18.ActivityOptions", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateActivityOptionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateActivityOptionsResponse{`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "ActivityOptions", "v18.ActivityOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`ResetAttempts:` + fmt.Sprintf("%v", this.ResetAttempts) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetActivityResponse{`,
+		`ActivityIds:` + fmt.Sprintf("%v", this.ActivityIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResetWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -17958,8 +21504,8 @@ func (this *ScheduleWorkflowTaskRequest) String() string {
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
-		`ChildClock:` + strings.Replace(fmt.Sprintf("%v", this.ChildClock), "VectorClock", "v15.VectorClock", 1) + `,`,
-		`ParentClock:` + strings.Replace(fmt.Sprintf("%v", this.ParentClock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`ChildClock:` + strings.Replace(fmt.Sprintf("%v", this.ChildClock), "VectorClock", "v16.VectorClock", 1) + `,`,
+		`ParentClock:` + strings.Replace(fmt.Sprintf("%v", this.ParentClock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -17980,7 +21526,7 @@ func (this *VerifyFirstWorkflowTaskScheduledRequest) String() string {
 	s := strings.Join([]string{`&VerifyFirstWorkflowTaskScheduledRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18003,8 +21549,8 @@ func (this *RecordChildExecutionCompletedRequest) String() string {
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`ParentInitiatedId:` + fmt.Sprintf("%v", this.ParentInitiatedId) + `,`,
 		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v111.HistoryEvent", 1) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v112.HistoryEvent", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`ParentInitiatedVersion:` + fmt.Sprintf("%v", this.ParentInitiatedVersion) + `,`,
 		`}`,
 	}, "")
@@ -18029,7 +21575,7 @@ func (this *VerifyChildExecutionCompletionRecordedRequest) String() string {
 		`ChildExecution:` + strings.Replace(fmt.Sprintf("%v", this.ChildExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`ParentInitiatedId:` + fmt.Sprintf("%v", this.ParentInitiatedId) + `,`,
 		`ParentInitiatedVersion:` + fmt.Sprintf("%v", this.ParentInitiatedVersion) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v15.VectorClock", 1) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v16.VectorClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18060,20 +21606,26 @@ func (this *DescribeWorkflowExecutionResponse) String() string {
 	}
 	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
 	for _, f := range this.PendingActivities {
-		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v112.PendingActivityInfo", 1) + ","
+		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v113.PendingActivityInfo", 1) + ","
 	}
 	repeatedStringForPendingActivities += "}"
 	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
 	for _, f := range this.PendingChildren {
-		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v112.PendingChildExecutionInfo", 1) + ","
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v113.PendingChildExecutionInfo", 1) + ","
 	}
 	repeatedStringForPendingChildren += "}"
+	repeatedStringForCompletionCallbacks := "[]*CompletionCallbackInfo{"
+	for _, f := range this.CompletionCallbacks {
+		repeatedStringForCompletionCallbacks += strings.Replace(fmt.Sprintf("%v", f), "CompletionCallbackInfo", "v11.CompletionCallbackInfo", 1) + ","
+	}
+	repeatedStringForCompletionCallbacks += "}"
 	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
-		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v112.WorkflowExecutionConfig", 1) + `,`,
-		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v112.WorkflowExecutionInfo", 1) + `,`,
+		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v113.WorkflowExecutionConfig", 1) + `,`,
+		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v113.WorkflowExecutionInfo", 1) + `,`,
 		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
 		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
-		`PendingWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.PendingWorkflowTask), "PendingWorkflowTaskInfo", "v112.PendingWorkflowTaskInfo", 1) + `,`,
+		`PendingWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.PendingWorkflowTask), "PendingWorkflowTaskInfo", "v113.PendingWorkflowTaskInfo", 1) + `,`,
+		`CompletionCallbacks:` + repeatedStringForCompletionCallbacks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18112,7 +21664,7 @@ func (this *ReplicateWorkflowStateRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ReplicateWorkflowStateRequest{`,
-		`WorkflowState:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowState), "WorkflowMutableState", "v113.WorkflowMutableState", 1) + `,`,
+		`WorkflowState:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowState), "WorkflowMutableState", "v19.WorkflowMutableState", 1) + `,`,
 		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`}`,
@@ -18169,6 +21721,8 @@ func (this *SyncActivityRequest) String() string {
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
 		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v18.VersionHistory", 1) + `,`,
 		`BaseExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.BaseExecutionInfo), "BaseExecutionInfo", "v11.BaseExecutionInfo", 1) + `,`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "ActivityOptions", "v18.ActivityOptions", 1) + `,`,
+		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18198,8 +21752,8 @@ func (this *DescribeMutableStateResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v113.WorkflowMutableState", 1) + `,`,
-		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v113.WorkflowMutableState", 1) + `,`,
+		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v19.WorkflowMutableState", 1) + `,`,
+		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v19.WorkflowMutableState", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18264,7 +21818,7 @@ func (this *GetShardResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetShardResponse{`,
-		`ShardInfo:` + strings.Replace(fmt.Sprintf("%v", this.ShardInfo), "ShardInfo", "v113.ShardInfo", 1) + `,`,
+		`ShardInfo:` + strings.Replace(fmt.Sprintf("%v", this.ShardInfo), "ShardInfo", "v19.ShardInfo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18427,11 +21981,17 @@ func (this *GetDLQMessagesResponse) String() string {
 		repeatedStringForReplicationTasksInfo += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v115.ReplicationTaskInfo", 1) + ","
 	}
 	repeatedStringForReplicationTasksInfo += "}"
+	repeatedStringForHistoryTasks := "[]*HistoryDLQTaskInfo{"
+	for _, f := range this.HistoryTasks {
+		repeatedStringForHistoryTasks += strings.Replace(fmt.Sprintf("%v", f), "HistoryDLQTaskInfo", "v18.HistoryDLQTaskInfo", 1) + ","
+	}
+	repeatedStringForHistoryTasks += "}"
 	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
 		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`ReplicationTasksInfo:` + repeatedStringForReplicationTasksInfo + `,`,
+		`HistoryTasks:` + repeatedStringForHistoryTasks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18707,6 +22267,31 @@ func (this *StreamWorkflowReplicationMessagesResponse_Messages) String() string
 	}, "")
 	return s
 }
+func (this *StreamWorkflowExecutionHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamWorkflowExecutionHistoryRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamWorkflowExecutionHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamWorkflowExecutionHistoryResponse{`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "History", "v112.History", 1) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PollWorkflowExecutionUpdateRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -18728,6 +22313,122 @@ func (this *PollWorkflowExecutionUpdateResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ListFaultInjectionScenariosRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListFaultInjectionScenariosRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListFaultInjectionScenariosResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScenarios := "[]*FaultInjectionScenario{"
+	for _, f := range this.Scenarios {
+		repeatedStringForScenarios += strings.Replace(fmt.Sprintf("%v", f), "FaultInjectionScenario", "v116.FaultInjectionScenario", 1) + ","
+	}
+	repeatedStringForScenarios += "}"
+	s := strings.Join([]string{`&ListFaultInjectionScenariosResponse{`,
+		`Scenarios:` + repeatedStringForScenarios + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateFaultInjectionScenarioRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateFaultInjectionScenarioRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateFaultInjectionScenarioResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateFaultInjectionScenarioResponse{`,
+		`Scenario:` + strings.Replace(fmt.Sprintf("%v", this.Scenario), "FaultInjectionScenario", "v116.FaultInjectionScenario", 1) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MigrateHistoryShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MigrateHistoryShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`TargetShardCount:` + fmt.Sprintf("%v", this.TargetShardCount) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MigrateHistoryShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MigrateHistoryShardResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`MigratedExecutionCount:` + fmt.Sprintf("%v", this.MigratedExecutionCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyMigratedExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyMigratedExecutionRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`MutableState:` + strings.Replace(fmt.Sprintf("%v", this.MutableState), "WorkflowMutableState", "v19.WorkflowMutableState", 1) + `,`,
+		`DbRecordVersion:` + fmt.Sprintf("%v", this.DbRecordVersion) + `,`,
+		`IsCurrent:` + fmt.Sprintf("%v", this.IsCurrent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyMigratedExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyMigratedExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Bundle:` + strings.Replace(fmt.Sprintf("%v", this.Bundle), "WorkflowExecutionBundle", "v116.WorkflowExecutionBundle", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -19051,94 +22752,9 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19165,18 +22781,16 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v11.CompletionCallback{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EagerWorkflowTask", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowIdConflictPolicy", wireType)
 			}
-			var msglen int
+			m.WorkflowIdConflictPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19186,28 +22800,168 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WorkflowIdConflictPolicy |= v15.WorkflowIdConflictPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EagerWorkflowTask == nil {
-				m.EagerWorkflowTask = &v1.PollWorkflowTaskQueueResponse{}
-			}
-			if err := m.EagerWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clock == nil {
+				m.Clock = &v16.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EagerWorkflowTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EagerWorkflowTask == nil {
+				m.EagerWorkflowTask = &v1.PollWorkflowTaskQueueResponse{}
+			}
+			if err := m.EagerWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19594,7 +23348,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TaskQueue == nil {
-				m.TaskQueue = &v16.TaskQueue{}
+				m.TaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -19630,7 +23384,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v16.TaskQueue{}
+				m.StickyTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -19720,7 +23474,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v17.WorkflowExecutionState(b&0x7F) << shift
+				m.WorkflowState |= v15.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19851,6 +23605,42 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuildIdAssignment == nil {
+				m.BuildIdAssignment = &v19.BuildIdAssignment{}
+			}
+			if err := m.BuildIdAssignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20237,7 +24027,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TaskQueue == nil {
-				m.TaskQueue = &v16.TaskQueue{}
+				m.TaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20273,7 +24063,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v16.TaskQueue{}
+				m.StickyTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -20399,7 +24189,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v17.WorkflowExecutionState(b&0x7F) << shift
+				m.WorkflowState |= v15.WorkflowExecutionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -20905,7 +24695,7 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21181,7 +24971,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v16.TaskQueue{}
+				m.WorkflowExecutionTaskQueue = &v17.TaskQueue{}
 			}
 			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21323,10 +25113,10 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Queries == nil {
-				m.Queries = make(map[string]*v19.WorkflowQuery)
+				m.Queries = make(map[string]*v110.WorkflowQuery)
 			}
 			var mapkey string
-			var mapvalue *v19.WorkflowQuery
+			var mapvalue *v110.WorkflowQuery
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -21400,7 +25190,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v19.WorkflowQuery{}
+					mapvalue = &v110.WorkflowQuery{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -21452,7 +25242,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21487,7 +25277,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &v110.Message{})
+			m.Messages = append(m.Messages, &v111.Message{})
 			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -21749,7 +25539,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -21838,7 +25628,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v111.HistoryEvent{}
+				m.ScheduledEvent = &v112.HistoryEvent{}
 			}
 			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22069,7 +25859,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+				m.Clock = &v16.VectorClock{}
 			}
 			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -24337,7 +28127,7 @@ func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24360,10 +28150,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24400,7 +28190,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24427,13 +28217,77 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetRequest == nil {
-				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -24458,7 +28312,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24481,44 +28335,12 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -24543,7 +28365,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24566,10 +28388,10 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24606,7 +28428,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24633,37 +28455,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
-			}
-			m.ExternalInitiatedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24673,48 +28476,24 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -24739,7 +28518,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24762,10 +28541,10 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -24792,7 +28571,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24815,10 +28594,10 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24890,10 +28669,10 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24903,15 +28682,27 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFirstWorkflowTask = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildClock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24938,18 +28729,18 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChildClock == nil {
-				m.ChildClock = &v15.VectorClock{}
+			if m.Options == nil {
+				m.Options = &v18.ActivityOptions{}
 			}
-			if err := m.ChildClock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentClock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24959,27 +28750,23 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentClock == nil {
-				m.ParentClock = &v15.VectorClock{}
-			}
-			if err := m.ParentClock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25005,60 +28792,7 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateActivityOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25081,83 +28815,15 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateActivityOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25184,10 +28850,10 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
+			if m.Options == nil {
+				m.Options = &v18.ActivityOptions{}
 			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25215,7 +28881,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyFirstWorkflowTaskScheduledResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25238,63 +28904,10 @@ func (m *VerifyFirstWorkflowTaskScheduledResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25366,10 +28979,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
 			}
-			m.ParentInitiatedId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25379,16 +28992,49 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParentInitiatedId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAttempts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetAttempts = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25398,33 +29044,82 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25434,33 +29129,82 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v111.HistoryEvent{}
-			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActivityIds = append(m.ActivityIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25470,33 +29214,29 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedVersion", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
 			}
-			m.ParentInitiatedVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25506,11 +29246,28 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParentInitiatedVersion |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResetRequest == nil {
+				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			}
+			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25535,7 +29292,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25558,12 +29315,44 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25588,7 +29377,7 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25611,10 +29400,10 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25651,7 +29440,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25678,16 +29467,35 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentExecution == nil {
-				m.ParentExecution = &v14.WorkflowExecution{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := m.ParentExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+			}
+			m.ExternalInitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25714,56 +29522,18 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChildExecution == nil {
-				m.ChildExecution = &v14.WorkflowExecution{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.ChildExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedId", wireType)
-			}
-			m.ParentInitiatedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParentInitiatedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedVersion", wireType)
-			}
-			m.ParentInitiatedVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParentInitiatedVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -25773,28 +29543,12 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Clock == nil {
-				m.Clock = &v15.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -25819,7 +29573,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *VerifyChildExecutionCompletionRecordedResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25842,10 +29596,10 @@ func (m *VerifyChildExecutionCompletionRecordedResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -25872,7 +29626,7 @@ func (m *VerifyChildExecutionCompletionRecordedResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25895,10 +29649,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25935,7 +29689,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25962,69 +29716,36 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IsFirstWorkflowTask = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildClock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26051,16 +29772,16 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfig == nil {
-				m.ExecutionConfig = &v112.WorkflowExecutionConfig{}
+			if m.ChildClock == nil {
+				m.ChildClock = &v16.VectorClock{}
 			}
-			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChildClock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentClock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26087,18 +29808,124 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v112.WorkflowExecutionInfo{}
+			if m.ParentClock == nil {
+				m.ParentClock = &v16.VectorClock{}
 			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentClock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyFirstWorkflowTaskScheduledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26108,29 +29935,27 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v112.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26157,14 +29982,16 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v112.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkflowTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26191,10 +30018,10 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingWorkflowTask == nil {
-				m.PendingWorkflowTask = &v112.PendingWorkflowTaskInfo{}
+			if m.Clock == nil {
+				m.Clock = &v16.VectorClock{}
 			}
-			if err := m.PendingWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26222,7 +30049,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
+func (m *VerifyFirstWorkflowTaskScheduledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26245,10 +30072,63 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyFirstWorkflowTaskScheduledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26320,10 +30200,10 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedId", wireType)
 			}
-			var msglen int
+			m.ParentInitiatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26333,29 +30213,14 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ParentInitiatedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v18.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26382,16 +30247,16 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26418,16 +30283,16 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v112.HistoryEvent{}
 			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26454,13 +30319,32 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseExecutionInfo == nil {
-				m.BaseExecutionInfo = &v11.BaseExecutionInfo{}
+			if m.Clock == nil {
+				m.Clock = &v16.VectorClock{}
 			}
-			if err := m.BaseExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedVersion", wireType)
+			}
+			m.ParentInitiatedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentInitiatedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -26485,7 +30369,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26508,10 +30392,10 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26538,7 +30422,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
+func (m *VerifyChildExecutionCompletionRecordedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26561,15 +30445,47 @@ func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateWorkflowStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateWorkflowStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26596,18 +30512,18 @@ func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowState == nil {
-				m.WorkflowState = &v113.WorkflowMutableState{}
+			if m.ParentExecution == nil {
+				m.ParentExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.WorkflowState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParentExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26617,29 +30533,71 @@ func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			if m.ChildExecution == nil {
+				m.ChildExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.ChildExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedId", wireType)
+			}
+			m.ParentInitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentInitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentInitiatedVersion", wireType)
+			}
+			m.ParentInitiatedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentInitiatedVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26649,23 +30607,27 @@ func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.Clock == nil {
+				m.Clock = &v16.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26691,7 +30653,7 @@ func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateWorkflowStateResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyChildExecutionCompletionRecordedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26714,10 +30676,10 @@ func (m *ReplicateWorkflowStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateWorkflowStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateWorkflowStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyChildExecutionCompletionRecordedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26744,7 +30706,7 @@ func (m *ReplicateWorkflowStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26767,15 +30729,15 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26803,30 +30765,11 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26853,10 +30796,10 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26884,60 +30827,7 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26960,17 +30850,17 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -26980,29 +30870,33 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			if m.ExecutionConfig == nil {
+				m.ExecutionConfig = &v113.WorkflowExecutionConfig{}
 			}
-			var stringLen uint64
+			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27012,29 +30906,33 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v113.WorkflowExecutionInfo{}
+			}
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27044,29 +30942,31 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.PendingActivities = append(m.PendingActivities, &v113.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27076,33 +30976,29 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChildren = append(m.PendingChildren, &v113.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWorkflowTask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27129,35 +31025,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.PendingWorkflowTask == nil {
+				m.PendingWorkflowTask = &v113.PendingWorkflowTaskInfo{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
-			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27184,18 +31061,69 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v11.CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27205,31 +31133,27 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27256,35 +31180,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27311,18 +31216,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v13.Failure{}
-			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v18.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27332,27 +31235,31 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			if m.Events == nil {
+				m.Events = &v14.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27379,14 +31286,14 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v18.VersionHistory{}
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v14.DataBlob{}
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseExecutionInfo", wireType)
 			}
@@ -27446,7 +31353,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27469,10 +31376,10 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -27499,7 +31406,7 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicateWorkflowStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27522,15 +31429,51 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateWorkflowStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateWorkflowStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowState == nil {
+				m.WorkflowState = &v19.WorkflowMutableState{}
+			}
+			if err := m.WorkflowState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27558,13 +31501,13 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27574,27 +31517,23 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27620,7 +31559,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateWorkflowStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27643,84 +31582,12 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateWorkflowStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateWorkflowStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CacheMutableState == nil {
-				m.CacheMutableState = &v113.WorkflowMutableState{}
-			}
-			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DatabaseMutableState == nil {
-				m.DatabaseMutableState = &v113.WorkflowMutableState{}
-			}
-			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -27745,7 +31612,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27768,15 +31635,15 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27804,7 +31671,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -27827,9 +31694,9 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27839,60 +31706,81 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -27917,7 +31805,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27940,17 +31828,1482 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardsNumber = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &v14.Payloads{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailure == nil {
+				m.LastFailure = &v13.Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v18.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseExecutionInfo == nil {
+				m.BaseExecutionInfo = &v11.BaseExecutionInfo{}
+			}
+			if err := m.BaseExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &v18.ActivityOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CacheMutableState == nil {
+				m.CacheMutableState = &v19.WorkflowMutableState{}
+			}
+			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatabaseMutableState == nil {
+				m.DatabaseMutableState = &v19.WorkflowMutableState{}
+			}
+			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v114.NamespaceCacheInfo{}
+			}
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -27960,90 +33313,141 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardInfo == nil {
+				m.ShardInfo = &v19.ShardInfo{}
+			}
+			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v15.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28070,16 +33474,156 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v114.NamespaceCacheInfo{}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
 			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &v115.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28107,7 +33651,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28133,7 +33677,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28156,17 +33700,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28176,11 +33720,107 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v115.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v115.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v115.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -28205,60 +33845,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28281,17 +33868,17 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28301,11 +33888,26 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v115.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -28330,7 +33932,7 @@ func (m *GetShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28353,15 +33955,15 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28388,10 +33990,8 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardInfo == nil {
-				m.ShardInfo = &v113.ShardInfo{}
-			}
-			if err := m.ShardInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v115.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28419,7 +34019,7 @@ func (m *GetShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28442,17 +34042,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28462,52 +34062,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
-			}
-			m.Category = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Category |= v17.TaskCategory(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28534,10 +34109,10 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28565,7 +34140,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28588,12 +34163,48 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &v1.QueryWorkflowResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -28618,7 +34229,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28641,17 +34252,17 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28661,31 +34272,29 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v115.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28695,23 +34304,27 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &v116.ReapplyEventsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28737,7 +34350,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28760,127 +34373,12 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v115.ReplicationMessages)
-			}
-			var mapkey int32
-			var mapvalue *v115.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v115.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -28905,7 +34403,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28928,17 +34426,55 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -28948,84 +34484,67 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v115.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29035,24 +34554,24 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v115.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -29079,7 +34598,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29102,17 +34621,36 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29122,29 +34660,31 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.ReplicationTasks = append(m.ReplicationTasks, &v115.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29154,84 +34694,63 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v115.ReplicationTaskInfo{})
+			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29258,10 +34777,8 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &v1.QueryWorkflowResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HistoryTasks = append(m.HistoryTasks, &v18.HistoryDLQTaskInfo{})
+			if err := m.HistoryTasks[len(m.HistoryTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29289,7 +34806,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29312,15 +34829,53 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29348,13 +34903,13 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var msglen int
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29364,28 +34919,11 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &v116.ReapplyEventsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -29410,7 +34948,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29433,10 +34971,10 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -29463,7 +35001,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29486,10 +35024,10 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -29506,7 +35044,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.Type |= v15.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -29658,7 +35196,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29681,17 +35219,104 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.Type = 0
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29701,14 +35326,27 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29735,16 +35373,124 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v115.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &v116.RefreshWorkflowTasksRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -29754,29 +35500,27 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29796,18 +35540,73 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v115.ReplicationTaskInfo{})
-			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateLastHistoryReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -29832,7 +35631,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29855,53 +35654,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29929,27 +35690,8 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.RemoteClusters = append(m.RemoteClusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -29974,7 +35716,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29997,12 +35739,46 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardReplicationStatus{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -30027,7 +35803,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30050,17 +35826,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.Type = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30070,16 +35846,16 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v17.DeadLetterQueueType(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskId", wireType)
 			}
-			m.ShardId = 0
+			m.MaxReplicationTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30089,16 +35865,16 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.MaxReplicationTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardLocalTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30108,29 +35884,33 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			if m.ShardLocalTime == nil {
+				m.ShardLocalTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ShardLocalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30140,35 +35920,344 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteClusters == nil {
+				m.RemoteClusters = make(map[string]*ShardReplicationStatusPerCluster)
+			}
+			var mapkey string
+			var mapvalue *ShardReplicationStatusPerCluster
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ShardReplicationStatusPerCluster{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RemoteClusters[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverNamespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HandoverNamespaces == nil {
+				m.HandoverNamespaces = make(map[string]*HandoverNamespaceInfo)
+			}
+			var mapkey string
+			var mapvalue *HandoverNamespaceInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HandoverNamespaceInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HandoverNamespaces[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskVisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxReplicationTaskVisibilityTime == nil {
+				m.MaxReplicationTaskVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MaxReplicationTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandoverNamespaceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandoverNamespaceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandoverNamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverReplicationTaskId", wireType)
 			}
-			var byteLen int
+			m.HandoverReplicationTaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30178,26 +36267,11 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.HandoverReplicationTaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -30222,7 +36296,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30245,17 +36319,36 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShardReplicationStatusPerCluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShardReplicationStatusPerCluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
+			}
+			m.AckedTaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckedTaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskVisibilityTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -30265,24 +36358,26 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.AckedTaskVisibilityTime == nil {
+				m.AckedTaskVisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AckedTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -30309,7 +36404,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30332,10 +36427,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30372,7 +36467,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30399,10 +36494,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v116.RefreshWorkflowTasksRequest{}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30430,7 +36525,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30453,10 +36548,10 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebuildMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebuildMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30483,7 +36578,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30506,10 +36601,10 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30580,6 +36675,78 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) erro
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowStartTime == nil {
+				m.WorkflowStartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowCloseTime == nil {
+				m.WorkflowCloseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowCloseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -30604,7 +36771,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *GenerateLastHistoryReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowVisibilityRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30627,10 +36794,10 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateLastHistoryReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30657,7 +36824,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30680,15 +36847,15 @@ func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30716,7 +36883,43 @@ func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteClusters = append(m.RemoteClusters, string(dAtA[iNdEx:postIndex]))
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.UpdateWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30742,7 +36945,7 @@ func (m *GetReplicationStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30765,15 +36968,15 @@ func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30800,8 +37003,10 @@ func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shards = append(m.Shards, &ShardReplicationStatus{})
-			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Response == nil {
+				m.Response = &v1.UpdateWorkflowExecutionResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30829,7 +37034,7 @@ func (m *GetReplicationStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *StreamWorkflowReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30852,53 +37057,15 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardReplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardReplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskId", wireType)
-			}
-			m.MaxReplicationTaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxReplicationTaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardLocalTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncReplicationState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30925,16 +37092,68 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardLocalTime == nil {
-				m.ShardLocalTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ShardLocalTime, dAtA[iNdEx:postIndex]); err != nil {
+			v := &v115.SyncReplicationState{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Attributes = &StreamWorkflowReplicationMessagesRequest_SyncReplicationState{v}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamWorkflowReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteClusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30961,109 +37180,100 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RemoteClusters == nil {
-				m.RemoteClusters = make(map[string]*ShardReplicationStatusPerCluster)
+			v := &v115.WorkflowReplicationMessages{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *ShardReplicationStatusPerCluster
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ShardReplicationStatusPerCluster{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			m.Attributes = &StreamWorkflowReplicationMessagesResponse_Messages{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamWorkflowExecutionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RemoteClusters[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HandoverNamespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31090,14 +37300,35 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HandoverNamespaces == nil {
-				m.HandoverNamespaces = make(map[string]*HandoverNamespaceInfo)
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			var mapkey string
-			var mapvalue *HandoverNamespaceInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			}
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v v12.EventType
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowRequestResponse
@@ -31107,43 +37338,44 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= v12.EventType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
+				m.EventTypes = append(m.EventTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
 					}
-					if postStringIndexmapkey > l {
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.EventTypes) == 0 {
+					m.EventTypes = make([]v12.EventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v12.EventType
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRequestResponse
@@ -31153,79 +37385,16 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						v |= v12.EventType(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &HandoverNamespaceInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.HandoverNamespaces[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationTaskVisibilityTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+					m.EventTypes = append(m.EventTypes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaxReplicationTaskVisibilityTime == nil {
-				m.MaxReplicationTaskVisibilityTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.MaxReplicationTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -31250,7 +37419,7 @@ func (m *ShardReplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandoverNamespaceInfo) Unmarshal(dAtA []byte) error {
+func (m *StreamWorkflowExecutionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31273,17 +37442,53 @@ func (m *HandoverNamespaceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandoverNamespaceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandoverNamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamWorkflowExecutionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.History == nil {
+				m.History = &v112.History{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HandoverReplicationTaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.HandoverReplicationTaskId = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31293,11 +37498,43 @@ func (m *HandoverNamespaceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HandoverReplicationTaskId |= int64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -31322,7 +37559,7 @@ func (m *HandoverNamespaceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
+func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31345,17 +37582,17 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShardReplicationStatusPerCluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollWorkflowExecutionUpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardReplicationStatusPerCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollWorkflowExecutionUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.AckedTaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31365,14 +37602,27 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AckedTaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckedTaskVisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31399,10 +37649,10 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AckedTaskVisibilityTime == nil {
-				m.AckedTaskVisibilityTime = new(time.Time)
+			if m.Request == nil {
+				m.Request = &v1.PollWorkflowExecutionUpdateRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AckedTaskVisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31430,7 +37680,7 @@ func (m *ShardReplicationStatusPerCluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *PollWorkflowExecutionUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31453,47 +37703,15 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollWorkflowExecutionUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollWorkflowExecutionUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31520,10 +37738,10 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.Response == nil {
+				m.Response = &v1.PollWorkflowExecutionUpdateResponse{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31551,7 +37769,7 @@ func (m *RebuildMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ListFaultInjectionScenariosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31574,12 +37792,31 @@ func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RebuildMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFaultInjectionScenariosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebuildMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFaultInjectionScenariosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -31604,7 +37841,7 @@ func (m *RebuildMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFaultInjectionScenariosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31627,17 +37864,17 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFaultInjectionScenariosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFaultInjectionScenariosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scenarios", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31647,29 +37884,31 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Scenarios = append(m.Scenarios, &v116.FaultInjectionScenario{})
+			if err := m.Scenarios[len(m.Scenarios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31679,33 +37918,101 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateFaultInjectionScenarioRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateFaultInjectionScenarioRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateFaultInjectionScenarioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31715,31 +38022,47 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowStartTime == nil {
-				m.WorkflowStartTime = new(time.Time)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowStartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31766,10 +38089,10 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowCloseTime == nil {
-				m.WorkflowCloseTime = new(time.Time)
+			if m.Duration == nil {
+				m.Duration = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowCloseTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31797,7 +38120,7 @@ func (m *DeleteWorkflowVisibilityRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteWorkflowVisibilityRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateFaultInjectionScenarioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31820,12 +38143,80 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateFaultInjectionScenarioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteWorkflowVisibilityRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateFaultInjectionScenarioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scenario", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scenario == nil {
+				m.Scenario = &v116.FaultInjectionScenario{}
+			}
+			if err := m.Scenario.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -31850,7 +38241,7 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *MigrateHistoryShardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31873,17 +38264,17 @@ func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateHistoryShardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateHistoryShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31893,29 +38284,54 @@ func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetShardCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.TargetShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -31925,26 +38341,24 @@ func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.UpdateWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -31971,7 +38385,7 @@ func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MigrateHistoryShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31994,17 +38408,17 @@ func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateHistoryShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateHistoryShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -32014,28 +38428,45 @@ func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &v1.UpdateWorkflowExecutionResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedExecutionCount", wireType)
+			}
+			m.MigratedExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedExecutionCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -32060,7 +38491,7 @@ func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamWorkflowReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyMigratedExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32083,15 +38514,34 @@ func (m *StreamWorkflowReplicationMessagesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyMigratedExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyMigratedExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncReplicationState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32118,12 +38568,52 @@ func (m *StreamWorkflowReplicationMessagesRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v115.SyncReplicationState{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MutableState == nil {
+				m.MutableState = &v19.WorkflowMutableState{}
+			}
+			if err := m.MutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Attributes = &StreamWorkflowReplicationMessagesRequest_SyncReplicationState{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbRecordVersion", wireType)
+			}
+			m.DbRecordVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbRecordVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCurrent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCurrent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -32148,7 +38638,7 @@ func (m *StreamWorkflowReplicationMessagesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *StreamWorkflowReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *CopyMigratedExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32171,47 +38661,12 @@ func (m *StreamWorkflowReplicationMessagesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyMigratedExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamWorkflowReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyMigratedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &v115.WorkflowReplicationMessages{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Attributes = &StreamWorkflowReplicationMessagesResponse_Messages{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -32236,7 +38691,7 @@ func (m *StreamWorkflowReplicationMessagesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32259,10 +38714,10 @@ func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowExecutionUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowExecutionUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32299,7 +38754,7 @@ func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32326,10 +38781,10 @@ func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.PollWorkflowExecutionUpdateRequest{}
+			if m.Bundle == nil {
+				m.Bundle = &v116.WorkflowExecutionBundle{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32357,7 +38812,7 @@ func (m *PollWorkflowExecutionUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollWorkflowExecutionUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32380,48 +38835,12 @@ func (m *PollWorkflowExecutionUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowExecutionUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowExecutionUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &v1.PollWorkflowExecutionUpdateResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -54,89 +54,102 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0xc6, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x55, 0x1b, 0x3f, 0x50, 0x3c, 0x65,
-	0xdc, 0x5d, 0xd0, 0xfd, 0x98, 0x75, 0x77, 0x26, 0x33, 0x93, 0x99, 0xdd, 0x19, 0x9d, 0x49, 0x66,
-	0x47, 0xf0, 0x22, 0x95, 0xe4, 0x9d, 0x49, 0x31, 0x3d, 0xe9, 0xb6, 0xaa, 0x12, 0xcd, 0x41, 0x10,
-	0x3c, 0x09, 0x82, 0x1f, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12,
-	0x3c, 0x88, 0xcc, 0xcd, 0x3d, 0x3a, 0x99, 0x8b, 0xc7, 0xfd, 0x13, 0x24, 0xe9, 0x54, 0x4d, 0xaa,
-	0xbb, 0x3a, 0xa9, 0xea, 0xce, 0x6d, 0x37, 0xa9, 0xe7, 0xd7, 0x4f, 0x7d, 0xe4, 0xad, 0x67, 0xde,
-	0x04, 0x5f, 0x12, 0x70, 0x14, 0x85, 0x8c, 0x04, 0x0b, 0x1c, 0x58, 0x0f, 0xd8, 0x02, 0x89, 0xe8,
-	0x42, 0x9b, 0x72, 0x11, 0xb2, 0xfe, 0xf0, 0x15, 0xda, 0x84, 0x85, 0xde, 0x85, 0x85, 0xf1, 0x3f,
-	0xcb, 0x11, 0x0b, 0x45, 0xe8, 0xbd, 0x24, 0x45, 0xe5, 0x58, 0x54, 0x26, 0x11, 0x2d, 0xeb, 0xa2,
-	0x72, 0xef, 0xc2, 0xf9, 0x45, 0x3b, 0x36, 0x83, 0xf7, 0xba, 0xc0, 0xc5, 0xbb, 0x0c, 0x78, 0x14,
-	0x76, 0xf8, 0xf8, 0x21, 0x17, 0xbf, 0xb8, 0x89, 0xcf, 0xad, 0xc7, 0x83, 0xeb, 0xf1, 0x60, 0xef,
-	0x3b, 0x84, 0x1f, 0xaf, 0x0b, 0xc2, 0xc4, 0xdb, 0x21, 0x3b, 0xdc, 0x0f, 0xc2, 0xf7, 0x57, 0x3f,
-	0x80, 0x66, 0x57, 0xd0, 0xb0, 0xe3, 0xad, 0x94, 0xad, 0x3c, 0x95, 0xcd, 0xf2, 0x5a, 0x6c, 0xe1,
-	0xfc, 0x6a, 0x41, 0x4a, 0x3c, 0x81, 0x17, 0x4a, 0xde, 0x97, 0x08, 0x3f, 0x54, 0x05, 0xb1, 0xd5,
-	0x15, 0xa4, 0x11, 0x40, 0x5d, 0x10, 0x01, 0xde, 0x75, 0x4b, 0x78, 0x42, 0x27, 0xbd, 0xbd, 0x91,
-	0x57, 0xae, 0x4c, 0x7d, 0x85, 0xf0, 0xc3, 0xdb, 0x61, 0x10, 0x68, 0xae, 0x6c, 0xb1, 0x49, 0xa1,
-	0xb4, 0x75, 0x23, 0xb7, 0x5e, 0xf9, 0xfa, 0x16, 0xe1, 0xc7, 0x6a, 0xc0, 0x41, 0xd4, 0x05, 0x6d,
-	0x1e, 0xf6, 0x77, 0x09, 0x3f, 0xdc, 0xe9, 0x42, 0x17, 0xbc, 0x65, 0x4b, 0xb6, 0x49, 0x2c, 0xfd,
-	0x55, 0x0a, 0x31, 0x94, 0xc7, 0x9f, 0x10, 0x7e, 0xaa, 0x06, 0xcd, 0x90, 0xb5, 0xe4, 0xb6, 0x0f,
-	0x47, 0x8d, 0xce, 0x01, 0xb4, 0xbc, 0xaa, 0xf5, 0x43, 0x32, 0x08, 0xd2, 0xed, 0x7a, 0x71, 0x90,
-	0xc1, 0xf2, 0x52, 0x53, 0xd0, 0x1e, 0x15, 0xfd, 0xfc, 0x96, 0x0d, 0x84, 0x7c, 0x96, 0x8d, 0x20,
-	0x65, 0xf9, 0x57, 0x84, 0x9f, 0x89, 0xff, 0xab, 0xcd, 0xad, 0x12, 0x1e, 0x45, 0x01, 0x0c, 0x5d,
-	0xdf, 0xb2, 0xdf, 0xcd, 0x4c, 0x88, 0x34, 0x7e, 0x7b, 0x2e, 0xac, 0xc4, 0x72, 0xa7, 0x86, 0xae,
-	0x11, 0x1a, 0x38, 0x2d, 0x77, 0x06, 0xc1, 0x7d, 0xb9, 0x33, 0x41, 0xca, 0xf2, 0x2f, 0x08, 0x3f,
-	0x9d, 0xde, 0x96, 0x75, 0x20, 0x4c, 0x34, 0x80, 0x08, 0x6f, 0x23, 0xf7, 0xd6, 0x2a, 0x86, 0xb4,
-	0x7d, 0x6b, 0x1e, 0x28, 0xd3, 0x39, 0x99, 0x1c, 0x9a, 0xfb, 0x9c, 0x18, 0x21, 0x39, 0xcf, 0x49,
-	0x06, 0xcb, 0x74, 0x4e, 0x26, 0x87, 0xe6, 0x3b, 0x27, 0x69, 0x42, 0xce, 0x73, 0x62, 0x02, 0x25,
-	0xce, 0x49, 0x7a, 0x76, 0xa4, 0xd3, 0x84, 0xa1, 0xe9, 0x8d, 0x02, 0x2b, 0x34, 0x66, 0xb8, 0x9f,
-	0x93, 0x29, 0x28, 0x65, 0xfc, 0x07, 0x84, 0x9f, 0xa8, 0xd3, 0x83, 0x0e, 0x09, 0xd2, 0x89, 0xc1,
-	0xfa, 0xae, 0x37, 0xeb, 0xa5, 0xe1, 0xb5, 0xa2, 0x18, 0x65, 0xf6, 0x0f, 0x84, 0x9f, 0x1b, 0x8f,
-	0xa2, 0xa2, 0x9d, 0x91, 0x73, 0xde, 0x74, 0x7b, 0x5c, 0x26, 0x48, 0xda, 0x7f, 0x6b, 0x6e, 0x3c,
-	0x35, 0x8f, 0x1f, 0x11, 0x7e, 0xb2, 0x06, 0x47, 0x61, 0x0f, 0x62, 0x91, 0x16, 0x37, 0xd6, 0xac,
-	0xf7, 0xd7, 0x0c, 0x90, 0xbe, 0xab, 0x85, 0x39, 0xca, 0xef, 0xcf, 0x08, 0x9f, 0xdf, 0x05, 0x76,
-	0x44, 0x3b, 0x44, 0x40, 0x7a, 0xc5, 0x6d, 0x3f, 0x48, 0xd9, 0x08, 0xe9, 0x79, 0x63, 0x0e, 0x24,
-	0xed, 0x68, 0xaf, 0xc0, 0xb0, 0xb8, 0xe4, 0x3f, 0xda, 0x19, 0x7a, 0xd7, 0xa3, 0x9d, 0x89, 0x51,
-	0x66, 0x87, 0xc1, 0x7d, 0x14, 0xb0, 0xf2, 0x07, 0x77, 0xb3, 0xdc, 0x35, 0xb8, 0x67, 0x51, 0x94,
-	0xd3, 0xdf, 0x11, 0xf6, 0xc7, 0xd0, 0xb8, 0x9e, 0xa4, 0x1d, 0x6f, 0x5a, 0x3f, 0x6b, 0x1a, 0x46,
-	0x3a, 0xdf, 0x9a, 0x13, 0x4d, 0x4b, 0xd3, 0xf5, 0x66, 0x1b, 0x5a, 0xdd, 0x00, 0x26, 0x6f, 0x7f,
-	0xeb, 0x34, 0x6d, 0x12, 0xbb, 0xa6, 0x69, 0x33, 0x43, 0x2b, 0x75, 0x7b, 0xc0, 0xe8, 0x7e, 0x7f,
-	0x8d, 0x32, 0x2e, 0xb4, 0x1c, 0x3b, 0x56, 0xb6, 0xac, 0x4b, 0xdd, 0x2c, 0x90, 0x6b, 0xa9, 0x9b,
-	0xcd, 0x53, 0xf3, 0xf8, 0x0d, 0xe1, 0x67, 0xe3, 0xc4, 0x52, 0x69, 0xd3, 0xa0, 0xa5, 0xb6, 0xe3,
-	0x2c, 0x88, 0xdc, 0x76, 0xca, 0x3d, 0x19, 0x14, 0x39, 0x83, 0xcd, 0xf9, 0xc0, 0x94, 0xfd, 0x7f,
-	0x10, 0x7e, 0x39, 0x9e, 0xad, 0x71, 0xec, 0xe8, 0x5c, 0x0d, 0x49, 0xd0, 0xf2, 0x76, 0x9d, 0x16,
-	0x6f, 0x16, 0x4e, 0x4e, 0xe8, 0xce, 0x9c, 0xa9, 0x5a, 0xc8, 0x5a, 0x01, 0xde, 0x64, 0xb4, 0x61,
-	0xa8, 0x8f, 0x55, 0xeb, 0xc2, 0x96, 0x41, 0x70, 0x0d, 0x59, 0x53, 0x40, 0xca, 0xf2, 0xd7, 0x08,
-	0x3f, 0x52, 0x83, 0x28, 0xa0, 0x4d, 0x22, 0x60, 0xb5, 0x07, 0x1d, 0xc1, 0xf7, 0x2e, 0x7a, 0x37,
-	0xac, 0xb7, 0x3c, 0xa1, 0x94, 0x16, 0x6f, 0xe6, 0x07, 0x24, 0xca, 0xf7, 0xf8, 0x7d, 0x39, 0x87,
-	0xf8, 0x3e, 0x5f, 0x71, 0xc5, 0x6b, 0x72, 0xf7, 0xf2, 0x6d, 0xa6, 0x68, 0x7d, 0x97, 0x7a, 0xbf,
-	0xd3, 0xac, 0xb7, 0x09, 0x6b, 0x0d, 0xdf, 0xec, 0x72, 0xeb, 0xbe, 0x4b, 0x42, 0xe7, 0xda, 0x77,
-	0x49, 0xc9, 0x95, 0xa9, 0x4f, 0x10, 0x7e, 0x60, 0xf8, 0xae, 0x0c, 0xab, 0xde, 0x55, 0x07, 0xa4,
-	0x14, 0x49, 0x3b, 0xd7, 0x72, 0x69, 0xb5, 0xdb, 0x41, 0x9e, 0x46, 0x2d, 0x98, 0x2d, 0x3b, 0x1e,
-	0x65, 0x53, 0x28, 0xab, 0x14, 0x62, 0x28, 0x8f, 0xdf, 0x20, 0xfc, 0xa8, 0x1c, 0x32, 0xee, 0x00,
-	0xae, 0x87, 0x5c, 0x78, 0x4b, 0x8e, 0xf8, 0x09, 0xad, 0x74, 0xb8, 0x5c, 0x04, 0xa1, 0x0c, 0x7e,
-	0x8c, 0x30, 0xae, 0x04, 0x21, 0x87, 0xd1, 0x7e, 0x7b, 0x97, 0x2d, 0xa1, 0x67, 0x12, 0x69, 0xe7,
-	0x4a, 0x0e, 0xa5, 0x72, 0xf1, 0x21, 0xbe, 0xbf, 0x0a, 0x22, 0xb6, 0xf0, 0x9a, 0x7d, 0x73, 0x50,
-	0x33, 0xf0, 0xba, 0xb3, 0x4e, 0x5b, 0x84, 0x38, 0x5d, 0x8f, 0xd2, 0xc5, 0x65, 0xa7, 0x40, 0x3e,
-	0x99, 0x29, 0xae, 0xe4, 0x50, 0x6a, 0xa5, 0xa9, 0x0a, 0x42, 0x16, 0x06, 0x1a, 0x76, 0xb6, 0x80,
-	0x73, 0x72, 0x00, 0xdc, 0xba, 0x34, 0x99, 0xe5, 0xae, 0xa5, 0x29, 0x8b, 0xa2, 0x5d, 0x49, 0x55,
-	0x10, 0x2b, 0x9b, 0x3b, 0x26, 0xb3, 0x55, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x57, 0xd2, 0x14, 0x90,
-	0xb2, 0xfc, 0x29, 0xc2, 0x0f, 0xee, 0x74, 0x81, 0xf5, 0x65, 0xb9, 0xf5, 0x6c, 0xab, 0x8f, 0xa6,
-	0x92, 0xd6, 0x16, 0xf3, 0x89, 0x35, 0x3b, 0x35, 0x20, 0x51, 0x14, 0xf4, 0xe3, 0x4b, 0xca, 0xda,
-	0x8e, 0xa6, 0x72, 0xb5, 0x93, 0x10, 0x2b, 0x3b, 0x9f, 0x21, 0x7c, 0x2e, 0x5e, 0x45, 0xb5, 0x8b,
-	0x8b, 0x4e, 0x8b, 0x9f, 0xdc, 0xba, 0xeb, 0x39, 0xd5, 0x7a, 0x83, 0xbf, 0xcb, 0x0e, 0x60, 0xd2,
-	0x93, 0x75, 0x83, 0x3f, 0x21, 0x74, 0x6e, 0xf0, 0xa7, 0xf4, 0x9a, 0xaf, 0x2d, 0xc8, 0xe9, 0x2b,
-	0x29, 0x74, 0xf5, 0x95, 0xd6, 0x27, 0xbe, 0x78, 0xd8, 0x67, 0xc0, 0xdb, 0x93, 0x49, 0x9f, 0x3b,
-	0x7c, 0xf1, 0x90, 0x16, 0xbb, 0x7f, 0xf1, 0x60, 0x62, 0x28, 0x8f, 0x7f, 0x23, 0xfc, 0x62, 0x15,
-	0x3a, 0xc0, 0x88, 0x80, 0x4d, 0xc2, 0xc5, 0xf8, 0x46, 0x9a, 0xf8, 0xe0, 0xc6, 0x96, 0x77, 0xac,
-	0x0f, 0xcf, 0x4c, 0x96, 0x9c, 0x41, 0x6d, 0x9e, 0x48, 0x6d, 0xd1, 0xf5, 0x62, 0x39, 0xce, 0x69,
-	0xcb, 0xb9, 0x2a, 0xad, 0x1e, 0xd6, 0x2a, 0x85, 0x18, 0x5a, 0x02, 0xa9, 0x41, 0xa3, 0x4b, 0x83,
-	0x96, 0x16, 0x92, 0x96, 0xac, 0xf7, 0x34, 0xa5, 0x75, 0x4d, 0x20, 0x46, 0x84, 0xd6, 0xa6, 0xd0,
-	0xdb, 0x2e, 0x7b, 0x94, 0xd3, 0x06, 0x0d, 0x46, 0x69, 0x6f, 0xf8, 0xe7, 0x90, 0x75, 0x9b, 0x62,
-	0x3a, 0xc6, 0xb5, 0x4d, 0x31, 0x8b, 0xa6, 0xf5, 0xaf, 0xee, 0x44, 0x2d, 0x52, 0xa4, 0x7f, 0x95,
-	0xa1, 0x77, 0xed, 0x5f, 0x65, 0x62, 0xb4, 0x06, 0xf8, 0x76, 0x18, 0xa4, 0xfb, 0x2e, 0xb1, 0xd4,
-	0xba, 0x01, 0x3e, 0x85, 0xe1, 0xda, 0x00, 0x9f, 0x8a, 0x52, 0xc6, 0xff, 0x42, 0xf8, 0xf9, 0xba,
-	0x60, 0x40, 0x8e, 0xce, 0xee, 0xd3, 0x74, 0xf8, 0xb0, 0x6e, 0x02, 0xcf, 0x22, 0xc9, 0x49, 0x6c,
-	0xcf, 0x0f, 0x28, 0xa7, 0xf2, 0x0a, 0x7a, 0x15, 0x2d, 0x47, 0xc7, 0x27, 0x7e, 0xe9, 0xee, 0x89,
-	0x5f, 0xba, 0x77, 0xe2, 0xa3, 0x8f, 0x06, 0x3e, 0xfa, 0x7e, 0xe0, 0xa3, 0x3f, 0x07, 0x3e, 0x3a,
-	0x1e, 0xf8, 0xe8, 0xdf, 0x81, 0x8f, 0xfe, 0x1b, 0xf8, 0xa5, 0x7b, 0x03, 0x1f, 0x7d, 0x7e, 0xea,
-	0x97, 0x8e, 0x4f, 0xfd, 0xd2, 0xdd, 0x53, 0xbf, 0xf4, 0xce, 0xd5, 0x83, 0xf0, 0xcc, 0x0f, 0x0d,
-	0xa7, 0xfe, 0x1a, 0xe1, 0x9a, 0xfe, 0x4a, 0xe3, 0xbe, 0xd1, 0x8f, 0x11, 0x2e, 0xfd, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0xa1, 0x85, 0xab, 0xc0, 0x28, 0x21, 0x00, 0x00,
+	// 1513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcd, 0x8b, 0x1c, 0xc5,
+	0x1b, 0xc7, 0xa7, 0x2e, 0x3f, 0x7e, 0x14, 0x1a, 0xb5, 0x7d, 0x8f, 0xda, 0xf8, 0x82, 0xe2, 0x69,
+	0x36, 0x2f, 0xa0, 0x79, 0x35, 0x66, 0x67, 0xb3, 0xb3, 0x9b, 0xec, 0x98, 0xec, 0x4c, 0x12, 0xc1,
+	0x8b, 0xd4, 0xf6, 0x3c, 0xd9, 0x2d, 0xd3, 0xdb, 0xdd, 0x76, 0x55, 0xaf, 0xce, 0x41, 0x10, 0x3c,
+	0x09, 0x82, 0x22, 0x08, 0x9e, 0x04, 0x41, 0x50, 0x04, 0x41, 0x10, 0x04, 0x41, 0x10, 0x0f, 0x82,
+	0x07, 0xd1, 0xdc, 0xcc, 0xd1, 0xcc, 0x5e, 0x3c, 0xe6, 0x4f, 0x90, 0x9e, 0xee, 0xaa, 0x9d, 0xea,
+	0xae, 0xee, 0xa9, 0xea, 0x99, 0x5b, 0xb2, 0x53, 0xdf, 0xcf, 0x7c, 0xab, 0xea, 0xd9, 0xa7, 0x9e,
+	0xaa, 0x67, 0xf1, 0x71, 0x0e, 0xbb, 0x51, 0x18, 0x13, 0x7f, 0x89, 0x41, 0xbc, 0x07, 0xf1, 0x12,
+	0x89, 0xe8, 0xd2, 0x0e, 0x65, 0x3c, 0x8c, 0x47, 0xe9, 0x4f, 0xa8, 0x07, 0x4b, 0x7b, 0x47, 0x97,
+	0xf2, 0x7f, 0xb6, 0xa3, 0x38, 0xe4, 0xa1, 0xf3, 0xbc, 0x10, 0xb5, 0x33, 0x51, 0x9b, 0x44, 0xb4,
+	0xad, 0x8a, 0xda, 0x7b, 0x47, 0x0f, 0x9f, 0x31, 0x63, 0xc7, 0xf0, 0x76, 0x02, 0x8c, 0xbf, 0x19,
+	0x03, 0x8b, 0xc2, 0x80, 0xe5, 0x5f, 0x72, 0xec, 0xaf, 0x1e, 0x3e, 0xb4, 0x96, 0x0d, 0x1e, 0x64,
+	0x83, 0x9d, 0xaf, 0x11, 0x7e, 0x64, 0xc0, 0x49, 0xcc, 0x5f, 0x0f, 0xe3, 0x9b, 0x37, 0xfc, 0xf0,
+	0x9d, 0x0b, 0xef, 0x82, 0x97, 0x70, 0x1a, 0x06, 0xce, 0x4a, 0xdb, 0xc8, 0x53, 0x5b, 0x2f, 0xef,
+	0x67, 0x16, 0x0e, 0x5f, 0x98, 0x93, 0x92, 0x4d, 0xe0, 0xd9, 0x96, 0xf3, 0x29, 0xc2, 0xf7, 0x75,
+	0x81, 0xf7, 0x12, 0x4e, 0xb6, 0x7c, 0x18, 0x70, 0xc2, 0xc1, 0x39, 0x6b, 0x08, 0x2f, 0xe8, 0x84,
+	0xb7, 0x57, 0x9a, 0xca, 0xa5, 0xa9, 0xcf, 0x10, 0xbe, 0xff, 0x4a, 0xe8, 0xfb, 0x8a, 0x2b, 0x53,
+	0x6c, 0x51, 0x28, 0x6c, 0x9d, 0x6b, 0xac, 0x97, 0xbe, 0xbe, 0x44, 0xf8, 0xa1, 0x3e, 0x30, 0xe0,
+	0x03, 0x4e, 0xbd, 0x9b, 0xa3, 0xab, 0x84, 0xdd, 0xdc, 0x4c, 0x20, 0x01, 0x67, 0xd9, 0x90, 0xad,
+	0x13, 0x0b, 0x7f, 0x9d, 0xb9, 0x18, 0xd2, 0xe3, 0xf7, 0x08, 0x3f, 0xde, 0x07, 0x2f, 0x8c, 0x87,
+	0x62, 0xdb, 0xd3, 0x51, 0x93, 0x38, 0x80, 0xa1, 0xd3, 0x35, 0xfe, 0x92, 0x0a, 0x82, 0x70, 0xbb,
+	0x36, 0x3f, 0x48, 0x63, 0xf9, 0xbc, 0xc7, 0xe9, 0x1e, 0xe5, 0xa3, 0xe6, 0x96, 0x35, 0x84, 0x66,
+	0x96, 0xb5, 0x20, 0x69, 0xf9, 0x27, 0x84, 0x9f, 0xcc, 0xfe, 0xab, 0xcc, 0xad, 0x13, 0xee, 0x46,
+	0x3e, 0xa4, 0xae, 0x2f, 0x9a, 0xef, 0x66, 0x25, 0x44, 0x18, 0xbf, 0xb4, 0x10, 0x56, 0x61, 0xb9,
+	0x4b, 0x43, 0x57, 0x09, 0xf5, 0xad, 0x96, 0xbb, 0x82, 0x60, 0xbf, 0xdc, 0x95, 0x20, 0x69, 0xf9,
+	0x47, 0x84, 0x9f, 0x28, 0x6f, 0xcb, 0x1a, 0x90, 0x98, 0x6f, 0x01, 0xe1, 0xce, 0x7a, 0xe3, 0xad,
+	0x95, 0x0c, 0x61, 0xfb, 0xe2, 0x22, 0x50, 0xba, 0x38, 0x99, 0x1e, 0xda, 0x38, 0x4e, 0xb4, 0x90,
+	0x86, 0x71, 0x52, 0xc1, 0xd2, 0xc5, 0xc9, 0xf4, 0xd0, 0x66, 0x71, 0x52, 0x26, 0x34, 0x8c, 0x13,
+	0x1d, 0xa8, 0x10, 0x27, 0xe5, 0xd9, 0x91, 0xc0, 0x83, 0xd4, 0xf4, 0xfa, 0x1c, 0x2b, 0x94, 0x33,
+	0xec, 0xe3, 0xa4, 0x06, 0x25, 0x8d, 0x7f, 0x8b, 0xf0, 0xa3, 0x03, 0xba, 0x1d, 0x10, 0xbf, 0x5c,
+	0x31, 0x18, 0x9f, 0xf5, 0x7a, 0xbd, 0x30, 0xbc, 0x3a, 0x2f, 0x46, 0x9a, 0xfd, 0x0d, 0xe1, 0xa7,
+	0xf3, 0x51, 0x94, 0xef, 0x54, 0xd4, 0x39, 0xaf, 0xd9, 0x7d, 0x5d, 0x25, 0x48, 0xd8, 0xbf, 0xbc,
+	0x30, 0x9e, 0x9c, 0xc7, 0x77, 0x08, 0x3f, 0xd6, 0x87, 0xdd, 0x70, 0x0f, 0x32, 0x91, 0x52, 0x6e,
+	0xac, 0x1a, 0xef, 0xaf, 0x1e, 0x20, 0x7c, 0x77, 0xe7, 0xe6, 0x48, 0xbf, 0x3f, 0x20, 0x7c, 0xf8,
+	0x2a, 0xc4, 0xbb, 0x34, 0x20, 0x1c, 0xca, 0x2b, 0x6e, 0xfa, 0x8b, 0x54, 0x8d, 0x10, 0x9e, 0xd7,
+	0x17, 0x40, 0x52, 0x42, 0x7b, 0x05, 0x7c, 0xe0, 0xd0, 0x3c, 0xb4, 0x2b, 0xf4, 0xb6, 0xa1, 0x5d,
+	0x89, 0x91, 0x66, 0xd3, 0xc2, 0xfd, 0x0a, 0x49, 0x18, 0x34, 0x2f, 0xdc, 0xf5, 0x72, 0xdb, 0xc2,
+	0xbd, 0x8a, 0xa2, 0x04, 0xef, 0xb5, 0x20, 0xd2, 0x7b, 0x35, 0x5d, 0x90, 0x2a, 0x80, 0x6d, 0xf0,
+	0x56, 0x73, 0xa4, 0xdf, 0xaf, 0x10, 0x7e, 0xf8, 0x5a, 0x34, 0x24, 0x1c, 0x44, 0x2a, 0xbc, 0x1c,
+	0xa5, 0x43, 0x98, 0x63, 0x5a, 0xf8, 0x6a, 0xd5, 0xc2, 0xe9, 0xca, 0x7c, 0x10, 0x69, 0xf3, 0x23,
+	0x84, 0xef, 0x9d, 0x54, 0xd8, 0x62, 0x88, 0x73, 0xda, 0xa6, 0x2e, 0x17, 0x2a, 0x61, 0xeb, 0x4c,
+	0x33, 0xb1, 0x12, 0x8f, 0x93, 0xcf, 0x9a, 0xc7, 0xa3, 0x5e, 0x6e, 0x1b, 0x8f, 0x55, 0x14, 0xe9,
+	0xf4, 0x17, 0x84, 0xdd, 0x1c, 0x9a, 0x9d, 0x6f, 0x65, 0xc7, 0x1b, 0xc6, 0xdf, 0x55, 0x87, 0x11,
+	0xce, 0x7b, 0x0b, 0xa2, 0x29, 0xb7, 0xbb, 0x81, 0xb7, 0x03, 0xc3, 0xc4, 0x87, 0xe9, 0x6a, 0xd4,
+	0xf8, 0x76, 0xa7, 0x13, 0xdb, 0xde, 0xee, 0xf4, 0x0c, 0xe5, 0xe8, 0xbd, 0x0e, 0x31, 0xbd, 0x31,
+	0x5a, 0xa5, 0x31, 0xe3, 0xca, 0xbd, 0x2a, 0x57, 0x0e, 0x8d, 0x8f, 0xde, 0x59, 0x20, 0xdb, 0xa3,
+	0x77, 0x36, 0x4f, 0xce, 0xe3, 0x67, 0x84, 0x9f, 0xca, 0x2a, 0xe8, 0xce, 0x0e, 0xf5, 0x87, 0x72,
+	0x3b, 0x0e, 0x0a, 0xe3, 0x4b, 0x56, 0x75, 0x78, 0x05, 0x45, 0xcc, 0x60, 0x63, 0x31, 0x30, 0x69,
+	0xff, 0x6f, 0x84, 0x5f, 0xc8, 0x66, 0xab, 0x1d, 0x3b, 0x89, 0xab, 0x94, 0x04, 0x43, 0xe7, 0xaa,
+	0xd5, 0xe2, 0xcd, 0xc2, 0x89, 0x09, 0x5d, 0x5b, 0x30, 0x55, 0x29, 0xfa, 0x57, 0x80, 0x79, 0x31,
+	0xdd, 0xd2, 0x9c, 0x2b, 0x5d, 0xe3, 0x83, 0xb6, 0x82, 0x60, 0x5b, 0xf4, 0xd7, 0x80, 0xa4, 0xe5,
+	0xcf, 0x11, 0x7e, 0xa0, 0x0f, 0x91, 0x4f, 0x3d, 0xc2, 0xe1, 0xc2, 0x1e, 0x04, 0x9c, 0x5d, 0x3f,
+	0xe6, 0x9c, 0x33, 0xde, 0xf2, 0x82, 0x52, 0x58, 0x7c, 0xb5, 0x39, 0xa0, 0x90, 0xbe, 0xf3, 0xcf,
+	0xc5, 0x1c, 0xb2, 0xfa, 0x72, 0xc5, 0x16, 0xaf, 0xc8, 0xed, 0xd3, 0xb7, 0x9e, 0xa2, 0xbc, 0x03,
+	0x0e, 0x46, 0x81, 0x37, 0xd8, 0x21, 0xf1, 0x30, 0xfd, 0x30, 0x61, 0xc6, 0xef, 0x80, 0x05, 0x9d,
+	0xed, 0x3b, 0x60, 0x49, 0x2e, 0x4d, 0x7d, 0x88, 0xf0, 0x3d, 0xe9, 0xa7, 0xf2, 0x2c, 0x3e, 0x65,
+	0x81, 0x2c, 0x1e, 0xc5, 0xa7, 0x1b, 0x69, 0x95, 0xd3, 0x41, 0x44, 0xa3, 0x72, 0x51, 0x58, 0xb6,
+	0x0c, 0x65, 0xdd, 0x25, 0xa1, 0x33, 0x17, 0x43, 0x7a, 0xfc, 0x02, 0xe1, 0x07, 0xc5, 0x90, 0xfc,
+	0x45, 0x7a, 0x2d, 0x64, 0xdc, 0x39, 0x6f, 0x89, 0x9f, 0xd2, 0x0a, 0x87, 0xcb, 0xf3, 0x20, 0xa4,
+	0xc1, 0x0f, 0x10, 0xc6, 0x1d, 0x3f, 0x64, 0x30, 0xd9, 0x6f, 0xe7, 0x84, 0x21, 0xf4, 0x40, 0x22,
+	0xec, 0x9c, 0x6c, 0xa0, 0x94, 0x2e, 0xde, 0xc3, 0xff, 0xef, 0x02, 0xcf, 0x2c, 0xbc, 0x64, 0xfe,
+	0x58, 0xad, 0x18, 0x78, 0xd9, 0x5a, 0xa7, 0x2c, 0x42, 0x76, 0xdb, 0x9b, 0x54, 0x17, 0x27, 0xac,
+	0x2e, 0x88, 0xd3, 0x35, 0xc5, 0xc9, 0x06, 0x4a, 0x25, 0x35, 0x75, 0x81, 0x8b, 0xc4, 0x40, 0xc3,
+	0xa0, 0x07, 0x8c, 0x91, 0x6d, 0x60, 0xc6, 0xa9, 0x49, 0x2f, 0xb7, 0x4d, 0x4d, 0x55, 0x14, 0xe5,
+	0x48, 0xea, 0x02, 0x5f, 0xd9, 0xd8, 0xd4, 0x99, 0xed, 0x9a, 0x7f, 0x8d, 0x9e, 0x60, 0x7b, 0x24,
+	0xd5, 0x80, 0x94, 0x5b, 0xc4, 0x66, 0x02, 0xf1, 0x48, 0xa4, 0x5b, 0xe3, 0x5b, 0x84, 0xa2, 0xb2,
+	0xbd, 0x45, 0x14, 0xc4, 0x85, 0x4b, 0x0d, 0x89, 0x22, 0x7f, 0x94, 0x1d, 0x52, 0x16, 0x97, 0x9a,
+	0x29, 0x95, 0xfd, 0xa5, 0x46, 0x11, 0x4b, 0x3b, 0x1f, 0x23, 0x7c, 0x28, 0x5b, 0x45, 0xb9, 0x8b,
+	0x67, 0xac, 0x16, 0xbf, 0xb8, 0x75, 0x67, 0x1b, 0xaa, 0xd5, 0x86, 0x53, 0x12, 0x6f, 0xc3, 0xb4,
+	0x27, 0xe3, 0x86, 0x53, 0x41, 0x68, 0xdd, 0x70, 0x2a, 0xe9, 0x15, 0x5f, 0x3d, 0x68, 0xe8, 0xab,
+	0x07, 0xf3, 0xf9, 0xea, 0x41, 0xa5, 0xaf, 0xac, 0x11, 0x76, 0x23, 0x06, 0xb6, 0x33, 0x5d, 0xe9,
+	0x33, 0x8b, 0x46, 0x58, 0x59, 0x6c, 0xdf, 0x08, 0xd3, 0x31, 0xa4, 0xc7, 0x3f, 0x11, 0x7e, 0xae,
+	0x0b, 0x01, 0xc4, 0x84, 0xc3, 0x06, 0x61, 0x3c, 0x3f, 0x91, 0xa6, 0x7e, 0x71, 0x33, 0xcb, 0x9b,
+	0xc6, 0xc1, 0x33, 0x93, 0x25, 0x66, 0xd0, 0x5f, 0x24, 0x52, 0x59, 0x74, 0x35, 0x59, 0xe6, 0x75,
+	0xda, 0x72, 0xa3, 0x4c, 0xab, 0x16, 0x6b, 0x9d, 0xb9, 0x18, 0x4a, 0x05, 0xd2, 0x87, 0xad, 0x84,
+	0xfa, 0x43, 0xa5, 0x48, 0x3a, 0x6f, 0xbc, 0xa7, 0x25, 0xad, 0x6d, 0x05, 0xa2, 0x45, 0x28, 0xcf,
+	0x14, 0xea, 0x33, 0xe0, 0x75, 0xca, 0xe8, 0x16, 0xf5, 0x27, 0xd5, 0x5e, 0x7a, 0x1d, 0x32, 0x7e,
+	0xa6, 0xa8, 0xc7, 0xd8, 0x3e, 0x53, 0xcc, 0xa2, 0x29, 0xef, 0xa9, 0xd9, 0x2b, 0x56, 0xf3, 0xf7,
+	0xd4, 0x0a, 0xbd, 0xed, 0x7b, 0x6a, 0x25, 0x46, 0x69, 0xc8, 0xa4, 0x0d, 0xf5, 0xd2, 0x98, 0x4c,
+	0x6a, 0xdc, 0x90, 0xa9, 0x61, 0xd8, 0x36, 0x64, 0x6a, 0x51, 0xd2, 0xf8, 0x1f, 0x08, 0x3f, 0x33,
+	0xe0, 0x31, 0x90, 0xdd, 0x83, 0xf3, 0xb4, 0x5c, 0x7c, 0x18, 0x37, 0x25, 0x66, 0x91, 0xc4, 0x24,
+	0xae, 0x2c, 0x0e, 0x28, 0xa6, 0xf2, 0x22, 0x3a, 0x82, 0x9c, 0x5f, 0x11, 0x76, 0x55, 0x8d, 0x9c,
+	0x7a, 0x9e, 0x78, 0x8c, 0xc3, 0xbe, 0x1e, 0x63, 0x1b, 0xf6, 0xb3, 0x68, 0x62, 0x16, 0x47, 0xd0,
+	0x24, 0x96, 0x36, 0x28, 0xe3, 0xab, 0x24, 0xf1, 0xf9, 0x7a, 0xf0, 0x16, 0x78, 0x93, 0x14, 0xe4,
+	0x41, 0x40, 0x62, 0x1a, 0x32, 0xe3, 0x58, 0xaa, 0x61, 0xd8, 0xc6, 0x52, 0x2d, 0x4a, 0x69, 0x02,
+	0x67, 0x01, 0xa6, 0x1f, 0x6b, 0xdc, 0x04, 0xae, 0x83, 0xd8, 0x36, 0x81, 0xeb, 0x59, 0x4a, 0x42,
+	0xef, 0xd1, 0xed, 0x98, 0x70, 0x71, 0xa5, 0xcb, 0xee, 0x4d, 0xa6, 0x09, 0x5d, 0xa3, 0xb5, 0x4d,
+	0xe8, 0x5a, 0x84, 0xd2, 0x57, 0xe8, 0x84, 0xd1, 0x28, 0x1f, 0x75, 0xf0, 0xcc, 0x65, 0xdc, 0x57,
+	0xd0, 0xaa, 0x6d, 0xfb, 0x0a, 0x15, 0x10, 0x25, 0x6b, 0xaf, 0xa7, 0x20, 0xde, 0x3c, 0x6b, 0x57,
+	0xe8, 0x6d, 0xb3, 0x76, 0x25, 0x46, 0x98, 0x5d, 0x8e, 0x6e, 0xdd, 0x71, 0x5b, 0xb7, 0xef, 0xb8,
+	0xad, 0xbb, 0x77, 0x5c, 0xf4, 0xfe, 0xd8, 0x45, 0xdf, 0x8c, 0x5d, 0xf4, 0xfb, 0xd8, 0x45, 0xb7,
+	0xc6, 0x2e, 0xfa, 0x67, 0xec, 0xa2, 0x7f, 0xc7, 0x6e, 0xeb, 0xee, 0xd8, 0x45, 0x9f, 0xec, 0xbb,
+	0xad, 0x5b, 0xfb, 0x6e, 0xeb, 0xf6, 0xbe, 0xdb, 0x7a, 0xe3, 0xd4, 0x76, 0x78, 0xe0, 0x80, 0x86,
+	0xb5, 0x7f, 0x4b, 0x77, 0x5a, 0xfd, 0xc9, 0xd6, 0xff, 0x26, 0x7f, 0x4a, 0x77, 0xfc, 0xbf, 0x01,
+	0x00, 0x36, 0x4b, 0x8d, 0x1e, 0xe6, 0x27, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -233,6 +246,16 @@ type HistoryServiceClient interface {
 	// provided) or the latest Workflow Execution (when WorkflowExecution.run_id is not provided). If the Workflow
 	// Execution is Running, it will be terminated before deletion.
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While paused, no workflow or activity
+	// task is dispatched and user timers are deferred, signals and updates are still accepted.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes the dispatch of tasks of a paused workflow execution and fires
+	// the user timers which expired while it was paused.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
+	// UpdateActivityOptions updates the task queue, timeouts and retry policy of a pending activity.
+	UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error)
+	// ResetActivity retries a pending activity immediately, skipping the remaining retry backoff.
+	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
 	// ResetWorkflowExecution reset an existing workflow execution by a firstEventId of a existing event batch
 	// in the history and immediately terminating the current execution instance.
 	// After reset, the history will grow from nextFirstEventId.
@@ -318,6 +341,25 @@ type HistoryServiceClient interface {
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	PollWorkflowExecutionUpdate(ctx context.Context, in *PollWorkflowExecutionUpdateRequest, opts ...grpc.CallOption) (*PollWorkflowExecutionUpdateResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (HistoryService_StreamWorkflowReplicationMessagesClient, error)
+	// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are
+	// committed. The stream is closed once all events of a closed workflow execution are sent.
+	StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryService_StreamWorkflowExecutionHistoryClient, error)
+	// ListFaultInjectionScenarios returns the persistence fault injection scenarios of a history host.
+	ListFaultInjectionScenarios(ctx context.Context, in *ListFaultInjectionScenariosRequest, opts ...grpc.CallOption) (*ListFaultInjectionScenariosResponse, error)
+	// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of a history host.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	UpdateFaultInjectionScenario(ctx context.Context, in *UpdateFaultInjectionScenarioRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionScenarioResponse, error)
+	// MigrateHistoryShard copies a page of executions of a shard that is being migrated to a larger
+	// history shard count into their target shards, and removes them from the source shard.
+	MigrateHistoryShard(ctx context.Context, in *MigrateHistoryShardRequest, opts ...grpc.CallOption) (*MigrateHistoryShardResponse, error)
+	// CopyMigratedExecution creates the mutable state of an execution copied by MigrateHistoryShard
+	// in its target shard. It is served by the owner of the target shard.
+	CopyMigratedExecution(ctx context.Context, in *CopyMigratedExecutionRequest, opts ...grpc.CallOption) (*CopyMigratedExecutionResponse, error)
+	// ImportWorkflowExecution creates the workflow executions of an exported workflow execution bundle.
+	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
 }
 
 type historyServiceClient struct {
@@ -481,6 +523,42 @@ func (c *historyServiceClient) DeleteWorkflowExecution(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateActivityOptions(ctx context.Context, in *UpdateActivityOptionsRequest, opts ...grpc.CallOption) (*UpdateActivityOptionsResponse, error) {
+	out := new(UpdateActivityOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error) {
+	out := new(ResetActivityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) ResetWorkflowExecution(ctx context.Context, in *ResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
 	out := new(ResetWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ResetWorkflowExecution", in, out, opts...)
@@ -782,6 +860,83 @@ func (x *historyServiceStreamWorkflowReplicationMessagesClient) Recv() (*StreamW
 	return m, nil
 }
 
+func (c *historyServiceClient) StreamWorkflowExecutionHistory(ctx context.Context, in *StreamWorkflowExecutionHistoryRequest, opts ...grpc.CallOption) (HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HistoryService_serviceDesc.Streams[1], "/temporal.server.api.historyservice.v1.HistoryService/StreamWorkflowExecutionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyServiceStreamWorkflowExecutionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HistoryService_StreamWorkflowExecutionHistoryClient interface {
+	Recv() (*StreamWorkflowExecutionHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type historyServiceStreamWorkflowExecutionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyServiceStreamWorkflowExecutionHistoryClient) Recv() (*StreamWorkflowExecutionHistoryResponse, error) {
+	m := new(StreamWorkflowExecutionHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *historyServiceClient) ListFaultInjectionScenarios(ctx context.Context, in *ListFaultInjectionScenariosRequest, opts ...grpc.CallOption) (*ListFaultInjectionScenariosResponse, error) {
+	out := new(ListFaultInjectionScenariosResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ListFaultInjectionScenarios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateFaultInjectionScenario(ctx context.Context, in *UpdateFaultInjectionScenarioRequest, opts ...grpc.CallOption) (*UpdateFaultInjectionScenarioResponse, error) {
+	out := new(UpdateFaultInjectionScenarioResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateFaultInjectionScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) MigrateHistoryShard(ctx context.Context, in *MigrateHistoryShardRequest, opts ...grpc.CallOption) (*MigrateHistoryShardResponse, error) {
+	out := new(MigrateHistoryShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/MigrateHistoryShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) CopyMigratedExecution(ctx context.Context, in *CopyMigratedExecutionRequest, opts ...grpc.CallOption) (*CopyMigratedExecutionResponse, error) {
+	out := new(CopyMigratedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/CopyMigratedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error) {
+	out := new(ImportWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ImportWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -866,6 +1021,16 @@ type HistoryServiceServer interface {
 	// provided) or the latest Workflow Execution (when WorkflowExecution.run_id is not provided). If the Workflow
 	// Execution is Running, it will be terminated before deletion.
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// PauseWorkflowExecution pauses a running workflow execution. While paused, no workflow or activity
+	// task is dispatched and user timers are deferred, signals and updates are still accepted.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes the dispatch of tasks of a paused workflow execution and fires
+	// the user timers which expired while it was paused.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	// UpdateActivityOptions updates the task queue, timeouts and retry policy of a pending activity.
+	UpdateActivityOptions(context.Context, *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error)
+	// ResetActivity retries a pending activity immediately, skipping the remaining retry backoff.
+	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
 	// ResetWorkflowExecution reset an existing workflow execution by a firstEventId of a existing event batch
 	// in the history and immediately terminating the current execution instance.
 	// After reset, the history will grow from nextFirstEventId.
@@ -951,6 +1116,25 @@ type HistoryServiceServer interface {
 	//     aip.dev/not-precedent: This service does not follow the update method API --)
 	PollWorkflowExecutionUpdate(context.Context, *PollWorkflowExecutionUpdateRequest) (*PollWorkflowExecutionUpdateResponse, error)
 	StreamWorkflowReplicationMessages(HistoryService_StreamWorkflowReplicationMessagesServer) error
+	// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are
+	// committed. The stream is closed once all events of a closed workflow execution are sent.
+	StreamWorkflowExecutionHistory(*StreamWorkflowExecutionHistoryRequest, HistoryService_StreamWorkflowExecutionHistoryServer) error
+	// ListFaultInjectionScenarios returns the persistence fault injection scenarios of a history host.
+	ListFaultInjectionScenarios(context.Context, *ListFaultInjectionScenariosRequest) (*ListFaultInjectionScenariosResponse, error)
+	// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of a history host.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: Only the enabled state of a scenario can be updated. --)
+	UpdateFaultInjectionScenario(context.Context, *UpdateFaultInjectionScenarioRequest) (*UpdateFaultInjectionScenarioResponse, error)
+	// MigrateHistoryShard copies a page of executions of a shard that is being migrated to a larger
+	// history shard count into their target shards, and removes them from the source shard.
+	MigrateHistoryShard(context.Context, *MigrateHistoryShardRequest) (*MigrateHistoryShardResponse, error)
+	// CopyMigratedExecution creates the mutable state of an execution copied by MigrateHistoryShard
+	// in its target shard. It is served by the owner of the target shard.
+	CopyMigratedExecution(context.Context, *CopyMigratedExecutionRequest) (*CopyMigratedExecutionResponse, error)
+	// ImportWorkflowExecution creates the workflow executions of an exported workflow execution bundle.
+	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -1008,6 +1192,18 @@ func (*UnimplementedHistoryServiceServer) TerminateWorkflowExecution(ctx context
 func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
+func (*UnimplementedHistoryServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpdateActivityOptions(ctx context.Context, req *UpdateActivityOptionsRequest) (*UpdateActivityOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivityOptions not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ResetActivity(ctx context.Context, req *ResetActivityRequest) (*ResetActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
+}
 func (*UnimplementedHistoryServiceServer) ResetWorkflowExecution(ctx context.Context, req *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowExecution not implemented")
 }
@@ -1101,6 +1297,24 @@ func (*UnimplementedHistoryServiceServer) PollWorkflowExecutionUpdate(ctx contex
 func (*UnimplementedHistoryServiceServer) StreamWorkflowReplicationMessages(srv HistoryService_StreamWorkflowReplicationMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
 }
+func (*UnimplementedHistoryServiceServer) StreamWorkflowExecutionHistory(req *StreamWorkflowExecutionHistoryRequest, srv HistoryService_StreamWorkflowExecutionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkflowExecutionHistory not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ListFaultInjectionScenarios(ctx context.Context, req *ListFaultInjectionScenariosRequest) (*ListFaultInjectionScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFaultInjectionScenarios not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpdateFaultInjectionScenario(ctx context.Context, req *UpdateFaultInjectionScenarioRequest) (*UpdateFaultInjectionScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaultInjectionScenario not implemented")
+}
+func (*UnimplementedHistoryServiceServer) MigrateHistoryShard(ctx context.Context, req *MigrateHistoryShardRequest) (*MigrateHistoryShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateHistoryShard not implemented")
+}
+func (*UnimplementedHistoryServiceServer) CopyMigratedExecution(ctx context.Context, req *CopyMigratedExecutionRequest) (*CopyMigratedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyMigratedExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ImportWorkflowExecution(ctx context.Context, req *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1412,6 +1626,78 @@ func _HistoryService_DeleteWorkflowExecution_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateActivityOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivityOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateActivityOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateActivityOptions(ctx, req.(*UpdateActivityOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResetActivity(ctx, req.(*ResetActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_ResetWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1978,6 +2264,117 @@ func (x *historyServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamW
 	return m, nil
 }
 
+func _HistoryService_StreamWorkflowExecutionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkflowExecutionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryServiceServer).StreamWorkflowExecutionHistory(m, &historyServiceStreamWorkflowExecutionHistoryServer{stream})
+}
+
+type HistoryService_StreamWorkflowExecutionHistoryServer interface {
+	Send(*StreamWorkflowExecutionHistoryResponse) error
+	grpc.ServerStream
+}
+
+type historyServiceStreamWorkflowExecutionHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyServiceStreamWorkflowExecutionHistoryServer) Send(m *StreamWorkflowExecutionHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HistoryService_ListFaultInjectionScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaultInjectionScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListFaultInjectionScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ListFaultInjectionScenarios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListFaultInjectionScenarios(ctx, req.(*ListFaultInjectionScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateFaultInjectionScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaultInjectionScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateFaultInjectionScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateFaultInjectionScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateFaultInjectionScenario(ctx, req.(*UpdateFaultInjectionScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_MigrateHistoryShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateHistoryShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MigrateHistoryShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/MigrateHistoryShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MigrateHistoryShard(ctx, req.(*MigrateHistoryShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_CopyMigratedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyMigratedExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).CopyMigratedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/CopyMigratedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).CopyMigratedExecution(ctx, req.(*CopyMigratedExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ImportWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ImportWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ImportWorkflowExecution(ctx, req.(*ImportWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -2050,6 +2447,22 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _HistoryService_DeleteWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UpdateActivityOptions",
+			Handler:    _HistoryService_UpdateActivityOptions_Handler,
+		},
+		{
+			MethodName: "ResetActivity",
+			Handler:    _HistoryService_ResetActivity_Handler,
+		},
 		{
 			MethodName: "ResetWorkflowExecution",
 			Handler:    _HistoryService_ResetWorkflowExecution_Handler,
@@ -2170,6 +2583,26 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PollWorkflowExecutionUpdate",
 			Handler:    _HistoryService_PollWorkflowExecutionUpdate_Handler,
 		},
+		{
+			MethodName: "ListFaultInjectionScenarios",
+			Handler:    _HistoryService_ListFaultInjectionScenarios_Handler,
+		},
+		{
+			MethodName: "UpdateFaultInjectionScenario",
+			Handler:    _HistoryService_UpdateFaultInjectionScenario_Handler,
+		},
+		{
+			MethodName: "MigrateHistoryShard",
+			Handler:    _HistoryService_MigrateHistoryShard_Handler,
+		},
+		{
+			MethodName: "CopyMigratedExecution",
+			Handler:    _HistoryService_CopyMigratedExecution_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowExecution",
+			Handler:    _HistoryService_ImportWorkflowExecution_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2178,6 +2611,11 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamWorkflowExecutionHistory",
+			Handler:       _HistoryService_StreamWorkflowExecutionHistory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
 }
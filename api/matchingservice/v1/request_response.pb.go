@@ -29,6 +29,7 @@ package matchingservice
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -43,7 +44,7 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v11 "go.temporal.io/api/common/v1"
-	v18 "go.temporal.io/api/enums/v1"
+	v19 "go.temporal.io/api/enums/v1"
 	v15 "go.temporal.io/api/protocol/v1"
 	v12 "go.temporal.io/api/query/v1"
 	v14 "go.temporal.io/api/taskqueue/v1"
@@ -51,7 +52,8 @@ import (
 	v17 "go.temporal.io/server/api/clock/v1"
 	v16 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
-	v19 "go.temporal.io/server/api/persistence/v1"
+	v18 "go.temporal.io/server/api/persistence/v1"
+	v110 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -549,6 +551,21 @@ type AddWorkflowTaskRequest struct {
 	ForwardedSource        string           `protobuf:"bytes,6,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v16.TaskSource   `protobuf:"varint,7,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
 	Clock                  *v17.VectorClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Lower values are dispatched first. Zero means the task queue default priority.
+	Priority int32 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Backlog tasks with different fairness keys are dispatched round-robin.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Recorded on the task so that backlogs can be inspected by workflow type.
+	WorkflowTypeName string `protobuf:"bytes,12,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	// If set to a future time, the task is parked in the backlog and not dispatched before that time.
+	// The schedule to start timeout starts at this time.
+	NotBefore *time.Time `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	// Only set if task_queue is sticky. The backlog of a sticky task queue is moved to the normal task
+	// queue of the workflow when its worker stops polling or is saturated.
+	NormalTaskQueue string `protobuf:"bytes,14,opt,name=normal_task_queue,json=normalTaskQueue,proto3" json:"normal_task_queue,omitempty"`
+	// Not set until the first workflow task of the run started, in which case the build ID ramp of the task
+	// queue decides the build ID the task goes to.
+	BuildIdAssignment *v18.BuildIdAssignment `protobuf:"bytes,15,opt,name=build_id_assignment,json=buildIdAssignment,proto3" json:"build_id_assignment,omitempty"`
 }
 
 func (m *AddWorkflowTaskRequest) Reset()      { *m = AddWorkflowTaskRequest{} }
@@ -639,6 +656,48 @@ func (m *AddWorkflowTaskRequest) GetClock() *v17.VectorClock {
 	return nil
 }
 
+func (m *AddWorkflowTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddWorkflowTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *AddWorkflowTaskRequest) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *AddWorkflowTaskRequest) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *AddWorkflowTaskRequest) GetNormalTaskQueue() string {
+	if m != nil {
+		return m.NormalTaskQueue
+	}
+	return ""
+}
+
+func (m *AddWorkflowTaskRequest) GetBuildIdAssignment() *v18.BuildIdAssignment {
+	if m != nil {
+		return m.BuildIdAssignment
+	}
+	return nil
+}
+
 type AddWorkflowTaskResponse struct {
 }
 
@@ -685,6 +744,15 @@ type AddActivityTaskRequest struct {
 	ForwardedSource        string           `protobuf:"bytes,7,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
 	Source                 v16.TaskSource   `protobuf:"varint,8,opt,name=source,proto3,enum=temporal.server.api.enums.v1.TaskSource" json:"source,omitempty"`
 	Clock                  *v17.VectorClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Lower values are dispatched first. Zero means the task queue default priority.
+	Priority int32 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Backlog tasks with different fairness keys are dispatched round-robin.
+	FairnessKey string `protobuf:"bytes,11,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	// Recorded on the task so that backlogs can be inspected by workflow type.
+	WorkflowTypeName string `protobuf:"bytes,12,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	// If set to a future time, the task is parked in the backlog and not dispatched before that time.
+	// The schedule to start timeout starts at this time.
+	NotBefore *time.Time `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 }
 
 func (m *AddActivityTaskRequest) Reset()      { *m = AddActivityTaskRequest{} }
@@ -775,6 +843,34 @@ func (m *AddActivityTaskRequest) GetClock() *v17.VectorClock {
 	return nil
 }
 
+func (m *AddActivityTaskRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *AddActivityTaskRequest) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *AddActivityTaskRequest) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
 type AddActivityTaskResponse struct {
 }
 
@@ -815,6 +911,8 @@ type QueryWorkflowRequest struct {
 	TaskQueue       *v14.TaskQueue           `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	QueryRequest    *v1.QueryWorkflowRequest `protobuf:"bytes,3,opt,name=query_request,json=queryRequest,proto3" json:"query_request,omitempty"`
 	ForwardedSource string                   `protobuf:"bytes,4,opt,name=forwarded_source,json=forwardedSource,proto3" json:"forwarded_source,omitempty"`
+	// Same as in AddWorkflowTaskRequest.
+	BuildIdAssignment *v18.BuildIdAssignment `protobuf:"bytes,5,opt,name=build_id_assignment,json=buildIdAssignment,proto3" json:"build_id_assignment,omitempty"`
 }
 
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
@@ -877,6 +975,13 @@ func (m *QueryWorkflowRequest) GetForwardedSource() string {
 	return ""
 }
 
+func (m *QueryWorkflowRequest) GetBuildIdAssignment() *v18.BuildIdAssignment {
+	if m != nil {
+		return m.BuildIdAssignment
+	}
+	return nil
+}
+
 type QueryWorkflowResponse struct {
 	QueryResult   *v11.Payloads      `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
 	QueryRejected *v12.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
@@ -1032,7 +1137,7 @@ var xxx_messageInfo_RespondQueryTaskCompletedResponse proto.InternalMessageInfo
 
 type CancelOutstandingPollRequest struct {
 	NamespaceId   string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	TaskQueueType v18.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 	TaskQueue     *v14.TaskQueue    `protobuf:"bytes,3,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	PollerId      string            `protobuf:"bytes,4,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
 }
@@ -1076,11 +1181,11 @@ func (m *CancelOutstandingPollRequest) GetNamespaceId() string {
 	return ""
 }
 
-func (m *CancelOutstandingPollRequest) GetTaskQueueType() v18.TaskQueueType {
+func (m *CancelOutstandingPollRequest) GetTaskQueueType() v19.TaskQueueType {
 	if m != nil {
 		return m.TaskQueueType
 	}
-	return v18.TASK_QUEUE_TYPE_UNSPECIFIED
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
 }
 
 func (m *CancelOutstandingPollRequest) GetTaskQueue() *v14.TaskQueue {
@@ -1133,8 +1238,9 @@ func (m *CancelOutstandingPollResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 
 type DescribeTaskQueueRequest struct {
-	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	DescRequest *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
+	NamespaceId    string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	DescRequest    *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
+	IncludeWorkers bool                         `protobuf:"varint,3,opt,name=include_workers,json=includeWorkers,proto3" json:"include_workers,omitempty"`
 }
 
 func (m *DescribeTaskQueueRequest) Reset()      { *m = DescribeTaskQueueRequest{} }
@@ -1183,9 +1289,22 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 	return nil
 }
 
+func (m *DescribeTaskQueueRequest) GetIncludeWorkers() bool {
+	if m != nil {
+		return m.IncludeWorkers
+	}
+	return false
+}
+
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Fairness keys with the largest persisted backlog, only set if task fairness is enabled.
+	FairnessKeyBacklogs []*FairnessKeyBacklog `protobuf:"bytes,3,rep,name=fairness_key_backlogs,json=fairnessKeyBacklogs,proto3" json:"fairness_key_backlogs,omitempty"`
+	// Number of tasks added to this partition by the history service since it was loaded.
+	AddedTaskCount int64 `protobuf:"varint,4,opt,name=added_task_count,json=addedTaskCount,proto3" json:"added_task_count,omitempty"`
+	// Workers which polled this partition recently, only set if requested.
+	Workers []*v110.WorkerInfo `protobuf:"bytes,5,rep,name=workers,proto3" json:"workers,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1234,6 +1353,78 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetFairnessKeyBacklogs() []*FairnessKeyBacklog {
+	if m != nil {
+		return m.FairnessKeyBacklogs
+	}
+	return nil
+}
+
+func (m *DescribeTaskQueueResponse) GetAddedTaskCount() int64 {
+	if m != nil {
+		return m.AddedTaskCount
+	}
+	return 0
+}
+
+func (m *DescribeTaskQueueResponse) GetWorkers() []*v110.WorkerInfo {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+type FairnessKeyBacklog struct {
+	FairnessKey  string `protobuf:"bytes,1,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	BacklogCount int64  `protobuf:"varint,2,opt,name=backlog_count,json=backlogCount,proto3" json:"backlog_count,omitempty"`
+}
+
+func (m *FairnessKeyBacklog) Reset()      { *m = FairnessKeyBacklog{} }
+func (*FairnessKeyBacklog) ProtoMessage() {}
+func (*FairnessKeyBacklog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{16}
+}
+func (m *FairnessKeyBacklog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FairnessKeyBacklog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FairnessKeyBacklog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FairnessKeyBacklog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FairnessKeyBacklog.Merge(m, src)
+}
+func (m *FairnessKeyBacklog) XXX_Size() int {
+	return m.Size()
+}
+func (m *FairnessKeyBacklog) XXX_DiscardUnknown() {
+	xxx_messageInfo_FairnessKeyBacklog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FairnessKeyBacklog proto.InternalMessageInfo
+
+func (m *FairnessKeyBacklog) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *FairnessKeyBacklog) GetBacklogCount() int64 {
+	if m != nil {
+		return m.BacklogCount
+	}
+	return 0
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace   string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NamespaceId string         `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1243,7 +1434,7 @@ type ListTaskQueuePartitionsRequest struct {
 func (m *ListTaskQueuePartitionsRequest) Reset()      { *m = ListTaskQueuePartitionsRequest{} }
 func (*ListTaskQueuePartitionsRequest) ProtoMessage() {}
 func (*ListTaskQueuePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{16}
+	return fileDescriptor_a429a3813476c583, []int{17}
 }
 func (m *ListTaskQueuePartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1492,7 @@ type ListTaskQueuePartitionsResponse struct {
 func (m *ListTaskQueuePartitionsResponse) Reset()      { *m = ListTaskQueuePartitionsResponse{} }
 func (*ListTaskQueuePartitionsResponse) ProtoMessage() {}
 func (*ListTaskQueuePartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{17}
+	return fileDescriptor_a429a3813476c583, []int{18}
 }
 func (m *ListTaskQueuePartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1552,7 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Reset() {
 }
 func (*UpdateWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
 func (*UpdateWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{18}
+	return fileDescriptor_a429a3813476c583, []int{19}
 }
 func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1412,7 +1603,7 @@ func (m *UpdateWorkerBuildIdCompatibilityResponse) Reset() {
 }
 func (*UpdateWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
 func (*UpdateWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{19}
+	return fileDescriptor_a429a3813476c583, []int{20}
 }
 func (m *UpdateWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1640,7 @@ type GetWorkerBuildIdCompatibilityRequest struct {
 func (m *GetWorkerBuildIdCompatibilityRequest) Reset()      { *m = GetWorkerBuildIdCompatibilityRequest{} }
 func (*GetWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
 func (*GetWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{20}
+	return fileDescriptor_a429a3813476c583, []int{21}
 }
 func (m *GetWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1690,7 @@ type GetWorkerBuildIdCompatibilityResponse struct {
 func (m *GetWorkerBuildIdCompatibilityResponse) Reset()      { *m = GetWorkerBuildIdCompatibilityResponse{} }
 func (*GetWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
 func (*GetWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{21}
+	return fileDescriptor_a429a3813476c583, []int{22}
 }
 func (m *GetWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1538,15 +1729,15 @@ func (m *GetWorkerBuildIdCompatibilityResponse) GetResponse() *v1.GetWorkerBuild
 type InvalidateTaskQueueMetadataRequest struct {
 	NamespaceId   string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
-	TaskQueueType v18.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 	// The task queue versioning data should be invalidated and replaced with this data, if set.
-	VersioningData *v19.VersioningData `protobuf:"bytes,4,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+	VersioningData *v18.VersioningData `protobuf:"bytes,4,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
 }
 
 func (m *InvalidateTaskQueueMetadataRequest) Reset()      { *m = InvalidateTaskQueueMetadataRequest{} }
 func (*InvalidateTaskQueueMetadataRequest) ProtoMessage() {}
 func (*InvalidateTaskQueueMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{22}
+	return fileDescriptor_a429a3813476c583, []int{23}
 }
 func (m *InvalidateTaskQueueMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,14 +1780,14 @@ func (m *InvalidateTaskQueueMetadataRequest) GetTaskQueue() string {
 	return ""
 }
 
-func (m *InvalidateTaskQueueMetadataRequest) GetTaskQueueType() v18.TaskQueueType {
+func (m *InvalidateTaskQueueMetadataRequest) GetTaskQueueType() v19.TaskQueueType {
 	if m != nil {
 		return m.TaskQueueType
 	}
-	return v18.TASK_QUEUE_TYPE_UNSPECIFIED
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
 }
 
-func (m *InvalidateTaskQueueMetadataRequest) GetVersioningData() *v19.VersioningData {
+func (m *InvalidateTaskQueueMetadataRequest) GetVersioningData() *v18.VersioningData {
 	if m != nil {
 		return m.VersioningData
 	}
@@ -1609,7 +1800,7 @@ type InvalidateTaskQueueMetadataResponse struct {
 func (m *InvalidateTaskQueueMetadataResponse) Reset()      { *m = InvalidateTaskQueueMetadataResponse{} }
 func (*InvalidateTaskQueueMetadataResponse) ProtoMessage() {}
 func (*InvalidateTaskQueueMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{23}
+	return fileDescriptor_a429a3813476c583, []int{24}
 }
 func (m *InvalidateTaskQueueMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1648,12 +1839,14 @@ type GetTaskQueueMetadataRequest struct {
 	// it can use any invalid value (ex: [0]).
 	// If the data is up to date, no value will be returned.
 	WantVersioningDataCurhash []byte `protobuf:"bytes,3,opt,name=want_versioning_data_curhash,json=wantVersioningDataCurhash,proto3" json:"want_versioning_data_curhash,omitempty"`
+	// If set, the requester wants the partition counts chosen by partition auto scaling.
+	WantPartitionConfig bool `protobuf:"varint,4,opt,name=want_partition_config,json=wantPartitionConfig,proto3" json:"want_partition_config,omitempty"`
 }
 
 func (m *GetTaskQueueMetadataRequest) Reset()      { *m = GetTaskQueueMetadataRequest{} }
 func (*GetTaskQueueMetadataRequest) ProtoMessage() {}
 func (*GetTaskQueueMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{24}
+	return fileDescriptor_a429a3813476c583, []int{25}
 }
 func (m *GetTaskQueueMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1703,17 +1896,26 @@ func (m *GetTaskQueueMetadataRequest) GetWantVersioningDataCurhash() []byte {
 	return nil
 }
 
+func (m *GetTaskQueueMetadataRequest) GetWantPartitionConfig() bool {
+	if m != nil {
+		return m.WantPartitionConfig
+	}
+	return false
+}
+
 type GetTaskQueueMetadataResponse struct {
 	// Types that are valid to be assigned to VersioningDataResp:
 	//	*GetTaskQueueMetadataResponse_VersioningData
 	//	*GetTaskQueueMetadataResponse_MatchedReqHash
 	VersioningDataResp isGetTaskQueueMetadataResponse_VersioningDataResp `protobuf_oneof:"versioning_data_resp"`
+	// Null if partition auto scaling has not chosen partition counts for the task queue.
+	PartitionConfig *v18.TaskQueuePartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 }
 
 func (m *GetTaskQueueMetadataResponse) Reset()      { *m = GetTaskQueueMetadataResponse{} }
 func (*GetTaskQueueMetadataResponse) ProtoMessage() {}
 func (*GetTaskQueueMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{25}
+	return fileDescriptor_a429a3813476c583, []int{26}
 }
 func (m *GetTaskQueueMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1750,7 +1952,7 @@ type isGetTaskQueueMetadataResponse_VersioningDataResp interface {
 }
 
 type GetTaskQueueMetadataResponse_VersioningData struct {
-	VersioningData *v19.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3,oneof" json:"versioning_data,omitempty"`
+	VersioningData *v18.VersioningData `protobuf:"bytes,1,opt,name=versioning_data,json=versioningData,proto3,oneof" json:"versioning_data,omitempty"`
 }
 type GetTaskQueueMetadataResponse_MatchedReqHash struct {
 	MatchedReqHash bool `protobuf:"varint,2,opt,name=matched_req_hash,json=matchedReqHash,proto3,oneof" json:"matched_req_hash,omitempty"`
@@ -1768,7 +1970,7 @@ func (m *GetTaskQueueMetadataResponse) GetVersioningDataResp() isGetTaskQueueMet
 	return nil
 }
 
-func (m *GetTaskQueueMetadataResponse) GetVersioningData() *v19.VersioningData {
+func (m *GetTaskQueueMetadataResponse) GetVersioningData() *v18.VersioningData {
 	if x, ok := m.GetVersioningDataResp().(*GetTaskQueueMetadataResponse_VersioningData); ok {
 		return x.VersioningData
 	}
@@ -1782,6 +1984,13 @@ func (m *GetTaskQueueMetadataResponse) GetMatchedReqHash() bool {
 	return false
 }
 
+func (m *GetTaskQueueMetadataResponse) GetPartitionConfig() *v18.TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GetTaskQueueMetadataResponse) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1790,436 +1999,828 @@ func (*GetTaskQueueMetadataResponse) XXX_OneofWrappers() []interface{} {
 	}
 }
 
-func init() {
-	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
-	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
-	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
-	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
-	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
-	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
-	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
-	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
-	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
-	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
-	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest")
-	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityResponse")
-	proto.RegisterType((*GetWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityRequest")
-	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse")
-	proto.RegisterType((*InvalidateTaskQueueMetadataRequest)(nil), "temporal.server.api.matchingservice.v1.InvalidateTaskQueueMetadataRequest")
-	proto.RegisterType((*InvalidateTaskQueueMetadataResponse)(nil), "temporal.server.api.matchingservice.v1.InvalidateTaskQueueMetadataResponse")
-	proto.RegisterType((*GetTaskQueueMetadataRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueMetadataRequest")
-	proto.RegisterType((*GetTaskQueueMetadataResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueMetadataResponse")
+type DescribeTaskQueueBacklogRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The task queue partition to describe.
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+func (m *DescribeTaskQueueBacklogRequest) Reset()      { *m = DescribeTaskQueueBacklogRequest{} }
+func (*DescribeTaskQueueBacklogRequest) ProtoMessage() {}
+func (*DescribeTaskQueueBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{27}
 }
-
-var fileDescriptor_a429a3813476c583 = []byte{
-	// 2052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0xc8, 0xb2, 0x2d, 0x3d, 0xc9, 0x8e, 0x3c, 0xec, 0x3a, 0xb2, 0x63, 0xcb, 0x8e, 0x92,
-	0x6c, 0x9c, 0x54, 0x90, 0x2b, 0xa6, 0x36, 0xb5, 0xbb, 0x90, 0x0a, 0xb1, 0x63, 0x62, 0x2f, 0x09,
-	0x38, 0x13, 0xb3, 0x50, 0x01, 0x6a, 0xb6, 0x35, 0xd3, 0x96, 0x06, 0x8f, 0xa6, 0xe5, 0xe9, 0x1e,
-	0x79, 0xcd, 0x09, 0x0e, 0x14, 0x07, 0x2e, 0x4b, 0x71, 0x81, 0xe2, 0xc2, 0x89, 0x02, 0x4e, 0x1c,
-	0xf8, 0x07, 0xb8, 0x71, 0xe0, 0x90, 0xe3, 0xde, 0x20, 0xce, 0x85, 0x2a, 0x2e, 0xcb, 0x7f, 0x40,
-	0xf5, 0xc7, 0x8c, 0x34, 0xa3, 0xb1, 0x25, 0x2b, 0x59, 0xc2, 0x4d, 0xf3, 0x3e, 0x7e, 0xfd, 0xde,
-	0xeb, 0xf7, 0x35, 0x23, 0xb8, 0xcb, 0x70, 0xab, 0x4d, 0x7c, 0xe4, 0xae, 0x51, 0xec, 0x77, 0xb0,
-	0xbf, 0x86, 0xda, 0xce, 0x5a, 0x0b, 0x31, 0xab, 0xe9, 0x78, 0x0d, 0x4e, 0x72, 0x2c, 0xbc, 0xd6,
-	0xb9, 0xbd, 0xe6, 0xe3, 0xc3, 0x00, 0x53, 0x66, 0xfa, 0x98, 0xb6, 0x89, 0x47, 0x71, 0xad, 0xed,
-	0x13, 0x46, 0xf4, 0x77, 0x42, 0xf5, 0x9a, 0x54, 0xaf, 0xa1, 0xb6, 0x53, 0x4b, 0xa8, 0xd7, 0x3a,
-	0xb7, 0x17, 0x2a, 0x0d, 0x42, 0x1a, 0x2e, 0x5e, 0x13, 0x5a, 0xf5, 0x60, 0x7f, 0xcd, 0x0e, 0x7c,
-	0xc4, 0x1c, 0xe2, 0x49, 0x9c, 0x85, 0xe5, 0x24, 0x9f, 0x39, 0x2d, 0x4c, 0x19, 0x6a, 0xb5, 0x95,
-	0xc0, 0x65, 0x1b, 0xb7, 0xb1, 0x67, 0x63, 0xcf, 0x72, 0x30, 0x5d, 0x6b, 0x90, 0x06, 0x11, 0x74,
-	0xf1, 0x4b, 0x89, 0x5c, 0x8d, 0x5c, 0xe1, 0x3e, 0x58, 0xa4, 0xd5, 0x22, 0x1e, 0x37, 0xbd, 0x85,
-	0x29, 0x45, 0x0d, 0x65, 0xf1, 0xc2, 0x3b, 0x31, 0x29, 0xec, 0x05, 0x2d, 0xca, 0x85, 0x18, 0xa2,
-	0x07, 0xe6, 0x61, 0x80, 0x83, 0x50, 0xee, 0x7a, 0x4c, 0x8e, 0xb3, 0x05, 0xb7, 0x1f, 0xf0, 0x4a,
-	0x4c, 0xf0, 0x30, 0xc0, 0xfe, 0xf1, 0xa0, 0x53, 0x05, 0xcd, 0x22, 0x6e, 0xbf, 0xdc, 0xcd, 0xb4,
-	0xeb, 0xb0, 0x5c, 0x62, 0x1d, 0xf4, 0xcb, 0x5e, 0x4f, 0x93, 0x8d, 0x39, 0xa4, 0x04, 0x6f, 0xa5,
-	0x09, 0x36, 0x1d, 0xca, 0x48, 0x9a, 0xa9, 0xb5, 0x34, 0xe9, 0x36, 0xf6, 0xa9, 0x43, 0x19, 0xf6,
-	0x64, 0x36, 0x70, 0x70, 0xaa, 0xe4, 0xef, 0xc4, 0x5c, 0x3b, 0x22, 0xfe, 0xc1, 0xbe, 0x4b, 0x8e,
-	0x06, 0xa6, 0x4e, 0xf5, 0xdf, 0x1a, 0x2c, 0xee, 0x12, 0xd7, 0xfd, 0xae, 0xd2, 0xd8, 0x43, 0xf4,
-	0xe0, 0x09, 0x0f, 0xb1, 0x21, 0xe5, 0xf5, 0xcb, 0x50, 0xf4, 0x50, 0x0b, 0xd3, 0x36, 0xb2, 0xb0,
-	0xe9, 0xd8, 0x65, 0x6d, 0x45, 0x5b, 0xcd, 0x1b, 0x85, 0x88, 0xb6, 0x63, 0xeb, 0x97, 0x20, 0xdf,
-	0x26, 0xae, 0x8b, 0x7d, 0xce, 0xcf, 0x08, 0x7e, 0x4e, 0x12, 0x76, 0x6c, 0xfd, 0x63, 0x28, 0xf2,
-	0xdf, 0xa6, 0x3a, 0xbf, 0x3c, 0xbe, 0xa2, 0xad, 0x16, 0xd6, 0xef, 0x46, 0xfe, 0x89, 0x5c, 0x4d,
-	0xd8, 0x5b, 0xeb, 0xdc, 0xae, 0x9d, 0x65, 0x94, 0x51, 0xe0, 0x90, 0xa1, 0x85, 0x37, 0xa0, 0xb4,
-	0x4f, 0xfc, 0x23, 0xe4, 0xdb, 0xd8, 0x36, 0x29, 0x09, 0x7c, 0x0b, 0x97, 0xb3, 0xc2, 0x8a, 0x0b,
-	0x11, 0xfd, 0xa9, 0x20, 0x57, 0xff, 0x9e, 0x87, 0xa5, 0x53, 0x80, 0x65, 0x54, 0xf4, 0x25, 0x00,
-	0x91, 0x84, 0x8c, 0x1c, 0x60, 0x4f, 0x38, 0x5b, 0x34, 0xf2, 0x9c, 0xb2, 0xc7, 0x09, 0xfa, 0xf7,
-	0x40, 0x0f, 0x6d, 0x35, 0xf1, 0x27, 0xd8, 0x0a, 0x78, 0xf5, 0x08, 0x9f, 0x0b, 0xeb, 0x37, 0xe2,
-	0x3e, 0xc9, 0xd4, 0xe7, 0xae, 0x84, 0xa7, 0x6d, 0x85, 0x0a, 0xc6, 0xec, 0x51, 0x92, 0xa4, 0xef,
-	0xc0, 0x74, 0x84, 0xcc, 0x8e, 0xdb, 0x58, 0x05, 0xea, 0xea, 0x20, 0xd0, 0xbd, 0xe3, 0x36, 0x36,
-	0x8a, 0x47, 0x3d, 0x4f, 0xfa, 0xfb, 0x30, 0xdf, 0xf6, 0x71, 0xc7, 0x21, 0x01, 0x35, 0x29, 0x43,
-	0x3e, 0xc3, 0xb6, 0x89, 0x3b, 0xd8, 0x63, 0xfc, 0x7e, 0x78, 0x64, 0xc6, 0x8d, 0xb9, 0x50, 0xe0,
-	0xa9, 0xe4, 0x6f, 0x71, 0xf6, 0x8e, 0xad, 0xaf, 0x42, 0xa9, 0x4f, 0x63, 0x42, 0x68, 0xcc, 0xd0,
-	0xb8, 0x64, 0x19, 0xa6, 0x10, 0xe3, 0xb6, 0xb1, 0xf2, 0xe4, 0x8a, 0xb6, 0x3a, 0x61, 0x84, 0x8f,
-	0x7a, 0x15, 0xa6, 0x3d, 0xfc, 0x09, 0xeb, 0x02, 0x4c, 0x09, 0x80, 0x02, 0x27, 0x86, 0xda, 0xb7,
-	0x40, 0xaf, 0x23, 0xeb, 0xc0, 0x25, 0x0d, 0xd3, 0x22, 0x81, 0xc7, 0xcc, 0xa6, 0xe3, 0xb1, 0x72,
-	0x4e, 0x08, 0x96, 0x14, 0x67, 0x93, 0x33, 0xb6, 0x1d, 0x8f, 0xe9, 0xef, 0x41, 0x99, 0x32, 0xc7,
-	0x3a, 0x38, 0xee, 0xc6, 0xdc, 0xc4, 0x1e, 0xaa, 0xbb, 0xd8, 0x2e, 0xe7, 0x57, 0xb4, 0xd5, 0x9c,
-	0x31, 0x27, 0xf9, 0x51, 0x38, 0xb7, 0x24, 0x57, 0xff, 0x00, 0x26, 0x44, 0x2f, 0x28, 0x43, 0x5a,
-	0x34, 0x05, 0xab, 0x37, 0x98, 0x4f, 0x38, 0xc1, 0x90, 0x2a, 0xfa, 0x21, 0x5c, 0x64, 0x3e, 0xf2,
-	0xa8, 0xc3, 0xdd, 0xe8, 0xde, 0x0d, 0xa2, 0x07, 0xe5, 0x82, 0x40, 0x7b, 0xbf, 0x96, 0xd6, 0x77,
-	0x55, 0x49, 0x73, 0xd8, 0xbd, 0x50, 0xbd, 0x37, 0xdf, 0x76, 0xbc, 0x7d, 0x62, 0xbc, 0xcd, 0xd2,
-	0x58, 0x7a, 0x03, 0x96, 0xfa, 0xd3, 0xcb, 0xec, 0x76, 0xc5, 0x72, 0x31, 0xcd, 0x8d, 0xa8, 0x2d,
-	0x8a, 0x33, 0xa3, 0x94, 0x5e, 0xe8, 0x4b, 0xb2, 0x88, 0xc7, 0xab, 0xba, 0xee, 0x23, 0xcf, 0x6a,
-	0xaa, 0x44, 0x9f, 0x11, 0x89, 0x5e, 0x90, 0x34, 0x99, 0xea, 0x0f, 0x61, 0x86, 0x5a, 0x4d, 0x6c,
-	0x07, 0x2e, 0xb6, 0x4d, 0x3e, 0x08, 0xca, 0x17, 0xc4, 0xe1, 0x0b, 0x35, 0x39, 0x25, 0x6a, 0xe1,
-	0x94, 0xa8, 0xed, 0x85, 0x53, 0x62, 0x23, 0xfb, 0xe9, 0x3f, 0x96, 0x35, 0x63, 0x3a, 0xd2, 0xe3,
-	0x1c, 0x7d, 0x13, 0x8a, 0x61, 0x4e, 0x09, 0x98, 0xd2, 0x90, 0x30, 0x05, 0xa5, 0x25, 0x40, 0x5c,
-	0x98, 0xe2, 0xb7, 0xe2, 0x60, 0x5a, 0x9e, 0x5d, 0x19, 0x5f, 0x2d, 0xac, 0x1b, 0xb5, 0xe1, 0x86,
-	0x5e, 0xed, 0xcc, 0x7a, 0xaf, 0x3d, 0x91, 0xa0, 0x5b, 0x1e, 0xf3, 0x8f, 0x8d, 0xf0, 0x08, 0xfd,
-	0x2e, 0xe4, 0x54, 0x3b, 0xa6, 0x65, 0x5d, 0x1c, 0x77, 0x39, 0x1e, 0xf2, 0x70, 0x76, 0xf0, 0x03,
-	0x1e, 0x4b, 0x49, 0x23, 0x52, 0x59, 0xf8, 0x18, 0x8a, 0xbd, 0xb8, 0x7a, 0x09, 0xc6, 0x0f, 0xf0,
-	0xb1, 0x6a, 0x9d, 0xfc, 0x27, 0xcf, 0xcb, 0x0e, 0x72, 0x03, 0xac, 0x5a, 0xc7, 0x90, 0x79, 0x29,
-	0x54, 0x3e, 0xc8, 0xbc, 0xa7, 0x7d, 0x98, 0xcd, 0x4d, 0x97, 0x66, 0xa2, 0xe6, 0x7d, 0xdf, 0x62,
-	0x4e, 0xc7, 0x61, 0xc7, 0xff, 0x57, 0xcd, 0xfb, 0x34, 0xa3, 0x46, 0x6f, 0xde, 0x39, 0xd9, 0xbc,
-	0x53, 0x80, 0xdf, 0x74, 0xf3, 0x5e, 0x86, 0x02, 0x52, 0x56, 0xf1, 0x30, 0x8e, 0x0b, 0x07, 0x20,
-	0x24, 0xed, 0xd8, 0xbc, 0xbb, 0x47, 0x02, 0xa2, 0xbb, 0x67, 0xcf, 0xee, 0xee, 0x91, 0x8f, 0xa2,
-	0xbb, 0xa3, 0x9e, 0x27, 0xfd, 0x0e, 0x4c, 0x38, 0x5e, 0x3b, 0x60, 0xa2, 0x2f, 0x17, 0xd6, 0x57,
-	0x4e, 0x83, 0xd8, 0x45, 0xc7, 0x2e, 0x41, 0x36, 0x35, 0xa4, 0x78, 0x4a, 0x3d, 0x4f, 0x8e, 0x56,
-	0xcf, 0xcf, 0x60, 0x3e, 0x24, 0x98, 0x8c, 0x98, 0x96, 0x4b, 0x28, 0x16, 0x80, 0x24, 0x60, 0xa2,
-	0xd7, 0x17, 0xd6, 0xe7, 0xfb, 0x30, 0x1f, 0xa8, 0x4d, 0x73, 0x23, 0xfb, 0x6b, 0x0e, 0x39, 0x17,
-	0x22, 0xec, 0x91, 0x4d, 0xae, 0xbf, 0x27, 0xd5, 0xfb, 0x7a, 0x45, 0x6e, 0x94, 0x5e, 0xb1, 0x07,
-	0x73, 0xe2, 0xb1, 0xdf, 0xba, 0xfc, 0x70, 0xd6, 0x7d, 0x49, 0xa8, 0x27, 0x4c, 0x7b, 0x04, 0xb3,
-	0x4d, 0x8c, 0x7c, 0x56, 0xc7, 0x88, 0x45, 0x80, 0x30, 0x1c, 0x60, 0x29, 0xd2, 0x0c, 0xd1, 0x7a,
-	0xc6, 0x67, 0x21, 0x3e, 0x3e, 0x31, 0x54, 0xac, 0xc0, 0xf7, 0xf9, 0xd0, 0x51, 0x24, 0x33, 0x71,
-	0x6f, 0xc5, 0x21, 0x83, 0x72, 0x49, 0xe1, 0xdc, 0x97, 0x30, 0x4f, 0x63, 0xb7, 0xf8, 0xb8, 0xd7,
-	0x1d, 0x1b, 0x33, 0xe4, 0xb8, 0xb4, 0x3c, 0x3d, 0x64, 0x4a, 0x75, 0xfd, 0x79, 0x20, 0x35, 0xfb,
-	0xd7, 0x97, 0x99, 0x91, 0xd7, 0x97, 0x2f, 0xf7, 0x94, 0x69, 0xd4, 0xa9, 0xc4, 0xf0, 0xc9, 0x77,
-	0x6b, 0xef, 0x5b, 0x21, 0x43, 0xbf, 0x03, 0x93, 0x4d, 0x8c, 0x6c, 0xec, 0xab, 0xc1, 0x52, 0x39,
-	0xed, 0xc8, 0x6d, 0x21, 0x65, 0x28, 0xe9, 0xea, 0x4f, 0xb3, 0x30, 0x77, 0xdf, 0xb6, 0x7b, 0x47,
-	0xc3, 0x39, 0xda, 0xe6, 0x43, 0xc8, 0xbf, 0x42, 0x0b, 0xe9, 0xea, 0xea, 0x9b, 0xaa, 0x67, 0xc9,
-	0xf9, 0x3e, 0x7e, 0x8e, 0xf9, 0x2e, 0x3a, 0x9b, 0x1c, 0xe7, 0xb7, 0x40, 0xef, 0xe6, 0x48, 0x62,
-	0xd5, 0x2b, 0x45, 0x9c, 0x70, 0xf9, 0x4a, 0x14, 0xb0, 0xaa, 0x15, 0x95, 0xd1, 0x13, 0xe7, 0x2e,
-	0x60, 0xb1, 0x42, 0x86, 0x79, 0x9d, 0xd6, 0xcf, 0x27, 0x53, 0xfb, 0xb9, 0xfe, 0x75, 0x98, 0x54,
-	0x02, 0xbc, 0x69, 0xcc, 0xac, 0xaf, 0xa6, 0x4e, 0x74, 0xf1, 0x2a, 0x15, 0x3a, 0x2e, 0x35, 0x0d,
-	0xa5, 0xa7, 0xdf, 0x83, 0x09, 0xf1, 0x56, 0xa6, 0xea, 0xfa, 0x46, 0x2a, 0x80, 0x90, 0xe0, 0x00,
-	0x1f, 0x61, 0x8b, 0x11, 0x7f, 0x93, 0x3f, 0x1a, 0x52, 0xaf, 0x3a, 0x0f, 0x17, 0xfb, 0x52, 0x40,
-	0xce, 0x92, 0xea, 0xcf, 0x65, 0x7a, 0xf4, 0x0e, 0x9b, 0x37, 0x9f, 0x1e, 0xd9, 0xd7, 0x99, 0x1e,
-	0x13, 0xa3, 0xa4, 0xc7, 0xe4, 0xeb, 0x4f, 0x8f, 0xa9, 0x41, 0xe9, 0x91, 0x7b, 0x43, 0xe9, 0xf1,
-	0x61, 0x36, 0x37, 0x5e, 0xca, 0xaa, 0x24, 0x89, 0x27, 0x82, 0x4a, 0x92, 0x9f, 0x65, 0xe0, 0x2d,
-	0xb1, 0x9b, 0x85, 0x77, 0x78, 0x8e, 0x14, 0x89, 0xdf, 0x6c, 0x66, 0xb4, 0x9b, 0x7d, 0x06, 0xd3,
-	0x62, 0x59, 0x4c, 0x6c, 0x68, 0xef, 0x0e, 0xdc, 0xd0, 0xd2, 0xac, 0x36, 0x8a, 0x02, 0x6b, 0x84,
-	0xd5, 0xec, 0x8f, 0x1a, 0xbc, 0x9d, 0x40, 0x54, 0x2b, 0xd9, 0x26, 0x14, 0x43, 0x03, 0x69, 0xe0,
-	0x32, 0x11, 0x88, 0x61, 0x26, 0x4c, 0x41, 0x99, 0xc2, 0x95, 0xf4, 0x6f, 0xc2, 0x4c, 0x08, 0xf2,
-	0x23, 0x6c, 0x31, 0x6c, 0x0f, 0x58, 0x9b, 0xe5, 0xba, 0xac, 0x64, 0x8d, 0xe9, 0xc3, 0xde, 0xc7,
-	0xea, 0xaf, 0x32, 0xb0, 0x22, 0xcd, 0xb3, 0x85, 0x1c, 0x8f, 0xeb, 0x26, 0x69, 0xb5, 0x5d, 0xcc,
-	0x85, 0xff, 0xc7, 0xf7, 0x77, 0x11, 0xa6, 0x04, 0x48, 0xb4, 0x34, 0x4e, 0xf2, 0xc7, 0x1d, 0x5b,
-	0xf7, 0x60, 0xd6, 0x0a, 0x8d, 0x8a, 0x2e, 0x57, 0x96, 0xff, 0xfd, 0x81, 0x97, 0x3b, 0xc8, 0x3d,
-	0xa3, 0x64, 0x25, 0x28, 0xd5, 0x2b, 0x70, 0xf9, 0x0c, 0x2d, 0x95, 0xee, 0xff, 0xd1, 0x60, 0x71,
-	0x13, 0x79, 0x16, 0x76, 0xbf, 0x1d, 0x30, 0xca, 0x90, 0x67, 0x3b, 0x5e, 0x63, 0xb7, 0x67, 0x9b,
-	0x1f, 0x22, 0x6c, 0x8f, 0xe0, 0x42, 0x37, 0x6c, 0x72, 0x55, 0xc8, 0x88, 0xfa, 0x4e, 0xc4, 0x2e,
-	0x56, 0xd8, 0x22, 0x58, 0x62, 0x55, 0x98, 0x66, 0xbd, 0x8f, 0xaf, 0x67, 0x7a, 0xc6, 0x5e, 0x81,
-	0xb2, 0xf1, 0x57, 0xa0, 0xea, 0x32, 0x2c, 0x9d, 0xe2, 0xb2, 0x0a, 0xca, 0x6f, 0x35, 0x28, 0x3f,
-	0xc0, 0xd4, 0xf2, 0x9d, 0x3a, 0x1e, 0xe5, 0x05, 0xec, 0x07, 0x50, 0xb4, 0x31, 0xb5, 0xa2, 0x4b,
-	0xce, 0x24, 0xbf, 0x2d, 0x9c, 0x72, 0xc9, 0xa7, 0x9d, 0x69, 0x14, 0x38, 0x5c, 0x78, 0xaf, 0x7f,
-	0xd1, 0x60, 0x3e, 0x45, 0x52, 0x55, 0xe7, 0x3d, 0x98, 0x92, 0x8e, 0xd2, 0xb2, 0x26, 0x5e, 0x73,
-	0xaf, 0x9d, 0x11, 0xbb, 0x5d, 0x19, 0x12, 0x6f, 0x9f, 0x18, 0xa1, 0x96, 0xfe, 0x11, 0xcc, 0xf6,
-	0xdc, 0x26, 0x65, 0x88, 0x05, 0x54, 0x79, 0x70, 0x73, 0x98, 0x6b, 0x78, 0x2a, 0x34, 0x8c, 0x0b,
-	0x2c, 0x4e, 0xa8, 0xfe, 0x5e, 0x83, 0xca, 0x23, 0x87, 0xb2, 0x48, 0x70, 0x17, 0xf9, 0xcc, 0xe1,
-	0x03, 0x86, 0x86, 0xa1, 0x5d, 0x84, 0x7c, 0x77, 0x3b, 0x94, 0x71, 0xed, 0x12, 0xfa, 0x02, 0x3f,
-	0xfe, 0xc5, 0x14, 0x70, 0xf5, 0x37, 0x19, 0x58, 0x3e, 0xd5, 0x50, 0x15, 0xe5, 0x1f, 0x43, 0xa5,
-	0xfb, 0xf2, 0xd7, 0x8d, 0x56, 0x3b, 0x92, 0x54, 0xc1, 0x7f, 0x77, 0x98, 0xc3, 0x23, 0xfc, 0xc7,
-	0x98, 0x21, 0x1b, 0x31, 0x64, 0x5c, 0x42, 0xc9, 0x17, 0xe2, 0xae, 0x0d, 0xfc, 0xec, 0xd8, 0xa7,
-	0xab, 0xfe, 0xb3, 0x33, 0xaf, 0x74, 0xf6, 0x51, 0xf2, 0xcb, 0x4a, 0xf7, 0xec, 0xea, 0x9f, 0x35,
-	0xb8, 0xfe, 0x9d, 0xb6, 0x8d, 0x18, 0xe6, 0x63, 0x01, 0xfb, 0x1b, 0x81, 0xe3, 0xda, 0x3b, 0x36,
-	0xef, 0x2b, 0x88, 0x39, 0x75, 0xc7, 0x75, 0xd8, 0xf1, 0x39, 0x0a, 0xa5, 0x0e, 0x53, 0xf1, 0x1a,
-	0xd9, 0x1e, 0x58, 0x23, 0x43, 0x9e, 0x6e, 0x84, 0xc0, 0xd5, 0x9b, 0xb0, 0x3a, 0x58, 0x47, 0x15,
-	0xfe, 0x9f, 0x34, 0xb8, 0xfa, 0x10, 0xb3, 0xd7, 0xe2, 0x9b, 0x99, 0xf4, 0x6d, 0x6b, 0xa0, 0x6f,
-	0xc3, 0x1c, 0xdd, 0x75, 0xec, 0x17, 0x1a, 0x5c, 0x1b, 0xa0, 0xa1, 0xb2, 0xb5, 0x0e, 0xb9, 0xf0,
-	0x3f, 0x02, 0x35, 0xad, 0xbf, 0xf1, 0xaa, 0xb6, 0x48, 0x34, 0x23, 0xc2, 0xad, 0xfe, 0x32, 0x03,
-	0xd5, 0x1d, 0xaf, 0x83, 0x5c, 0x87, 0xc7, 0x3a, 0xca, 0x9d, 0x28, 0xad, 0x86, 0x0f, 0xdc, 0x52,
-	0x5f, 0x11, 0xe7, 0x7b, 0x5b, 0x7b, 0xca, 0xb4, 0x19, 0x1f, 0x7d, 0xda, 0x7c, 0x1f, 0x2e, 0x74,
-	0xb0, 0x4f, 0x1d, 0xe2, 0x39, 0x5e, 0xc3, 0xe4, 0x96, 0xaa, 0x91, 0xbc, 0x9e, 0xba, 0x5a, 0xf6,
-	0xfc, 0x5d, 0x23, 0x17, 0xcc, 0x50, 0xf5, 0x01, 0xf7, 0x71, 0xa6, 0x13, 0x7b, 0xae, 0x5e, 0x83,
-	0x2b, 0x67, 0x86, 0x44, 0x85, 0xee, 0x77, 0x1a, 0x5c, 0x7a, 0x88, 0xd9, 0x17, 0x18, 0xb3, 0x7b,
-	0xb0, 0x78, 0x84, 0x3c, 0x66, 0x26, 0x5c, 0x35, 0xad, 0xc0, 0x6f, 0x22, 0xda, 0x14, 0x01, 0x2c,
-	0x1a, 0xf3, 0x5c, 0x26, 0xee, 0xd2, 0xa6, 0x14, 0xa8, 0xfe, 0x55, 0x83, 0xc5, 0x74, 0x13, 0x55,
-	0x8a, 0xfd, 0xb0, 0x3f, 0x8e, 0xda, 0xa8, 0x71, 0xdc, 0x1e, 0x4b, 0x46, 0x52, 0xbf, 0x09, 0x25,
-	0xf1, 0x1d, 0x58, 0x6e, 0x4e, 0xa6, 0x30, 0x9a, 0x7b, 0x99, 0xe3, 0xb2, 0x8a, 0x63, 0xe0, 0xc3,
-	0x6d, 0x44, 0x9b, 0x1b, 0x73, 0xf0, 0x56, 0xd2, 0x4f, 0x9e, 0xa5, 0x1b, 0xfe, 0xf3, 0x17, 0x95,
-	0xb1, 0xcf, 0x5e, 0x54, 0xc6, 0x3e, 0x7f, 0x51, 0xd1, 0x7e, 0x72, 0x52, 0xd1, 0xfe, 0x70, 0x52,
-	0xd1, 0xfe, 0x76, 0x52, 0xd1, 0x9e, 0x9f, 0x54, 0xb4, 0x7f, 0x9e, 0x54, 0xb4, 0x7f, 0x9d, 0x54,
-	0xc6, 0x3e, 0x3f, 0xa9, 0x68, 0x9f, 0xbe, 0xac, 0x8c, 0x3d, 0x7f, 0x59, 0x19, 0xfb, 0xec, 0x65,
-	0x65, 0xec, 0xd9, 0xd7, 0x1a, 0xa4, 0xeb, 0x81, 0x43, 0xce, 0xfe, 0x37, 0xf7, 0xab, 0x09, 0x52,
-	0x7d, 0x52, 0xbc, 0x4d, 0x7d, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x35, 0xe8, 0xbf, 0x59,
-	0x0e, 0x1e, 0x00, 0x00,
+func (m *DescribeTaskQueueBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*PollWorkflowTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DescribeTaskQueueBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskQueueBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if this.PollerId != that1.PollerId {
-		return false
-	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+}
+func (m *DescribeTaskQueueBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskQueueBacklogRequest.Merge(m, src)
+}
+func (m *DescribeTaskQueueBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskQueueBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskQueueBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskQueueBacklogRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskQueueBacklogRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+	return ""
+}
+
+func (m *DescribeTaskQueueBacklogRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	return true
+	return ""
 }
-func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeTaskQueueBacklogRequest) GetTaskQueueType() v19.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DescribeTaskQueueBacklogResponse struct {
+	Summary *v110.BacklogSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (m *DescribeTaskQueueBacklogResponse) Reset()      { *m = DescribeTaskQueueBacklogResponse{} }
+func (*DescribeTaskQueueBacklogResponse) ProtoMessage() {}
+func (*DescribeTaskQueueBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{28}
+}
+func (m *DescribeTaskQueueBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskQueueBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskQueueBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
-	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
-	}
-	if this.StartedEventId != that1.StartedEventId {
-		return false
-	}
-	if this.Attempt != that1.Attempt {
-		return false
-	}
-	if this.NextEventId != that1.NextEventId {
-		return false
+}
+func (m *DescribeTaskQueueBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskQueueBacklogResponse.Merge(m, src)
+}
+func (m *DescribeTaskQueueBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskQueueBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskQueueBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskQueueBacklogResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskQueueBacklogResponse) GetSummary() *v110.BacklogSummary {
+	if m != nil {
+		return m.Summary
 	}
-	if this.BacklogCountHint != that1.BacklogCountHint {
-		return false
+	return nil
+}
+
+type MoveTaskQueueTasksRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The task queue partition to move tasks from.
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// The task queue to move tasks to. Tasks are added to it like new tasks, so they may land on any of its partitions.
+	DestinationTaskQueue string                  `protobuf:"bytes,4,opt,name=destination_task_queue,json=destinationTaskQueue,proto3" json:"destination_task_queue,omitempty"`
+	Filter               *v110.BacklogTaskFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of tasks to move.
+	MaxTasks int32 `protobuf:"varint,6,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+}
+
+func (m *MoveTaskQueueTasksRequest) Reset()      { *m = MoveTaskQueueTasksRequest{} }
+func (*MoveTaskQueueTasksRequest) ProtoMessage() {}
+func (*MoveTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{29}
+}
+func (m *MoveTaskQueueTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskQueueTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskQueueTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
-		return false
+}
+func (m *MoveTaskQueueTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskQueueTasksRequest.Merge(m, src)
+}
+func (m *MoveTaskQueueTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskQueueTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskQueueTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskQueueTasksRequest proto.InternalMessageInfo
+
+func (m *MoveTaskQueueTasksRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.Query.Equal(that1.Query) {
-		return false
+	return ""
+}
+
+func (m *MoveTaskQueueTasksRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if !this.TransientWorkflowTask.Equal(that1.TransientWorkflowTask) {
-		return false
+	return ""
+}
+
+func (m *MoveTaskQueueTasksRequest) GetTaskQueueType() v19.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
-	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
-		return false
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *MoveTaskQueueTasksRequest) GetDestinationTaskQueue() string {
+	if m != nil {
+		return m.DestinationTaskQueue
 	}
-	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
-		return false
+	return ""
+}
+
+func (m *MoveTaskQueueTasksRequest) GetFilter() *v110.BacklogTaskFilter {
+	if m != nil {
+		return m.Filter
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
-		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
+	return nil
+}
+
+func (m *MoveTaskQueueTasksRequest) GetMaxTasks() int32 {
+	if m != nil {
+		return m.MaxTasks
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
+	return 0
+}
+
+type MoveTaskQueueTasksResponse struct {
+	MovedTaskCount int64 `protobuf:"varint,1,opt,name=moved_task_count,json=movedTaskCount,proto3" json:"moved_task_count,omitempty"`
+}
+
+func (m *MoveTaskQueueTasksResponse) Reset()      { *m = MoveTaskQueueTasksResponse{} }
+func (*MoveTaskQueueTasksResponse) ProtoMessage() {}
+func (*MoveTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{30}
+}
+func (m *MoveTaskQueueTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveTaskQueueTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveTaskQueueTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
+		return b[:n], nil
 	}
-	if len(this.Queries) != len(that1.Queries) {
-		return false
+}
+func (m *MoveTaskQueueTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveTaskQueueTasksResponse.Merge(m, src)
+}
+func (m *MoveTaskQueueTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveTaskQueueTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveTaskQueueTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveTaskQueueTasksResponse proto.InternalMessageInfo
+
+func (m *MoveTaskQueueTasksResponse) GetMovedTaskCount() int64 {
+	if m != nil {
+		return m.MovedTaskCount
 	}
-	for i := range this.Queries {
-		if !this.Queries[i].Equal(that1.Queries[i]) {
-			return false
+	return 0
+}
+
+type PurgeTaskQueueTasksRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The task queue partition to purge tasks from.
+	TaskQueue     string                  `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v19.TaskQueueType       `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Filter        *v110.BacklogTaskFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of tasks to purge.
+	MaxTasks int32 `protobuf:"varint,5,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"`
+}
+
+func (m *PurgeTaskQueueTasksRequest) Reset()      { *m = PurgeTaskQueueTasksRequest{} }
+func (*PurgeTaskQueueTasksRequest) ProtoMessage() {}
+func (*PurgeTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{31}
+}
+func (m *PurgeTaskQueueTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeTaskQueueTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeTaskQueueTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Messages) != len(that1.Messages) {
-		return false
+}
+func (m *PurgeTaskQueueTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeTaskQueueTasksRequest.Merge(m, src)
+}
+func (m *PurgeTaskQueueTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeTaskQueueTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeTaskQueueTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeTaskQueueTasksRequest proto.InternalMessageInfo
+
+func (m *PurgeTaskQueueTasksRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	for i := range this.Messages {
-		if !this.Messages[i].Equal(that1.Messages[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *PurgeTaskQueueTasksRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	return true
+	return ""
 }
-func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PurgeTaskQueueTasksRequest) GetTaskQueueType() v19.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
+}
 
-	that1, ok := that.(*PollActivityTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PurgeTaskQueueTasksRequest) GetFilter() *v110.BacklogTaskFilter {
+	if m != nil {
+		return m.Filter
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *PurgeTaskQueueTasksRequest) GetMaxTasks() int32 {
+	if m != nil {
+		return m.MaxTasks
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return 0
+}
+
+type PurgeTaskQueueTasksResponse struct {
+	PurgedTaskCount int64 `protobuf:"varint,1,opt,name=purged_task_count,json=purgedTaskCount,proto3" json:"purged_task_count,omitempty"`
+}
+
+func (m *PurgeTaskQueueTasksResponse) Reset()      { *m = PurgeTaskQueueTasksResponse{} }
+func (*PurgeTaskQueueTasksResponse) ProtoMessage() {}
+func (*PurgeTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{32}
+}
+func (m *PurgeTaskQueueTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeTaskQueueTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeTaskQueueTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PollerId != that1.PollerId {
-		return false
+}
+func (m *PurgeTaskQueueTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeTaskQueueTasksResponse.Merge(m, src)
+}
+func (m *PurgeTaskQueueTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeTaskQueueTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeTaskQueueTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeTaskQueueTasksResponse proto.InternalMessageInfo
+
+func (m *PurgeTaskQueueTasksResponse) GetPurgedTaskCount() int64 {
+	if m != nil {
+		return m.PurgedTaskCount
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+	return 0
+}
+
+type RecordActivityTaskClosedRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The task queue partition the activity task was dispatched from.
+	TaskQueue        string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Execution        *v11.WorkflowExecution `protobuf:"bytes,3,opt,name=execution,proto3" json:"execution,omitempty"`
+	ScheduledEventId int64                  `protobuf:"varint,4,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	Attempt          int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (m *RecordActivityTaskClosedRequest) Reset()      { *m = RecordActivityTaskClosedRequest{} }
+func (*RecordActivityTaskClosedRequest) ProtoMessage() {}
+func (*RecordActivityTaskClosedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{33}
+}
+func (m *RecordActivityTaskClosedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordActivityTaskClosedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordActivityTaskClosedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+}
+func (m *RecordActivityTaskClosedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskClosedRequest.Merge(m, src)
+}
+func (m *RecordActivityTaskClosedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordActivityTaskClosedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskClosedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordActivityTaskClosedRequest proto.InternalMessageInfo
+
+func (m *RecordActivityTaskClosedRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	return true
+	return ""
 }
-func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RecordActivityTaskClosedRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollActivityTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RecordActivityTaskClosedRequest) GetExecution() *v11.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *RecordActivityTaskClosedRequest) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
 	}
-	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
-		return false
+	return 0
+}
+
+func (m *RecordActivityTaskClosedRequest) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return 0
+}
+
+type RecordActivityTaskClosedResponse struct {
+}
+
+func (m *RecordActivityTaskClosedResponse) Reset()      { *m = RecordActivityTaskClosedResponse{} }
+func (*RecordActivityTaskClosedResponse) ProtoMessage() {}
+func (*RecordActivityTaskClosedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{34}
+}
+func (m *RecordActivityTaskClosedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordActivityTaskClosedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordActivityTaskClosedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ActivityId != that1.ActivityId {
-		return false
+}
+func (m *RecordActivityTaskClosedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordActivityTaskClosedResponse.Merge(m, src)
+}
+func (m *RecordActivityTaskClosedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordActivityTaskClosedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordActivityTaskClosedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordActivityTaskClosedResponse proto.InternalMessageInfo
+
+type UpdateBuildIdRampRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the workflow task queue, the ramp applies to its workflow executions.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// The build ID to ramp to. An empty build ID removes the ramp.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Percentage of new workflow executions, in [0, 100], which are routed to the build ID.
+	RampPercentage float32 `protobuf:"fixed32,4,opt,name=ramp_percentage,json=rampPercentage,proto3" json:"ramp_percentage,omitempty"`
+}
+
+func (m *UpdateBuildIdRampRequest) Reset()      { *m = UpdateBuildIdRampRequest{} }
+func (*UpdateBuildIdRampRequest) ProtoMessage() {}
+func (*UpdateBuildIdRampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{35}
+}
+func (m *UpdateBuildIdRampRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateBuildIdRampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateBuildIdRampRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.ActivityType.Equal(that1.ActivityType) {
-		return false
+}
+func (m *UpdateBuildIdRampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBuildIdRampRequest.Merge(m, src)
+}
+func (m *UpdateBuildIdRampRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateBuildIdRampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBuildIdRampRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBuildIdRampRequest proto.InternalMessageInfo
+
+func (m *UpdateBuildIdRampRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.Input.Equal(that1.Input) {
-		return false
+	return ""
+}
+
+func (m *UpdateBuildIdRampRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
-		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
+	return ""
+}
+
+func (m *UpdateBuildIdRampRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
-		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
-			return false
-		}
-	} else if this.ScheduleToCloseTimeout != nil {
-		return false
-	} else if that1.ScheduleToCloseTimeout != nil {
-		return false
+	return ""
+}
+
+func (m *UpdateBuildIdRampRequest) GetRampPercentage() float32 {
+	if m != nil {
+		return m.RampPercentage
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
+	return 0
+}
+
+type UpdateBuildIdRampResponse struct {
+}
+
+func (m *UpdateBuildIdRampResponse) Reset()      { *m = UpdateBuildIdRampResponse{} }
+func (*UpdateBuildIdRampResponse) ProtoMessage() {}
+func (*UpdateBuildIdRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{36}
+}
+func (m *UpdateBuildIdRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateBuildIdRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateBuildIdRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
+		return b[:n], nil
 	}
-	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
-		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
-			return false
-		}
-	} else if this.StartToCloseTimeout != nil {
-		return false
-	} else if that1.StartToCloseTimeout != nil {
-		return false
+}
+func (m *UpdateBuildIdRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateBuildIdRampResponse.Merge(m, src)
+}
+func (m *UpdateBuildIdRampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateBuildIdRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateBuildIdRampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateBuildIdRampResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
+	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
+	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
+	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
+	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
+	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
+	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
+	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
+	proto.RegisterType((*FairnessKeyBacklog)(nil), "temporal.server.api.matchingservice.v1.FairnessKeyBacklog")
+	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
+	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*InvalidateTaskQueueMetadataRequest)(nil), "temporal.server.api.matchingservice.v1.InvalidateTaskQueueMetadataRequest")
+	proto.RegisterType((*InvalidateTaskQueueMetadataResponse)(nil), "temporal.server.api.matchingservice.v1.InvalidateTaskQueueMetadataResponse")
+	proto.RegisterType((*GetTaskQueueMetadataRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueMetadataRequest")
+	proto.RegisterType((*GetTaskQueueMetadataResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueMetadataResponse")
+	proto.RegisterType((*DescribeTaskQueueBacklogRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueBacklogRequest")
+	proto.RegisterType((*DescribeTaskQueueBacklogResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueBacklogResponse")
+	proto.RegisterType((*MoveTaskQueueTasksRequest)(nil), "temporal.server.api.matchingservice.v1.MoveTaskQueueTasksRequest")
+	proto.RegisterType((*MoveTaskQueueTasksResponse)(nil), "temporal.server.api.matchingservice.v1.MoveTaskQueueTasksResponse")
+	proto.RegisterType((*PurgeTaskQueueTasksRequest)(nil), "temporal.server.api.matchingservice.v1.PurgeTaskQueueTasksRequest")
+	proto.RegisterType((*PurgeTaskQueueTasksResponse)(nil), "temporal.server.api.matchingservice.v1.PurgeTaskQueueTasksResponse")
+	proto.RegisterType((*RecordActivityTaskClosedRequest)(nil), "temporal.server.api.matchingservice.v1.RecordActivityTaskClosedRequest")
+	proto.RegisterType((*RecordActivityTaskClosedResponse)(nil), "temporal.server.api.matchingservice.v1.RecordActivityTaskClosedResponse")
+	proto.RegisterType((*UpdateBuildIdRampRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateBuildIdRampRequest")
+	proto.RegisterType((*UpdateBuildIdRampResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateBuildIdRampResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+}
+
+var fileDescriptor_a429a3813476c583 = []byte{
+	// 2622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcf, 0x73, 0x23, 0x47,
+	0xf5, 0xf7, 0x48, 0x96, 0x2d, 0x3d, 0xc9, 0xb2, 0x3c, 0x9b, 0x75, 0x64, 0x7b, 0x2d, 0x7b, 0x67,
+	0x93, 0xac, 0xe3, 0xca, 0x57, 0xfe, 0xc6, 0x21, 0xa9, 0x24, 0x24, 0xb5, 0xac, 0xbd, 0xbf, 0x9c,
+	0x64, 0xc1, 0x99, 0x35, 0x09, 0xb5, 0x84, 0x9a, 0xb4, 0x66, 0xda, 0xf2, 0xe0, 0xd1, 0x8c, 0x3c,
+	0xdd, 0x23, 0xaf, 0x39, 0x71, 0xe7, 0x12, 0x8a, 0xaa, 0x14, 0xdc, 0x38, 0x51, 0x90, 0x13, 0x1c,
+	0xf8, 0x07, 0x28, 0x0e, 0x54, 0xc1, 0x61, 0x0f, 0x1c, 0x72, 0x83, 0xf5, 0x5e, 0xa8, 0x82, 0x43,
+	0xa8, 0xe2, 0x0f, 0xa0, 0xfa, 0xc7, 0x8c, 0x34, 0xa3, 0x91, 0x25, 0x7b, 0x1d, 0xb2, 0x54, 0x71,
+	0xf3, 0xbc, 0x7e, 0xef, 0xf5, 0x7b, 0x9f, 0x7e, 0xbf, 0xba, 0x65, 0x78, 0x9b, 0xe2, 0x56, 0xdb,
+	0xf3, 0x91, 0xb3, 0x46, 0xb0, 0xdf, 0xc1, 0xfe, 0x1a, 0x6a, 0xdb, 0x6b, 0x2d, 0x44, 0xcd, 0x3d,
+	0xdb, 0x6d, 0x32, 0x92, 0x6d, 0xe2, 0xb5, 0xce, 0xcb, 0x6b, 0x3e, 0x3e, 0x08, 0x30, 0xa1, 0x86,
+	0x8f, 0x49, 0xdb, 0x73, 0x09, 0xae, 0xb7, 0x7d, 0x8f, 0x7a, 0xea, 0x0b, 0xa1, 0x78, 0x5d, 0x88,
+	0xd7, 0x51, 0xdb, 0xae, 0x27, 0xc4, 0xeb, 0x9d, 0x97, 0xe7, 0x6b, 0x4d, 0xcf, 0x6b, 0x3a, 0x78,
+	0x8d, 0x4b, 0x35, 0x82, 0xdd, 0x35, 0x2b, 0xf0, 0x11, 0xb5, 0x3d, 0x57, 0xe8, 0x99, 0x5f, 0x4a,
+	0xae, 0x53, 0xbb, 0x85, 0x09, 0x45, 0xad, 0xb6, 0x64, 0xb8, 0x6c, 0xe1, 0x36, 0x76, 0x2d, 0xec,
+	0x9a, 0x36, 0x26, 0x6b, 0x4d, 0xaf, 0xe9, 0x71, 0x3a, 0xff, 0x4b, 0xb2, 0x3c, 0x17, 0xb9, 0xc2,
+	0x7c, 0x30, 0xbd, 0x56, 0xcb, 0x73, 0x99, 0xe9, 0x2d, 0x4c, 0x08, 0x6a, 0x4a, 0x8b, 0xe7, 0x5f,
+	0x88, 0x71, 0x61, 0x37, 0x68, 0x11, 0xc6, 0x44, 0x11, 0xd9, 0x37, 0x0e, 0x02, 0x1c, 0x84, 0x7c,
+	0x57, 0x63, 0x7c, 0x6c, 0x99, 0xaf, 0xf6, 0x2b, 0xbc, 0x12, 0x63, 0x3c, 0x08, 0xb0, 0x7f, 0x34,
+	0x6c, 0x57, 0x4e, 0x33, 0x3d, 0xa7, 0x9f, 0x6f, 0x35, 0xed, 0x38, 0x4c, 0xc7, 0x33, 0xf7, 0xfb,
+	0x79, 0xaf, 0xa6, 0xf1, 0xc6, 0x1c, 0x92, 0x8c, 0x2f, 0xa5, 0x31, 0xee, 0xd9, 0x84, 0x7a, 0x69,
+	0xa6, 0xd6, 0xd3, 0xb8, 0xdb, 0xd8, 0x27, 0x36, 0xa1, 0xd8, 0x35, 0x71, 0xa8, 0x9c, 0x9c, 0xc4,
+	0x7f, 0x02, 0x5e, 0xaf, 0xc5, 0xa0, 0x38, 0xf4, 0xfc, 0xfd, 0x5d, 0xc7, 0x3b, 0x1c, 0x1a, 0x6a,
+	0xda, 0xdf, 0x15, 0xb8, 0xb4, 0xed, 0x39, 0xce, 0x87, 0x52, 0x62, 0x07, 0x91, 0xfd, 0xf7, 0xd9,
+	0x16, 0xba, 0xe0, 0x57, 0x2f, 0x43, 0xc9, 0x45, 0x2d, 0x4c, 0xda, 0xc8, 0xc4, 0x86, 0x6d, 0x55,
+	0x95, 0x65, 0x65, 0xa5, 0xa0, 0x17, 0x23, 0xda, 0x96, 0xa5, 0x2e, 0x40, 0xa1, 0xed, 0x39, 0x0e,
+	0xf6, 0xd9, 0x7a, 0x86, 0xaf, 0xe7, 0x05, 0x61, 0xcb, 0x52, 0x3f, 0x86, 0x12, 0xfb, 0xdb, 0x90,
+	0xfb, 0x57, 0xb3, 0xcb, 0xca, 0x4a, 0x71, 0xfd, 0xed, 0xc8, 0x3f, 0x1e, 0xdb, 0x09, 0x7b, 0xeb,
+	0x9d, 0x97, 0xeb, 0x27, 0x19, 0xa5, 0x17, 0x99, 0xca, 0xd0, 0xc2, 0x17, 0xa1, 0xb2, 0xeb, 0xf9,
+	0x87, 0xc8, 0xb7, 0xb0, 0x65, 0x10, 0x2f, 0xf0, 0x4d, 0x5c, 0x1d, 0xe7, 0x56, 0x4c, 0x47, 0xf4,
+	0x7b, 0x9c, 0xac, 0xfd, 0xa9, 0x00, 0x8b, 0x03, 0x14, 0x0b, 0x54, 0xd4, 0x45, 0x00, 0x1e, 0xb4,
+	0xd4, 0xdb, 0xc7, 0x2e, 0x77, 0xb6, 0xa4, 0x17, 0x18, 0x65, 0x87, 0x11, 0xd4, 0xef, 0x80, 0x1a,
+	0xda, 0x6a, 0xe0, 0x07, 0xd8, 0x0c, 0x58, 0xb6, 0x71, 0x9f, 0x8b, 0xeb, 0x2f, 0xc6, 0x7d, 0x12,
+	0xa9, 0xc2, 0x5c, 0x09, 0x77, 0xbb, 0x19, 0x0a, 0xe8, 0x33, 0x87, 0x49, 0x92, 0xba, 0x05, 0x53,
+	0x91, 0x66, 0x7a, 0xd4, 0xc6, 0x12, 0xa8, 0xe7, 0x86, 0x29, 0xdd, 0x39, 0x6a, 0x63, 0xbd, 0x74,
+	0xd8, 0xf3, 0xa5, 0xbe, 0x01, 0x73, 0x6d, 0x1f, 0x77, 0x6c, 0x2f, 0x20, 0x06, 0xa1, 0xc8, 0xa7,
+	0xd8, 0x32, 0x70, 0x07, 0xbb, 0x94, 0x9d, 0x0f, 0x43, 0x26, 0xab, 0xcf, 0x86, 0x0c, 0xf7, 0xc4,
+	0xfa, 0x4d, 0xb6, 0xbc, 0x65, 0xa9, 0x2b, 0x50, 0xe9, 0x93, 0xc8, 0x71, 0x89, 0x32, 0x89, 0x73,
+	0x56, 0x61, 0x12, 0x51, 0x66, 0x1b, 0xad, 0x4e, 0x2c, 0x2b, 0x2b, 0x39, 0x3d, 0xfc, 0x54, 0x35,
+	0x98, 0x72, 0xf1, 0x03, 0xda, 0x55, 0x30, 0xc9, 0x15, 0x14, 0x19, 0x31, 0x94, 0x7e, 0x09, 0xd4,
+	0x06, 0x32, 0xf7, 0x1d, 0xaf, 0x69, 0x98, 0x5e, 0xe0, 0x52, 0x63, 0xcf, 0x76, 0x69, 0x35, 0xcf,
+	0x19, 0x2b, 0x72, 0x65, 0x93, 0x2d, 0xdc, 0xb1, 0x5d, 0xaa, 0xbe, 0x0e, 0x55, 0x42, 0x6d, 0x73,
+	0xff, 0xa8, 0x8b, 0xb9, 0x81, 0x5d, 0xd4, 0x70, 0xb0, 0x55, 0x2d, 0x2c, 0x2b, 0x2b, 0x79, 0x7d,
+	0x56, 0xac, 0x47, 0x70, 0xde, 0x14, 0xab, 0xea, 0x9b, 0x90, 0xe3, 0xb5, 0xa3, 0x0a, 0x69, 0x68,
+	0xf2, 0xa5, 0x5e, 0x30, 0xdf, 0x67, 0x04, 0x5d, 0x88, 0xa8, 0x07, 0xf0, 0x2c, 0xf5, 0x91, 0x4b,
+	0x6c, 0xe6, 0x46, 0xf7, 0x6c, 0x10, 0xd9, 0xaf, 0x16, 0xb9, 0xb6, 0x37, 0xea, 0x69, 0x75, 0x5a,
+	0x96, 0x00, 0xa6, 0x76, 0x27, 0x14, 0xef, 0x8d, 0xb7, 0x2d, 0x77, 0xd7, 0xd3, 0x2f, 0xd2, 0xb4,
+	0x25, 0xb5, 0x09, 0x8b, 0xfd, 0xe1, 0x65, 0x74, 0xab, 0x68, 0xb5, 0x94, 0xe6, 0x46, 0x54, 0x16,
+	0xf8, 0x9e, 0x51, 0x48, 0xcf, 0xf7, 0x05, 0x59, 0xb4, 0xc6, 0xb2, 0xba, 0xe1, 0x23, 0xd7, 0xdc,
+	0x93, 0x81, 0x5e, 0xe6, 0x81, 0x5e, 0x14, 0x34, 0x11, 0xea, 0xb7, 0xa1, 0x4c, 0xcc, 0x3d, 0x6c,
+	0x05, 0x0e, 0xb6, 0x0c, 0xd6, 0x38, 0xaa, 0xd3, 0x7c, 0xf3, 0xf9, 0xba, 0xe8, 0x2a, 0xf5, 0xb0,
+	0xab, 0xd4, 0x77, 0xc2, 0xae, 0xb2, 0x31, 0xfe, 0xc9, 0x5f, 0x96, 0x14, 0x7d, 0x2a, 0x92, 0x63,
+	0x2b, 0xea, 0x26, 0x94, 0xc2, 0x98, 0xe2, 0x6a, 0x2a, 0x23, 0xaa, 0x29, 0x4a, 0x29, 0xae, 0xc4,
+	0x81, 0x49, 0x76, 0x2a, 0x36, 0x26, 0xd5, 0x99, 0xe5, 0xec, 0x4a, 0x71, 0x5d, 0xaf, 0x8f, 0xd6,
+	0x24, 0xeb, 0x27, 0xe6, 0x7b, 0xfd, 0x7d, 0xa1, 0xf4, 0xa6, 0x4b, 0xfd, 0x23, 0x3d, 0xdc, 0x42,
+	0x7d, 0x1b, 0xf2, 0xb2, 0xbc, 0x92, 0xaa, 0xca, 0xb7, 0xbb, 0x1c, 0x87, 0x3c, 0xec, 0x35, 0x6c,
+	0x83, 0xbb, 0x82, 0x53, 0x8f, 0x44, 0xe6, 0x3f, 0x86, 0x52, 0xaf, 0x5e, 0xb5, 0x02, 0xd9, 0x7d,
+	0x7c, 0x24, 0x4b, 0x27, 0xfb, 0x93, 0xc5, 0x65, 0x07, 0x39, 0x01, 0xae, 0x66, 0xd2, 0x0e, 0x74,
+	0x50, 0x5c, 0x72, 0x91, 0x37, 0x33, 0xaf, 0x2b, 0xef, 0x8c, 0xe7, 0xa7, 0x2a, 0xe5, 0xa8, 0x78,
+	0x5f, 0x37, 0xa9, 0xdd, 0xb1, 0xe9, 0xd1, 0x53, 0x55, 0xbc, 0x07, 0x19, 0x75, 0xf6, 0xe2, 0x9d,
+	0x87, 0xc5, 0x01, 0x8a, 0xbf, 0xea, 0xe2, 0xbd, 0x04, 0x45, 0x24, 0xad, 0x62, 0x30, 0x66, 0xb9,
+	0x03, 0x10, 0x92, 0xb6, 0x2c, 0x56, 0xdd, 0x23, 0x06, 0x5e, 0xdd, 0xc7, 0x4f, 0xae, 0xee, 0x91,
+	0x8f, 0xbc, 0xba, 0xa3, 0x9e, 0x2f, 0xf5, 0x35, 0xc8, 0xd9, 0x6e, 0x3b, 0xa0, 0xbc, 0x2e, 0x17,
+	0xd7, 0x97, 0x07, 0xa9, 0xd8, 0x46, 0x47, 0x8e, 0x87, 0x2c, 0xa2, 0x0b, 0xf6, 0x94, 0x7c, 0x9e,
+	0x38, 0x5b, 0x3e, 0xdf, 0x87, 0xb9, 0x90, 0x60, 0x50, 0xcf, 0x30, 0x1d, 0x8f, 0x60, 0xae, 0xd0,
+	0x0b, 0x28, 0xaf, 0xf5, 0xc5, 0xf5, 0xb9, 0x3e, 0x9d, 0x37, 0xe4, 0x64, 0xba, 0x31, 0xfe, 0x53,
+	0xa6, 0x72, 0x36, 0xd4, 0xb0, 0xe3, 0x6d, 0x32, 0xf9, 0x1d, 0x21, 0xde, 0x57, 0x2b, 0xf2, 0x67,
+	0xa9, 0x15, 0x3b, 0x30, 0xcb, 0x3f, 0xfb, 0xad, 0x2b, 0x8c, 0x66, 0xdd, 0x05, 0x2e, 0x9e, 0x30,
+	0xed, 0x3d, 0x98, 0xd9, 0xc3, 0xc8, 0xa7, 0x0d, 0x8c, 0x68, 0xa4, 0x10, 0x46, 0x53, 0x58, 0x89,
+	0x24, 0x43, 0x6d, 0x3d, 0xed, 0xb3, 0x18, 0x6f, 0x9f, 0x18, 0x6a, 0x66, 0xe0, 0xfb, 0xac, 0xe9,
+	0x48, 0x92, 0x91, 0x38, 0xb7, 0xd2, 0x88, 0xa0, 0x2c, 0x48, 0x3d, 0xd7, 0x85, 0x9a, 0x7b, 0xb1,
+	0x53, 0xbc, 0xdb, 0xeb, 0x8e, 0x85, 0x29, 0xb2, 0x1d, 0x52, 0x9d, 0x1a, 0x31, 0xa4, 0xba, 0xfe,
+	0xdc, 0x10, 0x92, 0xfd, 0xe3, 0x4b, 0xf9, 0xcc, 0xe3, 0xcb, 0xff, 0xf5, 0xa4, 0x69, 0x54, 0xa9,
+	0x78, 0xf3, 0x29, 0x74, 0x73, 0xef, 0x9b, 0xe1, 0x82, 0xfa, 0x1a, 0x4c, 0xec, 0x61, 0x64, 0x61,
+	0x5f, 0x36, 0x96, 0xda, 0xa0, 0x2d, 0xef, 0x70, 0x2e, 0x5d, 0x72, 0x6b, 0xbf, 0x9b, 0x80, 0xd9,
+	0xeb, 0x96, 0xd5, 0xdb, 0x1a, 0x4e, 0x51, 0x36, 0x6f, 0x43, 0xe1, 0x09, 0x4a, 0x48, 0x57, 0x56,
+	0xdd, 0x94, 0x35, 0x4b, 0xf4, 0xf7, 0xec, 0x29, 0xfa, 0x7b, 0x81, 0x86, 0x7f, 0xb2, 0x71, 0xaa,
+	0x1b, 0x23, 0x89, 0x51, 0xaf, 0x12, 0xad, 0x84, 0xc3, 0x57, 0x22, 0x81, 0x65, 0xae, 0xc8, 0x88,
+	0xce, 0x9d, 0x3a, 0x81, 0xf9, 0x08, 0x19, 0xc6, 0x75, 0x5a, 0x3d, 0x9f, 0x48, 0xad, 0xe7, 0xea,
+	0x37, 0x60, 0x42, 0x32, 0xb0, 0xa2, 0x51, 0x5e, 0x5f, 0x49, 0xed, 0xe8, 0xfc, 0xea, 0x15, 0x3a,
+	0x2e, 0x24, 0x75, 0x29, 0xa7, 0x5e, 0x83, 0x1c, 0xbf, 0xc5, 0x55, 0x0b, 0xc9, 0x03, 0xe8, 0x51,
+	0xc0, 0x39, 0x98, 0x82, 0x0f, 0xb0, 0x49, 0x3d, 0x7f, 0x93, 0x7d, 0xea, 0x42, 0x4e, 0x9d, 0x87,
+	0x7c, 0xdb, 0xb7, 0x3d, 0xdf, 0xa6, 0x62, 0x42, 0xcc, 0xe9, 0xd1, 0x37, 0x0b, 0x82, 0x5d, 0x64,
+	0xfb, 0x2e, 0x26, 0xc4, 0x60, 0xdd, 0xbb, 0x28, 0x82, 0x20, 0xa4, 0xbd, 0x8b, 0x8f, 0x18, 0xec,
+	0xb1, 0xa0, 0xe7, 0xe1, 0xca, 0xd3, 0xb3, 0xa0, 0x57, 0x7a, 0x63, 0x9a, 0x45, 0xab, 0x7a, 0x0d,
+	0xc0, 0xf5, 0xa8, 0xd1, 0xc0, 0xbb, 0x9e, 0x8f, 0xab, 0x53, 0x23, 0x26, 0x71, 0xc1, 0xf5, 0xe8,
+	0x06, 0x17, 0x51, 0x57, 0x61, 0xc6, 0xf5, 0xfc, 0x16, 0x72, 0x7a, 0x27, 0xc2, 0xb2, 0x00, 0x57,
+	0x2c, 0x74, 0x07, 0x3c, 0x0c, 0x17, 0x1a, 0x81, 0xed, 0x58, 0x86, 0x6d, 0x19, 0x88, 0x10, 0xbb,
+	0xe9, 0xb6, 0xb0, 0x4b, 0xe5, 0x08, 0xf7, 0x6a, 0x2a, 0x50, 0x3d, 0xb7, 0x51, 0x06, 0xd7, 0x06,
+	0x13, 0xdf, 0xb2, 0xae, 0x47, 0xc2, 0xfa, 0x4c, 0x23, 0x49, 0xd2, 0xe6, 0xe0, 0xd9, 0xbe, 0x1c,
+	0x12, 0xcd, 0x58, 0xfb, 0x6d, 0x8e, 0xe7, 0x57, 0x6f, 0xb7, 0xfe, 0xea, 0xf3, 0x6b, 0xfc, 0x3c,
+	0xf3, 0x2b, 0x77, 0x96, 0xfc, 0x9a, 0x38, 0xff, 0xfc, 0x9a, 0x1c, 0x96, 0x5f, 0xf9, 0xff, 0xe5,
+	0xd7, 0x08, 0xf9, 0xf5, 0xce, 0x78, 0x3e, 0x5b, 0x19, 0x97, 0x21, 0x1d, 0x0f, 0x5b, 0x19, 0xd2,
+	0xff, 0xc8, 0xc0, 0x33, 0x7c, 0x14, 0x0f, 0x23, 0xee, 0x14, 0x01, 0x1d, 0x8f, 0xc3, 0xcc, 0xd9,
+	0xe2, 0xf0, 0x3e, 0x4c, 0xf1, 0xbb, 0x41, 0x62, 0x20, 0x7f, 0x75, 0xe8, 0x40, 0x9e, 0x66, 0xb5,
+	0x5e, 0xe2, 0xba, 0x4e, 0x3f, 0x89, 0x0f, 0x2a, 0x2e, 0xb9, 0x73, 0x2e, 0x2e, 0xbf, 0x52, 0xe0,
+	0x62, 0xc2, 0x70, 0x39, 0xe8, 0x6f, 0x42, 0x29, 0xc4, 0x81, 0x04, 0x0e, 0xad, 0x2a, 0x23, 0xce,
+	0x2d, 0x45, 0xe9, 0x31, 0x13, 0x52, 0xdf, 0x85, 0x72, 0xa8, 0xe4, 0xfb, 0xd8, 0xa4, 0xd8, 0x1a,
+	0x72, 0x19, 0x13, 0x97, 0x30, 0xc9, 0xab, 0x4f, 0x1d, 0xf4, 0x7e, 0x6a, 0x3f, 0xc9, 0xc0, 0xb2,
+	0x30, 0xcf, 0xe2, 0x7c, 0xec, 0xf8, 0x36, 0xbd, 0x56, 0xdb, 0xc1, 0x8c, 0xf9, 0x3f, 0x1c, 0x26,
+	0xcf, 0xc2, 0x24, 0x57, 0x12, 0x5d, 0x45, 0x26, 0xd8, 0xe7, 0x96, 0xa5, 0xba, 0x30, 0x63, 0x86,
+	0x46, 0x45, 0x31, 0x24, 0x6a, 0xe2, 0xf5, 0xa1, 0x31, 0x34, 0xcc, 0x3d, 0xbd, 0x62, 0x26, 0x28,
+	0xda, 0x15, 0xb8, 0x7c, 0x82, 0x94, 0xcc, 0xaa, 0x7f, 0x2a, 0x70, 0x69, 0x13, 0xb9, 0x26, 0x76,
+	0xbe, 0x15, 0x50, 0x42, 0x91, 0x6b, 0xd9, 0x6e, 0x73, 0xbb, 0xe7, 0x8e, 0x38, 0x02, 0x6c, 0xef,
+	0xc1, 0x74, 0x17, 0x36, 0x31, 0x80, 0x66, 0x78, 0xd1, 0x4b, 0x60, 0x17, 0xab, 0x76, 0x1c, 0x2c,
+	0x3e, 0x80, 0x4e, 0xd1, 0xde, 0xcf, 0xf3, 0x99, 0xc9, 0x62, 0x17, 0xeb, 0xf1, 0xf8, 0xc5, 0x5a,
+	0x5b, 0x82, 0xc5, 0x01, 0x2e, 0x4b, 0x50, 0x7e, 0xaf, 0x40, 0xf5, 0x06, 0x26, 0xa6, 0x6f, 0x37,
+	0xf0, 0x59, 0xae, 0xf5, 0x1f, 0x41, 0xc9, 0xc2, 0xc4, 0x8c, 0x0e, 0x39, 0x93, 0x7c, 0xb1, 0x1a,
+	0x70, 0xc8, 0x83, 0xf6, 0xd4, 0x8b, 0x4c, 0x5d, 0x68, 0xc0, 0x55, 0x98, 0xb6, 0x5d, 0xd3, 0x09,
+	0x2c, 0xcc, 0x1f, 0xc6, 0xb0, 0x4f, 0x38, 0x4a, 0x79, 0xbd, 0x2c, 0xc9, 0x1f, 0x0a, 0xaa, 0xf6,
+	0x69, 0x16, 0xe6, 0x52, 0x54, 0xca, 0x34, 0xbe, 0x06, 0x93, 0x02, 0x11, 0x52, 0x55, 0xf8, 0x2b,
+	0xcb, 0xf3, 0x27, 0x80, 0xbc, 0x2d, 0xb0, 0x63, 0xaf, 0x67, 0xa1, 0x94, 0xfa, 0x01, 0xcc, 0xf4,
+	0x1c, 0x3b, 0xa1, 0x88, 0x06, 0x44, 0xba, 0xba, 0x3a, 0xca, 0x79, 0xdd, 0xe3, 0x12, 0xfa, 0x34,
+	0x8d, 0x13, 0x54, 0x17, 0x2e, 0xf6, 0xf6, 0x26, 0x43, 0xbe, 0x48, 0x32, 0x2f, 0x99, 0x99, 0x6f,
+	0x8e, 0xfa, 0xf6, 0x74, 0xab, 0xdb, 0xcc, 0x36, 0x84, 0x0a, 0xfd, 0xc2, 0x6e, 0x1f, 0x8d, 0xb0,
+	0x67, 0x57, 0x64, 0xb1, 0xba, 0xcb, 0xbd, 0xe1, 0x2f, 0xa2, 0x72, 0x7a, 0x2f, 0x73, 0xba, 0x48,
+	0x9c, 0xc0, 0xa5, 0xea, 0x2d, 0x98, 0x0c, 0x11, 0xcf, 0x71, 0x5b, 0x5e, 0x4a, 0xb5, 0x25, 0xe6,
+	0xae, 0x38, 0x0c, 0x81, 0x9c, 0x14, 0xd6, 0x3e, 0x02, 0xb5, 0xdf, 0xb8, 0xbe, 0x9e, 0xac, 0xf4,
+	0xf7, 0xe4, 0x2b, 0x30, 0x15, 0x7b, 0xb9, 0xe5, 0x70, 0x67, 0xf5, 0x52, 0xef, 0xa3, 0xad, 0xf6,
+	0x0b, 0x05, 0x6a, 0xef, 0xd9, 0x84, 0x46, 0x40, 0x6f, 0x23, 0x9f, 0xda, 0x6c, 0xbc, 0x21, 0x61,
+	0x08, 0x5d, 0x82, 0x42, 0xf7, 0x72, 0x27, 0xf6, 0xe9, 0x12, 0xfa, 0x22, 0x3c, 0xfb, 0xe5, 0x54,
+	0x4a, 0xed, 0x67, 0x19, 0x58, 0x1a, 0x68, 0xa8, 0x8c, 0xd2, 0x1f, 0x40, 0xad, 0xfb, 0x76, 0xd3,
+	0x8d, 0xb6, 0x76, 0xc4, 0x29, 0x83, 0xf7, 0xd5, 0x51, 0x36, 0x8f, 0xf4, 0xdf, 0xc5, 0x14, 0x59,
+	0x88, 0x22, 0x7d, 0x01, 0x25, 0xdf, 0xb3, 0xba, 0x36, 0xb0, 0xbd, 0x63, 0x2f, 0xcf, 0xfd, 0x7b,
+	0x67, 0x9e, 0x68, 0xef, 0xc3, 0xe4, 0xc3, 0x68, 0x77, 0x6f, 0xed, 0xd7, 0x0a, 0x5c, 0xfd, 0x76,
+	0xdb, 0x42, 0x54, 0x66, 0xb3, 0xec, 0xd9, 0xac, 0x80, 0x23, 0x6a, 0x37, 0x6c, 0xc7, 0xa6, 0x47,
+	0xa7, 0xa8, 0x48, 0x0d, 0x98, 0x8c, 0x17, 0xa3, 0x3b, 0x43, 0x8b, 0xd1, 0x88, 0xbb, 0xeb, 0xa1,
+	0x62, 0x6d, 0x15, 0x56, 0x86, 0xcb, 0xc8, 0x0a, 0xfb, 0x99, 0x02, 0xcf, 0xdd, 0xc6, 0xf4, 0x5c,
+	0x7c, 0x33, 0x92, 0xbe, 0xdd, 0x1c, 0xea, 0xdb, 0x28, 0x5b, 0x77, 0x1d, 0xfb, 0x91, 0x02, 0xcf,
+	0x0f, 0x91, 0x90, 0xd1, 0xda, 0x80, 0x7c, 0xf8, 0x13, 0x9f, 0x1c, 0x8b, 0x6e, 0x3d, 0xa9, 0x2d,
+	0x42, 0x9b, 0x1e, 0xe9, 0xd5, 0x7e, 0x9c, 0x01, 0x6d, 0xcb, 0xed, 0x20, 0xc7, 0x66, 0x58, 0x47,
+	0xb1, 0x13, 0x85, 0xd5, 0xe8, 0xc0, 0x2d, 0xf6, 0x25, 0x71, 0xa1, 0xb7, 0x87, 0xa6, 0xb4, 0xf5,
+	0xec, 0xd9, 0xdb, 0xfa, 0x77, 0x61, 0xba, 0x83, 0x7d, 0x62, 0x7b, 0xae, 0xed, 0x36, 0x0d, 0x66,
+	0xa9, 0x9c, 0x7d, 0xd6, 0x47, 0x19, 0x59, 0x3f, 0x88, 0x44, 0x6f, 0x30, 0x1f, 0xcb, 0x9d, 0xd8,
+	0xb7, 0xf6, 0x3c, 0x5c, 0x39, 0x11, 0x12, 0x09, 0xdd, 0x9f, 0x15, 0x58, 0xb8, 0x8d, 0xe9, 0x97,
+	0x88, 0xd9, 0x35, 0xb8, 0x74, 0x88, 0x5c, 0x6a, 0x24, 0x5c, 0x35, 0xcc, 0xc0, 0xdf, 0x43, 0x64,
+	0x8f, 0x03, 0x58, 0xd2, 0xe7, 0x18, 0x4f, 0xdc, 0xa5, 0x4d, 0xc1, 0xa0, 0xae, 0xc3, 0x45, 0xae,
+	0x20, 0x2a, 0x32, 0x86, 0xe9, 0xb9, 0xbb, 0x76, 0x93, 0x83, 0x95, 0xd7, 0x2f, 0xb0, 0xc5, 0xa8,
+	0x4c, 0x6c, 0xf2, 0x25, 0xed, 0xb3, 0x0c, 0x5c, 0x4a, 0x77, 0x4b, 0x86, 0xe5, 0xf7, 0xfa, 0xb1,
+	0x57, 0xce, 0x8a, 0xfd, 0x9d, 0xb1, 0x24, 0xfa, 0xea, 0x2a, 0x54, 0x78, 0xfb, 0x15, 0x63, 0xad,
+	0xc1, 0x1d, 0x65, 0xc8, 0xe4, 0x19, 0xaf, 0x5c, 0xd1, 0xf1, 0xc1, 0x1d, 0xe6, 0x5f, 0x13, 0x2a,
+	0x7d, 0xae, 0x89, 0x19, 0xef, 0xad, 0x51, 0x6c, 0xe9, 0x2f, 0x95, 0x02, 0x03, 0x7d, 0xba, 0x1d,
+	0x27, 0x6c, 0xcc, 0xc2, 0x33, 0xc9, 0x43, 0x60, 0x29, 0xa4, 0xfd, 0x46, 0x81, 0xa5, 0xbe, 0xa1,
+	0x28, 0x9c, 0x0f, 0x9e, 0xce, 0xdc, 0xd1, 0x5c, 0x58, 0x1e, 0x6c, 0xb2, 0x3c, 0xe3, 0x77, 0x60,
+	0x92, 0x04, 0xad, 0x16, 0xf2, 0x8f, 0xe4, 0xd9, 0xfe, 0xff, 0xf0, 0xd9, 0x44, 0xea, 0xb8, 0x27,
+	0xe4, 0xf4, 0x50, 0x81, 0xf6, 0xc7, 0x0c, 0xcc, 0xdd, 0xf5, 0x3a, 0xdd, 0xcd, 0xd8, 0x1f, 0xe4,
+	0x69, 0xad, 0x2c, 0x5f, 0x83, 0x59, 0x0b, 0x13, 0x6a, 0xbb, 0x28, 0xf9, 0x83, 0xad, 0x18, 0xfc,
+	0x9f, 0xe9, 0x59, 0x8d, 0x14, 0xa9, 0xef, 0xc2, 0xc4, 0xae, 0xed, 0x50, 0xec, 0xcb, 0x9b, 0xf3,
+	0x2b, 0x23, 0xc3, 0xc5, 0x74, 0xdc, 0xe2, 0xa2, 0xba, 0x54, 0xc1, 0xae, 0x1b, 0x2d, 0xf4, 0x80,
+	0x6f, 0x4d, 0xe4, 0x2f, 0xf2, 0xf9, 0x16, 0x7a, 0xc0, 0x61, 0xd3, 0x6e, 0xc1, 0x7c, 0x1a, 0x98,
+	0xf2, 0xdc, 0x56, 0xa0, 0xd2, 0xf2, 0x3a, 0xf1, 0xe9, 0x53, 0x11, 0xd3, 0x27, 0xa7, 0x47, 0xd3,
+	0xa7, 0xf6, 0x69, 0x06, 0xe6, 0xb7, 0x03, 0xbf, 0xf9, 0x5f, 0x72, 0x2c, 0x5d, 0x80, 0xc7, 0xcf,
+	0x19, 0xe0, 0x5c, 0x02, 0xe0, 0x2d, 0x58, 0x48, 0xc5, 0x45, 0x22, 0xbc, 0x0a, 0x33, 0x6d, 0xb6,
+	0x9c, 0x02, 0xf1, 0xb4, 0x58, 0xe8, 0x62, 0xfc, 0x2f, 0x05, 0x96, 0x74, 0x6c, 0x7a, 0x7e, 0xec,
+	0x0d, 0x8a, 0xff, 0x14, 0x65, 0x9d, 0x1f, 0xd0, 0xb1, 0xf7, 0xd5, 0xec, 0x13, 0xbc, 0xaf, 0x9e,
+	0xee, 0xa7, 0x87, 0x9e, 0x9f, 0xbd, 0x72, 0xb1, 0x9f, 0xbd, 0x34, 0x0d, 0x96, 0x07, 0x7b, 0x2d,
+	0x9b, 0xe7, 0xcf, 0x15, 0xa8, 0x8a, 0xf9, 0x4e, 0x0e, 0x2a, 0x3a, 0x6a, 0xb5, 0xcf, 0x0f, 0x93,
+	0x39, 0xc8, 0x87, 0xcf, 0x5a, 0xf2, 0xc2, 0x31, 0x29, 0x1f, 0xa5, 0xd8, 0x85, 0xd7, 0x47, 0xad,
+	0xb6, 0xd1, 0xc6, 0xbe, 0x89, 0x5d, 0x8a, 0x9a, 0x22, 0xb3, 0x33, 0x7a, 0x99, 0x91, 0xb7, 0x23,
+	0xaa, 0xb6, 0x00, 0x73, 0x29, 0x16, 0x0a, 0xfb, 0x37, 0xfc, 0x87, 0x8f, 0x6a, 0x63, 0x9f, 0x3f,
+	0xaa, 0x8d, 0x7d, 0xf1, 0xa8, 0xa6, 0xfc, 0xf0, 0xb8, 0xa6, 0xfc, 0xf2, 0xb8, 0xa6, 0xfc, 0xe1,
+	0xb8, 0xa6, 0x3c, 0x3c, 0xae, 0x29, 0x7f, 0x3d, 0xae, 0x29, 0x7f, 0x3b, 0xae, 0x8d, 0x7d, 0x71,
+	0x5c, 0x53, 0x3e, 0x79, 0x5c, 0x1b, 0x7b, 0xf8, 0xb8, 0x36, 0xf6, 0xf9, 0xe3, 0xda, 0xd8, 0xfd,
+	0xb7, 0x9a, 0x5e, 0xf7, 0x64, 0x6c, 0xef, 0xe4, 0x7f, 0x29, 0xfc, 0x7a, 0x82, 0xd4, 0x98, 0xe0,
+	0x2f, 0x9f, 0xaf, 0xfc, 0x7b, 0x00, 0x48, 0x07, 0x90, 0x2a, 0x93, 0x28, 0x00, 0x00,
+}
+
+func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
-		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+
+	that1, ok := that.(*PollWorkflowTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollWorkflowTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if this.HeartbeatTimeout != nil {
-		return false
-	} else if that1.HeartbeatTimeout != nil {
-		return false
-	}
-	if this.Attempt != that1.Attempt {
-		return false
 	}
-	if that1.CurrentAttemptScheduledTime == nil {
-		if this.CurrentAttemptScheduledTime != nil {
-			return false
-		}
-	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
+	if this.PollerId != that1.PollerId {
 		return false
 	}
-	if this.WorkflowNamespace != that1.WorkflowNamespace {
+	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
-	if !this.Header.Equal(that1.Header) {
+	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
 	return true
 }
-func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
+func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddWorkflowTaskRequest)
+	that1, ok := that.(*PollWorkflowTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(AddWorkflowTaskRequest)
+		that2, ok := that.(PollWorkflowTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2231,48 +2832,87 @@ func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
 		return false
 	}
-	if this.ScheduledEventId != that1.ScheduledEventId {
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
 		return false
 	}
-	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
-		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
-			return false
-		}
-	} else if this.ScheduleToStartTimeout != nil {
+	if this.StartedEventId != that1.StartedEventId {
 		return false
-	} else if that1.ScheduleToStartTimeout != nil {
+	}
+	if this.Attempt != that1.Attempt {
 		return false
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
+	if this.NextEventId != that1.NextEventId {
 		return false
 	}
-	if this.Source != that1.Source {
+	if this.BacklogCountHint != that1.BacklogCountHint {
 		return false
 	}
-	if !this.Clock.Equal(that1.Clock) {
+	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
 		return false
 	}
-	return true
-}
-func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.Query.Equal(that1.Query) {
+		return false
 	}
-
-	that1, ok := that.(*AddWorkflowTaskResponse)
-	if !ok {
-		that2, ok := that.(AddWorkflowTaskResponse)
-		if ok {
-			that1 = &that2
+	if !this.TransientWorkflowTask.Equal(that1.TransientWorkflowTask) {
+		return false
+	}
+	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if len(this.Queries) != len(that1.Queries) {
+		return false
+	}
+	for i := range this.Queries {
+		if !this.Queries[i].Equal(that1.Queries[i]) {
+			return false
+		}
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollActivityTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollActivityTaskQueueRequest)
+		if ok {
+			that1 = &that2
 		} else {
 			return false
 		}
@@ -2282,16 +2922,28 @@ func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.PollerId != that1.PollerId {
+		return false
+	}
+	if !this.PollRequest.Equal(that1.PollRequest) {
+		return false
+	}
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
 	return true
 }
-func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
+func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AddActivityTaskRequest)
+	that1, ok := that.(*PollActivityTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(AddActivityTaskRequest)
+		that2, ok := that.(PollActivityTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2303,67 +2955,94 @@ func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if this.ActivityId != that1.ActivityId {
 		return false
 	}
-	if this.ScheduledEventId != that1.ScheduledEventId {
+	if !this.ActivityType.Equal(that1.ActivityType) {
 		return false
 	}
-	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
-		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+	if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
 			return false
 		}
-	} else if this.ScheduleToStartTimeout != nil {
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
 		return false
-	} else if that1.ScheduleToStartTimeout != nil {
+	}
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
 		return false
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
 		return false
 	}
-	if this.Source != that1.Source {
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
+			return false
+		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
 		return false
 	}
-	if !this.Clock.Equal(that1.Clock) {
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
 		return false
 	}
-	return true
-}
-func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.Attempt != that1.Attempt {
+		return false
 	}
-
-	that1, ok := that.(*AddActivityTaskResponse)
-	if !ok {
-		that2, ok := that.(AddActivityTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	if that1.CurrentAttemptScheduledTime == nil {
+		if this.CurrentAttemptScheduledTime != nil {
 			return false
 		}
+	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.WorkflowNamespace != that1.WorkflowNamespace {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
 		return false
 	}
 	return true
 }
-func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
+func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryWorkflowRequest)
+	that1, ok := that.(*AddWorkflowTaskRequest)
 	if !ok {
-		that2, ok := that.(QueryWorkflowRequest)
+		that2, ok := that.(AddWorkflowTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2378,25 +3057,65 @@ func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
 	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if !this.QueryRequest.Equal(that1.QueryRequest) {
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
 		return false
 	}
 	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !this.Clock.Equal(that1.Clock) {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if that1.NotBefore == nil {
+		if this.NotBefore != nil {
+			return false
+		}
+	} else if !this.NotBefore.Equal(*that1.NotBefore) {
+		return false
+	}
+	if this.NormalTaskQueue != that1.NormalTaskQueue {
+		return false
+	}
+	if !this.BuildIdAssignment.Equal(that1.BuildIdAssignment) {
+		return false
+	}
 	return true
 }
-func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
+func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryWorkflowResponse)
+	that1, ok := that.(*AddWorkflowTaskResponse)
 	if !ok {
-		that2, ok := that.(QueryWorkflowResponse)
+		that2, ok := that.(AddWorkflowTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2408,22 +3127,16 @@ func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.QueryResult.Equal(that1.QueryResult) {
-		return false
-	}
-	if !this.QueryRejected.Equal(that1.QueryRejected) {
-		return false
-	}
 	return true
 }
-func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
+func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondQueryTaskCompletedRequest)
+	that1, ok := that.(*AddActivityTaskRequest)
 	if !ok {
-		that2, ok := that.(RespondQueryTaskCompletedRequest)
+		that2, ok := that.(AddActivityTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2438,25 +3151,59 @@ func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
 	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if this.TaskId != that1.TaskId {
+	if this.ScheduledEventId != that1.ScheduledEventId {
 		return false
 	}
-	if !this.CompletedRequest.Equal(that1.CompletedRequest) {
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !this.Clock.Equal(that1.Clock) {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if that1.NotBefore == nil {
+		if this.NotBefore != nil {
+			return false
+		}
+	} else if !this.NotBefore.Equal(*that1.NotBefore) {
 		return false
 	}
 	return true
 }
-func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
+func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondQueryTaskCompletedResponse)
+	that1, ok := that.(*AddActivityTaskResponse)
 	if !ok {
-		that2, ok := that.(RespondQueryTaskCompletedResponse)
+		that2, ok := that.(AddActivityTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2470,14 +3217,14 @@ func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
+func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CancelOutstandingPollRequest)
+	that1, ok := that.(*QueryWorkflowRequest)
 	if !ok {
-		that2, ok := that.(CancelOutstandingPollRequest)
+		that2, ok := that.(QueryWorkflowRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2492,13 +3239,130 @@ func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueueType != that1.TaskQueueType {
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if !this.QueryRequest.Equal(that1.QueryRequest) {
 		return false
 	}
-	if this.PollerId != that1.PollerId {
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
+	if !this.BuildIdAssignment.Equal(that1.BuildIdAssignment) {
+		return false
+	}
+	return true
+}
+func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryWorkflowResponse)
+	if !ok {
+		that2, ok := that.(QueryWorkflowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.QueryResult.Equal(that1.QueryResult) {
+		return false
+	}
+	if !this.QueryRejected.Equal(that1.QueryRejected) {
+		return false
+	}
+	return true
+}
+func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondQueryTaskCompletedRequest)
+	if !ok {
+		that2, ok := that.(RespondQueryTaskCompletedRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if !this.CompletedRequest.Equal(that1.CompletedRequest) {
+		return false
+	}
+	return true
+}
+func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		that2, ok := that.(RespondQueryTaskCompletedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CancelOutstandingPollRequest)
+	if !ok {
+		that2, ok := that.(CancelOutstandingPollRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if this.PollerId != that1.PollerId {
 		return false
 	}
 	return true
@@ -2549,6 +3413,9 @@ func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
 	if !this.DescRequest.Equal(that1.DescRequest) {
 		return false
 	}
+	if this.IncludeWorkers != that1.IncludeWorkers {
+		return false
+	}
 	return true
 }
 func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
@@ -2581,6 +3448,52 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if len(this.FairnessKeyBacklogs) != len(that1.FairnessKeyBacklogs) {
+		return false
+	}
+	for i := range this.FairnessKeyBacklogs {
+		if !this.FairnessKeyBacklogs[i].Equal(that1.FairnessKeyBacklogs[i]) {
+			return false
+		}
+	}
+	if this.AddedTaskCount != that1.AddedTaskCount {
+		return false
+	}
+	if len(this.Workers) != len(that1.Workers) {
+		return false
+	}
+	for i := range this.Workers {
+		if !this.Workers[i].Equal(that1.Workers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FairnessKeyBacklog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FairnessKeyBacklog)
+	if !ok {
+		that2, ok := that.(FairnessKeyBacklog)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.BacklogCount != that1.BacklogCount {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2831,6 +3744,9 @@ func (this *GetTaskQueueMetadataRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.WantVersioningDataCurhash, that1.WantVersioningDataCurhash) {
 		return false
 	}
+	if this.WantPartitionConfig != that1.WantPartitionConfig {
+		return false
+	}
 	return true
 }
 func (this *GetTaskQueueMetadataResponse) Equal(that interface{}) bool {
@@ -2861,6 +3777,9 @@ func (this *GetTaskQueueMetadataResponse) Equal(that interface{}) bool {
 	} else if !this.VersioningDataResp.Equal(that1.VersioningDataResp) {
 		return false
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
 	return true
 }
 func (this *GetTaskQueueMetadataResponse_VersioningData) Equal(that interface{}) bool {
@@ -2911,778 +3830,925 @@ func (this *GetTaskQueueMetadataResponse_MatchedReqHash) Equal(that interface{})
 	}
 	return true
 }
-func (this *PollWorkflowTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
-	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollWorkflowTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 21)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*DescribeTaskQueueBacklogRequest)
+	if !ok {
+		that2, ok := that.(DescribeTaskQueueBacklogRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
-	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
-	if this.Query != nil {
-		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if this.TransientWorkflowTask != nil {
-		s = append(s, "TransientWorkflowTask: "+fmt.Sprintf("%#v", this.TransientWorkflowTask)+",\n")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if this.WorkflowExecutionTaskQueue != nil {
-		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	return true
+}
+func (this *DescribeTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+
+	that1, ok := that.(*DescribeTaskQueueBacklogResponse)
+	if !ok {
+		that2, ok := that.(DescribeTaskQueueBacklogResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	mapStringForQueries += "}"
-	if this.Queries != nil {
-		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Messages != nil {
-		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	if !this.Summary.Equal(that1.Summary) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PollActivityTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MoveTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*MoveTaskQueueTasksRequest)
+	if !ok {
+		that2, ok := that.(MoveTaskQueueTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollActivityTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 20)
-	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
-	if this.ActivityType != nil {
-		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
-	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
-	if this.HeartbeatDetails != nil {
-		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	if this.DestinationTaskQueue != that1.DestinationTaskQueue {
+		return false
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if !this.Filter.Equal(that1.Filter) {
+		return false
 	}
-	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
-	if this.Header != nil {
-		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	if this.MaxTasks != that1.MaxTasks {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AddWorkflowTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 12)
-	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+func (this *MoveTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*MoveTaskQueueTasksResponse)
+	if !ok {
+		that2, ok := that.(MoveTaskQueueTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	if this.Clock != nil {
-		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddWorkflowTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.MovedTaskCount != that1.MovedTaskCount {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AddActivityTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&matchingservice.AddActivityTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*PurgeTaskQueueTasksRequest)
+	if !ok {
+		that2, ok := that.(PurgeTaskQueueTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	if this.Clock != nil {
-		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddActivityTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddActivityTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *QueryWorkflowRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.QueryWorkflowRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if !this.Filter.Equal(that1.Filter) {
+		return false
 	}
-	if this.QueryRequest != nil {
-		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
+	if this.MaxTasks != that1.MaxTasks {
+		return false
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *QueryWorkflowResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.QueryWorkflowResponse{")
-	if this.QueryResult != nil {
-		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
+
+	that1, ok := that.(*PurgeTaskQueueTasksResponse)
+	if !ok {
+		that2, ok := that.(PurgeTaskQueueTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.QueryRejected != nil {
-		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.PurgedTaskCount != that1.PurgedTaskCount {
+		return false
+	}
+	return true
 }
-func (this *RespondQueryTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RecordActivityTaskClosedRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*RecordActivityTaskClosedRequest)
+	if !ok {
+		that2, ok := that.(RecordActivityTaskClosedRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	if this.CompletedRequest != nil {
-		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondQueryTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CancelOutstandingPollRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CancelOutstandingPollResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	return true
 }
-func (this *DescribeTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RecordActivityTaskClosedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.DescRequest != nil {
-		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
+
+	that1, ok := that.(*RecordActivityTaskClosedResponse)
+	if !ok {
+		that2, ok := that.(RecordActivityTaskClosedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *DescribeTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateBuildIdRampRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
-	if this.Pollers != nil {
-		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
+
+	that1, ok := that.(*UpdateBuildIdRampRequest)
+	if !ok {
+		that2, ok := that.(UpdateBuildIdRampRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TaskQueueStatus != nil {
-		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.RampPercentage != that1.RampPercentage {
+		return false
+	}
+	return true
 }
-func (this *ListTaskQueuePartitionsRequest) GoString() string {
+func (this *UpdateBuildIdRampResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateBuildIdRampResponse)
+	if !ok {
+		that2, ok := that.(UpdateBuildIdRampResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PollWorkflowTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ListTaskQueuePartitionsResponse) GoString() string {
+func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
-	if this.ActivityTaskQueuePartitions != nil {
-		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
+	s := make([]string, 0, 21)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if this.WorkflowTaskQueuePartitions != nil {
-		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
+	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
+	if this.Query != nil {
+		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	}
+	if this.TransientWorkflowTask != nil {
+		s = append(s, "TransientWorkflowTask: "+fmt.Sprintf("%#v", this.TransientWorkflowTask)+",\n")
+	}
+	if this.WorkflowExecutionTaskQueue != nil {
+		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	}
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	if this.Queries != nil {
+		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	}
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *UpdateWorkerBuildIdCompatibilityRequest) GoString() string {
+func (this *PollActivityTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *UpdateWorkerBuildIdCompatibilityResponse) GoString() string {
+func (this *PollActivityTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{")
+	s := make([]string, 0, 20)
+	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	if this.ActivityType != nil {
+		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	}
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetWorkerBuildIdCompatibilityRequest) GoString() string {
+func (this *AddWorkflowTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityRequest{")
+	s := make([]string, 0, 18)
+	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.Clock != nil {
+		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "NotBefore: "+fmt.Sprintf("%#v", this.NotBefore)+",\n")
+	s = append(s, "NormalTaskQueue: "+fmt.Sprintf("%#v", this.NormalTaskQueue)+",\n")
+	if this.BuildIdAssignment != nil {
+		s = append(s, "BuildIdAssignment: "+fmt.Sprintf("%#v", this.BuildIdAssignment)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetWorkerBuildIdCompatibilityResponse) GoString() string {
+func (this *AddWorkflowTaskResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse{")
-	if this.Response != nil {
-		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
-	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *InvalidateTaskQueueMetadataRequest) GoString() string {
+func (this *AddActivityTaskRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.InvalidateTaskQueueMetadataRequest{")
+	s := make([]string, 0, 16)
+	s = append(s, "&matchingservice.AddActivityTaskRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	if this.VersioningData != nil {
-		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.Clock != nil {
+		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "NotBefore: "+fmt.Sprintf("%#v", this.NotBefore)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *InvalidateTaskQueueMetadataResponse) GoString() string {
+func (this *AddActivityTaskResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.InvalidateTaskQueueMetadataResponse{")
+	s = append(s, "&matchingservice.AddActivityTaskResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetTaskQueueMetadataRequest) GoString() string {
+func (this *QueryWorkflowRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.GetTaskQueueMetadataRequest{")
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.QueryWorkflowRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	s = append(s, "WantVersioningDataCurhash: "+fmt.Sprintf("%#v", this.WantVersioningDataCurhash)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	if this.QueryRequest != nil {
+		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
+	}
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	if this.BuildIdAssignment != nil {
+		s = append(s, "BuildIdAssignment: "+fmt.Sprintf("%#v", this.BuildIdAssignment)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetTaskQueueMetadataResponse) GoString() string {
+func (this *QueryWorkflowResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.GetTaskQueueMetadataResponse{")
-	if this.VersioningDataResp != nil {
-		s = append(s, "VersioningDataResp: "+fmt.Sprintf("%#v", this.VersioningDataResp)+",\n")
-	}
+	s = append(s, "&matchingservice.QueryWorkflowResponse{")
+	if this.QueryResult != nil {
+		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
+	}
+	if this.QueryRejected != nil {
+		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetTaskQueueMetadataResponse_VersioningData) GoString() string {
+func (this *RespondQueryTaskCompletedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&matchingservice.GetTaskQueueMetadataResponse_VersioningData{` +
-		`VersioningData:` + fmt.Sprintf("%#v", this.VersioningData) + `}`}, ", ")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	if this.CompletedRequest != nil {
+		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *GetTaskQueueMetadataResponse_MatchedReqHash) GoString() string {
+func (this *RespondQueryTaskCompletedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&matchingservice.GetTaskQueueMetadataResponse_MatchedReqHash{` +
-		`MatchedReqHash:` + fmt.Sprintf("%#v", this.MatchedReqHash) + `}`}, ", ")
-	return s
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *CancelOutstandingPollRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CancelOutstandingPollResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *DescribeTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.DescRequest != nil {
+		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
+	}
+	s = append(s, "IncludeWorkers: "+fmt.Sprintf("%#v", this.IncludeWorkers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *DescribeTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
+	if this.Pollers != nil {
+		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueueStatus != nil {
+		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.FairnessKeyBacklogs != nil {
+		s = append(s, "FairnessKeyBacklogs: "+fmt.Sprintf("%#v", this.FairnessKeyBacklogs)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "AddedTaskCount: "+fmt.Sprintf("%#v", this.AddedTaskCount)+",\n")
+	if this.Workers != nil {
+		s = append(s, "Workers: "+fmt.Sprintf("%#v", this.Workers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FairnessKeyBacklog) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.FairnessKeyBacklog{")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "BacklogCount: "+fmt.Sprintf("%#v", this.BacklogCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ListTaskQueuePartitionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+func (this *ListTaskQueuePartitionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
+	if this.ActivityTaskQueuePartitions != nil {
+		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
 	}
-	if m.StartedTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if this.WorkflowTaskQueuePartitions != nil {
+		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
 	}
-	if m.ScheduledTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x7a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x72
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		{
-			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TransientWorkflowTask != nil {
-		{
-			size, err := m.TransientWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.BacklogCountHint != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
-		i--
-		dAtA[i] = 0x40
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x38
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InvalidateTaskQueueMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.InvalidateTaskQueueMetadataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.VersioningData != nil {
+		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
 	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
-		i--
-		dAtA[i] = 0x28
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InvalidateTaskQueueMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x20
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.InvalidateTaskQueueMetadataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueMetadataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.GetTaskQueueMetadataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "WantVersioningDataCurhash: "+fmt.Sprintf("%#v", this.WantVersioningDataCurhash)+",\n")
+	s = append(s, "WantPartitionConfig: "+fmt.Sprintf("%#v", this.WantPartitionConfig)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueMetadataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetTaskQueueMetadataResponse{")
+	if this.VersioningDataResp != nil {
+		s = append(s, "VersioningDataResp: "+fmt.Sprintf("%#v", this.VersioningDataResp)+",\n")
 	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetTaskQueueMetadataResponse_VersioningData) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := strings.Join([]string{`&matchingservice.GetTaskQueueMetadataResponse_VersioningData{` +
+		`VersioningData:` + fmt.Sprintf("%#v", this.VersioningData) + `}`}, ", ")
+	return s
 }
-
-func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetTaskQueueMetadataResponse_MatchedReqHash) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&matchingservice.GetTaskQueueMetadataResponse_MatchedReqHash{` +
+		`MatchedReqHash:` + fmt.Sprintf("%#v", this.MatchedReqHash) + `}`}, ", ")
+	return s
 }
-
-func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *DescribeTaskQueueBacklogRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.DescribeTaskQueueBacklogRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeTaskQueueBacklogResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.DescribeTaskQueueBacklogResponse{")
+	if this.Summary != nil {
+		s = append(s, "Summary: "+fmt.Sprintf("%#v", this.Summary)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MoveTaskQueueTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 10)
+	s = append(s, "&matchingservice.MoveTaskQueueTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "DestinationTaskQueue: "+fmt.Sprintf("%#v", this.DestinationTaskQueue)+",\n")
+	if this.Filter != nil {
+		s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
+	}
+	s = append(s, "MaxTasks: "+fmt.Sprintf("%#v", this.MaxTasks)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MoveTaskQueueTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.MoveTaskQueueTasksResponse{")
+	s = append(s, "MovedTaskCount: "+fmt.Sprintf("%#v", this.MovedTaskCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeTaskQueueTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.PurgeTaskQueueTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.Filter != nil {
+		s = append(s, "Filter: "+fmt.Sprintf("%#v", this.Filter)+",\n")
+	}
+	s = append(s, "MaxTasks: "+fmt.Sprintf("%#v", this.MaxTasks)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeTaskQueueTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.PurgeTaskQueueTasksResponse{")
+	s = append(s, "PurgedTaskCount: "+fmt.Sprintf("%#v", this.PurgedTaskCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordActivityTaskClosedRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.RecordActivityTaskClosedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordActivityTaskClosedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RecordActivityTaskClosedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateBuildIdRampRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.UpdateBuildIdRampRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "RampPercentage: "+fmt.Sprintf("%#v", this.RampPercentage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateBuildIdRampResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.UpdateBuildIdRampResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PollRequest != nil {
 		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3690,20 +4756,121 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.StartedTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
+		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
-	if len(m.WorkflowNamespace) > 0 {
-		i -= len(m.WorkflowNamespace)
-		copy(dAtA[i:], m.WorkflowNamespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+	if m.ScheduledTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x7a
 	}
-	if m.WorkflowType != nil {
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.WorkflowExecutionTaskQueue != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3711,11 +4878,11 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x62
 	}
-	if m.HeartbeatDetails != nil {
+	if m.TransientWorkflowTask != nil {
 		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TransientWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3723,49 +4890,251 @@ func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x5a
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err14 != nil {
-			return 0, err14
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= n14
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n14))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x52
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+	if m.StickyExecutionEnabled {
 		i--
-		dAtA[i] = 0x58
-	}
-	if m.HeartbeatTimeout != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err15 != nil {
-			return 0, err15
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= n15
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n15))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x48
 	}
-	if m.StartToCloseTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x40
 	}
-	if m.StartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err17 != nil {
-			return 0, err17
-		}
-		i -= n17
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.WorkflowNamespace) > 0 {
+		i -= len(m.WorkflowNamespace)
+		copy(dAtA[i:], m.WorkflowNamespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CurrentAttemptScheduledTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HeartbeatTimeout != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartToCloseTimeout != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartedTime != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
 		i = encodeVarintRequestResponse(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x42
@@ -3863,6 +5232,54 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.BuildIdAssignment != nil {
+		{
+			size, err := m.BuildIdAssignment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.NormalTaskQueue) > 0 {
+		i -= len(m.NormalTaskQueue)
+		copy(dAtA[i:], m.NormalTaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NormalTaskQueue)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.NotBefore != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -3888,12 +5305,12 @@ func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x32
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err24 != nil {
-			return 0, err24
+		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n24
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n24))
+		i -= n26
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -3979,13 +5396,42 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if m.Clock != nil {
-		{
-			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	if m.NotBefore != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Clock != nil {
+		{
+			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
@@ -4004,12 +5450,12 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x3a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err28 != nil {
-			return 0, err28
+		n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n28
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n28))
+		i -= n31
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -4095,6 +5541,18 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BuildIdAssignment != nil {
+		{
+			size, err := m.BuildIdAssignment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ForwardedSource) > 0 {
 		i -= len(m.ForwardedSource)
 		copy(dAtA[i:], m.ForwardedSource)
@@ -4364,6 +5822,16 @@ func (m *DescribeTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeWorkers {
+		i--
+		if m.IncludeWorkers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DescRequest != nil {
 		{
 			size, err := m.DescRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -4406,6 +5874,39 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AddedTaskCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.AddedTaskCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FairnessKeyBacklogs) > 0 {
+		for iNdEx := len(m.FairnessKeyBacklogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FairnessKeyBacklogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -4435,6 +5936,41 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *FairnessKeyBacklog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FairnessKeyBacklog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FairnessKeyBacklog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BacklogCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListTaskQueuePartitionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4774,6 +6310,16 @@ func (m *GetTaskQueueMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.WantPartitionConfig {
+		i--
+		if m.WantPartitionConfig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.WantVersioningDataCurhash) > 0 {
 		i -= len(m.WantVersioningDataCurhash)
 		copy(dAtA[i:], m.WantVersioningDataCurhash)
@@ -4818,6 +6364,18 @@ func (m *GetTaskQueueMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.VersioningDataResp != nil {
 		{
 			size := m.VersioningDataResp.Size()
@@ -4868,318 +6426,431 @@ func (m *GetTaskQueueMetadataResponse_MatchedReqHash) MarshalToSizedBuffer(dAtA
 	dAtA[i] = 0x10
 	return len(dAtA) - i, nil
 }
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DescribeTaskQueueBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DescribeTaskQueueBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskQueueBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeTaskQueueBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskQueueBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskQueueBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveTaskQueueTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskQueueTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskQueueTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTasks != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxTasks))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	if len(m.DestinationTaskQueue) > 0 {
+		i -= len(m.DestinationTaskQueue)
+		copy(dAtA[i:], m.DestinationTaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.DestinationTaskQueue)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StickyExecutionEnabled {
-		n += 2
-	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TransientWorkflowTask != nil {
-		l = m.TransientWorkflowTask.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		l = m.WorkflowExecutionTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 2 + l + sovRequestResponse(uint64(l))
-	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 2 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollActivityTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MoveTaskQueueTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTaskQueueTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTaskQueueTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MovedTaskCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MovedTaskCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollActivityTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeTaskQueueTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeTaskQueueTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeTaskQueueTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ActivityId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ActivityType != nil {
-		l = m.ActivityType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.HeartbeatTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.CurrentAttemptScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MaxTasks != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxTasks))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.WorkflowNamespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 2 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddWorkflowTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeTaskQueueTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeTaskQueueTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeTaskQueueTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
-	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
-	}
-	if m.Clock != nil {
-		l = m.Clock.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.PurgedTaskCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PurgedTaskCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddWorkflowTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RecordActivityTaskClosedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RecordActivityTaskClosedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordActivityTaskClosedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordActivityTaskClosedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RecordActivityTaskClosedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordActivityTaskClosedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateBuildIdRampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
+	return dAtA[:n], nil
+}
+
+func (m *UpdateBuildIdRampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateBuildIdRampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RampPercentage != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RampPercentage))))
+		i--
+		dAtA[i] = 0x25
 	}
-	if m.Clock != nil {
-		l = m.Clock.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateBuildIdRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateBuildIdRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateBuildIdRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5189,12 +6860,12 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
+	l = len(m.PollerId)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.QueryRequest != nil {
-		l = m.QueryRequest.Size()
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	l = len(m.ForwardedSource)
@@ -5204,58 +6875,89 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
+func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
+	l = len(m.TaskToken)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
+	if m.TransientWorkflowTask != nil {
+		l = m.TransientWorkflowTask.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.TaskId)
+	if m.WorkflowExecutionTaskQueue != nil {
+		l = m.WorkflowExecutionTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BranchToken)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.CompletedRequest != nil {
-		l = m.CompletedRequest.Size()
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 2 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	var l int
-	_ = l
 	return n
 }
 
-func (m *CancelOutstandingPollRequest) Size() (n int) {
+func (m *PollActivityTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5265,108 +6967,165 @@ func (m *CancelOutstandingPollRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.PollerId)
+	l = len(m.ForwardedSource)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *CancelOutstandingPollResponse) Size() (n int) {
+func (m *PollActivityTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *DescribeTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.DescRequest != nil {
-		l = m.DescRequest.Size()
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *DescribeTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.Pollers) > 0 {
-		for _, e := range m.Pollers {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueueStatus != nil {
-		l = m.TaskQueueStatus.Size()
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.CurrentAttemptScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowNamespace)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
+func (m *AddWorkflowTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Namespace)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	if m.TaskQueue != nil {
 		l = m.TaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.NamespaceId)
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	if m.Clock != nil {
+		l = m.Clock.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NormalTaskQueue)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.BuildIdAssignment != nil {
+		l = m.BuildIdAssignment.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
+func (m *AddWorkflowTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ActivityTaskQueuePartitions) > 0 {
-		for _, e := range m.ActivityTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	if len(m.WorkflowTaskQueuePartitions) > 0 {
-		for _, e := range m.WorkflowTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
+func (m *AddActivityTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5376,14 +7135,51 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	if m.Clock != nil {
+		l = m.Clock.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
+func (m *AddActivityTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5392,7 +7188,7 @@ func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
+func (m *QueryWorkflowRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5402,27 +7198,43 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.QueryRequest != nil {
+		l = m.QueryRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.BuildIdAssignment != nil {
+		l = m.BuildIdAssignment.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetWorkerBuildIdCompatibilityResponse) Size() (n int) {
+func (m *QueryWorkflowResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Response != nil {
-		l = m.Response.Size()
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *InvalidateTaskQueueMetadataRequest) Size() (n int) {
+func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5432,21 +7244,22 @@ func (m *InvalidateTaskQueueMetadataRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.TaskQueue)
-	if l > 0 {
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VersioningData != nil {
-		l = m.VersioningData.Size()
+	if m.CompletedRequest != nil {
+		l = m.CompletedRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *InvalidateTaskQueueMetadataResponse) Size() (n int) {
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5455,7 +7268,7 @@ func (m *InvalidateTaskQueueMetadataResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetTaskQueueMetadataRequest) Size() (n int) {
+func (m *CancelOutstandingPollRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5465,476 +7278,2249 @@ func (m *GetTaskQueueMetadataRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.TaskQueue)
-	if l > 0 {
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.WantVersioningDataCurhash)
+	l = len(m.PollerId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetTaskQueueMetadataResponse) Size() (n int) {
+func (m *CancelOutstandingPollResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VersioningDataResp != nil {
-		n += m.VersioningDataResp.Size()
-	}
 	return n
 }
 
-func (m *GetTaskQueueMetadataResponse_VersioningData) Size() (n int) {
+func (m *DescribeTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VersioningData != nil {
-		l = m.VersioningData.Size()
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DescRequest != nil {
+		l = m.DescRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.IncludeWorkers {
+		n += 2
+	}
 	return n
 }
-func (m *GetTaskQueueMetadataResponse_MatchedReqHash) Size() (n int) {
+
+func (m *DescribeTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	return n
-}
-
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *PollWorkflowTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollWorkflowTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	repeatedStringForMessages := "[]*Message{"
-	for _, f := range this.Messages {
-		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v15.Message", 1) + ","
+	if m.TaskQueueStatus != nil {
+		l = m.TaskQueueStatus.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForMessages += "}"
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if len(m.FairnessKeyBacklogs) > 0 {
+		for _, e := range m.FairnessKeyBacklogs {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	if m.AddedTaskCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.AddedTaskCount))
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
-		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
-		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
-		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Queries:` + mapStringForQueries + `,`,
-		`Messages:` + repeatedStringForMessages + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollActivityTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PollActivityTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FairnessKeyBacklog) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
-		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.BacklogCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BacklogCount))
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AddActivityTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AddActivityTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddActivityTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&AddActivityTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&QueryWorkflowRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&QueryWorkflowResponse{`,
-		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
-		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RespondQueryTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondQueryTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.ActivityTaskQueuePartitions) > 0 {
+		for _, e := range m.ActivityTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancelOutstandingPollRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.WorkflowTaskQueuePartitions) > 0 {
+		for _, e := range m.WorkflowTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CancelOutstandingPollResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPollers := "[]*PollerInfo{"
-	for _, f := range this.Pollers {
-		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForPollers += "}"
-	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
-		`Pollers:` + repeatedStringForPollers + `,`,
-		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ListTaskQueuePartitionsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *ListTaskQueuePartitionsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.ActivityTaskQueuePartitions {
-		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForActivityTaskQueuePartitions += "}"
-	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.WorkflowTaskQueuePartitions {
-		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForWorkflowTaskQueuePartitions += "}"
-	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
-		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
-		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *UpdateWorkerBuildIdCompatibilityRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateWorkerBuildIdCompatibilityRequest", "v1.UpdateWorkerBuildIdCompatibilityRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateWorkerBuildIdCompatibilityResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetWorkerBuildIdCompatibilityRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *InvalidateTaskQueueMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "GetWorkerBuildIdCompatibilityRequest", "v1.GetWorkerBuildIdCompatibilityRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetWorkerBuildIdCompatibilityResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse{`,
-		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "GetWorkerBuildIdCompatibilityResponse", "v1.GetWorkerBuildIdCompatibilityResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InvalidateTaskQueueMetadataRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&InvalidateTaskQueueMetadataRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v19.VersioningData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InvalidateTaskQueueMetadataResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
 	}
-	s := strings.Join([]string{`&InvalidateTaskQueueMetadataResponse{`,
-		`}`,
-	}, "")
-	return s
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *GetTaskQueueMetadataRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *InvalidateTaskQueueMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetTaskQueueMetadataRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`WantVersioningDataCurhash:` + fmt.Sprintf("%v", this.WantVersioningDataCurhash) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *GetTaskQueueMetadataResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetTaskQueueMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetTaskQueueMetadataResponse{`,
-		`VersioningDataResp:` + fmt.Sprintf("%v", this.VersioningDataResp) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WantVersioningDataCurhash)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WantPartitionConfig {
+		n += 2
+	}
+	return n
 }
-func (this *GetTaskQueueMetadataResponse_VersioningData) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetTaskQueueMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetTaskQueueMetadataResponse_VersioningData{`,
-		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v19.VersioningData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.VersioningDataResp != nil {
+		n += m.VersioningDataResp.Size()
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *GetTaskQueueMetadataResponse_MatchedReqHash) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetTaskQueueMetadataResponse_VersioningData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetTaskQueueMetadataResponse_MatchedReqHash{`,
-		`MatchedReqHash:` + fmt.Sprintf("%v", this.MatchedReqHash) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.VersioningData != nil {
+		l = m.VersioningData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *GetTaskQueueMetadataResponse_MatchedReqHash) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	n += 2
+	return n
 }
-func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *DescribeTaskQueueBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	return n
+}
+
+func (m *DescribeTaskQueueBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MoveTaskQueueTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	l = len(m.DestinationTaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxTasks != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxTasks))
+	}
+	return n
+}
+
+func (m *MoveTaskQueueTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MovedTaskCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MovedTaskCount))
+	}
+	return n
+}
+
+func (m *PurgeTaskQueueTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxTasks != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxTasks))
+	}
+	return n
+}
+
+func (m *PurgeTaskQueueTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PurgedTaskCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PurgedTaskCount))
+	}
+	return n
+}
+
+func (m *RecordActivityTaskClosedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *RecordActivityTaskClosedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateBuildIdRampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.RampPercentage != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *UpdateBuildIdRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PollWorkflowTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollWorkflowTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMessages := "[]*Message{"
+	for _, f := range this.Messages {
+		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v15.Message", 1) + ","
+	}
+	repeatedStringForMessages += "}"
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
+		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
+		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
+		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Queries:` + mapStringForQueries + `,`,
+		`Messages:` + repeatedStringForMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
+		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NormalTaskQueue:` + fmt.Sprintf("%v", this.NormalTaskQueue) + `,`,
+		`BuildIdAssignment:` + strings.Replace(fmt.Sprintf("%v", this.BuildIdAssignment), "BuildIdAssignment", "v18.BuildIdAssignment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`BuildIdAssignment:` + strings.Replace(fmt.Sprintf("%v", this.BuildIdAssignment), "BuildIdAssignment", "v18.BuildIdAssignment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowResponse{`,
+		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
+		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
+		`IncludeWorkers:` + fmt.Sprintf("%v", this.IncludeWorkers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPollers := "[]*PollerInfo{"
+	for _, f := range this.Pollers {
+		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+	}
+	repeatedStringForPollers += "}"
+	repeatedStringForFairnessKeyBacklogs := "[]*FairnessKeyBacklog{"
+	for _, f := range this.FairnessKeyBacklogs {
+		repeatedStringForFairnessKeyBacklogs += strings.Replace(f.String(), "FairnessKeyBacklog", "FairnessKeyBacklog", 1) + ","
+	}
+	repeatedStringForFairnessKeyBacklogs += "}"
+	repeatedStringForWorkers := "[]*WorkerInfo{"
+	for _, f := range this.Workers {
+		repeatedStringForWorkers += strings.Replace(fmt.Sprintf("%v", f), "WorkerInfo", "v110.WorkerInfo", 1) + ","
+	}
+	repeatedStringForWorkers += "}"
+	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
+		`Pollers:` + repeatedStringForPollers + `,`,
+		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`FairnessKeyBacklogs:` + repeatedStringForFairnessKeyBacklogs + `,`,
+		`AddedTaskCount:` + fmt.Sprintf("%v", this.AddedTaskCount) + `,`,
+		`Workers:` + repeatedStringForWorkers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FairnessKeyBacklog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FairnessKeyBacklog{`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`BacklogCount:` + fmt.Sprintf("%v", this.BacklogCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.ActivityTaskQueuePartitions {
+		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForActivityTaskQueuePartitions += "}"
+	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.WorkflowTaskQueuePartitions {
+		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForWorkflowTaskQueuePartitions += "}"
+	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
+		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
+		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateWorkerBuildIdCompatibilityRequest", "v1.UpdateWorkerBuildIdCompatibilityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "GetWorkerBuildIdCompatibilityRequest", "v1.GetWorkerBuildIdCompatibilityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "GetWorkerBuildIdCompatibilityResponse", "v1.GetWorkerBuildIdCompatibilityResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InvalidateTaskQueueMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InvalidateTaskQueueMetadataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InvalidateTaskQueueMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InvalidateTaskQueueMetadataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueMetadataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueMetadataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`WantVersioningDataCurhash:` + fmt.Sprintf("%v", this.WantVersioningDataCurhash) + `,`,
+		`WantPartitionConfig:` + fmt.Sprintf("%v", this.WantPartitionConfig) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueMetadataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueMetadataResponse{`,
+		`VersioningDataResp:` + fmt.Sprintf("%v", this.VersioningDataResp) + `,`,
+		`PartitionConfig:` + strings.Replace(fmt.Sprintf("%v", this.PartitionConfig), "TaskQueuePartitionConfig", "v18.TaskQueuePartitionConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueMetadataResponse_VersioningData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueMetadataResponse_VersioningData{`,
+		`VersioningData:` + strings.Replace(fmt.Sprintf("%v", this.VersioningData), "VersioningData", "v18.VersioningData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueMetadataResponse_MatchedReqHash) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueMetadataResponse_MatchedReqHash{`,
+		`MatchedReqHash:` + fmt.Sprintf("%v", this.MatchedReqHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueBacklogRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueueBacklogRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueBacklogResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueueBacklogResponse{`,
+		`Summary:` + strings.Replace(fmt.Sprintf("%v", this.Summary), "BacklogSummary", "v110.BacklogSummary", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MoveTaskQueueTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MoveTaskQueueTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`DestinationTaskQueue:` + fmt.Sprintf("%v", this.DestinationTaskQueue) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "BacklogTaskFilter", "v110.BacklogTaskFilter", 1) + `,`,
+		`MaxTasks:` + fmt.Sprintf("%v", this.MaxTasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MoveTaskQueueTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MoveTaskQueueTasksResponse{`,
+		`MovedTaskCount:` + fmt.Sprintf("%v", this.MovedTaskCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeTaskQueueTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeTaskQueueTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`Filter:` + strings.Replace(fmt.Sprintf("%v", this.Filter), "BacklogTaskFilter", "v110.BacklogTaskFilter", 1) + `,`,
+		`MaxTasks:` + fmt.Sprintf("%v", this.MaxTasks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeTaskQueueTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeTaskQueueTasksResponse{`,
+		`PurgedTaskCount:` + fmt.Sprintf("%v", this.PurgedTaskCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskClosedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskClosedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskClosedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskClosedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateBuildIdRampRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateBuildIdRampRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`RampPercentage:` + fmt.Sprintf("%v", this.RampPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateBuildIdRampResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateBuildIdRampResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &v12.WorkflowQuery{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientWorkflowTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransientWorkflowTask == nil {
+				m.TransientWorkflowTask = &v13.TransientWorkflowTaskInfo{}
+			}
+			if err := m.TransientWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskQueue == nil {
+				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v12.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v12.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v12.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &v15.Message{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowRequestResponse
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &v11.ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Input == nil {
+				m.Input = &v11.Payloads{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5944,29 +9530,232 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -5976,27 +9765,31 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v11.Payloads{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6023,16 +9816,16 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6060,7 +9853,43 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v11.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6086,7 +9915,7 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6109,17 +9938,17 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6129,29 +9958,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6178,16 +10005,158 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v16.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6214,18 +10183,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
+			if m.Clock == nil {
+				m.Clock = &v17.VectorClock{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.PreviousStartedEventId = 0
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6235,16 +10204,16 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				m.Priority |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
 			}
-			m.StartedEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6254,35 +10223,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NextEventId = 0
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6292,16 +10255,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.BacklogCountHint = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6311,16 +10287,33 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BacklogCountHint |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalTaskQueue", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6330,15 +10323,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalTaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdAssignment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6365,18 +10370,124 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &v12.WorkflowQuery{}
+			if m.BuildIdAssignment == nil {
+				m.BuildIdAssignment = &v18.BuildIdAssignment{}
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BuildIdAssignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransientWorkflowTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6386,31 +10497,27 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransientWorkflowTask == nil {
-				m.TransientWorkflowTask = &v13.TransientWorkflowTaskInfo{}
-			}
-			if err := m.TransientWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6437,18 +10544,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
 			}
-			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6458,31 +10565,33 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
 			}
-			var msglen int
+			m.ScheduledEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6492,31 +10601,14 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ScheduledEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6543,18 +10635,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6564,124 +10656,46 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v12.WorkflowQuery)
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var mapkey string
-			var mapvalue *v12.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v12.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v16.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Queries[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6708,69 +10722,18 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &v15.Message{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Clock == nil {
+				m.Clock = &v17.VectorClock{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6780,27 +10743,14 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Priority |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6828,13 +10778,13 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6844,33 +10794,29 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
-			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6880,23 +10826,27 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6922,7 +10872,7 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6945,85 +10895,68 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7051,83 +10984,11 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ActivityType == nil {
-				m.ActivityType = &v11.ActivityType{}
-			}
-			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &v11.Payloads{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7154,16 +11015,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7190,18 +11051,18 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToCloseTimeout == nil {
-				m.ScheduleToCloseTimeout = new(time.Duration)
+			if m.QueryRequest == nil {
+				m.QueryRequest = &v1.QueryWorkflowRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7211,31 +11072,27 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdAssignment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7262,16 +11119,69 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartToCloseTimeout == nil {
-				m.StartToCloseTimeout = new(time.Duration)
+			if m.BuildIdAssignment == nil {
+				m.BuildIdAssignment = &v18.BuildIdAssignment{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BuildIdAssignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7298,35 +11208,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatTimeout == nil {
-				m.HeartbeatTimeout = new(time.Duration)
+			if m.QueryResult == nil {
+				m.QueryResult = &v11.Payloads{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7353,18 +11244,71 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v12.QueryRejected{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7374,31 +11318,27 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v11.Payloads{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7425,16 +11365,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7462,11 +11402,11 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7490,16 +11430,69 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletedRequest == nil {
+				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
+			}
+			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &v11.Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7524,7 +11517,7 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7547,10 +11540,10 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7586,10 +11579,10 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var msglen int
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7599,28 +11592,11 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
@@ -7658,63 +11634,8 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
-			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7742,62 +11663,7 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= v16.TaskSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Clock == nil {
-				m.Clock = &v17.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7823,7 +11689,7 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7846,10 +11712,10 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7876,7 +11742,7 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7899,10 +11765,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7939,7 +11805,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7966,18 +11832,18 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
+			if m.DescRequest == nil {
+				m.DescRequest = &v1.DescribeTaskQueueRequest{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeWorkers", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7987,33 +11853,70 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.IncludeWorkers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
 			}
-			m.ScheduledEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8023,14 +11926,29 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8057,18 +11975,18 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
+			if m.TaskQueueStatus == nil {
+				m.TaskQueueStatus = &v14.TaskQueueStatus{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKeyBacklogs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8078,29 +11996,31 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.FairnessKeyBacklogs = append(m.FairnessKeyBacklogs, &FairnessKeyBacklog{})
+			if err := m.FairnessKeyBacklogs[len(m.FairnessKeyBacklogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedTaskCount", wireType)
 			}
-			m.Source = 0
+			m.AddedTaskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8110,14 +12030,14 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v16.TaskSource(b&0x7F) << shift
+				m.AddedTaskCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8144,10 +12064,8 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v17.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Workers = append(m.Workers, &v110.WorkerInfo{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8175,7 +12093,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *FairnessKeyBacklog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8198,12 +12116,63 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FairnessKeyBacklog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FairnessKeyBacklog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCount", wireType)
+			}
+			m.BacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8228,7 +12197,7 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8251,15 +12220,15 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8287,7 +12256,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -8327,43 +12296,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QueryRequest == nil {
-				m.QueryRequest = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8391,7 +12324,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8417,7 +12350,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8440,15 +12373,15 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8475,16 +12408,14 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v11.Payloads{}
-			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8511,10 +12442,8 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v12.QueryRejected{}
-			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8542,7 +12471,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8565,10 +12494,10 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8605,7 +12534,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8622,26 +12551,132 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.UpdateWorkerBuildIdCompatibilityRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8669,11 +12704,11 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8700,10 +12735,10 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedRequest == nil {
-				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
+			if m.Request == nil {
+				m.Request = &v1.GetWorkerBuildIdCompatibilityRequest{}
 			}
-			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8731,7 +12766,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8754,12 +12789,48 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &v1.GetWorkerBuildIdCompatibilityResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8784,7 +12855,7 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8807,10 +12878,10 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvalidateTaskQueueMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvalidateTaskQueueMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8846,10 +12917,10 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			m.TaskQueueType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8859,16 +12930,29 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v18.TaskQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var msglen int
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8878,33 +12962,16 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8914,23 +12981,27 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.VersioningData == nil {
+				m.VersioningData = &v18.VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8956,7 +13027,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+func (m *InvalidateTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8979,10 +13050,10 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvalidateTaskQueueMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvalidateTaskQueueMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9009,7 +13080,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9032,10 +13103,10 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskQueueMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskQueueMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9072,9 +13143,75 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantVersioningDataCurhash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WantVersioningDataCurhash = append(m.WantVersioningDataCurhash[:0], dAtA[iNdEx:postIndex]...)
+			if m.WantVersioningDataCurhash == nil {
+				m.WantVersioningDataCurhash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WantPartitionConfig", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9084,28 +13221,12 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DescRequest == nil {
-				m.DescRequest = &v1.DescribeTaskQueueRequest{}
-			}
-			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.WantPartitionConfig = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9130,7 +13251,7 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9153,15 +13274,15 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskQueueMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskQueueMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9188,14 +13309,36 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &v18.VersioningData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.VersioningDataResp = &GetTaskQueueMetadataResponse_VersioningData{v}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedReqHash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.VersioningDataResp = &GetTaskQueueMetadataResponse_MatchedReqHash{b}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9222,10 +13365,10 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueueStatus == nil {
-				m.TaskQueueStatus = &v14.TaskQueueStatus{}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v18.TaskQueuePartitionConfig{}
 			}
-			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9253,7 +13396,7 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskQueueBacklogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9276,15 +13419,15 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskQueueBacklogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskQueueBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9312,13 +13455,13 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9328,33 +13471,29 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var stringLen uint64
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9364,24 +13503,11 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9406,7 +13532,7 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskQueueBacklogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9429,15 +13555,15 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskQueueBacklogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskQueueBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9464,42 +13590,10 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Summary == nil {
+				m.Summary = &v110.BacklogSummary{}
 			}
-			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9527,7 +13621,7 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *MoveTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9550,10 +13644,10 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTaskQueueTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9590,7 +13684,90 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationTaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9617,13 +13794,32 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.UpdateWorkerBuildIdCompatibilityRequest{}
+			if m.Filter == nil {
+				m.Filter = &v110.BacklogTaskFilter{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasks", wireType)
+			}
+			m.MaxTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9648,7 +13844,7 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *MoveTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9671,12 +13867,31 @@ func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTaskQueueTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedTaskCount", wireType)
+			}
+			m.MovedTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovedTaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9701,7 +13916,7 @@ func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeTaskQueueTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9724,10 +13939,10 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeTaskQueueTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeTaskQueueTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9764,7 +13979,58 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9791,13 +14057,32 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.GetWorkerBuildIdCompatibilityRequest{}
+			if m.Filter == nil {
+				m.Filter = &v110.BacklogTaskFilter{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasks", wireType)
+			}
+			m.MaxTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9822,7 +14107,7 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9845,17 +14130,17 @@ func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeTaskQueueTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeTaskQueueTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgedTaskCount", wireType)
 			}
-			var msglen int
+			m.PurgedTaskCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9865,28 +14150,11 @@ func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PurgedTaskCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &v1.GetWorkerBuildIdCompatibilityResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9911,7 +14179,7 @@ func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskClosedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9934,10 +14202,10 @@ func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InvalidateTaskQueueMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskClosedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InvalidateTaskQueueMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskClosedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10005,27 +14273,8 @@ func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
-			}
-			m.TaskQueueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskQueueType |= v18.TaskQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10052,13 +14301,51 @@ func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersioningData == nil {
-				m.VersioningData = &v19.VersioningData{}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
 			}
-			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10083,7 +14370,7 @@ func (m *InvalidateTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InvalidateTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskClosedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10106,10 +14393,10 @@ func (m *InvalidateTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InvalidateTaskQueueMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskClosedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InvalidateTaskQueueMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskClosedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10136,7 +14423,7 @@ func (m *InvalidateTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateBuildIdRampRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10159,10 +14446,10 @@ func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateBuildIdRampRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateBuildIdRampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10231,9 +14518,9 @@ func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WantVersioningDataCurhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10243,26 +14530,35 @@ func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WantVersioningDataCurhash = append(m.WantVersioningDataCurhash[:0], dAtA[iNdEx:postIndex]...)
-			if m.WantVersioningDataCurhash == nil {
-				m.WantVersioningDataCurhash = []byte{}
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampPercentage", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RampPercentage = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10287,7 +14583,7 @@ func (m *GetTaskQueueMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateBuildIdRampResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10310,68 +14606,12 @@ func (m *GetTaskQueueMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateBuildIdRampResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateBuildIdRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &v19.VersioningData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.VersioningDataResp = &GetTaskQueueMetadataResponse_VersioningData{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchedReqHash", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.VersioningDataResp = &GetTaskQueueMetadataResponse_MatchedReqHash{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
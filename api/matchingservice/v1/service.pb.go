@@ -54,43 +54,50 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x0b, 0xc3, 0x49, 0xa8, 0xaa, 0x05, 0x42, 0x14, 0x71, 0x42, 0x0c, 0x8c, 0x8e,
-	0x0a, 0x6c, 0xb4, 0x40, 0x9a, 0x40, 0x08, 0x34, 0x6a, 0xca, 0x8b, 0x90, 0x58, 0xd0, 0x25, 0x7e,
-	0x08, 0xa7, 0x3a, 0x3e, 0x73, 0x3e, 0x1b, 0x65, 0xe3, 0x13, 0x20, 0x06, 0x26, 0x56, 0x24, 0xc4,
-	0xc0, 0xc4, 0xd4, 0x89, 0x15, 0xc6, 0x8c, 0x1d, 0x89, 0xb3, 0x30, 0xf6, 0x23, 0x20, 0xd7, 0xb9,
-	0x6b, 0xde, 0x7b, 0x71, 0xb2, 0x25, 0xce, 0xfd, 0x7f, 0xcf, 0xef, 0x1f, 0xdf, 0x49, 0x87, 0x6f,
-	0x4b, 0x68, 0x07, 0x5c, 0x50, 0xaf, 0x10, 0x82, 0x88, 0x41, 0x14, 0x68, 0xc0, 0x0a, 0x6d, 0x2a,
-	0x9b, 0x6f, 0x99, 0xdf, 0x4a, 0x1f, 0xb1, 0x26, 0x14, 0xe2, 0xcd, 0xc2, 0xe0, 0xa3, 0x13, 0x08,
-	0x2e, 0xb9, 0x7d, 0x43, 0xa5, 0x9c, 0x2c, 0xe5, 0xd0, 0x80, 0x39, 0x63, 0x29, 0x27, 0xde, 0xdc,
-	0xd8, 0x36, 0xa4, 0x0b, 0x78, 0x17, 0x41, 0x28, 0x5f, 0x0b, 0x08, 0x03, 0xee, 0x87, 0x83, 0x31,
-	0x37, 0x0f, 0xd7, 0xf1, 0x5a, 0x6d, 0xb0, 0xfa, 0x59, 0xb6, 0xda, 0xfe, 0x86, 0xf0, 0xc5, 0x3a,
-	0xf7, 0xbc, 0x97, 0x5c, 0x1c, 0xbc, 0xf1, 0xf8, 0xfb, 0xe7, 0x34, 0x3c, 0xd8, 0x8f, 0x20, 0x02,
-	0xbb, 0xec, 0x98, 0x59, 0x39, 0x53, 0xe3, 0x4f, 0x33, 0x85, 0x8d, 0x07, 0x4b, 0x52, 0xb2, 0x02,
-	0xd7, 0x2d, 0x2d, 0x5a, 0x6c, 0x4a, 0x16, 0x33, 0xd9, 0xc9, 0x29, 0x3a, 0x11, 0xcf, 0x25, 0x3a,
-	0x85, 0xa2, 0x45, 0x3f, 0x23, 0xbc, 0x56, 0x74, 0xdd, 0xe1, 0x2e, 0xf6, 0x5d, 0x53, 0xf8, 0x58,
-	0x50, 0xc9, 0xdd, 0xcb, 0x9d, 0x1f, 0xd7, 0x1a, 0x36, 0x5f, 0x48, 0x6b, 0x38, 0x98, 0x47, 0x6b,
-	0x34, 0xaf, 0xb5, 0x3e, 0x22, 0x7c, 0x7e, 0x3f, 0x02, 0xd1, 0x51, 0xda, 0xf6, 0x96, 0x29, 0x74,
-	0x24, 0xa6, 0x94, 0xb6, 0x73, 0xa6, 0xb5, 0xd0, 0x4f, 0x84, 0x2f, 0x67, 0x5f, 0xdd, 0x93, 0x25,
-	0xa9, 0x6f, 0x89, 0xb7, 0x03, 0x0f, 0x24, 0xb8, 0xf6, 0x23, 0x53, 0xfc, 0x4c, 0x84, 0x12, 0xad,
-	0xae, 0x80, 0x34, 0x72, 0x38, 0x4a, 0xd4, 0x6f, 0x82, 0xb7, 0x17, 0xc9, 0x50, 0x52, 0xdf, 0x65,
-	0x7e, 0x2b, 0xdd, 0xa8, 0xe6, 0x87, 0x63, 0x6a, 0x7c, 0xe1, 0xc3, 0x31, 0x83, 0xa2, 0x45, 0xbf,
-	0x20, 0xbc, 0x5e, 0x86, 0xb0, 0x29, 0x58, 0x03, 0x4e, 0x4f, 0xf0, 0x7d, 0x53, 0xfc, 0x44, 0x54,
-	0x09, 0x16, 0x97, 0x20, 0x68, 0xb9, 0x1f, 0x08, 0x5f, 0xda, 0x65, 0xa1, 0xd4, 0xbf, 0xd5, 0xa9,
-	0x90, 0x4c, 0x32, 0xee, 0x87, 0xf6, 0x43, 0xd3, 0x01, 0x33, 0x00, 0x4a, 0xb4, 0xb2, 0x34, 0x47,
-	0xeb, 0xfe, 0x46, 0xf8, 0xda, 0x8b, 0xc0, 0xa5, 0x12, 0xd2, 0x6d, 0x0c, 0x62, 0x27, 0x62, 0x9e,
-	0x5b, 0x75, 0xd3, 0xfd, 0x41, 0x25, 0x6b, 0x30, 0x8f, 0xc9, 0x8e, 0xbd, 0x67, 0x3a, 0xef, 0x2c,
-	0x92, 0x2a, 0x50, 0x5f, 0x1d, 0x50, 0x37, 0xf9, 0x85, 0xf0, 0xd5, 0x0a, 0xc8, 0x39, 0x35, 0x76,
-	0x4d, 0xa7, 0xce, 0xc5, 0xa8, 0x0e, 0xb5, 0x15, 0xd1, 0x74, 0x81, 0x43, 0x84, 0xaf, 0x54, 0xfd,
-	0x98, 0x7a, 0x2c, 0xed, 0xac, 0x5f, 0x5b, 0x0d, 0x24, 0x75, 0xa9, 0xa4, 0xf6, 0x63, 0xd3, 0x81,
-	0x73, 0x20, 0x4a, 0xfe, 0xc9, 0x4a, 0x58, 0x5a, 0xfd, 0x2b, 0xc2, 0x17, 0x2a, 0x20, 0x27, 0x9d,
-	0x4b, 0x0b, 0xfc, 0x49, 0x33, 0x65, 0xcb, 0xcb, 0x41, 0x94, 0xe5, 0x8e, 0xe8, 0xf6, 0x88, 0x75,
-	0xd4, 0x23, 0xd6, 0x71, 0x8f, 0xa0, 0x0f, 0x09, 0x41, 0xdf, 0x13, 0x82, 0xfe, 0x24, 0x04, 0x75,
-	0x13, 0x82, 0xfe, 0x26, 0x04, 0xfd, 0x4b, 0x88, 0x75, 0x9c, 0x10, 0xf4, 0xa9, 0x4f, 0xac, 0x6e,
-	0x9f, 0x58, 0x47, 0x7d, 0x62, 0xbd, 0xda, 0x6a, 0xf1, 0xd3, 0xf9, 0x8c, 0xcf, 0xbf, 0x35, 0xdd,
-	0x19, 0x7b, 0xd4, 0x38, 0x77, 0x72, 0x6b, 0xba, 0xf5, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x39,
-	0x89, 0x97, 0xd4, 0x09, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x0b, 0xc3, 0x49, 0xa8, 0xe2, 0x00, 0x15, 0x8a, 0x38, 0x21, 0x06, 0x46, 0x47,
+	0x05, 0x36, 0x5a, 0xa0, 0x49, 0x21, 0x2d, 0x34, 0x6a, 0x5a, 0x40, 0x48, 0x2c, 0xe8, 0x6a, 0x1f,
+	0xe1, 0x54, 0xc7, 0x67, 0xce, 0xe7, 0xa0, 0x6e, 0x7c, 0x02, 0xc4, 0xc0, 0x84, 0x84, 0x84, 0x84,
+	0x84, 0x18, 0x90, 0x90, 0x98, 0x98, 0x58, 0x61, 0xec, 0xd8, 0x91, 0xba, 0x0b, 0x63, 0x3f, 0x00,
+	0x03, 0x72, 0x9d, 0xbb, 0xc6, 0x71, 0x1c, 0x2e, 0x76, 0x36, 0xc7, 0xb9, 0xff, 0xef, 0xfd, 0x5e,
+	0xce, 0xcf, 0x8e, 0xe1, 0x75, 0x49, 0xbb, 0x01, 0x17, 0xc4, 0xab, 0x85, 0x54, 0xf4, 0xa8, 0xa8,
+	0x91, 0x80, 0xd5, 0xba, 0x44, 0x3a, 0xcf, 0x99, 0xdf, 0x49, 0x4e, 0x31, 0x87, 0xd6, 0x7a, 0xf3,
+	0xb5, 0xfe, 0xa1, 0x1d, 0x08, 0x2e, 0x39, 0xba, 0xa2, 0x52, 0x76, 0x9a, 0xb2, 0x49, 0xc0, 0xec,
+	0xa1, 0x94, 0xdd, 0x9b, 0x9f, 0x5b, 0x34, 0xa4, 0x0b, 0xfa, 0x22, 0xa2, 0xa1, 0x7c, 0x2a, 0x68,
+	0x18, 0x70, 0x3f, 0xec, 0x97, 0xb9, 0xfa, 0x77, 0x16, 0xce, 0xb4, 0xfa, 0xab, 0x1f, 0xa4, 0xab,
+	0xd1, 0x27, 0x00, 0xcf, 0xb6, 0xb9, 0xe7, 0x3d, 0xe6, 0x62, 0xfb, 0x99, 0xc7, 0x5f, 0x3e, 0x24,
+	0xe1, 0xf6, 0x46, 0x44, 0x23, 0x8a, 0x96, 0x6d, 0x33, 0x2b, 0x7b, 0x64, 0x7c, 0x33, 0x55, 0x98,
+	0xbb, 0x53, 0x91, 0x92, 0x36, 0x70, 0xd9, 0xd2, 0xa2, 0x4b, 0x8e, 0x64, 0x3d, 0x26, 0x77, 0x4a,
+	0x8a, 0xe6, 0xe2, 0xa5, 0x44, 0x47, 0x50, 0xb4, 0xe8, 0x5b, 0x00, 0x67, 0x96, 0x5c, 0x77, 0xb0,
+	0x17, 0x74, 0xd3, 0x14, 0x3e, 0x14, 0x54, 0x72, 0xb7, 0x4a, 0xe7, 0x87, 0xb5, 0x06, 0xcd, 0x27,
+	0xd2, 0x1a, 0x0c, 0x96, 0xd1, 0xca, 0xe6, 0xb5, 0xd6, 0x6b, 0x00, 0x4f, 0x6e, 0x44, 0x54, 0xec,
+	0x28, 0x6d, 0xb4, 0x60, 0x0a, 0xcd, 0xc4, 0x94, 0xd2, 0x62, 0xc9, 0xb4, 0x16, 0xfa, 0x06, 0xe0,
+	0xf9, 0xf4, 0xa3, 0x7b, 0xb4, 0x24, 0xf1, 0x6d, 0xf0, 0x6e, 0xe0, 0x51, 0x49, 0x5d, 0xb4, 0x62,
+	0x8a, 0x2f, 0x44, 0x28, 0xd1, 0xd5, 0x29, 0x90, 0x32, 0xc3, 0xd1, 0x20, 0xbe, 0x43, 0xbd, 0xf5,
+	0x48, 0x86, 0x92, 0xf8, 0x2e, 0xf3, 0x3b, 0xc9, 0x85, 0x6a, 0x3e, 0x1c, 0x23, 0xe3, 0x13, 0x0f,
+	0x47, 0x01, 0x45, 0x8b, 0xbe, 0x03, 0xf0, 0xd4, 0x32, 0x0d, 0x1d, 0xc1, 0xb6, 0xe8, 0xf1, 0x04,
+	0xdf, 0x36, 0xc5, 0xe7, 0xa2, 0x4a, 0x70, 0xa9, 0x02, 0x41, 0xcb, 0x7d, 0x01, 0x70, 0x76, 0x8d,
+	0x85, 0x52, 0x7f, 0xd7, 0x26, 0x42, 0x32, 0xc9, 0xb8, 0x1f, 0xa2, 0xbb, 0xa6, 0x05, 0x0a, 0x00,
+	0x4a, 0xb4, 0x59, 0x99, 0xa3, 0x75, 0x7f, 0x02, 0x78, 0xe9, 0x51, 0xe0, 0x12, 0x49, 0x93, 0xcb,
+	0x98, 0x8a, 0x7a, 0xc4, 0x3c, 0x77, 0xd5, 0x4d, 0xae, 0x0f, 0x22, 0xd9, 0x16, 0xf3, 0x98, 0xdc,
+	0x41, 0xeb, 0xa6, 0xf5, 0xfe, 0x47, 0x52, 0x0d, 0xb4, 0xa7, 0x07, 0xd4, 0x9d, 0xfc, 0x00, 0xf0,
+	0x62, 0x93, 0xca, 0x31, 0x6d, 0xac, 0x99, 0x56, 0x1d, 0x8b, 0x51, 0x3d, 0xb4, 0xa6, 0x44, 0xd3,
+	0x0d, 0x7c, 0x07, 0xf0, 0xc2, 0xaa, 0xdf, 0x23, 0x1e, 0x4b, 0x7a, 0xd6, 0xdb, 0xd6, 0xa2, 0x92,
+	0xb8, 0x44, 0x12, 0x74, 0xcf, 0xb4, 0xe0, 0x18, 0x88, 0x92, 0xbf, 0x3f, 0x15, 0x96, 0x56, 0xff,
+	0x08, 0xe0, 0x99, 0x26, 0x95, 0x79, 0xe7, 0xc6, 0x04, 0x3f, 0x52, 0xa1, 0xec, 0x72, 0x35, 0x88,
+	0xb6, 0xfc, 0x0a, 0xe0, 0xb9, 0xdc, 0xe8, 0xd6, 0x89, 0xb3, 0xed, 0xf1, 0x0e, 0x6a, 0x96, 0x1e,
+	0xfe, 0x3e, 0x41, 0xd9, 0xae, 0x54, 0x07, 0x69, 0xe3, 0xf7, 0x00, 0xa2, 0x16, 0xef, 0x1d, 0x2f,
+	0x49, 0x0e, 0x42, 0x64, 0x7c, 0xa3, 0xca, 0x67, 0x95, 0x65, 0xbd, 0x0a, 0x42, 0xfb, 0x7d, 0x00,
+	0xf0, 0x74, 0x3b, 0x12, 0x9d, 0x61, 0x41, 0x63, 0xfa, 0x88, 0xb0, 0x32, 0x6c, 0x54, 0x62, 0x64,
+	0x36, 0x7d, 0x93, 0x3a, 0x5c, 0x64, 0xfe, 0x3c, 0x34, 0x3c, 0x1e, 0x52, 0xd7, 0x7c, 0xd3, 0x8b,
+	0x08, 0x13, 0x6f, 0x7a, 0x31, 0x28, 0xf3, 0x78, 0x4b, 0xef, 0x7b, 0xfd, 0x1b, 0xc6, 0x26, 0xe9,
+	0x06, 0xe6, 0x8f, 0xb7, 0x5c, 0x74, 0xe2, 0xc7, 0xdb, 0x08, 0x82, 0x92, 0xab, 0x8b, 0xdd, 0x7d,
+	0x6c, 0xed, 0xed, 0x63, 0xeb, 0x70, 0x1f, 0x83, 0x57, 0x31, 0x06, 0x9f, 0x63, 0x0c, 0x7e, 0xc5,
+	0x18, 0xec, 0xc6, 0x18, 0xfc, 0x8e, 0x31, 0xf8, 0x13, 0x63, 0xeb, 0x30, 0xc6, 0xe0, 0xcd, 0x01,
+	0xb6, 0x76, 0x0f, 0xb0, 0xb5, 0x77, 0x80, 0xad, 0x27, 0x0b, 0x1d, 0x7e, 0x5c, 0x9c, 0xf1, 0xf1,
+	0x6f, 0x1e, 0x37, 0x86, 0x4e, 0x6d, 0x9d, 0x38, 0x7a, 0xf3, 0xb8, 0xf6, 0x6f, 0x00, 0xff, 0x03,
+	0xc0, 0x1e, 0x18, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -144,6 +151,18 @@ type MatchingServiceClient interface {
 	InvalidateTaskQueueMetadata(ctx context.Context, in *InvalidateTaskQueueMetadataRequest, opts ...grpc.CallOption) (*InvalidateTaskQueueMetadataResponse, error)
 	// Fetch some metadata about a task queue.
 	GetTaskQueueMetadata(ctx context.Context, in *GetTaskQueueMetadataRequest, opts ...grpc.CallOption) (*GetTaskQueueMetadataResponse, error)
+	// DescribeTaskQueueBacklog summarizes the persisted backlog of a task queue partition.
+	DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error)
+	// MoveTaskQueueTasks moves backlog tasks of a task queue partition to another task queue.
+	MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error)
+	// PurgeTaskQueueTasks deletes backlog tasks of a task queue partition.
+	PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error)
+	// RecordActivityTaskClosed is called by history when an activity task dispatched by the task queue partition
+	// is closed, to release its slot in the inflight activity task limit of the partition.
+	RecordActivityTaskClosed(ctx context.Context, in *RecordActivityTaskClosedRequest, opts ...grpc.CallOption) (*RecordActivityTaskClosedResponse, error)
+	// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions
+	// of a task queue.
+	UpdateBuildIdRamp(ctx context.Context, in *UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*UpdateBuildIdRampResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -271,6 +290,51 @@ func (c *matchingServiceClient) GetTaskQueueMetadata(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *matchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogResponse, error) {
+	out := new(DescribeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueueBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) MoveTaskQueueTasks(ctx context.Context, in *MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*MoveTaskQueueTasksResponse, error) {
+	out := new(MoveTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*PurgeTaskQueueTasksResponse, error) {
+	out := new(PurgeTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/PurgeTaskQueueTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) RecordActivityTaskClosed(ctx context.Context, in *RecordActivityTaskClosedRequest, opts ...grpc.CallOption) (*RecordActivityTaskClosedResponse, error) {
+	out := new(RecordActivityTaskClosedResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/RecordActivityTaskClosed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateBuildIdRamp(ctx context.Context, in *UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*UpdateBuildIdRampResponse, error) {
+	out := new(UpdateBuildIdRampResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/UpdateBuildIdRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 type MatchingServiceServer interface {
 	// PollWorkflowTaskQueue is called by frontend to process WorkflowTask from a specific task queue.  A
@@ -312,6 +376,18 @@ type MatchingServiceServer interface {
 	InvalidateTaskQueueMetadata(context.Context, *InvalidateTaskQueueMetadataRequest) (*InvalidateTaskQueueMetadataResponse, error)
 	// Fetch some metadata about a task queue.
 	GetTaskQueueMetadata(context.Context, *GetTaskQueueMetadataRequest) (*GetTaskQueueMetadataResponse, error)
+	// DescribeTaskQueueBacklog summarizes the persisted backlog of a task queue partition.
+	DescribeTaskQueueBacklog(context.Context, *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error)
+	// MoveTaskQueueTasks moves backlog tasks of a task queue partition to another task queue.
+	MoveTaskQueueTasks(context.Context, *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error)
+	// PurgeTaskQueueTasks deletes backlog tasks of a task queue partition.
+	PurgeTaskQueueTasks(context.Context, *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error)
+	// RecordActivityTaskClosed is called by history when an activity task dispatched by the task queue partition
+	// is closed, to release its slot in the inflight activity task limit of the partition.
+	RecordActivityTaskClosed(context.Context, *RecordActivityTaskClosedRequest) (*RecordActivityTaskClosedResponse, error)
+	// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions
+	// of a task queue.
+	UpdateBuildIdRamp(context.Context, *UpdateBuildIdRampRequest) (*UpdateBuildIdRampResponse, error)
 }
 
 // UnimplementedMatchingServiceServer can be embedded to have forward compatible implementations.
@@ -357,6 +433,21 @@ func (*UnimplementedMatchingServiceServer) InvalidateTaskQueueMetadata(ctx conte
 func (*UnimplementedMatchingServiceServer) GetTaskQueueMetadata(ctx context.Context, req *GetTaskQueueMetadataRequest) (*GetTaskQueueMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueMetadata not implemented")
 }
+func (*UnimplementedMatchingServiceServer) DescribeTaskQueueBacklog(ctx context.Context, req *DescribeTaskQueueBacklogRequest) (*DescribeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklog not implemented")
+}
+func (*UnimplementedMatchingServiceServer) MoveTaskQueueTasks(ctx context.Context, req *MoveTaskQueueTasksRequest) (*MoveTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTaskQueueTasks not implemented")
+}
+func (*UnimplementedMatchingServiceServer) PurgeTaskQueueTasks(ctx context.Context, req *PurgeTaskQueueTasksRequest) (*PurgeTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTaskQueueTasks not implemented")
+}
+func (*UnimplementedMatchingServiceServer) RecordActivityTaskClosed(ctx context.Context, req *RecordActivityTaskClosedRequest) (*RecordActivityTaskClosedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordActivityTaskClosed not implemented")
+}
+func (*UnimplementedMatchingServiceServer) UpdateBuildIdRamp(ctx context.Context, req *UpdateBuildIdRampRequest) (*UpdateBuildIdRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuildIdRamp not implemented")
+}
 
 func RegisterMatchingServiceServer(s *grpc.Server, srv MatchingServiceServer) {
 	s.RegisterService(&_MatchingService_serviceDesc, srv)
@@ -596,6 +687,96 @@ func _MatchingService_GetTaskQueueMetadata_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_DescribeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueueBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeTaskQueueBacklog(ctx, req.(*DescribeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_MoveTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).MoveTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/MoveTaskQueueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).MoveTaskQueueTasks(ctx, req.(*MoveTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_PurgeTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskQueueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).PurgeTaskQueueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/PurgeTaskQueueTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).PurgeTaskQueueTasks(ctx, req.(*PurgeTaskQueueTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_RecordActivityTaskClosed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordActivityTaskClosedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RecordActivityTaskClosed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/RecordActivityTaskClosed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RecordActivityTaskClosed(ctx, req.(*RecordActivityTaskClosedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateBuildIdRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBuildIdRampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateBuildIdRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/UpdateBuildIdRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateBuildIdRamp(ctx, req.(*UpdateBuildIdRampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MatchingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.matchingservice.v1.MatchingService",
 	HandlerType: (*MatchingServiceServer)(nil),
@@ -652,6 +833,26 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueMetadata",
 			Handler:    _MatchingService_GetTaskQueueMetadata_Handler,
 		},
+		{
+			MethodName: "DescribeTaskQueueBacklog",
+			Handler:    _MatchingService_DescribeTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "MoveTaskQueueTasks",
+			Handler:    _MatchingService_MoveTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "PurgeTaskQueueTasks",
+			Handler:    _MatchingService_PurgeTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "RecordActivityTaskClosed",
+			Handler:    _MatchingService_RecordActivityTaskClosed_Handler,
+		},
+		{
+			MethodName: "UpdateBuildIdRamp",
+			Handler:    _MatchingService_UpdateBuildIdRamp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
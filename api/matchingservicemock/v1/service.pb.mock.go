@@ -140,6 +140,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueue(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueue), varargs...)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceClient) DescribeTaskQueueBacklog(ctx context.Context, in *matchingservice.DescribeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueueBacklog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueueBacklog), varargs...)
+}
+
 // GetTaskQueueMetadata mocks base method.
 func (m *MockMatchingServiceClient) GetTaskQueueMetadata(ctx context.Context, in *matchingservice.GetTaskQueueMetadataRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +240,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceClient) MoveTaskQueueTasks(ctx context.Context, in *matchingservice.MoveTaskQueueTasksRequest, opts ...grpc.CallOption) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockMatchingServiceClientMockRecorder) MoveTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).MoveTaskQueueTasks), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +300,26 @@ func (mr *MockMatchingServiceClientMockRecorder) PollWorkflowTaskQueue(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).PollWorkflowTaskQueue), varargs...)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceClient) PurgeTaskQueueTasks(ctx context.Context, in *matchingservice.PurgeTaskQueueTasksRequest, opts ...grpc.CallOption) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockMatchingServiceClientMockRecorder) PurgeTaskQueueTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).PurgeTaskQueueTasks), varargs...)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockMatchingServiceClient) QueryWorkflow(ctx context.Context, in *matchingservice.QueryWorkflowRequest, opts ...grpc.CallOption) (*matchingservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +340,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RecordActivityTaskClosed mocks base method.
+func (m *MockMatchingServiceClient) RecordActivityTaskClosed(ctx context.Context, in *matchingservice.RecordActivityTaskClosedRequest, opts ...grpc.CallOption) (*matchingservice.RecordActivityTaskClosedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordActivityTaskClosed", varargs...)
+	ret0, _ := ret[0].(*matchingservice.RecordActivityTaskClosedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordActivityTaskClosed indicates an expected call of RecordActivityTaskClosed.
+func (mr *MockMatchingServiceClientMockRecorder) RecordActivityTaskClosed(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivityTaskClosed", reflect.TypeOf((*MockMatchingServiceClient)(nil).RecordActivityTaskClosed), varargs...)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockMatchingServiceClient) RespondQueryTaskCompleted(ctx context.Context, in *matchingservice.RespondQueryTaskCompletedRequest, opts ...grpc.CallOption) (*matchingservice.RespondQueryTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -300,6 +380,26 @@ func (mr *MockMatchingServiceClientMockRecorder) RespondQueryTaskCompleted(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceClient)(nil).RespondQueryTaskCompleted), varargs...)
 }
 
+// UpdateBuildIdRamp mocks base method.
+func (m *MockMatchingServiceClient) UpdateBuildIdRamp(ctx context.Context, in *matchingservice.UpdateBuildIdRampRequest, opts ...grpc.CallOption) (*matchingservice.UpdateBuildIdRampResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBuildIdRamp", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateBuildIdRampResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuildIdRamp indicates an expected call of UpdateBuildIdRamp.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateBuildIdRamp(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuildIdRamp", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateBuildIdRamp), varargs...)
+}
+
 // UpdateWorkerBuildIdCompatibility mocks base method.
 func (m *MockMatchingServiceClient) UpdateWorkerBuildIdCompatibility(ctx context.Context, in *matchingservice.UpdateWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +503,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueue(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueue), arg0, arg1)
 }
 
+// DescribeTaskQueueBacklog mocks base method.
+func (m *MockMatchingServiceServer) DescribeTaskQueueBacklog(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueBacklogRequest) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklog indicates an expected call of DescribeTaskQueueBacklog.
+func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueueBacklog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklog", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueueBacklog), arg0, arg1)
+}
+
 // GetTaskQueueMetadata mocks base method.
 func (m *MockMatchingServiceServer) GetTaskQueueMetadata(arg0 context.Context, arg1 *matchingservice.GetTaskQueueMetadataRequest) (*matchingservice.GetTaskQueueMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +578,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// MoveTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceServer) MoveTaskQueueTasks(arg0 context.Context, arg1 *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.MoveTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTaskQueueTasks indicates an expected call of MoveTaskQueueTasks.
+func (mr *MockMatchingServiceServerMockRecorder) MoveTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).MoveTaskQueueTasks), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -493,6 +623,21 @@ func (mr *MockMatchingServiceServerMockRecorder) PollWorkflowTaskQueue(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollWorkflowTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).PollWorkflowTaskQueue), arg0, arg1)
 }
 
+// PurgeTaskQueueTasks mocks base method.
+func (m *MockMatchingServiceServer) PurgeTaskQueueTasks(arg0 context.Context, arg1 *matchingservice.PurgeTaskQueueTasksRequest) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.PurgeTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueTasks indicates an expected call of PurgeTaskQueueTasks.
+func (mr *MockMatchingServiceServerMockRecorder) PurgeTaskQueueTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).PurgeTaskQueueTasks), arg0, arg1)
+}
+
 // QueryWorkflow mocks base method.
 func (m *MockMatchingServiceServer) QueryWorkflow(arg0 context.Context, arg1 *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error) {
 	m.ctrl.T.Helper()
@@ -508,6 +653,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RecordActivityTaskClosed mocks base method.
+func (m *MockMatchingServiceServer) RecordActivityTaskClosed(arg0 context.Context, arg1 *matchingservice.RecordActivityTaskClosedRequest) (*matchingservice.RecordActivityTaskClosedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordActivityTaskClosed", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.RecordActivityTaskClosedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordActivityTaskClosed indicates an expected call of RecordActivityTaskClosed.
+func (mr *MockMatchingServiceServerMockRecorder) RecordActivityTaskClosed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordActivityTaskClosed", reflect.TypeOf((*MockMatchingServiceServer)(nil).RecordActivityTaskClosed), arg0, arg1)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockMatchingServiceServer) RespondQueryTaskCompleted(arg0 context.Context, arg1 *matchingservice.RespondQueryTaskCompletedRequest) (*matchingservice.RespondQueryTaskCompletedResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,6 +683,21 @@ func (mr *MockMatchingServiceServerMockRecorder) RespondQueryTaskCompleted(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondQueryTaskCompleted", reflect.TypeOf((*MockMatchingServiceServer)(nil).RespondQueryTaskCompleted), arg0, arg1)
 }
 
+// UpdateBuildIdRamp mocks base method.
+func (m *MockMatchingServiceServer) UpdateBuildIdRamp(arg0 context.Context, arg1 *matchingservice.UpdateBuildIdRampRequest) (*matchingservice.UpdateBuildIdRampResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBuildIdRamp", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateBuildIdRampResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBuildIdRamp indicates an expected call of UpdateBuildIdRamp.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateBuildIdRamp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuildIdRamp", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateBuildIdRamp), arg0, arg1)
+}
+
 // UpdateWorkerBuildIdCompatibility mocks base method.
 func (m *MockMatchingServiceServer) UpdateWorkerBuildIdCompatibility(arg0 context.Context, arg1 *matchingservice.UpdateWorkerBuildIdCompatibilityRequest) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
 	m.ctrl.T.Helper()
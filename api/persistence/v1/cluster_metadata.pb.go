@@ -54,17 +54,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // data column
 type ClusterMetadata struct {
-	ClusterName              string                            `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	HistoryShardCount        int32                             `protobuf:"varint,2,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
-	ClusterId                string                            `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	VersionInfo              *v1.VersionInfo                   `protobuf:"bytes,4,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
-	IndexSearchAttributes    map[string]*IndexSearchAttributes `protobuf:"bytes,5,rep,name=index_search_attributes,json=indexSearchAttributes,proto3" json:"index_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ClusterAddress           string                            `protobuf:"bytes,6,opt,name=cluster_address,json=clusterAddress,proto3" json:"cluster_address,omitempty"`
-	FailoverVersionIncrement int64                             `protobuf:"varint,7,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
-	InitialFailoverVersion   int64                             `protobuf:"varint,8,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
-	IsGlobalNamespaceEnabled bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
-	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
-	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
+	ClusterName                string                            `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	HistoryShardCount          int32                             `protobuf:"varint,2,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	ClusterId                  string                            `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	VersionInfo                *v1.VersionInfo                   `protobuf:"bytes,4,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	IndexSearchAttributes      map[string]*IndexSearchAttributes `protobuf:"bytes,5,rep,name=index_search_attributes,json=indexSearchAttributes,proto3" json:"index_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ClusterAddress             string                            `protobuf:"bytes,6,opt,name=cluster_address,json=clusterAddress,proto3" json:"cluster_address,omitempty"`
+	FailoverVersionIncrement   int64                             `protobuf:"varint,7,opt,name=failover_version_increment,json=failoverVersionIncrement,proto3" json:"failover_version_increment,omitempty"`
+	InitialFailoverVersion     int64                             `protobuf:"varint,8,opt,name=initial_failover_version,json=initialFailoverVersion,proto3" json:"initial_failover_version,omitempty"`
+	IsGlobalNamespaceEnabled   bool                              `protobuf:"varint,9,opt,name=is_global_namespace_enabled,json=isGlobalNamespaceEnabled,proto3" json:"is_global_namespace_enabled,omitempty"`
+	IsConnectionEnabled        bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
+	UseClusterIdMembership     bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
+	HistoryShardCountMigration *HistoryShardCountMigration       `protobuf:"bytes,12,opt,name=history_shard_count_migration,json=historyShardCountMigration,proto3" json:"history_shard_count_migration,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -176,6 +177,76 @@ func (m *ClusterMetadata) GetUseClusterIdMembership() bool {
 	return false
 }
 
+func (m *ClusterMetadata) GetHistoryShardCountMigration() *HistoryShardCountMigration {
+	if m != nil {
+		return m.HistoryShardCountMigration
+	}
+	return nil
+}
+
+// HistoryShardCountMigration tracks an in progress increase of the history shard count.
+// Workflows of a source shard are mapped using target_shard_count once the source shard is migrated.
+type HistoryShardCountMigration struct {
+	TargetShardCount int32 `protobuf:"varint,1,opt,name=target_shard_count,json=targetShardCount,proto3" json:"target_shard_count,omitempty"`
+	// Source shards whose workflows are being copied to their target shards.
+	MigratingShardIds []int32 `protobuf:"varint,2,rep,packed,name=migrating_shard_ids,json=migratingShardIds,proto3" json:"migrating_shard_ids,omitempty"`
+	// Source shards whose workflows are served from their target shards.
+	MigratedShardIds []int32 `protobuf:"varint,3,rep,packed,name=migrated_shard_ids,json=migratedShardIds,proto3" json:"migrated_shard_ids,omitempty"`
+}
+
+func (m *HistoryShardCountMigration) Reset()      { *m = HistoryShardCountMigration{} }
+func (*HistoryShardCountMigration) ProtoMessage() {}
+func (*HistoryShardCountMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{1}
+}
+func (m *HistoryShardCountMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryShardCountMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryShardCountMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryShardCountMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryShardCountMigration.Merge(m, src)
+}
+func (m *HistoryShardCountMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryShardCountMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryShardCountMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryShardCountMigration proto.InternalMessageInfo
+
+func (m *HistoryShardCountMigration) GetTargetShardCount() int32 {
+	if m != nil {
+		return m.TargetShardCount
+	}
+	return 0
+}
+
+func (m *HistoryShardCountMigration) GetMigratingShardIds() []int32 {
+	if m != nil {
+		return m.MigratingShardIds
+	}
+	return nil
+}
+
+func (m *HistoryShardCountMigration) GetMigratedShardIds() []int32 {
+	if m != nil {
+		return m.MigratedShardIds
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=temporal.api.enums.v1.IndexedValueType"`
 }
@@ -183,7 +254,7 @@ type IndexSearchAttributes struct {
 func (m *IndexSearchAttributes) Reset()      { *m = IndexSearchAttributes{} }
 func (*IndexSearchAttributes) ProtoMessage() {}
 func (*IndexSearchAttributes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f4771d63f405884, []int{1}
+	return fileDescriptor_1f4771d63f405884, []int{2}
 }
 func (m *IndexSearchAttributes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,6 +293,7 @@ func (m *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
 	proto.RegisterMapType((map[string]*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry")
+	proto.RegisterType((*HistoryShardCountMigration)(nil), "temporal.server.api.persistence.v1.HistoryShardCountMigration")
 	proto.RegisterType((*IndexSearchAttributes)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes")
 	proto.RegisterMapType((map[string]v11.IndexedValueType)(nil), "temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry")
 }
@@ -231,49 +303,55 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x50, 0x41, 0x98, 0x12, 0xd0, 0x25, 0xe0, 0x58, 0xe2, 0xa6, 0x12, 0x0d, 0x3d, 0x6d,
-	0x43, 0xf5, 0x00, 0x2a, 0x07, 0x6c, 0x90, 0x70, 0x00, 0x93, 0xa2, 0x1c, 0xbc, 0x6c, 0xa6, 0xbb,
-	0x0f, 0x3a, 0xba, 0x3b, 0xb3, 0x99, 0x99, 0xdd, 0xd8, 0x9b, 0x89, 0x89, 0x57, 0xfd, 0x02, 0xde,
-	0x8d, 0x9f, 0xc4, 0x23, 0x47, 0x8e, 0x52, 0x2e, 0x1e, 0xf9, 0x08, 0x66, 0x67, 0x77, 0xdb, 0x42,
-	0x8a, 0x1a, 0x6f, 0x3b, 0xef, 0xf7, 0x67, 0xde, 0xfc, 0xe6, 0xed, 0xe0, 0x0d, 0x0d, 0x61, 0x24,
-	0x24, 0x0d, 0x1a, 0x0a, 0x64, 0x02, 0xb2, 0x41, 0x23, 0xd6, 0x88, 0x40, 0x2a, 0xa6, 0x34, 0x70,
-	0x0f, 0x1a, 0xc9, 0x5a, 0xc3, 0x0b, 0x62, 0xa5, 0x41, 0xba, 0x21, 0x68, 0xea, 0x53, 0x4d, 0x9d,
-	0x48, 0x0a, 0x2d, 0xac, 0x95, 0x42, 0xea, 0x64, 0x52, 0x87, 0x46, 0xcc, 0x19, 0x91, 0x3a, 0xc9,
-	0x5a, 0x75, 0xc0, 0x31, 0xbe, 0xc0, 0xe3, 0x50, 0x19, 0x47, 0x11, 0x86, 0x82, 0x67, 0x3e, 0xd5,
-	0x87, 0x97, 0x38, 0x49, 0x6a, 0x20, 0x78, 0xca, 0x0a, 0x41, 0x29, 0x7a, 0x0c, 0x19, 0x6d, 0xe5,
-	0xfb, 0x14, 0x9e, 0x6f, 0x65, 0x9d, 0xec, 0xe5, 0x8d, 0x58, 0xf7, 0xf1, 0x6c, 0xd1, 0x1c, 0xa7,
-	0x21, 0x10, 0x54, 0x43, 0xf5, 0x99, 0x76, 0x25, 0xaf, 0xed, 0xd3, 0x10, 0x2c, 0x07, 0x2f, 0x74,
-	0x99, 0xd2, 0x42, 0xf6, 0x5c, 0xd5, 0xa5, 0xd2, 0x77, 0x3d, 0x11, 0x73, 0x4d, 0x26, 0x6a, 0xa8,
-	0x3e, 0xd9, 0xbe, 0x9d, 0x43, 0x07, 0x29, 0xd2, 0x4a, 0x01, 0xeb, 0x1e, 0xc6, 0x85, 0x25, 0xf3,
-	0x49, 0xd9, 0x18, 0xce, 0xe4, 0x95, 0x5d, 0xdf, 0xda, 0xc1, 0xb3, 0x79, 0x87, 0x2e, 0xe3, 0x47,
-	0x82, 0xdc, 0xa8, 0xa1, 0x7a, 0xa5, 0xf9, 0xc0, 0x19, 0x64, 0x91, 0x86, 0x90, 0x33, 0x9c, 0x64,
-	0xcd, 0x39, 0xcc, 0x3e, 0x77, 0xf9, 0x91, 0x68, 0x57, 0x92, 0xe1, 0xc2, 0xfa, 0x84, 0xf0, 0x1d,
-	0xc6, 0x7d, 0x78, 0xef, 0x2a, 0xa0, 0xd2, 0xeb, 0xba, 0x54, 0x6b, 0xc9, 0x3a, 0xb1, 0x06, 0x45,
-	0x26, 0x6b, 0xe5, 0x7a, 0xa5, 0xb9, 0xef, 0xfc, 0x3d, 0x60, 0xe7, 0x4a, 0x22, 0xce, 0x6e, 0x6a,
-	0x79, 0x60, 0x1c, 0xb7, 0x06, 0x86, 0xdb, 0x5c, 0xcb, 0x5e, 0x7b, 0x91, 0x8d, 0xc3, 0xac, 0x55,
-	0x3c, 0x5f, 0x1c, 0x98, 0xfa, 0xbe, 0x04, 0xa5, 0xc8, 0x94, 0x39, 0xf5, 0x5c, 0x5e, 0xde, 0xca,
-	0xaa, 0xd6, 0x33, 0x5c, 0x3d, 0xa2, 0x2c, 0x10, 0x09, 0x48, 0x77, 0x98, 0x81, 0x27, 0x21, 0x04,
-	0xae, 0xc9, 0xcd, 0x1a, 0xaa, 0x97, 0xdb, 0xa4, 0x60, 0x0c, 0xce, 0x9d, 0xe3, 0xd6, 0x3a, 0x26,
-	0x8c, 0x33, 0xcd, 0x68, 0xe0, 0x5e, 0x75, 0x21, 0xd3, 0x46, 0xbb, 0x94, 0xe3, 0x2f, 0x2e, 0x5b,
-	0x58, 0x9b, 0x78, 0x99, 0x29, 0xf7, 0x38, 0x10, 0x1d, 0x1a, 0x98, 0x6b, 0x56, 0x11, 0xf5, 0xc0,
-	0x05, 0x4e, 0x3b, 0x01, 0xf8, 0x64, 0xa6, 0x86, 0xea, 0xd3, 0x6d, 0xc2, 0xd4, 0x8e, 0x61, 0xec,
-	0x17, 0x84, 0xed, 0x0c, 0xb7, 0x9a, 0x78, 0x91, 0x29, 0xd7, 0x13, 0x9c, 0x83, 0xa7, 0xd3, 0x9e,
-	0x0b, 0x21, 0x36, 0xc2, 0x05, 0xa6, 0x5a, 0x03, 0xac, 0xd0, 0x6c, 0xe0, 0xbb, 0xb1, 0x02, 0x77,
-	0x38, 0x08, 0x6e, 0x08, 0x61, 0x07, 0xa4, 0xea, 0xb2, 0x88, 0x54, 0x8c, 0x6e, 0x29, 0x56, 0xd0,
-	0x2a, 0xc6, 0x62, 0x6f, 0x80, 0x56, 0x3f, 0x22, 0x5c, 0xbd, 0xfe, 0x12, 0xac, 0x5b, 0xb8, 0xfc,
-	0x0e, 0x7a, 0xf9, 0xa0, 0xa6, 0x9f, 0xd6, 0x4b, 0x3c, 0x99, 0xd0, 0x20, 0x06, 0x33, 0x92, 0x95,
-	0xe6, 0xc6, 0xbf, 0xdc, 0xfa, 0xd8, 0x0d, 0xda, 0x99, 0xcf, 0x93, 0x89, 0x75, 0xb4, 0xf2, 0x75,
-	0x02, 0x2f, 0x8e, 0x25, 0x59, 0x9f, 0x11, 0x26, 0x5e, 0xac, 0xb4, 0x08, 0xc7, 0x0c, 0x1e, 0x32,
-	0x83, 0xf7, 0xfa, 0xbf, 0x5b, 0x70, 0x5a, 0xc6, 0x79, 0xfc, 0xfc, 0x2d, 0x79, 0x63, 0xc1, 0xaa,
-	0xc4, 0xcb, 0x7f, 0x90, 0x8d, 0x49, 0x6c, 0x73, 0x34, 0xb1, 0xb9, 0xe6, 0xea, 0xe5, 0x9f, 0xcf,
-	0x3c, 0x32, 0x83, 0x0e, 0xc1, 0x3f, 0x4c, 0xa9, 0xaf, 0x7a, 0x11, 0x8c, 0xe4, 0xf3, 0xfc, 0xed,
-	0xc9, 0x99, 0x5d, 0x3a, 0x3d, 0xb3, 0x4b, 0x17, 0x67, 0x36, 0xfa, 0xd0, 0xb7, 0xd1, 0xb7, 0xbe,
-	0x8d, 0x7e, 0xf4, 0x6d, 0x74, 0xd2, 0xb7, 0xd1, 0xcf, 0xbe, 0x8d, 0x7e, 0xf5, 0xed, 0xd2, 0x45,
-	0xdf, 0x46, 0x5f, 0xce, 0xed, 0xd2, 0xc9, 0xb9, 0x5d, 0x3a, 0x3d, 0xb7, 0x4b, 0x6f, 0x1e, 0x1f,
-	0x8b, 0xe1, 0x5e, 0x4c, 0x5c, 0xff, 0x64, 0x3e, 0x1d, 0x59, 0x76, 0xa6, 0xcc, 0xfb, 0xf5, 0xe8,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7d, 0x0e, 0x79, 0x6b, 0x05, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0x1b, 0x45,
+	0x1c, 0xf7, 0xc4, 0xa4, 0x6d, 0xc6, 0x51, 0x1b, 0x26, 0x4a, 0x18, 0xb6, 0xea, 0xca, 0x44, 0xa0,
+	0xfa, 0x80, 0xd6, 0x8a, 0xe1, 0xd0, 0x00, 0x45, 0x2a, 0x56, 0x29, 0x39, 0x24, 0x48, 0x5b, 0xe8,
+	0x81, 0xcb, 0x6a, 0xbc, 0xfb, 0x8f, 0x3d, 0xb0, 0x3b, 0xb3, 0x9a, 0x99, 0x5d, 0x91, 0x1b, 0x08,
+	0x89, 0x2b, 0xbc, 0x00, 0xf7, 0x9e, 0x78, 0x0e, 0x8e, 0x39, 0xf6, 0x48, 0x9c, 0x0b, 0xc7, 0x3e,
+	0x02, 0xda, 0xd9, 0x0f, 0xdb, 0xd1, 0x1a, 0xa2, 0xde, 0xbc, 0xf3, 0xfb, 0x98, 0xff, 0xe7, 0x18,
+	0x1f, 0x19, 0x48, 0x52, 0xa9, 0x58, 0x3c, 0xd4, 0xa0, 0x72, 0x50, 0x43, 0x96, 0xf2, 0x61, 0x0a,
+	0x4a, 0x73, 0x6d, 0x40, 0x84, 0x30, 0xcc, 0x0f, 0x87, 0x61, 0x9c, 0x69, 0x03, 0x2a, 0x48, 0xc0,
+	0xb0, 0x88, 0x19, 0xe6, 0xa5, 0x4a, 0x1a, 0x49, 0x0e, 0x6a, 0xa9, 0x57, 0x4a, 0x3d, 0x96, 0x72,
+	0x6f, 0x49, 0xea, 0xe5, 0x87, 0x4e, 0xc3, 0xb1, 0xbe, 0x20, 0xb2, 0x44, 0x5b, 0x47, 0x99, 0x24,
+	0x52, 0x94, 0x3e, 0xce, 0x07, 0x2b, 0x9c, 0xbc, 0x30, 0x90, 0xa2, 0x60, 0x25, 0xa0, 0x35, 0x9b,
+	0x42, 0x49, 0x3b, 0xf8, 0xf3, 0x36, 0xbe, 0x37, 0x2e, 0x23, 0x39, 0xa9, 0x02, 0x21, 0xef, 0xe1,
+	0xed, 0x3a, 0x38, 0xc1, 0x12, 0xa0, 0xa8, 0x8f, 0x06, 0x5b, 0x7e, 0xaf, 0x3a, 0x3b, 0x65, 0x09,
+	0x10, 0x0f, 0xef, 0xce, 0xb8, 0x36, 0x52, 0x9d, 0x07, 0x7a, 0xc6, 0x54, 0x14, 0x84, 0x32, 0x13,
+	0x86, 0x6e, 0xf4, 0xd1, 0x60, 0xd3, 0x7f, 0xbb, 0x82, 0x9e, 0x17, 0xc8, 0xb8, 0x00, 0xc8, 0x03,
+	0x8c, 0x6b, 0x4b, 0x1e, 0xd1, 0xae, 0x35, 0xdc, 0xaa, 0x4e, 0x8e, 0x23, 0xf2, 0x0c, 0x6f, 0x57,
+	0x11, 0x06, 0x5c, 0x9c, 0x49, 0xfa, 0x56, 0x1f, 0x0d, 0x7a, 0xa3, 0xf7, 0xbd, 0xa6, 0x16, 0x45,
+	0x11, 0x2a, 0x86, 0x97, 0x1f, 0x7a, 0x2f, 0xca, 0x9f, 0xc7, 0xe2, 0x4c, 0xfa, 0xbd, 0x7c, 0xf1,
+	0x41, 0x7e, 0x45, 0xf8, 0x1d, 0x2e, 0x22, 0xf8, 0x31, 0xd0, 0xc0, 0x54, 0x38, 0x0b, 0x98, 0x31,
+	0x8a, 0x4f, 0x32, 0x03, 0x9a, 0x6e, 0xf6, 0xbb, 0x83, 0xde, 0xe8, 0xd4, 0xfb, 0xff, 0x02, 0x7b,
+	0xd7, 0x2a, 0xe2, 0x1d, 0x17, 0x96, 0xcf, 0xad, 0xe3, 0x93, 0xc6, 0xf0, 0xa9, 0x30, 0xea, 0xdc,
+	0xdf, 0xe3, 0x6d, 0x18, 0x79, 0x88, 0xef, 0xd5, 0x09, 0xb3, 0x28, 0x52, 0xa0, 0x35, 0xbd, 0x65,
+	0xb3, 0xbe, 0x5b, 0x1d, 0x3f, 0x29, 0x4f, 0xc9, 0x67, 0xd8, 0x39, 0x63, 0x3c, 0x96, 0x39, 0xa8,
+	0x60, 0x51, 0x83, 0x50, 0x41, 0x02, 0xc2, 0xd0, 0xdb, 0x7d, 0x34, 0xe8, 0xfa, 0xb4, 0x66, 0x34,
+	0x79, 0x57, 0x38, 0x79, 0x84, 0x29, 0x17, 0xdc, 0x70, 0x16, 0x07, 0xd7, 0x5d, 0xe8, 0x1d, 0xab,
+	0xdd, 0xaf, 0xf0, 0x2f, 0x57, 0x2d, 0xc8, 0x63, 0x7c, 0x9f, 0xeb, 0x60, 0x1a, 0xcb, 0x09, 0x8b,
+	0x6d, 0x9b, 0x75, 0xca, 0x42, 0x08, 0x40, 0xb0, 0x49, 0x0c, 0x11, 0xdd, 0xea, 0xa3, 0xc1, 0x1d,
+	0x9f, 0x72, 0xfd, 0xcc, 0x32, 0x4e, 0x6b, 0xc2, 0xd3, 0x12, 0x27, 0x23, 0xbc, 0xc7, 0x75, 0x10,
+	0x4a, 0x21, 0x20, 0x34, 0x45, 0xcc, 0xb5, 0x10, 0x5b, 0xe1, 0x2e, 0xd7, 0xe3, 0x06, 0xab, 0x35,
+	0x47, 0xf8, 0xdd, 0x4c, 0x43, 0xb0, 0x18, 0x84, 0x20, 0x81, 0x64, 0x02, 0x4a, 0xcf, 0x78, 0x4a,
+	0x7b, 0x56, 0xb7, 0x9f, 0x69, 0x18, 0xd7, 0x63, 0x71, 0xd2, 0xa0, 0xe4, 0x67, 0x84, 0x1f, 0xb4,
+	0x0c, 0x5c, 0x90, 0xf0, 0xa9, 0x62, 0xc5, 0x1d, 0x74, 0xdb, 0x8e, 0xcc, 0xe7, 0x37, 0xe9, 0xee,
+	0x57, 0xd7, 0xc7, 0xf3, 0xa4, 0x76, 0xf1, 0x9d, 0xd9, 0x5a, 0xcc, 0xf9, 0x05, 0x61, 0x67, 0xfd,
+	0x20, 0x90, 0x1d, 0xdc, 0xfd, 0x01, 0xce, 0xab, 0x65, 0x29, 0x7e, 0x92, 0xaf, 0xf1, 0x66, 0xce,
+	0xe2, 0x0c, 0xec, 0x5a, 0xf4, 0x46, 0x47, 0x37, 0x89, 0xad, 0xf5, 0x02, 0xbf, 0xf4, 0xf9, 0x64,
+	0xe3, 0x11, 0x3a, 0x78, 0x89, 0xb0, 0xb3, 0x3e, 0x01, 0xf2, 0x21, 0x26, 0x86, 0xa9, 0x29, 0x98,
+	0x95, 0xbd, 0x44, 0x76, 0x2f, 0x77, 0x4a, 0x64, 0x69, 0x2d, 0x3d, 0xbc, 0x5b, 0x55, 0x50, 0x4c,
+	0x2b, 0x01, 0x8f, 0x34, 0xdd, 0xe8, 0x77, 0x8b, 0x35, 0x6e, 0x20, 0xab, 0x38, 0x8e, 0x74, 0xe1,
+	0x5e, 0x1e, 0x42, 0xb4, 0x44, 0xef, 0x5a, 0xfa, 0x4e, 0x8d, 0xd4, 0xec, 0x83, 0x3f, 0x36, 0xf0,
+	0x5e, 0x6b, 0x3e, 0xe4, 0x37, 0x84, 0x69, 0x98, 0x69, 0x23, 0x93, 0x96, 0x3d, 0x45, 0x76, 0x4f,
+	0xbf, 0x7d, 0xe3, 0x6a, 0x79, 0x63, 0xeb, 0xdc, 0xbe, 0xae, 0xfb, 0x61, 0x2b, 0xe8, 0x28, 0x7c,
+	0xff, 0x3f, 0x64, 0x2d, 0xcd, 0x7d, 0xbc, 0xdc, 0xdc, 0xbb, 0xa3, 0x87, 0xab, 0x6f, 0x95, 0x7d,
+	0x93, 0x9b, 0x08, 0x21, 0x7a, 0x51, 0x50, 0xbf, 0x39, 0x4f, 0x61, 0xa9, 0x95, 0x5f, 0x7c, 0x7f,
+	0x71, 0xe9, 0x76, 0x5e, 0x5d, 0xba, 0x9d, 0xd7, 0x97, 0x2e, 0xfa, 0x69, 0xee, 0xa2, 0x97, 0x73,
+	0x17, 0xfd, 0x35, 0x77, 0xd1, 0xc5, 0xdc, 0x45, 0x7f, 0xcf, 0x5d, 0xf4, 0xcf, 0xdc, 0xed, 0xbc,
+	0x9e, 0xbb, 0xe8, 0xf7, 0x2b, 0xb7, 0x73, 0x71, 0xe5, 0x76, 0x5e, 0x5d, 0xb9, 0x9d, 0xef, 0x3e,
+	0x9e, 0xca, 0xc5, 0x5d, 0x5c, 0xae, 0xff, 0x87, 0xf9, 0x74, 0xe9, 0x73, 0x72, 0xcb, 0x3e, 0xf7,
+	0x1f, 0xfd, 0x3b, 0x00, 0x65, 0x64, 0xa1, 0xb0, 0x9a, 0x06, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -333,6 +411,49 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if this.UseClusterIdMembership != that1.UseClusterIdMembership {
 		return false
 	}
+	if !this.HistoryShardCountMigration.Equal(that1.HistoryShardCountMigration) {
+		return false
+	}
+	return true
+}
+func (this *HistoryShardCountMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryShardCountMigration)
+	if !ok {
+		that2, ok := that.(HistoryShardCountMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TargetShardCount != that1.TargetShardCount {
+		return false
+	}
+	if len(this.MigratingShardIds) != len(that1.MigratingShardIds) {
+		return false
+	}
+	for i := range this.MigratingShardIds {
+		if this.MigratingShardIds[i] != that1.MigratingShardIds[i] {
+			return false
+		}
+	}
+	if len(this.MigratedShardIds) != len(that1.MigratedShardIds) {
+		return false
+	}
+	for i := range this.MigratedShardIds {
+		if this.MigratedShardIds[i] != that1.MigratedShardIds[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *IndexSearchAttributes) Equal(that interface{}) bool {
@@ -368,7 +489,7 @@ func (this *ClusterMetadata) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&persistence.ClusterMetadata{")
 	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
@@ -395,6 +516,21 @@ func (this *ClusterMetadata) GoString() string {
 	s = append(s, "IsGlobalNamespaceEnabled: "+fmt.Sprintf("%#v", this.IsGlobalNamespaceEnabled)+",\n")
 	s = append(s, "IsConnectionEnabled: "+fmt.Sprintf("%#v", this.IsConnectionEnabled)+",\n")
 	s = append(s, "UseClusterIdMembership: "+fmt.Sprintf("%#v", this.UseClusterIdMembership)+",\n")
+	if this.HistoryShardCountMigration != nil {
+		s = append(s, "HistoryShardCountMigration: "+fmt.Sprintf("%#v", this.HistoryShardCountMigration)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryShardCountMigration) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.HistoryShardCountMigration{")
+	s = append(s, "TargetShardCount: "+fmt.Sprintf("%#v", this.TargetShardCount)+",\n")
+	s = append(s, "MigratingShardIds: "+fmt.Sprintf("%#v", this.MigratingShardIds)+",\n")
+	s = append(s, "MigratedShardIds: "+fmt.Sprintf("%#v", this.MigratedShardIds)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -448,6 +584,18 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryShardCountMigration != nil {
+		{
+			size, err := m.HistoryShardCountMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.UseClusterIdMembership {
 		i--
 		if m.UseClusterIdMembership {
@@ -555,6 +703,72 @@ func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryShardCountMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryShardCountMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryShardCountMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigratedShardIds) > 0 {
+		dAtA5 := make([]byte, len(m.MigratedShardIds)*10)
+		var j4 int
+		for _, num1 := range m.MigratedShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MigratingShardIds) > 0 {
+		dAtA7 := make([]byte, len(m.MigratingShardIds)*10)
+		var j6 int
+		for _, num1 := range m.MigratingShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TargetShardCount != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.TargetShardCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IndexSearchAttributes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -659,6 +873,36 @@ func (m *ClusterMetadata) Size() (n int) {
 	if m.UseClusterIdMembership {
 		n += 2
 	}
+	if m.HistoryShardCountMigration != nil {
+		l = m.HistoryShardCountMigration.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *HistoryShardCountMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetShardCount != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.TargetShardCount))
+	}
+	if len(m.MigratingShardIds) > 0 {
+		l = 0
+		for _, e := range m.MigratingShardIds {
+			l += sovClusterMetadata(uint64(e))
+		}
+		n += 1 + sovClusterMetadata(uint64(l)) + l
+	}
+	if len(m.MigratedShardIds) > 0 {
+		l = 0
+		for _, e := range m.MigratedShardIds {
+			l += sovClusterMetadata(uint64(e))
+		}
+		n += 1 + sovClusterMetadata(uint64(l)) + l
+	}
 	return n
 }
 
@@ -711,6 +955,19 @@ func (this *ClusterMetadata) String() string {
 		`IsGlobalNamespaceEnabled:` + fmt.Sprintf("%v", this.IsGlobalNamespaceEnabled) + `,`,
 		`IsConnectionEnabled:` + fmt.Sprintf("%v", this.IsConnectionEnabled) + `,`,
 		`UseClusterIdMembership:` + fmt.Sprintf("%v", this.UseClusterIdMembership) + `,`,
+		`HistoryShardCountMigration:` + strings.Replace(this.HistoryShardCountMigration.String(), "HistoryShardCountMigration", "HistoryShardCountMigration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryShardCountMigration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryShardCountMigration{`,
+		`TargetShardCount:` + fmt.Sprintf("%v", this.TargetShardCount) + `,`,
+		`MigratingShardIds:` + fmt.Sprintf("%v", this.MigratingShardIds) + `,`,
+		`MigratedShardIds:` + fmt.Sprintf("%v", this.MigratedShardIds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1150,6 +1407,266 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseClusterIdMembership = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCountMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistoryShardCountMigration == nil {
+				m.HistoryShardCountMigration = &HistoryShardCountMigration{}
+			}
+			if err := m.HistoryShardCountMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryShardCountMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryShardCountMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryShardCountMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetShardCount", wireType)
+			}
+			m.TargetShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MigratingShardIds = append(m.MigratingShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterMetadata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClusterMetadata
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MigratingShardIds) == 0 {
+					m.MigratingShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MigratingShardIds = append(m.MigratingShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratingShardIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MigratedShardIds = append(m.MigratedShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClusterMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClusterMetadata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClusterMetadata
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MigratedShardIds) == 0 {
+					m.MigratedShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClusterMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MigratedShardIds = append(m.MigratedShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedShardIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
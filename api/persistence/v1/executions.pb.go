@@ -245,6 +245,16 @@ type WorkflowExecutionInfo struct {
 	CloseTime                    *time.Time             `protobuf:"bytes,66,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
 	CloseVisibilityTaskCompleted bool                   `protobuf:"varint,67,opt,name=close_visibility_task_completed,json=closeVisibilityTaskCompleted,proto3" json:"close_visibility_task_completed,omitempty"`
 	BaseExecutionInfo            *v15.BaseExecutionInfo `protobuf:"bytes,76,opt,name=base_execution_info,json=baseExecutionInfo,proto3" json:"base_execution_info,omitempty"`
+	// Set while the workflow execution is paused, no workflow or activity task is dispatched
+	// and user timers are not fired until the execution is unpaused.
+	PauseInfo *WorkflowPauseInfo `protobuf:"bytes,77,opt,name=pause_info,json=pauseInfo,proto3" json:"pause_info,omitempty"`
+	// Delivered when the workflow execution closes without continuing as a new run.
+	CompletionCallbacks []*v15.CompletionCallbackInfo `protobuf:"bytes,78,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
+	// Set when the first workflow task of the run starts.
+	BuildIdAssignment *BuildIdAssignment `protobuf:"bytes,79,opt,name=build_id_assignment,json=buildIdAssignment,proto3" json:"build_id_assignment,omitempty"`
+	// Priority of the workflow and activity tasks of the run set in the header of the start request,
+	// zero if not set.
+	TaskPriority int32 `protobuf:"varint,80,opt,name=task_priority,json=taskPriority,proto3" json:"task_priority,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -741,6 +751,93 @@ func (m *WorkflowExecutionInfo) GetBaseExecutionInfo() *v15.BaseExecutionInfo {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetPauseInfo() *WorkflowPauseInfo {
+	if m != nil {
+		return m.PauseInfo
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetCompletionCallbacks() []*v15.CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetBuildIdAssignment() *BuildIdAssignment {
+	if m != nil {
+		return m.BuildIdAssignment
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetTaskPriority() int32 {
+	if m != nil {
+		return m.TaskPriority
+	}
+	return 0
+}
+
+type WorkflowPauseInfo struct {
+	PauseTime *time.Time `protobuf:"bytes,1,opt,name=pause_time,json=pauseTime,proto3,stdtime" json:"pause_time,omitempty"`
+	Identity  string     `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason    string     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *WorkflowPauseInfo) Reset()      { *m = WorkflowPauseInfo{} }
+func (*WorkflowPauseInfo) ProtoMessage() {}
+func (*WorkflowPauseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+}
+func (m *WorkflowPauseInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowPauseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowPauseInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowPauseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowPauseInfo.Merge(m, src)
+}
+func (m *WorkflowPauseInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowPauseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowPauseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowPauseInfo proto.InternalMessageInfo
+
+func (m *WorkflowPauseInfo) GetPauseTime() *time.Time {
+	if m != nil {
+		return m.PauseTime
+	}
+	return nil
+}
+
+func (m *WorkflowPauseInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkflowPauseInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -748,7 +845,7 @@ type ExecutionStats struct {
 func (m *ExecutionStats) Reset()      { *m = ExecutionStats{} }
 func (*ExecutionStats) ProtoMessage() {}
 func (*ExecutionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+	return fileDescriptor_67a714d0e7ba9f37, []int{3}
 }
 func (m *ExecutionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +892,7 @@ type WorkflowExecutionState struct {
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+	return fileDescriptor_67a714d0e7ba9f37, []int{4}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,13 +969,14 @@ type TransferTaskInfo struct {
 	DeleteAfterClose bool `protobuf:"varint,15,opt,name=delete_after_close,json=deleteAfterClose,proto3" json:"delete_after_close,omitempty"`
 	// Types that are valid to be assigned to TaskDetails:
 	//	*TransferTaskInfo_CloseExecutionTaskDetails_
+	//	*TransferTaskInfo_CompletionCallbackTaskDetails_
 	TaskDetails isTransferTaskInfo_TaskDetails `protobuf_oneof:"task_details"`
 }
 
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
 func (*TransferTaskInfo) ProtoMessage() {}
 func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5}
 }
 func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,8 +1015,12 @@ type isTransferTaskInfo_TaskDetails interface {
 type TransferTaskInfo_CloseExecutionTaskDetails_ struct {
 	CloseExecutionTaskDetails *TransferTaskInfo_CloseExecutionTaskDetails `protobuf:"bytes,16,opt,name=close_execution_task_details,json=closeExecutionTaskDetails,proto3,oneof" json:"close_execution_task_details,omitempty"`
 }
+type TransferTaskInfo_CompletionCallbackTaskDetails_ struct {
+	CompletionCallbackTaskDetails *TransferTaskInfo_CompletionCallbackTaskDetails `protobuf:"bytes,17,opt,name=completion_callback_task_details,json=completionCallbackTaskDetails,proto3,oneof" json:"completion_callback_task_details,omitempty"`
+}
 
-func (*TransferTaskInfo_CloseExecutionTaskDetails_) isTransferTaskInfo_TaskDetails() {}
+func (*TransferTaskInfo_CloseExecutionTaskDetails_) isTransferTaskInfo_TaskDetails()     {}
+func (*TransferTaskInfo_CompletionCallbackTaskDetails_) isTransferTaskInfo_TaskDetails() {}
 
 func (m *TransferTaskInfo) GetTaskDetails() isTransferTaskInfo_TaskDetails {
 	if m != nil {
@@ -1032,10 +1134,18 @@ func (m *TransferTaskInfo) GetCloseExecutionTaskDetails() *TransferTaskInfo_Clos
 	return nil
 }
 
+func (m *TransferTaskInfo) GetCompletionCallbackTaskDetails() *TransferTaskInfo_CompletionCallbackTaskDetails {
+	if x, ok := m.GetTaskDetails().(*TransferTaskInfo_CompletionCallbackTaskDetails_); ok {
+		return x.CompletionCallbackTaskDetails
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TransferTaskInfo) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TransferTaskInfo_CloseExecutionTaskDetails_)(nil),
+		(*TransferTaskInfo_CompletionCallbackTaskDetails_)(nil),
 	}
 }
 
@@ -1050,7 +1160,7 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) Reset() {
 }
 func (*TransferTaskInfo_CloseExecutionTaskDetails) ProtoMessage() {}
 func (*TransferTaskInfo_CloseExecutionTaskDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4, 0}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5, 0}
 }
 func (m *TransferTaskInfo_CloseExecutionTaskDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1086,6 +1196,52 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) GetCanSkipVisibilityArchiva
 	return false
 }
 
+type TransferTaskInfo_CompletionCallbackTaskDetails struct {
+	// Index of the callback in the completion callbacks of the workflow execution.
+	CallbackIndex int32 `protobuf:"varint,1,opt,name=callback_index,json=callbackIndex,proto3" json:"callback_index,omitempty"`
+}
+
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) Reset() {
+	*m = TransferTaskInfo_CompletionCallbackTaskDetails{}
+}
+func (*TransferTaskInfo_CompletionCallbackTaskDetails) ProtoMessage() {}
+func (*TransferTaskInfo_CompletionCallbackTaskDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{5, 1}
+}
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTaskInfo_CompletionCallbackTaskDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTaskInfo_CompletionCallbackTaskDetails.Merge(m, src)
+}
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTaskInfo_CompletionCallbackTaskDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTaskInfo_CompletionCallbackTaskDetails proto.InternalMessageInfo
+
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) GetCallbackIndex() int32 {
+	if m != nil {
+		return m.CallbackIndex
+	}
+	return 0
+}
+
 // replication column
 type ReplicationTaskInfo struct {
 	NamespaceId       string      `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1106,7 +1262,7 @@ type ReplicationTaskInfo struct {
 func (m *ReplicationTaskInfo) Reset()      { *m = ReplicationTaskInfo{} }
 func (*ReplicationTaskInfo) ProtoMessage() {}
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{5}
+	return fileDescriptor_67a714d0e7ba9f37, []int{6}
 }
 func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1399,7 @@ type VisibilityTaskInfo struct {
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
 func (*VisibilityTaskInfo) ProtoMessage() {}
 func (*VisibilityTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{6}
+	return fileDescriptor_67a714d0e7ba9f37, []int{7}
 }
 func (m *VisibilityTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1358,12 +1514,17 @@ type TimerTaskInfo struct {
 	BranchToken         []byte                 `protobuf:"bytes,12,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
 	// If this is true, we can bypass archival before deleting. Only defined for DeleteHistoryEventTasks.
 	AlreadyArchived bool `protobuf:"varint,13,opt,name=already_archived,json=alreadyArchived,proto3" json:"already_archived,omitempty"`
+	// Index of the callback in the completion callbacks of the workflow execution.
+	// Only defined for CompletionCallbackBackoffTasks.
+	CallbackIndex int32 `protobuf:"varint,14,opt,name=callback_index,json=callbackIndex,proto3" json:"callback_index,omitempty"`
+	// Stamp of the activity the task is generated for. Only defined for ActivityRetryTimerTasks.
+	Stamp int32 `protobuf:"varint,15,opt,name=stamp,proto3" json:"stamp,omitempty"`
 }
 
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
 func (*TimerTaskInfo) ProtoMessage() {}
 func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{7}
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
 }
 func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1483,6 +1644,20 @@ func (m *TimerTaskInfo) GetAlreadyArchived() bool {
 	return false
 }
 
+func (m *TimerTaskInfo) GetCallbackIndex() int32 {
+	if m != nil {
+		return m.CallbackIndex
+	}
+	return 0
+}
+
+func (m *TimerTaskInfo) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
 type ArchivalTaskInfo struct {
 	TaskId         int64       `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	NamespaceId    string      `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1496,7 +1671,7 @@ type ArchivalTaskInfo struct {
 func (m *ArchivalTaskInfo) Reset()      { *m = ArchivalTaskInfo{} }
 func (*ArchivalTaskInfo) ProtoMessage() {}
 func (*ArchivalTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *ArchivalTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1611,12 +1786,16 @@ type ActivityInfo struct {
 	ScheduledEventId            int64          `protobuf:"varint,30,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
 	LastHeartbeatDetails        *v12.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	// Incremented when the activity is reset, so that retry timer tasks generated before are ignored.
+	Stamp int32 `protobuf:"varint,33,opt,name=stamp,proto3" json:"stamp,omitempty"`
+	// Priority of the activity tasks set in the header of the schedule command, zero if not set.
+	TaskPriority int32 `protobuf:"varint,34,opt,name=task_priority,json=taskPriority,proto3" json:"task_priority,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,6 +2027,20 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetStamp() int32 {
+	if m != nil {
+		return m.Stamp
+	}
+	return 0
+}
+
+func (m *ActivityInfo) GetTaskPriority() int32 {
+	if m != nil {
+		return m.TaskPriority
+	}
+	return 0
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version        int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1861,7 +2054,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2137,7 @@ type ChildExecutionInfo struct {
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2068,7 +2261,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2136,7 +2329,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2396,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{14}
+	return fileDescriptor_67a714d0e7ba9f37, []int{15}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2263,7 +2456,7 @@ type UpdateInfo struct {
 func (m *UpdateInfo) Reset()      { *m = UpdateInfo{} }
 func (*UpdateInfo) ProtoMessage() {}
 func (*UpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{15}
+	return fileDescriptor_67a714d0e7ba9f37, []int{16}
 }
 func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2346,10 +2539,12 @@ func init() {
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterType((*WorkflowPauseInfo)(nil), "temporal.server.api.persistence.v1.WorkflowPauseInfo")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
 	proto.RegisterType((*TransferTaskInfo_CloseExecutionTaskDetails)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo.CloseExecutionTaskDetails")
+	proto.RegisterType((*TransferTaskInfo_CompletionCallbackTaskDetails)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo.CompletionCallbackTaskDetails")
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "temporal.server.api.persistence.v1.ReplicationTaskInfo")
 	proto.RegisterType((*VisibilityTaskInfo)(nil), "temporal.server.api.persistence.v1.VisibilityTaskInfo")
 	proto.RegisterType((*TimerTaskInfo)(nil), "temporal.server.api.persistence.v1.TimerTaskInfo")
@@ -2368,238 +2563,253 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x73, 0xdb, 0x46,
-	0x74, 0xa2, 0x05, 0x89, 0xe0, 0x23, 0x45, 0x41, 0xd0, 0x17, 0xa4, 0xc8, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0xd8, 0x54, 0x24, 0x39, 0x75, 0x3e, 0xda, 0xb8, 0x92, 0x2c, 0xdb, 0x64, 0x1c, 0xdb, 0x81,
-	0x94, 0x38, 0x93, 0x26, 0xc3, 0x81, 0x80, 0x95, 0x84, 0x8a, 0x04, 0x68, 0x00, 0x94, 0xcc, 0x4c,
-	0x0f, 0x39, 0x74, 0x72, 0x4e, 0x6f, 0x9d, 0xe9, 0x1f, 0xe8, 0xb1, 0x97, 0xde, 0x7b, 0xe8, 0xa1,
-	0xa7, 0x4e, 0x6e, 0xcd, 0x2d, 0x8d, 0x73, 0xe9, 0xa5, 0x93, 0x4c, 0x7f, 0x41, 0x67, 0xdf, 0xee,
-	0xe2, 0x8b, 0x90, 0x44, 0x39, 0xf1, 0x21, 0x37, 0x62, 0xdf, 0xc7, 0xbe, 0x7d, 0xfb, 0xf6, 0x7d,
-	0x12, 0xd6, 0x03, 0xd2, 0xee, 0xb8, 0x9e, 0xd1, 0x5a, 0xf1, 0x89, 0x77, 0x4c, 0xbc, 0x15, 0xa3,
-	0x63, 0xaf, 0x74, 0x88, 0xe7, 0xdb, 0x7e, 0x40, 0x1c, 0x93, 0xac, 0x1c, 0xaf, 0xae, 0x90, 0xe7,
-	0xc4, 0xec, 0x06, 0xb6, 0xeb, 0xf8, 0xb5, 0x8e, 0xe7, 0x06, 0xae, 0x5a, 0x15, 0x44, 0x35, 0x46,
-	0x54, 0x33, 0x3a, 0x76, 0x2d, 0x46, 0x54, 0x3b, 0x5e, 0x9d, 0xaf, 0x1c, 0xb8, 0xee, 0x41, 0x8b,
-	0xac, 0x20, 0xc5, 0x5e, 0x77, 0x7f, 0xc5, 0xea, 0x7a, 0x06, 0x65, 0xc2, 0x78, 0xcc, 0x2f, 0xa6,
-	0xe1, 0x81, 0xdd, 0x26, 0x7e, 0x60, 0xb4, 0x3b, 0x1c, 0xe1, 0x8a, 0x45, 0x3a, 0xc4, 0xb1, 0x88,
-	0x63, 0xda, 0xc4, 0x5f, 0x39, 0x70, 0x0f, 0x5c, 0x5c, 0xc7, 0x5f, 0x1c, 0xe5, 0x6a, 0x28, 0x3c,
-	0x95, 0xda, 0x74, 0xdb, 0x6d, 0xd7, 0xa1, 0x02, 0xb7, 0x89, 0xef, 0x1b, 0x07, 0x24, 0x13, 0x8b,
-	0x38, 0xdd, 0xb6, 0x4f, 0x91, 0x4e, 0x5c, 0xef, 0x68, 0xbf, 0xe5, 0x9e, 0x70, 0xac, 0x6b, 0x09,
-	0xac, 0x7d, 0xc3, 0x6e, 0x75, 0x3d, 0xd2, 0xcf, 0xec, 0x8d, 0x04, 0x9a, 0xe0, 0xd1, 0x8f, 0xf7,
-	0x56, 0x96, 0x5e, 0xcd, 0x96, 0x6b, 0x1e, 0xf5, 0xe3, 0x5e, 0xcf, 0xc2, 0x0d, 0xe5, 0x64, 0xc7,
-	0xe2, 0xa8, 0x6f, 0x9f, 0x89, 0x9a, 0x3a, 0xd2, 0x9b, 0x67, 0x22, 0x07, 0x86, 0x7f, 0xc4, 0x11,
-	0xdf, 0x1d, 0x88, 0x6b, 0x93, 0x52, 0x34, 0x83, 0x5e, 0x47, 0xc8, 0x7d, 0x23, 0x8b, 0xec, 0xd0,
-	0xf6, 0x03, 0xd7, 0xeb, 0xf5, 0x9f, 0x72, 0x65, 0x00, 0x4b, 0x7b, 0xd6, 0x25, 0x5d, 0xc2, 0xad,
-	0x6c, 0xfe, 0x66, 0x16, 0xc1, 0xa9, 0x1a, 0xaf, 0x7e, 0x97, 0x87, 0xc2, 0xce, 0xa1, 0xe1, 0x59,
-	0x75, 0x67, 0xdf, 0x55, 0xe7, 0x40, 0xf6, 0xe9, 0x47, 0xd3, 0xb6, 0xb4, 0xdc, 0x52, 0x6e, 0x79,
-	0x44, 0xcf, 0xe3, 0x77, 0xdd, 0xa2, 0x20, 0xcf, 0x70, 0x0e, 0x08, 0x05, 0x5d, 0x5a, 0xca, 0x2d,
-	0x0f, 0xeb, 0x79, 0xfc, 0xae, 0x5b, 0xea, 0x14, 0x8c, 0xb8, 0x27, 0x0e, 0xf1, 0xb4, 0xe1, 0xa5,
-	0xdc, 0x72, 0x41, 0x67, 0x1f, 0xea, 0x0d, 0x50, 0xfd, 0xc0, 0x6d, 0x11, 0xa7, 0xe9, 0xdb, 0x8e,
-	0x49, 0x9a, 0x1e, 0x71, 0xc8, 0x89, 0x36, 0x8a, 0x5c, 0x15, 0x06, 0xd9, 0xa1, 0x00, 0x9d, 0xae,
-	0xab, 0x1b, 0x50, 0xec, 0x76, 0x2c, 0x23, 0x20, 0x4d, 0x6a, 0xd1, 0x5a, 0x7e, 0x29, 0xb7, 0x5c,
-	0x5c, 0x9b, 0xaf, 0x31, 0x73, 0xaf, 0x09, 0x73, 0xaf, 0xed, 0x0a, 0x73, 0xdf, 0x94, 0xbe, 0xff,
-	0x69, 0x31, 0xa7, 0x03, 0x23, 0xa2, 0xcb, 0xea, 0xdf, 0xe7, 0x60, 0xce, 0x23, 0x9d, 0x96, 0x6d,
-	0xe2, 0x8b, 0x69, 0x5a, 0xad, 0x67, 0x4d, 0xc3, 0x3c, 0x6a, 0xb6, 0xc8, 0x31, 0x69, 0x69, 0x63,
-	0x4b, 0xc3, 0xcb, 0xc5, 0xb5, 0x7a, 0xed, 0xfc, 0x47, 0x58, 0x0b, 0xf5, 0x51, 0xd3, 0x23, 0x76,
-	0x77, 0x5b, 0xcf, 0x36, 0xcc, 0xa3, 0x87, 0x94, 0xd7, 0xb6, 0x13, 0x78, 0x3d, 0x7d, 0xc6, 0xcb,
-	0x04, 0xaa, 0x47, 0xa0, 0xe0, 0x85, 0x44, 0x7b, 0xfb, 0x9a, 0x82, 0x9b, 0x6f, 0x5c, 0x6c, 0xf3,
-	0x4f, 0x29, 0x17, 0xc1, 0xd6, 0x67, 0x9b, 0x96, 0x9f, 0x25, 0x16, 0x55, 0x03, 0x4a, 0x6c, 0x33,
-	0x3f, 0x30, 0x02, 0xe2, 0x6b, 0x13, 0xb8, 0xd1, 0x47, 0x2f, 0xb1, 0xd1, 0x0e, 0x32, 0x60, 0xbb,
-	0x14, 0x9f, 0x45, 0x2b, 0xf3, 0x75, 0x78, 0xed, 0x0c, 0x35, 0xa8, 0x0a, 0x0c, 0x1f, 0x91, 0x1e,
-	0x5a, 0x4b, 0x41, 0xa7, 0x3f, 0xa9, 0x39, 0x1c, 0x1b, 0xad, 0x2e, 0xe1, 0x66, 0xc2, 0x3e, 0x3e,
-	0xb8, 0xf4, 0x5e, 0x6e, 0x3e, 0x80, 0xc9, 0x8c, 0x43, 0xc5, 0x59, 0x8c, 0x30, 0x16, 0xf7, 0xe3,
-	0x2c, 0x8a, 0x6b, 0xab, 0x83, 0x9c, 0x27, 0xc1, 0x39, 0xbe, 0xab, 0x03, 0x4a, 0xfa, 0x84, 0x19,
-	0x5b, 0xde, 0x4d, 0x6e, 0x59, 0x1b, 0x78, 0x4b, 0x64, 0x1b, 0xdb, 0xaf, 0x21, 0xc9, 0x92, 0x32,
-	0xd2, 0x90, 0xe4, 0x11, 0x65, 0xb4, 0x21, 0xc9, 0xb2, 0x52, 0x68, 0x48, 0x72, 0x41, 0x81, 0x86,
-	0x24, 0x83, 0x52, 0x6c, 0x48, 0x72, 0x51, 0x29, 0x35, 0x24, 0xb9, 0xa4, 0x8c, 0x35, 0x24, 0xb9,
-	0xac, 0x8c, 0x37, 0x24, 0x79, 0x5c, 0x51, 0xaa, 0xff, 0x74, 0x15, 0xa6, 0x9f, 0xf2, 0x67, 0xba,
-	0x2d, 0x42, 0x07, 0x3e, 0xca, 0x2b, 0x50, 0x72, 0x8c, 0x36, 0xf1, 0x3b, 0x86, 0x49, 0xc4, 0xc3,
-	0x2c, 0xe8, 0xc5, 0x70, 0xad, 0x6e, 0xa9, 0x8b, 0x50, 0x0c, 0xfd, 0x0d, 0x7f, 0x9f, 0x05, 0x1d,
-	0xc4, 0x52, 0xdd, 0x52, 0x6b, 0x30, 0xd9, 0x31, 0x3c, 0xe2, 0x04, 0xcd, 0x04, 0x2b, 0xf6, 0x60,
-	0x27, 0x18, 0xe8, 0x51, 0x8c, 0xe1, 0x0d, 0x50, 0x39, 0x7e, 0x9c, 0xaf, 0x84, 0xe8, 0x0a, 0x83,
-	0x3c, 0x8d, 0xb8, 0x57, 0x61, 0x8c, 0x63, 0x7b, 0x5d, 0x87, 0x22, 0x8e, 0x30, 0x11, 0xd9, 0xa2,
-	0xde, 0x75, 0x12, 0x12, 0xd8, 0x8e, 0x1d, 0xd8, 0x46, 0x40, 0xd0, 0xcb, 0x8c, 0xa2, 0x8d, 0x70,
-	0x09, 0xea, 0x02, 0x52, 0xb7, 0xd4, 0xf7, 0x61, 0xce, 0x74, 0xdb, 0x9d, 0x16, 0xc1, 0xb7, 0x4c,
-	0x8e, 0x29, 0xe5, 0x9e, 0x11, 0x98, 0x87, 0x94, 0x2a, 0x8f, 0x54, 0x33, 0x11, 0xc2, 0x36, 0x85,
-	0x6f, 0x52, 0x70, 0xdd, 0x52, 0x2f, 0x03, 0xa0, 0xd3, 0x45, 0x2b, 0xd6, 0x0a, 0x28, 0x4b, 0x81,
-	0xae, 0xe0, 0x7d, 0xd1, 0xb3, 0x45, 0xce, 0xb9, 0xd7, 0x21, 0xa8, 0x12, 0x0d, 0xd8, 0xd9, 0x04,
-	0x64, 0xb7, 0xd7, 0x21, 0x54, 0x21, 0xea, 0xd7, 0x30, 0x1f, 0x62, 0x87, 0x21, 0x1d, 0x9d, 0x94,
-	0xdb, 0x0d, 0xb4, 0x22, 0x1a, 0xcb, 0x5c, 0x9f, 0x9f, 0xba, 0xcb, 0xc3, 0xf6, 0xa6, 0xf4, 0x8f,
-	0xd4, 0x4d, 0x69, 0x27, 0xe9, 0x9b, 0xdd, 0x65, 0x0c, 0xd4, 0x4f, 0x61, 0x2a, 0x64, 0x4f, 0x95,
-	0x27, 0x18, 0x97, 0x06, 0x63, 0x1c, 0x9e, 0x44, 0xef, 0x86, 0x2c, 0xf7, 0xe0, 0xb2, 0x45, 0xf6,
-	0x8d, 0x6e, 0x2b, 0x76, 0x79, 0x2c, 0x08, 0x71, 0xde, 0x63, 0x83, 0xf1, 0x9e, 0xe7, 0x5c, 0xc4,
-	0x45, 0xef, 0x1a, 0xfe, 0x91, 0xd8, 0xe3, 0x6d, 0x50, 0x5b, 0x86, 0x1f, 0xf0, 0x7b, 0x41, 0xee,
-	0xb6, 0xa5, 0x4d, 0xe0, 0xb5, 0x8c, 0x53, 0x08, 0x5e, 0x08, 0xa5, 0xa8, 0x5b, 0xea, 0x4d, 0x98,
-	0x44, 0xe4, 0x7d, 0xdb, 0x0b, 0x49, 0x6c, 0x4b, 0x53, 0x11, 0x5b, 0xa1, 0xa0, 0x7b, 0x14, 0x82,
-	0x24, 0x75, 0x4b, 0xfd, 0x18, 0x5e, 0x47, 0xf4, 0xa4, 0xf0, 0x7e, 0x60, 0x78, 0xd4, 0x66, 0x42,
-	0xf2, 0x49, 0x24, 0xaf, 0x50, 0xd4, 0xb8, 0x84, 0x3b, 0x0c, 0x4f, 0x30, 0xbb, 0x03, 0x80, 0x94,
-	0x2c, 0xac, 0x4c, 0x0d, 0x18, 0x56, 0x0a, 0x48, 0x83, 0x51, 0xa5, 0x01, 0x28, 0x61, 0x33, 0x1e,
-	0x9d, 0xa6, 0x07, 0x64, 0x53, 0xa6, 0x94, 0x9f, 0x45, 0x11, 0x6a, 0x0d, 0xa6, 0x93, 0x87, 0x3a,
-	0xa6, 0xfe, 0xc4, 0x75, 0xb4, 0x19, 0x3c, 0xcb, 0xe4, 0x49, 0xec, 0x1c, 0x9f, 0x33, 0x90, 0x7a,
-	0x0f, 0x96, 0x52, 0x8a, 0x30, 0x0f, 0x89, 0xd5, 0x6d, 0xc5, 0x55, 0x31, 0x8b, 0xe4, 0x0b, 0x71,
-	0xf2, 0x1d, 0x81, 0x25, 0x14, 0xb1, 0x09, 0x95, 0x73, 0x14, 0xaa, 0x21, 0x97, 0xf9, 0x93, 0xd3,
-	0x95, 0xb9, 0x93, 0x96, 0x5f, 0x58, 0xd4, 0xdc, 0x60, 0x16, 0x95, 0x38, 0xa0, 0x30, 0xa5, 0x3e,
-	0xa5, 0x18, 0x01, 0x75, 0xbd, 0x81, 0x36, 0x8f, 0xce, 0x39, 0x41, 0xb3, 0xc1, 0x40, 0x89, 0x47,
-	0x99, 0x38, 0x0c, 0x5e, 0xcf, 0x6b, 0x03, 0x5e, 0xcf, 0x6c, 0xc6, 0x51, 0xf1, 0x9e, 0x0c, 0x58,
-	0x38, 0x4d, 0xe7, 0xb8, 0xc1, 0xc2, 0x80, 0x1b, 0xcc, 0x65, 0xde, 0x08, 0x6e, 0xe1, 0xc1, 0xb5,
-	0xe4, 0x16, 0xae, 0x67, 0x1f, 0xd8, 0x8e, 0xd1, 0x4a, 0xef, 0x55, 0x19, 0x70, 0xaf, 0x2b, 0xf1,
-	0xbd, 0x1e, 0x73, 0x66, 0xc9, 0x3d, 0x6f, 0x83, 0x96, 0xdc, 0xd3, 0x23, 0xcf, 0xba, 0xc4, 0xc7,
-	0xcb, 0x5f, 0x44, 0xf7, 0x37, 0x1d, 0x67, 0xa2, 0x33, 0x68, 0xdd, 0x52, 0xbf, 0x8a, 0x7b, 0x4c,
-	0x91, 0xce, 0x6a, 0x77, 0x97, 0x72, 0xcb, 0xe5, 0x53, 0x02, 0x25, 0xa6, 0xc1, 0x34, 0x44, 0x26,
-	0x9c, 0x47, 0xaf, 0x43, 0x62, 0x1e, 0x96, 0xaf, 0xa8, 0x8f, 0xd3, 0xaa, 0xf0, 0xbb, 0x07, 0x07,
-	0x54, 0x2c, 0xd3, 0x75, 0x02, 0xdb, 0xa1, 0x99, 0x94, 0xdf, 0xa4, 0xb9, 0xe3, 0xf6, 0x52, 0x6e,
-	0x59, 0xd6, 0x97, 0x12, 0x4a, 0x65, 0xa8, 0x5b, 0x1c, 0x73, 0xc3, 0x7f, 0x44, 0x4e, 0xfa, 0x9f,
-	0x0c, 0xcf, 0xae, 0x9b, 0xbe, 0xfd, 0x0d, 0x69, 0xee, 0xf5, 0x68, 0xa2, 0x74, 0xaf, 0xff, 0xc9,
-	0x3c, 0x60, 0x58, 0x3b, 0xf6, 0x37, 0x64, 0x93, 0xe2, 0xa8, 0xd7, 0x41, 0x31, 0x0d, 0xc7, 0x24,
-	0x2d, 0xa1, 0x28, 0x62, 0x69, 0x97, 0x51, 0x86, 0x71, 0xb6, 0xae, 0x8b, 0x65, 0xf5, 0x2d, 0x98,
-	0x48, 0xa2, 0x52, 0x9d, 0x2e, 0xa1, 0x4e, 0x93, 0xb8, 0x75, 0xc4, 0xf5, 0x03, 0xdb, 0x3c, 0xea,
-	0x35, 0x63, 0x51, 0xea, 0x0a, 0xc3, 0x65, 0x80, 0xdd, 0x30, 0x56, 0x1d, 0xc0, 0x12, 0xc7, 0x15,
-	0x66, 0xd1, 0x0c, 0xdc, 0x66, 0xe4, 0xd1, 0xe8, 0xe3, 0xab, 0x0e, 0xf6, 0xf8, 0x16, 0x18, 0x23,
-	0x61, 0x12, 0xbb, 0xee, 0x8e, 0xf0, 0x71, 0xf4, 0x15, 0x6a, 0x90, 0x17, 0xef, 0xee, 0x75, 0x96,
-	0xf8, 0xf3, 0x4f, 0xf5, 0x33, 0x98, 0xf1, 0x48, 0xe0, 0xf5, 0x78, 0xdc, 0x6e, 0x35, 0x6d, 0x27,
-	0x20, 0xde, 0xb1, 0xd1, 0xd2, 0xae, 0x0e, 0xb6, 0xf1, 0x14, 0x92, 0xb3, 0xd8, 0xde, 0xaa, 0x73,
-	0xe2, 0x88, 0x6d, 0xdb, 0x78, 0x6e, 0xb7, 0xbb, 0xed, 0x88, 0xed, 0xb5, 0x8b, 0xb0, 0xfd, 0x84,
-	0x51, 0x87, 0x6c, 0x6f, 0xa5, 0xd9, 0xf2, 0x63, 0xf8, 0xda, 0x1b, 0x78, 0xac, 0x04, 0x15, 0x77,
-	0x27, 0xbe, 0xfa, 0x01, 0xad, 0x1c, 0x28, 0xd5, 0x9e, 0x61, 0x1e, 0xb9, 0xfb, 0xfb, 0x4d, 0xd3,
-	0x25, 0xfb, 0xfb, 0xb6, 0x69, 0x13, 0x27, 0xd0, 0xde, 0x5c, 0xca, 0x2d, 0xe7, 0xf4, 0x59, 0x44,
-	0xd8, 0x64, 0xf0, 0xad, 0x08, 0xac, 0xb6, 0xa1, 0x9a, 0x91, 0x20, 0x90, 0xe7, 0x1d, 0x9b, 0x89,
-	0xcb, 0x9e, 0xf1, 0xf2, 0x80, 0xcf, 0x78, 0xb1, 0x2f, 0x53, 0xd8, 0x0e, 0x39, 0xe1, 0x23, 0xbe,
-	0x0b, 0x8b, 0x4c, 0x54, 0xc7, 0x75, 0x9a, 0xf8, 0xcb, 0xd8, 0x6b, 0x91, 0x26, 0xf1, 0x3c, 0xd7,
-	0xc3, 0x77, 0xe9, 0x6b, 0xd7, 0x97, 0x86, 0x97, 0x0b, 0xfa, 0x6b, 0x08, 0x7c, 0xe4, 0x3a, 0xba,
-	0x40, 0xda, 0xa6, 0x38, 0xf4, 0xc9, 0xf9, 0xea, 0x32, 0x28, 0x87, 0x86, 0xcf, 0xe8, 0x9b, 0x1d,
-	0xb7, 0x65, 0x9b, 0x3d, 0xed, 0x2d, 0x34, 0xed, 0xf2, 0xa1, 0xe1, 0x23, 0xc5, 0x13, 0x5c, 0x55,
-	0x5f, 0x87, 0x31, 0xd3, 0x73, 0x9d, 0xd0, 0xfe, 0xb4, 0xb7, 0xd1, 0x52, 0x4b, 0x74, 0x51, 0xd8,
-	0x12, 0x4d, 0x51, 0x7d, 0xfb, 0x80, 0x7a, 0x2f, 0xd3, 0xed, 0x3a, 0x81, 0x56, 0xc3, 0xd7, 0x55,
-	0x64, 0x6b, 0x5b, 0x74, 0x49, 0xbd, 0x06, 0x65, 0xc3, 0x0c, 0xec, 0x63, 0x3b, 0xe8, 0x71, 0xa4,
-	0xfb, 0x88, 0x34, 0x26, 0x56, 0x19, 0xda, 0x1a, 0x4c, 0x9b, 0x87, 0x76, 0xcb, 0x8a, 0xa9, 0x92,
-	0x61, 0x3f, 0x60, 0x21, 0x12, 0x81, 0xa1, 0x6e, 0x18, 0xcd, 0x32, 0x28, 0x5d, 0x9f, 0x78, 0xa8,
-	0x68, 0x8f, 0xa3, 0xd7, 0x11, 0xbd, 0x4c, 0xd7, 0xa9, 0xda, 0x3c, 0x86, 0xb9, 0x01, 0x97, 0xc5,
-	0xfb, 0xe4, 0xcf, 0x95, 0x3c, 0x0f, 0x88, 0x17, 0x09, 0xde, 0x60, 0x31, 0x90, 0x23, 0x6d, 0x21,
-	0xce, 0x36, 0x47, 0x09, 0x05, 0xe4, 0x47, 0x4d, 0x91, 0x7e, 0xcc, 0x04, 0x64, 0xc0, 0x24, 0xcd,
-	0xa7, 0x30, 0x61, 0x74, 0x03, 0xb7, 0xe9, 0x11, 0x9f, 0x04, 0xcd, 0x8e, 0x6b, 0x3b, 0x81, 0xaf,
-	0xad, 0xa3, 0x45, 0x5c, 0x8b, 0xdc, 0x27, 0xf5, 0x9b, 0x61, 0x2f, 0xe2, 0x78, 0xb5, 0xa6, 0x53,
-	0xec, 0x27, 0x88, 0xac, 0x8f, 0x53, 0xfa, 0xd8, 0x82, 0xfa, 0x77, 0x30, 0xe1, 0x13, 0xc3, 0x33,
-	0x0f, 0xa9, 0x81, 0x7b, 0xf6, 0x5e, 0x97, 0x3a, 0xb5, 0x5b, 0x58, 0xfd, 0x3d, 0x1e, 0xa4, 0x74,
-	0xc9, 0x2c, 0x35, 0x6a, 0x3b, 0xc8, 0x72, 0x23, 0xe4, 0xc8, 0xca, 0x41, 0xc5, 0x4f, 0x2d, 0xab,
-	0x4f, 0x41, 0x6a, 0x93, 0xb6, 0xab, 0xbd, 0x8b, 0x1b, 0x6e, 0xbd, 0xfc, 0x86, 0x9f, 0x90, 0xb6,
-	0xcb, 0x36, 0x41, 0x86, 0xea, 0xd7, 0x30, 0xc1, 0x73, 0x22, 0xee, 0xb4, 0x6d, 0xe2, 0x6b, 0x7f,
-	0x81, 0x9a, 0x7a, 0x27, 0x73, 0x17, 0xee, 0xda, 0xe9, 0x0e, 0x3c, 0x63, 0x7a, 0x20, 0xe8, 0x74,
-	0xe5, 0x38, 0xb5, 0xa2, 0xae, 0xc3, 0x0c, 0x4f, 0x42, 0x43, 0xeb, 0xe2, 0x15, 0xcb, 0x6d, 0xb4,
-	0xea, 0x49, 0x84, 0x86, 0x22, 0xb2, 0xca, 0xe5, 0x6f, 0x60, 0x3c, 0x42, 0xa7, 0x75, 0xb6, 0xaf,
-	0xbd, 0x87, 0x12, 0xad, 0x0d, 0x72, 0xee, 0x90, 0x19, 0xad, 0x13, 0x7d, 0xbd, 0x4c, 0x12, 0xdf,
-	0x89, 0x54, 0x83, 0x8a, 0x92, 0xf6, 0x1b, 0xef, 0x5f, 0x34, 0xd5, 0xd0, 0xbb, 0x69, 0x8f, 0x71,
-	0x0b, 0x66, 0xfb, 0xd2, 0xef, 0xe0, 0x39, 0x9e, 0xfa, 0x03, 0x66, 0xb3, 0xc9, 0x14, 0x7c, 0xf7,
-	0x39, 0x3d, 0xf5, 0x2d, 0x98, 0xc1, 0x9e, 0x42, 0x33, 0xf0, 0x0c, 0xc7, 0xb7, 0x63, 0x2f, 0xf1,
-	0x43, 0x24, 0x9a, 0x42, 0xe8, 0x6e, 0x08, 0x64, 0x96, 0x7e, 0x1f, 0xca, 0xc9, 0x22, 0x49, 0xfb,
-	0xcb, 0x01, 0x0f, 0x30, 0x46, 0xe2, 0xa5, 0x91, 0xba, 0x02, 0x53, 0x0e, 0x39, 0xe9, 0xbf, 0xa7,
-	0xbf, 0x62, 0x15, 0xab, 0x43, 0x4e, 0x52, 0xb7, 0xf4, 0x10, 0x4a, 0xbc, 0xbe, 0xc4, 0x7e, 0xa1,
-	0xf6, 0x11, 0xee, 0x7b, 0x3d, 0xf3, 0x8a, 0x10, 0x83, 0x99, 0x8c, 0x19, 0xb8, 0xde, 0x16, 0xfd,
-	0x14, 0xd5, 0x2a, 0x7e, 0xa8, 0xef, 0x81, 0xd6, 0x57, 0xad, 0x8a, 0x64, 0xfd, 0x0e, 0x2b, 0x3e,
-	0x53, 0x25, 0xab, 0xc8, 0xd7, 0xd7, 0x61, 0xc6, 0x6c, 0xb9, 0x3e, 0xd7, 0xdb, 0x3e, 0x75, 0x4b,
-	0xbc, 0x3a, 0xfa, 0x6b, 0xee, 0xc1, 0x28, 0x74, 0x97, 0x03, 0x79, 0x85, 0x74, 0x1b, 0x34, 0x46,
-	0x74, 0x6c, 0xfb, 0xf6, 0x9e, 0xdd, 0xa2, 0x4e, 0x52, 0x90, 0x6d, 0x20, 0xd9, 0x34, 0xc2, 0x3f,
-	0x0f, 0xc1, 0x9c, 0xf0, 0x0e, 0x00, 0xdf, 0x8d, 0xea, 0x7a, 0x73, 0xd0, 0xf2, 0x86, 0xc9, 0x40,
-	0xf5, 0xbc, 0x0d, 0x8b, 0xd9, 0x3b, 0xf3, 0xda, 0x9a, 0x58, 0xda, 0x16, 0xc6, 0x85, 0x85, 0x0c,
-	0x01, 0xb6, 0x04, 0x8e, 0xba, 0x07, 0x93, 0x7b, 0x86, 0x4f, 0x62, 0xf7, 0x65, 0x3b, 0xfb, 0xae,
-	0xf6, 0xf0, 0x8c, 0x77, 0x12, 0x77, 0x75, 0x9b, 0x86, 0x4f, 0x12, 0x8e, 0x41, 0x9f, 0xd8, 0x4b,
-	0x2f, 0xcd, 0x5b, 0x30, 0x9d, 0xe9, 0x9f, 0x32, 0x5a, 0x50, 0xef, 0x26, 0x9b, 0x39, 0x8b, 0x49,
-	0x27, 0xcb, 0x7b, 0xc3, 0xc7, 0xab, 0xb5, 0x27, 0x46, 0xaf, 0xe5, 0x1a, 0x56, 0xbc, 0x5b, 0xf4,
-	0x05, 0x14, 0x42, 0xa7, 0xf4, 0x87, 0x72, 0x0e, 0x7b, 0x41, 0x61, 0xcf, 0xa7, 0x21, 0xc9, 0x8a,
-	0x32, 0xd1, 0x90, 0xe4, 0x1b, 0xca, 0xcd, 0x86, 0x24, 0xdf, 0x54, 0x6a, 0x0d, 0x49, 0x5e, 0x51,
-	0xde, 0x69, 0x48, 0xf2, 0x3b, 0xca, 0x6a, 0x43, 0x92, 0x57, 0x95, 0xb5, 0x86, 0x24, 0xaf, 0x29,
-	0xeb, 0xd5, 0x75, 0x28, 0x27, 0x1d, 0x09, 0x0d, 0xb9, 0xf1, 0xb4, 0x16, 0x65, 0x1c, 0xd6, 0x8b,
-	0x87, 0x51, 0x12, 0x5b, 0xfd, 0x35, 0x07, 0x33, 0x7d, 0x6e, 0x17, 0xdb, 0x55, 0x98, 0xaf, 0x7a,
-	0x84, 0x3e, 0xef, 0x58, 0xbe, 0x9a, 0xe3, 0xf9, 0x2a, 0x02, 0xa2, 0x7c, 0x75, 0x1a, 0x46, 0xf9,
-	0xe3, 0x63, 0x7d, 0xa5, 0x11, 0x0f, 0x1f, 0x5c, 0x03, 0x46, 0xd0, 0x05, 0x60, 0x13, 0xa9, 0xbc,
-	0x76, 0x6b, 0xb0, 0x3a, 0x20, 0x29, 0x87, 0xce, 0x58, 0xa8, 0xf7, 0x60, 0x94, 0xfe, 0xe8, 0xfa,
-	0xd8, 0x62, 0x4a, 0x14, 0x15, 0xe7, 0x73, 0xe9, 0xfa, 0x3a, 0xa7, 0xae, 0xfe, 0xdf, 0x28, 0x28,
-	0x89, 0xa7, 0xf5, 0x47, 0xf5, 0xcf, 0x22, 0x1d, 0x0c, 0xc7, 0x75, 0xb0, 0x05, 0x85, 0xa8, 0x1e,
-	0x62, 0xa2, 0xbf, 0x71, 0xb6, 0x1e, 0xc2, 0x3a, 0x48, 0x0e, 0x44, 0xfd, 0x53, 0x83, 0xc9, 0xc0,
-	0xf0, 0x0e, 0x48, 0xaa, 0x37, 0xc7, 0x7a, 0x68, 0x13, 0x0c, 0x94, 0xea, 0xcd, 0x71, 0xfc, 0xb8,
-	0xcc, 0xa3, 0xac, 0x7f, 0xc5, 0x20, 0xc9, 0xde, 0x1c, 0xc7, 0xe6, 0x07, 0xc8, 0xb3, 0xe3, 0xb3,
-	0x45, 0xe6, 0x3b, 0x93, 0x0d, 0x33, 0x39, 0xdd, 0x30, 0xfb, 0x10, 0xe6, 0x39, 0x0b, 0x96, 0x9a,
-	0x85, 0xdb, 0xba, 0x4e, 0xab, 0x87, 0xfd, 0x35, 0x59, 0x9f, 0x65, 0x18, 0x5b, 0x14, 0x41, 0xec,
-	0xfe, 0xd8, 0x69, 0xf5, 0x70, 0x0c, 0xd0, 0xdf, 0xb1, 0x00, 0xd6, 0xfb, 0xf1, 0xd3, 0x5d, 0x0a,
-	0x0d, 0xf2, 0xc2, 0xcd, 0x16, 0xd9, 0x90, 0x81, 0x7f, 0xaa, 0xb3, 0x90, 0x17, 0x1e, 0xb1, 0x84,
-	0x90, 0xd1, 0x80, 0xb9, 0xc0, 0x3a, 0x8c, 0xc7, 0x7d, 0x17, 0xf5, 0x83, 0x63, 0x83, 0xf6, 0x67,
-	0x22, 0x42, 0x74, 0x86, 0x37, 0x40, 0xb5, 0x08, 0x75, 0x68, 0x4d, 0x63, 0x3f, 0xa0, 0xa9, 0x24,
-	0x75, 0x79, 0xda, 0x38, 0x1e, 0x50, 0x61, 0x90, 0x0d, 0x0a, 0xd8, 0xa2, 0xeb, 0xea, 0x3f, 0xe4,
-	0x80, 0x39, 0xc5, 0x78, 0x5f, 0x90, 0x8a, 0x68, 0x91, 0xc0, 0xb0, 0xb1, 0xeb, 0x4f, 0xc5, 0x78,
-	0x34, 0x48, 0x96, 0x90, 0x36, 0xda, 0x1a, 0x6e, 0x11, 0x75, 0x0b, 0x0d, 0xff, 0xe8, 0x2e, 0xe3,
-	0xfa, 0x60, 0x48, 0x9f, 0x33, 0x4f, 0x03, 0xce, 0x7f, 0x05, 0x73, 0xa7, 0x52, 0xaa, 0x77, 0x60,
-	0xc1, 0x34, 0x9c, 0xa6, 0x7f, 0x64, 0x77, 0xe2, 0xee, 0x9e, 0xba, 0x54, 0x9b, 0x16, 0x5e, 0x39,
-	0x3c, 0xe8, 0x9c, 0x69, 0x38, 0x3b, 0x47, 0x76, 0x27, 0x72, 0xf5, 0x1b, 0x1c, 0x61, 0xb3, 0x0c,
-	0xa5, 0xf8, 0x01, 0x99, 0x2f, 0xab, 0xfe, 0xab, 0x04, 0x93, 0xb1, 0x09, 0xc1, 0x9f, 0xe6, 0xdd,
-	0xc5, 0x6c, 0x6d, 0x24, 0x69, 0x6b, 0x57, 0xa1, 0x9c, 0xea, 0x55, 0xb2, 0x36, 0x75, 0x69, 0x3f,
-	0xde, 0xa7, 0xac, 0xc2, 0x98, 0x43, 0x9e, 0xc7, 0x90, 0x58, 0x57, 0xba, 0x48, 0x17, 0x05, 0x4e,
-	0xb6, 0xf5, 0xcb, 0xa7, 0x58, 0xff, 0x15, 0x28, 0xed, 0x79, 0x86, 0x63, 0x1e, 0x36, 0x03, 0xf7,
-	0x88, 0xb0, 0x27, 0x50, 0xd2, 0x8b, 0x6c, 0x6d, 0x97, 0x2e, 0x89, 0xbc, 0x88, 0x2a, 0x25, 0x81,
-	0x3a, 0x86, 0xa8, 0x34, 0x2f, 0xd2, 0xbb, 0xce, 0x66, 0x8c, 0x20, 0xf6, 0x6e, 0xc6, 0xcf, 0x7b,
-	0x37, 0xca, 0x4b, 0xbe, 0x9b, 0x05, 0x00, 0x21, 0x14, 0xef, 0x02, 0x17, 0x74, 0x99, 0x89, 0x52,
-	0xb7, 0x52, 0xd3, 0x8f, 0x70, 0xee, 0x51, 0xfd, 0xdf, 0x61, 0x50, 0x53, 0x09, 0xcd, 0x9f, 0xdb,
-	0x6c, 0x62, 0xaa, 0x1e, 0x3d, 0x4f, 0xd5, 0xf9, 0x97, 0x54, 0x75, 0x32, 0xe1, 0x93, 0x2f, 0x9e,
-	0xf0, 0x25, 0x1b, 0xe2, 0x85, 0x8b, 0x37, 0xc4, 0xcf, 0xca, 0x55, 0xe1, 0x8c, 0x5c, 0xb5, 0xfa,
-	0xab, 0x04, 0x63, 0x58, 0x8b, 0xff, 0x69, 0xae, 0x7a, 0x1b, 0x4a, 0xbc, 0xc9, 0xc6, 0xf8, 0x8c,
-	0x20, 0x9f, 0xea, 0x29, 0xc9, 0x09, 0x6f, 0xa5, 0x21, 0x8f, 0x62, 0x10, 0x7d, 0xa8, 0x24, 0xd6,
-	0xe1, 0x16, 0x0d, 0x26, 0xe4, 0x37, 0x8a, 0xfc, 0x56, 0x07, 0xcb, 0x9c, 0x78, 0xeb, 0x09, 0xd9,
-	0x87, 0x4d, 0xf1, 0xd8, 0x62, 0xdc, 0x30, 0xf3, 0x49, 0xc3, 0xbc, 0x0e, 0xa1, 0xaf, 0x09, 0xbb,
-	0xeb, 0x32, 0xb6, 0xc3, 0xc6, 0xc5, 0xba, 0xe8, 0xac, 0xcf, 0x81, 0x1c, 0xba, 0xa9, 0x02, 0xe3,
-	0x42, 0xb8, 0x77, 0x8a, 0x99, 0x37, 0x9c, 0x67, 0xde, 0xc5, 0x97, 0x34, 0xef, 0xb4, 0x07, 0x2c,
-	0xf5, 0x7b, 0xc0, 0xeb, 0xa0, 0x18, 0x2d, 0x8f, 0x18, 0x96, 0x88, 0x5c, 0xc4, 0x42, 0xef, 0x27,
-	0xeb, 0xe3, 0x7c, 0x7d, 0x83, 0x2f, 0x57, 0xff, 0xe5, 0x12, 0x28, 0x22, 0x78, 0x85, 0x46, 0x17,
-	0x3b, 0x46, 0x2e, 0x71, 0x8c, 0xb4, 0x35, 0x5e, 0x3a, 0xd7, 0x1a, 0x87, 0xcf, 0xb0, 0x46, 0xe9,
-	0x54, 0x6b, 0x1c, 0xf9, 0xfd, 0x8e, 0x67, 0x34, 0x79, 0xbf, 0x7f, 0x9c, 0x7f, 0xa9, 0x7e, 0x57,
-	0x86, 0xd2, 0x06, 0xef, 0xc8, 0xa1, 0xba, 0x62, 0xbb, 0xe6, 0x92, 0xbb, 0xde, 0x06, 0x2d, 0x1d,
-	0xdb, 0xc2, 0x01, 0x2d, 0x1b, 0xfd, 0x4f, 0x27, 0x23, 0x9c, 0x98, 0xcf, 0xde, 0x87, 0x72, 0x6a,
-	0xc8, 0x21, 0x0d, 0xda, 0x24, 0xf0, 0x13, 0x03, 0x8d, 0x65, 0x50, 0xfa, 0xa6, 0x58, 0xcc, 0x27,
-	0x97, 0xfd, 0xe4, 0xe4, 0x6a, 0x0b, 0x4a, 0x89, 0x11, 0xd1, 0xa0, 0xea, 0x29, 0xfa, 0xb1, 0xb1,
-	0xd0, 0x22, 0x14, 0xc3, 0x16, 0x26, 0x8f, 0xe2, 0x05, 0x1d, 0xc4, 0x12, 0xcb, 0xa3, 0x63, 0xe5,
-	0x14, 0x1f, 0x3c, 0x7b, 0x61, 0x21, 0xf5, 0x25, 0xcc, 0x9d, 0xde, 0xc5, 0x87, 0xc1, 0xba, 0xde,
-	0x33, 0x7e, 0x76, 0xff, 0x3e, 0xc5, 0x3b, 0x8a, 0x11, 0x17, 0x98, 0x52, 0xc7, 0x78, 0x6f, 0x89,
-	0x78, 0x41, 0x79, 0xef, 0x62, 0x2b, 0x88, 0xca, 0x9a, 0x66, 0x3c, 0xe0, 0x94, 0x7a, 0x92, 0x45,
-	0x8f, 0x24, 0xd7, 0x87, 0x30, 0x71, 0x48, 0x0c, 0x2f, 0xd8, 0x23, 0x46, 0x70, 0xd1, 0xd1, 0xb4,
-	0x12, 0x52, 0x0a, 0x6e, 0x59, 0xb3, 0x9a, 0xf2, 0x05, 0x66, 0x35, 0x2c, 0x37, 0xca, 0x9a, 0xd5,
-	0xb0, 0xae, 0xb2, 0x98, 0x32, 0xd2, 0x1a, 0x55, 0x61, 0xae, 0x33, 0x10, 0xb1, 0x8c, 0x15, 0xa1,
-	0xf1, 0x11, 0xca, 0x44, 0x72, 0x84, 0x92, 0xac, 0xaf, 0xd4, 0x74, 0x7d, 0x75, 0x3d, 0x32, 0x63,
-	0xdb, 0x22, 0x4e, 0x60, 0x07, 0x3d, 0x9c, 0x6e, 0xe3, 0x3c, 0x08, 0xd7, 0xeb, 0x7c, 0x39, 0xb3,
-	0x6f, 0x3f, 0x95, 0xd9, 0xb7, 0x3f, 0x7d, 0x6c, 0x33, 0xfd, 0x6a, 0xc6, 0x36, 0x33, 0xaf, 0x66,
-	0x6c, 0x33, 0x7b, 0xc6, 0xd8, 0x66, 0x17, 0xa6, 0x19, 0x55, 0xba, 0x6b, 0xaa, 0x0d, 0xf8, 0xbc,
-	0x27, 0x91, 0x3c, 0xd5, 0x2f, 0x3d, 0x73, 0x18, 0x34, 0x77, 0xf6, 0x30, 0x68, 0x80, 0xe9, 0xcc,
-	0xfc, 0xf9, 0xd3, 0x99, 0x47, 0xa0, 0x32, 0x2e, 0xac, 0x6f, 0xcb, 0xfe, 0x55, 0xc9, 0xc7, 0xda,
-	0x4b, 0xc9, 0xec, 0x83, 0x03, 0x69, 0xc8, 0xb8, 0xc7, 0x7e, 0xea, 0x0a, 0xd2, 0x3e, 0x34, 0xfc,
-	0x80, 0xaf, 0xd0, 0x02, 0x3e, 0xc6, 0x8f, 0x86, 0x2b, 0xe2, 0x45, 0xa6, 0xb6, 0x80, 0xa6, 0x36,
-	0x1b, 0x52, 0x3d, 0x45, 0x78, 0x68, 0x72, 0xd9, 0x25, 0x4c, 0xe5, 0x94, 0x12, 0xe6, 0x73, 0x98,
-	0xc1, 0x4d, 0xa2, 0xa7, 0x2d, 0xaa, 0xe1, 0xc5, 0x2c, 0xf1, 0xfb, 0x1a, 0x66, 0xbe, 0x3e, 0x45,
-	0xe9, 0x1f, 0x08, 0x72, 0x51, 0xbb, 0x7e, 0x0d, 0xf3, 0x29, 0xbe, 0xf1, 0x3f, 0x64, 0x2c, 0x0d,
-	0x3a, 0xf1, 0x4f, 0xf0, 0x8e, 0xfe, 0x99, 0xd1, 0x90, 0xe4, 0x61, 0x45, 0x6a, 0x48, 0xf2, 0xa8,
-	0x92, 0x6f, 0x48, 0xf2, 0x65, 0xa5, 0x52, 0xfd, 0xcf, 0x1c, 0x14, 0x30, 0x5b, 0x3d, 0x27, 0x0a,
-	0x66, 0xc5, 0xa0, 0x4b, 0x99, 0x31, 0x68, 0x03, 0x8a, 0x68, 0xa7, 0x3c, 0x42, 0x0f, 0x0f, 0xfa,
-	0x17, 0x47, 0x46, 0x24, 0x22, 0x50, 0xdc, 0x11, 0x49, 0xb8, 0x0f, 0xfa, 0x16, 0xee, 0x83, 0xe6,
-	0x40, 0x66, 0xfe, 0x2a, 0x6c, 0x20, 0xe5, 0xf1, 0xbb, 0x6e, 0x55, 0xff, 0x4b, 0x02, 0x75, 0x2b,
-	0x31, 0x3d, 0x3b, 0x3f, 0xbe, 0x47, 0xcd, 0xef, 0xec, 0xf8, 0x1e, 0xc2, 0x13, 0xf1, 0x3d, 0x4b,
-	0x25, 0xc3, 0x99, 0x2a, 0xa9, 0xc1, 0xa4, 0xc0, 0x8c, 0xe7, 0x55, 0xbc, 0xf5, 0xc5, 0x41, 0xb1,
-	0x66, 0xd6, 0x55, 0x10, 0x1c, 0x44, 0xb1, 0xc9, 0xda, 0x5e, 0x22, 0xb8, 0xb3, 0x76, 0x56, 0x66,
-	0x73, 0x53, 0xce, 0x6e, 0x6e, 0x2e, 0x40, 0x21, 0x4c, 0xf0, 0x44, 0xc4, 0x0e, 0x17, 0x2e, 0xf8,
-	0x57, 0xb1, 0x2f, 0xc2, 0xbf, 0xb8, 0xb1, 0x28, 0xc9, 0xfd, 0x73, 0x11, 0xf3, 0xbd, 0xe5, 0x53,
-	0xaa, 0x86, 0x27, 0x62, 0xea, 0xe0, 0x13, 0xe6, 0xb9, 0xc5, 0x9f, 0xe1, 0x62, 0x4b, 0x54, 0x8e,
-	0xf4, 0x55, 0x84, 0x7d, 0x30, 0x25, 0x79, 0x09, 0x38, 0x14, 0x18, 0x61, 0x33, 0x90, 0xb1, 0x8b,
-	0xce, 0x40, 0x18, 0x5d, 0x5f, 0x26, 0x5c, 0xee, 0xcb, 0x84, 0xc3, 0x3f, 0x39, 0xe6, 0x15, 0xb9,
-	0xfa, 0x6f, 0x39, 0x98, 0xd0, 0xe3, 0x13, 0xd3, 0x57, 0x65, 0x58, 0x99, 0x91, 0x7b, 0x38, 0xfb,
-	0x5f, 0x16, 0xd9, 0x2a, 0x93, 0xb2, 0x55, 0x56, 0xfd, 0xf7, 0x1c, 0xc0, 0x0e, 0x4e, 0x6e, 0x5f,
-	0x95, 0xec, 0xc9, 0xdc, 0x70, 0x38, 0x9d, 0x1b, 0x66, 0x8b, 0x9b, 0xcf, 0x16, 0x37, 0xf5, 0x17,
-	0x53, 0xe6, 0xb4, 0x64, 0xa5, 0x50, 0xfd, 0x36, 0x07, 0xf2, 0xd6, 0x21, 0x31, 0x8f, 0xfc, 0x6e,
-	0x3b, 0x7d, 0x88, 0x91, 0xe8, 0x10, 0x77, 0x61, 0x74, 0xbf, 0x65, 0x1c, 0xbb, 0x1e, 0x8a, 0x5c,
-	0x5e, 0xbb, 0x71, 0x76, 0x2d, 0x22, 0x38, 0xde, 0x43, 0x1a, 0x9d, 0xd3, 0x46, 0xff, 0xf3, 0x1d,
-	0xc6, 0x22, 0x8d, 0x7d, 0x54, 0x7f, 0xca, 0x01, 0x30, 0xc7, 0x8a, 0x9a, 0xb4, 0x40, 0x35, 0x4c,
-	0x93, 0x74, 0x02, 0x7a, 0x3d, 0x6c, 0xf4, 0x4d, 0x3c, 0x94, 0xa7, 0xb8, 0xb6, 0x7e, 0xde, 0x44,
-	0x97, 0xff, 0x23, 0x07, 0x4f, 0xfd, 0x84, 0x91, 0x3e, 0x18, 0xd2, 0x27, 0x22, 0x86, 0x7c, 0x51,
-	0xdd, 0x83, 0x89, 0x70, 0x5e, 0x15, 0x6e, 0x72, 0xe9, 0xf7, 0x6c, 0xa2, 0x84, 0xfc, 0xf8, 0xda,
-	0x66, 0x9e, 0x1f, 0x77, 0xf3, 0x6f, 0x7f, 0xf8, 0xb9, 0x32, 0xf4, 0xe3, 0xcf, 0x95, 0xa1, 0xdf,
-	0x7e, 0xae, 0xe4, 0xbe, 0x7d, 0x51, 0xc9, 0xfd, 0xf3, 0x8b, 0x4a, 0xee, 0x3f, 0x5e, 0x54, 0x72,
-	0x3f, 0xbc, 0xa8, 0xe4, 0xfe, 0xfb, 0x45, 0x25, 0xf7, 0x3f, 0x2f, 0x2a, 0x43, 0xbf, 0xbd, 0xa8,
-	0xe4, 0xbe, 0xff, 0xa5, 0x32, 0xf4, 0xc3, 0x2f, 0x95, 0xa1, 0x1f, 0x7f, 0xa9, 0x0c, 0x7d, 0x79,
-	0xeb, 0xc0, 0x8d, 0x24, 0xb1, 0xdd, 0xd3, 0xff, 0xce, 0xff, 0x61, 0xec, 0x73, 0x6f, 0x14, 0xc3,
-	0xc2, 0xfa, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xc5, 0x7e, 0xfc, 0x71, 0x32, 0x00, 0x00,
+	// 3936 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x82, 0x08, 0x92, 0x83, 0x07, 0x10, 0x1c, 0x0c, 0x3f, 0x34, 0x84, 0x29, 0x90, 0x82, 0x2d,
+	0x2f, 0x65, 0xcb, 0xa0, 0x45, 0xc9, 0x91, 0xd7, 0x4e, 0xd6, 0x21, 0x21, 0xca, 0x02, 0x56, 0x96,
+	0xe8, 0x21, 0xd7, 0xde, 0xda, 0xac, 0x0b, 0x35, 0x98, 0x69, 0x92, 0xb3, 0x04, 0x66, 0xa0, 0xe9,
+	0x01, 0x3f, 0xb6, 0x72, 0xd8, 0x43, 0x2a, 0x95, 0xe3, 0xe6, 0x96, 0x4b, 0xee, 0x39, 0xe4, 0x90,
+	0x4b, 0xee, 0x49, 0x55, 0x0e, 0x39, 0xa5, 0x7c, 0xcb, 0x56, 0x2e, 0x1b, 0xcb, 0x97, 0xe4, 0x90,
+	0xca, 0xfe, 0x84, 0xad, 0x7e, 0xdd, 0x3d, 0x98, 0x19, 0x0c, 0xc9, 0xa1, 0x6c, 0x1d, 0x7c, 0xc3,
+	0x74, 0xbf, 0xf7, 0xfa, 0xf5, 0xeb, 0xd7, 0xef, 0xb3, 0x01, 0xf7, 0x03, 0xd2, 0x1f, 0x78, 0xbe,
+	0xd9, 0x5b, 0xa7, 0xc4, 0x3f, 0x26, 0xfe, 0xba, 0x39, 0x70, 0xd6, 0x07, 0xc4, 0xa7, 0x0e, 0x0d,
+	0x88, 0x6b, 0x91, 0xf5, 0xe3, 0x7b, 0xeb, 0xe4, 0x94, 0x58, 0xc3, 0xc0, 0xf1, 0x5c, 0xda, 0x18,
+	0xf8, 0x5e, 0xe0, 0x69, 0x75, 0x89, 0xd4, 0xe0, 0x48, 0x0d, 0x73, 0xe0, 0x34, 0x22, 0x48, 0x8d,
+	0xe3, 0x7b, 0xd5, 0xda, 0x81, 0xe7, 0x1d, 0xf4, 0xc8, 0x3a, 0x62, 0x74, 0x87, 0xfb, 0xeb, 0xf6,
+	0xd0, 0x37, 0x19, 0x11, 0x4e, 0xa3, 0xba, 0x92, 0x9c, 0x0f, 0x9c, 0x3e, 0xa1, 0x81, 0xd9, 0x1f,
+	0x08, 0x80, 0x5b, 0x36, 0x19, 0x10, 0xd7, 0x26, 0xae, 0xe5, 0x10, 0xba, 0x7e, 0xe0, 0x1d, 0x78,
+	0x38, 0x8e, 0xbf, 0x04, 0xc8, 0x5b, 0x21, 0xf3, 0x8c, 0x6b, 0xcb, 0xeb, 0xf7, 0x3d, 0x97, 0x31,
+	0xdc, 0x27, 0x94, 0x9a, 0x07, 0x24, 0x15, 0x8a, 0xb8, 0xc3, 0x3e, 0x65, 0x40, 0x27, 0x9e, 0x7f,
+	0xb4, 0xdf, 0xf3, 0x4e, 0x04, 0xd4, 0xed, 0x18, 0xd4, 0xbe, 0xe9, 0xf4, 0x86, 0x3e, 0x19, 0x27,
+	0xf6, 0x76, 0x0c, 0x4c, 0xd2, 0x18, 0x87, 0x7b, 0x27, 0x4d, 0xae, 0x56, 0xcf, 0xb3, 0x8e, 0xc6,
+	0x61, 0xef, 0xa4, 0xc1, 0x86, 0x7c, 0xf2, 0x6d, 0x09, 0xd0, 0x77, 0x2f, 0x04, 0x4d, 0x6c, 0xe9,
+	0x47, 0x17, 0x02, 0x07, 0x26, 0x3d, 0x12, 0x80, 0x1f, 0x64, 0xa2, 0xda, 0x61, 0x18, 0x9d, 0xe0,
+	0x6c, 0x20, 0xf9, 0xbe, 0x9b, 0x86, 0x76, 0xe8, 0xd0, 0xc0, 0xf3, 0xcf, 0xc6, 0x77, 0xb9, 0x9e,
+	0x41, 0xd3, 0x5e, 0x0c, 0xc9, 0x90, 0x08, 0x2d, 0xab, 0x36, 0x32, 0x20, 0x30, 0x96, 0x24, 0xfc,
+	0x7b, 0x69, 0xf0, 0xe7, 0x9e, 0x50, 0xfd, 0xaf, 0xa7, 0xa1, 0xb0, 0x7b, 0x68, 0xfa, 0x76, 0xcb,
+	0xdd, 0xf7, 0xb4, 0x25, 0x50, 0x28, 0xfb, 0xe8, 0x38, 0xb6, 0x9e, 0x5b, 0xcd, 0xad, 0x4d, 0x1a,
+	0xd3, 0xf8, 0xdd, 0xb2, 0xd9, 0x94, 0x6f, 0xba, 0x07, 0x84, 0x4d, 0x5d, 0x5f, 0xcd, 0xad, 0x4d,
+	0x18, 0xd3, 0xf8, 0xdd, 0xb2, 0xb5, 0x79, 0x98, 0xf4, 0x4e, 0x5c, 0xe2, 0xeb, 0x13, 0xab, 0xb9,
+	0xb5, 0x82, 0xc1, 0x3f, 0xb4, 0xbb, 0xa0, 0xd1, 0xc0, 0xeb, 0x11, 0xb7, 0x43, 0x1d, 0xd7, 0x22,
+	0x1d, 0x9f, 0xb8, 0xe4, 0x44, 0x9f, 0x42, 0xaa, 0x2a, 0x9f, 0xd9, 0x65, 0x13, 0x06, 0x1b, 0xd7,
+	0x36, 0xa1, 0x38, 0x1c, 0xd8, 0x66, 0x40, 0x3a, 0xec, 0x06, 0xe8, 0xd3, 0xab, 0xb9, 0xb5, 0xe2,
+	0x46, 0xb5, 0xc1, 0xaf, 0x47, 0x43, 0x5e, 0x8f, 0xc6, 0x9e, 0xbc, 0x1e, 0x5b, 0xf9, 0xdf, 0xfe,
+	0x7e, 0x25, 0x67, 0x00, 0x47, 0x62, 0xc3, 0xda, 0x5f, 0xe5, 0x60, 0xc9, 0x27, 0x83, 0x9e, 0x63,
+	0xe1, 0x0d, 0xeb, 0xd8, 0xbd, 0x17, 0x1d, 0xd3, 0x3a, 0xea, 0xf4, 0xc8, 0x31, 0xe9, 0xe9, 0x33,
+	0xab, 0x13, 0x6b, 0xc5, 0x8d, 0x56, 0xe3, 0xf2, 0x4b, 0xdb, 0x08, 0xe5, 0xd1, 0x30, 0x46, 0xe4,
+	0x1e, 0xf5, 0x5e, 0x6c, 0x5a, 0x47, 0x4f, 0x19, 0xad, 0x6d, 0x37, 0xf0, 0xcf, 0x8c, 0x45, 0x3f,
+	0x75, 0x52, 0x3b, 0x02, 0x15, 0x0f, 0x70, 0xb4, 0x36, 0xd5, 0x55, 0x5c, 0x7c, 0xf3, 0x6a, 0x8b,
+	0x7f, 0xce, 0xa8, 0x48, 0xb2, 0x94, 0x2f, 0x5a, 0x7e, 0x11, 0x1b, 0xd4, 0x4c, 0x28, 0xf1, 0xc5,
+	0x68, 0x60, 0x06, 0x84, 0xea, 0x15, 0x5c, 0xe8, 0x27, 0xaf, 0xb0, 0xd0, 0x2e, 0x12, 0xe0, 0xab,
+	0x14, 0x5f, 0x8c, 0x46, 0xaa, 0x2d, 0x78, 0xe3, 0x02, 0x31, 0x68, 0x2a, 0x4c, 0x1c, 0x91, 0x33,
+	0xd4, 0x96, 0x82, 0xc1, 0x7e, 0x32, 0x75, 0x38, 0x36, 0x7b, 0x43, 0x22, 0xd4, 0x84, 0x7f, 0x7c,
+	0x74, 0xfd, 0xc3, 0x5c, 0x35, 0x80, 0xb9, 0x94, 0x4d, 0x45, 0x49, 0x4c, 0x72, 0x12, 0x9f, 0x46,
+	0x49, 0x14, 0x37, 0xee, 0x65, 0xd9, 0x4f, 0x8c, 0x72, 0x74, 0x55, 0x17, 0xd4, 0xe4, 0x0e, 0x53,
+	0x96, 0x7c, 0x14, 0x5f, 0xb2, 0x91, 0x79, 0x49, 0x24, 0x1b, 0x59, 0xaf, 0x9d, 0x57, 0xf2, 0xea,
+	0x64, 0x3b, 0xaf, 0x4c, 0xaa, 0x53, 0xed, 0xbc, 0xa2, 0xa8, 0x85, 0x76, 0x5e, 0x29, 0xa8, 0xd0,
+	0xce, 0x2b, 0xa0, 0x16, 0xdb, 0x79, 0xa5, 0xa8, 0x96, 0xda, 0x79, 0xa5, 0xa4, 0xce, 0xb4, 0xf3,
+	0x4a, 0x59, 0x9d, 0x6d, 0xe7, 0x95, 0x59, 0x55, 0xad, 0xff, 0xef, 0xdb, 0xb0, 0xf0, 0xa5, 0xb8,
+	0xa6, 0xdb, 0xd2, 0xd5, 0xe0, 0xa5, 0xbc, 0x05, 0x25, 0xd7, 0xec, 0x13, 0x3a, 0x30, 0x2d, 0x22,
+	0x2f, 0x66, 0xc1, 0x28, 0x86, 0x63, 0x2d, 0x5b, 0x5b, 0x81, 0x62, 0x68, 0x9f, 0xc4, 0xfd, 0x2c,
+	0x18, 0x20, 0x87, 0x5a, 0xb6, 0xd6, 0x80, 0xb9, 0x81, 0xe9, 0x13, 0x37, 0xe8, 0xc4, 0x48, 0xf1,
+	0x0b, 0x5b, 0xe1, 0x53, 0xcf, 0x22, 0x04, 0xef, 0x82, 0x26, 0xe0, 0xa3, 0x74, 0xf3, 0x08, 0xae,
+	0xf2, 0x99, 0x2f, 0x47, 0xd4, 0xeb, 0x30, 0x23, 0xa0, 0xfd, 0xa1, 0xcb, 0x00, 0x27, 0x39, 0x8b,
+	0x7c, 0xd0, 0x18, 0xba, 0x31, 0x0e, 0x1c, 0xd7, 0x09, 0x1c, 0x33, 0x20, 0x68, 0x65, 0xa6, 0x50,
+	0x47, 0x04, 0x07, 0x2d, 0x39, 0xd3, 0xb2, 0xb5, 0x1f, 0xc3, 0x92, 0xe5, 0xf5, 0x07, 0x3d, 0x82,
+	0x77, 0x99, 0x1c, 0x33, 0xcc, 0xae, 0x19, 0x58, 0x87, 0x0c, 0x6b, 0x1a, 0xb1, 0x16, 0x47, 0x00,
+	0xdb, 0x6c, 0x7e, 0x8b, 0x4d, 0xb7, 0x6c, 0xed, 0x26, 0x00, 0x1a, 0x69, 0xd4, 0x62, 0xbd, 0x80,
+	0xbc, 0x14, 0xd8, 0x08, 0x9e, 0x17, 0xdb, 0xdb, 0xc8, 0x98, 0x9f, 0x0d, 0x08, 0x8a, 0x44, 0x07,
+	0xbe, 0x37, 0x39, 0xb3, 0x77, 0x36, 0x20, 0x4c, 0x20, 0xda, 0x57, 0x50, 0x0d, 0xa1, 0xc3, 0x10,
+	0x00, 0x8d, 0x94, 0x37, 0x0c, 0xf4, 0x22, 0x2a, 0xcb, 0xd2, 0x98, 0x9d, 0x7a, 0x24, 0xdc, 0xfc,
+	0x56, 0xfe, 0xef, 0x98, 0x99, 0xd2, 0x4f, 0x92, 0x27, 0xbb, 0xc7, 0x09, 0x68, 0x9f, 0xc3, 0x7c,
+	0x48, 0xde, 0x1f, 0x8e, 0x08, 0x97, 0xb2, 0x11, 0x0e, 0x77, 0x62, 0x0c, 0x43, 0x92, 0x5d, 0xb8,
+	0x69, 0x93, 0x7d, 0x73, 0xd8, 0x8b, 0x1c, 0x1e, 0x77, 0x5a, 0x82, 0xf6, 0x4c, 0x36, 0xda, 0x55,
+	0x41, 0x45, 0x1e, 0xf4, 0x9e, 0x49, 0x8f, 0xe4, 0x1a, 0xef, 0x82, 0xd6, 0x33, 0x69, 0x20, 0xce,
+	0x05, 0xa9, 0x3b, 0xb6, 0x5e, 0xc1, 0x63, 0x99, 0x65, 0x33, 0x78, 0x20, 0x0c, 0xa3, 0x65, 0x6b,
+	0xef, 0xc1, 0x1c, 0x02, 0xef, 0x3b, 0x7e, 0x88, 0xe2, 0xd8, 0xba, 0x86, 0xd0, 0x2a, 0x9b, 0x7a,
+	0xec, 0xf8, 0x02, 0xa5, 0x65, 0x6b, 0x3f, 0x85, 0x37, 0x11, 0x3c, 0xce, 0x3c, 0x0d, 0x4c, 0x9f,
+	0xe9, 0x4c, 0x88, 0x3e, 0x87, 0xe8, 0x35, 0x06, 0x1a, 0xe5, 0x70, 0x97, 0xc3, 0x49, 0x62, 0x9f,
+	0x00, 0x20, 0x26, 0x77, 0x2b, 0xf3, 0x19, 0xdd, 0x4a, 0x01, 0x71, 0xd8, 0xa8, 0xd6, 0x06, 0xe4,
+	0xb0, 0x13, 0xf5, 0x4e, 0x0b, 0x19, 0xc9, 0x94, 0x19, 0xe6, 0xcf, 0x46, 0x1e, 0x6a, 0x03, 0x16,
+	0xe2, 0x9b, 0x3a, 0x66, 0xf6, 0xc4, 0x73, 0xf5, 0x45, 0xdc, 0xcb, 0xdc, 0x49, 0x64, 0x1f, 0x5f,
+	0xf0, 0x29, 0xed, 0x31, 0xac, 0x26, 0x04, 0x61, 0x1d, 0x12, 0x7b, 0xd8, 0x8b, 0x8a, 0xe2, 0x06,
+	0xa2, 0x2f, 0x47, 0xd1, 0x77, 0x25, 0x94, 0x14, 0xc4, 0x16, 0xd4, 0x2e, 0x11, 0xa8, 0x8e, 0x54,
+	0xaa, 0x27, 0xe7, 0x0b, 0x73, 0x37, 0xc9, 0xbf, 0xd4, 0xa8, 0xa5, 0x6c, 0x1a, 0x15, 0xdb, 0xa0,
+	0x54, 0xa5, 0x31, 0xa1, 0x98, 0x01, 0x33, 0xbd, 0x81, 0x5e, 0x45, 0xe3, 0x1c, 0xc3, 0xd9, 0xe4,
+	0x53, 0xb1, 0x4b, 0x19, 0xdb, 0x0c, 0x1e, 0xcf, 0x1b, 0x19, 0x8f, 0xe7, 0x46, 0xca, 0x56, 0xf1,
+	0x9c, 0x4c, 0x58, 0x3e, 0x4f, 0xe6, 0xb8, 0xc0, 0x72, 0xc6, 0x05, 0x96, 0x52, 0x4f, 0x04, 0x97,
+	0xf0, 0xe1, 0x76, 0x7c, 0x09, 0xcf, 0x77, 0x0e, 0x1c, 0xd7, 0xec, 0x25, 0xd7, 0xaa, 0x65, 0x5c,
+	0xeb, 0x56, 0x74, 0xad, 0xe7, 0x82, 0x58, 0x7c, 0xcd, 0x87, 0xa0, 0xc7, 0xd7, 0xf4, 0xc9, 0x8b,
+	0x21, 0xa1, 0x78, 0xf8, 0x2b, 0x68, 0xfe, 0x16, 0xa2, 0x44, 0x0c, 0x3e, 0xdb, 0xb2, 0xb5, 0x5f,
+	0x82, 0x16, 0x47, 0x64, 0x66, 0x53, 0x7f, 0xb4, 0x9a, 0x5b, 0x2b, 0x9f, 0xe3, 0x28, 0x31, 0x6c,
+	0x66, 0x2e, 0x32, 0x66, 0x3c, 0xce, 0x06, 0x24, 0x62, 0x61, 0xc5, 0x88, 0xf6, 0x3c, 0x29, 0x0a,
+	0x3a, 0x3c, 0x38, 0x60, 0x6c, 0x59, 0x9e, 0x1b, 0x38, 0x2e, 0x8b, 0xa4, 0x68, 0x87, 0xc5, 0x8e,
+	0xdb, 0xab, 0xb9, 0x35, 0xc5, 0x58, 0x8d, 0x09, 0x95, 0x83, 0x36, 0x05, 0xe4, 0x26, 0x7d, 0x46,
+	0x4e, 0xc6, 0xaf, 0x8c, 0x88, 0xc6, 0x3b, 0xd4, 0xf9, 0x35, 0xe9, 0x74, 0xcf, 0x58, 0xa0, 0xf4,
+	0x78, 0xfc, 0xca, 0x3c, 0xe1, 0x50, 0xbb, 0xce, 0xaf, 0xc9, 0x16, 0x83, 0xd1, 0xee, 0x80, 0x6a,
+	0x99, 0xae, 0x45, 0x7a, 0x52, 0x50, 0xc4, 0xd6, 0x6f, 0x22, 0x0f, 0xb3, 0x7c, 0xdc, 0x90, 0xc3,
+	0xda, 0x3b, 0x50, 0x89, 0x83, 0x32, 0x99, 0xae, 0xa2, 0x4c, 0xe3, 0xb0, 0x2d, 0x84, 0xa5, 0x81,
+	0x63, 0x1d, 0x9d, 0x75, 0x22, 0x5e, 0xea, 0x16, 0x87, 0xe5, 0x13, 0x7b, 0xa1, 0xaf, 0x3a, 0x80,
+	0x55, 0x01, 0x2b, 0xd5, 0xa2, 0x13, 0x78, 0x9d, 0x91, 0x45, 0x63, 0x97, 0xaf, 0x9e, 0xed, 0xf2,
+	0x2d, 0x73, 0x42, 0x52, 0x25, 0xf6, 0xbc, 0x5d, 0x69, 0xe3, 0xd8, 0x2d, 0xd4, 0x61, 0x5a, 0xde,
+	0xbb, 0x37, 0x79, 0xe0, 0x2f, 0x3e, 0xb5, 0x9f, 0xc1, 0xa2, 0x4f, 0x02, 0xff, 0x4c, 0xf8, 0xed,
+	0x5e, 0xc7, 0x71, 0x03, 0xe2, 0x1f, 0x9b, 0x3d, 0xfd, 0xad, 0x6c, 0x0b, 0xcf, 0x23, 0x3a, 0xf7,
+	0xed, 0xbd, 0x96, 0x40, 0x1e, 0x91, 0xed, 0x9b, 0xa7, 0x4e, 0x7f, 0xd8, 0x1f, 0x91, 0xbd, 0x7d,
+	0x15, 0xb2, 0x9f, 0x71, 0xec, 0x90, 0xec, 0x83, 0x24, 0x59, 0xb1, 0x0d, 0xaa, 0xbf, 0x8d, 0xdb,
+	0x8a, 0x61, 0x09, 0x73, 0x42, 0xb5, 0x8f, 0x60, 0x89, 0x63, 0x75, 0x4d, 0xeb, 0xc8, 0xdb, 0xdf,
+	0xef, 0x58, 0x1e, 0xd9, 0xdf, 0x77, 0x2c, 0x87, 0xb8, 0x81, 0xfe, 0xa3, 0xd5, 0xdc, 0x5a, 0xce,
+	0xb8, 0x81, 0x00, 0x5b, 0x7c, 0xbe, 0x39, 0x9a, 0xd6, 0xfa, 0x50, 0x4f, 0x09, 0x10, 0xc8, 0xe9,
+	0xc0, 0xe1, 0xec, 0xf2, 0x6b, 0xbc, 0x96, 0xf1, 0x1a, 0xaf, 0x8c, 0x45, 0x0a, 0xdb, 0x21, 0x25,
+	0xbc, 0xc4, 0x8f, 0x60, 0x85, 0xb3, 0xea, 0x7a, 0x6e, 0x07, 0x7f, 0x99, 0xdd, 0x1e, 0xe9, 0x10,
+	0xdf, 0xf7, 0x7c, 0xbc, 0x97, 0x54, 0xbf, 0xb3, 0x3a, 0xb1, 0x56, 0x30, 0xde, 0xc0, 0xc9, 0x67,
+	0x9e, 0x6b, 0x48, 0xa0, 0x6d, 0x06, 0xc3, 0xae, 0x1c, 0xd5, 0xd6, 0x40, 0x3d, 0x34, 0x29, 0xc7,
+	0xef, 0x0c, 0xbc, 0x9e, 0x63, 0x9d, 0xe9, 0xef, 0xa0, 0x6a, 0x97, 0x0f, 0x4d, 0x8a, 0x18, 0x3b,
+	0x38, 0xaa, 0xbd, 0x09, 0x33, 0x96, 0xef, 0xb9, 0xa1, 0xfe, 0xe9, 0xef, 0xa2, 0xa6, 0x96, 0xd8,
+	0xa0, 0xd4, 0x25, 0x16, 0xa2, 0x52, 0xe7, 0x80, 0x59, 0x2f, 0xcb, 0x1b, 0xba, 0x81, 0xde, 0xc0,
+	0xdb, 0x55, 0xe4, 0x63, 0x4d, 0x36, 0xa4, 0xdd, 0x86, 0xb2, 0x69, 0x05, 0xce, 0xb1, 0x13, 0x9c,
+	0x09, 0xa0, 0x4f, 0x11, 0x68, 0x46, 0x8e, 0x72, 0xb0, 0x0d, 0x58, 0xb0, 0x0e, 0x9d, 0x9e, 0x1d,
+	0x11, 0x25, 0x87, 0x7e, 0xc2, 0x5d, 0x24, 0x4e, 0x86, 0xb2, 0xe1, 0x38, 0x6b, 0xa0, 0x0e, 0x29,
+	0xf1, 0x51, 0xd0, 0xbe, 0x00, 0x6f, 0x21, 0x78, 0x99, 0x8d, 0x33, 0xb1, 0xf9, 0x1c, 0x72, 0x13,
+	0x6e, 0xca, 0xfb, 0x29, 0xae, 0x2b, 0x39, 0x0d, 0x88, 0x3f, 0x62, 0xbc, 0xcd, 0x7d, 0xa0, 0x00,
+	0x6a, 0x22, 0xcc, 0xb6, 0x00, 0x09, 0x19, 0x14, 0x5b, 0x4d, 0xa0, 0xfe, 0x94, 0x33, 0xc8, 0x27,
+	0xe3, 0x38, 0x9f, 0x43, 0xc5, 0x1c, 0x06, 0x5e, 0xc7, 0x27, 0x94, 0x04, 0x9d, 0x81, 0xe7, 0xb8,
+	0x01, 0xd5, 0xef, 0xa3, 0x46, 0xdc, 0x1e, 0x99, 0x4f, 0x66, 0x37, 0xc3, 0xda, 0xc5, 0xf1, 0xbd,
+	0x86, 0xc1, 0xa0, 0x77, 0x10, 0xd8, 0x98, 0x65, 0xf8, 0x91, 0x01, 0xed, 0x2f, 0xa1, 0x42, 0x89,
+	0xe9, 0x5b, 0x87, 0x4c, 0xc1, 0x7d, 0xa7, 0x3b, 0x64, 0x46, 0xed, 0x01, 0x66, 0x7f, 0xcf, 0xb3,
+	0xa4, 0x2e, 0xa9, 0xa9, 0x46, 0x63, 0x17, 0x49, 0x6e, 0x86, 0x14, 0x79, 0x3a, 0xa8, 0xd2, 0xc4,
+	0xb0, 0xf6, 0x25, 0xe4, 0xfb, 0xa4, 0xef, 0xe9, 0x1f, 0xe0, 0x82, 0xcd, 0x57, 0x5f, 0xf0, 0x33,
+	0xd2, 0xf7, 0xf8, 0x22, 0x48, 0x50, 0xfb, 0x0a, 0x2a, 0x22, 0x26, 0x12, 0x46, 0xdb, 0x21, 0x54,
+	0xff, 0x13, 0x94, 0xd4, 0xfb, 0xa9, 0xab, 0x08, 0xd3, 0xce, 0x56, 0x10, 0x11, 0xd3, 0x13, 0x89,
+	0x67, 0xa8, 0xc7, 0x89, 0x11, 0xed, 0x3e, 0x2c, 0x8a, 0x20, 0x34, 0xd4, 0x2e, 0x91, 0xb1, 0x3c,
+	0x44, 0xad, 0x9e, 0xc3, 0xd9, 0x90, 0x45, 0x9e, 0xb9, 0xfc, 0x05, 0xcc, 0x8e, 0xc0, 0x69, 0x60,
+	0x06, 0x54, 0xff, 0x10, 0x39, 0xda, 0xc8, 0xb2, 0xef, 0x90, 0x18, 0xcb, 0x13, 0xa9, 0x51, 0x26,
+	0xb1, 0xef, 0x58, 0xa8, 0xe1, 0x0f, 0xc7, 0xed, 0xc6, 0x8f, 0xaf, 0x1a, 0x6a, 0x18, 0xc3, 0xa4,
+	0xc5, 0x78, 0x00, 0x37, 0xc6, 0xc2, 0xef, 0xe0, 0x14, 0x77, 0xfd, 0x11, 0xd7, 0xd9, 0x78, 0x08,
+	0xbe, 0x77, 0xca, 0x76, 0xfd, 0x00, 0x16, 0xd9, 0x5e, 0x49, 0x27, 0xf0, 0x4d, 0x97, 0x3a, 0x91,
+	0x9b, 0xf8, 0x31, 0x22, 0xcd, 0xe3, 0xec, 0x5e, 0x38, 0xc9, 0x35, 0xfd, 0x53, 0x28, 0xc7, 0x93,
+	0x24, 0xfd, 0x4f, 0x33, 0x6e, 0x60, 0x86, 0x44, 0x53, 0x23, 0x6d, 0x1d, 0xe6, 0x5d, 0x72, 0x32,
+	0x7e, 0x4e, 0x7f, 0xc6, 0x33, 0x56, 0x97, 0x9c, 0x24, 0x4e, 0xe9, 0x29, 0x94, 0x44, 0x7e, 0x89,
+	0xf5, 0x45, 0xfd, 0x27, 0xb8, 0xee, 0x9d, 0xd4, 0x23, 0x42, 0x08, 0xae, 0x32, 0x56, 0xe0, 0xf9,
+	0x4d, 0xf6, 0x29, 0xb3, 0x55, 0xfc, 0xd0, 0x3e, 0x04, 0x7d, 0x2c, 0x5b, 0x95, 0xc1, 0xfa, 0x27,
+	0x3c, 0xf9, 0x4c, 0xa4, 0xac, 0x32, 0x5e, 0xbf, 0x0f, 0x8b, 0x56, 0xcf, 0xa3, 0x42, 0x6e, 0xfb,
+	0xc4, 0xe7, 0x5e, 0xde, 0xb1, 0xf5, 0x3f, 0x17, 0x16, 0x8c, 0xcd, 0xee, 0x89, 0x49, 0x91, 0x21,
+	0x3d, 0x04, 0x9d, 0x23, 0x1d, 0x3b, 0xd4, 0xe9, 0x3a, 0x3d, 0x66, 0x24, 0x25, 0xda, 0x26, 0xa2,
+	0x2d, 0xe0, 0xfc, 0x17, 0xe1, 0xb4, 0x40, 0xfc, 0x04, 0x40, 0xac, 0xc6, 0x64, 0xbd, 0x95, 0x35,
+	0xbd, 0xe1, 0x3c, 0x30, 0x39, 0x6f, 0xc3, 0x4a, 0xfa, 0xca, 0x22, 0xb7, 0x26, 0xb6, 0xde, 0x44,
+	0xbf, 0xb0, 0x9c, 0xc2, 0x40, 0x53, 0xc2, 0x68, 0x5d, 0x98, 0xeb, 0x9a, 0x94, 0x44, 0xce, 0xcb,
+	0x71, 0xf7, 0x3d, 0xfd, 0xe9, 0x05, 0xf7, 0x24, 0x6a, 0xea, 0xb6, 0x4c, 0x4a, 0x62, 0x86, 0xc1,
+	0xa8, 0x74, 0x93, 0x43, 0xda, 0x1e, 0xc0, 0xc0, 0x1c, 0x52, 0xc2, 0x49, 0x7f, 0x86, 0xa4, 0x3f,
+	0xb8, 0x8a, 0xe9, 0xd9, 0x61, 0xd8, 0x48, 0xbd, 0x30, 0x90, 0x3f, 0xb5, 0x5f, 0xc1, 0x7c, 0xa4,
+	0xce, 0x60, 0x99, 0xbd, 0x1e, 0x8b, 0x01, 0xa8, 0xfe, 0x0c, 0x4d, 0xdb, 0xc3, 0x4b, 0x59, 0x6f,
+	0x86, 0xc8, 0x4d, 0x81, 0x8b, 0x2b, 0xcc, 0x59, 0x63, 0xe3, 0x54, 0x23, 0x30, 0xd7, 0x1d, 0x32,
+	0xe7, 0xe6, 0xd8, 0x1d, 0x93, 0x32, 0x4f, 0xd1, 0x67, 0x01, 0xc6, 0xf3, 0xec, 0x5b, 0xd9, 0x62,
+	0xe8, 0x2d, 0x7b, 0x33, 0x44, 0x36, 0x2a, 0xdd, 0xe4, 0x10, 0x73, 0xd9, 0x78, 0x84, 0x03, 0xdf,
+	0xf1, 0x7c, 0x27, 0x38, 0xd3, 0x77, 0x30, 0xf4, 0x29, 0xb1, 0xc1, 0x1d, 0x31, 0x56, 0xb5, 0x61,
+	0x21, 0xd5, 0xda, 0xa7, 0x14, 0xf4, 0x3e, 0x88, 0x97, 0xc6, 0x56, 0xe2, 0x2e, 0x4b, 0x54, 0xe6,
+	0x8f, 0xef, 0x35, 0x76, 0xcc, 0xb3, 0x9e, 0x67, 0xda, 0xd1, 0xda, 0xdb, 0xcf, 0xa1, 0x10, 0x9a,
+	0xf8, 0xef, 0x95, 0x72, 0x58, 0x59, 0x0b, 0x2b, 0x68, 0xed, 0xbc, 0xa2, 0xaa, 0x95, 0x76, 0x5e,
+	0xb9, 0xab, 0xbe, 0xd7, 0xce, 0x2b, 0xef, 0xa9, 0x8d, 0x76, 0x5e, 0x59, 0x57, 0xdf, 0x6f, 0xe7,
+	0x95, 0xf7, 0xd5, 0x7b, 0xed, 0xbc, 0x72, 0x4f, 0xdd, 0x68, 0xe7, 0x95, 0x0d, 0xf5, 0x7e, 0xfd,
+	0x6f, 0x72, 0x50, 0x19, 0x53, 0x0a, 0x76, 0x97, 0xb8, 0x7e, 0xe1, 0x5d, 0xca, 0x65, 0xbd, 0x4b,
+	0x88, 0x83, 0x77, 0xa9, 0x0a, 0x8a, 0x63, 0x13, 0x37, 0x60, 0x22, 0xe7, 0x25, 0xb8, 0xf0, 0x5b,
+	0x5b, 0x84, 0x29, 0x9f, 0x98, 0xd4, 0x73, 0x45, 0xcd, 0x4d, 0x7c, 0xd5, 0xef, 0x43, 0x39, 0xee,
+	0x21, 0x58, 0x2c, 0x15, 0xcd, 0x57, 0x90, 0x91, 0x09, 0xa3, 0x78, 0x38, 0xca, 0x4e, 0xea, 0xff,
+	0x9f, 0x83, 0xc5, 0x31, 0x7f, 0xca, 0xb0, 0x09, 0x26, 0x22, 0x3e, 0x61, 0x76, 0x3b, 0x92, 0x88,
+	0xe4, 0x44, 0x22, 0x82, 0x13, 0xa3, 0x44, 0x64, 0x01, 0xa6, 0x84, 0x55, 0xe5, 0xdc, 0x4e, 0xfa,
+	0x68, 0x49, 0xdb, 0x30, 0x89, 0xb6, 0x1d, 0x39, 0x2d, 0x6f, 0x3c, 0xc8, 0x96, 0xe0, 0xc5, 0xf9,
+	0x30, 0x38, 0x09, 0xed, 0x31, 0x4c, 0xb1, 0x1f, 0x43, 0xaa, 0xe7, 0x93, 0xd9, 0xe2, 0xe5, 0x54,
+	0x86, 0xd4, 0x10, 0xd8, 0xf5, 0xff, 0x52, 0x40, 0x8d, 0xd9, 0xcc, 0xef, 0xab, 0x30, 0x3a, 0x92,
+	0xc1, 0x44, 0x54, 0x06, 0x4d, 0x28, 0x8c, 0x12, 0x5d, 0xce, 0xfa, 0xdb, 0x17, 0xcb, 0x21, 0x4c,
+	0x70, 0x95, 0x40, 0xfc, 0x62, 0x25, 0xcf, 0xc0, 0xf4, 0x0f, 0x48, 0xa2, 0xe8, 0xca, 0x8b, 0xa3,
+	0x15, 0x3e, 0x95, 0x28, 0xba, 0x0a, 0xf8, 0x28, 0xcf, 0x53, 0x08, 0xae, 0xf2, 0x99, 0x78, 0xd1,
+	0x55, 0x40, 0x8b, 0x0d, 0x4c, 0xf3, 0xed, 0xf3, 0x41, 0xee, 0x14, 0xe3, 0x95, 0x50, 0x25, 0x59,
+	0x09, 0xfd, 0x18, 0xaa, 0x82, 0x04, 0x8f, 0xb9, 0xc3, 0x65, 0x3d, 0xb7, 0x77, 0x86, 0x85, 0x53,
+	0xc5, 0xb8, 0xc1, 0x21, 0x9a, 0x0c, 0x40, 0xae, 0xfe, 0xdc, 0xed, 0x9d, 0x31, 0x6e, 0x53, 0x4a,
+	0x51, 0xc0, 0x8b, 0x7a, 0x34, 0x59, 0x7e, 0xd2, 0x61, 0x5a, 0xfa, 0xcf, 0x22, 0xef, 0x1e, 0x89,
+	0x4f, 0xed, 0x06, 0x4c, 0x4b, 0x57, 0x57, 0xc2, 0x99, 0xa9, 0x80, 0xfb, 0xb6, 0x16, 0xcc, 0x46,
+	0x9d, 0x12, 0xbb, 0x94, 0x33, 0x59, 0x0b, 0x6f, 0x23, 0x44, 0xbc, 0x99, 0x77, 0x41, 0xb3, 0x09,
+	0xf3, 0x54, 0x1d, 0x73, 0x3f, 0x60, 0x39, 0x02, 0xf3, 0x65, 0xfa, 0x2c, 0x6e, 0x50, 0xe5, 0x33,
+	0x9b, 0x6c, 0xa2, 0xc9, 0xc6, 0xb5, 0xbf, 0xcd, 0x01, 0xf7, 0x76, 0xd1, 0x82, 0x2f, 0x63, 0xd1,
+	0x26, 0x81, 0xe9, 0x60, 0x3b, 0x87, 0xb1, 0xf1, 0x2c, 0x8b, 0xc1, 0x4e, 0x2a, 0x6d, 0x03, 0x97,
+	0x18, 0x95, 0x81, 0x4d, 0x7a, 0xf4, 0x88, 0x53, 0x7d, 0x72, 0xcd, 0x58, 0xb2, 0xce, 0x9b, 0xd4,
+	0xfe, 0x3e, 0x07, 0xab, 0x29, 0x7e, 0x2a, 0xce, 0x57, 0x05, 0xf9, 0x32, 0x5e, 0x8d, 0xaf, 0x31,
+	0x7f, 0x15, 0xe7, 0xed, 0xa6, 0x75, 0x11, 0x40, 0xf5, 0x97, 0xb0, 0x74, 0xee, 0xce, 0xb4, 0x4f,
+	0x60, 0xd9, 0x32, 0xdd, 0x0e, 0x3d, 0x72, 0x06, 0xd1, 0x38, 0x83, 0x79, 0x1f, 0x87, 0x65, 0xfc,
+	0x39, 0x3c, 0x88, 0x25, 0xcb, 0x74, 0x77, 0x8f, 0x9c, 0xc1, 0x28, 0xc6, 0xd8, 0x14, 0x00, 0xd5,
+	0xc7, 0x70, 0xf3, 0x42, 0xfe, 0x58, 0x76, 0x19, 0x8a, 0xc4, 0x71, 0x6d, 0x72, 0x2a, 0x5a, 0x3b,
+	0x33, 0x56, 0xe8, 0x94, 0x6d, 0x72, 0xba, 0x55, 0x86, 0x52, 0x54, 0x60, 0xdc, 0x7d, 0xd4, 0xff,
+	0x39, 0x0f, 0x73, 0x91, 0x16, 0xd7, 0x0f, 0xc6, 0xbe, 0x44, 0xee, 0xd4, 0x64, 0xfc, 0x4e, 0xbd,
+	0x05, 0xe5, 0x44, 0xb1, 0x9d, 0xf7, 0x59, 0x4a, 0xfb, 0xd1, 0x42, 0x7b, 0x1d, 0x66, 0x5c, 0x72,
+	0x1a, 0x01, 0xe2, 0x6d, 0x95, 0x22, 0x1b, 0x94, 0x30, 0xe9, 0xb7, 0x5c, 0x39, 0xe7, 0x96, 0xdf,
+	0x82, 0x52, 0xd7, 0x37, 0x5d, 0xeb, 0xb0, 0x13, 0x78, 0x47, 0x84, 0x5f, 0xf5, 0x92, 0x51, 0xe4,
+	0x63, 0x7b, 0x6c, 0x48, 0x06, 0xf6, 0x4c, 0x28, 0x31, 0xd0, 0x19, 0x04, 0x65, 0x81, 0xbd, 0x31,
+	0x74, 0xb7, 0x22, 0x08, 0x11, 0xfb, 0x30, 0x7b, 0x99, 0x7d, 0x50, 0x5f, 0xd1, 0x3e, 0x2c, 0x03,
+	0x48, 0xa6, 0x44, 0x1b, 0xa3, 0x60, 0x28, 0x9c, 0x95, 0x96, 0x9d, 0x68, 0xdf, 0x85, 0x8d, 0xbb,
+	0xfa, 0xff, 0x4d, 0x80, 0x96, 0x88, 0xc8, 0x7f, 0xd8, 0x6a, 0x13, 0x11, 0xf5, 0xd4, 0x65, 0xa2,
+	0x9e, 0x7e, 0x45, 0x51, 0xc7, 0x33, 0x16, 0xe5, 0xea, 0x19, 0x4b, 0xbc, 0xa3, 0x53, 0xb8, 0x7a,
+	0x47, 0xe7, 0xa2, 0x64, 0x0b, 0x2e, 0x48, 0xb6, 0xea, 0xff, 0x38, 0x09, 0x33, 0x8c, 0xc2, 0x0f,
+	0x27, 0x02, 0xd9, 0x86, 0x92, 0xa8, 0x12, 0x73, 0x3a, 0x93, 0x48, 0xa7, 0x7e, 0x4e, 0x10, 0x26,
+	0x6a, 0xc1, 0x48, 0xa3, 0x18, 0x8c, 0x3e, 0x34, 0x12, 0x69, 0xd1, 0xc8, 0x0a, 0x29, 0xd2, 0x9b,
+	0x42, 0x7a, 0xf7, 0xb2, 0x45, 0x88, 0xa2, 0x76, 0x8a, 0xe4, 0xe7, 0x4e, 0xc6, 0x07, 0xa3, 0x8a,
+	0x39, 0x1d, 0x57, 0xcc, 0x3b, 0x10, 0xda, 0x9a, 0xb0, 0x3d, 0xa4, 0xa0, 0x81, 0x9f, 0x95, 0xe3,
+	0xb2, 0x35, 0xb4, 0x04, 0x4a, 0x68, 0xa6, 0x0a, 0x9c, 0x0a, 0x11, 0xd6, 0x29, 0xa2, 0xde, 0x70,
+	0x99, 0x7a, 0x17, 0x5f, 0x51, 0xbd, 0x93, 0x16, 0xb0, 0x34, 0x6e, 0x01, 0xef, 0x80, 0x6a, 0xf6,
+	0x7c, 0x62, 0xda, 0xd2, 0x03, 0x12, 0x1b, 0xad, 0x9f, 0x62, 0xcc, 0x8a, 0xf1, 0x4d, 0x31, 0x9c,
+	0xe2, 0xd6, 0xca, 0x29, 0x6e, 0x8d, 0xbd, 0xb8, 0x40, 0x9e, 0xd0, 0x40, 0x4e, 0x1a, 0xfc, 0xa3,
+	0xfe, 0x4f, 0xd7, 0x41, 0x95, 0x1e, 0x34, 0xd4, 0xd8, 0x88, 0x0c, 0x72, 0x31, 0x19, 0x24, 0x55,
+	0xf9, 0xfa, 0xa5, 0xaa, 0x3c, 0x71, 0x81, 0x2a, 0xe7, 0xcf, 0x55, 0xe5, 0xc9, 0xef, 0x6e, 0xb5,
+	0xa6, 0xe2, 0xca, 0xf1, 0xfd, 0x19, 0xa7, 0xfa, 0xbf, 0x96, 0xa1, 0xb4, 0x29, 0xea, 0xd1, 0x28,
+	0xae, 0xc8, 0xaa, 0xb9, 0xf8, 0xaa, 0x0f, 0x41, 0x4f, 0x3a, 0xc6, 0xf0, 0x79, 0x02, 0x7f, 0xf8,
+	0xb2, 0x10, 0x77, 0x8f, 0xf2, 0x75, 0xc2, 0xa7, 0x50, 0x4e, 0xb4, 0xf8, 0xf2, 0x59, 0x4b, 0x64,
+	0x34, 0xd6, 0xce, 0x5b, 0x03, 0x75, 0xac, 0x87, 0xcb, 0x0d, 0x7a, 0x99, 0xc6, 0xfb, 0xb6, 0x4d,
+	0x28, 0xc5, 0x1a, 0xa4, 0x59, 0xc5, 0x53, 0xa4, 0x91, 0xa6, 0xe8, 0x0a, 0x14, 0xc3, 0x02, 0xbe,
+	0x08, 0x01, 0x0a, 0x06, 0xc8, 0x21, 0x9e, 0x6c, 0x44, 0x72, 0x4e, 0xf1, 0xec, 0xc2, 0x0f, 0xb3,
+	0xcd, 0x5f, 0xc0, 0xd2, 0xf9, 0x3d, 0x2c, 0xc8, 0xd6, 0xf3, 0x59, 0xa4, 0xe9, 0xdd, 0xab, 0x04,
+	0xed, 0x91, 0x83, 0xb9, 0xc2, 0x1b, 0x8d, 0x08, 0xed, 0xa6, 0x74, 0x36, 0x8c, 0xf6, 0x1e, 0x2c,
+	0x0a, 0x5e, 0x93, 0x84, 0x33, 0xbe, 0xd1, 0x98, 0xe3, 0xae, 0x27, 0x4e, 0xf5, 0x29, 0x54, 0x0e,
+	0x89, 0xe9, 0x07, 0x5d, 0x62, 0x06, 0x57, 0x7d, 0x98, 0xa1, 0x86, 0x98, 0x92, 0x5a, 0x5a, 0xa7,
+	0xb2, 0x7c, 0x85, 0x4e, 0x25, 0x0f, 0xac, 0xd2, 0x3a, 0x95, 0xbc, 0xa7, 0x22, 0x7b, 0xec, 0x2c,
+	0x91, 0x57, 0xb9, 0xdd, 0x0d, 0xa4, 0x23, 0xe4, 0x99, 0x7a, 0xb4, 0x81, 0x58, 0x89, 0x37, 0x10,
+	0xe3, 0x49, 0xa8, 0x96, 0x4c, 0x42, 0xef, 0x8c, 0xd4, 0x38, 0xac, 0x9e, 0xcc, 0xc9, 0x6e, 0x28,
+	0x8e, 0xb7, 0xc4, 0x70, 0x6a, 0xd7, 0x6a, 0x3e, 0xb5, 0x6b, 0x75, 0x7e, 0xd3, 0x72, 0xe1, 0xf5,
+	0x34, 0x2d, 0x17, 0x5f, 0x4f, 0xd3, 0xf2, 0xc6, 0x05, 0x4d, 0xcb, 0x3d, 0x58, 0xe0, 0x58, 0xc9,
+	0x9e, 0x81, 0x9e, 0xf1, 0x7a, 0xcf, 0x21, 0x7a, 0xa2, 0x5b, 0x70, 0x61, 0x2b, 0x74, 0xe9, 0xe2,
+	0x56, 0x68, 0x86, 0xde, 0x64, 0xf5, 0xf2, 0xde, 0xe4, 0x33, 0xd0, 0x38, 0x15, 0xde, 0xb5, 0xe0,
+	0x6f, 0x90, 0xc5, 0xa3, 0x8e, 0xd5, 0x78, 0xe8, 0x22, 0x26, 0x99, 0xcb, 0x78, 0xcc, 0x7f, 0x1a,
+	0x2a, 0xe2, 0x3e, 0x65, 0x1d, 0x0d, 0x3e, 0xc2, 0xaa, 0x1c, 0x11, 0x7a, 0xcc, 0x5d, 0x11, 0x7f,
+	0xa4, 0x6a, 0xcb, 0xa8, 0x6a, 0x37, 0x42, 0xac, 0x2f, 0x71, 0x3e, 0x54, 0xb9, 0xf4, 0xfc, 0xa7,
+	0x76, 0x4e, 0xfe, 0xf3, 0x05, 0x2c, 0xe2, 0x22, 0xa3, 0xab, 0x2d, 0x53, 0xf3, 0x95, 0x34, 0xf6,
+	0xc7, 0x0a, 0x9c, 0xd4, 0x98, 0x67, 0xf8, 0x4f, 0x24, 0xba, 0x4c, 0x6f, 0xbf, 0x82, 0x6a, 0x82,
+	0x6e, 0xf4, 0x39, 0xd2, 0x6a, 0xd6, 0xf7, 0x2e, 0x31, 0xda, 0x91, 0x77, 0x49, 0x61, 0xfc, 0x70,
+	0x2b, 0x12, 0x3f, 0x8c, 0x97, 0x91, 0xeb, 0xe3, 0x65, 0xe4, 0x76, 0x5e, 0x99, 0x50, 0xf3, 0xed,
+	0xbc, 0x32, 0xa5, 0x4e, 0xb7, 0xf3, 0xca, 0x4d, 0xb5, 0x56, 0xff, 0x8f, 0x1c, 0x14, 0x18, 0x55,
+	0xff, 0x12, 0x07, 0x9a, 0xe6, 0xbe, 0xae, 0xa7, 0xba, 0xaf, 0x4d, 0x28, 0xa2, 0x8a, 0x0b, 0xe7,
+	0x3e, 0x91, 0x71, 0xbb, 0xc0, 0x91, 0xa4, 0xf3, 0x8a, 0xda, 0xb0, 0x3c, 0xae, 0x03, 0xc1, 0xc8,
+	0x7c, 0x2d, 0x81, 0xc2, 0x4d, 0x5d, 0x58, 0xa0, 0x9b, 0xc6, 0xef, 0x96, 0x5d, 0xff, 0xcf, 0x3c,
+	0x68, 0xcd, 0x58, 0xdb, 0xf9, 0xf2, 0xd0, 0x60, 0xd4, 0x35, 0x4a, 0x0f, 0x0d, 0xc2, 0xf9, 0x58,
+	0x68, 0x90, 0x26, 0x92, 0x89, 0x54, 0x91, 0x34, 0x60, 0x4e, 0x42, 0x46, 0x43, 0x32, 0x51, 0x5a,
+	0x14, 0x53, 0x91, 0x62, 0xe1, 0x5b, 0x20, 0x29, 0xc8, 0x24, 0x97, 0x97, 0x15, 0x65, 0x5c, 0xc0,
+	0xcb, 0x85, 0xa9, 0xc5, 0x63, 0x25, 0xbd, 0x78, 0xbc, 0x0c, 0x85, 0x30, 0x36, 0x94, 0xce, 0x3e,
+	0x1c, 0xb8, 0xe2, 0x1b, 0xcb, 0x9f, 0x87, 0x6f, 0x43, 0xb9, 0x83, 0x15, 0xa6, 0xbd, 0x88, 0xa1,
+	0xe2, 0xda, 0x39, 0xd9, 0xca, 0x8e, 0x6c, 0xd7, 0x51, 0xc2, 0x8d, 0xbe, 0x7c, 0x45, 0x1a, 0x19,
+	0x62, 0x7c, 0x24, 0x8f, 0x22, 0xac, 0x33, 0xaa, 0xf1, 0x43, 0xc0, 0x6e, 0xda, 0x24, 0x6f, 0x1e,
+	0xce, 0x5c, 0xb5, 0x79, 0xc8, 0xf1, 0xc6, 0x82, 0xe8, 0xf2, 0x58, 0x10, 0x1d, 0xbe, 0x0e, 0x9e,
+	0x56, 0x95, 0xfa, 0xbf, 0xe4, 0xa0, 0x62, 0x44, 0x9f, 0x1a, 0xbc, 0x2e, 0xc5, 0x4a, 0x75, 0xfa,
+	0x13, 0xe9, 0xcf, 0x93, 0xd2, 0x45, 0x96, 0x4f, 0x17, 0x59, 0xfd, 0xdf, 0x72, 0x00, 0xbb, 0xf8,
+	0xe4, 0xe1, 0x75, 0xf1, 0x1e, 0x0f, 0x2b, 0x27, 0x92, 0x61, 0x65, 0x3a, 0xbb, 0xd3, 0xe9, 0xec,
+	0x26, 0xde, 0x66, 0x73, 0xa3, 0xa5, 0xa8, 0x85, 0xfa, 0x6f, 0x72, 0xa0, 0x34, 0x0f, 0x89, 0x75,
+	0x44, 0x87, 0xfd, 0xe4, 0x26, 0x26, 0x47, 0x9b, 0x78, 0x04, 0x53, 0xfb, 0x3d, 0xf3, 0xd8, 0xf3,
+	0x91, 0xe5, 0xf2, 0xc6, 0xdd, 0x8b, 0xd3, 0x18, 0x49, 0xf1, 0x31, 0xe2, 0x18, 0x02, 0x77, 0xf4,
+	0x40, 0x7e, 0x02, 0x93, 0x43, 0xfe, 0x51, 0xff, 0x7d, 0x0e, 0x80, 0xdb, 0x64, 0x94, 0xa4, 0x0d,
+	0x9a, 0x69, 0x59, 0x64, 0x10, 0xb0, 0xe3, 0xe1, 0x6f, 0x46, 0x88, 0x2f, 0xba, 0x52, 0xf7, 0x2f,
+	0x7b, 0x0a, 0x21, 0x9e, 0xb2, 0xe1, 0xae, 0x77, 0x38, 0xea, 0x93, 0x6b, 0x46, 0x65, 0x44, 0x50,
+	0x0c, 0x6a, 0x5d, 0xa8, 0x84, 0x8d, 0xde, 0x70, 0x91, 0xeb, 0xdf, 0x65, 0x11, 0x35, 0xa4, 0x27,
+	0xc6, 0xb6, 0xa6, 0xc5, 0x76, 0xb7, 0x7e, 0xf5, 0xf5, 0x37, 0xb5, 0x6b, 0xbf, 0xfb, 0xa6, 0x76,
+	0xed, 0x0f, 0xdf, 0xd4, 0x72, 0xbf, 0x79, 0x59, 0xcb, 0xfd, 0xc3, 0xcb, 0x5a, 0xee, 0xdf, 0x5f,
+	0xd6, 0x72, 0x5f, 0xbf, 0xac, 0xe5, 0xfe, 0xfb, 0x65, 0x2d, 0xf7, 0x3f, 0x2f, 0x6b, 0xd7, 0xfe,
+	0xf0, 0xb2, 0x96, 0xfb, 0xed, 0xb7, 0xb5, 0x6b, 0x5f, 0x7f, 0x5b, 0xbb, 0xf6, 0xbb, 0x6f, 0x6b,
+	0xd7, 0x7e, 0xf1, 0xe0, 0xc0, 0x1b, 0x71, 0xe2, 0x78, 0xe7, 0xff, 0x0d, 0xe6, 0xe3, 0xc8, 0x67,
+	0x77, 0x0a, 0xdd, 0xc2, 0xfd, 0x3f, 0x0e, 0x00, 0xc2, 0xae, 0x18, 0x79, 0xda, 0x35, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2976,6 +3186,57 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if !this.BaseExecutionInfo.Equal(that1.BaseExecutionInfo) {
 		return false
 	}
+	if !this.PauseInfo.Equal(that1.PauseInfo) {
+		return false
+	}
+	if len(this.CompletionCallbacks) != len(that1.CompletionCallbacks) {
+		return false
+	}
+	for i := range this.CompletionCallbacks {
+		if !this.CompletionCallbacks[i].Equal(that1.CompletionCallbacks[i]) {
+			return false
+		}
+	}
+	if !this.BuildIdAssignment.Equal(that1.BuildIdAssignment) {
+		return false
+	}
+	if this.TaskPriority != that1.TaskPriority {
+		return false
+	}
+	return true
+}
+func (this *WorkflowPauseInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowPauseInfo)
+	if !ok {
+		that2, ok := that.(WorkflowPauseInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.PauseTime == nil {
+		if this.PauseTime != nil {
+			return false
+		}
+	} else if !this.PauseTime.Equal(*that1.PauseTime) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
 	return true
 }
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -3135,6 +3396,30 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails_) Equal(that interface{})
 	}
 	return true
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferTaskInfo_CompletionCallbackTaskDetails_)
+	if !ok {
+		that2, ok := that.(TransferTaskInfo_CompletionCallbackTaskDetails_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CompletionCallbackTaskDetails.Equal(that1.CompletionCallbackTaskDetails) {
+		return false
+	}
+	return true
+}
 func (this *TransferTaskInfo_CloseExecutionTaskDetails) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3159,6 +3444,30 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails) Equal(that interface{})
 	}
 	return true
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferTaskInfo_CompletionCallbackTaskDetails)
+	if !ok {
+		that2, ok := that.(TransferTaskInfo_CompletionCallbackTaskDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CallbackIndex != that1.CallbackIndex {
+		return false
+	}
+	return true
+}
 func (this *ReplicationTaskInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3348,6 +3657,12 @@ func (this *TimerTaskInfo) Equal(that interface{}) bool {
 	if this.AlreadyArchived != that1.AlreadyArchived {
 		return false
 	}
+	if this.CallbackIndex != that1.CallbackIndex {
+		return false
+	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
 	return true
 }
 func (this *ArchivalTaskInfo) Equal(that interface{}) bool {
@@ -3559,6 +3874,12 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.Stamp != that1.Stamp {
+		return false
+	}
+	if this.TaskPriority != that1.TaskPriority {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3889,7 +4210,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 70)
+	s := make([]string, 0, 74)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3991,6 +4312,28 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this.BaseExecutionInfo != nil {
 		s = append(s, "BaseExecutionInfo: "+fmt.Sprintf("%#v", this.BaseExecutionInfo)+",\n")
 	}
+	if this.PauseInfo != nil {
+		s = append(s, "PauseInfo: "+fmt.Sprintf("%#v", this.PauseInfo)+",\n")
+	}
+	if this.CompletionCallbacks != nil {
+		s = append(s, "CompletionCallbacks: "+fmt.Sprintf("%#v", this.CompletionCallbacks)+",\n")
+	}
+	if this.BuildIdAssignment != nil {
+		s = append(s, "BuildIdAssignment: "+fmt.Sprintf("%#v", this.BuildIdAssignment)+",\n")
+	}
+	s = append(s, "TaskPriority: "+fmt.Sprintf("%#v", this.TaskPriority)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkflowPauseInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.WorkflowPauseInfo{")
+	s = append(s, "PauseTime: "+fmt.Sprintf("%#v", this.PauseTime)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4021,7 +4364,7 @@ func (this *TransferTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 20)
 	s = append(s, "&persistence.TransferTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4051,6 +4394,14 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails_) GoString() string {
 		`CloseExecutionTaskDetails:` + fmt.Sprintf("%#v", this.CloseExecutionTaskDetails) + `}`}, ", ")
 	return s
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails_) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&persistence.TransferTaskInfo_CompletionCallbackTaskDetails_{` +
+		`CompletionCallbackTaskDetails:` + fmt.Sprintf("%#v", this.CompletionCallbackTaskDetails) + `}`}, ", ")
+	return s
+}
 func (this *TransferTaskInfo_CloseExecutionTaskDetails) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4061,6 +4412,16 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.TransferTaskInfo_CompletionCallbackTaskDetails{")
+	s = append(s, "CallbackIndex: "+fmt.Sprintf("%#v", this.CallbackIndex)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *ReplicationTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4106,7 +4467,7 @@ func (this *TimerTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&persistence.TimerTaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4121,6 +4482,8 @@ func (this *TimerTaskInfo) GoString() string {
 	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
 	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
 	s = append(s, "AlreadyArchived: "+fmt.Sprintf("%#v", this.AlreadyArchived)+",\n")
+	s = append(s, "CallbackIndex: "+fmt.Sprintf("%#v", this.CallbackIndex)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4144,7 +4507,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 33)
+	s := make([]string, 0, 35)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -4179,6 +4542,8 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "Stamp: "+fmt.Sprintf("%#v", this.Stamp)+",\n")
+	s = append(s, "TaskPriority: "+fmt.Sprintf("%#v", this.TaskPriority)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4437,9 +4802,16 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.BaseExecutionInfo != nil {
+	if m.TaskPriority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.TaskPriority))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BuildIdAssignment != nil {
 		{
-			size, err := m.BaseExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BuildIdAssignment.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4449,10 +4821,54 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xfa
 	}
-	if m.SignalExternalCount != 0 {
-		i = encodeVarintExecutions(dAtA, i, uint64(m.SignalExternalCount))
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.PauseInfo != nil {
+		{
+			size, err := m.PauseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.BaseExecutionInfo != nil {
+		{
+			size, err := m.BaseExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.SignalExternalCount != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.SignalExternalCount))
 		i--
 		dAtA[i] = 0x4
 		i--
@@ -4525,12 +4941,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.CloseTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
-		if err5 != nil {
-			return 0, err5
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n5
-		i = encodeVarintExecutions(dAtA, i, uint64(n5))
+		i -= n7
+		i = encodeVarintExecutions(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x4
 		i--
@@ -4581,12 +4997,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xea
 	}
 	if m.ExecutionTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintExecutions(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintExecutions(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4607,12 +5023,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowRunExpirationTime != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintExecutions(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintExecutions(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4765,12 +5181,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err14 != nil {
-			return 0, err14
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4792,24 +5208,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err15 != nil {
-			return 0, err15
+		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err16 != nil {
-			return 0, err16
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4823,12 +5239,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err17 != nil {
-			return 0, err17
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4862,12 +5278,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err18 != nil {
-			return 0, err18
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4886,24 +5302,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err19 != nil {
-			return 0, err19
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err20 != nil {
-			return 0, err20
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n22
+		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4917,12 +5333,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err21 != nil {
-			return 0, err21
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i -= n23
+		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4950,24 +5366,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err22 != nil {
-			return 0, err22
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n22
-		i = encodeVarintExecutions(dAtA, i, uint64(n22))
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err23 != nil {
-			return 0, err23
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n23
-		i = encodeVarintExecutions(dAtA, i, uint64(n23))
+		i -= n25
+		i = encodeVarintExecutions(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4995,32 +5411,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err24 != nil {
-			return 0, err24
+		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n24
-		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.WorkflowRunTimeout != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
-		if err25 != nil {
-			return 0, err25
+		n27, err27 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.WorkflowExecutionTimeout != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
-		if err26 != nil {
-			return 0, err26
+		n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -5086,6 +5502,53 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkflowPauseInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowPauseInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowPauseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PauseTime != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PauseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5201,12 +5664,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x78
 	}
 	if m.VisibilityTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err27 != nil {
-			return 0, err27
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err30 != nil {
+			return 0, err30
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -5315,6 +5778,29 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails_) MarshalToSizedBuffer(dAtA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompletionCallbackTaskDetails != nil {
+		{
+			size, err := m.CompletionCallbackTaskDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TransferTaskInfo_CloseExecutionTaskDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5348,6 +5834,34 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackIndex != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.CallbackIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReplicationTaskInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5378,12 +5892,12 @@ func (m *ReplicationTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.VisibilityTime != nil {
-		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err29 != nil {
-			return 0, err29
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n29
-		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5483,32 +5997,32 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x50
 	}
 	if m.StartTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err30 != nil {
-			return 0, err30
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n30
-		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.CloseTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
-		if err31 != nil {
-			return 0, err31
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.VisibilityTime != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err32 != nil {
-			return 0, err32
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n36
+		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5571,6 +6085,16 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stamp != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CallbackIndex != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.CallbackIndex))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.AlreadyArchived {
 		i--
 		if m.AlreadyArchived {
@@ -5589,12 +6113,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 	}
 	if m.VisibilityTime != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err33 != nil {
-			return 0, err33
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n37
+		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -5678,12 +6202,12 @@ func (m *ArchivalTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err34 != nil {
-			return 0, err34
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5746,13 +6270,27 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TaskPriority != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.TaskPriority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Stamp != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.Stamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err35 != nil {
-			return 0, err35
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5822,12 +6360,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err38 != nil {
-			return 0, err38
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5841,24 +6379,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err39 != nil {
-			return 0, err39
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n39
-		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err40 != nil {
-			return 0, err40
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5924,42 +6462,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err41 != nil {
-			return 0, err41
+		n45, err45 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n45
+		i = encodeVarintExecutions(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err42 != nil {
-			return 0, err42
+		n46, err46 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err46 != nil {
+			return 0, err46
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n46
+		i = encodeVarintExecutions(dAtA, i, uint64(n46))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err43 != nil {
-			return 0, err43
+		n47, err47 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n43
-		i = encodeVarintExecutions(dAtA, i, uint64(n43))
+		i -= n47
+		i = encodeVarintExecutions(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err44 != nil {
-			return 0, err44
+		n48, err48 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err48 != nil {
+			return 0, err48
 		}
-		i -= n44
-		i = encodeVarintExecutions(dAtA, i, uint64(n44))
+		i -= n48
+		i = encodeVarintExecutions(dAtA, i, uint64(n48))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5978,12 +6516,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err45 != nil {
-			return 0, err45
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n45
-		i = encodeVarintExecutions(dAtA, i, uint64(n45))
+		i -= n49
+		i = encodeVarintExecutions(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5993,12 +6531,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err46 != nil {
-			return 0, err46
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n46
-		i = encodeVarintExecutions(dAtA, i, uint64(n46))
+		i -= n50
+		i = encodeVarintExecutions(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -6048,12 +6586,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err47 != nil {
-			return 0, err47
+		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err51 != nil {
+			return 0, err51
 		}
-		i -= n47
-		i = encodeVarintExecutions(dAtA, i, uint64(n47))
+		i -= n51
+		i = encodeVarintExecutions(dAtA, i, uint64(n51))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6708,6 +7246,44 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = m.BaseExecutionInfo.Size()
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.PauseInfo != nil {
+		l = m.PauseInfo.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 2 + l + sovExecutions(uint64(l))
+		}
+	}
+	if m.BuildIdAssignment != nil {
+		l = m.BuildIdAssignment.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if m.TaskPriority != 0 {
+		n += 2 + sovExecutions(uint64(m.TaskPriority))
+	}
+	return n
+}
+
+func (m *WorkflowPauseInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.PauseTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6820,6 +7396,18 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails_) Size() (n int) {
 	}
 	return n
 }
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionCallbackTaskDetails != nil {
+		l = m.CompletionCallbackTaskDetails.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
 func (m *TransferTaskInfo_CloseExecutionTaskDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6832,6 +7420,18 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) Size() (n int) {
 	return n
 }
 
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallbackIndex != 0 {
+		n += 1 + sovExecutions(uint64(m.CallbackIndex))
+	}
+	return n
+}
+
 func (m *ReplicationTaskInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6982,6 +7582,12 @@ func (m *TimerTaskInfo) Size() (n int) {
 	if m.AlreadyArchived {
 		n += 2
 	}
+	if m.CallbackIndex != 0 {
+		n += 1 + sovExecutions(uint64(m.CallbackIndex))
+	}
+	if m.Stamp != 0 {
+		n += 1 + sovExecutions(uint64(m.Stamp))
+	}
 	return n
 }
 
@@ -7132,6 +7738,12 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	if m.Stamp != 0 {
+		n += 2 + sovExecutions(uint64(m.Stamp))
+	}
+	if m.TaskPriority != 0 {
+		n += 2 + sovExecutions(uint64(m.TaskPriority))
+	}
 	return n
 }
 
@@ -7370,6 +7982,11 @@ func (this *WorkflowExecutionInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCompletionCallbacks := "[]*CompletionCallbackInfo{"
+	for _, f := range this.CompletionCallbacks {
+		repeatedStringForCompletionCallbacks += strings.Replace(fmt.Sprintf("%v", f), "CompletionCallbackInfo", "v15.CompletionCallbackInfo", 1) + ","
+	}
+	repeatedStringForCompletionCallbacks += "}"
 	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
 	for k, _ := range this.SearchAttributes {
 		keysForSearchAttributes = append(keysForSearchAttributes, k)
@@ -7457,6 +8074,22 @@ func (this *WorkflowExecutionInfo) String() string {
 		`RequestCancelExternalCount:` + fmt.Sprintf("%v", this.RequestCancelExternalCount) + `,`,
 		`SignalExternalCount:` + fmt.Sprintf("%v", this.SignalExternalCount) + `,`,
 		`BaseExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.BaseExecutionInfo), "BaseExecutionInfo", "v15.BaseExecutionInfo", 1) + `,`,
+		`PauseInfo:` + strings.Replace(this.PauseInfo.String(), "WorkflowPauseInfo", "WorkflowPauseInfo", 1) + `,`,
+		`CompletionCallbacks:` + repeatedStringForCompletionCallbacks + `,`,
+		`BuildIdAssignment:` + strings.Replace(fmt.Sprintf("%v", this.BuildIdAssignment), "BuildIdAssignment", "BuildIdAssignment", 1) + `,`,
+		`TaskPriority:` + fmt.Sprintf("%v", this.TaskPriority) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowPauseInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowPauseInfo{`,
+		`PauseTime:` + strings.Replace(fmt.Sprintf("%v", this.PauseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7518,6 +8151,16 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails_) String() string {
 	}, "")
 	return s
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferTaskInfo_CompletionCallbackTaskDetails_{`,
+		`CompletionCallbackTaskDetails:` + strings.Replace(fmt.Sprintf("%v", this.CompletionCallbackTaskDetails), "TransferTaskInfo_CompletionCallbackTaskDetails", "TransferTaskInfo_CompletionCallbackTaskDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TransferTaskInfo_CloseExecutionTaskDetails) String() string {
 	if this == nil {
 		return "nil"
@@ -7528,6 +8171,16 @@ func (this *TransferTaskInfo_CloseExecutionTaskDetails) String() string {
 	}, "")
 	return s
 }
+func (this *TransferTaskInfo_CompletionCallbackTaskDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferTaskInfo_CompletionCallbackTaskDetails{`,
+		`CallbackIndex:` + fmt.Sprintf("%v", this.CallbackIndex) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ReplicationTaskInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -7587,6 +8240,8 @@ func (this *TimerTaskInfo) String() string {
 		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
 		`AlreadyArchived:` + fmt.Sprintf("%v", this.AlreadyArchived) + `,`,
+		`CallbackIndex:` + fmt.Sprintf("%v", this.CallbackIndex) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7641,6 +8296,8 @@ func (this *ActivityInfo) String() string {
 		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v12.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Stamp:` + fmt.Sprintf("%v", this.Stamp) + `,`,
+		`TaskPriority:` + fmt.Sprintf("%v", this.TaskPriority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10207,7 +10864,297 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChildExecutionCount", wireType)
 			}
-			m.ChildExecutionCount = 0
+			m.ChildExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildExecutionCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 73:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserTimerCount", wireType)
+			}
+			m.UserTimerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserTimerCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 74:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestCancelExternalCount", wireType)
+			}
+			m.RequestCancelExternalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestCancelExternalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 75:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalExternalCount", wireType)
+			}
+			m.SignalExternalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalExternalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 76:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseExecutionInfo == nil {
+				m.BaseExecutionInfo = &v15.BaseExecutionInfo{}
+			}
+			if err := m.BaseExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseInfo == nil {
+				m.PauseInfo = &WorkflowPauseInfo{}
+			}
+			if err := m.PauseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &v15.CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 79:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuildIdAssignment == nil {
+				m.BuildIdAssignment = &BuildIdAssignment{}
+			}
+			if err := m.BuildIdAssignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPriority", wireType)
+			}
+			m.TaskPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskPriority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowPauseInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowPauseInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseTime", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -10217,54 +11164,33 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChildExecutionCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 73:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserTimerCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
 			}
-			m.UserTimerCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UserTimerCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
 			}
-		case 74:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestCancelExternalCount", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RequestCancelExternalCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExecutions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestCancelExternalCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.PauseTime == nil {
+				m.PauseTime = new(time.Time)
 			}
-		case 75:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalExternalCount", wireType)
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PauseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SignalExternalCount = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -10274,16 +11200,29 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignalExternalCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 76:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -10293,27 +11232,23 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseExecutionInfo == nil {
-				m.BaseExecutionInfo = &v15.BaseExecutionInfo{}
-			}
-			if err := m.BaseExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11006,6 +11941,41 @@ func (m *TransferTaskInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskDetails = &TransferTaskInfo_CloseExecutionTaskDetails_{v}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbackTaskDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransferTaskInfo_CompletionCallbackTaskDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.TaskDetails = &TransferTaskInfo_CompletionCallbackTaskDetails_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -11103,6 +12073,78 @@ func (m *TransferTaskInfo_CloseExecutionTaskDetails) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *TransferTaskInfo_CompletionCallbackTaskDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionCallbackTaskDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionCallbackTaskDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackIndex", wireType)
+			}
+			m.CallbackIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ReplicationTaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12183,6 +13225,44 @@ func (m *TimerTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AlreadyArchived = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackIndex", wireType)
+			}
+			m.CallbackIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -13305,6 +14385,44 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stamp", wireType)
+			}
+			m.Stamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stamp |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPriority", wireType)
+			}
+			m.TaskPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskPriority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
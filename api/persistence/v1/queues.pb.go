@@ -34,15 +34,21 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v1 "go.temporal.io/api/common/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -297,6 +303,90 @@ func (m *QueueSliceRange) GetExclusiveMax() *TaskKey {
 	return nil
 }
 
+// HistoryTaskDLQMessage is a history task which kept failing and was moved out of its queue.
+type HistoryTaskDLQMessage struct {
+	ShardId     int32        `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	CategoryId  int32        `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Task        *v1.DataBlob `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	Attempt     int32        `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError   string       `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	EnqueueTime *time.Time   `protobuf:"bytes,6,opt,name=enqueue_time,json=enqueueTime,proto3,stdtime" json:"enqueue_time,omitempty"`
+}
+
+func (m *HistoryTaskDLQMessage) Reset()      { *m = HistoryTaskDLQMessage{} }
+func (*HistoryTaskDLQMessage) ProtoMessage() {}
+func (*HistoryTaskDLQMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7fa5f143ac80378, []int{5}
+}
+func (m *HistoryTaskDLQMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryTaskDLQMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryTaskDLQMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryTaskDLQMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryTaskDLQMessage.Merge(m, src)
+}
+func (m *HistoryTaskDLQMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryTaskDLQMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryTaskDLQMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryTaskDLQMessage proto.InternalMessageInfo
+
+func (m *HistoryTaskDLQMessage) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetTask() *v1.DataBlob {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetEnqueueTime() *time.Time {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueueAckLevel)(nil), "temporal.server.api.persistence.v1.QueueAckLevel")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.QueueAckLevel.ClusterAckLevelEntry")
@@ -305,6 +395,7 @@ func init() {
 	proto.RegisterType((*QueueReaderState)(nil), "temporal.server.api.persistence.v1.QueueReaderState")
 	proto.RegisterType((*QueueSliceScope)(nil), "temporal.server.api.persistence.v1.QueueSliceScope")
 	proto.RegisterType((*QueueSliceRange)(nil), "temporal.server.api.persistence.v1.QueueSliceRange")
+	proto.RegisterType((*HistoryTaskDLQMessage)(nil), "temporal.server.api.persistence.v1.HistoryTaskDLQMessage")
 }
 
 func init() {
@@ -312,41 +403,54 @@ func init() {
 }
 
 var fileDescriptor_b7fa5f143ac80378 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x9b, 0xa8, 0xdb, 0x6a, 0x5b, 0xb4, 0x43, 0x55, 0x90, 0x99, 0x7a, 0x9a,
-	0x84, 0x48, 0xb4, 0x76, 0x07, 0x04, 0x17, 0x18, 0x02, 0x01, 0x65, 0xd2, 0xc8, 0x90, 0x90, 0xb8,
-	0x44, 0x26, 0xfb, 0xd4, 0x9a, 0xa4, 0x49, 0xb0, 0xdd, 0xd0, 0xde, 0x78, 0x04, 0x1e, 0x03, 0x1e,
-	0x80, 0x77, 0xe0, 0xd8, 0xe3, 0x4e, 0x88, 0xa6, 0x1c, 0x38, 0xee, 0x11, 0x50, 0x9c, 0x26, 0x4d,
-	0x07, 0x88, 0x8c, 0x5b, 0x3e, 0xdb, 0xff, 0xdf, 0xf7, 0xff, 0xfe, 0x75, 0x8d, 0x4d, 0x09, 0xa3,
-	0x30, 0xe0, 0xd4, 0x33, 0x05, 0xf0, 0x08, 0xb8, 0x49, 0x43, 0x66, 0x86, 0xc0, 0x05, 0x13, 0x12,
-	0x7c, 0x07, 0xcc, 0xe8, 0xc0, 0x7c, 0x37, 0x86, 0x31, 0x08, 0x23, 0xe4, 0x81, 0x0c, 0xf4, 0x4e,
-	0x26, 0x30, 0x52, 0x81, 0x41, 0x43, 0x66, 0x14, 0x04, 0x46, 0x74, 0xd0, 0xee, 0x95, 0x80, 0x86,
-	0x1c, 0xce, 0x98, 0x43, 0x65, 0x06, 0x6e, 0x1b, 0x25, 0x44, 0x92, 0x0a, 0x77, 0x79, 0xbe, 0xf3,
-	0x03, 0xe1, 0xe6, 0x8b, 0xc4, 0xd9, 0x03, 0xc7, 0x7d, 0x0e, 0x11, 0x78, 0xfa, 0x75, 0x5c, 0xa3,
-	0x8e, 0x6b, 0x7b, 0x49, 0xd1, 0x42, 0x7b, 0x68, 0xbf, 0x6a, 0x5d, 0xa3, 0xd9, 0x26, 0xc7, 0x3b,
-	0x8e, 0x37, 0x16, 0x12, 0xb8, 0xbd, 0x3a, 0x54, 0xd9, 0xab, 0xee, 0xd7, 0xbb, 0x8f, 0x8d, 0x7f,
-	0xcf, 0x64, 0xac, 0xb5, 0x32, 0x1e, 0xa6, 0xa8, 0xac, 0x7e, 0xe4, 0x4b, 0x3e, 0xb5, 0xb6, 0x9c,
-	0xf5, 0xd5, 0xf6, 0x11, 0xde, 0xfd, 0xd3, 0x41, 0x7d, 0x1b, 0x57, 0x5d, 0x98, 0x2a, 0x8b, 0x35,
-	0x2b, 0xf9, 0xd4, 0x77, 0xf1, 0x46, 0x44, 0xbd, 0x31, 0xb4, 0x2a, 0xca, 0x76, 0x5a, 0xdc, 0xad,
-	0xdc, 0x41, 0x9d, 0x6f, 0x15, 0x8c, 0x55, 0xef, 0x53, 0x49, 0x25, 0xe8, 0x80, 0x9b, 0x1c, 0xe8,
-	0x19, 0x70, 0x5b, 0x24, 0xb5, 0x68, 0x21, 0x35, 0xc2, 0xfd, 0xd2, 0x23, 0x28, 0x8c, 0x61, 0x29,
-	0x86, 0xfa, 0x16, 0xa9, 0xf9, 0x06, 0x2f, 0x2c, 0xe9, 0x1c, 0xdf, 0x84, 0x49, 0x32, 0x0e, 0x8b,
-	0xc0, 0x5e, 0x36, 0x1c, 0xb2, 0xc1, 0xd0, 0x7e, 0x4f, 0x25, 0xf0, 0x11, 0xe5, 0xae, 0x72, 0x5a,
-	0xef, 0xde, 0x2a, 0xd3, 0xf8, 0x25, 0x15, 0x6e, 0x1f, 0xa6, 0xd6, 0x8d, 0x9c, 0x99, 0xf6, 0x7f,
-	0xc2, 0x06, 0xc3, 0x57, 0x19, 0xb0, 0x3d, 0xc6, 0x3b, 0xbf, 0xd9, 0x2a, 0x46, 0x55, 0x4d, 0xa3,
-	0x7a, 0x56, 0x8c, 0xaa, 0xde, 0x3d, 0x2c, 0x3d, 0x79, 0x01, 0x5e, 0x0c, 0xd8, 0xc6, 0xdb, 0x97,
-	0xb7, 0xf5, 0x3e, 0xde, 0x14, 0x4e, 0x10, 0xe6, 0xf1, 0xf6, 0xca, 0xc7, 0xeb, 0x31, 0x07, 0x4e,
-	0x13, 0xad, 0xb5, 0x44, 0x74, 0x3e, 0x23, 0xbc, 0x75, 0x69, 0x4f, 0x7f, 0x8a, 0x37, 0x38, 0xf5,
-	0x07, 0xa0, 0x06, 0xbb, 0x32, 0xdf, 0x4a, 0xa4, 0x56, 0x4a, 0xd0, 0xfb, 0xb8, 0x96, 0xff, 0x97,
-	0x96, 0x99, 0xdc, 0x2e, 0x83, 0x3b, 0xc9, 0x44, 0xd6, 0x4a, 0xdf, 0xf9, 0xb2, 0xe6, 0x55, 0xf5,
-	0xd1, 0x4f, 0x70, 0x93, 0xf9, 0xd9, 0x5d, 0x18, 0x31, 0x7f, 0xe9, 0xf9, 0x4a, 0xbf, 0x7c, 0x23,
-	0x27, 0x1c, 0x33, 0x3f, 0x21, 0xae, 0x6e, 0xd7, 0x88, 0x4e, 0xfe, 0xe7, 0x2e, 0x35, 0x72, 0xc2,
-	0x31, 0x9d, 0x1c, 0xbd, 0x9d, 0xcd, 0x89, 0x76, 0x3e, 0x27, 0xda, 0xc5, 0x9c, 0xa0, 0x0f, 0x31,
-	0x41, 0x9f, 0x62, 0x82, 0xbe, 0xc6, 0x04, 0xcd, 0x62, 0x82, 0xbe, 0xc7, 0x04, 0xfd, 0x8c, 0x89,
-	0x76, 0x11, 0x13, 0xf4, 0x71, 0x41, 0xb4, 0xd9, 0x82, 0x68, 0xe7, 0x0b, 0xa2, 0xbd, 0x3e, 0x1c,
-	0x04, 0xab, 0x96, 0x2c, 0xf8, 0xfb, 0xc3, 0x73, 0xaf, 0x50, 0xbe, 0xd9, 0x54, 0xef, 0x4f, 0xef,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x23, 0x0d, 0xe4, 0x3b, 0x05, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0x13, 0x02, 0x64, 0x12, 0x04, 0x8c, 0xa8, 0xe4, 0xa6, 0xad, 0x43, 0xa3, 0x1e, 0x90,
+	0xaa, 0xda, 0xe2, 0xcf, 0xa1, 0x6a, 0x2f, 0x6d, 0x80, 0x0a, 0x0a, 0x48, 0x60, 0x90, 0x2a, 0xf5,
+	0x62, 0x0d, 0xf6, 0xab, 0x33, 0x8d, 0xed, 0x71, 0x67, 0x26, 0x29, 0xb9, 0xf5, 0xd6, 0x2b, 0x1f,
+	0xa3, 0xfd, 0x00, 0xfd, 0x0e, 0x3d, 0x72, 0xe4, 0xd4, 0x2e, 0x61, 0x0f, 0x7b, 0xe4, 0x23, 0xac,
+	0x66, 0x6c, 0x27, 0x81, 0xdd, 0xd5, 0x86, 0xbd, 0xcd, 0x7b, 0xf3, 0x7e, 0xbf, 0xf7, 0x7b, 0xcf,
+	0xe3, 0x1f, 0x72, 0x24, 0xc4, 0x29, 0xe3, 0x24, 0x72, 0x04, 0xf0, 0x01, 0x70, 0x87, 0xa4, 0xd4,
+	0x49, 0x81, 0x0b, 0x2a, 0x24, 0x24, 0x3e, 0x38, 0x83, 0x4d, 0xe7, 0xb7, 0x3e, 0xf4, 0x41, 0xd8,
+	0x29, 0x67, 0x92, 0xe1, 0x76, 0x01, 0xb0, 0x33, 0x80, 0x4d, 0x52, 0x6a, 0x4f, 0x01, 0xec, 0xc1,
+	0x66, 0xb3, 0x15, 0x32, 0x16, 0x46, 0xe0, 0x68, 0xc4, 0x65, 0xff, 0x17, 0x47, 0xd2, 0x18, 0x84,
+	0x24, 0x71, 0x9a, 0x91, 0x34, 0x3f, 0x0f, 0x20, 0x85, 0x24, 0x80, 0xc4, 0xa7, 0x20, 0x9c, 0x90,
+	0x85, 0x4c, 0xe7, 0xf5, 0x29, 0x2f, 0xf9, 0x62, 0x2c, 0x4c, 0x29, 0xf2, 0x59, 0x1c, 0xb3, 0x44,
+	0x89, 0x89, 0x41, 0x08, 0x12, 0x42, 0x5e, 0xb5, 0x3d, 0x83, 0xfc, 0x94, 0x43, 0x40, 0x7d, 0x22,
+	0x8b, 0x11, 0x9a, 0xf6, 0x0c, 0x20, 0x49, 0x44, 0x2f, 0xaf, 0x6f, 0xbf, 0x34, 0xd0, 0xd2, 0x99,
+	0xda, 0xc1, 0xf7, 0x7e, 0xef, 0x18, 0x06, 0x10, 0xe1, 0x4f, 0x50, 0x8d, 0xf8, 0x3d, 0x2f, 0x52,
+	0x81, 0x69, 0xac, 0x1b, 0x1b, 0x15, 0x77, 0x91, 0x14, 0x97, 0x1c, 0xad, 0xfa, 0x51, 0x5f, 0x48,
+	0xe0, 0xde, 0xa4, 0xa8, 0xbc, 0x5e, 0xd9, 0xa8, 0x6f, 0xfd, 0x60, 0xbf, 0x7f, 0x7b, 0xf6, 0xa3,
+	0x56, 0xf6, 0x6e, 0x46, 0x55, 0xc4, 0xfb, 0x89, 0xe4, 0x43, 0x77, 0xd9, 0x7f, 0x9c, 0x6d, 0x76,
+	0xd0, 0xda, 0xdb, 0x0a, 0xf1, 0x0a, 0xaa, 0xf4, 0x60, 0xa8, 0x25, 0xd6, 0x5c, 0x75, 0xc4, 0x6b,
+	0xa8, 0x3a, 0x20, 0x51, 0x1f, 0xcc, 0xb2, 0x96, 0x9d, 0x05, 0xdf, 0x94, 0xbf, 0x36, 0xda, 0xff,
+	0x95, 0x11, 0xd2, 0xbd, 0xcf, 0x25, 0x91, 0x80, 0x01, 0x2d, 0x71, 0x20, 0x01, 0x70, 0x4f, 0xa8,
+	0x58, 0x98, 0x86, 0x1e, 0xe1, 0xbb, 0x99, 0x47, 0xd0, 0x34, 0xb6, 0xab, 0x39, 0xf4, 0x59, 0x64,
+	0xe2, 0x1b, 0x7c, 0x2a, 0x85, 0x39, 0x6a, 0xc1, 0x95, 0x1a, 0x87, 0x0e, 0xc0, 0xcb, 0x1b, 0x76,
+	0x69, 0xd8, 0xf5, 0x7e, 0x27, 0x12, 0x78, 0x4c, 0x78, 0x4f, 0x2b, 0xad, 0x6f, 0x7d, 0x39, 0x4b,
+	0xe3, 0x0b, 0x22, 0x7a, 0x47, 0x30, 0x74, 0x3f, 0x1d, 0x73, 0x66, 0xfd, 0x0f, 0x68, 0xd8, 0xfd,
+	0xa9, 0x20, 0x6c, 0xf6, 0xd1, 0xea, 0x1b, 0xb2, 0xa6, 0x57, 0x55, 0xc9, 0x56, 0xf5, 0xe3, 0xf4,
+	0xaa, 0xea, 0x5b, 0x3b, 0x33, 0x4f, 0x3e, 0x45, 0x3e, 0xbd, 0x60, 0x0f, 0xad, 0x3c, 0xbd, 0xc6,
+	0x47, 0x68, 0x5e, 0xf8, 0x2c, 0x1d, 0xaf, 0x77, 0x7b, 0xf6, 0xf5, 0x46, 0xd4, 0x87, 0x73, 0x85,
+	0x75, 0x73, 0x8a, 0xf6, 0xdf, 0x06, 0x5a, 0x7e, 0x72, 0x87, 0x0f, 0x51, 0x95, 0x93, 0x24, 0x04,
+	0x3d, 0xd8, 0xb3, 0xf9, 0x5d, 0x05, 0x75, 0x33, 0x06, 0x7c, 0x84, 0x6a, 0xe3, 0x7f, 0x29, 0xdf,
+	0xc9, 0x57, 0xb3, 0xd0, 0x9d, 0x16, 0x20, 0x77, 0x82, 0x6f, 0xff, 0xf3, 0x48, 0xab, 0xee, 0x83,
+	0x4f, 0xd1, 0x12, 0x4d, 0x8a, 0xb7, 0x10, 0xd3, 0xc4, 0x34, 0x9e, 0xff, 0xe5, 0x1b, 0x63, 0x86,
+	0x13, 0x9a, 0x28, 0xc6, 0xc9, 0xeb, 0x8a, 0xc9, 0xd5, 0x87, 0xbc, 0xa5, 0xc6, 0x98, 0xe1, 0x84,
+	0x5c, 0xb5, 0xff, 0x2c, 0xa3, 0x8f, 0x0e, 0xa8, 0x90, 0x8c, 0x0f, 0x55, 0xc1, 0xde, 0xf1, 0xd9,
+	0x49, 0xe6, 0x48, 0xf8, 0x63, 0xb4, 0x28, 0xba, 0x84, 0x07, 0x1e, 0x0d, 0xb4, 0xf0, 0xaa, 0xbb,
+	0xa0, 0xe3, 0xc3, 0x00, 0xb7, 0x50, 0x5d, 0x0d, 0x1d, 0x32, 0x3e, 0x54, 0xb7, 0x65, 0x7d, 0x8b,
+	0x8a, 0xd4, 0x61, 0x80, 0x77, 0xd0, 0x9c, 0x72, 0x1c, 0xb3, 0xa2, 0xe5, 0xad, 0x4f, 0xe4, 0x29,
+	0x5d, 0x99, 0xf9, 0x29, 0x49, 0x7b, 0x44, 0x92, 0x4e, 0xc4, 0x2e, 0x5d, 0x5d, 0x8d, 0x4d, 0xb4,
+	0x40, 0xa4, 0x2a, 0x95, 0xe6, 0x5c, 0xd6, 0x30, 0x0f, 0xf1, 0x67, 0x08, 0x45, 0x44, 0x48, 0x0f,
+	0x38, 0x67, 0xdc, 0xac, 0xea, 0xdf, 0xbf, 0xa6, 0x32, 0xfb, 0x2a, 0x81, 0x77, 0x51, 0x03, 0x12,
+	0x6d, 0xeb, 0x9e, 0xb2, 0x66, 0x73, 0x5e, 0xb7, 0x6d, 0xda, 0x99, 0x6f, 0xdb, 0x85, 0x6f, 0xdb,
+	0x17, 0x85, 0x6f, 0x77, 0xe6, 0xae, 0xff, 0x6f, 0x19, 0x6e, 0x3d, 0x47, 0xa9, 0x7c, 0xe7, 0xd7,
+	0x9b, 0x3b, 0xab, 0x74, 0x7b, 0x67, 0x95, 0x1e, 0xee, 0x2c, 0xe3, 0x8f, 0x91, 0x65, 0xfc, 0x35,
+	0xb2, 0x8c, 0x7f, 0x47, 0x96, 0x71, 0x33, 0xb2, 0x8c, 0x17, 0x23, 0xcb, 0x78, 0x35, 0xb2, 0x4a,
+	0x0f, 0x23, 0xcb, 0xb8, 0xbe, 0xb7, 0x4a, 0x37, 0xf7, 0x56, 0xe9, 0xf6, 0xde, 0x2a, 0xfd, 0xbc,
+	0x13, 0xb2, 0xc9, 0x74, 0x94, 0xbd, 0xdb, 0x82, 0xbf, 0x9d, 0x0a, 0x2f, 0xe7, 0xb5, 0xa4, 0xed,
+	0xd7, 0x03, 0x00, 0xa7, 0x29, 0x13, 0x1b, 0xaf, 0x06, 0x00, 0x00,
 }
 
 func (this *QueueAckLevel) Equal(that interface{}) bool {
@@ -496,6 +600,49 @@ func (this *QueueSliceRange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryTaskDLQMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryTaskDLQMessage)
+	if !ok {
+		that2, ok := that.(HistoryTaskDLQMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.CategoryId != that1.CategoryId {
+		return false
+	}
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if that1.EnqueueTime == nil {
+		if this.EnqueueTime != nil {
+			return false
+		}
+	} else if !this.EnqueueTime.Equal(*that1.EnqueueTime) {
+		return false
+	}
+	return true
+}
 func (this *QueueAckLevel) GoString() string {
 	if this == nil {
 		return "nil"
@@ -586,6 +733,23 @@ func (this *QueueSliceRange) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryTaskDLQMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&persistence.HistoryTaskDLQMessage{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "CategoryId: "+fmt.Sprintf("%#v", this.CategoryId)+",\n")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "EnqueueTime: "+fmt.Sprintf("%#v", this.EnqueueTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringQueues(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -829,6 +993,73 @@ func (m *QueueSliceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryTaskDLQMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskDLQMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskDLQMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnqueueTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EnqueueTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQueues(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintQueues(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueues(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueues(v)
 	base := offset
@@ -935,6 +1166,36 @@ func (m *QueueSliceRange) Size() (n int) {
 	return n
 }
 
+func (m *HistoryTaskDLQMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovQueues(uint64(m.ShardId))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovQueues(uint64(m.CategoryId))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovQueues(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	if m.EnqueueTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime)
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	return n
+}
+
 func sovQueues(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1020,6 +1281,21 @@ func (this *QueueSliceRange) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryTaskDLQMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryTaskDLQMessage{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`CategoryId:` + fmt.Sprintf("%v", this.CategoryId) + `,`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "DataBlob", "v1.DataBlob", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`EnqueueTime:` + strings.Replace(fmt.Sprintf("%v", this.EnqueueTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringQueues(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1754,6 +2030,220 @@ func (m *QueueSliceRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryTaskDLQMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueues
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &v1.DataBlob{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueTime == nil {
+				m.EnqueueTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EnqueueTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueues(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQueues(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
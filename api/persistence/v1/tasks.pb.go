@@ -28,6 +28,7 @@
 package persistence
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -117,6 +118,18 @@ type TaskInfo struct {
 	CreateTime       *time.Time      `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time,omitempty"`
 	ExpiryTime       *time.Time      `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	Clock            *v1.VectorClock `protobuf:"bytes,7,opt,name=clock,proto3" json:"clock,omitempty"`
+	// Lower values are dispatched first. Zero means the task queue default priority.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Backlog tasks with different fairness keys are dispatched round-robin.
+	FairnessKey      string `protobuf:"bytes,9,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
+	WorkflowTypeName string `protobuf:"bytes,10,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	// The task is not dispatched before this time.
+	NotBefore *time.Time `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	// Only set on sticky workflow tasks, the task queue the task is moved to when the sticky worker
+	// stops polling or is saturated.
+	NormalTaskQueue string `protobuf:"bytes,12,opt,name=normal_task_queue,json=normalTaskQueue,proto3" json:"normal_task_queue,omitempty"`
+	// Only set on workflow tasks, see BuildIdAssignment.
+	BuildIdAssignment *BuildIdAssignment `protobuf:"bytes,13,opt,name=build_id_assignment,json=buildIdAssignment,proto3" json:"build_id_assignment,omitempty"`
 }
 
 func (m *TaskInfo) Reset()      { *m = TaskInfo{} }
@@ -200,6 +213,48 @@ func (m *TaskInfo) GetClock() *v1.VectorClock {
 	return nil
 }
 
+func (m *TaskInfo) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskInfo) GetFairnessKey() string {
+	if m != nil {
+		return m.FairnessKey
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *TaskInfo) GetNormalTaskQueue() string {
+	if m != nil {
+		return m.NormalTaskQueue
+	}
+	return ""
+}
+
+func (m *TaskInfo) GetBuildIdAssignment() *BuildIdAssignment {
+	if m != nil {
+		return m.BuildIdAssignment
+	}
+	return nil
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	NamespaceId    string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -210,6 +265,8 @@ type TaskQueueInfo struct {
 	ExpiryTime     *time.Time        `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
 	LastUpdateTime *time.Time        `protobuf:"bytes,7,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
 	VersioningData *VersioningData   `protobuf:"bytes,8,opt,name=versioning_data,json=versioningData,proto3" json:"versioning_data,omitempty"`
+	// Only set on the root workflow partition, when partition auto scaling is enabled.
+	PartitionConfig *TaskQueuePartitionConfig `protobuf:"bytes,9,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 }
 
 func (m *TaskQueueInfo) Reset()      { *m = TaskQueueInfo{} }
@@ -300,16 +357,131 @@ func (m *TaskQueueInfo) GetVersioningData() *VersioningData {
 	return nil
 }
 
+func (m *TaskQueueInfo) GetPartitionConfig() *TaskQueuePartitionConfig {
+	if m != nil {
+		return m.PartitionConfig
+	}
+	return nil
+}
+
+// Partition counts chosen by partition auto scaling for the workflow and activity queues of a task queue.
+type TaskQueuePartitionConfig struct {
+	WorkflowPartitions *TaskQueuePartitionCounts `protobuf:"bytes,1,opt,name=workflow_partitions,json=workflowPartitions,proto3" json:"workflow_partitions,omitempty"`
+	ActivityPartitions *TaskQueuePartitionCounts `protobuf:"bytes,2,opt,name=activity_partitions,json=activityPartitions,proto3" json:"activity_partitions,omitempty"`
+}
+
+func (m *TaskQueuePartitionConfig) Reset()      { *m = TaskQueuePartitionConfig{} }
+func (*TaskQueuePartitionConfig) ProtoMessage() {}
+func (*TaskQueuePartitionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{3}
+}
+func (m *TaskQueuePartitionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueuePartitionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueuePartitionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueuePartitionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueuePartitionConfig.Merge(m, src)
+}
+func (m *TaskQueuePartitionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueuePartitionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueuePartitionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueuePartitionConfig proto.InternalMessageInfo
+
+func (m *TaskQueuePartitionConfig) GetWorkflowPartitions() *TaskQueuePartitionCounts {
+	if m != nil {
+		return m.WorkflowPartitions
+	}
+	return nil
+}
+
+func (m *TaskQueuePartitionConfig) GetActivityPartitions() *TaskQueuePartitionCounts {
+	if m != nil {
+		return m.ActivityPartitions
+	}
+	return nil
+}
+
+type TaskQueuePartitionCounts struct {
+	ReadPartitions  int32 `protobuf:"varint,1,opt,name=read_partitions,json=readPartitions,proto3" json:"read_partitions,omitempty"`
+	WritePartitions int32 `protobuf:"varint,2,opt,name=write_partitions,json=writePartitions,proto3" json:"write_partitions,omitempty"`
+}
+
+func (m *TaskQueuePartitionCounts) Reset()      { *m = TaskQueuePartitionCounts{} }
+func (*TaskQueuePartitionCounts) ProtoMessage() {}
+func (*TaskQueuePartitionCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{4}
+}
+func (m *TaskQueuePartitionCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueuePartitionCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueuePartitionCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueuePartitionCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueuePartitionCounts.Merge(m, src)
+}
+func (m *TaskQueuePartitionCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueuePartitionCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueuePartitionCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueuePartitionCounts proto.InternalMessageInfo
+
+func (m *TaskQueuePartitionCounts) GetReadPartitions() int32 {
+	if m != nil {
+		return m.ReadPartitions
+	}
+	return 0
+}
+
+func (m *TaskQueuePartitionCounts) GetWritePartitions() int32 {
+	if m != nil {
+		return m.WritePartitions
+	}
+	return 0
+}
+
 // Holds all the data related to worker versioning for a task queue.
 // Backwards-incompatible changes cannot be made, as this would make existing stored data unreadable
 type VersioningData struct {
 	VersionSets []*v12.CompatibleVersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+	// Gradual rollout of a new build ID for new workflow executions, if any.
+	Ramp *BuildIdRamp `protobuf:"bytes,2,opt,name=ramp,proto3" json:"ramp,omitempty"`
+	// The build IDs of the version sets which no longer get new workflow executions, i.e. which are neither the
+	// default nor the ramping build ID, with the time they stopped getting them.
+	SupersededBuildIds []*SupersededBuildId `protobuf:"bytes,3,rep,name=superseded_build_ids,json=supersededBuildIds,proto3" json:"superseded_build_ids,omitempty"`
 }
 
 func (m *VersioningData) Reset()      { *m = VersioningData{} }
 func (*VersioningData) ProtoMessage() {}
 func (*VersioningData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c734e3b35cf986, []int{3}
+	return fileDescriptor_f9c734e3b35cf986, []int{5}
 }
 func (m *VersioningData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,6 +517,171 @@ func (m *VersioningData) GetVersionSets() []*v12.CompatibleVersionSet {
 	return nil
 }
 
+func (m *VersioningData) GetRamp() *BuildIdRamp {
+	if m != nil {
+		return m.Ramp
+	}
+	return nil
+}
+
+func (m *VersioningData) GetSupersededBuildIds() []*SupersededBuildId {
+	if m != nil {
+		return m.SupersededBuildIds
+	}
+	return nil
+}
+
+type SupersededBuildId struct {
+	BuildId        string     `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	SupersededTime *time.Time `protobuf:"bytes,2,opt,name=superseded_time,json=supersededTime,proto3,stdtime" json:"superseded_time,omitempty"`
+}
+
+func (m *SupersededBuildId) Reset()      { *m = SupersededBuildId{} }
+func (*SupersededBuildId) ProtoMessage() {}
+func (*SupersededBuildId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{6}
+}
+func (m *SupersededBuildId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupersededBuildId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupersededBuildId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupersededBuildId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupersededBuildId.Merge(m, src)
+}
+func (m *SupersededBuildId) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupersededBuildId) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupersededBuildId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupersededBuildId proto.InternalMessageInfo
+
+func (m *SupersededBuildId) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *SupersededBuildId) GetSupersededTime() *time.Time {
+	if m != nil {
+		return m.SupersededTime
+	}
+	return nil
+}
+
+// Routes a percentage of the workflow executions of a task queue to a build ID other than the default one.
+type BuildIdRamp struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Percentage of workflow executions, in [0, 100], which are routed to the ramping build ID.
+	RampPercentage float32 `protobuf:"fixed32,2,opt,name=ramp_percentage,json=rampPercentage,proto3" json:"ramp_percentage,omitempty"`
+}
+
+func (m *BuildIdRamp) Reset()      { *m = BuildIdRamp{} }
+func (*BuildIdRamp) ProtoMessage() {}
+func (*BuildIdRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{7}
+}
+func (m *BuildIdRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildIdRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildIdRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildIdRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildIdRamp.Merge(m, src)
+}
+func (m *BuildIdRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildIdRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildIdRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildIdRamp proto.InternalMessageInfo
+
+func (m *BuildIdRamp) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *BuildIdRamp) GetRampPercentage() float32 {
+	if m != nil {
+		return m.RampPercentage
+	}
+	return 0
+}
+
+// The build ID a workflow run is assigned to by the start of its first workflow task. Build ID ramps only
+// route the workflow tasks of runs which are not assigned yet, the tasks of the other runs keep going to
+// the build ID they are assigned to.
+type BuildIdAssignment struct {
+	// Empty if the worker which started the first workflow task did not report a build ID.
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *BuildIdAssignment) Reset()      { *m = BuildIdAssignment{} }
+func (*BuildIdAssignment) ProtoMessage() {}
+func (*BuildIdAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9c734e3b35cf986, []int{8}
+}
+func (m *BuildIdAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildIdAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildIdAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildIdAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildIdAssignment.Merge(m, src)
+}
+func (m *BuildIdAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildIdAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildIdAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildIdAssignment proto.InternalMessageInfo
+
+func (m *BuildIdAssignment) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type TaskKey struct {
 	FireTime *time.Time `protobuf:"bytes,1,opt,name=fire_time,json=fireTime,proto3,stdtime" json:"fire_time,omitempty"`
 	TaskId   int64      `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -353,7 +690,7 @@ type TaskKey struct {
 func (m *TaskKey) Reset()      { *m = TaskKey{} }
 func (*TaskKey) ProtoMessage() {}
 func (*TaskKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c734e3b35cf986, []int{4}
+	return fileDescriptor_f9c734e3b35cf986, []int{9}
 }
 func (m *TaskKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +737,12 @@ func init() {
 	proto.RegisterType((*AllocatedTaskInfo)(nil), "temporal.server.api.persistence.v1.AllocatedTaskInfo")
 	proto.RegisterType((*TaskInfo)(nil), "temporal.server.api.persistence.v1.TaskInfo")
 	proto.RegisterType((*TaskQueueInfo)(nil), "temporal.server.api.persistence.v1.TaskQueueInfo")
+	proto.RegisterType((*TaskQueuePartitionConfig)(nil), "temporal.server.api.persistence.v1.TaskQueuePartitionConfig")
+	proto.RegisterType((*TaskQueuePartitionCounts)(nil), "temporal.server.api.persistence.v1.TaskQueuePartitionCounts")
 	proto.RegisterType((*VersioningData)(nil), "temporal.server.api.persistence.v1.VersioningData")
+	proto.RegisterType((*SupersededBuildId)(nil), "temporal.server.api.persistence.v1.SupersededBuildId")
+	proto.RegisterType((*BuildIdRamp)(nil), "temporal.server.api.persistence.v1.BuildIdRamp")
+	proto.RegisterType((*BuildIdAssignment)(nil), "temporal.server.api.persistence.v1.BuildIdAssignment")
 	proto.RegisterType((*TaskKey)(nil), "temporal.server.api.persistence.v1.TaskKey")
 }
 
@@ -409,52 +751,74 @@ func init() {
 }
 
 var fileDescriptor_f9c734e3b35cf986 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0x34, 0x3f, 0x93, 0x7e, 0xf9, 0xca, 0x48, 0x88, 0xa8, 0x48, 0x6e, 0x1b, 0x21,
-	0x28, 0xa8, 0xb2, 0xd5, 0xc0, 0x02, 0x09, 0x21, 0x68, 0x0b, 0x8b, 0x50, 0x36, 0x98, 0xd2, 0x05,
-	0x2c, 0xa2, 0xa9, 0xe7, 0x26, 0x98, 0xd8, 0x33, 0xc6, 0x33, 0x76, 0xc9, 0x8e, 0x47, 0xe8, 0x63,
-	0xf0, 0x28, 0x2c, 0x58, 0x74, 0x59, 0xb1, 0x81, 0xba, 0x1b, 0x96, 0x7d, 0x04, 0x34, 0xe3, 0xd8,
-	0x6d, 0x44, 0x2b, 0xb2, 0x60, 0x37, 0x77, 0xee, 0x39, 0x67, 0xee, 0x9c, 0xe3, 0x31, 0xb2, 0x24,
-	0x04, 0x21, 0x8f, 0x88, 0x6f, 0x0b, 0x88, 0x12, 0x88, 0x6c, 0x12, 0x7a, 0x76, 0x08, 0x91, 0xf0,
-	0x84, 0x04, 0xe6, 0x82, 0x9d, 0x6c, 0xd8, 0x92, 0x88, 0x91, 0xb0, 0xc2, 0x88, 0x4b, 0x8e, 0x3b,
-	0x39, 0xde, 0xca, 0xf0, 0x16, 0x09, 0x3d, 0xeb, 0x02, 0xde, 0x4a, 0x36, 0x96, 0x96, 0x87, 0x9c,
-	0x0f, 0x7d, 0xb0, 0x35, 0x63, 0x3f, 0x1e, 0xd8, 0xd2, 0x0b, 0x40, 0x48, 0x12, 0x84, 0x99, 0xc8,
-	0xd2, 0x2a, 0x85, 0x10, 0x18, 0x05, 0xe6, 0x7a, 0x20, 0xec, 0x21, 0x1f, 0x72, 0xbd, 0xaf, 0x57,
-	0x13, 0xc8, 0xed, 0x62, 0x2e, 0x35, 0x10, 0xb0, 0x38, 0x10, 0xf9, 0x28, 0xfd, 0x8f, 0x31, 0xc4,
-	0x30, 0xc1, 0xdd, 0x99, 0xc2, 0xa9, 0xb6, 0xee, 0x2a, 0x6c, 0x00, 0x42, 0x90, 0x61, 0x0e, 0xbc,
-	0x77, 0xd9, 0x45, 0x5d, 0x9f, 0xbb, 0xa3, 0x3f, 0xb0, 0x1d, 0x86, 0xae, 0x6d, 0xfa, 0x3e, 0x77,
-	0x89, 0x04, 0xba, 0x4b, 0xc4, 0xa8, 0xc7, 0x06, 0x1c, 0x3f, 0x45, 0x15, 0x4a, 0x24, 0x69, 0x1b,
-	0x2b, 0xc6, 0x5a, 0xb3, 0xbb, 0x6e, 0xfd, 0xdd, 0x08, 0x2b, 0xe7, 0x3a, 0x9a, 0x89, 0x6f, 0xa0,
-	0x9a, 0x9e, 0xdf, 0xa3, 0xed, 0xb9, 0x15, 0x63, 0xad, 0xec, 0x54, 0x55, 0xd9, 0xa3, 0x9d, 0xef,
-	0x73, 0xa8, 0x5e, 0x9c, 0xb3, 0x8a, 0x16, 0x18, 0x09, 0x40, 0x84, 0xc4, 0x05, 0x05, 0x55, 0xe7,
-	0x35, 0x9c, 0x66, 0xb1, 0xd7, 0xa3, 0x78, 0x19, 0x35, 0x0f, 0x78, 0x34, 0x1a, 0xf8, 0xfc, 0x20,
-	0x17, 0x6b, 0x38, 0x28, 0xdf, 0xea, 0x51, 0x7c, 0x1d, 0x55, 0xa3, 0x98, 0xa9, 0x5e, 0x59, 0xf7,
-	0xe6, 0xa3, 0x98, 0xf5, 0x28, 0x5e, 0x47, 0x58, 0xb8, 0xef, 0x81, 0xc6, 0x3e, 0xd0, 0x3e, 0x24,
-	0xc0, 0xa4, 0x82, 0x54, 0xf4, 0x2c, 0x8b, 0x45, 0xe7, 0xb9, 0x6a, 0xf4, 0x28, 0xde, 0x44, 0x4d,
-	0x37, 0x02, 0x22, 0xa1, 0xaf, 0xf2, 0x6b, 0xcf, 0xeb, 0x7b, 0x2f, 0x59, 0x59, 0xb8, 0x56, 0x1e,
-	0xae, 0xb5, 0x9b, 0x87, 0xbb, 0x55, 0x39, 0xfc, 0xb1, 0x6c, 0x38, 0x28, 0x23, 0xa9, 0x6d, 0x25,
-	0x01, 0x9f, 0x42, 0x2f, 0x1a, 0x67, 0x12, 0xd5, 0x59, 0x25, 0x32, 0x92, 0x96, 0x78, 0x82, 0xe6,
-	0x75, 0x4a, 0xed, 0x9a, 0x26, 0xdf, 0xbd, 0xd4, 0x77, 0x8d, 0x50, 0x8e, 0xef, 0x81, 0x2b, 0x79,
-	0xb4, 0xad, 0x4a, 0x27, 0xe3, 0x75, 0xbe, 0x95, 0xd1, 0x7f, 0xca, 0xdc, 0x57, 0xea, 0xbb, 0x98,
-	0xd5, 0x61, 0x8c, 0x2a, 0xaa, 0x9c, 0x58, 0xab, 0xd7, 0x78, 0x13, 0x35, 0x74, 0x7c, 0x72, 0x1c,
-	0x82, 0xf6, 0xb5, 0xd5, 0xbd, 0x75, 0x3e, 0x8d, 0x1a, 0x43, 0x7f, 0xa6, 0x79, 0xf0, 0xfa, 0xbc,
-	0xdd, 0x71, 0x08, 0x4e, 0x5d, 0xd1, 0xd4, 0x0a, 0x3f, 0x44, 0x95, 0x91, 0xc7, 0x32, 0xcb, 0x67,
-	0x60, 0xef, 0x78, 0x8c, 0x3a, 0x9a, 0x81, 0x6f, 0xa2, 0x06, 0x71, 0x47, 0x7d, 0x1f, 0x12, 0xf0,
-	0x75, 0x14, 0x65, 0xa7, 0x4e, 0xdc, 0xd1, 0x4b, 0x55, 0xff, 0x0b, 0x9b, 0x5f, 0xa0, 0x45, 0x9f,
-	0x08, 0xd9, 0x8f, 0x43, 0x5a, 0x24, 0x5e, 0x9b, 0x51, 0xa7, 0xa5, 0x98, 0x6f, 0x34, 0x51, 0x6b,
-	0xbd, 0x43, 0xff, 0x27, 0xea, 0x21, 0x70, 0xe6, 0xb1, 0x61, 0x5f, 0x3f, 0x9a, 0xba, 0x96, 0xea,
-	0xce, 0xf2, 0x68, 0xf6, 0x0a, 0xea, 0x33, 0x22, 0x89, 0xd3, 0x4a, 0xa6, 0xea, 0x0e, 0x45, 0xad,
-	0x69, 0x04, 0x76, 0xd0, 0xc2, 0x04, 0xd3, 0x17, 0x20, 0x45, 0xdb, 0x58, 0x29, 0xaf, 0x35, 0xbb,
-	0xf6, 0xb4, 0xb9, 0xc5, 0x9f, 0x41, 0x1d, 0xb1, 0xcd, 0x83, 0x90, 0x48, 0x6f, 0xdf, 0x87, 0x89,
-	0xd4, 0x6b, 0x90, 0x4e, 0x33, 0x29, 0xd6, 0xa2, 0x43, 0x50, 0x4d, 0xa5, 0xb0, 0x03, 0x63, 0xfc,
-	0x18, 0x35, 0x06, 0x5e, 0x34, 0xb1, 0xc4, 0x98, 0xd1, 0x92, 0xba, 0xa2, 0x68, 0x33, 0xae, 0x7a,
-	0xf4, 0x5b, 0x1f, 0x8e, 0x4e, 0xcc, 0xd2, 0xf1, 0x89, 0x59, 0x3a, 0x3b, 0x31, 0x8d, 0xcf, 0xa9,
-	0x69, 0x7c, 0x49, 0x4d, 0xe3, 0x6b, 0x6a, 0x1a, 0x47, 0xa9, 0x69, 0xfc, 0x4c, 0x4d, 0xe3, 0x57,
-	0x6a, 0x96, 0xce, 0x52, 0xd3, 0x38, 0x3c, 0x35, 0x4b, 0x47, 0xa7, 0x66, 0xe9, 0xf8, 0xd4, 0x2c,
-	0xbd, 0x7d, 0x30, 0xe4, 0xe7, 0x17, 0xf3, 0xf8, 0xd5, 0x7f, 0xed, 0x47, 0x17, 0xca, 0xfd, 0xaa,
-	0x1e, 0xf4, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xd4, 0xe2, 0x22, 0xee, 0x05, 0x00,
-	0x00,
+	// 1063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x76, 0x62, 0x8f, 0x53, 0x3b, 0x99, 0x82, 0x58, 0x82, 0xb4, 0x49, 0x2d, 0x44,
+	0xd3, 0xaa, 0x5a, 0xab, 0x01, 0x24, 0x24, 0x40, 0x90, 0x04, 0x0e, 0xa6, 0x08, 0xb5, 0xdb, 0xd0,
+	0x03, 0x1c, 0x56, 0xe3, 0xdd, 0xe7, 0x65, 0xf0, 0xee, 0xcc, 0x32, 0x33, 0xeb, 0xe0, 0x1b, 0x1f,
+	0xa1, 0xe2, 0xca, 0x17, 0xe0, 0x0b, 0xf0, 0x1d, 0x38, 0xe6, 0xd8, 0x1b, 0xc4, 0xb9, 0x70, 0xec,
+	0x91, 0x23, 0x9a, 0x59, 0xef, 0xc6, 0x49, 0x9a, 0xb2, 0x88, 0xde, 0x76, 0xde, 0xfb, 0xfd, 0xde,
+	0xff, 0xf7, 0x6c, 0xe4, 0x2a, 0x48, 0x52, 0x2e, 0x48, 0x3c, 0x90, 0x20, 0xa6, 0x20, 0x06, 0x24,
+	0xa5, 0x83, 0x14, 0x84, 0xa4, 0x52, 0x01, 0x0b, 0x60, 0x30, 0xbd, 0x3f, 0x50, 0x44, 0x4e, 0xa4,
+	0x9b, 0x0a, 0xae, 0x38, 0xee, 0x17, 0x78, 0x37, 0xc7, 0xbb, 0x24, 0xa5, 0xee, 0x12, 0xde, 0x9d,
+	0xde, 0xdf, 0xda, 0x8e, 0x38, 0x8f, 0x62, 0x18, 0x18, 0xc6, 0x28, 0x1b, 0x0f, 0x14, 0x4d, 0x40,
+	0x2a, 0x92, 0xa4, 0xb9, 0x91, 0xad, 0x5b, 0x21, 0xa4, 0xc0, 0x42, 0x60, 0x01, 0x05, 0x39, 0x88,
+	0x78, 0xc4, 0x8d, 0xdc, 0x7c, 0x2d, 0x20, 0xef, 0x94, 0x71, 0xe9, 0x80, 0x80, 0x65, 0x89, 0x2c,
+	0x42, 0xf1, 0x7f, 0xc8, 0x20, 0x83, 0x05, 0xee, 0xf6, 0x05, 0x9c, 0x56, 0x1b, 0xad, 0xc6, 0x26,
+	0x20, 0x25, 0x89, 0x0a, 0xe0, 0xdd, 0x17, 0x25, 0x1a, 0xc4, 0x3c, 0x98, 0x5c, 0xc1, 0xf6, 0x19,
+	0xda, 0xdc, 0x8f, 0x63, 0x1e, 0x10, 0x05, 0xe1, 0x11, 0x91, 0x93, 0x21, 0x1b, 0x73, 0xfc, 0x29,
+	0x6a, 0x84, 0x44, 0x11, 0xdb, 0xda, 0xb1, 0x76, 0x3b, 0x7b, 0xf7, 0xdc, 0x7f, 0x2f, 0x84, 0x5b,
+	0x70, 0x3d, 0xc3, 0xc4, 0x6f, 0xa0, 0x35, 0x13, 0x3f, 0x0d, 0xed, 0x95, 0x1d, 0x6b, 0xb7, 0xee,
+	0xad, 0xea, 0xe7, 0x30, 0xec, 0xff, 0xd2, 0x44, 0xad, 0xd2, 0xcf, 0x2d, 0xb4, 0xce, 0x48, 0x02,
+	0x32, 0x25, 0x01, 0x68, 0xa8, 0xf6, 0xd7, 0xf6, 0x3a, 0xa5, 0x6c, 0x18, 0xe2, 0x6d, 0xd4, 0x39,
+	0xe6, 0x62, 0x32, 0x8e, 0xf9, 0x71, 0x61, 0xac, 0xed, 0xa1, 0x42, 0x34, 0x0c, 0xf1, 0xeb, 0x68,
+	0x55, 0x64, 0x4c, 0xeb, 0xea, 0x46, 0xd7, 0x14, 0x19, 0x1b, 0x86, 0xf8, 0x1e, 0xc2, 0x32, 0xf8,
+	0x0e, 0xc2, 0x2c, 0x86, 0xd0, 0x87, 0x29, 0x30, 0xa5, 0x21, 0x0d, 0x13, 0xcb, 0x46, 0xa9, 0xf9,
+	0x5c, 0x2b, 0x86, 0x21, 0xde, 0x47, 0x9d, 0x40, 0x00, 0x51, 0xe0, 0xeb, 0xfe, 0xd9, 0x4d, 0x93,
+	0xf7, 0x96, 0x9b, 0x37, 0xd7, 0x2d, 0x9a, 0xeb, 0x1e, 0x15, 0xcd, 0x3d, 0x68, 0x3c, 0xfd, 0x63,
+	0xdb, 0xf2, 0x50, 0x4e, 0xd2, 0x62, 0x6d, 0x02, 0x7e, 0x4c, 0xa9, 0x98, 0xe5, 0x26, 0x56, 0xab,
+	0x9a, 0xc8, 0x49, 0xc6, 0xc4, 0x27, 0xa8, 0x69, 0xba, 0x64, 0xaf, 0x19, 0xf2, 0x9d, 0x17, 0xd6,
+	0xdd, 0x20, 0x74, 0xc5, 0x9f, 0x40, 0xa0, 0xb8, 0x38, 0xd4, 0x4f, 0x2f, 0xe7, 0xe1, 0x2d, 0xd4,
+	0x4a, 0x05, 0xe5, 0x82, 0xaa, 0x99, 0xdd, 0xda, 0xb1, 0x76, 0x9b, 0x5e, 0xf9, 0xd6, 0xb5, 0x1e,
+	0x13, 0x2a, 0x18, 0x48, 0xe9, 0x4f, 0x60, 0x66, 0xb7, 0xf3, 0x5a, 0x17, 0xb2, 0x07, 0x30, 0xd3,
+	0x35, 0x2b, 0x6b, 0xad, 0x66, 0x29, 0xf8, 0xba, 0x11, 0x36, 0x32, 0xc0, 0x8d, 0x42, 0x73, 0x34,
+	0x4b, 0xe1, 0x2b, 0x62, 0xa2, 0x45, 0x8c, 0x2b, 0x7f, 0x04, 0x63, 0x2e, 0xc0, 0xee, 0x54, 0xcc,
+	0xb7, 0xcd, 0xb8, 0x3a, 0x30, 0x14, 0x7c, 0x17, 0x6d, 0x32, 0x2e, 0x12, 0x12, 0xfb, 0xe7, 0xa3,
+	0x6e, 0xaf, 0x1b, 0x6f, 0xbd, 0x5c, 0xa1, 0x07, 0xe5, 0x91, 0x16, 0x63, 0x40, 0x37, 0x47, 0x19,
+	0x8d, 0x43, 0x9f, 0x86, 0x3e, 0x91, 0x92, 0x46, 0x2c, 0x01, 0xa6, 0xec, 0x1b, 0xc6, 0xeb, 0xfb,
+	0x55, 0x06, 0xf4, 0x40, 0xd3, 0x87, 0xe1, 0x7e, 0x49, 0xf6, 0x36, 0x47, 0x97, 0x45, 0xfd, 0xdf,
+	0x1a, 0xe8, 0x46, 0xe9, 0xb4, 0xea, 0x88, 0x62, 0xd4, 0x30, 0x85, 0xca, 0x67, 0xd3, 0x7c, 0xe3,
+	0x7d, 0xd4, 0x36, 0x49, 0xe9, 0x32, 0x9a, 0xc1, 0xec, 0xee, 0xbd, 0x7d, 0x1e, 0xa5, 0x0e, 0xcf,
+	0xec, 0x79, 0xb1, 0x39, 0xc6, 0x9f, 0xae, 0xac, 0xd7, 0xd2, 0x34, 0xfd, 0x85, 0x3f, 0x40, 0x8d,
+	0x09, 0x65, 0xf9, 0xcc, 0x56, 0x60, 0x3f, 0xa0, 0x2c, 0xf4, 0x0c, 0x03, 0xbf, 0x85, 0xda, 0x24,
+	0x98, 0xf8, 0x31, 0x4c, 0x21, 0x36, 0xb3, 0x5c, 0xf7, 0x5a, 0x24, 0x98, 0x7c, 0xa9, 0xdf, 0xaf,
+	0x62, 0x4e, 0xbf, 0x40, 0x1b, 0x31, 0x91, 0xca, 0xcf, 0xd2, 0xb0, 0x5c, 0x99, 0xb5, 0x8a, 0x76,
+	0xba, 0x9a, 0xf9, 0xb5, 0x21, 0x1a, 0x5b, 0xdf, 0xa2, 0xde, 0x54, 0x37, 0x8a, 0x33, 0xca, 0x22,
+	0xdf, 0x5c, 0x9d, 0x96, 0x31, 0xb5, 0x57, 0xa5, 0xa9, 0x4f, 0x4a, 0xea, 0x67, 0x44, 0x11, 0xaf,
+	0x3b, 0xbd, 0xf0, 0xc6, 0x11, 0xda, 0x48, 0x89, 0x50, 0x54, 0x51, 0xce, 0xfc, 0x80, 0xb3, 0x31,
+	0x8d, 0xcc, 0xdc, 0x77, 0xf6, 0x3e, 0xaa, 0x7a, 0xd3, 0x4c, 0x6d, 0x1f, 0x16, 0x46, 0x0e, 0x8d,
+	0x0d, 0xaf, 0x97, 0x5e, 0x14, 0xf4, 0xff, 0xb6, 0x90, 0x7d, 0x1d, 0x1a, 0x27, 0xe8, 0x66, 0xb9,
+	0x56, 0x25, 0x51, 0xda, 0xd6, 0xff, 0x0b, 0x24, 0x63, 0x4a, 0x7a, 0xe5, 0xbe, 0x96, 0x0a, 0xa9,
+	0xdd, 0x91, 0x40, 0xd1, 0x29, 0x55, 0xb3, 0x65, 0x77, 0x2b, 0xaf, 0xc2, 0x5d, 0x61, 0xf8, 0xdc,
+	0x5d, 0x9f, 0x21, 0xfb, 0x3a, 0x3c, 0xbe, 0x8d, 0x7a, 0x02, 0x48, 0x78, 0x39, 0xeb, 0xa6, 0xd7,
+	0xd5, 0xe2, 0xa5, 0x98, 0xef, 0xa0, 0x8d, 0x63, 0x41, 0x15, 0x5c, 0x0e, 0xb8, 0xe9, 0xf5, 0x8c,
+	0x7c, 0xc9, 0xdf, 0xcf, 0x2b, 0xa8, 0x7b, 0xb1, 0xed, 0xd8, 0x43, 0xeb, 0x8b, 0xc6, 0xfb, 0x12,
+	0x94, 0xf6, 0x51, 0xdf, 0xed, 0xec, 0x0d, 0x2e, 0x6e, 0x4c, 0xf9, 0x7b, 0xa9, 0x33, 0x3c, 0xe4,
+	0x49, 0x4a, 0x14, 0x1d, 0xc5, 0xb0, 0x30, 0xf5, 0x18, 0x94, 0xd7, 0x99, 0x96, 0xdf, 0x12, 0x1f,
+	0xa2, 0x86, 0x20, 0x49, 0xba, 0x28, 0xdb, 0xe0, 0x3f, 0x5c, 0x18, 0x8f, 0x24, 0xa9, 0x67, 0xc8,
+	0x38, 0x42, 0xaf, 0xc9, 0x4c, 0xa3, 0x20, 0x84, 0xd0, 0x2f, 0x0e, 0x98, 0xb4, 0xeb, 0x3b, 0xf5,
+	0xaa, 0x67, 0xeb, 0x71, 0xc9, 0x2f, 0xcc, 0x63, 0x79, 0x59, 0x24, 0xfb, 0x33, 0xb4, 0x79, 0x05,
+	0x88, 0xdf, 0x44, 0xad, 0xc2, 0xe5, 0xe2, 0x6c, 0xad, 0x2d, 0x2e, 0x1e, 0x1e, 0xa2, 0xde, 0x52,
+	0x60, 0x66, 0x81, 0x57, 0xaa, 0x2e, 0xf0, 0x39, 0x51, 0xab, 0xfa, 0x8f, 0x50, 0x67, 0x29, 0xf1,
+	0x97, 0x39, 0xd5, 0xd3, 0x40, 0x92, 0xd4, 0x4f, 0x41, 0x04, 0xc0, 0x14, 0x89, 0x72, 0xa7, 0x2b,
+	0x5e, 0x57, 0x8b, 0x1f, 0x96, 0xd2, 0xbe, 0x8b, 0x36, 0xaf, 0x5c, 0xeb, 0x97, 0x18, 0xee, 0x13,
+	0xb4, 0xa6, 0x47, 0x50, 0xff, 0x84, 0x7d, 0x8c, 0xda, 0x63, 0x2a, 0x16, 0x37, 0xc9, 0xaa, 0x98,
+	0x52, 0x4b, 0x53, 0xb4, 0xf0, 0xda, 0xbf, 0x2d, 0x07, 0xdf, 0x9f, 0x9c, 0x3a, 0xb5, 0x67, 0xa7,
+	0x4e, 0xed, 0xf9, 0xa9, 0x63, 0xfd, 0x34, 0x77, 0xac, 0x5f, 0xe7, 0x8e, 0xf5, 0xfb, 0xdc, 0xb1,
+	0x4e, 0xe6, 0x8e, 0xf5, 0xe7, 0xdc, 0xb1, 0xfe, 0x9a, 0x3b, 0xb5, 0xe7, 0x73, 0xc7, 0x7a, 0x7a,
+	0xe6, 0xd4, 0x4e, 0xce, 0x9c, 0xda, 0xb3, 0x33, 0xa7, 0xf6, 0xcd, 0x7b, 0x11, 0x3f, 0xef, 0x31,
+	0xe5, 0xd7, 0xff, 0xef, 0xfc, 0x70, 0xe9, 0x39, 0x5a, 0x35, 0x81, 0xbe, 0xfb, 0xcf, 0x00, 0x1f,
+	0x9f, 0x99, 0x08, 0xb0, 0x0a, 0x00, 0x00,
 }
 
 func (this *AllocatedTaskInfo) Equal(that interface{}) bool {
@@ -532,6 +896,28 @@ func (this *TaskInfo) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if this.FairnessKey != that1.FairnessKey {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if that1.NotBefore == nil {
+		if this.NotBefore != nil {
+			return false
+		}
+	} else if !this.NotBefore.Equal(*that1.NotBefore) {
+		return false
+	}
+	if this.NormalTaskQueue != that1.NormalTaskQueue {
+		return false
+	}
+	if !this.BuildIdAssignment.Equal(that1.BuildIdAssignment) {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueInfo) Equal(that interface{}) bool {
@@ -585,16 +971,19 @@ func (this *TaskQueueInfo) Equal(that interface{}) bool {
 	if !this.VersioningData.Equal(that1.VersioningData) {
 		return false
 	}
+	if !this.PartitionConfig.Equal(that1.PartitionConfig) {
+		return false
+	}
 	return true
 }
-func (this *VersioningData) Equal(that interface{}) bool {
+func (this *TaskQueuePartitionConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*VersioningData)
+	that1, ok := that.(*TaskQueuePartitionConfig)
 	if !ok {
-		that2, ok := that.(VersioningData)
+		that2, ok := that.(TaskQueuePartitionConfig)
 		if ok {
 			that1 = &that2
 		} else {
@@ -606,24 +995,22 @@ func (this *VersioningData) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.VersionSets) != len(that1.VersionSets) {
+	if !this.WorkflowPartitions.Equal(that1.WorkflowPartitions) {
 		return false
 	}
-	for i := range this.VersionSets {
-		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
-			return false
-		}
+	if !this.ActivityPartitions.Equal(that1.ActivityPartitions) {
+		return false
 	}
 	return true
 }
-func (this *TaskKey) Equal(that interface{}) bool {
+func (this *TaskQueuePartitionCounts) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TaskKey)
+	that1, ok := that.(*TaskQueuePartitionCounts)
 	if !ok {
-		that2, ok := that.(TaskKey)
+		that2, ok := that.(TaskQueuePartitionCounts)
 		if ok {
 			that1 = &that2
 		} else {
@@ -635,36 +1022,185 @@ func (this *TaskKey) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.FireTime == nil {
-		if this.FireTime != nil {
-			return false
-		}
-	} else if !this.FireTime.Equal(*that1.FireTime) {
+	if this.ReadPartitions != that1.ReadPartitions {
 		return false
 	}
-	if this.TaskId != that1.TaskId {
+	if this.WritePartitions != that1.WritePartitions {
 		return false
 	}
 	return true
 }
-func (this *AllocatedTaskInfo) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *VersioningData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&persistence.AllocatedTaskInfo{")
-	if this.Data != nil {
-		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+
+	that1, ok := that.(*VersioningData)
+	if !ok {
+		that2, ok := that.(VersioningData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VersionSets) != len(that1.VersionSets) {
+		return false
+	}
+	for i := range this.VersionSets {
+		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
+			return false
+		}
+	}
+	if !this.Ramp.Equal(that1.Ramp) {
+		return false
+	}
+	if len(this.SupersededBuildIds) != len(that1.SupersededBuildIds) {
+		return false
+	}
+	for i := range this.SupersededBuildIds {
+		if !this.SupersededBuildIds[i].Equal(that1.SupersededBuildIds[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SupersededBuildId) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupersededBuildId)
+	if !ok {
+		that2, ok := that.(SupersededBuildId)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if that1.SupersededTime == nil {
+		if this.SupersededTime != nil {
+			return false
+		}
+	} else if !this.SupersededTime.Equal(*that1.SupersededTime) {
+		return false
+	}
+	return true
+}
+func (this *BuildIdRamp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BuildIdRamp)
+	if !ok {
+		that2, ok := that.(BuildIdRamp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.RampPercentage != that1.RampPercentage {
+		return false
+	}
+	return true
+}
+func (this *BuildIdAssignment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BuildIdAssignment)
+	if !ok {
+		that2, ok := that.(BuildIdAssignment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	return true
+}
+func (this *TaskKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskKey)
+	if !ok {
+		that2, ok := that.(TaskKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.FireTime == nil {
+		if this.FireTime != nil {
+			return false
+		}
+	} else if !this.FireTime.Equal(*that1.FireTime) {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	return true
+}
+func (this *AllocatedTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.AllocatedTaskInfo{")
+	if this.Data != nil {
+		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	}
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *TaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 17)
 	s = append(s, "&persistence.TaskInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -675,6 +1211,14 @@ func (this *TaskInfo) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "Priority: "+fmt.Sprintf("%#v", this.Priority)+",\n")
+	s = append(s, "FairnessKey: "+fmt.Sprintf("%#v", this.FairnessKey)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "NotBefore: "+fmt.Sprintf("%#v", this.NotBefore)+",\n")
+	s = append(s, "NormalTaskQueue: "+fmt.Sprintf("%#v", this.NormalTaskQueue)+",\n")
+	if this.BuildIdAssignment != nil {
+		s = append(s, "BuildIdAssignment: "+fmt.Sprintf("%#v", this.BuildIdAssignment)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -682,7 +1226,7 @@ func (this *TaskQueueInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&persistence.TaskQueueInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
@@ -694,6 +1238,35 @@ func (this *TaskQueueInfo) GoString() string {
 	if this.VersioningData != nil {
 		s = append(s, "VersioningData: "+fmt.Sprintf("%#v", this.VersioningData)+",\n")
 	}
+	if this.PartitionConfig != nil {
+		s = append(s, "PartitionConfig: "+fmt.Sprintf("%#v", this.PartitionConfig)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueuePartitionConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.TaskQueuePartitionConfig{")
+	if this.WorkflowPartitions != nil {
+		s = append(s, "WorkflowPartitions: "+fmt.Sprintf("%#v", this.WorkflowPartitions)+",\n")
+	}
+	if this.ActivityPartitions != nil {
+		s = append(s, "ActivityPartitions: "+fmt.Sprintf("%#v", this.ActivityPartitions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskQueuePartitionCounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.TaskQueuePartitionCounts{")
+	s = append(s, "ReadPartitions: "+fmt.Sprintf("%#v", this.ReadPartitions)+",\n")
+	s = append(s, "WritePartitions: "+fmt.Sprintf("%#v", this.WritePartitions)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -701,11 +1274,49 @@ func (this *VersioningData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 7)
 	s = append(s, "&persistence.VersioningData{")
 	if this.VersionSets != nil {
 		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
 	}
+	if this.Ramp != nil {
+		s = append(s, "Ramp: "+fmt.Sprintf("%#v", this.Ramp)+",\n")
+	}
+	if this.SupersededBuildIds != nil {
+		s = append(s, "SupersededBuildIds: "+fmt.Sprintf("%#v", this.SupersededBuildIds)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SupersededBuildId) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.SupersededBuildId{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "SupersededTime: "+fmt.Sprintf("%#v", this.SupersededTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BuildIdRamp) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.BuildIdRamp{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "RampPercentage: "+fmt.Sprintf("%#v", this.RampPercentage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BuildIdAssignment) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&persistence.BuildIdAssignment{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -788,9 +1399,9 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Clock != nil {
+	if m.BuildIdAssignment != nil {
 		{
-			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BuildIdAssignment.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -798,25 +1409,73 @@ func (m *TaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTasks(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x6a
 	}
-	if m.ExpiryTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+	if len(m.NormalTaskQueue) > 0 {
+		i -= len(m.NormalTaskQueue)
+		copy(dAtA[i:], m.NormalTaskQueue)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.NormalTaskQueue)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.NotBefore != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
 		if err3 != nil {
 			return 0, err3
 		}
 		i -= n3
 		i = encodeVarintTasks(dAtA, i, uint64(n3))
 		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FairnessKey) > 0 {
+		i -= len(m.FairnessKey)
+		copy(dAtA[i:], m.FairnessKey)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.FairnessKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Clock != nil {
+		{
+			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTasks(dAtA, i, uint64(n5))
+		i--
 		dAtA[i] = 0x32
 	}
 	if m.CreateTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreateTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintTasks(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintTasks(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -869,6 +1528,18 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.VersioningData != nil {
 		{
 			size, err := m.VersioningData.MarshalToSizedBuffer(dAtA[:i])
@@ -882,22 +1553,22 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.LastUpdateTime != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err6 != nil {
-			return 0, err6
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n6
-		i = encodeVarintTasks(dAtA, i, uint64(n6))
+		i -= n9
+		i = encodeVarintTasks(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x3a
 	}
 	if m.ExpiryTime != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err7 != nil {
-			return 0, err7
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n7
-		i = encodeVarintTasks(dAtA, i, uint64(n7))
+		i -= n10
+		i = encodeVarintTasks(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -933,7 +1604,7 @@ func (m *TaskQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VersioningData) Marshal() (dAtA []byte, err error) {
+func (m *TaskQueuePartitionConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -943,34 +1614,44 @@ func (m *VersioningData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VersioningData) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskQueuePartitionConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskQueuePartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VersionSets) > 0 {
-		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTasks(dAtA, i, uint64(size))
+	if m.ActivityPartitions != nil {
+		{
+			size, err := m.ActivityPartitions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WorkflowPartitions != nil {
+		{
+			size, err := m.WorkflowPartitions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskKey) Marshal() (dAtA []byte, err error) {
+func (m *TaskQueuePartitionCounts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -980,28 +1661,230 @@ func (m *TaskKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskQueuePartitionCounts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskQueuePartitionCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTasks(dAtA, i, uint64(m.TaskId))
+	if m.WritePartitions != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.WritePartitions))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.FireTime != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime):])
-		if err8 != nil {
-			return 0, err8
+	if m.ReadPartitions != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.ReadPartitions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersioningData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersioningData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupersededBuildIds) > 0 {
+		for iNdEx := len(m.SupersededBuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupersededBuildIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Ramp != nil {
+		{
+			size, err := m.Ramp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTasks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTasks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupersededBuildId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupersededBuildId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupersededBuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SupersededTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SupersededTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SupersededTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTasks(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildIdRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildIdRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildIdRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RampPercentage != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RampPercentage))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuildIdAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildIdAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuildIdAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintTasks(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTasks(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FireTime != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n8
-		i = encodeVarintTasks(dAtA, i, uint64(n8))
+		i -= n15
+		i = encodeVarintTasks(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1068,6 +1951,29 @@ func (m *TaskInfo) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTasks(uint64(m.Priority))
+	}
+	l = len(m.FairnessKey)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	l = len(m.NormalTaskQueue)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.BuildIdAssignment != nil {
+		l = m.BuildIdAssignment.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
 	return n
 }
 
@@ -1106,6 +2012,42 @@ func (m *TaskQueueInfo) Size() (n int) {
 		l = m.VersioningData.Size()
 		n += 1 + l + sovTasks(uint64(l))
 	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskQueuePartitionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowPartitions != nil {
+		l = m.WorkflowPartitions.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.ActivityPartitions != nil {
+		l = m.ActivityPartitions.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskQueuePartitionCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadPartitions != 0 {
+		n += 1 + sovTasks(uint64(m.ReadPartitions))
+	}
+	if m.WritePartitions != 0 {
+		n += 1 + sovTasks(uint64(m.WritePartitions))
+	}
 	return n
 }
 
@@ -1121,6 +2063,62 @@ func (m *VersioningData) Size() (n int) {
 			n += 1 + l + sovTasks(uint64(l))
 		}
 	}
+	if m.Ramp != nil {
+		l = m.Ramp.Size()
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if len(m.SupersededBuildIds) > 0 {
+		for _, e := range m.SupersededBuildIds {
+			l = e.Size()
+			n += 1 + l + sovTasks(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupersededBuildId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.SupersededTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SupersededTime)
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	return n
+}
+
+func (m *BuildIdRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
+	if m.RampPercentage != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *BuildIdAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovTasks(uint64(l))
+	}
 	return n
 }
 
@@ -1169,6 +2167,12 @@ func (this *TaskInfo) String() string {
 		`CreateTime:` + strings.Replace(fmt.Sprintf("%v", this.CreateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v1.VectorClock", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`FairnessKey:` + fmt.Sprintf("%v", this.FairnessKey) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NormalTaskQueue:` + fmt.Sprintf("%v", this.NormalTaskQueue) + `,`,
+		`BuildIdAssignment:` + strings.Replace(this.BuildIdAssignment.String(), "BuildIdAssignment", "BuildIdAssignment", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1186,37 +2190,99 @@ func (this *TaskQueueInfo) String() string {
 		`ExpiryTime:` + strings.Replace(fmt.Sprintf("%v", this.ExpiryTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`LastUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`VersioningData:` + strings.Replace(this.VersioningData.String(), "VersioningData", "VersioningData", 1) + `,`,
+		`PartitionConfig:` + strings.Replace(this.PartitionConfig.String(), "TaskQueuePartitionConfig", "TaskQueuePartitionConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VersioningData) String() string {
+func (this *TaskQueuePartitionConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForVersionSets := "[]*CompatibleVersionSet{"
-	for _, f := range this.VersionSets {
-		repeatedStringForVersionSets += strings.Replace(fmt.Sprintf("%v", f), "CompatibleVersionSet", "v12.CompatibleVersionSet", 1) + ","
-	}
-	repeatedStringForVersionSets += "}"
-	s := strings.Join([]string{`&VersioningData{`,
-		`VersionSets:` + repeatedStringForVersionSets + `,`,
+	s := strings.Join([]string{`&TaskQueuePartitionConfig{`,
+		`WorkflowPartitions:` + strings.Replace(this.WorkflowPartitions.String(), "TaskQueuePartitionCounts", "TaskQueuePartitionCounts", 1) + `,`,
+		`ActivityPartitions:` + strings.Replace(this.ActivityPartitions.String(), "TaskQueuePartitionCounts", "TaskQueuePartitionCounts", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TaskKey) String() string {
+func (this *TaskQueuePartitionCounts) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TaskKey{`,
-		`FireTime:` + strings.Replace(fmt.Sprintf("%v", this.FireTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+	s := strings.Join([]string{`&TaskQueuePartitionCounts{`,
+		`ReadPartitions:` + fmt.Sprintf("%v", this.ReadPartitions) + `,`,
+		`WritePartitions:` + fmt.Sprintf("%v", this.WritePartitions) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func valueToStringTasks(v interface{}) string {
+func (this *VersioningData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.VersionSets {
+		repeatedStringForVersionSets += strings.Replace(fmt.Sprintf("%v", f), "CompatibleVersionSet", "v12.CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForVersionSets += "}"
+	repeatedStringForSupersededBuildIds := "[]*SupersededBuildId{"
+	for _, f := range this.SupersededBuildIds {
+		repeatedStringForSupersededBuildIds += strings.Replace(f.String(), "SupersededBuildId", "SupersededBuildId", 1) + ","
+	}
+	repeatedStringForSupersededBuildIds += "}"
+	s := strings.Join([]string{`&VersioningData{`,
+		`VersionSets:` + repeatedStringForVersionSets + `,`,
+		`Ramp:` + strings.Replace(this.Ramp.String(), "BuildIdRamp", "BuildIdRamp", 1) + `,`,
+		`SupersededBuildIds:` + repeatedStringForSupersededBuildIds + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SupersededBuildId) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SupersededBuildId{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`SupersededTime:` + strings.Replace(fmt.Sprintf("%v", this.SupersededTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BuildIdRamp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildIdRamp{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`RampPercentage:` + fmt.Sprintf("%v", this.RampPercentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BuildIdAssignment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildIdAssignment{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskKey{`,
+		`FireTime:` + strings.Replace(fmt.Sprintf("%v", this.FireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTasks(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
@@ -1461,24 +2527,906 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
 			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateTime == nil {
+				m.CreateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clock == nil {
+				m.Clock = &v1.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FairnessKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FairnessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalTaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalTaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdAssignment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuildIdAssignment == nil {
+				m.BuildIdAssignment = &BuildIdAssignment{}
+			}
+			if err := m.BuildIdAssignment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= v11.TaskQueueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			}
+			m.AckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpdateTime == nil {
+				m.LastUpdateTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersioningData == nil {
+				m.VersioningData = &VersioningData{}
+			}
+			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskQueuePartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueuePartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueuePartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueuePartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowPartitions == nil {
+				m.WorkflowPartitions = &TaskQueuePartitionCounts{}
+			}
+			if err := m.WorkflowPartitions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTasks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityPartitions == nil {
+				m.ActivityPartitions = &TaskQueuePartitionCounts{}
+			}
+			if err := m.ActivityPartitions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskQueuePartitionCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueuePartitionCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueuePartitionCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPartitions", wireType)
+			}
+			m.ReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritePartitions", wireType)
+			}
+			m.WritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTasks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTasks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersioningData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersioningData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersioningData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,16 +3453,14 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateTime == nil {
-				m.CreateTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.VersionSets = append(m.VersionSets, &v12.CompatibleVersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1541,16 +3487,16 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiryTime == nil {
-				m.ExpiryTime = new(time.Time)
+			if m.Ramp == nil {
+				m.Ramp = &BuildIdRamp{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ramp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBuildIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1577,10 +3523,8 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v1.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SupersededBuildIds = append(m.SupersededBuildIds, &SupersededBuildId{})
+			if err := m.SupersededBuildIds[len(m.SupersededBuildIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1608,7 +3552,7 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
+func (m *SupersededBuildId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1631,15 +3575,15 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskQueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupersededBuildId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskQueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupersededBuildId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1667,100 +3611,11 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTasks
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTasks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
-			}
-			m.TaskType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskType |= v11.TaskQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			m.Kind = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Kind |= v11.TaskQueueKind(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
-			}
-			m.AckLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AckLevel |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1787,54 +3642,71 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpiryTime == nil {
-				m.ExpiryTime = new(time.Time)
+			if m.SupersededTime == nil {
+				m.SupersededTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SupersededTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTasks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTasks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTasks
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpdateTime == nil {
-				m.LastUpdateTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildIdRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTasks
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildIdRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildIdRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1844,28 +3716,35 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersioningData == nil {
-				m.VersioningData = &VersioningData{}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampPercentage", wireType)
 			}
-			if err := m.VersioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RampPercentage = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTasks(dAtA[iNdEx:])
@@ -1890,7 +3769,7 @@ func (m *TaskQueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersioningData) Unmarshal(dAtA []byte) error {
+func (m *BuildIdAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1913,17 +3792,17 @@ func (m *VersioningData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersioningData: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildIdAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersioningData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildIdAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTasks
@@ -1933,25 +3812,23 @@ func (m *VersioningData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTasks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTasks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionSets = append(m.VersionSets, &v12.CompatibleVersionSet{})
-			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -785,6 +785,9 @@ type SyncActivityTaskAttributes struct {
 	LastWorkerIdentity string                 `protobuf:"bytes,13,opt,name=last_worker_identity,json=lastWorkerIdentity,proto3" json:"last_worker_identity,omitempty"`
 	VersionHistory     *v15.VersionHistory    `protobuf:"bytes,14,opt,name=version_history,json=versionHistory,proto3" json:"version_history,omitempty"`
 	BaseExecutionInfo  *v16.BaseExecutionInfo `protobuf:"bytes,15,opt,name=base_execution_info,json=baseExecutionInfo,proto3" json:"base_execution_info,omitempty"`
+	// Options of the activity, which can be updated after the activity is scheduled.
+	Options             *v15.ActivityOptions `protobuf:"bytes,16,opt,name=options,proto3" json:"options,omitempty"`
+	RetryExpirationTime *time.Time           `protobuf:"bytes,17,opt,name=retry_expiration_time,json=retryExpirationTime,proto3,stdtime" json:"retry_expiration_time,omitempty"`
 }
 
 func (m *SyncActivityTaskAttributes) Reset()      { *m = SyncActivityTaskAttributes{} }
@@ -924,6 +927,20 @@ func (m *SyncActivityTaskAttributes) GetBaseExecutionInfo() *v16.BaseExecutionIn
 	return nil
 }
 
+func (m *SyncActivityTaskAttributes) GetOptions() *v15.ActivityOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *SyncActivityTaskAttributes) GetRetryExpirationTime() *time.Time {
+	if m != nil {
+		return m.RetryExpirationTime
+	}
+	return nil
+}
+
 type HistoryTaskAttributes struct {
 	NamespaceId         string                    `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId          string                    `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -1079,109 +1096,112 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x8e, 0xed, 0x3c, 0x3b, 0x8e, 0x33, 0x69, 0xfe, 0x71, 0x52, 0xd5, 0x49, 0xfd,
-	0x6f, 0x21, 0x85, 0x62, 0x37, 0xc9, 0x81, 0x96, 0x22, 0x50, 0x12, 0x5a, 0xea, 0x4a, 0x2d, 0xd5,
-	0x36, 0x6a, 0x25, 0x2e, 0xcb, 0xd8, 0x3b, 0x4e, 0x56, 0xb1, 0x77, 0xad, 0x99, 0xb1, 0x53, 0xdf,
-	0x10, 0x5c, 0x10, 0x12, 0x52, 0x25, 0xbe, 0x00, 0x07, 0x0e, 0x9c, 0x38, 0xf0, 0x21, 0x10, 0xc7,
-	0x4a, 0x5c, 0x7a, 0xa3, 0x4d, 0x2f, 0x70, 0xeb, 0x47, 0x40, 0x33, 0x3b, 0x63, 0xef, 0xda, 0x6b,
-	0xd7, 0x14, 0x10, 0x37, 0xef, 0x9b, 0xdf, 0xfb, 0xbd, 0x37, 0x6f, 0xde, 0x7b, 0xf3, 0xc6, 0x70,
-	0x85, 0x93, 0x56, 0xdb, 0xa3, 0xb8, 0x59, 0x61, 0x84, 0x76, 0x09, 0xad, 0xe0, 0xb6, 0x53, 0xa1,
-	0xa4, 0xdd, 0x74, 0xea, 0x98, 0x3b, 0x9e, 0x5b, 0xe9, 0x6e, 0x55, 0x5a, 0x84, 0x31, 0x7c, 0x48,
-	0xca, 0x6d, 0xea, 0x71, 0x0f, 0x95, 0xb4, 0x46, 0xd9, 0xd7, 0x28, 0xe3, 0xb6, 0x53, 0x0e, 0x68,
-	0x94, 0xbb, 0x5b, 0x6b, 0xeb, 0x87, 0x9e, 0x77, 0xd8, 0x24, 0x15, 0xa9, 0x51, 0xeb, 0x34, 0x2a,
-	0xdc, 0x69, 0x11, 0xc6, 0x71, 0xab, 0xed, 0x93, 0xac, 0x9d, 0xb7, 0x49, 0x9b, 0xb8, 0x36, 0x71,
-	0xeb, 0x0e, 0x61, 0x95, 0x43, 0xef, 0xd0, 0x93, 0x72, 0xf9, 0x4b, 0x41, 0xca, 0x51, 0x9e, 0x11,
-	0xb7, 0xd3, 0x62, 0xc2, 0xa7, 0xa0, 0x41, 0x1f, 0xff, 0xe6, 0x44, 0x3c, 0xc7, 0xec, 0x58, 0x01,
-	0x2f, 0x47, 0x01, 0x8f, 0x1c, 0xc6, 0x3d, 0xda, 0x1b, 0xd9, 0xee, 0xda, 0x87, 0x51, 0xe8, 0x36,
-	0xa1, 0xcc, 0x61, 0x9c, 0xb8, 0x75, 0x22, 0x34, 0x4e, 0x3c, 0x7a, 0xdc, 0x68, 0x7a, 0x27, 0x56,
-	0xab, 0xc3, 0x71, 0xad, 0x49, 0x2c, 0xc6, 0x31, 0xd7, 0x04, 0x17, 0xfa, 0x04, 0x42, 0xb3, 0xee,
-	0xb5, 0x5a, 0x11, 0x51, 0x0d, 0x78, 0x2f, 0x50, 0x2e, 0x6e, 0x11, 0xd6, 0xc6, 0x3e, 0x7b, 0x18,
-	0x78, 0x29, 0x04, 0x9c, 0x74, 0x52, 0x6b, 0x17, 0x43, 0xd0, 0x06, 0x76, 0x9a, 0x1d, 0x1a, 0xc1,
-	0xf8, 0x4e, 0xd4, 0x0e, 0xf5, 0x96, 0x46, 0xe0, 0xa5, 0xaf, 0x52, 0xb0, 0x60, 0x0e, 0xcc, 0x1e,
-	0x60, 0x76, 0x8c, 0xee, 0xc2, 0x9c, 0x08, 0xb0, 0xc5, 0x7b, 0x6d, 0x52, 0x30, 0x36, 0x8c, 0xcd,
-	0xdc, 0xf6, 0x56, 0x39, 0x2a, 0x4f, 0xe4, 0x79, 0x94, 0xbb, 0x5b, 0xe5, 0x21, 0x86, 0x83, 0x5e,
-	0x9b, 0x98, 0x69, 0xae, 0x7e, 0xa1, 0x0b, 0x90, 0x63, 0x5e, 0x87, 0xd6, 0x89, 0x25, 0x69, 0x1d,
-	0xbb, 0x10, 0xdb, 0x30, 0x36, 0xe3, 0x66, 0xd6, 0x97, 0x0a, 0x8d, 0xaa, 0x8d, 0x7a, 0xb0, 0xda,
-	0x0f, 0x94, 0x0f, 0xc4, 0x9c, 0x53, 0xa7, 0xd6, 0xe1, 0x84, 0x15, 0xe2, 0x1b, 0xc6, 0x66, 0x66,
-	0xfb, 0x7a, 0xf9, 0xd5, 0xd9, 0x5a, 0xbe, 0xab, 0x49, 0x04, 0xef, 0x6e, 0x9f, 0xe2, 0xd6, 0x8c,
-	0xb9, 0xe2, 0x46, 0x2f, 0xa1, 0x6f, 0x0c, 0x38, 0xcf, 0x7a, 0x6e, 0xdd, 0x62, 0x47, 0x98, 0xda,
-	0xf2, 0xbc, 0x3b, 0x6c, 0xc4, 0x87, 0x59, 0xe9, 0xc3, 0xee, 0x34, 0x3e, 0xdc, 0xef, 0xb9, 0xf5,
-	0xfb, 0x82, 0xeb, 0xbe, 0xa4, 0x1a, 0xf1, 0xe4, 0x1c, 0x9b, 0x04, 0x40, 0x5f, 0x1a, 0x20, 0x11,
-	0x16, 0xae, 0x73, 0xa7, 0xeb, 0xf0, 0xde, 0x88, 0x2f, 0x49, 0xe9, 0xcb, 0x07, 0xd3, 0xfa, 0xb2,
-	0xab, 0x78, 0x46, 0x1c, 0x59, 0x63, 0x63, 0x57, 0x11, 0x83, 0x15, 0x55, 0x47, 0x23, 0xe6, 0xd3,
-	0xd2, 0xfc, 0xb5, 0x69, 0xcc, 0xdf, 0xf2, 0x29, 0x46, 0x2c, 0x2f, 0x1f, 0x45, 0x2d, 0xa0, 0x6f,
-	0x0d, 0xf8, 0xbf, 0xdc, 0x7a, 0xbf, 0x0a, 0x65, 0xf5, 0x8d, 0x78, 0x00, 0xd2, 0x83, 0xfd, 0x69,
-	0x03, 0xf0, 0x50, 0xb1, 0x89, 0x70, 0x8f, 0x26, 0xc6, 0x3a, 0x9b, 0x0c, 0x41, 0x55, 0x58, 0xe8,
-	0x3a, 0xcc, 0xa9, 0x39, 0x4d, 0x79, 0x18, 0x4e, 0x8b, 0x14, 0xe6, 0xa4, 0x03, 0x6b, 0x65, 0xbf,
-	0x37, 0x96, 0x75, 0x6f, 0x2c, 0x1f, 0xe8, 0xde, 0xb8, 0x97, 0x78, 0xfc, 0xdb, 0xba, 0x61, 0xe6,
-	0x06, 0x8a, 0x62, 0x69, 0x2f, 0x0b, 0x30, 0xd8, 0xc6, 0xed, 0x44, 0x3a, 0x91, 0x9f, 0xbd, 0x9d,
-	0x48, 0xa7, 0xf2, 0xe9, 0xd2, 0xd7, 0x31, 0xc8, 0x07, 0x0b, 0xc9, 0x3b, 0x26, 0x2e, 0x5a, 0x85,
-	0xb4, 0x9f, 0x94, 0x8e, 0x2d, 0x4b, 0x71, 0xd6, 0x4c, 0xc9, 0xef, 0xaa, 0x8d, 0xae, 0xc1, 0x6a,
-	0x13, 0x33, 0x6e, 0x51, 0xc2, 0xa9, 0x43, 0xba, 0xc4, 0xb6, 0x54, 0x69, 0x0f, 0x2a, 0xec, 0x7f,
-	0x02, 0x60, 0xea, 0xf5, 0x3b, 0xfe, 0x72, 0x40, 0xb5, 0x4d, 0xbd, 0x3a, 0x61, 0x2c, 0xac, 0x1a,
-	0x1f, 0xa8, 0xde, 0xd3, 0xeb, 0x03, 0x55, 0x02, 0xc5, 0x21, 0xd5, 0xe1, 0xc8, 0x24, 0xa6, 0x8c,
-	0xcc, 0xd9, 0x90, 0x85, 0x07, 0xa1, 0x30, 0x95, 0x0e, 0x60, 0x61, 0xa8, 0x88, 0xd0, 0x2e, 0x64,
-	0x74, 0x65, 0x0a, 0x33, 0xc6, 0x94, 0x66, 0xc0, 0x57, 0x92, 0xac, 0x3f, 0x19, 0x70, 0x46, 0xd0,
-	0x06, 0xc2, 0x2c, 0x8f, 0x7b, 0x72, 0x40, 0x8c, 0x89, 0x01, 0xb1, 0xe0, 0xec, 0x18, 0x55, 0xe9,
-	0x66, 0x6c, 0x4a, 0x37, 0x0b, 0x51, 0xf4, 0xd2, 0xe9, 0x1f, 0x63, 0xb0, 0x14, 0x70, 0x58, 0x2d,
-	0x31, 0xf4, 0x19, 0x2c, 0x06, 0x32, 0x5d, 0x56, 0x08, 0x2b, 0x18, 0x1b, 0xf1, 0xcd, 0xcc, 0xf6,
-	0xce, 0x34, 0x75, 0x31, 0xd4, 0xb4, 0xcd, 0x3c, 0x0d, 0x0b, 0xd8, 0xdf, 0xc9, 0xb0, 0x55, 0x48,
-	0x1f, 0x61, 0x66, 0xb5, 0x3c, 0x4a, 0x64, 0x42, 0xa5, 0xcd, 0xd4, 0x11, 0x66, 0x77, 0x3c, 0x4a,
-	0x90, 0x05, 0x8b, 0x23, 0xcd, 0x56, 0x25, 0xcd, 0xce, 0x6b, 0x34, 0x57, 0x73, 0x61, 0xa8, 0x99,
-	0x96, 0xfe, 0x30, 0xe0, 0xac, 0xae, 0xe6, 0xff, 0x26, 0x70, 0x1b, 0x90, 0x95, 0x81, 0x0b, 0xdf,
-	0x77, 0x20, 0x64, 0xea, 0xb6, 0xbb, 0x09, 0xb9, 0x01, 0x42, 0x26, 0x4a, 0x7c, 0xca, 0x44, 0xc9,
-	0x6a, 0x16, 0x99, 0x1c, 0xcf, 0xc2, 0xc9, 0x21, 0xd9, 0xdd, 0x86, 0x87, 0xce, 0x43, 0x76, 0x70,
-	0x9b, 0xaa, 0x1c, 0x9e, 0x33, 0x33, 0x7d, 0x59, 0xd5, 0x46, 0xeb, 0x90, 0xe9, 0x37, 0x59, 0xe5,
-	0xe3, 0x9c, 0x09, 0x5a, 0x54, 0xb5, 0xd1, 0x32, 0x24, 0x69, 0xc7, 0xd5, 0x2d, 0x61, 0xce, 0x9c,
-	0xa5, 0x1d, 0xb7, 0x6a, 0xa3, 0xfd, 0xe0, 0x78, 0x90, 0x90, 0xe3, 0xc1, 0x1b, 0x93, 0xc7, 0x83,
-	0x88, 0x99, 0x60, 0x05, 0x52, 0x3a, 0x38, 0xb3, 0x32, 0x38, 0x49, 0xee, 0x07, 0xa6, 0x00, 0xa9,
-	0xae, 0x98, 0xc7, 0x3c, 0x57, 0x5e, 0x72, 0x71, 0x53, 0x7f, 0x8a, 0x31, 0xa2, 0xe1, 0x50, 0xc6,
-	0x2d, 0xd2, 0x25, 0x2e, 0x17, 0x9a, 0x29, 0x7f, 0x8c, 0x90, 0xd2, 0x1b, 0x42, 0x58, 0xb5, 0x51,
-	0x09, 0xe6, 0x5d, 0xf2, 0x28, 0x00, 0x4a, 0x4b, 0x50, 0x46, 0x08, 0x35, 0xe6, 0x32, 0x20, 0x56,
-	0x3f, 0x22, 0x76, 0xa7, 0x49, 0xec, 0x01, 0x70, 0x4e, 0x02, 0xf3, 0xfd, 0x15, 0x85, 0x2e, 0x7d,
-	0x97, 0x80, 0x95, 0x31, 0x43, 0x05, 0xc2, 0xb0, 0x34, 0x08, 0xb3, 0xd7, 0x26, 0x54, 0x9e, 0x82,
-	0x1a, 0x9a, 0xae, 0x4c, 0x8e, 0x4a, 0x9f, 0xf3, 0x13, 0xad, 0x67, 0x22, 0x77, 0x44, 0x86, 0x72,
-	0x10, 0xeb, 0x9f, 0x4e, 0xcc, 0xb1, 0xd1, 0xfb, 0x90, 0x70, 0xdc, 0x86, 0xa7, 0xf2, 0x65, 0x73,
-	0x60, 0x43, 0x90, 0xf7, 0xf5, 0x43, 0x06, 0x44, 0x46, 0x98, 0x52, 0x0b, 0xed, 0x41, 0xb2, 0xee,
-	0xb9, 0x0d, 0xe7, 0x50, 0x55, 0xdc, 0x5b, 0xd3, 0xe8, 0xef, 0x4b, 0x0d, 0x53, 0x69, 0xa2, 0x06,
-	0xa0, 0x60, 0xfd, 0x28, 0x3e, 0x7f, 0x3c, 0x7a, 0x37, 0xcc, 0x37, 0x6e, 0x36, 0x0b, 0xa4, 0xac,
-	0x22, 0x0f, 0x96, 0xa4, 0x2f, 0x42, 0x17, 0x21, 0xe7, 0x73, 0x5b, 0xe1, 0x8c, 0x98, 0xf7, 0xa5,
-	0x0f, 0x54, 0x5e, 0x5c, 0x82, 0xbc, 0x98, 0x86, 0xbd, 0x2e, 0xa1, 0x7d, 0xa0, 0x9f, 0x19, 0x0b,
-	0x5a, 0xae, 0xa1, 0x0f, 0x02, 0x50, 0x35, 0x7f, 0x14, 0xd2, 0xb2, 0xf0, 0xdf, 0x9e, 0xe8, 0xf7,
-	0x4d, 0xa5, 0xa4, 0x3b, 0x8e, 0x26, 0x51, 0xc3, 0x4d, 0xe9, 0x7b, 0x03, 0xce, 0x4d, 0x9c, 0xf9,
-	0xc4, 0x5e, 0xd4, 0x0c, 0x5c, 0x6f, 0x76, 0x18, 0x27, 0x54, 0x55, 0xe4, 0xbc, 0x2f, 0xdd, 0xf7,
-	0x85, 0xa1, 0xeb, 0x3e, 0x16, 0xbe, 0xee, 0x87, 0xae, 0xbf, 0xf8, 0x6b, 0x5c, 0x7f, 0xbf, 0x26,
-	0x61, 0x6d, 0xfc, 0x38, 0xf8, 0x6f, 0xf6, 0x8c, 0x40, 0x55, 0x27, 0xc2, 0x55, 0x1d, 0x5d, 0x8b,
-	0xb3, 0xd1, 0xb5, 0x88, 0x3e, 0x86, 0xdc, 0x00, 0x2d, 0xe3, 0x90, 0x9c, 0x32, 0x0e, 0xf3, 0x7d,
-	0x3d, 0xb1, 0x82, 0x36, 0x21, 0xcf, 0x38, 0xa6, 0x3c, 0x68, 0xd4, 0x4f, 0x9a, 0x9c, 0x92, 0x6b,
-	0x93, 0xfb, 0x90, 0xd5, 0x48, 0x69, 0x30, 0x3d, 0xa5, 0xc1, 0x8c, 0xd2, 0x92, 0xe6, 0xee, 0xc1,
-	0x92, 0x6c, 0xf7, 0x47, 0x04, 0x53, 0x5e, 0x23, 0x98, 0xff, 0xb5, 0x21, 0x72, 0x51, 0x28, 0xdf,
-	0xd2, 0xba, 0x92, 0xf1, 0x3d, 0x48, 0xd9, 0x84, 0x63, 0xa7, 0xa9, 0x67, 0xe1, 0x8d, 0x70, 0x06,
-	0xfb, 0x4f, 0x53, 0x91, 0xbc, 0xf7, 0x70, 0xaf, 0xe9, 0x61, 0x9b, 0x99, 0x5a, 0x41, 0x9c, 0x06,
-	0xe6, 0x02, 0xcd, 0x0b, 0x19, 0x3f, 0xc9, 0xd4, 0xa7, 0xd8, 0xac, 0xf4, 0x53, 0x3d, 0x2f, 0x0b,
-	0xd9, 0x28, 0x6a, 0xb5, 0xa8, 0x0b, 0xa3, 0x43, 0x89, 0x99, 0x11, 0x5a, 0xea, 0x03, 0x5d, 0x81,
-	0x33, 0x92, 0x44, 0xa4, 0x05, 0xa1, 0x96, 0x63, 0x13, 0x97, 0x3b, 0xbc, 0x57, 0x98, 0x97, 0x19,
-	0x81, 0xc4, 0xda, 0x43, 0xb9, 0x54, 0x55, 0x2b, 0xe8, 0x21, 0x2c, 0xa8, 0x7c, 0xe8, 0x97, 0x65,
-	0x4e, 0x5a, 0x2e, 0x47, 0xb6, 0x50, 0x85, 0x11, 0x0e, 0xa8, 0xca, 0x56, 0x85, 0x68, 0xe6, 0xba,
-	0xa1, 0x6f, 0x54, 0x83, 0xa5, 0x1a, 0x66, 0xc4, 0x22, 0x8f, 0x48, 0xbd, 0x23, 0xbb, 0x95, 0xec,
-	0x9d, 0x0b, 0x92, 0x7c, 0x3b, 0x92, 0x5c, 0x27, 0xb3, 0x60, 0xdf, 0xc3, 0x8c, 0xdc, 0xd0, 0xaa,
-	0xb2, 0x8b, 0x2e, 0xd6, 0x86, 0x45, 0xa5, 0x9f, 0xe3, 0xb0, 0x1c, 0xf9, 0xca, 0x19, 0x29, 0xa8,
-	0xd8, 0x2b, 0x0b, 0x2a, 0x3e, 0xa1, 0xa0, 0x12, 0xc1, 0x82, 0x6a, 0xc0, 0xf2, 0x50, 0xc4, 0x2c,
-	0x87, 0x93, 0x96, 0x78, 0xa5, 0xc6, 0xc7, 0x6e, 0x6d, 0x6c, 0xdc, 0xaa, 0x9c, 0xb4, 0xcc, 0xa5,
-	0xee, 0x88, 0x8c, 0xa1, 0xab, 0x90, 0x94, 0xf5, 0xa1, 0x9f, 0x9c, 0x63, 0xb3, 0xec, 0x23, 0xcc,
-	0xf1, 0x5e, 0xd3, 0xab, 0x99, 0x0a, 0x2f, 0x26, 0x1c, 0x97, 0x9c, 0x58, 0xc2, 0x79, 0xc5, 0x90,
-	0x9a, 0x92, 0x21, 0xeb, 0x92, 0x13, 0xb3, 0xe3, 0xde, 0xf0, 0x79, 0xc6, 0x1c, 0x61, 0xfa, 0x1f,
-	0x3c, 0xc2, 0xdb, 0x89, 0xb4, 0x91, 0x8f, 0x95, 0xbe, 0x30, 0x60, 0xfd, 0x15, 0x8f, 0x45, 0x64,
-	0x41, 0x2e, 0xfc, 0x32, 0x55, 0xef, 0x90, 0xab, 0x91, 0x8e, 0x04, 0xfe, 0x59, 0x12, 0xbe, 0x68,
-	0xe2, 0x3b, 0xfe, 0x1f, 0x4b, 0x92, 0xdf, 0x9c, 0x3f, 0x09, 0x9a, 0xdb, 0x73, 0x9e, 0x3c, 0x2f,
-	0xce, 0x3c, 0x7d, 0x5e, 0x9c, 0x79, 0xf9, 0xbc, 0x68, 0x7c, 0x7e, 0x5a, 0x34, 0x7e, 0x38, 0x2d,
-	0x1a, 0xbf, 0x9c, 0x16, 0x8d, 0x27, 0xa7, 0x45, 0xe3, 0xd9, 0x69, 0xd1, 0xf8, 0xfd, 0xb4, 0x38,
-	0xf3, 0xf2, 0xb4, 0x68, 0x3c, 0x7e, 0x51, 0x9c, 0x79, 0xf2, 0xa2, 0x38, 0xf3, 0xf4, 0x45, 0x71,
-	0xe6, 0xd3, 0x9d, 0x43, 0x6f, 0xe0, 0x80, 0xe3, 0x8d, 0xff, 0xfb, 0xef, 0x3a, 0x25, 0x6d, 0xf5,
-	0x55, 0x4b, 0xca, 0x7e, 0xb3, 0xf3, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0x36, 0xf8, 0xde,
-	0x36, 0x14, 0x00, 0x00,
+	// 1667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x3f, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x94, 0x48, 0x3d, 0x52, 0x14, 0x35, 0xb2, 0x22, 0x8a, 0x86, 0x29, 0x99, 0xb1,
+	0x63, 0x39, 0x71, 0x48, 0x4b, 0x2a, 0x62, 0xc7, 0x41, 0x02, 0x49, 0x91, 0x63, 0x1a, 0xf0, 0x1f,
+	0xac, 0x05, 0x1b, 0x48, 0xb3, 0x59, 0x72, 0x87, 0xd2, 0x40, 0xe4, 0xee, 0x62, 0x66, 0x48, 0x99,
+	0x5d, 0x90, 0x34, 0x41, 0x80, 0x00, 0x06, 0xf2, 0x05, 0x52, 0xa4, 0x48, 0x95, 0x22, 0x1f, 0x22,
+	0x48, 0xe9, 0xd2, 0x5d, 0x6c, 0xb9, 0xb9, 0xeb, 0x5c, 0xdc, 0x07, 0x38, 0xcc, 0xec, 0x0c, 0xb9,
+	0x4b, 0x2e, 0x29, 0x9e, 0xef, 0x0e, 0xd7, 0x71, 0xdf, 0xbc, 0xdf, 0xef, 0xbd, 0x7d, 0xf3, 0x7e,
+	0xb3, 0x6f, 0x08, 0x77, 0x39, 0xee, 0xf8, 0x1e, 0xb5, 0xdb, 0x35, 0x86, 0x69, 0x0f, 0xd3, 0x9a,
+	0xed, 0x93, 0x1a, 0xc5, 0x7e, 0x9b, 0x34, 0x6d, 0x4e, 0x3c, 0xb7, 0xd6, 0xdb, 0xa9, 0x75, 0x30,
+	0x63, 0xf6, 0x09, 0xae, 0xfa, 0xd4, 0xe3, 0x1e, 0xaa, 0x68, 0x44, 0x35, 0x40, 0x54, 0x6d, 0x9f,
+	0x54, 0x43, 0x88, 0x6a, 0x6f, 0xa7, 0xb4, 0x79, 0xe2, 0x79, 0x27, 0x6d, 0x5c, 0x93, 0x88, 0x46,
+	0xb7, 0x55, 0xe3, 0xa4, 0x83, 0x19, 0xb7, 0x3b, 0x7e, 0x40, 0x52, 0xba, 0xee, 0x60, 0x1f, 0xbb,
+	0x0e, 0x76, 0x9b, 0x04, 0xb3, 0xda, 0x89, 0x77, 0xe2, 0x49, 0xbb, 0xfc, 0xa5, 0x5c, 0xaa, 0x71,
+	0x99, 0x61, 0xb7, 0xdb, 0x61, 0x22, 0xa7, 0x70, 0xc0, 0xc0, 0xff, 0xd6, 0x54, 0x7f, 0x6e, 0xb3,
+	0x33, 0xe5, 0x78, 0x27, 0xce, 0xf1, 0x94, 0x30, 0xee, 0xd1, 0xfe, 0xd8, 0xeb, 0x96, 0x7e, 0x13,
+	0xe7, 0xed, 0x63, 0xca, 0x08, 0xe3, 0xd8, 0x6d, 0x62, 0x81, 0x38, 0xf7, 0xe8, 0x59, 0xab, 0xed,
+	0x9d, 0x5b, 0x9d, 0x2e, 0xb7, 0x1b, 0x6d, 0x6c, 0x31, 0x6e, 0x73, 0x4d, 0x70, 0x63, 0x40, 0x20,
+	0x90, 0x4d, 0xaf, 0xd3, 0x89, 0xa9, 0x6a, 0xe9, 0x56, 0xc4, 0xcb, 0xb5, 0x3b, 0x98, 0xf9, 0x76,
+	0x13, 0x8f, 0x3b, 0xde, 0x8e, 0x38, 0x4e, 0xdb, 0xa9, 0xd2, 0xcd, 0x88, 0x6b, 0xcb, 0x26, 0xed,
+	0x2e, 0x8d, 0x61, 0xfc, 0x79, 0xdc, 0x1b, 0xea, 0x57, 0x1a, 0x73, 0xaf, 0xfc, 0x25, 0x0d, 0xcb,
+	0xe6, 0x30, 0xec, 0xb1, 0xcd, 0xce, 0xd0, 0x53, 0x58, 0x14, 0x05, 0xb6, 0x78, 0xdf, 0xc7, 0x45,
+	0x63, 0xcb, 0xd8, 0xce, 0xef, 0xee, 0x54, 0xe3, 0xfa, 0x44, 0xee, 0x47, 0xb5, 0xb7, 0x53, 0x1d,
+	0x61, 0x38, 0xee, 0xfb, 0xd8, 0xcc, 0x70, 0xf5, 0x0b, 0xdd, 0x80, 0x3c, 0xf3, 0xba, 0xb4, 0x89,
+	0x2d, 0x49, 0x4b, 0x9c, 0x62, 0x62, 0xcb, 0xd8, 0x4e, 0x9a, 0xb9, 0xc0, 0x2a, 0x10, 0x75, 0x07,
+	0xf5, 0x61, 0x63, 0x50, 0xa8, 0xc0, 0xd1, 0xe6, 0x9c, 0x92, 0x46, 0x97, 0x63, 0x56, 0x4c, 0x6e,
+	0x19, 0xdb, 0xd9, 0xdd, 0x07, 0xd5, 0xcb, 0xbb, 0xb5, 0xfa, 0x54, 0x93, 0x08, 0xde, 0xfd, 0x01,
+	0xc5, 0xa3, 0x39, 0x73, 0xdd, 0x8d, 0x5f, 0x42, 0x7f, 0x33, 0xe0, 0x3a, 0xeb, 0xbb, 0x4d, 0x8b,
+	0x9d, 0xda, 0xd4, 0x91, 0xfb, 0xdd, 0x65, 0x63, 0x39, 0xcc, 0xcb, 0x1c, 0xf6, 0x67, 0xc9, 0xe1,
+	0x45, 0xdf, 0x6d, 0xbe, 0x10, 0x5c, 0x2f, 0x24, 0xd5, 0x58, 0x26, 0xd7, 0xd8, 0x34, 0x07, 0xf4,
+	0x67, 0x03, 0xa4, 0x87, 0x65, 0x37, 0x39, 0xe9, 0x11, 0xde, 0x1f, 0xcb, 0x65, 0x41, 0xe6, 0xf2,
+	0xeb, 0x59, 0x73, 0xd9, 0x57, 0x3c, 0x63, 0x89, 0x94, 0xd8, 0xc4, 0x55, 0xc4, 0x60, 0x5d, 0xe9,
+	0x68, 0x2c, 0x7c, 0x46, 0x86, 0xbf, 0x3f, 0x4b, 0xf8, 0x47, 0x01, 0xc5, 0x58, 0xe4, 0xb5, 0xd3,
+	0xb8, 0x05, 0xf4, 0x77, 0x03, 0x7e, 0x2c, 0x5f, 0x7d, 0xa0, 0x42, 0xa9, 0xbe, 0xb1, 0x0c, 0x40,
+	0x66, 0x70, 0x38, 0x6b, 0x01, 0x5e, 0x29, 0x36, 0x51, 0xee, 0xf1, 0xc6, 0xd8, 0x64, 0xd3, 0x5d,
+	0x50, 0x1d, 0x96, 0x7b, 0x84, 0x91, 0x06, 0x69, 0xcb, 0xcd, 0x20, 0x1d, 0x5c, 0x5c, 0x94, 0x09,
+	0x94, 0xaa, 0xc1, 0xd9, 0x58, 0xd5, 0x67, 0x63, 0xf5, 0x58, 0x9f, 0x8d, 0x07, 0xa9, 0x37, 0xff,
+	0xdf, 0x34, 0xcc, 0xfc, 0x10, 0x28, 0x96, 0x0e, 0x72, 0x00, 0xc3, 0xd7, 0x78, 0x9c, 0xca, 0xa4,
+	0x0a, 0xf3, 0x8f, 0x53, 0x99, 0x74, 0x21, 0x53, 0xf9, 0x6b, 0x02, 0x0a, 0x61, 0x21, 0x79, 0x67,
+	0xd8, 0x45, 0x1b, 0x90, 0x09, 0x9a, 0x92, 0x38, 0x52, 0x8a, 0xf3, 0x66, 0x5a, 0x3e, 0xd7, 0x1d,
+	0x74, 0x1f, 0x36, 0xda, 0x36, 0xe3, 0x16, 0xc5, 0x9c, 0x12, 0xdc, 0xc3, 0x8e, 0xa5, 0xa4, 0x3d,
+	0x54, 0xd8, 0x8f, 0x84, 0x83, 0xa9, 0xd7, 0x9f, 0x04, 0xcb, 0x21, 0xa8, 0x4f, 0xbd, 0x26, 0x66,
+	0x2c, 0x0a, 0x4d, 0x0e, 0xa1, 0xcf, 0xf5, 0xfa, 0x10, 0x8a, 0xa1, 0x3c, 0x02, 0x1d, 0xad, 0x4c,
+	0x6a, 0xc6, 0xca, 0x5c, 0x8d, 0x44, 0x78, 0x19, 0x29, 0x53, 0xe5, 0x18, 0x96, 0x47, 0x44, 0x84,
+	0xf6, 0x21, 0xab, 0x95, 0x29, 0xc2, 0x18, 0x33, 0x86, 0x81, 0x00, 0x24, 0x59, 0xff, 0x63, 0xc0,
+	0x15, 0x41, 0x1b, 0x2a, 0xb3, 0xdc, 0xee, 0xe9, 0x05, 0x31, 0xa6, 0x16, 0xc4, 0x82, 0xab, 0x13,
+	0xa0, 0x32, 0xcd, 0xc4, 0x8c, 0x69, 0x16, 0xe3, 0xe8, 0x65, 0xd2, 0xff, 0x4e, 0xc0, 0x6a, 0x28,
+	0x61, 0xb5, 0xc4, 0xd0, 0x1f, 0x60, 0x25, 0xd4, 0xe9, 0x52, 0x21, 0xac, 0x68, 0x6c, 0x25, 0xb7,
+	0xb3, 0xbb, 0x7b, 0xb3, 0xe8, 0x62, 0xe4, 0xd0, 0x36, 0x0b, 0x34, 0x6a, 0x60, 0xdf, 0xa6, 0xc3,
+	0x36, 0x20, 0x73, 0x6a, 0x33, 0xab, 0xe3, 0x51, 0x2c, 0x1b, 0x2a, 0x63, 0xa6, 0x4f, 0x6d, 0xf6,
+	0xc4, 0xa3, 0x18, 0x59, 0xb0, 0x32, 0x76, 0xd8, 0xaa, 0xa6, 0xd9, 0xfb, 0x8c, 0xc3, 0xd5, 0x5c,
+	0x1e, 0x39, 0x4c, 0x2b, 0x5f, 0x1a, 0x70, 0x55, 0xab, 0xf9, 0x87, 0x29, 0xdc, 0x16, 0xe4, 0x64,
+	0xe1, 0xa2, 0xdf, 0x3b, 0x10, 0x36, 0xf5, 0xb5, 0x7b, 0x08, 0xf9, 0xa1, 0x87, 0x6c, 0x94, 0xe4,
+	0x8c, 0x8d, 0x92, 0xd3, 0x2c, 0xb2, 0x39, 0xde, 0x47, 0x9b, 0x43, 0xb2, 0xbb, 0x2d, 0x0f, 0x5d,
+	0x87, 0xdc, 0xf0, 0x6b, 0xaa, 0x7a, 0x78, 0xd1, 0xcc, 0x0e, 0x6c, 0x75, 0x07, 0x6d, 0x42, 0x76,
+	0x70, 0xc8, 0xaa, 0x1c, 0x17, 0x4d, 0xd0, 0xa6, 0xba, 0x83, 0xd6, 0x60, 0x81, 0x76, 0x5d, 0x7d,
+	0x24, 0x2c, 0x9a, 0xf3, 0xb4, 0xeb, 0xd6, 0x1d, 0x74, 0x18, 0x1e, 0x0f, 0x52, 0x72, 0x3c, 0xf8,
+	0xc9, 0xf4, 0xf1, 0x20, 0x66, 0x26, 0x58, 0x87, 0xb4, 0x2e, 0xce, 0xbc, 0x2c, 0xce, 0x02, 0x0f,
+	0x0a, 0x53, 0x84, 0x74, 0x0f, 0x53, 0x46, 0x3c, 0x57, 0x7e, 0xe4, 0x92, 0xa6, 0x7e, 0x14, 0x63,
+	0x44, 0x8b, 0x50, 0xc6, 0x2d, 0xdc, 0xc3, 0x2e, 0x17, 0xc8, 0x74, 0x30, 0x46, 0x48, 0xeb, 0x91,
+	0x30, 0xd6, 0x1d, 0x54, 0x81, 0x25, 0x17, 0xbf, 0x0e, 0x39, 0x65, 0xa4, 0x53, 0x56, 0x18, 0xb5,
+	0xcf, 0x1d, 0x40, 0xac, 0x79, 0x8a, 0x9d, 0x6e, 0x1b, 0x3b, 0x43, 0xc7, 0x45, 0xe9, 0x58, 0x18,
+	0xac, 0x28, 0xef, 0xca, 0x3f, 0x52, 0xb0, 0x3e, 0x61, 0xa8, 0x40, 0x36, 0xac, 0x0e, 0xcb, 0xec,
+	0xf9, 0x98, 0xca, 0x5d, 0x50, 0x43, 0xd3, 0xdd, 0xe9, 0x55, 0x19, 0x70, 0x3e, 0xd3, 0x38, 0x13,
+	0xb9, 0x63, 0x36, 0x94, 0x87, 0xc4, 0x60, 0x77, 0x12, 0xc4, 0x41, 0xbf, 0x82, 0x14, 0x71, 0x5b,
+	0x9e, 0xea, 0x97, 0xed, 0x61, 0x0c, 0x41, 0x3e, 0xc0, 0x47, 0x02, 0x88, 0x8e, 0x30, 0x25, 0x0a,
+	0x1d, 0xc0, 0x42, 0xd3, 0x73, 0x5b, 0xe4, 0x44, 0x29, 0xee, 0xa7, 0xb3, 0xe0, 0x0f, 0x25, 0xc2,
+	0x54, 0x48, 0xd4, 0x02, 0x14, 0xd6, 0x8f, 0xe2, 0x0b, 0xc6, 0xa3, 0x5f, 0x44, 0xf9, 0x26, 0xcd,
+	0x66, 0xa1, 0x96, 0x55, 0xe4, 0x2b, 0x74, 0xd4, 0x84, 0x6e, 0x42, 0x3e, 0xe0, 0xb6, 0xa2, 0x1d,
+	0xb1, 0x14, 0x58, 0x5f, 0xaa, 0xbe, 0xb8, 0x0d, 0x05, 0x31, 0x0d, 0x7b, 0x3d, 0x4c, 0x07, 0x8e,
+	0x41, 0x67, 0x2c, 0x6b, 0xbb, 0x76, 0x7d, 0x19, 0x72, 0x55, 0xf3, 0x47, 0x31, 0x23, 0x85, 0xff,
+	0xb3, 0xa9, 0x79, 0x3f, 0x54, 0x20, 0x7d, 0xe2, 0x68, 0x12, 0x35, 0xdc, 0x54, 0xfe, 0x69, 0xc0,
+	0xb5, 0xa9, 0x33, 0x9f, 0x78, 0x17, 0x35, 0x03, 0x37, 0xdb, 0x5d, 0xc6, 0x31, 0x55, 0x8a, 0x5c,
+	0x0a, 0xac, 0x87, 0x81, 0x31, 0xf2, 0xb9, 0x4f, 0x44, 0x3f, 0xf7, 0x23, 0x9f, 0xbf, 0xe4, 0x67,
+	0x7c, 0xfe, 0xbe, 0x4a, 0x43, 0x69, 0xf2, 0x38, 0xf8, 0x7d, 0x9e, 0x19, 0x21, 0x55, 0xa7, 0xa2,
+	0xaa, 0x8e, 0xd7, 0xe2, 0x7c, 0xbc, 0x16, 0xd1, 0xef, 0x20, 0x3f, 0xf4, 0x96, 0x75, 0x58, 0x98,
+	0xb1, 0x0e, 0x4b, 0x03, 0x9c, 0x58, 0x41, 0xdb, 0x50, 0x60, 0xdc, 0xa6, 0x3c, 0x1c, 0x34, 0x68,
+	0x9a, 0xbc, 0xb2, 0xeb, 0x90, 0x87, 0x90, 0xd3, 0x9e, 0x32, 0x60, 0x66, 0xc6, 0x80, 0x59, 0x85,
+	0x92, 0xe1, 0x9e, 0xc3, 0xaa, 0x3c, 0xee, 0x4f, 0xb1, 0x4d, 0x79, 0x03, 0xdb, 0xfc, 0x9b, 0x0d,
+	0x91, 0x2b, 0x02, 0xfc, 0x48, 0x63, 0x25, 0xe3, 0x2f, 0x21, 0xed, 0x60, 0x6e, 0x93, 0xb6, 0x9e,
+	0x85, 0xb7, 0xa2, 0x1d, 0x1c, 0x5c, 0x4d, 0x45, 0xf3, 0x3e, 0xb7, 0xfb, 0x6d, 0xcf, 0x76, 0x98,
+	0xa9, 0x01, 0x62, 0x37, 0x6c, 0x2e, 0xbc, 0x79, 0x31, 0x1b, 0x34, 0x99, 0x7a, 0x14, 0x2f, 0x2b,
+	0xf3, 0x54, 0xd7, 0xcb, 0x62, 0x2e, 0x8e, 0x5a, 0x2d, 0x6a, 0x61, 0x74, 0x29, 0x36, 0xb3, 0x02,
+	0xa5, 0x1e, 0xd0, 0x5d, 0xb8, 0x22, 0x49, 0x44, 0x5b, 0x60, 0x6a, 0x11, 0x07, 0xbb, 0x9c, 0xf0,
+	0x7e, 0x71, 0x49, 0x76, 0x04, 0x12, 0x6b, 0xaf, 0xe4, 0x52, 0x5d, 0xad, 0xa0, 0x57, 0xb0, 0xac,
+	0xfa, 0x61, 0x20, 0xcb, 0xbc, 0x8c, 0x5c, 0x8d, 0x3d, 0x42, 0x95, 0x8f, 0x48, 0x40, 0x29, 0x5b,
+	0x09, 0xd1, 0xcc, 0xf7, 0x22, 0xcf, 0xa8, 0x01, 0xab, 0x0d, 0x9b, 0x61, 0x0b, 0xbf, 0xc6, 0xcd,
+	0xae, 0x3c, 0xad, 0xe4, 0xd9, 0xb9, 0x2c, 0xc9, 0x77, 0x63, 0xc9, 0x75, 0x33, 0x0b, 0xf6, 0x03,
+	0x9b, 0xe1, 0x23, 0x0d, 0x95, 0xa7, 0xe8, 0x4a, 0x63, 0xd4, 0x84, 0xea, 0x90, 0xf6, 0x7c, 0xf1,
+	0xc4, 0x8a, 0x05, 0xc9, 0x5b, 0xbb, 0x2c, 0x69, 0xad, 0xbf, 0x67, 0x01, 0xcc, 0xd4, 0x78, 0x74,
+	0x0c, 0x6b, 0x14, 0x73, 0xda, 0xb7, 0xf0, 0x6b, 0x9f, 0x50, 0x35, 0x9e, 0x88, 0x46, 0x59, 0x99,
+	0xb1, 0x51, 0x56, 0x25, 0xfc, 0x68, 0x80, 0x96, 0xb2, 0xff, 0x6f, 0x12, 0xd6, 0x62, 0xaf, 0x61,
+	0x63, 0x8a, 0x4f, 0x5c, 0xaa, 0xf8, 0xe4, 0x14, 0xc5, 0xa7, 0xc2, 0x8a, 0x6f, 0xc1, 0xda, 0xc8,
+	0x96, 0x5a, 0x84, 0xe3, 0x8e, 0xb8, 0x46, 0x27, 0x27, 0xd6, 0x7e, 0xe2, 0xc6, 0xd6, 0x39, 0xee,
+	0x98, 0xab, 0xbd, 0x31, 0x1b, 0x43, 0xf7, 0x60, 0x41, 0x0a, 0x58, 0xdf, 0x89, 0x27, 0xca, 0xe0,
+	0xb7, 0x36, 0xb7, 0x0f, 0xda, 0x5e, 0xc3, 0x54, 0xfe, 0x62, 0x04, 0x73, 0xf1, 0xb9, 0x25, 0x92,
+	0x57, 0x0c, 0xe9, 0x19, 0x19, 0x72, 0x2e, 0x3e, 0x37, 0xbb, 0xee, 0x51, 0xc0, 0x33, 0xa1, 0xc7,
+	0x32, 0xdf, 0x61, 0x8f, 0x3d, 0x4e, 0x65, 0x8c, 0x42, 0xa2, 0xf2, 0x27, 0x03, 0x36, 0x2f, 0xb9,
+	0xcd, 0x22, 0x0b, 0xf2, 0xd1, 0xab, 0xb3, 0xba, 0x28, 0xdd, 0x8b, 0x4d, 0x24, 0xf4, 0xd7, 0x97,
+	0xc8, 0x45, 0x13, 0x3f, 0x09, 0xfe, 0xf9, 0x92, 0xfc, 0xe6, 0xd2, 0x79, 0x38, 0xdc, 0x01, 0x79,
+	0xfb, 0xa1, 0x3c, 0xf7, 0xee, 0x43, 0x79, 0xee, 0xd3, 0x87, 0xb2, 0xf1, 0xc7, 0x8b, 0xb2, 0xf1,
+	0xaf, 0x8b, 0xb2, 0xf1, 0xbf, 0x8b, 0xb2, 0xf1, 0xf6, 0xa2, 0x6c, 0xbc, 0xbf, 0x28, 0x1b, 0x5f,
+	0x5c, 0x94, 0xe7, 0x3e, 0x5d, 0x94, 0x8d, 0x37, 0x1f, 0xcb, 0x73, 0x6f, 0x3f, 0x96, 0xe7, 0xde,
+	0x7d, 0x2c, 0xcf, 0xfd, 0x7e, 0xef, 0xc4, 0x1b, 0x26, 0x40, 0xbc, 0xc9, 0xff, 0x4f, 0x3e, 0xa0,
+	0xd8, 0x57, 0x4f, 0x8d, 0x05, 0xd9, 0xe7, 0x7b, 0x5f, 0x0f, 0x00, 0x45, 0xeb, 0x9f, 0xa1, 0xd7,
+	0x14, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1728,6 +1748,16 @@ func (this *SyncActivityTaskAttributes) Equal(that interface{}) bool {
 	if !this.BaseExecutionInfo.Equal(that1.BaseExecutionInfo) {
 		return false
 	}
+	if !this.Options.Equal(that1.Options) {
+		return false
+	}
+	if that1.RetryExpirationTime == nil {
+		if this.RetryExpirationTime != nil {
+			return false
+		}
+	} else if !this.RetryExpirationTime.Equal(*that1.RetryExpirationTime) {
+		return false
+	}
 	return true
 }
 func (this *HistoryTaskAttributes) Equal(that interface{}) bool {
@@ -1980,7 +2010,7 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 21)
 	s = append(s, "&repication.SyncActivityTaskAttributes{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -2005,6 +2035,10 @@ func (this *SyncActivityTaskAttributes) GoString() string {
 	if this.BaseExecutionInfo != nil {
 		s = append(s, "BaseExecutionInfo: "+fmt.Sprintf("%#v", this.BaseExecutionInfo)+",\n")
 	}
+	if this.Options != nil {
+		s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	}
+	s = append(s, "RetryExpirationTime: "+fmt.Sprintf("%#v", this.RetryExpirationTime)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2678,6 +2712,32 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.RetryExpirationTime != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintMessage(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BaseExecutionInfo != nil {
 		{
 			size, err := m.BaseExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -2739,22 +2799,22 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err20 != nil {
-			return 0, err20
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n20
-		i = encodeVarintMessage(dAtA, i, uint64(n20))
+		i -= n22
+		i = encodeVarintMessage(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err21 != nil {
-			return 0, err21
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n21
-		i = encodeVarintMessage(dAtA, i, uint64(n21))
+		i -= n23
+		i = encodeVarintMessage(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -2764,12 +2824,12 @@ func (m *SyncActivityTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, err
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err22 != nil {
-			return 0, err22
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n22
-		i = encodeVarintMessage(dAtA, i, uint64(n22))
+		i -= n24
+		i = encodeVarintMessage(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -3288,6 +3348,14 @@ func (m *SyncActivityTaskAttributes) Size() (n int) {
 		l = m.BaseExecutionInfo.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.RetryExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime)
+		n += 2 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -3553,6 +3621,8 @@ func (this *SyncActivityTaskAttributes) String() string {
 		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
 		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v15.VersionHistory", 1) + `,`,
 		`BaseExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.BaseExecutionInfo), "BaseExecutionInfo", "v16.BaseExecutionInfo", 1) + `,`,
+		`Options:` + strings.Replace(fmt.Sprintf("%v", this.Options), "ActivityOptions", "v15.ActivityOptions", 1) + `,`,
+		`RetryExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.RetryExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5717,6 +5787,78 @@ func (m *SyncActivityTaskAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &v15.ActivityOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryExpirationTime == nil {
+				m.RetryExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RetryExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -0,0 +1,2348 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/taskqueue/v1/message.proto
+
+package taskqueue
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v11 "go.temporal.io/api/enums/v1"
+	v1 "go.temporal.io/server/api/persistence/v1"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BacklogTaskFilter selects tasks in the backlog of a task queue. Unset fields match all tasks.
+type BacklogTaskFilter struct {
+	WorkflowId       string `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId            string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	WorkflowTypeName string `protobuf:"bytes,3,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	// Only tasks created before this time are selected.
+	CreatedBefore *time.Time `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
+}
+
+func (m *BacklogTaskFilter) Reset()      { *m = BacklogTaskFilter{} }
+func (*BacklogTaskFilter) ProtoMessage() {}
+func (*BacklogTaskFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{0}
+}
+func (m *BacklogTaskFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BacklogTaskFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BacklogTaskFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BacklogTaskFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BacklogTaskFilter.Merge(m, src)
+}
+func (m *BacklogTaskFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BacklogTaskFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BacklogTaskFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BacklogTaskFilter proto.InternalMessageInfo
+
+func (m *BacklogTaskFilter) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *BacklogTaskFilter) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *BacklogTaskFilter) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
+}
+
+func (m *BacklogTaskFilter) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+type BacklogSummary struct {
+	TaskCount int64 `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	// Tasks added before workflow types were recorded on tasks are counted under the empty name.
+	WorkflowTypeTaskCounts map[string]int64      `protobuf:"bytes,2,rep,name=workflow_type_task_counts,json=workflowTypeTaskCounts,proto3" json:"workflow_type_task_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AgeHistogram           []*BacklogAgeBucket   `protobuf:"bytes,3,rep,name=age_histogram,json=ageHistogram,proto3" json:"age_histogram,omitempty"`
+	OldestTask             *v1.AllocatedTaskInfo `protobuf:"bytes,4,opt,name=oldest_task,json=oldestTask,proto3" json:"oldest_task,omitempty"`
+	// True if the backlog has more tasks than matching scans per request, in which case the summary only
+	// covers the tasks with the lowest task ids.
+	Truncated bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *BacklogSummary) Reset()      { *m = BacklogSummary{} }
+func (*BacklogSummary) ProtoMessage() {}
+func (*BacklogSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{1}
+}
+func (m *BacklogSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BacklogSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BacklogSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BacklogSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BacklogSummary.Merge(m, src)
+}
+func (m *BacklogSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *BacklogSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BacklogSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BacklogSummary proto.InternalMessageInfo
+
+func (m *BacklogSummary) GetTaskCount() int64 {
+	if m != nil {
+		return m.TaskCount
+	}
+	return 0
+}
+
+func (m *BacklogSummary) GetWorkflowTypeTaskCounts() map[string]int64 {
+	if m != nil {
+		return m.WorkflowTypeTaskCounts
+	}
+	return nil
+}
+
+func (m *BacklogSummary) GetAgeHistogram() []*BacklogAgeBucket {
+	if m != nil {
+		return m.AgeHistogram
+	}
+	return nil
+}
+
+func (m *BacklogSummary) GetOldestTask() *v1.AllocatedTaskInfo {
+	if m != nil {
+		return m.OldestTask
+	}
+	return nil
+}
+
+func (m *BacklogSummary) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type BacklogAgeBucket struct {
+	// Upper bound of the age of the tasks in this bucket, not set for the last bucket.
+	MaxAge    *time.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age,omitempty"`
+	TaskCount int64          `protobuf:"varint,2,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+}
+
+func (m *BacklogAgeBucket) Reset()      { *m = BacklogAgeBucket{} }
+func (*BacklogAgeBucket) ProtoMessage() {}
+func (*BacklogAgeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{2}
+}
+func (m *BacklogAgeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BacklogAgeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BacklogAgeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BacklogAgeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BacklogAgeBucket.Merge(m, src)
+}
+func (m *BacklogAgeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BacklogAgeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BacklogAgeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BacklogAgeBucket proto.InternalMessageInfo
+
+func (m *BacklogAgeBucket) GetMaxAge() *time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *BacklogAgeBucket) GetTaskCount() int64 {
+	if m != nil {
+		return m.TaskCount
+	}
+	return 0
+}
+
+// WorkerInfo describes a worker polling a task queue, as reported by the worker in its polls.
+type WorkerInfo struct {
+	Identity    string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Name of the root partition of the task queue.
+	TaskQueue     string            `protobuf:"bytes,3,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v11.TaskQueueType `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	SdkName       string            `protobuf:"bytes,5,opt,name=sdk_name,json=sdkName,proto3" json:"sdk_name,omitempty"`
+	SdkVersion    string            `protobuf:"bytes,6,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	// Build id of the worker, only set if the worker opted into worker versioning.
+	BuildId       string     `protobuf:"bytes,7,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	FirstPollTime *time.Time `protobuf:"bytes,8,opt,name=first_poll_time,json=firstPollTime,proto3,stdtime" json:"first_poll_time,omitempty"`
+	LastPollTime  *time.Time `protobuf:"bytes,9,opt,name=last_poll_time,json=lastPollTime,proto3,stdtime" json:"last_poll_time,omitempty"`
+	PollCount     int64      `protobuf:"varint,10,opt,name=poll_count,json=pollCount,proto3" json:"poll_count,omitempty"`
+	// Dispatch rate limit of the task queue requested by the worker.
+	MaxTasksPerSecond float64 `protobuf:"fixed64,11,opt,name=max_tasks_per_second,json=maxTasksPerSecond,proto3" json:"max_tasks_per_second,omitempty"`
+	// Average number of polls per second since the first poll.
+	PollRate float64 `protobuf:"fixed64,12,opt,name=poll_rate,json=pollRate,proto3" json:"poll_rate,omitempty"`
+	// Task slots of the worker as reported in the metadata of its latest poll, zero if not reported.
+	TaskSlotsAvailable int32 `protobuf:"varint,13,opt,name=task_slots_available,json=taskSlotsAvailable,proto3" json:"task_slots_available,omitempty"`
+	TaskSlotsUsed      int32 `protobuf:"varint,14,opt,name=task_slots_used,json=taskSlotsUsed,proto3" json:"task_slots_used,omitempty"`
+}
+
+func (m *WorkerInfo) Reset()      { *m = WorkerInfo{} }
+func (*WorkerInfo) ProtoMessage() {}
+func (*WorkerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{3}
+}
+func (m *WorkerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerInfo.Merge(m, src)
+}
+func (m *WorkerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerInfo proto.InternalMessageInfo
+
+func (m *WorkerInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetTaskQueueType() v11.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
+	}
+	return v11.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *WorkerInfo) GetSdkName() string {
+	if m != nil {
+		return m.SdkName
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetSdkVersion() string {
+	if m != nil {
+		return m.SdkVersion
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *WorkerInfo) GetFirstPollTime() *time.Time {
+	if m != nil {
+		return m.FirstPollTime
+	}
+	return nil
+}
+
+func (m *WorkerInfo) GetLastPollTime() *time.Time {
+	if m != nil {
+		return m.LastPollTime
+	}
+	return nil
+}
+
+func (m *WorkerInfo) GetPollCount() int64 {
+	if m != nil {
+		return m.PollCount
+	}
+	return 0
+}
+
+func (m *WorkerInfo) GetMaxTasksPerSecond() float64 {
+	if m != nil {
+		return m.MaxTasksPerSecond
+	}
+	return 0
+}
+
+func (m *WorkerInfo) GetPollRate() float64 {
+	if m != nil {
+		return m.PollRate
+	}
+	return 0
+}
+
+func (m *WorkerInfo) GetTaskSlotsAvailable() int32 {
+	if m != nil {
+		return m.TaskSlotsAvailable
+	}
+	return 0
+}
+
+func (m *WorkerInfo) GetTaskSlotsUsed() int32 {
+	if m != nil {
+		return m.TaskSlotsUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BacklogTaskFilter)(nil), "temporal.server.api.taskqueue.v1.BacklogTaskFilter")
+	proto.RegisterType((*BacklogSummary)(nil), "temporal.server.api.taskqueue.v1.BacklogSummary")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.taskqueue.v1.BacklogSummary.WorkflowTypeTaskCountsEntry")
+	proto.RegisterType((*BacklogAgeBucket)(nil), "temporal.server.api.taskqueue.v1.BacklogAgeBucket")
+	proto.RegisterType((*WorkerInfo)(nil), "temporal.server.api.taskqueue.v1.WorkerInfo")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/taskqueue/v1/message.proto", fileDescriptor_4e9b64ab0f85f299)
+}
+
+var fileDescriptor_4e9b64ab0f85f299 = []byte{
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x15, 0x2d, 0xff, 0x5e, 0x59, 0xb2, 0x33, 0xf0, 0xf7, 0x81, 0x56, 0x1a, 0x5a, 0x31, 0x8a,
+	0x40, 0x8b, 0x82, 0x4a, 0x54, 0x14, 0x08, 0xda, 0x95, 0xd5, 0x36, 0x8d, 0x80, 0xa0, 0x48, 0x69,
+	0x35, 0x01, 0xba, 0x21, 0x46, 0xe2, 0x15, 0x4b, 0x70, 0xc8, 0x61, 0x67, 0x86, 0xb2, 0xb5, 0xeb,
+	0xae, 0xdb, 0x2c, 0xfb, 0x08, 0x7d, 0x8e, 0xae, 0xba, 0x34, 0xd0, 0x4d, 0x76, 0xad, 0xe5, 0x4d,
+	0x96, 0x79, 0x84, 0x62, 0x86, 0xa2, 0x24, 0xbb, 0x6e, 0xeb, 0x9d, 0xe6, 0x9e, 0x73, 0xff, 0xcf,
+	0xa5, 0xc0, 0x55, 0x98, 0x64, 0x5c, 0x50, 0xd6, 0x91, 0x28, 0x26, 0x28, 0x3a, 0x34, 0x8b, 0x3a,
+	0x8a, 0xca, 0xf8, 0x87, 0x1c, 0x73, 0xec, 0x4c, 0x9e, 0x74, 0x12, 0x94, 0x92, 0x86, 0xe8, 0x66,
+	0x82, 0x2b, 0x4e, 0x5a, 0x25, 0xdf, 0x2d, 0xf8, 0x2e, 0xcd, 0x22, 0x77, 0xc1, 0x77, 0x27, 0x4f,
+	0x9a, 0x4e, 0xc8, 0x79, 0xc8, 0xb0, 0x63, 0xf8, 0xc3, 0x7c, 0xdc, 0x09, 0x72, 0x41, 0x55, 0xc4,
+	0xd3, 0x22, 0x42, 0xf3, 0xe8, 0x26, 0xae, 0xa2, 0x04, 0xa5, 0xa2, 0x49, 0x36, 0x27, 0x3c, 0x0c,
+	0x30, 0xc3, 0x34, 0xc0, 0x74, 0x14, 0xa1, 0xec, 0x84, 0x3c, 0xe4, 0xc6, 0x6e, 0x7e, 0xcd, 0x29,
+	0x8f, 0x16, 0x55, 0xeb, 0x72, 0x31, 0xcd, 0x13, 0xa9, 0x4b, 0xd5, 0x75, 0xf8, 0x45, 0x21, 0x05,
+	0xef, 0xd6, 0xee, 0x32, 0x14, 0x32, 0x92, 0x0a, 0xd3, 0x11, 0x96, 0x4e, 0xb2, 0xe0, 0x1f, 0xff,
+	0x6a, 0xc1, 0xbd, 0x1e, 0x1d, 0xc5, 0x8c, 0x87, 0x03, 0x2a, 0xe3, 0x67, 0x11, 0x53, 0x28, 0xc8,
+	0x11, 0xd4, 0xce, 0xb8, 0x88, 0xc7, 0x8c, 0x9f, 0xf9, 0x51, 0x60, 0x5b, 0x2d, 0xab, 0xbd, 0xe3,
+	0x41, 0x69, 0xea, 0x07, 0xe4, 0x7f, 0xb0, 0x29, 0xf2, 0x54, 0x63, 0x6b, 0x06, 0xdb, 0x10, 0x79,
+	0xda, 0x0f, 0xc8, 0x47, 0x40, 0x16, 0x7e, 0x6a, 0x9a, 0xa1, 0x9f, 0xd2, 0x04, 0xed, 0xaa, 0xa1,
+	0xec, 0x97, 0xc8, 0x60, 0x9a, 0xe1, 0xd7, 0x34, 0x41, 0xf2, 0x15, 0x34, 0x46, 0x02, 0xa9, 0xc2,
+	0xc0, 0x1f, 0xe2, 0x98, 0x0b, 0xb4, 0xd7, 0x5b, 0x56, 0xbb, 0xd6, 0x6d, 0xba, 0xc5, 0xc0, 0xdc,
+	0x72, 0x60, 0xee, 0xa0, 0x1c, 0x58, 0x6f, 0xfd, 0xcd, 0x1f, 0x47, 0x96, 0x57, 0x9f, 0xfb, 0xf5,
+	0x8c, 0xdb, 0xf1, 0xef, 0x55, 0x68, 0xcc, 0x9b, 0x38, 0xcd, 0x93, 0x84, 0x8a, 0x29, 0x79, 0x00,
+	0x60, 0x66, 0x33, 0xe2, 0x79, 0xaa, 0x4c, 0x03, 0x55, 0x6f, 0x47, 0x5b, 0x3e, 0xd7, 0x06, 0xf2,
+	0x93, 0x05, 0x87, 0xd7, 0x2b, 0x5d, 0xb2, 0xa5, 0xbd, 0xd6, 0xaa, 0xb6, 0x6b, 0xdd, 0x17, 0xee,
+	0x7f, 0x6d, 0xde, 0xbd, 0x9e, 0xd4, 0x7d, 0xbd, 0xd2, 0xe1, 0xa0, 0xcc, 0x25, 0xbf, 0x4c, 0x95,
+	0x98, 0x7a, 0xff, 0x3f, 0xbb, 0x15, 0x24, 0xaf, 0xa1, 0x4e, 0x43, 0xf4, 0xbf, 0x8f, 0xa4, 0xe2,
+	0xa1, 0xa0, 0x89, 0x5d, 0x35, 0xc9, 0xbb, 0x77, 0x4e, 0x7e, 0x12, 0x62, 0x2f, 0x1f, 0xc5, 0xa8,
+	0xbc, 0x5d, 0x1a, 0xe2, 0xf3, 0x32, 0x0e, 0x79, 0x05, 0x35, 0xce, 0x02, 0x94, 0xca, 0xb4, 0x36,
+	0x1f, 0xed, 0x27, 0xb7, 0x86, 0x5d, 0xd1, 0x87, 0x0e, 0x7c, 0xc2, 0x18, 0x1f, 0xe9, 0xf1, 0xea,
+	0x32, 0xfb, 0xe9, 0x98, 0x7b, 0x50, 0x44, 0xd2, 0x6f, 0xf2, 0x01, 0xec, 0x28, 0x91, 0xa7, 0x86,
+	0x60, 0x6f, 0xb4, 0xac, 0xf6, 0xb6, 0xb7, 0x34, 0x34, 0xfb, 0x70, 0xff, 0x5f, 0xa6, 0x40, 0xf6,
+	0xa1, 0x1a, 0xe3, 0x74, 0x2e, 0x28, 0xfd, 0x93, 0x1c, 0xc0, 0xc6, 0x84, 0xb2, 0x1c, 0x8d, 0x90,
+	0xaa, 0x5e, 0xf1, 0xf8, 0x74, 0xed, 0xa9, 0x75, 0x1c, 0xc3, 0xfe, 0xcd, 0x16, 0xc9, 0x53, 0xd8,
+	0x4a, 0xe8, 0xb9, 0x4f, 0x43, 0x34, 0x31, 0x6a, 0xdd, 0xc3, 0xbf, 0x69, 0xe5, 0x8b, 0xf9, 0xf1,
+	0xf5, 0xd6, 0x7f, 0xd6, 0x52, 0xd9, 0x4c, 0xe8, 0xf9, 0x49, 0x88, 0x37, 0x04, 0xb1, 0x76, 0x43,
+	0x10, 0xc7, 0xef, 0xd6, 0x01, 0x74, 0xe1, 0x28, 0x74, 0xc3, 0xa4, 0x09, 0xdb, 0x51, 0x80, 0xa9,
+	0x8a, 0x54, 0x59, 0xec, 0xe2, 0x4d, 0x1e, 0xc2, 0xae, 0x96, 0xb5, 0xcc, 0xe8, 0x08, 0x97, 0x17,
+	0x50, 0x5b, 0xd8, 0xfa, 0xc1, 0x22, 0x99, 0xd9, 0xd5, 0x5c, 0xff, 0x26, 0xd9, 0x37, 0xda, 0x40,
+	0x5e, 0xc0, 0xde, 0x12, 0x36, 0xf2, 0x33, 0xeb, 0x69, 0x74, 0x3f, 0x5c, 0xae, 0x47, 0xef, 0xc5,
+	0x9c, 0xb9, 0xde, 0xc8, 0xa0, 0x74, 0xd5, 0x33, 0xf5, 0xea, 0x6a, 0xf5, 0x49, 0x0e, 0x61, 0x5b,
+	0x06, 0x71, 0x71, 0x6a, 0x1b, 0x26, 0xd5, 0x96, 0x0c, 0x62, 0x73, 0x61, 0x47, 0x50, 0xd3, 0xd0,
+	0x44, 0xef, 0x97, 0xa7, 0xf6, 0xa6, 0x41, 0x41, 0x06, 0xf1, 0xab, 0xc2, 0xa2, 0x7d, 0x87, 0x79,
+	0xc4, 0x02, 0xdd, 0xc7, 0x56, 0xe1, 0x6b, 0xde, 0xfd, 0x80, 0x3c, 0x87, 0xbd, 0x71, 0x24, 0xa4,
+	0xf2, 0x33, 0xce, 0x98, 0xaf, 0x3f, 0x59, 0xf6, 0xf6, 0x5d, 0xcf, 0xd3, 0x38, 0xbe, 0xe4, 0x8c,
+	0x69, 0x84, 0x3c, 0x83, 0x06, 0xa3, 0xd7, 0x02, 0xed, 0xdc, 0x31, 0xd0, 0x2e, 0xa3, 0x2b, 0x71,
+	0x1e, 0x00, 0x98, 0x10, 0xc5, 0x0a, 0xa1, 0x58, 0xa1, 0xb6, 0x14, 0x37, 0xdd, 0x81, 0x03, 0xad,
+	0x0d, 0xf3, 0x75, 0xf3, 0x33, 0x14, 0xbe, 0xc4, 0x11, 0x4f, 0x03, 0xbb, 0xd6, 0xb2, 0xda, 0x96,
+	0x77, 0x2f, 0xa1, 0xe7, 0x7a, 0x8c, 0xf2, 0x25, 0x8a, 0x53, 0x03, 0x90, 0xfb, 0x60, 0xbc, 0x7d,
+	0x41, 0x15, 0xda, 0xbb, 0x86, 0xb5, 0xad, 0x0d, 0x1e, 0x55, 0x48, 0x1e, 0xc3, 0x81, 0xd9, 0x91,
+	0x64, 0x5c, 0x49, 0x9f, 0x4e, 0x68, 0xc4, 0xe8, 0x90, 0xa1, 0x5d, 0x6f, 0x59, 0xed, 0x0d, 0x8f,
+	0x68, 0xec, 0x54, 0x43, 0x27, 0x25, 0x42, 0x1e, 0xc1, 0xde, 0x8a, 0x47, 0x2e, 0x31, 0xb0, 0x1b,
+	0x86, 0x5c, 0x5f, 0x90, 0xbf, 0x95, 0x18, 0xf4, 0xc6, 0x17, 0x97, 0x4e, 0xe5, 0xed, 0xa5, 0x53,
+	0x79, 0x7f, 0xe9, 0x58, 0x3f, 0xce, 0x1c, 0xeb, 0x97, 0x99, 0x63, 0xfd, 0x36, 0x73, 0xac, 0x8b,
+	0x99, 0x63, 0xfd, 0x39, 0x73, 0xac, 0x77, 0x33, 0xa7, 0xf2, 0x7e, 0xe6, 0x58, 0x6f, 0xae, 0x9c,
+	0xca, 0xc5, 0x95, 0x53, 0x79, 0x7b, 0xe5, 0x54, 0xbe, 0x7b, 0x1c, 0xf2, 0xa5, 0x38, 0x22, 0xfe,
+	0x4f, 0x7f, 0x5e, 0x9f, 0x2d, 0x1e, 0xc3, 0x4d, 0x33, 0xd6, 0x8f, 0xff, 0x1a, 0x00, 0x98, 0x3d,
+	0x97, 0x90, 0xf1, 0x06, 0x00, 0x00,
+}
+
+func (this *BacklogTaskFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BacklogTaskFilter)
+	if !ok {
+		that2, ok := that.(BacklogTaskFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if that1.CreatedBefore == nil {
+		if this.CreatedBefore != nil {
+			return false
+		}
+	} else if !this.CreatedBefore.Equal(*that1.CreatedBefore) {
+		return false
+	}
+	return true
+}
+func (this *BacklogSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BacklogSummary)
+	if !ok {
+		that2, ok := that.(BacklogSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskCount != that1.TaskCount {
+		return false
+	}
+	if len(this.WorkflowTypeTaskCounts) != len(that1.WorkflowTypeTaskCounts) {
+		return false
+	}
+	for i := range this.WorkflowTypeTaskCounts {
+		if this.WorkflowTypeTaskCounts[i] != that1.WorkflowTypeTaskCounts[i] {
+			return false
+		}
+	}
+	if len(this.AgeHistogram) != len(that1.AgeHistogram) {
+		return false
+	}
+	for i := range this.AgeHistogram {
+		if !this.AgeHistogram[i].Equal(that1.AgeHistogram[i]) {
+			return false
+		}
+	}
+	if !this.OldestTask.Equal(that1.OldestTask) {
+		return false
+	}
+	if this.Truncated != that1.Truncated {
+		return false
+	}
+	return true
+}
+func (this *BacklogAgeBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BacklogAgeBucket)
+	if !ok {
+		that2, ok := that.(BacklogAgeBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAge != nil && that1.MaxAge != nil {
+		if *this.MaxAge != *that1.MaxAge {
+			return false
+		}
+	} else if this.MaxAge != nil {
+		return false
+	} else if that1.MaxAge != nil {
+		return false
+	}
+	if this.TaskCount != that1.TaskCount {
+		return false
+	}
+	return true
+}
+func (this *WorkerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkerInfo)
+	if !ok {
+		that2, ok := that.(WorkerInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if this.SdkName != that1.SdkName {
+		return false
+	}
+	if this.SdkVersion != that1.SdkVersion {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if that1.FirstPollTime == nil {
+		if this.FirstPollTime != nil {
+			return false
+		}
+	} else if !this.FirstPollTime.Equal(*that1.FirstPollTime) {
+		return false
+	}
+	if that1.LastPollTime == nil {
+		if this.LastPollTime != nil {
+			return false
+		}
+	} else if !this.LastPollTime.Equal(*that1.LastPollTime) {
+		return false
+	}
+	if this.PollCount != that1.PollCount {
+		return false
+	}
+	if this.MaxTasksPerSecond != that1.MaxTasksPerSecond {
+		return false
+	}
+	if this.PollRate != that1.PollRate {
+		return false
+	}
+	if this.TaskSlotsAvailable != that1.TaskSlotsAvailable {
+		return false
+	}
+	if this.TaskSlotsUsed != that1.TaskSlotsUsed {
+		return false
+	}
+	return true
+}
+func (this *BacklogTaskFilter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&taskqueue.BacklogTaskFilter{")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "CreatedBefore: "+fmt.Sprintf("%#v", this.CreatedBefore)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BacklogSummary) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&taskqueue.BacklogSummary{")
+	s = append(s, "TaskCount: "+fmt.Sprintf("%#v", this.TaskCount)+",\n")
+	keysForWorkflowTypeTaskCounts := make([]string, 0, len(this.WorkflowTypeTaskCounts))
+	for k, _ := range this.WorkflowTypeTaskCounts {
+		keysForWorkflowTypeTaskCounts = append(keysForWorkflowTypeTaskCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowTypeTaskCounts)
+	mapStringForWorkflowTypeTaskCounts := "map[string]int64{"
+	for _, k := range keysForWorkflowTypeTaskCounts {
+		mapStringForWorkflowTypeTaskCounts += fmt.Sprintf("%#v: %#v,", k, this.WorkflowTypeTaskCounts[k])
+	}
+	mapStringForWorkflowTypeTaskCounts += "}"
+	if this.WorkflowTypeTaskCounts != nil {
+		s = append(s, "WorkflowTypeTaskCounts: "+mapStringForWorkflowTypeTaskCounts+",\n")
+	}
+	if this.AgeHistogram != nil {
+		s = append(s, "AgeHistogram: "+fmt.Sprintf("%#v", this.AgeHistogram)+",\n")
+	}
+	if this.OldestTask != nil {
+		s = append(s, "OldestTask: "+fmt.Sprintf("%#v", this.OldestTask)+",\n")
+	}
+	s = append(s, "Truncated: "+fmt.Sprintf("%#v", this.Truncated)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BacklogAgeBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&taskqueue.BacklogAgeBucket{")
+	s = append(s, "MaxAge: "+fmt.Sprintf("%#v", this.MaxAge)+",\n")
+	s = append(s, "TaskCount: "+fmt.Sprintf("%#v", this.TaskCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WorkerInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&taskqueue.WorkerInfo{")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "SdkName: "+fmt.Sprintf("%#v", this.SdkName)+",\n")
+	s = append(s, "SdkVersion: "+fmt.Sprintf("%#v", this.SdkVersion)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "FirstPollTime: "+fmt.Sprintf("%#v", this.FirstPollTime)+",\n")
+	s = append(s, "LastPollTime: "+fmt.Sprintf("%#v", this.LastPollTime)+",\n")
+	s = append(s, "PollCount: "+fmt.Sprintf("%#v", this.PollCount)+",\n")
+	s = append(s, "MaxTasksPerSecond: "+fmt.Sprintf("%#v", this.MaxTasksPerSecond)+",\n")
+	s = append(s, "PollRate: "+fmt.Sprintf("%#v", this.PollRate)+",\n")
+	s = append(s, "TaskSlotsAvailable: "+fmt.Sprintf("%#v", this.TaskSlotsAvailable)+",\n")
+	s = append(s, "TaskSlotsUsed: "+fmt.Sprintf("%#v", this.TaskSlotsUsed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BacklogTaskFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BacklogTaskFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BacklogTaskFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedBefore != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CreatedBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedBefore):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BacklogSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BacklogSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BacklogSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OldestTask != nil {
+		{
+			size, err := m.OldestTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AgeHistogram) > 0 {
+		for iNdEx := len(m.AgeHistogram) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgeHistogram[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WorkflowTypeTaskCounts) > 0 {
+		for k := range m.WorkflowTypeTaskCounts {
+			v := m.WorkflowTypeTaskCounts[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TaskCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BacklogAgeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BacklogAgeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BacklogAgeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAge != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxAge):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskSlotsUsed != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskSlotsUsed))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TaskSlotsAvailable != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskSlotsAvailable))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PollRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PollRate))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.MaxTasksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxTasksPerSecond))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.PollCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PollCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastPollTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastPollTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPollTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FirstPollTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FirstPollTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstPollTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SdkVersion) > 0 {
+		i -= len(m.SdkVersion)
+		copy(dAtA[i:], m.SdkVersion)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SdkVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SdkName) > 0 {
+		i -= len(m.SdkName)
+		copy(dAtA[i:], m.SdkName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SdkName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BacklogTaskFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreatedBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CreatedBefore)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *BacklogSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskCount != 0 {
+		n += 1 + sovMessage(uint64(m.TaskCount))
+	}
+	if len(m.WorkflowTypeTaskCounts) > 0 {
+		for k, v := range m.WorkflowTypeTaskCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	if len(m.AgeHistogram) > 0 {
+		for _, e := range m.AgeHistogram {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.OldestTask != nil {
+		l = m.OldestTask.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *BacklogAgeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAge != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxAge)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TaskCount != 0 {
+		n += 1 + sovMessage(uint64(m.TaskCount))
+	}
+	return n
+}
+
+func (m *WorkerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovMessage(uint64(m.TaskQueueType))
+	}
+	l = len(m.SdkName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SdkVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.FirstPollTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FirstPollTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastPollTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPollTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PollCount != 0 {
+		n += 1 + sovMessage(uint64(m.PollCount))
+	}
+	if m.MaxTasksPerSecond != 0 {
+		n += 9
+	}
+	if m.PollRate != 0 {
+		n += 9
+	}
+	if m.TaskSlotsAvailable != 0 {
+		n += 1 + sovMessage(uint64(m.TaskSlotsAvailable))
+	}
+	if m.TaskSlotsUsed != 0 {
+		n += 1 + sovMessage(uint64(m.TaskSlotsUsed))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BacklogTaskFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BacklogTaskFilter{`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`CreatedBefore:` + strings.Replace(fmt.Sprintf("%v", this.CreatedBefore), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BacklogSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAgeHistogram := "[]*BacklogAgeBucket{"
+	for _, f := range this.AgeHistogram {
+		repeatedStringForAgeHistogram += strings.Replace(f.String(), "BacklogAgeBucket", "BacklogAgeBucket", 1) + ","
+	}
+	repeatedStringForAgeHistogram += "}"
+	keysForWorkflowTypeTaskCounts := make([]string, 0, len(this.WorkflowTypeTaskCounts))
+	for k, _ := range this.WorkflowTypeTaskCounts {
+		keysForWorkflowTypeTaskCounts = append(keysForWorkflowTypeTaskCounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowTypeTaskCounts)
+	mapStringForWorkflowTypeTaskCounts := "map[string]int64{"
+	for _, k := range keysForWorkflowTypeTaskCounts {
+		mapStringForWorkflowTypeTaskCounts += fmt.Sprintf("%v: %v,", k, this.WorkflowTypeTaskCounts[k])
+	}
+	mapStringForWorkflowTypeTaskCounts += "}"
+	s := strings.Join([]string{`&BacklogSummary{`,
+		`TaskCount:` + fmt.Sprintf("%v", this.TaskCount) + `,`,
+		`WorkflowTypeTaskCounts:` + mapStringForWorkflowTypeTaskCounts + `,`,
+		`AgeHistogram:` + repeatedStringForAgeHistogram + `,`,
+		`OldestTask:` + strings.Replace(fmt.Sprintf("%v", this.OldestTask), "AllocatedTaskInfo", "v1.AllocatedTaskInfo", 1) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BacklogAgeBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BacklogAgeBucket{`,
+		`MaxAge:` + strings.Replace(fmt.Sprintf("%v", this.MaxAge), "Duration", "types.Duration", 1) + `,`,
+		`TaskCount:` + fmt.Sprintf("%v", this.TaskCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkerInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkerInfo{`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`SdkName:` + fmt.Sprintf("%v", this.SdkName) + `,`,
+		`SdkVersion:` + fmt.Sprintf("%v", this.SdkVersion) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`FirstPollTime:` + strings.Replace(fmt.Sprintf("%v", this.FirstPollTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastPollTime:` + strings.Replace(fmt.Sprintf("%v", this.LastPollTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PollCount:` + fmt.Sprintf("%v", this.PollCount) + `,`,
+		`MaxTasksPerSecond:` + fmt.Sprintf("%v", this.MaxTasksPerSecond) + `,`,
+		`PollRate:` + fmt.Sprintf("%v", this.PollRate) + `,`,
+		`TaskSlotsAvailable:` + fmt.Sprintf("%v", this.TaskSlotsAvailable) + `,`,
+		`TaskSlotsUsed:` + fmt.Sprintf("%v", this.TaskSlotsUsed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BacklogTaskFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BacklogTaskFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BacklogTaskFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBefore == nil {
+				m.CreatedBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CreatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BacklogSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BacklogSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BacklogSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCount", wireType)
+			}
+			m.TaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeTaskCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTypeTaskCounts == nil {
+				m.WorkflowTypeTaskCounts = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WorkflowTypeTaskCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeHistogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgeHistogram = append(m.AgeHistogram, &BacklogAgeBucket{})
+			if err := m.AgeHistogram[len(m.AgeHistogram)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestTask == nil {
+				m.OldestTask = &v1.AllocatedTaskInfo{}
+			}
+			if err := m.OldestTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BacklogAgeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BacklogAgeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BacklogAgeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAge == nil {
+				m.MaxAge = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCount", wireType)
+			}
+			m.TaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v11.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstPollTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstPollTime == nil {
+				m.FirstPollTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FirstPollTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPollTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPollTime == nil {
+				m.LastPollTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastPollTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollCount", wireType)
+			}
+			m.PollCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxTasksPerSecond = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PollRate = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSlotsAvailable", wireType)
+			}
+			m.TaskSlotsAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskSlotsAvailable |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskSlotsUsed", wireType)
+			}
+			m.TaskSlotsUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskSlotsUsed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
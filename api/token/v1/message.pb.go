@@ -60,6 +60,8 @@ type HistoryContinuation struct {
 	PersistenceToken      []byte                        `protobuf:"bytes,6,opt,name=persistence_token,json=persistenceToken,proto3" json:"persistence_token,omitempty"`
 	TransientWorkflowTask *v1.TransientWorkflowTaskInfo `protobuf:"bytes,7,opt,name=transient_workflow_task,json=transientWorkflowTask,proto3" json:"transient_workflow_task,omitempty"`
 	BranchToken           []byte                        `protobuf:"bytes,8,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	// Set when the history is paged by a worker processing a workflow task.
+	ForWorkflowTask bool `protobuf:"varint,10,opt,name=for_workflow_task,json=forWorkflowTask,proto3" json:"for_workflow_task,omitempty"`
 }
 
 func (m *HistoryContinuation) Reset()      { *m = HistoryContinuation{} }
@@ -143,6 +145,13 @@ func (m *HistoryContinuation) GetBranchToken() []byte {
 	return nil
 }
 
+func (m *HistoryContinuation) GetForWorkflowTask() bool {
+	if m != nil {
+		return m.ForWorkflowTask
+	}
+	return false
+}
+
 type RawHistoryContinuation struct {
 	NamespaceId       string               `protobuf:"bytes,10,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId        string               `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -250,6 +259,109 @@ func (m *RawHistoryContinuation) GetVersionHistories() *v1.VersionHistories {
 	return nil
 }
 
+type ExportWorkflowExecutionContinuation struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Run of the continued as new chain being exported.
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Number of runs of the chain exported so far, including the run being exported.
+	RunCount int32 `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// Branch token and next event ID of the run being exported, unset until its mutable state is exported.
+	BranchToken      []byte `protobuf:"bytes,5,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
+	NextEventId      int64  `protobuf:"varint,6,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	PersistenceToken []byte `protobuf:"bytes,7,opt,name=persistence_token,json=persistenceToken,proto3" json:"persistence_token,omitempty"`
+	// Run following the run being exported in the chain, known once its last event is exported.
+	NextRunId string `protobuf:"bytes,8,opt,name=next_run_id,json=nextRunId,proto3" json:"next_run_id,omitempty"`
+}
+
+func (m *ExportWorkflowExecutionContinuation) Reset()      { *m = ExportWorkflowExecutionContinuation{} }
+func (*ExportWorkflowExecutionContinuation) ProtoMessage() {}
+func (*ExportWorkflowExecutionContinuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_020fff7d28118bec, []int{2}
+}
+func (m *ExportWorkflowExecutionContinuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportWorkflowExecutionContinuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportWorkflowExecutionContinuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportWorkflowExecutionContinuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportWorkflowExecutionContinuation.Merge(m, src)
+}
+func (m *ExportWorkflowExecutionContinuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportWorkflowExecutionContinuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportWorkflowExecutionContinuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportWorkflowExecutionContinuation proto.InternalMessageInfo
+
+func (m *ExportWorkflowExecutionContinuation) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetRunCount() int32 {
+	if m != nil {
+		return m.RunCount
+	}
+	return 0
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetBranchToken() []byte {
+	if m != nil {
+		return m.BranchToken
+	}
+	return nil
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetPersistenceToken() []byte {
+	if m != nil {
+		return m.PersistenceToken
+	}
+	return nil
+}
+
+func (m *ExportWorkflowExecutionContinuation) GetNextRunId() string {
+	if m != nil {
+		return m.NextRunId
+	}
+	return ""
+}
+
 type Task struct {
 	NamespaceId      string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId       string           `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -260,12 +372,14 @@ type Task struct {
 	WorkflowType     string           `protobuf:"bytes,7,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	ActivityType     string           `protobuf:"bytes,8,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
 	Clock            *v11.VectorClock `protobuf:"bytes,9,opt,name=clock,proto3" json:"clock,omitempty"`
+	// The task queue partition which holds a slot in its inflight activity task limit for this task, if any.
+	InflightTaskQueue string `protobuf:"bytes,10,opt,name=inflight_task_queue,json=inflightTaskQueue,proto3" json:"inflight_task_queue,omitempty"`
 }
 
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{2}
+	return fileDescriptor_020fff7d28118bec, []int{3}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,6 +471,13 @@ func (m *Task) GetClock() *v11.VectorClock {
 	return nil
 }
 
+func (m *Task) GetInflightTaskQueue() string {
+	if m != nil {
+		return m.InflightTaskQueue
+	}
+	return ""
+}
+
 type QueryTask struct {
 	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -366,7 +487,7 @@ type QueryTask struct {
 func (m *QueryTask) Reset()      { *m = QueryTask{} }
 func (*QueryTask) ProtoMessage() {}
 func (*QueryTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{3}
+	return fileDescriptor_020fff7d28118bec, []int{4}
 }
 func (m *QueryTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,6 +540,7 @@ func (m *QueryTask) GetTaskId() string {
 func init() {
 	proto.RegisterType((*HistoryContinuation)(nil), "temporal.server.api.token.v1.HistoryContinuation")
 	proto.RegisterType((*RawHistoryContinuation)(nil), "temporal.server.api.token.v1.RawHistoryContinuation")
+	proto.RegisterType((*ExportWorkflowExecutionContinuation)(nil), "temporal.server.api.token.v1.ExportWorkflowExecutionContinuation")
 	proto.RegisterType((*Task)(nil), "temporal.server.api.token.v1.Task")
 	proto.RegisterType((*QueryTask)(nil), "temporal.server.api.token.v1.QueryTask")
 }
@@ -428,51 +550,58 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0x13, 0x3b,
-	0x14, 0xc6, 0xe3, 0xfc, 0x1f, 0x27, 0xbd, 0x37, 0x99, 0xaa, 0xb7, 0x51, 0x75, 0xef, 0xdc, 0x34,
-	0xb0, 0x08, 0xa5, 0x9a, 0x50, 0x58, 0x21, 0x16, 0x48, 0x54, 0x48, 0x4d, 0x77, 0x1d, 0x45, 0x20,
-	0x21, 0x41, 0xe4, 0xce, 0x38, 0xad, 0x95, 0xd4, 0x9e, 0xda, 0x9e, 0x29, 0xd9, 0xf1, 0x08, 0x3c,
-	0x06, 0xbc, 0x09, 0xcb, 0x6e, 0x90, 0xba, 0xa4, 0xe9, 0x86, 0x1d, 0x7d, 0x04, 0x64, 0xcf, 0x38,
-	0x09, 0xed, 0x20, 0x58, 0xb0, 0x1b, 0x7f, 0xe7, 0xe7, 0xe3, 0xe3, 0xef, 0xd3, 0x18, 0x6e, 0x49,
-	0x7c, 0x12, 0x32, 0x8e, 0x26, 0x3d, 0x81, 0x79, 0x8c, 0x79, 0x0f, 0x85, 0xa4, 0x27, 0xd9, 0x18,
-	0xd3, 0x5e, 0xbc, 0xd3, 0x3b, 0xc1, 0x42, 0xa0, 0x23, 0xec, 0x86, 0x9c, 0x49, 0x66, 0xff, 0x6b,
-	0x58, 0x37, 0x61, 0x5d, 0x14, 0x12, 0x57, 0xb3, 0x6e, 0xbc, 0xb3, 0x91, 0xd9, 0xc9, 0x9f, 0x30,
-	0x7f, 0x7c, 0xab, 0xd3, 0xc6, 0x76, 0x16, 0x7b, 0x4c, 0x84, 0x64, 0x7c, 0x7a, 0x8b, 0xee, 0x7c,
-	0xcb, 0xc3, 0xd5, 0xbd, 0xa4, 0xb8, 0xcb, 0xa8, 0x24, 0x34, 0x42, 0x92, 0x30, 0x6a, 0xaf, 0xc1,
-	0x32, 0x8f, 0xe8, 0x90, 0x04, 0x2d, 0xd0, 0x06, 0x5d, 0xcb, 0x2b, 0xf1, 0x88, 0xf6, 0x03, 0xfb,
-	0x2e, 0xfc, 0x6b, 0x44, 0xb8, 0x90, 0x43, 0x1c, 0x63, 0x2a, 0x55, 0x39, 0xdf, 0x06, 0xdd, 0x82,
-	0x57, 0xd7, 0xea, 0x73, 0x25, 0xf6, 0x03, 0xbb, 0x03, 0x57, 0x28, 0x7e, 0xbb, 0x04, 0x15, 0x34,
-	0x54, 0x53, 0xa2, 0x61, 0x5c, 0xb8, 0x4a, 0xc4, 0xf0, 0x8c, 0xf1, 0xf1, 0x68, 0xc2, 0xce, 0x86,
-	0x3c, 0xa2, 0x94, 0xd0, 0xa3, 0x56, 0xa9, 0x0d, 0xba, 0x55, 0xaf, 0x49, 0xc4, 0xcb, 0xb4, 0xe2,
-	0x25, 0x05, 0xfb, 0x3e, 0x6c, 0x86, 0x98, 0x0b, 0x22, 0x24, 0xa6, 0x3e, 0x1e, 0x6a, 0x6b, 0x5a,
-	0xe5, 0x36, 0xe8, 0xd6, 0xbd, 0xc6, 0x52, 0x61, 0xa0, 0x74, 0xfb, 0x14, 0xae, 0x4b, 0x8e, 0xa8,
-	0x20, 0xea, 0xfc, 0xf9, 0x19, 0x12, 0x89, 0x71, 0xab, 0xd2, 0x06, 0xdd, 0xda, 0xc3, 0xc7, 0x6e,
-	0x96, 0xdf, 0xa9, 0x4b, 0x6e, 0xbc, 0xe3, 0x0e, 0xcc, 0x76, 0x33, 0xc7, 0x00, 0x89, 0x71, 0x9f,
-	0x8e, 0x98, 0xb7, 0x26, 0xb3, 0x4a, 0xf6, 0x26, 0xac, 0x1f, 0x72, 0x44, 0xfd, 0xe3, 0x74, 0xb4,
-	0xaa, 0x1e, 0xad, 0x96, 0x68, 0x7a, 0xaa, 0xfd, 0x62, 0xd5, 0x6a, 0xc0, 0xce, 0xc7, 0x02, 0xfc,
-	0xc7, 0x43, 0x67, 0x59, 0xa6, 0x6f, 0xc2, 0x3a, 0x45, 0x27, 0x58, 0x84, 0xc8, 0xc7, 0xca, 0x36,
-	0xa8, 0xad, 0xaf, 0xcd, 0xb5, 0x7e, 0x60, 0xff, 0x0f, 0x6b, 0xf3, 0xfb, 0xa4, 0xee, 0x5b, 0x1e,
-	0x34, 0x52, 0x3f, 0x58, 0x0a, 0xae, 0x70, 0x23, 0x38, 0x21, 0x11, 0x5f, 0xca, 0xa4, 0x98, 0x04,
-	0xa7, 0xd5, 0xa5, 0x50, 0x96, 0xa9, 0x58, 0xf9, 0xca, 0xa8, 0x0e, 0xa5, 0xe0, 0x35, 0x17, 0xe8,
-	0x8b, 0xa4, 0x60, 0xb7, 0x61, 0x1d, 0xd3, 0x60, 0xd1, 0xb3, 0xac, 0x41, 0x88, 0x69, 0x60, 0x3a,
-	0x6e, 0xc1, 0xe6, 0x82, 0x30, 0xfd, 0x2a, 0x1a, 0xfb, 0xdb, 0x60, 0xa6, 0x5b, 0x66, 0xc4, 0xd5,
-	0x9f, 0x44, 0xfc, 0x1a, 0x36, 0xd3, 0x76, 0xc3, 0x24, 0x36, 0x82, 0x45, 0xcb, 0xd2, 0xe1, 0x3e,
-	0xf8, 0x55, 0xb8, 0xe9, 0x81, 0x7b, 0x66, 0x9f, 0xd7, 0x88, 0x6f, 0x28, 0xfb, 0xc5, 0x2a, 0x68,
-	0xe4, 0x3b, 0x9f, 0xf3, 0xb0, 0x68, 0xd2, 0xfd, 0x21, 0x19, 0xf0, 0xe7, 0x92, 0xd9, 0x86, 0xb6,
-	0xf0, 0x8f, 0x71, 0x10, 0x4d, 0x70, 0x70, 0x33, 0x9d, 0xc6, 0xbc, 0x62, 0xfc, 0x6c, 0xc1, 0x0a,
-	0x92, 0xea, 0x7a, 0x52, 0xa7, 0x52, 0xf2, 0xcc, 0x52, 0x9d, 0x8f, 0x7c, 0x49, 0x62, 0x22, 0xa7,
-	0x26, 0x0a, 0xcb, 0x83, 0x46, 0xea, 0x07, 0xf6, 0x1d, 0xb8, 0xb2, 0xf8, 0x15, 0xa6, 0x21, 0xd6,
-	0x31, 0x58, 0x5e, 0xdd, 0x88, 0x83, 0x69, 0x88, 0x15, 0x34, 0xef, 0xa2, 0xa1, 0x6a, 0x02, 0x19,
-	0x51, 0x43, 0x4f, 0x61, 0x49, 0x3f, 0x3e, 0xa9, 0xdf, 0xf7, 0x32, 0xfd, 0xd6, 0x44, 0xe2, 0xb6,
-	0x2f, 0x19, 0xdf, 0x55, 0x4b, 0x2f, 0xd9, 0xd7, 0x19, 0x41, 0xeb, 0x20, 0xc2, 0x7c, 0xfa, 0xbb,
-	0xde, 0xfe, 0x07, 0xa1, 0xfa, 0x79, 0x87, 0xa7, 0x11, 0x8e, 0x70, 0x6a, 0xad, 0xa5, 0x94, 0x03,
-	0x25, 0xd8, 0xeb, 0xb0, 0xa2, 0xcb, 0x73, 0x6b, 0xcb, 0x6a, 0xd9, 0x0f, 0x9e, 0xbd, 0x39, 0xbf,
-	0x74, 0x72, 0x17, 0x97, 0x4e, 0xee, 0xfa, 0xd2, 0x01, 0xef, 0x66, 0x0e, 0xf8, 0x30, 0x73, 0xc0,
-	0xa7, 0x99, 0x03, 0xce, 0x67, 0x0e, 0xf8, 0x32, 0x73, 0xc0, 0xd7, 0x99, 0x93, 0xbb, 0x9e, 0x39,
-	0xe0, 0xfd, 0x95, 0x93, 0x3b, 0xbf, 0x72, 0x72, 0x17, 0x57, 0x4e, 0xee, 0x55, 0xf7, 0x88, 0x2d,
-	0x6e, 0x44, 0x58, 0xd6, 0xeb, 0xfd, 0x44, 0x7f, 0x1c, 0x96, 0xf5, 0x23, 0xfa, 0xe8, 0x7b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x28, 0x67, 0x43, 0xf3, 0xea, 0x05, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x8f, 0xe3, 0x44,
+	0x18, 0x8d, 0x37, 0x3f, 0xfd, 0x25, 0xc7, 0x25, 0x3e, 0x1d, 0x17, 0x1d, 0x60, 0x72, 0x39, 0x8a,
+	0xb0, 0x9c, 0x1c, 0x16, 0x2a, 0x44, 0x81, 0xc4, 0x6a, 0xa5, 0xcb, 0x75, 0x37, 0x8a, 0x40, 0x42,
+	0x02, 0xcb, 0x67, 0x4f, 0x92, 0x51, 0xb2, 0x33, 0xbe, 0x99, 0xb1, 0x77, 0xd3, 0xd1, 0xd0, 0xd3,
+	0xf3, 0x0f, 0x40, 0xc9, 0x7f, 0x41, 0xb9, 0xe5, 0x95, 0x6c, 0xb6, 0xa1, 0xdc, 0x3f, 0x01, 0xcd,
+	0xd8, 0x93, 0x58, 0x89, 0x17, 0x28, 0xb8, 0x2e, 0xf3, 0xbe, 0x37, 0xdf, 0x7c, 0x79, 0xef, 0xcd,
+	0x18, 0x8e, 0x25, 0x3e, 0x8f, 0x19, 0x0f, 0x56, 0x63, 0x81, 0x79, 0x8a, 0xf9, 0x38, 0x88, 0xc9,
+	0x58, 0xb2, 0x25, 0xa6, 0xe3, 0xf4, 0x64, 0x7c, 0x8e, 0x85, 0x08, 0xe6, 0xd8, 0x8b, 0x39, 0x93,
+	0xcc, 0x79, 0xdf, 0x70, 0xbd, 0x8c, 0xeb, 0x05, 0x31, 0xf1, 0x34, 0xd7, 0x4b, 0x4f, 0x1e, 0x97,
+	0x76, 0x0a, 0x57, 0x2c, 0x5c, 0x1e, 0x74, 0x7a, 0xfc, 0xac, 0x8c, 0xbb, 0x20, 0x42, 0x32, 0xbe,
+	0x3e, 0x60, 0x0f, 0x7f, 0xa9, 0xc2, 0x83, 0xe7, 0x59, 0xf1, 0x94, 0x51, 0x49, 0x68, 0x12, 0x48,
+	0xc2, 0xa8, 0xf3, 0x10, 0x1a, 0x3c, 0xa1, 0x3e, 0x89, 0xfa, 0xd6, 0xc0, 0x1a, 0xd9, 0xa8, 0xce,
+	0x13, 0x3a, 0x89, 0x9c, 0x8f, 0xe0, 0x9d, 0x19, 0xe1, 0x42, 0xfa, 0x38, 0xc5, 0x54, 0xaa, 0xf2,
+	0xd1, 0xc0, 0x1a, 0x55, 0x51, 0x47, 0xa3, 0x67, 0x0a, 0x9c, 0x44, 0xce, 0x10, 0xee, 0x51, 0x7c,
+	0x59, 0x20, 0x55, 0x35, 0xa9, 0xad, 0x40, 0xc3, 0xf1, 0xe0, 0x01, 0x11, 0xfe, 0x05, 0xe3, 0xcb,
+	0xd9, 0x8a, 0x5d, 0xf8, 0x3c, 0xa1, 0x94, 0xd0, 0x79, 0xbf, 0x3e, 0xb0, 0x46, 0x2d, 0xd4, 0x23,
+	0xe2, 0xdb, 0xbc, 0x82, 0xb2, 0x82, 0xf3, 0x09, 0xf4, 0x62, 0xcc, 0x05, 0x11, 0x12, 0xd3, 0x10,
+	0xfb, 0x5a, 0x9a, 0x7e, 0x63, 0x60, 0x8d, 0x3a, 0xa8, 0x5b, 0x28, 0x4c, 0x15, 0xee, 0xbc, 0x86,
+	0x47, 0x92, 0x07, 0x54, 0x10, 0x75, 0xfe, 0xf6, 0x0c, 0x19, 0x88, 0x65, 0xbf, 0x39, 0xb0, 0x46,
+	0xed, 0xcf, 0xbe, 0xf0, 0xca, 0xf4, 0xce, 0x55, 0xf2, 0xd2, 0x13, 0x6f, 0x6a, 0xb6, 0x9b, 0x39,
+	0xa6, 0x81, 0x58, 0x4e, 0xe8, 0x8c, 0xa1, 0x87, 0xb2, 0xac, 0xe4, 0x3c, 0x81, 0xce, 0x2b, 0x1e,
+	0xd0, 0x70, 0x91, 0x8f, 0xd6, 0xd2, 0xa3, 0xb5, 0x33, 0x2c, 0x9b, 0xea, 0x18, 0x7a, 0x33, 0xc6,
+	0xf7, 0xe6, 0x01, 0xfd, 0x87, 0xef, 0xcf, 0x18, 0x2f, 0xb6, 0x7b, 0x51, 0x6b, 0xd9, 0x5d, 0x18,
+	0xfe, 0x56, 0x85, 0x77, 0x51, 0x70, 0x51, 0x66, 0xd0, 0x13, 0xe8, 0xd0, 0xe0, 0x1c, 0x8b, 0x38,
+	0x08, 0xb1, 0x92, 0x18, 0xb4, 0x4d, 0xed, 0x2d, 0x36, 0x89, 0x9c, 0x0f, 0xa1, 0xbd, 0x3d, 0x2b,
+	0x77, 0xca, 0x46, 0x60, 0xa0, 0x49, 0x54, 0x30, 0xb9, 0xba, 0x67, 0xb2, 0x90, 0x01, 0x2f, 0xf8,
+	0x57, 0xcb, 0x4c, 0xd6, 0x68, 0xc1, 0xc0, 0x22, 0x2b, 0x55, 0x1e, 0x30, 0xaa, 0x0d, 0xac, 0xa2,
+	0xde, 0x8e, 0xfa, 0x4d, 0x56, 0x70, 0x06, 0xd0, 0xc1, 0x34, 0xda, 0xf5, 0x6c, 0x68, 0x22, 0x60,
+	0x1a, 0x99, 0x8e, 0xc7, 0xd0, 0xdb, 0x31, 0x4c, 0xbf, 0xa6, 0xa6, 0xdd, 0x37, 0x34, 0xd3, 0xad,
+	0x34, 0x0e, 0xad, 0x3b, 0xe2, 0xf0, 0x3d, 0xf4, 0xf2, 0x76, 0x7e, 0x66, 0x31, 0xc1, 0xa2, 0x6f,
+	0xeb, 0x20, 0x7c, 0xfa, 0x6f, 0x41, 0xc8, 0x0f, 0x7c, 0x6e, 0xf6, 0xa1, 0x6e, 0xba, 0x87, 0xbc,
+	0xa8, 0xb5, 0xac, 0xee, 0xd1, 0xf0, 0xf7, 0x23, 0x78, 0x7a, 0x76, 0x19, 0x33, 0xbe, 0xcd, 0xc5,
+	0xd9, 0x25, 0x0e, 0x13, 0x65, 0xd6, 0x3f, 0x1a, 0x67, 0xfd, 0x7f, 0xc6, 0xbd, 0x07, 0xb6, 0x82,
+	0x43, 0x96, 0x50, 0xa9, 0x3d, 0xab, 0xa3, 0x16, 0x4f, 0xe8, 0xa9, 0x5a, 0x1f, 0x04, 0xb4, 0x7e,
+	0x18, 0xd0, 0x83, 0x7b, 0xdb, 0x38, 0xbc, 0xb7, 0xa5, 0xc2, 0x37, 0xef, 0x10, 0xde, 0x05, 0xbd,
+	0xd7, 0xcf, 0x87, 0x6d, 0xe9, 0x61, 0x6d, 0x05, 0x21, 0x35, 0xf0, 0xf0, 0xa7, 0x2a, 0xd4, 0xcc,
+	0xed, 0x79, 0x5b, 0xa2, 0x3c, 0x03, 0x47, 0x84, 0x0b, 0x1c, 0x25, 0x2b, 0x1c, 0xed, 0x27, 0xba,
+	0xbb, 0xad, 0x98, 0xbf, 0xd7, 0x87, 0x66, 0x20, 0x55, 0x24, 0xa4, 0x16, 0xa8, 0x8e, 0xcc, 0x52,
+	0x9d, 0x1f, 0x84, 0x92, 0xa4, 0x44, 0xae, 0x8d, 0x34, 0x36, 0x02, 0x03, 0x4d, 0x22, 0xe7, 0x29,
+	0xdc, 0xdb, 0x5d, 0xed, 0x75, 0x8c, 0xb5, 0x2a, 0x36, 0xea, 0x18, 0x70, 0xba, 0x8e, 0xb1, 0x22,
+	0x6d, 0xbb, 0x68, 0x52, 0xa6, 0x49, 0xc7, 0x80, 0x9a, 0xf4, 0x15, 0xd4, 0xf5, 0xe3, 0x9e, 0x67,
+	0xf4, 0xe3, 0xd2, 0x8c, 0x6a, 0x46, 0x96, 0xd0, 0x50, 0x32, 0x7e, 0xaa, 0x96, 0x28, 0xdb, 0xa7,
+	0x1f, 0x57, 0x3a, 0x5b, 0x91, 0xf9, 0x42, 0xea, 0x57, 0xc6, 0x7f, 0x9d, 0xe0, 0x04, 0xe7, 0x6f,
+	0x44, 0xcf, 0x94, 0x94, 0xf2, 0x2f, 0x55, 0x61, 0x38, 0x03, 0xfb, 0x65, 0x82, 0xf9, 0xfa, 0xbf,
+	0x7a, 0xf1, 0x01, 0x40, 0xa1, 0x6d, 0x66, 0x85, 0x2d, 0x4d, 0x3b, 0xe7, 0x11, 0x34, 0x75, 0x79,
+	0x6b, 0x45, 0x43, 0x2d, 0x27, 0xd1, 0xd7, 0x3f, 0x5c, 0x5d, 0xbb, 0x95, 0x37, 0xd7, 0x6e, 0xe5,
+	0xf6, 0xda, 0xb5, 0x7e, 0xdc, 0xb8, 0xd6, 0xaf, 0x1b, 0xd7, 0xfa, 0x63, 0xe3, 0x5a, 0x57, 0x1b,
+	0xd7, 0xfa, 0x73, 0xe3, 0x5a, 0x7f, 0x6d, 0xdc, 0xca, 0xed, 0xc6, 0xb5, 0x7e, 0xbe, 0x71, 0x2b,
+	0x57, 0x37, 0x6e, 0xe5, 0xcd, 0x8d, 0x5b, 0xf9, 0x6e, 0x34, 0x67, 0x3b, 0x05, 0x08, 0x2b, 0xfb,
+	0x9a, 0x7e, 0xa9, 0x7f, 0xbc, 0x6a, 0xe8, 0x8f, 0xda, 0xe7, 0x7f, 0x0f, 0x00, 0xf7, 0x0c, 0xe0,
+	0xc3, 0x7a, 0x07, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -515,6 +644,9 @@ func (this *HistoryContinuation) Equal(that interface{}) bool {
 	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
 		return false
 	}
+	if this.ForWorkflowTask != that1.ForWorkflowTask {
+		return false
+	}
 	return true
 }
 func (this *RawHistoryContinuation) Equal(that interface{}) bool {
@@ -565,6 +697,51 @@ func (this *RawHistoryContinuation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ExportWorkflowExecutionContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportWorkflowExecutionContinuation)
+	if !ok {
+		that2, ok := that.(ExportWorkflowExecutionContinuation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.RunCount != that1.RunCount {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if !bytes.Equal(this.PersistenceToken, that1.PersistenceToken) {
+		return false
+	}
+	if this.NextRunId != that1.NextRunId {
+		return false
+	}
+	return true
+}
 func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -611,6 +788,9 @@ func (this *Task) Equal(that interface{}) bool {
 	if !this.Clock.Equal(that1.Clock) {
 		return false
 	}
+	if this.InflightTaskQueue != that1.InflightTaskQueue {
+		return false
+	}
 	return true
 }
 func (this *QueryTask) Equal(that interface{}) bool {
@@ -647,7 +827,7 @@ func (this *HistoryContinuation) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&token.HistoryContinuation{")
 	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
 	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
@@ -658,6 +838,7 @@ func (this *HistoryContinuation) GoString() string {
 		s = append(s, "TransientWorkflowTask: "+fmt.Sprintf("%#v", this.TransientWorkflowTask)+",\n")
 	}
 	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ForWorkflowTask: "+fmt.Sprintf("%#v", this.ForWorkflowTask)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -681,11 +862,28 @@ func (this *RawHistoryContinuation) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ExportWorkflowExecutionContinuation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&token.ExportWorkflowExecutionContinuation{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "RunCount: "+fmt.Sprintf("%#v", this.RunCount)+",\n")
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "PersistenceToken: "+fmt.Sprintf("%#v", this.PersistenceToken)+",\n")
+	s = append(s, "NextRunId: "+fmt.Sprintf("%#v", this.NextRunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&token.Task{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -698,6 +896,7 @@ func (this *Task) GoString() string {
 	if this.Clock != nil {
 		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
+	s = append(s, "InflightTaskQueue: "+fmt.Sprintf("%#v", this.InflightTaskQueue)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -741,6 +940,16 @@ func (m *HistoryContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ForWorkflowTask {
+		i--
+		if m.ForWorkflowTask {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.BranchToken) > 0 {
 		i -= len(m.BranchToken)
 		copy(dAtA[i:], m.BranchToken)
@@ -880,6 +1089,81 @@ func (m *RawHistoryContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ExportWorkflowExecutionContinuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportWorkflowExecutionContinuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportWorkflowExecutionContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextRunId) > 0 {
+		i -= len(m.NextRunId)
+		copy(dAtA[i:], m.NextRunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NextRunId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PersistenceToken) > 0 {
+		i -= len(m.PersistenceToken)
+		copy(dAtA[i:], m.PersistenceToken)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PersistenceToken)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RunCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RunCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Task) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -900,6 +1184,13 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InflightTaskQueue) > 0 {
+		i -= len(m.InflightTaskQueue)
+		copy(dAtA[i:], m.InflightTaskQueue)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.InflightTaskQueue)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Clock != nil {
 		{
 			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
@@ -1053,6 +1344,9 @@ func (m *HistoryContinuation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.ForWorkflowTask {
+		n += 2
+	}
 	return n
 }
 
@@ -1097,6 +1391,45 @@ func (m *RawHistoryContinuation) Size() (n int) {
 	return n
 }
 
+func (m *ExportWorkflowExecutionContinuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.RunCount != 0 {
+		n += 1 + sovMessage(uint64(m.RunCount))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	l = len(m.PersistenceToken)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.NextRunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func (m *Task) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1137,6 +1470,10 @@ func (m *Task) Size() (n int) {
 		l = m.Clock.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.InflightTaskQueue)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -1179,6 +1516,7 @@ func (this *HistoryContinuation) String() string {
 		`PersistenceToken:` + fmt.Sprintf("%v", this.PersistenceToken) + `,`,
 		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v1.TransientWorkflowTaskInfo", 1) + `,`,
 		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ForWorkflowTask:` + fmt.Sprintf("%v", this.ForWorkflowTask) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1201,20 +1539,38 @@ func (this *RawHistoryContinuation) String() string {
 	}, "")
 	return s
 }
-func (this *Task) String() string {
+func (this *ExportWorkflowExecutionContinuation) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Task{`,
+	s := strings.Join([]string{`&ExportWorkflowExecutionContinuation{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
 		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`RunCount:` + fmt.Sprintf("%v", this.RunCount) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`PersistenceToken:` + fmt.Sprintf("%v", this.PersistenceToken) + `,`,
+		`NextRunId:` + fmt.Sprintf("%v", this.NextRunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Task) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Task{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
 		`WorkflowType:` + fmt.Sprintf("%v", this.WorkflowType) + `,`,
 		`ActivityType:` + fmt.Sprintf("%v", this.ActivityType) + `,`,
 		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v11.VectorClock", 1) + `,`,
+		`InflightTaskQueue:` + fmt.Sprintf("%v", this.InflightTaskQueue) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1462,6 +1818,26 @@ func (m *HistoryContinuation) Unmarshal(dAtA []byte) error {
 				m.BranchToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForWorkflowTask", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForWorkflowTask = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1781,6 +2157,293 @@ func (m *RawHistoryContinuation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExportWorkflowExecutionContinuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportWorkflowExecutionContinuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportWorkflowExecutionContinuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunCount", wireType)
+			}
+			m.RunCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistenceToken = append(m.PersistenceToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.PersistenceToken == nil {
+				m.PersistenceToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2076,6 +2739,38 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflightTaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflightTaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
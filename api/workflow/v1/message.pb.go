@@ -34,16 +34,23 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
 	v11 "go.temporal.io/server/api/clock/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -193,9 +200,140 @@ func (m *BaseExecutionInfo) GetLowestCommonAncestorEventVersion() int64 {
 	return 0
 }
 
+// CompletionCallback is an HTTP endpoint which is notified when the workflow execution completes.
+type CompletionCallback struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers attached to the HTTP request.
+	Header map[string]string `protobuf:"bytes,2,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *CompletionCallback) Reset()      { *m = CompletionCallback{} }
+func (*CompletionCallback) ProtoMessage() {}
+func (*CompletionCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{2}
+}
+func (m *CompletionCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallback.Merge(m, src)
+}
+func (m *CompletionCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallback proto.InternalMessageInfo
+
+func (m *CompletionCallback) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CompletionCallback) GetHeader() map[string]string {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type CompletionCallbackInfo struct {
+	Callback                *CompletionCallback         `protobuf:"bytes,1,opt,name=callback,proto3" json:"callback,omitempty"`
+	State                   v12.CompletionCallbackState `protobuf:"varint,2,opt,name=state,proto3,enum=temporal.server.api.enums.v1.CompletionCallbackState" json:"state,omitempty"`
+	Attempt                 int32                       `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastAttemptCompleteTime *time.Time                  `protobuf:"bytes,4,opt,name=last_attempt_complete_time,json=lastAttemptCompleteTime,proto3,stdtime" json:"last_attempt_complete_time,omitempty"`
+	LastAttemptFailure      string                      `protobuf:"bytes,5,opt,name=last_attempt_failure,json=lastAttemptFailure,proto3" json:"last_attempt_failure,omitempty"`
+}
+
+func (m *CompletionCallbackInfo) Reset()      { *m = CompletionCallbackInfo{} }
+func (*CompletionCallbackInfo) ProtoMessage() {}
+func (*CompletionCallbackInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4f1ca48d03c9ded, []int{3}
+}
+func (m *CompletionCallbackInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallbackInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallbackInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallbackInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallbackInfo.Merge(m, src)
+}
+func (m *CompletionCallbackInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallbackInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallbackInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallbackInfo proto.InternalMessageInfo
+
+func (m *CompletionCallbackInfo) GetCallback() *CompletionCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetState() v12.CompletionCallbackState {
+	if m != nil {
+		return m.State
+	}
+	return v12.COMPLETION_CALLBACK_STATE_UNSPECIFIED
+}
+
+func (m *CompletionCallbackInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *CompletionCallbackInfo) GetLastAttemptCompleteTime() *time.Time {
+	if m != nil {
+		return m.LastAttemptCompleteTime
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetLastAttemptFailure() string {
+	if m != nil {
+		return m.LastAttemptFailure
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParentExecutionInfo)(nil), "temporal.server.api.workflow.v1.ParentExecutionInfo")
 	proto.RegisterType((*BaseExecutionInfo)(nil), "temporal.server.api.workflow.v1.BaseExecutionInfo")
+	proto.RegisterType((*CompletionCallback)(nil), "temporal.server.api.workflow.v1.CompletionCallback")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.workflow.v1.CompletionCallback.HeaderEntry")
+	proto.RegisterType((*CompletionCallbackInfo)(nil), "temporal.server.api.workflow.v1.CompletionCallbackInfo")
 }
 
 func init() {
@@ -203,35 +341,52 @@ func init() {
 }
 
 var fileDescriptor_c4f1ca48d03c9ded = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0x6e, 0x13, 0x31,
-	0x1c, 0xc7, 0xcf, 0x09, 0x89, 0x14, 0x87, 0x81, 0x1a, 0x21, 0x45, 0x05, 0xb9, 0xa1, 0xea, 0x10,
-	0x40, 0xf8, 0x14, 0x18, 0x19, 0x10, 0x2d, 0x15, 0xba, 0x05, 0xa1, 0x0c, 0x45, 0x62, 0x89, 0xcc,
-	0xdd, 0xaf, 0x95, 0xd5, 0x3b, 0xfb, 0x64, 0x3b, 0x17, 0x46, 0x1e, 0x81, 0xc7, 0x60, 0xe4, 0x11,
-	0x18, 0x19, 0x33, 0x76, 0x24, 0x97, 0x85, 0xb1, 0x8f, 0x80, 0xec, 0xcb, 0xdd, 0x01, 0xa5, 0xdd,
-	0xee, 0x7e, 0xfe, 0xf8, 0xfb, 0xe7, 0x27, 0xe3, 0xa7, 0x16, 0xb2, 0x5c, 0x69, 0x9e, 0x86, 0x06,
-	0x74, 0x01, 0x3a, 0xe4, 0xb9, 0x08, 0x97, 0x4a, 0x9f, 0x9f, 0xa6, 0x6a, 0x19, 0x16, 0xd3, 0x30,
-	0x03, 0x63, 0xf8, 0x19, 0xb0, 0x5c, 0x2b, 0xab, 0xc8, 0x5e, 0x8d, 0xb3, 0x0a, 0x67, 0x3c, 0x17,
-	0xac, 0xc6, 0x59, 0x31, 0xdd, 0x3d, 0x68, 0xf4, 0x9c, 0x50, 0xac, 0xb2, 0x4c, 0xc9, 0x2b, 0x32,
-	0xbb, 0x8f, 0xff, 0xe7, 0x1a, 0xa7, 0x2a, 0x3e, 0xbf, 0xc2, 0xee, 0x7f, 0xeb, 0xe0, 0xbb, 0xef,
-	0xb8, 0x06, 0x69, 0x8f, 0x3f, 0x41, 0xbc, 0xb0, 0x42, 0xc9, 0x48, 0x9e, 0x2a, 0xf2, 0x10, 0xdf,
-	0x96, 0x3c, 0x03, 0x93, 0xf3, 0x18, 0xe6, 0x22, 0x19, 0xa1, 0x31, 0x9a, 0x0c, 0x66, 0xc3, 0x66,
-	0x16, 0x25, 0xe4, 0x01, 0x1e, 0x34, 0xbf, 0xa3, 0x8e, 0x3f, 0x6f, 0x07, 0xe4, 0x0d, 0x1e, 0x40,
-	0xad, 0x38, 0xea, 0x8e, 0xd1, 0x64, 0xf8, 0xec, 0x11, 0x6b, 0xfa, 0xb9, 0x62, 0x55, 0x7c, 0x56,
-	0x4c, 0xd9, 0xfb, 0x6d, 0xc5, 0x26, 0xc2, 0xac, 0xbd, 0xeb, 0x92, 0x08, 0x29, 0xac, 0xe0, 0x16,
-	0x12, 0x97, 0xe4, 0xd6, 0x18, 0x4d, 0xba, 0xb3, 0x61, 0x33, 0x8b, 0x12, 0xf2, 0x12, 0xf7, 0x7c,
-	0xbd, 0x51, 0xef, 0x5f, 0x9f, 0x3f, 0xf6, 0xe8, 0x09, 0xe7, 0x76, 0x02, 0xb1, 0x55, 0xfa, 0xc8,
-	0xfd, 0xce, 0xaa, 0x7b, 0xe4, 0x09, 0xde, 0x69, 0x3d, 0x0a, 0xd0, 0xc6, 0x85, 0xee, 0x7b, 0xa3,
-	0x3b, 0xcd, 0xc1, 0x49, 0x35, 0xdf, 0xff, 0x8e, 0xf0, 0xce, 0x21, 0x37, 0xf0, 0xf7, 0xc2, 0xee,
-	0xe1, 0xbe, 0x5e, 0xc8, 0x76, 0x55, 0x3d, 0xbd, 0x90, 0x51, 0x42, 0x5e, 0xe3, 0xbd, 0x54, 0x2d,
-	0xc1, 0xd8, 0x79, 0x55, 0x77, 0xce, 0x65, 0x0c, 0xc6, 0x2a, 0x3d, 0x87, 0x02, 0xa4, 0x75, 0x7c,
-	0xc7, 0xfb, 0xdc, 0xaf, 0xb0, 0x23, 0x4f, 0xbd, 0xda, 0x42, 0xc7, 0x8e, 0x89, 0x12, 0xf2, 0x16,
-	0x1f, 0xdc, 0xa8, 0x52, 0x47, 0xee, 0x7a, 0xa9, 0xf1, 0xb5, 0x52, 0xdb, 0x0a, 0x87, 0xc9, 0x6a,
-	0x4d, 0x83, 0x8b, 0x35, 0x0d, 0x2e, 0xd7, 0x14, 0x7d, 0x2e, 0x29, 0xfa, 0x5a, 0x52, 0xf4, 0xa3,
-	0xa4, 0x68, 0x55, 0x52, 0xf4, 0xb3, 0xa4, 0xe8, 0x57, 0x49, 0x83, 0xcb, 0x92, 0xa2, 0x2f, 0x1b,
-	0x1a, 0xac, 0x36, 0x34, 0xb8, 0xd8, 0xd0, 0xe0, 0x03, 0x3b, 0x53, 0xed, 0x66, 0x85, 0xba, 0xe6,
-	0x4d, 0xbf, 0xa8, 0xbf, 0x3f, 0xf6, 0xfd, 0x13, 0x7b, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0xc9, 0x7e, 0x2f, 0x06, 0x03, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xa4, 0xc9, 0xf3, 0x64, 0x83, 0x50, 0xbb, 0x14, 0x88, 0x02, 0x72, 0xd2, 0xaa,
+	0x87, 0x40, 0xc5, 0x9a, 0xa6, 0x42, 0xe2, 0xe5, 0x50, 0xb5, 0xa1, 0x40, 0x84, 0x04, 0xc8, 0xa0,
+	0x56, 0x42, 0x42, 0xd1, 0xd6, 0x9e, 0x04, 0x2b, 0xb6, 0xd7, 0x5a, 0xaf, 0x53, 0x7a, 0xe3, 0x23,
+	0xf4, 0x63, 0x70, 0xe4, 0xcc, 0x89, 0x23, 0xc7, 0x1e, 0x7b, 0x83, 0xa4, 0x17, 0x8e, 0xfd, 0x08,
+	0x68, 0xd7, 0x2f, 0x69, 0x49, 0x0a, 0x12, 0x37, 0xcf, 0xec, 0x7f, 0x7f, 0xf3, 0xdf, 0x59, 0xef,
+	0xa0, 0x3b, 0x02, 0xbc, 0x80, 0x71, 0xea, 0x1a, 0x21, 0xf0, 0x21, 0x70, 0x83, 0x06, 0x8e, 0xb1,
+	0xcf, 0xf8, 0xa0, 0xe7, 0xb2, 0x7d, 0x63, 0xb8, 0x66, 0x78, 0x10, 0x86, 0xb4, 0x0f, 0x24, 0xe0,
+	0x4c, 0x30, 0x5c, 0x4f, 0xe5, 0x24, 0x96, 0x13, 0x1a, 0x38, 0x24, 0x95, 0x93, 0xe1, 0x5a, 0xad,
+	0xde, 0x67, 0xac, 0xef, 0x82, 0xa1, 0xe4, 0x7b, 0x51, 0xcf, 0x10, 0x8e, 0x07, 0xa1, 0xa0, 0x5e,
+	0x10, 0x13, 0x6a, 0x4b, 0x36, 0x04, 0xe0, 0xdb, 0xe0, 0x5b, 0x0e, 0x84, 0x46, 0x9f, 0xf5, 0x99,
+	0xca, 0xab, 0xaf, 0x44, 0xb2, 0x92, 0x79, 0x92, 0x66, 0x2c, 0xe6, 0x79, 0xcc, 0x9f, 0xb2, 0x52,
+	0xbb, 0x3d, 0xcb, 0xb9, 0xe5, 0x32, 0x6b, 0x30, 0xad, 0x5d, 0x9d, 0xa5, 0x05, 0x3f, 0xf2, 0x42,
+	0xa9, 0xcd, 0xfc, 0x2b, 0xf1, 0xf2, 0xe7, 0x3c, 0xba, 0xf2, 0x8a, 0x72, 0xf0, 0xc5, 0xf6, 0x07,
+	0xb0, 0x22, 0xe1, 0x30, 0xbf, 0xe3, 0xf7, 0x18, 0x5e, 0x42, 0x97, 0x7c, 0xea, 0x41, 0x18, 0x50,
+	0x0b, 0xba, 0x8e, 0x5d, 0xd5, 0x1a, 0x5a, 0xb3, 0x6c, 0x56, 0xb2, 0x5c, 0xc7, 0xc6, 0x37, 0x51,
+	0x39, 0x0b, 0xab, 0x79, 0xb5, 0x3e, 0x49, 0xe0, 0xa7, 0xa8, 0x0c, 0x29, 0xb1, 0x5a, 0x68, 0x68,
+	0xcd, 0x4a, 0xeb, 0x16, 0xc9, 0x1a, 0x2a, 0x3b, 0x19, 0x9f, 0x95, 0x0c, 0xd7, 0xc8, 0x6e, 0xe2,
+	0x29, 0xb3, 0x60, 0x4e, 0xf6, 0x4a, 0x27, 0x8e, 0xef, 0x08, 0x87, 0x0a, 0xb0, 0xa5, 0x93, 0xb9,
+	0x86, 0xd6, 0x2c, 0x98, 0x95, 0x2c, 0xd7, 0xb1, 0xf1, 0x06, 0x2a, 0xaa, 0x5e, 0x54, 0x8b, 0xbf,
+	0xd7, 0x39, 0x73, 0x71, 0x4a, 0x21, 0xab, 0xed, 0x80, 0x25, 0x18, 0x6f, 0xcb, 0xd0, 0x8c, 0xf7,
+	0xe1, 0x55, 0xb4, 0x30, 0xa9, 0x31, 0x04, 0x1e, 0x4a, 0xd3, 0x25, 0x55, 0x68, 0x3e, 0x5b, 0xd8,
+	0x89, 0xf3, 0xcb, 0x5f, 0x35, 0xb4, 0xb0, 0x45, 0x43, 0x38, 0xdf, 0xb0, 0xab, 0xa8, 0xc4, 0x23,
+	0x7f, 0xd2, 0xaa, 0x22, 0x8f, 0xfc, 0x8e, 0x8d, 0x1f, 0xa3, 0xba, 0xcb, 0xf6, 0x21, 0x14, 0xdd,
+	0xf8, 0xb8, 0x5d, 0xea, 0x5b, 0x10, 0x0a, 0xc6, 0xbb, 0x30, 0x04, 0x5f, 0x48, 0x7d, 0x5e, 0xd5,
+	0xb9, 0x11, 0xcb, 0xda, 0x4a, 0xb5, 0x99, 0x88, 0xb6, 0xa5, 0xa6, 0x63, 0xe3, 0x17, 0x68, 0xe5,
+	0x8f, 0x94, 0xd4, 0x72, 0x41, 0xa1, 0x1a, 0x17, 0xa2, 0xd2, 0x23, 0x7c, 0xd1, 0x10, 0x6e, 0x33,
+	0x2f, 0x70, 0x41, 0xfa, 0x6f, 0x53, 0xd7, 0xdd, 0xa3, 0xd6, 0x00, 0xcf, 0xa3, 0x42, 0xc4, 0xdd,
+	0xe4, 0x00, 0xf2, 0x13, 0xef, 0xa2, 0xd2, 0x7b, 0xa0, 0x36, 0xf0, 0x6a, 0xbe, 0x51, 0x68, 0x56,
+	0x5a, 0x1b, 0xe4, 0x2f, 0x6f, 0x82, 0x4c, 0x63, 0xc9, 0x33, 0x45, 0xd8, 0xf6, 0x05, 0x3f, 0x30,
+	0x13, 0x5c, 0xed, 0x01, 0xaa, 0x9c, 0x49, 0xcb, 0xca, 0x03, 0x38, 0x48, 0x2b, 0x0f, 0xe0, 0x00,
+	0x2f, 0xa2, 0xe2, 0x90, 0xba, 0x51, 0xfa, 0x67, 0xc5, 0xc1, 0xc3, 0xfc, 0x7d, 0x6d, 0x79, 0x94,
+	0x47, 0xd7, 0xa6, 0xab, 0xa8, 0x4b, 0x78, 0x89, 0xfe, 0xb7, 0x92, 0x58, 0xb1, 0x2a, 0xad, 0xf5,
+	0x7f, 0x30, 0x6c, 0x66, 0x10, 0xfc, 0x1c, 0x15, 0x43, 0x41, 0x45, 0xec, 0xe2, 0x72, 0xeb, 0xde,
+	0x4c, 0x9a, 0x7a, 0x5b, 0xb3, 0x51, 0xaf, 0xe5, 0x66, 0x33, 0x66, 0xe0, 0x2a, 0xfa, 0x8f, 0x0a,
+	0x09, 0x10, 0xea, 0xa2, 0x8a, 0x66, 0x1a, 0xe2, 0x77, 0xa8, 0xe6, 0xd2, 0x50, 0x74, 0x93, 0xb8,
+	0x6b, 0xc5, 0x20, 0xe8, 0xca, 0x81, 0xa2, 0xfe, 0xf8, 0x4a, 0xab, 0x46, 0xe2, 0x69, 0x43, 0xd2,
+	0x69, 0x43, 0xde, 0xa4, 0xd3, 0x66, 0x6b, 0xee, 0xf0, 0x7b, 0x5d, 0x33, 0xaf, 0x4b, 0xc6, 0x66,
+	0x8c, 0x48, 0xac, 0x80, 0xd4, 0xe0, 0xbb, 0x68, 0xf1, 0x1c, 0xbe, 0x47, 0x1d, 0x37, 0xe2, 0xa0,
+	0x9e, 0x4b, 0xd9, 0xc4, 0x67, 0xb6, 0x3d, 0x89, 0x57, 0xb6, 0xec, 0xa3, 0x91, 0x9e, 0x3b, 0x1e,
+	0xe9, 0xb9, 0xd3, 0x91, 0xae, 0x7d, 0x1c, 0xeb, 0xda, 0xa7, 0xb1, 0xae, 0x7d, 0x1b, 0xeb, 0xda,
+	0xd1, 0x58, 0xd7, 0x7e, 0x8c, 0x75, 0xed, 0xe7, 0x58, 0xcf, 0x9d, 0x8e, 0x75, 0xed, 0xf0, 0x44,
+	0xcf, 0x1d, 0x9d, 0xe8, 0xb9, 0xe3, 0x13, 0x3d, 0xf7, 0x96, 0xf4, 0xd9, 0xa4, 0x41, 0x0e, 0xbb,
+	0x60, 0xca, 0x3e, 0x4a, 0xbf, 0xf7, 0x4a, 0xea, 0x28, 0xeb, 0xbf, 0x06, 0x00, 0x08, 0x28, 0xe5,
+	0xa6, 0x98, 0x05, 0x00, 0x00,
 }
 
 func (this *ParentExecutionInfo) Equal(that interface{}) bool {
@@ -303,6 +458,78 @@ func (this *BaseExecutionInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CompletionCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompletionCallback)
+	if !ok {
+		that2, ok := that.(CompletionCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if len(this.Header) != len(that1.Header) {
+		return false
+	}
+	for i := range this.Header {
+		if this.Header[i] != that1.Header[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *CompletionCallbackInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CompletionCallbackInfo)
+	if !ok {
+		that2, ok := that.(CompletionCallbackInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Callback.Equal(that1.Callback) {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if that1.LastAttemptCompleteTime == nil {
+		if this.LastAttemptCompleteTime != nil {
+			return false
+		}
+	} else if !this.LastAttemptCompleteTime.Equal(*that1.LastAttemptCompleteTime) {
+		return false
+	}
+	if this.LastAttemptFailure != that1.LastAttemptFailure {
+		return false
+	}
+	return true
+}
 func (this *ParentExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -334,6 +561,45 @@ func (this *BaseExecutionInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CompletionCallback) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&workflow.CompletionCallback{")
+	s = append(s, "Url: "+fmt.Sprintf("%#v", this.Url)+",\n")
+	keysForHeader := make([]string, 0, len(this.Header))
+	for k, _ := range this.Header {
+		keysForHeader = append(keysForHeader, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeader)
+	mapStringForHeader := "map[string]string{"
+	for _, k := range keysForHeader {
+		mapStringForHeader += fmt.Sprintf("%#v: %#v,", k, this.Header[k])
+	}
+	mapStringForHeader += "}"
+	if this.Header != nil {
+		s = append(s, "Header: "+mapStringForHeader+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CompletionCallbackInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&workflow.CompletionCallbackInfo{")
+	if this.Callback != nil {
+		s = append(s, "Callback: "+fmt.Sprintf("%#v", this.Callback)+",\n")
+	}
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastAttemptCompleteTime: "+fmt.Sprintf("%#v", this.LastAttemptCompleteTime)+",\n")
+	s = append(s, "LastAttemptFailure: "+fmt.Sprintf("%#v", this.LastAttemptFailure)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -453,6 +719,117 @@ func (m *BaseExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompletionCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Header) > 0 {
+		for k := range m.Header {
+			v := m.Header[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletionCallbackInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallbackInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionCallbackInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastAttemptFailure) > 0 {
+		i -= len(m.LastAttemptFailure)
+		copy(dAtA[i:], m.LastAttemptFailure)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastAttemptFailure)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastAttemptCompleteTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptCompleteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -514,6 +891,54 @@ func (m *BaseExecutionInfo) Size() (n int) {
 	return n
 }
 
+func (m *CompletionCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Header) > 0 {
+		for k, v := range m.Header {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CompletionCallbackInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovMessage(uint64(m.State))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	if m.LastAttemptCompleteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptCompleteTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LastAttemptFailure)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -547,15 +972,50 @@ func (this *BaseExecutionInfo) String() string {
 	}, "")
 	return s
 }
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *CompletionCallback) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	keysForHeader := make([]string, 0, len(this.Header))
+	for k, _ := range this.Header {
+		keysForHeader = append(keysForHeader, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeader)
+	mapStringForHeader := "map[string]string{"
+	for _, k := range keysForHeader {
+		mapStringForHeader += fmt.Sprintf("%v: %v,", k, this.Header[k])
+	}
+	mapStringForHeader += "}"
+	s := strings.Join([]string{`&CompletionCallback{`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`Header:` + mapStringForHeader + `,`,
+		`}`,
+	}, "")
+	return s
 }
-func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
+func (this *CompletionCallbackInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompletionCallbackInfo{`,
+		`Callback:` + strings.Replace(this.Callback.String(), "CompletionCallback", "CompletionCallback", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastAttemptCompleteTime:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptCompleteTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastAttemptFailure:` + fmt.Sprintf("%v", this.LastAttemptFailure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ParentExecutionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,6 +1365,413 @@ func (m *BaseExecutionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CompletionCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Header[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompletionCallbackInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompletionCallbackInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompletionCallbackInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &CompletionCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= v12.CompletionCallbackState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptCompleteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptCompleteTime == nil {
+				m.LastAttemptCompleteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptCompleteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptFailure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAttemptFailure = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53,6 +53,16 @@ func (c *clientImpl) AddSearchAttributes(
 	return c.client.AddSearchAttributes(ctx, request, opts...)
 }
 
+func (c *clientImpl) CheckPersistenceConsistency(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CheckPersistenceConsistency(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -103,6 +113,26 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -183,6 +213,16 @@ func (c *clientImpl) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -203,6 +243,16 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListFaultInjectionScenarios(
+	ctx context.Context,
+	request *adminservice.ListFaultInjectionScenariosRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListFaultInjectionScenariosResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListFaultInjectionScenarios(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -213,6 +263,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -223,6 +283,26 @@ func (c *clientImpl) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -233,6 +313,16 @@ func (c *clientImpl) PurgeDLQMessages(
 	return c.client.PurgeDLQMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -302,3 +392,73 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartHistoryShardCountMigration(
+	ctx context.Context,
+	request *adminservice.StartHistoryShardCountMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartHistoryShardCountMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartHistoryShardCountMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartResetActivitiesBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartResetActivitiesBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartResetActivitiesBatchOperationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartResetActivitiesBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateActivityOptions(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *adminservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateBuildIdRampResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateBuildIdRamp(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateFaultInjectionScenario(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionScenarioRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateFaultInjectionScenarioResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateFaultInjectionScenario(ctx, request, opts...)
+}
@@ -104,3 +104,17 @@ func (c *metricClient) StreamWorkflowReplicationMessages(
 
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (_ adminservice.AdminService_StreamWorkflowExecutionHistoryClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminStreamWorkflowExecutionHistoryScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
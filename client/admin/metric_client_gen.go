@@ -63,6 +63,20 @@ func (c *metricClient) AddSearchAttributes(
 	return c.client.AddSearchAttributes(ctx, request, opts...)
 }
 
+func (c *metricClient) CheckPersistenceConsistency(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CheckPersistenceConsistencyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientCheckPersistenceConsistencyScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CheckPersistenceConsistency(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -133,6 +147,34 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeTaskQueueBacklogScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
+func (c *metricClient) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExportWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientExportWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -245,6 +287,20 @@ func (c *metricClient) GetWorkflowExecutionRawHistoryV2(
 	return c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 }
 
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ImportWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientImportWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -273,6 +329,20 @@ func (c *metricClient) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *metricClient) ListFaultInjectionScenarios(
+	ctx context.Context,
+	request *adminservice.ListFaultInjectionScenariosRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListFaultInjectionScenariosResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListFaultInjectionScenariosScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListFaultInjectionScenarios(ctx, request, opts...)
+}
+
 func (c *metricClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -287,6 +357,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListWorkersScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListWorkers(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -301,6 +385,34 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.MoveTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientMoveTaskQueueTasksScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientPauseWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -315,6 +427,20 @@ func (c *metricClient) PurgeDLQMessages(
 	return c.client.PurgeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PurgeTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientPurgeTaskQueueTasksScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -412,3 +538,101 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResetActivityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientResetActivityScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResetActivity(ctx, request, opts...)
+}
+
+func (c *metricClient) StartHistoryShardCountMigration(
+	ctx context.Context,
+	request *adminservice.StartHistoryShardCountMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartHistoryShardCountMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartHistoryShardCountMigrationScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartHistoryShardCountMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) StartResetActivitiesBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartResetActivitiesBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartResetActivitiesBatchOperationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientStartResetActivitiesBatchOperationScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartResetActivitiesBatchOperation(ctx, request, opts...)
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUnpauseWorkflowExecutionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateActivityOptionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateActivityOptionsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateActivityOptions(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *adminservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateBuildIdRampResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateBuildIdRampScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateBuildIdRamp(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateFaultInjectionScenario(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionScenarioRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateFaultInjectionScenarioResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientUpdateFaultInjectionScenarioScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateFaultInjectionScenario(ctx, request, opts...)
+}
@@ -65,6 +65,21 @@ func (c *retryableClient) AddSearchAttributes(
 	return resp, err
 }
 
+func (c *retryableClient) CheckPersistenceConsistency(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	var resp *adminservice.CheckPersistenceConsistencyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CheckPersistenceConsistency(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -140,6 +155,36 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogResponse, error) {
+	var resp *adminservice.DescribeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	var resp *adminservice.ExportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -260,6 +305,21 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 	return resp, err
 }
 
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	var resp *adminservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -290,6 +350,21 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListFaultInjectionScenarios(
+	ctx context.Context,
+	request *adminservice.ListFaultInjectionScenariosRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListFaultInjectionScenariosResponse, error) {
+	var resp *adminservice.ListFaultInjectionScenariosResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListFaultInjectionScenarios(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -305,6 +380,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkersResponse, error) {
+	var resp *adminservice.ListWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -320,6 +410,36 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.MoveTaskQueueTasksResponse, error) {
+	var resp *adminservice.MoveTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -335,6 +455,21 @@ func (c *retryableClient) PurgeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueTasksResponse, error) {
+	var resp *adminservice.PurgeTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -439,3 +574,108 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+	var resp *adminservice.ResetActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartHistoryShardCountMigration(
+	ctx context.Context,
+	request *adminservice.StartHistoryShardCountMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartHistoryShardCountMigrationResponse, error) {
+	var resp *adminservice.StartHistoryShardCountMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartHistoryShardCountMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartResetActivitiesBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartResetActivitiesBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartResetActivitiesBatchOperationResponse, error) {
+	var resp *adminservice.StartResetActivitiesBatchOperationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartResetActivitiesBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+	var resp *adminservice.UpdateActivityOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *adminservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateBuildIdRampResponse, error) {
+	var resp *adminservice.UpdateBuildIdRampResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateBuildIdRamp(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateFaultInjectionScenario(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionScenarioRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateFaultInjectionScenarioResponse, error) {
+	var resp *adminservice.UpdateFaultInjectionScenarioResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFaultInjectionScenario(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
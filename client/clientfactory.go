@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/client/matching"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
@@ -66,7 +67,7 @@ type (
 			monitor membership.Monitor,
 			metricsHandler metrics.Handler,
 			dc *dynamicconfig.Collection,
-			numberOfHistoryShards int32,
+			historyShardResolver historyshard.Resolver,
 			logger log.Logger,
 			throttledLogger log.Logger,
 		) Factory
@@ -76,13 +77,13 @@ type (
 	NamespaceIDToNameFunc func(id namespace.ID) (namespace.Name, error)
 
 	rpcClientFactory struct {
-		rpcFactory            common.RPCFactory
-		monitor               membership.Monitor
-		metricsHandler        metrics.Handler
-		dynConfig             *dynamicconfig.Collection
-		numberOfHistoryShards int32
-		logger                log.Logger
-		throttledLogger       log.Logger
+		rpcFactory           common.RPCFactory
+		monitor              membership.Monitor
+		metricsHandler       metrics.Handler
+		dynConfig            *dynamicconfig.Collection
+		historyShardResolver historyshard.Resolver
+		logger               log.Logger
+		throttledLogger      log.Logger
 	}
 
 	factoryProviderImpl struct {
@@ -104,18 +105,18 @@ func (p *factoryProviderImpl) NewFactory(
 	monitor membership.Monitor,
 	metricsHandler metrics.Handler,
 	dc *dynamicconfig.Collection,
-	numberOfHistoryShards int32,
+	historyShardResolver historyshard.Resolver,
 	logger log.Logger,
 	throttledLogger log.Logger,
 ) Factory {
 	return &rpcClientFactory{
-		rpcFactory:            rpcFactory,
-		monitor:               monitor,
-		metricsHandler:        metricsHandler,
-		dynConfig:             dc,
-		numberOfHistoryShards: numberOfHistoryShards,
-		logger:                logger,
-		throttledLogger:       throttledLogger,
+		rpcFactory:           rpcFactory,
+		monitor:              monitor,
+		metricsHandler:       metricsHandler,
+		dynConfig:            dc,
+		historyShardResolver: historyShardResolver,
+		logger:               logger,
+		throttledLogger:      throttledLogger,
 	}
 }
 
@@ -131,7 +132,7 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 		return historyservice.NewHistoryServiceClient(connection), nil
 	}
 	clientCache := common.NewClientCache(keyResolver, clientProvider)
-	client := history.NewClient(cf.numberOfHistoryShards, timeout, clientCache, cf.logger)
+	client := history.NewClient(cf.historyShardResolver, timeout, clientCache, cf.logger)
 	if cf.metricsHandler != nil {
 		client = history.NewMetricClient(client, cf.metricsHandler, cf.logger, cf.throttledLogger)
 	}
@@ -153,11 +154,12 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 		connection := cf.rpcFactory.CreateInternodeGRPCConnection(clientKey)
 		return matchingservice.NewMatchingServiceClient(connection), nil
 	}
+	clientCache := common.NewClientCache(keyResolver, clientProvider)
 	client := matching.NewClient(
 		timeout,
 		longPollTimeout,
-		common.NewClientCache(keyResolver, clientProvider),
-		matching.NewLoadBalancer(namespaceIDToName, cf.dynConfig),
+		clientCache,
+		matching.NewLoadBalancer(namespaceIDToName, cf.dynConfig, clientCache),
 	)
 
 	if cf.metricsHandler != nil {
@@ -42,8 +42,10 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
@@ -55,7 +57,7 @@ const (
 )
 
 type clientImpl struct {
-	numberOfShards  int32
+	shardResolver   historyshard.Resolver
 	tokenSerializer common.TaskTokenSerializer
 	timeout         time.Duration
 	clients         common.ClientCache
@@ -64,13 +66,13 @@ type clientImpl struct {
 
 // NewClient creates a new history service gRPC client
 func NewClient(
-	numberOfShards int32,
+	shardResolver historyshard.Resolver,
 	timeout time.Duration,
 	clients common.ClientCache,
 	logger log.Logger,
 ) historyservice.HistoryServiceClient {
 	return &clientImpl{
-		numberOfShards:  numberOfShards,
+		shardResolver:   shardResolver,
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 		timeout:         timeout,
 		clients:         clients,
@@ -250,13 +252,29 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 	)
 }
 
+func (c *clientImpl) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (historyservice.HistoryService_StreamWorkflowExecutionHistoryClient, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	// do not use createContext function, let caller manage stream API lifecycle
+	return client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
 
 func (c *clientImpl) getClientForWorkflowID(namespaceID, workflowID string) (historyservice.HistoryServiceClient, error) {
-	key := common.WorkflowIDToHistoryShard(namespaceID, workflowID, c.numberOfShards)
-	return c.getClientForShardID(key)
+	shardID, err := c.shardResolver.WorkflowShardID(namespace.ID(namespaceID), workflowID)
+	if err != nil {
+		return nil, err
+	}
+	return c.getClientForShardID(shardID)
 }
 
 func (c *clientImpl) getClientForShardID(shardID int32) (historyservice.HistoryServiceClient, error) {
@@ -75,6 +75,20 @@ func (c *metricClient) StreamWorkflowReplicationMessages(
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
 
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (_ historyservice.HistoryService_StreamWorkflowExecutionHistoryClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientStreamWorkflowExecutionHistoryScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
+
 func (c *metricClient) startMetricsRecording(
 	ctx context.Context,
 	operation string,
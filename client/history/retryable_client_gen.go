@@ -50,6 +50,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CopyMigratedExecution(
+	ctx context.Context,
+	request *historyservice.CopyMigratedExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.CopyMigratedExecutionResponse, error) {
+	var resp *historyservice.CopyMigratedExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CopyMigratedExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.DeleteWorkflowExecutionRequest,
@@ -230,6 +245,36 @@ func (c *retryableClient) GetShard(
 	return resp, err
 }
 
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	var resp *historyservice.ImportWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListFaultInjectionScenarios(
+	ctx context.Context,
+	request *historyservice.ListFaultInjectionScenariosRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListFaultInjectionScenariosResponse, error) {
+	var resp *historyservice.ListFaultInjectionScenariosResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListFaultInjectionScenarios(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
@@ -245,6 +290,36 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateHistoryShard(
+	ctx context.Context,
+	request *historyservice.MigrateHistoryShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MigrateHistoryShardResponse, error) {
+	var resp *historyservice.MigrateHistoryShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateHistoryShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -485,6 +560,21 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *historyservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResetActivityResponse, error) {
+	var resp *historyservice.ResetActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -695,6 +785,51 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *historyservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateActivityOptionsResponse, error) {
+	var resp *historyservice.UpdateActivityOptionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateFaultInjectionScenario(
+	ctx context.Context,
+	request *historyservice.UpdateFaultInjectionScenarioRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateFaultInjectionScenarioResponse, error) {
+	var resp *historyservice.UpdateFaultInjectionScenarioResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFaultInjectionScenario(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
@@ -203,10 +203,17 @@ func (c *clientImpl) getClientForTaskqueue(
 	taskQueue *taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 ) (matchingservice.MatchingServiceClient, error) {
-	key := fmt.Sprintf("%s:%s:%d", namespaceID, taskQueue.Name, int(taskQueueType))
-	client, err := c.clients.GetClientForKey(key)
+	client, err := c.clients.GetClientForKey(taskQueueClientKey(namespaceID, taskQueue.Name, taskQueueType))
 	if err != nil {
 		return nil, err
 	}
 	return client.(matchingservice.MatchingServiceClient), nil
 }
+
+func taskQueueClientKey(
+	namespaceID string,
+	taskQueueName string,
+	taskQueueType enumspb.TaskQueueType,
+) string {
+	return fmt.Sprintf("%s:%s:%d", namespaceID, taskQueueName, int(taskQueueType))
+}
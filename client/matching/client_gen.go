@@ -65,6 +65,21 @@ func (c *clientImpl) DescribeTaskQueue(
 	return client.DescribeTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetTaskQueueMetadata(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueMetadataRequest,
@@ -125,6 +140,51 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RecordActivityTaskClosed(
+	ctx context.Context,
+	request *matchingservice.RecordActivityTaskClosedRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.RecordActivityTaskClosedResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RecordActivityTaskClosed(ctx, request, opts...)
+}
+
 func (c *clientImpl) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *matchingservice.RespondQueryTaskCompletedRequest,
@@ -140,6 +200,21 @@ func (c *clientImpl) RespondQueryTaskCompleted(
 	return client.RespondQueryTaskCompleted(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *matchingservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateBuildIdRampResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateBuildIdRamp(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
@@ -26,10 +26,13 @@ package matching
 
 import (
 	"math/rand"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqname"
@@ -68,6 +71,7 @@ type (
 	defaultLoadBalancer struct {
 		nReadPartitions   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		nWritePartitions  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		partitionConfigs  *partitionConfigCache
 		namespaceIDToName func(id namespace.ID) (namespace.Name, error)
 	}
 )
@@ -77,11 +81,16 @@ type (
 func NewLoadBalancer(
 	namespaceIDToName func(id namespace.ID) (namespace.Name, error),
 	dc *dynamicconfig.Collection,
+	clients common.ClientCache,
 ) LoadBalancer {
 	return &defaultLoadBalancer{
 		namespaceIDToName: namespaceIDToName,
 		nReadPartitions:   dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
 		nWritePartitions:  dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
+		partitionConfigs: newPartitionConfigCache(
+			dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 20*time.Second),
+			clients,
+		),
 	}
 }
 
@@ -91,7 +100,8 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions,
+		(*persistencespb.TaskQueuePartitionCounts).GetWritePartitions)
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -100,7 +110,8 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions,
+		(*persistencespb.TaskQueuePartitionCounts).GetReadPartitions)
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -109,6 +120,7 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters,
+	nAutoScaledPartitions func(*persistencespb.TaskQueuePartitionCounts) int32,
 ) string {
 	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
 		return taskQueue.GetName()
@@ -127,5 +139,11 @@ func (lb *defaultLoadBalancer) pickPartition(
 	}
 
 	n := util.Max(1, nPartitions(nsName.String(), tqName.BaseNameString(), taskQueueType))
+	// auto scaled counts are followed even if auto scaling is turned off, as they are kept until the
+	// partitions removed by turning it off are drained
+	partitionConfig := lb.partitionConfigs.get(namespaceID, nsName, tqName.BaseNameString())
+	if autoScaled := nAutoScaledPartitions(partitionCountsForType(partitionConfig, taskQueueType)); autoScaled > 0 {
+		n = int(autoScaled)
+	}
 	return tqName.WithPartition(rand.Intn(n)).FullName()
 }
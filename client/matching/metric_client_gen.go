@@ -63,6 +63,20 @@ func (c *metricClient) DescribeTaskQueue(
 	return c.client.DescribeTaskQueue(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DescribeTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientDescribeTaskQueueBacklogScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *metricClient) GetTaskQueueMetadata(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueMetadataRequest,
@@ -119,6 +133,48 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.MoveTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientMoveTaskQueueTasksScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MoveTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.PurgeTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientPurgeTaskQueueTasksScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+}
+
+func (c *metricClient) RecordActivityTaskClosed(
+	ctx context.Context,
+	request *matchingservice.RecordActivityTaskClosedRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.RecordActivityTaskClosedResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientRecordActivityTaskClosedScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecordActivityTaskClosed(ctx, request, opts...)
+}
+
 func (c *metricClient) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *matchingservice.RespondQueryTaskCompletedRequest,
@@ -133,6 +189,20 @@ func (c *metricClient) RespondQueryTaskCompleted(
 	return c.client.RespondQueryTaskCompleted(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *matchingservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateBuildIdRampResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientUpdateBuildIdRampScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateBuildIdRamp(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	partitionConfigCacheSize    = 10000
+	partitionConfigFetchTimeout = 5 * time.Second
+)
+
+type (
+	// partitionConfigCache holds the partition counts chosen by partition auto scaling for the task queues
+	// this client talks to. Counts are fetched from the root workflow partition in the background, callers
+	// get the last fetched counts without waiting.
+	partitionConfigCache struct {
+		refreshInterval dynamicconfig.DurationPropertyFn
+		clients         common.ClientCache
+
+		lock    sync.Mutex
+		entries cache.Cache
+	}
+
+	partitionConfigKey struct {
+		namespaceID namespace.ID
+		taskQueue   string
+	}
+
+	partitionConfigEntry struct {
+		config      *persistencespb.TaskQueuePartitionConfig
+		refreshTime time.Time
+		refreshing  bool
+	}
+)
+
+func newPartitionConfigCache(
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	clients common.ClientCache,
+) *partitionConfigCache {
+	return &partitionConfigCache{
+		refreshInterval: refreshInterval,
+		clients:         clients,
+		entries:         cache.NewLRU(partitionConfigCacheSize),
+	}
+}
+
+// get returns the last fetched partition config of the task queue, nil if there is none, and starts a
+// refresh if one is due.
+func (c *partitionConfigCache) get(
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	taskQueue string,
+) *persistencespb.TaskQueuePartitionConfig {
+	key := partitionConfigKey{namespaceID: namespaceID, taskQueue: taskQueue}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, _ := c.entries.Get(key).(*partitionConfigEntry)
+	if entry == nil {
+		entry = &partitionConfigEntry{}
+		c.entries.Put(key, entry)
+	}
+	if !entry.refreshing && time.Since(entry.refreshTime) >= c.refreshInterval() {
+		entry.refreshing = true
+		go c.refresh(key, namespaceName, entry)
+	}
+	return entry.config
+}
+
+func (c *partitionConfigCache) refresh(
+	key partitionConfigKey,
+	namespaceName namespace.Name,
+	entry *partitionConfigEntry,
+) {
+	partitionConfig, err := c.fetch(key, namespaceName)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry.refreshing = false
+	entry.refreshTime = time.Now()
+	if err == nil {
+		entry.config = partitionConfig
+	}
+}
+
+func (c *partitionConfigCache) fetch(
+	key partitionConfigKey,
+	namespaceName namespace.Name,
+) (*persistencespb.TaskQueuePartitionConfig, error) {
+	client, err := c.clients.GetClientForKey(taskQueueClientKey(key.namespaceID.String(), key.taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), partitionConfigFetchTimeout)
+	defer cancel()
+	ctx = headers.SetCallerInfo(ctx, headers.NewBackgroundCallerInfo(namespaceName.String()))
+
+	resp, err := client.(matchingservice.MatchingServiceClient).GetTaskQueueMetadata(ctx, &matchingservice.GetTaskQueueMetadataRequest{
+		NamespaceId:         key.namespaceID.String(),
+		TaskQueue:           key.taskQueue,
+		WantPartitionConfig: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPartitionConfig(), nil
+}
+
+func partitionCountsForType(
+	partitionConfig *persistencespb.TaskQueuePartitionConfig,
+	taskQueueType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return partitionConfig.GetActivityPartitions()
+	}
+	return partitionConfig.GetWorkflowPartitions()
+}
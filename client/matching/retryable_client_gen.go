@@ -95,6 +95,21 @@ func (c *retryableClient) DescribeTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	var resp *matchingservice.DescribeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetTaskQueueMetadata(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueMetadataRequest,
@@ -155,6 +170,21 @@ func (c *retryableClient) ListTaskQueuePartitions(
 	return resp, err
 }
 
+func (c *retryableClient) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	var resp *matchingservice.MoveTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollActivityTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollActivityTaskQueueRequest,
@@ -185,6 +215,21 @@ func (c *retryableClient) PollWorkflowTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	var resp *matchingservice.PurgeTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) QueryWorkflow(
 	ctx context.Context,
 	request *matchingservice.QueryWorkflowRequest,
@@ -200,6 +245,21 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) RecordActivityTaskClosed(
+	ctx context.Context,
+	request *matchingservice.RecordActivityTaskClosedRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.RecordActivityTaskClosedResponse, error) {
+	var resp *matchingservice.RecordActivityTaskClosedResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordActivityTaskClosed(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *matchingservice.RespondQueryTaskCompletedRequest,
@@ -215,6 +275,21 @@ func (c *retryableClient) RespondQueryTaskCompleted(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *matchingservice.UpdateBuildIdRampRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateBuildIdRampResponse, error) {
+	var resp *matchingservice.UpdateBuildIdRampResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateBuildIdRamp(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
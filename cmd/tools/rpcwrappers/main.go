@@ -90,6 +90,12 @@ var (
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
+		"client.admin.StreamWorkflowExecutionHistory":               true,
+		"metricsClient.admin.StreamWorkflowExecutionHistory":        true,
+		"retryableClient.admin.StreamWorkflowExecutionHistory":      true,
+		"client.history.StreamWorkflowExecutionHistory":             true,
+		"metricsClient.history.StreamWorkflowExecutionHistory":      true,
+		"retryableClient.history.StreamWorkflowExecutionHistory":    true,
 
 		// these are non-standard implementations. do not generate.
 		"client.history.DescribeHistoryHost":    true,
@@ -188,8 +194,11 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"UpdateWorkerBuildIdCompatibilityRequest",
 		"RespondQueryTaskCompletedRequest",
 		"ListTaskQueuePartitionsRequest",
-		"GetTaskQueueMetadataRequest":
+		"GetTaskQueueMetadataRequest",
+		"UpdateBuildIdRampRequest":
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_WORKFLOW"
+	case "RecordActivityTaskClosedRequest":
+		tqtPath = "enumspb.TASK_QUEUE_TYPE_ACTIVITY"
 	default:
 		tqtPath = pathToField(t, "TaskQueueType", "request", 2)
 	}
@@ -144,7 +144,7 @@ func (h *historyArchiver) Archive(
 
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, targetHistoryBlobSize)
+		historyIterator = archiver.NewHistoryIterator(request, h.container.ExecutionManager, h.container.PayloadStore, targetHistoryBlobSize)
 	}
 
 	var historyBatches []*historypb.History
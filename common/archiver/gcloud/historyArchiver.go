@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -143,7 +144,7 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 	historyIterator := h.historyIterator
 	var progress progress
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.ExecutionManager, h.container.PayloadStore, featureCatalog, &progress)
 	}
 
 	encoder := codec.NewJSONPBEncoder()
@@ -371,11 +372,11 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, highestVersionPart, lowestVersionPart, nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, payloadStore payloadstore.Store, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
 
 	defer func() {
 		if err != nil || historyIterator == nil {
-			historyIterator, err = archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, nil)
+			historyIterator, err = archiver.NewHistoryIteratorFromState(request, executionManager, payloadStore, targetHistoryBlobSize, nil)
 		}
 	}()
 
@@ -383,7 +384,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err = featureCatalog.ProgressManager.LoadProgress(ctx, &progress)
 			if err == nil {
-				historyIterator, err = archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, progress.IteratorState)
+				historyIterator, err = archiver.NewHistoryIteratorFromState(request, executionManager, payloadStore, targetHistoryBlobSize, progress.IteratorState)
 			}
 		}
 
@@ -39,6 +39,8 @@ import (
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -64,6 +66,7 @@ type (
 
 		request               *ArchiveHistoryRequest
 		executionManager      persistence.ExecutionManager
+		payloadStore          payloadstore.Store
 		sizeEstimator         SizeEstimator
 		historyPageSize       int
 		targetHistoryBlobSize int
@@ -78,19 +81,21 @@ var (
 func NewHistoryIterator(
 	request *ArchiveHistoryRequest,
 	executionManager persistence.ExecutionManager,
+	payloadStore payloadstore.Store,
 	targetHistoryBlobSize int,
 ) HistoryIterator {
-	return newHistoryIterator(request, executionManager, targetHistoryBlobSize)
+	return newHistoryIterator(request, executionManager, payloadStore, targetHistoryBlobSize)
 }
 
 // NewHistoryIteratorFromState returns a new HistoryIterator with specified state
 func NewHistoryIteratorFromState(
 	request *ArchiveHistoryRequest,
 	executionManager persistence.ExecutionManager,
+	payloadStore payloadstore.Store,
 	targetHistoryBlobSize int,
 	initialState []byte,
 ) (HistoryIterator, error) {
-	it := newHistoryIterator(request, executionManager, targetHistoryBlobSize)
+	it := newHistoryIterator(request, executionManager, payloadStore, targetHistoryBlobSize)
 	if initialState == nil {
 		return it, nil
 	}
@@ -103,6 +108,7 @@ func NewHistoryIteratorFromState(
 func newHistoryIterator(
 	request *ArchiveHistoryRequest,
 	executionManager persistence.ExecutionManager,
+	payloadStore payloadstore.Store,
 	targetHistoryBlobSize int,
 ) *historyIterator {
 	return &historyIterator{
@@ -112,6 +118,7 @@ func newHistoryIterator(
 		},
 		request:               request,
 		executionManager:      executionManager,
+		payloadStore:          payloadStore,
 		historyPageSize:       historyPageSize,
 		targetHistoryBlobSize: targetHistoryBlobSize,
 		sizeEstimator:         NewJSONSizeEstimator(),
@@ -222,7 +229,16 @@ func (i *historyIterator) readHistory(firstEventID int64) ([]*historypb.History,
 		ShardID:     i.request.ShardID,
 	}
 	historyBatches, _, _, err := persistence.ReadFullPageEventsByBatch(context.TODO(), i.executionManager, req)
-	return historyBatches, err
+	if err != nil || i.payloadStore == nil {
+		return historyBatches, err
+	}
+	// archived history must be self-contained as offloaded payloads are deleted with the workflow
+	for _, batch := range historyBatches {
+		if err := payloadstore.Resolve(context.TODO(), i.payloadStore, batch, namespace.ID(i.request.NamespaceID)); err != nil {
+			return nil, err
+		}
+	}
+	return historyBatches, nil
 }
 
 // reset resets iterator to a certain state given its encoded representation
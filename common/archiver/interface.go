@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -76,6 +77,7 @@ type (
 		Logger           log.Logger
 		MetricsHandler   metrics.Handler
 		ClusterMetadata  cluster.Metadata
+		PayloadStore     payloadstore.Store
 	}
 
 	// HistoryArchiver is used to archive history and read archived history
@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -159,7 +160,7 @@ func (h *historyArchiver) Archive(
 	var progress uploadProgress
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, featureCatalog, &progress)
+		historyIterator = loadHistoryIterator(ctx, request, h.container.ExecutionManager, h.container.PayloadStore, featureCatalog, &progress)
 	}
 	for historyIterator.HasNext() {
 		historyBlob, err := historyIterator.Next()
@@ -231,12 +232,12 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, executionManager persistence.ExecutionManager, payloadStore payloadstore.Store, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
 			err := featureCatalog.ProgressManager.LoadProgress(ctx, progress)
 			if err == nil {
-				historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, progress.IteratorState)
+				historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, payloadStore, targetHistoryBlobSize, progress.IteratorState)
 				if err == nil {
 					return historyIterator
 				}
@@ -247,7 +248,7 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 			progress.uploadedSize = 0
 		}
 	}
-	return archiver.NewHistoryIterator(request, executionManager, targetHistoryBlobSize)
+	return archiver.NewHistoryIterator(request, executionManager, payloadStore, targetHistoryBlobSize)
 }
 
 func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, progress *uploadProgress) {
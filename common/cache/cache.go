@@ -51,7 +51,8 @@ type Cache interface {
 	// Iterator returns the iterator of the cache
 	Iterator() Iterator
 
-	// Size returns the number of entries currently stored in the Cache
+	// Size returns the number of entries currently stored in the Cache, or
+	// the total size of the stored values if the Cache is limited size based
 	Size() int
 }
 
@@ -66,6 +67,21 @@ type Options struct {
 
 	// Pin prevents in-use objects from getting evicted.
 	Pin bool
+
+	// LimitSizeBased bounds the cache by the total size of its values instead of
+	// the number of entries. Values implementing SizeGetter are accounted by their
+	// reported size, which is refreshed when they are released, other values count as one.
+	LimitSizeBased bool
+
+	// EvictedFunc is an optional function called when an element is evicted to make
+	// room for other elements. It is called synchronously while holding the cache lock.
+	EvictedFunc RemovedFunc
+}
+
+// SizeGetter is implemented by values reporting their size to a cache limited size based
+type SizeGetter interface {
+	// CacheSize returns the size of the value, usually an estimate in bytes
+	CacheSize() int
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -39,12 +39,15 @@ var (
 // lru is a concurrent fixed size cache that evicts elements in lru order
 type (
 	lru struct {
-		mut      sync.Mutex
-		byAccess *list.List
-		byKey    map[interface{}]*list.Element
-		maxSize  int
-		ttl      time.Duration
-		pin      bool
+		mut            sync.Mutex
+		byAccess       *list.List
+		byKey          map[interface{}]*list.Element
+		maxSize        int
+		currSize       int
+		ttl            time.Duration
+		pin            bool
+		limitSizeBased bool
+		evictedFunc    RemovedFunc
 	}
 
 	iteratorImpl struct {
@@ -58,6 +61,7 @@ type (
 		createTime time.Time
 		value      interface{}
 		refCount   int
+		size       int
 	}
 )
 
@@ -135,11 +139,13 @@ func New(maxSize int, opts *Options) Cache {
 	}
 
 	return &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		maxSize:  maxSize,
-		pin:      opts.Pin,
+		byAccess:       list.New(),
+		byKey:          make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:            opts.TTL,
+		maxSize:        maxSize,
+		pin:            opts.Pin,
+		limitSizeBased: opts.LimitSizeBased,
+		evictedFunc:    opts.EvictedFunc,
 	}
 }
 
@@ -237,14 +243,20 @@ func (c *lru) Release(key interface{}) {
 	}
 	entry := elt.Value.(*entryImpl)
 	entry.refCount--
+	if c.limitSizeBased {
+		// the value may have grown or shrunk while in use
+		c.updateSizeInternal(entry)
+		c.evictInternal(0)
+	}
 }
 
-// Size returns the number of entries currently in the lru, useful if cache is not full
+// Size returns the number of entries currently in the lru, or the total size
+// of the values if the lru is limited size based, useful if cache is not full
 func (c *lru) Size() int {
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
-	return len(c.byKey)
+	return c.currSize
 }
 
 // Put puts a new value associated with a given key, returning the existing value (if present)
@@ -269,6 +281,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now().UTC()
 				}
+				c.updateSizeInternal(entry)
 			}
 
 			c.byAccess.MoveToFront(elt)
@@ -292,36 +305,56 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 		entry.createTime = time.Now().UTC()
 	}
 
-	if len(c.byKey) >= c.maxSize {
-		c.evictOnceInternal()
-	}
-	if len(c.byKey) >= c.maxSize {
+	entry.size = c.getSize(value)
+	if !c.evictInternal(entry.size) {
 		return nil, ErrCacheFull
 	}
 
 	element := c.byAccess.PushFront(entry)
 	c.byKey[key] = element
+	c.currSize += entry.size
 	return nil, nil
 }
 
 func (c *lru) deleteInternal(element *list.Element) {
 	entry := c.byAccess.Remove(element).(*entryImpl)
 	delete(c.byKey, entry.key)
+	c.currSize -= entry.size
 }
 
-func (c *lru) evictOnceInternal() {
+// evictInternal evicts elements not being referenced in lru order until there is room
+// for a new element of the given size. Returns false if there is not enough room.
+func (c *lru) evictInternal(newSize int) bool {
 	element := c.byAccess.Back()
-	for element != nil {
+	for element != nil && c.currSize+newSize > c.maxSize {
 		entry := element.Value.(*entryImpl)
+		prev := element.Prev()
+		// skip entries still being referenced
 		if entry.refCount == 0 {
 			c.deleteInternal(element)
-			return
+			if c.evictedFunc != nil {
+				c.evictedFunc(entry.value)
+			}
 		}
+		element = prev
+	}
+	return c.currSize+newSize <= c.maxSize
+}
+
+func (c *lru) updateSizeInternal(entry *entryImpl) {
+	size := c.getSize(entry.value)
+	c.currSize += size - entry.size
+	entry.size = size
+}
 
-		// entry.refCount > 0
-		// skip, entry still being referenced
-		element = element.Prev()
+func (c *lru) getSize(value interface{}) int {
+	if !c.limitSizeBased {
+		return 1
+	}
+	if sizeGetter, ok := value.(SizeGetter); ok {
+		return sizeGetter.CacheSize()
 	}
+	return 1
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
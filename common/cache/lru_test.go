@@ -302,3 +302,76 @@ func TestZeroSizeCache(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 0, cache.Size())
 }
+
+type testSizedValue struct {
+	size int
+}
+
+func (v *testSizedValue) CacheSize() int {
+	return v.size
+}
+
+func TestLimitSizeBased(t *testing.T) {
+	var evicted []interface{}
+	cache := New(10, &Options{
+		LimitSizeBased: true,
+		EvictedFunc: func(value interface{}) {
+			evicted = append(evicted, value)
+		},
+	})
+
+	valueA := &testSizedValue{size: 4}
+	valueB := &testSizedValue{size: 4}
+	valueC := &testSizedValue{size: 6}
+	cache.Put("A", valueA)
+	cache.Put("B", valueB)
+	assert.Equal(t, 8, cache.Size())
+
+	// A is the least recently used and is evicted to make room for C
+	cache.Put("C", valueC)
+	assert.Equal(t, 10, cache.Size())
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, valueB, cache.Get("B"))
+	assert.Equal(t, []interface{}{valueA}, evicted)
+
+	// values not implementing SizeGetter count as one, C is now the least recently used
+	cache.Put("D", "value")
+	assert.Equal(t, 5, cache.Size())
+	assert.Nil(t, cache.Get("C"))
+	assert.Equal(t, []interface{}{valueA, valueC}, evicted)
+}
+
+func TestLimitSizeBasedWithPin(t *testing.T) {
+	cache := New(10, &Options{
+		LimitSizeBased: true,
+		Pin:            true,
+	})
+
+	valueA := &testSizedValue{size: 2}
+	valueB := &testSizedValue{size: 2}
+	_, err := cache.PutIfNotExist("A", valueA)
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist("B", valueB)
+	assert.NoError(t, err)
+
+	// pinned values grow while in use, sizes are refreshed on release
+	valueA.size = 6
+	valueB.size = 6
+	cache.Release("A")
+	assert.Equal(t, 8, cache.Size())
+
+	// B is still pinned so the released A is evicted once B is released and exceeds the limit
+	cache.Release("B")
+	assert.Equal(t, 6, cache.Size())
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, valueB, cache.Get("B"))
+
+	// B is pinned and there is no room for C
+	_, err = cache.PutIfNotExist("C", &testSizedValue{size: 5})
+	assert.ErrorIs(t, err, ErrCacheFull)
+
+	cache.Release("B")
+	_, err = cache.PutIfNotExist("C", &testSizedValue{size: 5})
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get("B"))
+}
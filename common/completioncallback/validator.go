@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package completioncallback contains the restrictions on the HTTP endpoints which are notified when
+// a workflow execution completes, shared by the frontend which accepts them and the history service
+// which delivers them.
+package completioncallback
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+)
+
+const (
+	// SignatureHeaderName is the header carrying the hex encoded HMAC-SHA256 signature of the request body,
+	// set only when a signing key is configured. Headers with the Temporal- prefix are reserved.
+	SignatureHeaderName = "Temporal-Callback-Signature"
+
+	reservedHeaderPrefix = "Temporal-"
+
+	dialTimeout   = 30 * time.Second
+	dialKeepAlive = 30 * time.Second
+)
+
+var (
+	// ErrAddressNotAllowed is returned when dialing a callback host which resolves to an address
+	// which is not routable on the public internet, e.g. loopback or private addresses.
+	ErrAddressNotAllowed = errors.New("callback address is not allowed")
+
+	// reservedHeaders can not be set by callbacks, they are either set by the HTTP client or
+	// control the connection to the callback host.
+	reservedHeaders = map[string]struct{}{
+		"Connection":          {},
+		"Content-Length":      {},
+		"Content-Type":        {},
+		"Expect":              {},
+		"Host":                {},
+		"Keep-Alive":          {},
+		"Proxy-Authorization": {},
+		"Proxy-Connection":    {},
+		"Te":                  {},
+		"Trailer":             {},
+		"Transfer-Encoding":   {},
+		"Upgrade":             {},
+	}
+
+	// sharedAddressSpace is 100.64.0.0/10 used for carrier-grade NAT, not covered by net.IP.IsPrivate.
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+// Validate returns an error if the callback URL is not an absolute http or https URL to one of
+// the allowed hosts, or if the callback sets a reserved or malformed header.
+// allowedHosts is a comma separated list of host names, a name starting with "*." also allows
+// all of its subdomains. No host is allowed if allowedHosts is empty.
+func Validate(
+	callback *workflowspb.CompletionCallback,
+	allowedHosts string,
+) error {
+	callbackURL, err := url.Parse(callback.GetUrl())
+	if err != nil || (callbackURL.Scheme != "http" && callbackURL.Scheme != "https") || callbackURL.Host == "" {
+		return errors.New("URL must be an absolute http or https URL")
+	}
+	if callbackURL.User != nil {
+		return errors.New("URL must not contain user info")
+	}
+	if !IsAllowedHost(callbackURL.Hostname(), allowedHosts) {
+		return fmt.Errorf("host %v is not allowed", callbackURL.Hostname())
+	}
+
+	for name, value := range callback.GetHeader() {
+		if !isValidHeaderName(name) || strings.ContainsAny(value, "\r\n\x00") {
+			return fmt.Errorf("header %q is malformed", name)
+		}
+		canonicalName := http.CanonicalHeaderKey(name)
+		if _, ok := reservedHeaders[canonicalName]; ok || strings.HasPrefix(canonicalName, reservedHeaderPrefix) {
+			return fmt.Errorf("header %v is reserved", canonicalName)
+		}
+	}
+	return nil
+}
+
+// IsAllowedHost returns true if the host matches one of the comma separated allowed hosts.
+func IsAllowedHost(
+	host string,
+	allowedHosts string,
+) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	for _, allowedHost := range strings.Split(allowedHosts, ",") {
+		allowedHost = strings.ToLower(strings.TrimSpace(allowedHost))
+		if allowedHost == "" {
+			continue
+		}
+		if strings.HasPrefix(allowedHost, "*.") {
+			if strings.HasSuffix(host, allowedHost[1:]) {
+				return true
+			}
+			continue
+		}
+		if host == allowedHost {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedIP returns false for addresses which are not routable on the public internet.
+func IsAllowedIP(
+	ip net.IP,
+) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// NewHTTPClient returns the HTTP client used to deliver callbacks. The client checks the address
+// of the callback host after DNS resolution, right before connecting, so that a host name can not be
+// used to reach an internal address. Redirects are not followed, proxies are not used.
+func NewHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: dialKeepAlive,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsAllowedIP(ip) {
+				return fmt.Errorf("%w: %v", ErrAddressNotAllowed, host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func isValidHeaderName(
+	name string,
+) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if c > 0x7f || !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("!#$%&'*+-.^_`|~", c)) {
+			return false
+		}
+	}
+	return true
+}
@@ -60,6 +60,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// PayloadStore is the config for the external store large payloads are offloaded to
+		PayloadStore *PayloadStore `yaml:"payloadStore"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
@@ -248,6 +250,10 @@ type (
 	DataStore struct {
 		// FaultInjection contains the config for fault injector wrapper.
 		FaultInjection *FaultInjection `yaml:"faultInjection"`
+		// Recording contains the config for the wrapper which captures data store traffic to a file.
+		Recording *PersistenceRecording `yaml:"recording"`
+		// Replay contains the config for the wrapper which serves data store traffic captured by Recording.
+		Replay *PersistenceReplay `yaml:"replay"`
 		// Cassandra contains the config for a cassandra datastore
 		Cassandra *Cassandra `yaml:"cassandra"`
 		// SQL contains the config for a SQL based datastore
@@ -282,6 +288,89 @@ type (
 		*/
 		// This will cause the UpdateShard method of the ShardStore to always return ShardOwnershipLostError.
 		Targets FaultInjectionTargets `yaml:"targets"`
+
+		// Scenarios is a mapping of scenario name to a fault injection scenario. Scenarios are applied in addition
+		// to Rate and Targets, and can be enabled and disabled at runtime through the admin API.
+		// Here is an example config which slows down and fails writes for shard 3 once enabled. Half of the
+		// failed UpdateWorkflowExecution calls are ambiguous: the write is applied before the timeout is returned.
+		/*
+			scenarios:
+			  slow-shard-3:
+				enabled: false
+				shardID: 3
+				dataStores:
+				  ExecutionStore: [UpdateWorkflowExecution, AppendHistoryNodes]
+				latency:
+				  distribution: uniform
+				  min: 50ms
+				  max: 2s
+				errors:
+				  TimeoutError: 0.05
+				ambiguousCommitRate: 0.05
+		*/
+		Scenarios map[string]FaultInjectionScenario `yaml:"scenarios"`
+	}
+
+	// FaultInjectionScenario is a set of faults applied to the data store calls it targets while it is enabled.
+	FaultInjectionScenario struct {
+		// Enabled is whether the scenario is active on startup.
+		Enabled bool `yaml:"enabled"`
+		// Duration limits how long the scenario stays enabled after it is enabled. Zero means until disabled.
+		Duration time.Duration `yaml:"duration"`
+		// DataStores is a map of datastore name to the methods the scenario targets. An empty method list
+		// targets every method of the data store. If DataStores is empty, every data store is targeted.
+		DataStores map[DataStoreName][]string `yaml:"dataStores"`
+		// ShardID restricts the scenario to calls for the given shard. Zero targets all shards.
+		ShardID int32 `yaml:"shardID"`
+		// NamespaceID restricts the scenario to calls for the given namespace. Empty targets all namespaces.
+		NamespaceID string `yaml:"namespaceID"`
+		// Latency is added to every targeted call.
+		Latency *FaultInjectionLatency `yaml:"latency"`
+		// Errors is a map of error type to probability of returning that error instead of calling the data store.
+		// See FaultInjectionMethodConfig.Errors for the semantics.
+		Errors map[string]float64 `yaml:"errors"`
+		// AmbiguousCommitRate is the probability of returning a persistence TimeoutError after the call
+		// to the data store succeeded.
+		AmbiguousCommitRate float64 `yaml:"ambiguousCommitRate"`
+		// Seed is the seed for the random number generator of the scenario. If zero, the current time is used.
+		Seed int64 `yaml:"seed"`
+	}
+
+	// FaultInjectionLatency describes the distribution latency is sampled from.
+	FaultInjectionLatency struct {
+		// Distribution is one of "fixed" (always Min), "uniform" (between Min and Max) or "exponential"
+		// (Min plus an exponentially distributed delay with the given Mean, capped at Max if set).
+		Distribution string        `yaml:"distribution"`
+		Min          time.Duration `yaml:"min"`
+		Max          time.Duration `yaml:"max"`
+		Mean         time.Duration `yaml:"mean"`
+	}
+
+	// PersistenceRecording is the config for capturing every data store request and response to a local file.
+	// It is meant for debugging: recordings contain raw persisted data and can grow quickly.
+	/*
+		recording:
+		  file: /tmp/temporal-persistence.jsonl
+		  namespaceID: 2f3b9b6e-0f8a-4b4e-9d43-6a1c7c0f9a1e
+		  workflowID: order-1234
+	*/
+	PersistenceRecording struct {
+		// File is the path of the file recorded calls are appended to, one JSON document per line.
+		File string `yaml:"file" validate:"nonzero"`
+		// NamespaceID restricts the recording to calls for the given namespace. Calls which can't be attributed to
+		// a namespace, e.g. shard or history branch operations, are always recorded.
+		NamespaceID string `yaml:"namespaceID"`
+		// WorkflowID restricts the recording to calls for the given workflow. Calls which can't be attributed to
+		// a workflow are always recorded.
+		WorkflowID string `yaml:"workflowID"`
+	}
+
+	// PersistenceReplay is the config for serving data store calls from a file produced by PersistenceRecording.
+	// Recorded calls are served in the order they were recorded, separately for each data store method. Calls
+	// without a remaining recording fall through to the configured data store.
+	PersistenceReplay struct {
+		// File is the path of the recording to replay.
+		File string `yaml:"file" validate:"nonzero"`
 	}
 
 	// FaultInjectionTargets is the set of targets for fault injection. A target is a method of a data store.
@@ -437,6 +526,16 @@ type (
 		Visibility VisibilityArchival `yaml:"visibility"`
 	}
 
+	// PayloadStore contains the config for the external store large payloads are offloaded to
+	PayloadStore struct {
+		// URI is the location of the store, either file:///path/to/dir or s3://bucket/optional/prefix
+		URI string `yaml:"uri"`
+		// Filestore is the config used when URI has the file scheme
+		Filestore *FilestoreArchiver `yaml:"filestore"`
+		// S3store is the config used when URI has the s3 scheme
+		S3store *S3Archiver `yaml:"s3store"`
+	}
+
 	// HistoryArchival contains the config for history archival
 	HistoryArchival struct {
 		// State is the state of history archival either: enabled, disabled, or paused
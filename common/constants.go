@@ -56,6 +56,20 @@ const (
 	EndMessageID int64 = 1<<63 - 1
 )
 
+const (
+	// MaxTaskPriority is the lowest priority workflow and activity tasks can have, larger values are
+	// treated as this priority. Matching persists the backlog of each priority apart, so the number of
+	// priorities is kept small.
+	MaxTaskPriority = 5
+)
+
+const (
+	// WorkflowPausedMarkerName is the reserved name of the marker recorded when a workflow execution is paused
+	WorkflowPausedMarkerName = "__temporal_workflow_paused"
+	// WorkflowUnpausedMarkerName is the reserved name of the marker recorded when a workflow execution is unpaused
+	WorkflowUnpausedMarkerName = "__temporal_workflow_unpaused"
+)
+
 const (
 	// GetHistoryMaxPageSize is the max page size for get history
 	GetHistoryMaxPageSize = 256
@@ -102,3 +116,8 @@ const (
 	// DefaultQueueReaderID is the default readerID when loading history tasks
 	DefaultQueueReaderID int64 = 0
 )
+
+const (
+	// WorkflowExecutionBundleVersion is the version of the exported workflow execution bundle format
+	WorkflowExecutionBundleVersion int32 = 1
+)
@@ -104,6 +104,11 @@ const (
 	// EnableEagerWorkflowStart toggles "eager workflow start" - returning the first workflow task inline in the
 	// response to a StartWorkflowExecution request and skipping the trip through matching.
 	EnableEagerWorkflowStart = "system.enableEagerWorkflowStart"
+	// EnableCompletionCallbacks indicates if workflow completion callbacks are accepted and delivered per namespace
+	EnableCompletionCallbacks = "system.enableCompletionCallbacks"
+	// CompletionCallbackAllowedHosts is the comma separated list of hosts workflow completion callbacks can be
+	// delivered to per namespace, a host starting with "*." also allows all of its subdomains
+	CompletionCallbackAllowedHosts = "system.completionCallbackAllowedHosts"
 	// NamespaceCacheRefreshInterval is the key for namespace cache refresh interval dynamic config
 	NamespaceCacheRefreshInterval = "system.namespaceCacheRefreshInterval"
 
@@ -128,6 +133,9 @@ const (
 	MemoSizeLimitError = "limit.memoSize.error"
 	// MemoSizeLimitWarn is the per event memo size limit for warning
 	MemoSizeLimitWarn = "limit.memoSize.warn"
+	// PayloadOffloadThreshold is the size above which payloads are offloaded to the payload store
+	// for namespaces with FrontendEnablePayloadOffload
+	PayloadOffloadThreshold = "limit.payloadOffloadThreshold"
 	// NumPendingChildExecutionsLimitError is the maximum number of pending child workflows a workflow can have before
 	// StartChildWorkflowExecution commands will fail.
 	NumPendingChildExecutionsLimitError = "limit.numPendingChildExecutions.error"
@@ -270,6 +278,16 @@ const (
 	FrontendMaxExecutionCountBatchOperationPerNamespace = "frontend.MaxExecutionCountBatchOperationPerNamespace"
 	// FrontendEnableBatcher enables batcher-related RPCs in the frontend
 	FrontendEnableBatcher = "frontend.enableBatcher"
+	// FrontendMaxCompletionCallbacksPerWorkflow is the max number of completion callbacks attached to a workflow execution
+	FrontendMaxCompletionCallbacksPerWorkflow = "frontend.maxCompletionCallbacksPerWorkflow"
+	// FrontendEnableWorkflowImport enables the admin API importing exported workflow execution bundles.
+	// It is meant to be enabled on development servers where exported executions are replayed.
+	FrontendEnableWorkflowImport = "frontend.enableWorkflowImport"
+	// FrontendExportWorkflowMaxRunCount is the max number of runs of a continued as new chain exported in a bundle
+	FrontendExportWorkflowMaxRunCount = "frontend.exportWorkflowMaxRunCount"
+	// FrontendEnablePayloadOffload enables offloading payloads above PayloadOffloadThreshold to the payload store
+	// configured in static config, instead of failing requests whose payloads exceed BlobSizeLimitError.
+	FrontendEnablePayloadOffload = "frontend.enablePayloadOffload"
 
 	// FrontendEnableUpdateWorkflowExecution enables UpdateWorkflowExecution API in the frontend.
 	//  UpdateWorkflowExecution API is under active development and is not ready for production use.
@@ -318,6 +336,28 @@ const (
 	MatchingLongPollExpirationInterval = "matching.longPollExpirationInterval"
 	// MatchingSyncMatchWaitDuration is to wait time for sync match
 	MatchingSyncMatchWaitDuration = "matching.syncMatchWaitDuration"
+	// MatchingEnableTaskPriority dispatches backlog tasks by priority instead of by task ID. Tasks with
+	// another priority than the default priority are persisted in a backlog per priority, which is read
+	// apart from the backlog of the default priority.
+	MatchingEnableTaskPriority = "matching.enableTaskPriority"
+	// MatchingDefaultTaskPriority is the priority of tasks which have no priority set
+	MatchingDefaultTaskPriority = "matching.defaultTaskPriority"
+	// MatchingTaskPriorityAgingInterval is the time a task waits before it is dispatched as if it had
+	// one priority level higher, to prevent starvation of low priority tasks
+	MatchingTaskPriorityAgingInterval = "matching.taskPriorityAgingInterval"
+	// MatchingEnableTaskFairness dispatches buffered backlog tasks round-robin between their fairness keys.
+	// Only the tasks read into the in-memory buffer of a partition take part in the round-robin, while the backlog
+	// of each fairness key reported in DescribeTaskQueue and in metrics is counted from persistence.
+	MatchingEnableTaskFairness = "matching.enableTaskFairness"
+	// MatchingFairnessKeyWeights is a map from fairness key to its integer weight in the round-robin dispatch
+	// of a namespace. Keys which are not in the map have weight 1.
+	MatchingFairnessKeyWeights = "matching.fairnessKeyWeights"
+	// MatchingFairnessTopKeysCount is the number of fairness keys with the largest backlog that are reported
+	// in DescribeTaskQueue
+	MatchingFairnessTopKeysCount = "matching.fairnessTopKeysCount"
+	// MatchingFairnessBacklogCountInterval is the interval at which the persisted backlog of each fairness key is
+	// counted for the fairness key metrics. At most MatchingMaxBacklogScanTasks tasks are counted.
+	MatchingFairnessBacklogCountInterval = "matching.fairnessBacklogCountInterval"
 	// MatchingUpdateAckInterval is the interval for update ack
 	MatchingUpdateAckInterval = "matching.updateAckInterval"
 	// MatchingMaxTaskQueueIdleTime is the time after which an idle task queue will be unloaded
@@ -328,12 +368,52 @@ const (
 	MatchingMaxTaskBatchSize = "matching.maxTaskBatchSize"
 	// MatchingMaxTaskDeleteBatchSize is the max batch size for range deletion of tasks
 	MatchingMaxTaskDeleteBatchSize = "matching.maxTaskDeleteBatchSize"
+	// MatchingMaxBacklogScanTasks is the max number of backlog tasks scanned by one request to inspect, move or
+	// purge the backlog of a task queue partition
+	MatchingMaxBacklogScanTasks = "matching.maxBacklogScanTasks"
+	// MatchingMaxInflightActivityTasks is the max number of activity tasks of a task queue which are dispatched to
+	// workers and not closed yet. 0 means no limit. The limit is approximate, it is divided evenly across the read
+	// partitions of the task queue which enforce their share independently.
+	MatchingMaxInflightActivityTasks = "matching.maxInflightActivityTasks"
+	// MatchingPollerIdentityDispatchRPS is the max rate at which tasks of a task queue are dispatched to pollers with
+	// the same identity. 0 means no limit
+	MatchingPollerIdentityDispatchRPS = "matching.pollerIdentityDispatchRPS"
+	// MatchingMaxDelayedTasks is the max number of backlog tasks read before their not-before time which are parked
+	// per task queue partition. Reading the backlog pauses while the limit is reached
+	MatchingMaxDelayedTasks = "matching.maxDelayedTasks"
+	// MatchingStickyPollerUnavailableWindow is the duration after which the worker of a sticky task queue is treated
+	// as unavailable if it did not poll, and workflow tasks are sent to the normal task queue instead. The default
+	// sticky schedule to start timeout is 5s, so the default of 10s without a poll seems reasonable
+	MatchingStickyPollerUnavailableWindow = "matching.stickyPollerUnavailableWindow"
+	// MatchingStickyMaxBacklog is the number of backlog tasks at which the worker of a sticky task queue is treated
+	// as saturated, and workflow tasks are sent to the normal task queue instead. 0 means no limit
+	MatchingStickyMaxBacklog = "matching.stickyMaxBacklog"
 	// MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	MatchingThrottledLogRPS = "matching.throttledLogRPS"
 	// MatchingNumTaskqueueWritePartitions is the number of write partitions for a task queue
 	MatchingNumTaskqueueWritePartitions = "matching.numTaskqueueWritePartitions"
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions = "matching.numTaskqueueReadPartitions"
+	// MatchingEnablePartitionAutoScale lets the root partition of a task queue choose the number of read and write
+	// partitions from the observed add rate and backlog, instead of using the two keys above. When turned off, the
+	// auto scaled counts move back to the configured counts once the removed partitions are drained.
+	MatchingEnablePartitionAutoScale = "matching.enablePartitionAutoScale"
+	// MatchingPartitionAutoScaleMinPartitions is the lowest number of partitions partition auto scaling chooses
+	MatchingPartitionAutoScaleMinPartitions = "matching.partitionAutoScaleMinPartitions"
+	// MatchingPartitionAutoScaleMaxPartitions is the highest number of partitions partition auto scaling chooses
+	MatchingPartitionAutoScaleMaxPartitions = "matching.partitionAutoScaleMaxPartitions"
+	// MatchingPartitionAutoScaleTargetAddRate is the rate of added tasks per second a single partition should handle
+	MatchingPartitionAutoScaleTargetAddRate = "matching.partitionAutoScaleTargetAddRate"
+	// MatchingPartitionAutoScaleTargetBacklog is the backlog a single partition should hold
+	MatchingPartitionAutoScaleTargetBacklog = "matching.partitionAutoScaleTargetBacklog"
+	// MatchingPartitionAutoScaleInterval is how often partition auto scaling re-evaluates the partition counts. Each
+	// evaluation changes either the read or the write partitions, so it should be longer than
+	// MatchingPartitionConfigRefreshInterval.
+	MatchingPartitionAutoScaleInterval = "matching.partitionAutoScaleInterval"
+	// MatchingPartitionConfigRefreshInterval is how often matching clients refresh the partition counts chosen by
+	// partition auto scaling. Read partitions are only removed once their write partitions have been removed for
+	// longer than this interval.
+	MatchingPartitionConfigRefreshInterval = "matching.partitionConfigRefreshInterval"
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls = "matching.forwarderMaxOutstandingPolls"
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -346,6 +426,14 @@ const (
 	MatchingShutdownDrainDuration = "matching.shutdownDrainDuration"
 	// MatchingMetadataPollFrequency is how often non-root partitions will poll the root partition for fresh metadata
 	MatchingMetadataPollFrequency = "matching.metadataPollFrequency"
+	// MatchingEnableBuildIdScavenger lets the root partition of a task queue periodically remove the build IDs which
+	// are unreachable from its versioning data
+	MatchingEnableBuildIdScavenger = "matching.enableBuildIdScavenger"
+	// MatchingBuildIdScavengerInterval is how often the build ID scavenger looks for unreachable build IDs
+	MatchingBuildIdScavengerInterval = "matching.buildIdScavengerInterval"
+	// MatchingBuildIdRetention is how long a build ID is kept after it stopped getting new workflow executions, even
+	// if it's unreachable
+	MatchingBuildIdRetention = "matching.buildIdRetention"
 
 	// keys for history
 
@@ -365,12 +453,22 @@ const (
 	HistoryEnablePersistencePriorityRateLimiting = "history.enablePersistencePriorityRateLimiting"
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	HistoryLongPollExpirationInterval = "history.longPollExpirationInterval"
+	// WorkflowHistoryStreamMaxDuration is the maximum duration of a workflow history stream, the client has to
+	// resume the stream from the last received event batch afterwards
+	WorkflowHistoryStreamMaxDuration = "history.workflowHistoryStreamMaxDuration"
+	// MaxWorkflowHistoryStreams is the maximum number of concurrent workflow history streams served by a history host
+	MaxWorkflowHistoryStreams = "history.maxWorkflowHistoryStreams"
 	// HistoryCacheInitialSize is initial size of history cache
 	HistoryCacheInitialSize = "history.cacheInitialSize"
 	// HistoryCacheMaxSize is max size of history cache
 	HistoryCacheMaxSize = "history.cacheMaxSize"
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL = "history.cacheTTL"
+	// HistoryCacheLimitSizeBased if true, the history cache is bounded by HistoryCacheMaxSizeBytes
+	// using mutable state size estimates instead of by HistoryCacheMaxSize entries, changes take effect on shard reload
+	HistoryCacheLimitSizeBased = "history.cacheLimitSizeBased"
+	// HistoryCacheMaxSizeBytes is max size of history cache in bytes, per shard
+	HistoryCacheMaxSizeBytes = "history.cacheMaxSizeBytes"
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	HistoryShutdownDrainDuration = "history.shutdownDrainDuration"
 	// EventsCacheInitialSize is initial size of events cache
@@ -409,6 +507,26 @@ const (
 	QueuePendingTaskMaxCount = "history.queuePendingTasksMaxCount"
 	// QueueMaxReaderCount is the max number of readers in one multi-cursor queue
 	QueueMaxReaderCount = "history.queueMaxReaderCount"
+	// TaskDLQEnabled enables moving the transfer, timer, visibility and archival tasks which keep failing to the
+	// history task DLQ, so they don't block their queue
+	TaskDLQEnabled = "history.taskDLQEnabled"
+	// TaskDLQMaxAttempts is the number of failed attempts after which a task is moved to the history task DLQ.
+	// 0 means no attempt limit.
+	TaskDLQMaxAttempts = "history.taskDLQMaxAttempts"
+	// TaskDLQMaxTaskAge is how long a task may keep failing after it's loaded before it's moved to the history task
+	// DLQ. 0 means no age limit.
+	TaskDLQMaxTaskAge = "history.taskDLQMaxTaskAge"
+	// CompletionCallbackTimeout is the timeout of a single delivery attempt of a workflow completion callback.
+	CompletionCallbackTimeout = "history.completionCallbackTimeout"
+	// CompletionCallbackMaxAttempts is the number of delivery attempts of a workflow completion callback
+	// after which the callback is failed.
+	CompletionCallbackMaxAttempts = "history.completionCallbackMaxAttempts"
+	// CompletionCallbackSigningKey is the key used to sign the payload of workflow completion callbacks with
+	// HMAC-SHA256, payloads are not signed if the key is empty.
+	CompletionCallbackSigningKey = "history.completionCallbackSigningKey"
+	// CompletionCallbackDeliveryWorkerCount is the number of workers delivering workflow completion callbacks
+	// on a history host, callbacks are delivered outside of the transfer queue processor
+	CompletionCallbackDeliveryWorkerCount = "history.completionCallbackDeliveryWorkerCount"
 	// ContinueAsNewMinInterval is the minimal interval between continue_as_new executions.
 	// This is needed to prevent tight loop continue_as_new spin. Default is 1s.
 	ContinueAsNewMinInterval = "history.continueAsNewMinInterval"
@@ -592,8 +710,24 @@ const (
 	ShardSyncMinInterval = "history.shardSyncMinInterval"
 	// EmitShardLagLog whether emit the shard lag log
 	EmitShardLagLog = "history.emitShardLagLog"
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events, either Proto3 or Proto3Zstd.
+	// Proto3Zstd compresses event blobs and must only be enabled once all services (and remote clusters)
+	// run a version that can read it.
 	DefaultEventEncoding = "history.defaultEventEncoding"
+	// TaskPrioritySearchAttribute is the name of the Int search attribute holding the priority of the
+	// workflow and activity tasks of a workflow. Lower values are dispatched first, values above
+	// common.MaxTaskPriority are capped. Empty disables task priority.
+	TaskPrioritySearchAttribute = "history.taskPrioritySearchAttribute"
+	// TaskPriorityHeader is the name of the header field of workflow start requests and activity schedule
+	// commands holding the priority of their tasks as an integer. It takes precedence over the search
+	// attribute, activities without it have the priority of their workflow. Empty disables it.
+	TaskPriorityHeader = "history.taskPriorityHeader"
+	// TaskFairnessKeySearchAttribute is the name of the Keyword search attribute holding the fairness key of the
+	// workflow and activity tasks of a workflow. Empty disables fairness keys.
+	TaskFairnessKeySearchAttribute = "history.taskFairnessKeySearchAttribute"
+	// EnableActivityRetryDelayInMatching enables adding activity retries to matching right away with a not-before
+	// time, instead of holding them as history timers until the retry backoff has passed
+	EnableActivityRetryDelayInMatching = "history.enableActivityRetryDelayInMatching"
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows = "history.numArchiveSystemWorkflows"
 	// ArchiveRequestRPS is the rate limit on the number of archive request per second
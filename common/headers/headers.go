@@ -37,6 +37,17 @@ const (
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
 
+	// CompletionCallbacksHeaderName carries the completion callbacks of a started workflow,
+	// each value is a JSON encoded temporal.server.api.workflow.v1.CompletionCallback.
+	CompletionCallbacksHeaderName = "temporal-completion-callbacks"
+	// WorkflowIDConflictPolicyHeaderName carries the name of the workflow ID conflict policy, e.g. UseExisting,
+	// applied when starting a workflow while a workflow with the same ID is running.
+	WorkflowIDConflictPolicyHeaderName = "temporal-workflow-id-conflict-policy"
+	// WorkerTaskSlotsAvailableHeaderName and WorkerTaskSlotsUsedHeaderName carry the number of free and
+	// busy task slots of the worker sending a poll request, for the worker inventory of the task queue.
+	WorkerTaskSlotsAvailableHeaderName = "temporal-worker-task-slots-available"
+	WorkerTaskSlotsUsedHeaderName      = "temporal-worker-task-slots-used"
+
 	callerNameHeaderName = "caller-name"
 	callerTypeHeaderName = "caller-type"
 	callOriginHeaderName = "call-initiation"
@@ -49,6 +60,8 @@ var (
 		ClientVersionHeaderName,
 		SupportedServerVersionsHeaderName,
 		SupportedFeaturesHeaderName,
+		WorkerTaskSlotsAvailableHeaderName,
+		WorkerTaskSlotsUsedHeaderName,
 		callerNameHeaderName,
 		callerTypeHeaderName,
 		callOriginHeaderName,
@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historyshard
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// Layout describes how workflows are mapped to history shards. While the history shard
+	// count is being migrated to a larger target shard count, workflows of source shards that
+	// have been migrated are mapped using the target shard count, and workflows that are moving
+	// out of a source shard which is being migrated are not served until the shard is migrated.
+	Layout struct {
+		ShardCount        int32
+		TargetShardCount  int32
+		MigratingShardIDs map[int32]struct{}
+		MigratedShardIDs  map[int32]struct{}
+	}
+)
+
+// NewLayout creates a layout with the given number of shards and no migration in progress.
+func NewLayout(shardCount int32) *Layout {
+	return &Layout{
+		ShardCount: shardCount,
+	}
+}
+
+// NewLayoutFromClusterMetadata creates a layout from the persisted cluster metadata.
+func NewLayoutFromClusterMetadata(metadata *persistencespb.ClusterMetadata) *Layout {
+	layout := NewLayout(metadata.GetHistoryShardCount())
+	migration := metadata.GetHistoryShardCountMigration()
+	if migration == nil {
+		return layout
+	}
+
+	layout.TargetShardCount = migration.GetTargetShardCount()
+	layout.MigratingShardIDs = make(map[int32]struct{}, len(migration.GetMigratingShardIds()))
+	for _, shardID := range migration.GetMigratingShardIds() {
+		layout.MigratingShardIDs[shardID] = struct{}{}
+	}
+	layout.MigratedShardIDs = make(map[int32]struct{}, len(migration.GetMigratedShardIds()))
+	for _, shardID := range migration.GetMigratedShardIds() {
+		layout.MigratedShardIDs[shardID] = struct{}{}
+	}
+	return layout
+}
+
+// IsMigrating returns true if the history shard count is being migrated.
+func (l *Layout) IsMigrating() bool {
+	return l.TargetShardCount > l.ShardCount
+}
+
+// MaxShardCount returns the number of shards which need to be owned by history hosts.
+func (l *Layout) MaxShardCount() int32 {
+	if l.IsMigrating() {
+		return l.TargetShardCount
+	}
+	return l.ShardCount
+}
+
+// WorkflowShardID returns the ID of the shard serving the given workflow.
+func (l *Layout) WorkflowShardID(
+	namespaceID namespace.ID,
+	workflowID string,
+) (int32, error) {
+	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, l.ShardCount)
+	if !l.IsMigrating() {
+		return shardID, nil
+	}
+
+	if _, ok := l.MigratedShardIDs[shardID]; ok {
+		return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, l.TargetShardCount), nil
+	}
+	if _, ok := l.MigratingShardIDs[shardID]; ok {
+		targetShardID := common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, l.TargetShardCount)
+		if targetShardID != shardID {
+			return 0, serviceerror.NewUnavailable(fmt.Sprintf(
+				"workflow is being migrated from history shard %v to history shard %v", shardID, targetShardID,
+			))
+		}
+	}
+	return shardID, nil
+}
@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package historyshard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	layoutSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestLayoutSuite(t *testing.T) {
+	s := new(layoutSuite)
+	suite.Run(t, s)
+}
+
+func (s *layoutSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *layoutSuite) TestNoMigration() {
+	layout := NewLayoutFromClusterMetadata(&persistencespb.ClusterMetadata{HistoryShardCount: 4})
+
+	s.False(layout.IsMigrating())
+	s.Equal(int32(4), layout.MaxShardCount())
+	for _, workflowID := range []string{"workflow-1", "workflow-2", "workflow-3"} {
+		shardID, err := layout.WorkflowShardID(namespace.ID("namespace-id"), workflowID)
+		s.NoError(err)
+		s.Equal(common.WorkflowIDToHistoryShard("namespace-id", workflowID, 4), shardID)
+	}
+}
+
+func (s *layoutSuite) TestMigration() {
+	layout := NewLayoutFromClusterMetadata(&persistencespb.ClusterMetadata{
+		HistoryShardCount: 2,
+		HistoryShardCountMigration: &persistencespb.HistoryShardCountMigration{
+			TargetShardCount:  4,
+			MigratingShardIds: []int32{1},
+			MigratedShardIds:  []int32{2},
+		},
+	})
+	s.True(layout.IsMigrating())
+	s.Equal(int32(4), layout.MaxShardCount())
+
+	var stayed, moving, moved int
+	for i := 0; i < 100; i++ {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		sourceShardID := common.WorkflowIDToHistoryShard("namespace-id", workflowID, 2)
+		targetShardID := common.WorkflowIDToHistoryShard("namespace-id", workflowID, 4)
+
+		shardID, err := layout.WorkflowShardID(namespace.ID("namespace-id"), workflowID)
+		switch {
+		case sourceShardID == 2:
+			// migrated shard, served from target shard
+			s.NoError(err)
+			s.Equal(targetShardID, shardID)
+			moved++
+		case targetShardID == sourceShardID:
+			// migrating shard, workflow stays in source shard
+			s.NoError(err)
+			s.Equal(sourceShardID, shardID)
+			stayed++
+		default:
+			// migrating shard, workflow is leaving source shard
+			s.IsType(&serviceerror.Unavailable{}, err)
+			moving++
+		}
+	}
+	s.Positive(stayed)
+	s.Positive(moving)
+	s.Positive(moved)
+}
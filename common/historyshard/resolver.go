@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination resolver_mock.go
+
+package historyshard
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// LayoutRefreshInterval is the maximum age of the layout used by a Resolver, shard layout changes
+	// must wait at least this long before relying on all hosts having observed them.
+	LayoutRefreshInterval = 10 * time.Second
+
+	layoutRefreshTimeout = 5 * time.Second
+)
+
+type (
+	// Resolver maps workflows to history shards using the history shard layout stored in cluster
+	// metadata, so that it follows an ongoing history shard count migration. It must be used
+	// instead of common.WorkflowIDToHistoryShard to find the shard of a workflow.
+	Resolver interface {
+		// WorkflowShardID returns the ID of the shard serving the given workflow, or Unavailable if the
+		// workflow is being moved to another shard.
+		WorkflowShardID(namespaceID namespace.ID, workflowID string) (int32, error)
+		// Layout returns the cached history shard layout.
+		Layout() *Layout
+		// Refresh reloads the history shard layout from cluster metadata.
+		Refresh(ctx context.Context) (*Layout, error)
+	}
+
+	resolverImpl struct {
+		clusterMetadataManager persistence.ClusterMetadataManager
+		numberOfShards         int32
+		timeSource             clock.TimeSource
+		logger                 log.Logger
+
+		layout      atomic.Value // *Layout
+		refreshedAt int64
+		refreshing  int32
+	}
+
+	staticResolver struct {
+		layout *Layout
+	}
+)
+
+var _ Resolver = (*resolverImpl)(nil)
+var _ Resolver = (*staticResolver)(nil)
+
+// NewResolver creates a Resolver which reloads the layout from cluster metadata in the background once
+// it is older than LayoutRefreshInterval. numberOfShards is the shard count used until the layout is loaded.
+func NewResolver(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	numberOfShards int32,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) Resolver {
+	return &resolverImpl{
+		clusterMetadataManager: clusterMetadataManager,
+		numberOfShards:         numberOfShards,
+		timeSource:             timeSource,
+		logger:                 logger,
+	}
+}
+
+// NewStaticResolver creates a Resolver with a fixed layout of numberOfShards shards, for processes which
+// have no access to cluster metadata.
+func NewStaticResolver(numberOfShards int32) Resolver {
+	return &staticResolver{
+		layout: NewLayout(numberOfShards),
+	}
+}
+
+func (r *resolverImpl) WorkflowShardID(
+	namespaceID namespace.ID,
+	workflowID string,
+) (int32, error) {
+	return r.Layout().WorkflowShardID(namespaceID, workflowID)
+}
+
+func (r *resolverImpl) Layout() *Layout {
+	layout, ok := r.layout.Load().(*Layout)
+	if !ok {
+		// the first caller has to wait for the layout, as routing by shard count alone is wrong
+		// while a migration is in progress
+		ctx, cancel := context.WithTimeout(context.Background(), layoutRefreshTimeout)
+		defer cancel()
+		layout, err := r.Refresh(ctx)
+		if err != nil {
+			r.logger.Error("Unable to load history shard layout.", tag.Error(err))
+			return NewLayout(r.numberOfShards)
+		}
+		return layout
+	}
+
+	refreshedAt := time.Unix(0, atomic.LoadInt64(&r.refreshedAt))
+	if r.timeSource.Now().Sub(refreshedAt) >= LayoutRefreshInterval && atomic.CompareAndSwapInt32(&r.refreshing, 0, 1) {
+		go func() {
+			defer atomic.StoreInt32(&r.refreshing, 0)
+			ctx, cancel := context.WithTimeout(context.Background(), layoutRefreshTimeout)
+			defer cancel()
+			if _, err := r.Refresh(ctx); err != nil {
+				r.logger.Warn("Unable to refresh history shard layout.", tag.Error(err))
+			}
+		}()
+	}
+	return layout
+}
+
+func (r *resolverImpl) Refresh(ctx context.Context) (*Layout, error) {
+	resp, err := r.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	layout := NewLayoutFromClusterMetadata(&resp.ClusterMetadata)
+	r.layout.Store(layout)
+	atomic.StoreInt64(&r.refreshedAt, r.timeSource.Now().UnixNano())
+	return layout, nil
+}
+
+func (r *staticResolver) WorkflowShardID(
+	namespaceID namespace.ID,
+	workflowID string,
+) (int32, error) {
+	return r.layout.WorkflowShardID(namespaceID, workflowID)
+}
+
+func (r *staticResolver) Layout() *Layout {
+	return r.layout
+}
+
+func (r *staticResolver) Refresh(_ context.Context) (*Layout, error) {
+	return r.layout, nil
+}
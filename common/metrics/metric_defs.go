@@ -140,8 +140,40 @@ const (
 	AdminClientResendReplicationTasksScope = "AdminClientResendReplicationTasks"
 	// AdminClientGetTaskQueueTasksScope tracks RPC calls to admin service
 	AdminClientGetTaskQueueTasksScope = "AdminClientGetTaskQueueTasks"
+	// AdminClientDescribeTaskQueueBacklogScope tracks RPC calls to admin service
+	AdminClientDescribeTaskQueueBacklogScope = "AdminClientDescribeTaskQueueBacklog"
+	// AdminClientMoveTaskQueueTasksScope tracks RPC calls to admin service
+	AdminClientMoveTaskQueueTasksScope = "AdminClientMoveTaskQueueTasks"
+	// AdminClientPurgeTaskQueueTasksScope tracks RPC calls to admin service
+	AdminClientPurgeTaskQueueTasksScope = "AdminClientPurgeTaskQueueTasks"
+	// AdminClientListWorkersScope tracks RPC calls to admin service
+	AdminClientListWorkersScope = "AdminClientListWorkers"
+	// AdminClientUpdateBuildIdRampScope tracks RPC calls to admin service
+	AdminClientUpdateBuildIdRampScope = "AdminClientUpdateBuildIdRamp"
 	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
 	AdminClientDeleteWorkflowExecutionScope = "AdminClientDeleteWorkflowExecution"
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope = "AdminClientPauseWorkflowExecution"
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope = "AdminClientUnpauseWorkflowExecution"
+	// AdminClientUpdateActivityOptionsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityOptionsScope = "AdminClientUpdateActivityOptions"
+	// AdminClientExportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientExportWorkflowExecutionScope = "AdminClientExportWorkflowExecution"
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope = "AdminClientImportWorkflowExecution"
+	// AdminClientResetActivityScope tracks RPC calls to admin service
+	AdminClientResetActivityScope = "AdminClientResetActivity"
+	// AdminClientStartResetActivitiesBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartResetActivitiesBatchOperationScope = "AdminClientStartResetActivitiesBatchOperation"
+	// AdminClientListFaultInjectionScenariosScope tracks RPC calls to admin service
+	AdminClientListFaultInjectionScenariosScope = "AdminClientListFaultInjectionScenarios"
+	// AdminClientUpdateFaultInjectionScenarioScope tracks RPC calls to admin service
+	AdminClientUpdateFaultInjectionScenarioScope = "AdminClientUpdateFaultInjectionScenario"
+	// AdminClientStartHistoryShardCountMigrationScope tracks RPC calls to admin service
+	AdminClientStartHistoryShardCountMigrationScope = "AdminClientStartHistoryShardCountMigration"
+	// AdminClientCheckPersistenceConsistencyScope tracks RPC calls to admin service
+	AdminClientCheckPersistenceConsistencyScope = "AdminClientCheckPersistenceConsistency"
 
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHost
 	AdminDescribeHistoryHostScope = "AdminDescribeHistoryHost"
@@ -199,6 +231,8 @@ const (
 	AdminDeleteWorkflowExecutionScope = "AdminDeleteWorkflowExecution"
 	// AdminStreamWorkflowReplicationMessagesScope is the metric scope for admin.AdminStreamReplicationMessages
 	AdminStreamWorkflowReplicationMessagesScope = "AdminStreamWorkflowReplicationMessages"
+	// AdminStreamWorkflowExecutionHistoryScope is the metric scope for admin.AdminStreamWorkflowExecutionHistory
+	AdminStreamWorkflowExecutionHistoryScope = "AdminStreamWorkflowExecutionHistory"
 
 	// OperatorAddSearchAttributesScope is the metric scope for operator.AddSearchAttributes
 	OperatorAddSearchAttributesScope
@@ -381,6 +415,16 @@ const (
 	HistoryClientPollWorkflowExecutionUpdateScope = "HistoryClientPollPollWorkflowExecutionUpdate"
 	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientDeleteWorkflowExecutionScope = "HistoryClientDeleteWorkflowExecution"
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope = "HistoryClientPauseWorkflowExecution"
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope = "HistoryClientUnpauseWorkflowExecution"
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope = "HistoryClientUpdateActivityOptions"
+	// HistoryClientImportWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientImportWorkflowExecutionScope = "HistoryClientImportWorkflowExecution"
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope = "HistoryClientResetActivity"
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope = "HistoryClientResetWorkflowExecution"
 	// HistoryClientScheduleWorkflowTaskScope tracks RPC calls to history service
@@ -441,6 +485,16 @@ const (
 	HistoryClientGetReplicationMessagesScope = "HistoryClientGetReplicationMessages"
 	// HistoryClientStreamWorkflowReplicationMessagesScope tracks RPC calls to history service
 	HistoryClientStreamWorkflowReplicationMessagesScope = "HistoryClientStreamWorkflowReplicationMessages"
+	// HistoryClientStreamWorkflowExecutionHistoryScope tracks RPC calls to history service
+	HistoryClientStreamWorkflowExecutionHistoryScope = "HistoryClientStreamWorkflowExecutionHistory"
+	// HistoryClientListFaultInjectionScenariosScope tracks RPC calls to history service
+	HistoryClientListFaultInjectionScenariosScope = "HistoryClientListFaultInjectionScenarios"
+	// HistoryClientUpdateFaultInjectionScenarioScope tracks RPC calls to history service
+	HistoryClientUpdateFaultInjectionScenarioScope = "HistoryClientUpdateFaultInjectionScenario"
+	// HistoryClientMigrateHistoryShardScope tracks RPC calls to history service
+	HistoryClientMigrateHistoryShardScope = "HistoryClientMigrateHistoryShard"
+	// HistoryClientCopyMigratedExecutionScope tracks RPC calls to history service
+	HistoryClientCopyMigratedExecutionScope = "HistoryClientCopyMigratedExecution"
 )
 
 // Matching Client Operations
@@ -471,6 +525,16 @@ const (
 	MatchingClientInvalidateTaskQueueMetadataScope = "MatchingClientInvalidateTaskQueueMetadata"
 	// MatchingClientGetTaskQueueMetadataScope tracks RPC calls to matching service
 	MatchingClientGetTaskQueueMetadataScope = "MatchingClientGetTaskQueueMetadata"
+	// MatchingClientDescribeTaskQueueBacklogScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskQueueBacklogScope = "MatchingClientDescribeTaskQueueBacklog"
+	// MatchingClientMoveTaskQueueTasksScope tracks RPC calls to matching service
+	MatchingClientMoveTaskQueueTasksScope = "MatchingClientMoveTaskQueueTasks"
+	// MatchingClientPurgeTaskQueueTasksScope tracks RPC calls to matching service
+	MatchingClientPurgeTaskQueueTasksScope = "MatchingClientPurgeTaskQueueTasks"
+	// MatchingClientRecordActivityTaskClosedScope tracks RPC calls to matching service
+	MatchingClientRecordActivityTaskClosedScope = "MatchingClientRecordActivityTaskClosed"
+	// MatchingClientUpdateBuildIdRampScope tracks RPC calls to matching service
+	MatchingClientUpdateBuildIdRampScope = "MatchingClientUpdateBuildIdRamp"
 )
 
 // Worker
@@ -1104,6 +1168,16 @@ const (
 	MatchingInvalidateTaskQueueMetadataScope = "InvalidateTaskQueueMetadata"
 	// MatchingGetTaskQueueMetadataScope tracks GetWorkerBuildIdCompatibility API calls received by service
 	MatchingGetTaskQueueMetadataScope = "GetTaskQueueMetadata"
+	// MatchingDescribeTaskQueueBacklogScope tracks DescribeTaskQueueBacklog API calls received by service
+	MatchingDescribeTaskQueueBacklogScope = "DescribeTaskQueueBacklog"
+	// MatchingMoveTaskQueueTasksScope tracks MoveTaskQueueTasks API calls received by service
+	MatchingMoveTaskQueueTasksScope = "MoveTaskQueueTasks"
+	// MatchingPurgeTaskQueueTasksScope tracks PurgeTaskQueueTasks API calls received by service
+	MatchingPurgeTaskQueueTasksScope = "PurgeTaskQueueTasks"
+	// MatchingRecordActivityTaskClosedScope tracks RecordActivityTaskClosed API calls received by service
+	MatchingRecordActivityTaskClosedScope = "RecordActivityTaskClosed"
+	// MatchingUpdateBuildIdRampScope tracks UpdateBuildIdRamp API calls received by service
+	MatchingUpdateBuildIdRampScope = "UpdateBuildIdRamp"
 )
 
 // Worker Scope
@@ -1171,6 +1245,7 @@ const (
 	TaskTypeTransferActiveTaskStartChildExecution  = "TransferActiveTaskStartChildExecution"
 	TaskTypeTransferActiveTaskResetWorkflow        = "TransferActiveTaskResetWorkflow"
 	TaskTypeTransferActiveTaskDeleteExecution      = "TransferActiveTaskDeleteExecution"
+	TaskTypeTransferActiveTaskCompletionCallback   = "TransferActiveTaskCompletionCallback"
 	TaskTypeTransferStandbyTaskActivity            = "TransferStandbyTaskActivity"
 	TaskTypeTransferStandbyTaskWorkflowTask        = "TransferStandbyTaskWorkflowTask"
 	TaskTypeTransferStandbyTaskCloseExecution      = "TransferStandbyTaskCloseExecution"
@@ -1179,6 +1254,7 @@ const (
 	TaskTypeTransferStandbyTaskStartChildExecution = "TransferStandbyTaskStartChildExecution"
 	TaskTypeTransferStandbyTaskResetWorkflow       = "TransferStandbyTaskResetWorkflow"
 	TaskTypeTransferStandbyTaskDeleteExecution     = "TransferStandbyTaskDeleteExecution"
+	TaskTypeTransferStandbyTaskCompletionCallback  = "TransferStandbyTaskCompletionCallback"
 	TaskTypeVisibilityTaskStartExecution           = "VisibilityTaskStartExecution"
 	TaskTypeVisibilityTaskUpsertExecution          = "VisibilityTaskUpsertExecution"
 	TaskTypeVisibilityTaskCloseExecution           = "VisibilityTaskCloseExecution"
@@ -1191,6 +1267,7 @@ const (
 	TaskTypeTimerActiveTaskActivityRetryTimer      = "TimerActiveTaskActivityRetryTimer"
 	TaskTypeTimerActiveTaskWorkflowBackoffTimer    = "TimerActiveTaskWorkflowBackoffTimer"
 	TaskTypeTimerActiveTaskDeleteHistoryEvent      = "TimerActiveTaskDeleteHistoryEvent"
+	TaskTypeTimerActiveTaskCallbackBackoff         = "TimerActiveTaskCallbackBackoff"
 	TaskTypeTimerStandbyTaskActivityTimeout        = "TimerStandbyTaskActivityTimeout"
 	TaskTypeTimerStandbyTaskWorkflowTaskTimeout    = "TimerStandbyTaskWorkflowTaskTimeout"
 	TaskTypeTimerStandbyTaskUserTimer              = "TimerStandbyTaskUserTimer"
@@ -1198,6 +1275,7 @@ const (
 	TaskTypeTimerStandbyTaskActivityRetryTimer     = "TimerStandbyTaskActivityRetryTimer"
 	TaskTypeTimerStandbyTaskWorkflowBackoffTimer   = "TimerStandbyTaskWorkflowBackoffTimer"
 	TaskTypeTimerStandbyTaskDeleteHistoryEvent     = "TimerStandbyTaskDeleteHistoryEvent"
+	TaskTypeTimerStandbyTaskCallbackBackoff        = "TimerStandbyTaskCallbackBackoff"
 	TaskTypeMemoryScheduledTaskWorkflowTaskTimeout = "MemoryScheduledTaskWorkflowTaskTimeout"
 )
 
@@ -1274,6 +1352,8 @@ var (
 	CacheFailures                                = NewCounterDef("cache_errors")
 	CacheLatency                                 = NewTimerDef("cache_latency")
 	CacheMissCounter                             = NewCounterDef("cache_miss")
+	CacheEvictions                               = NewCounterDef("cache_evictions")
+	CacheUsage                                   = NewGaugeDef("cache_usage")
 	HistoryEventNotificationQueueingLatency      = NewTimerDef("history_event_notification_queueing_latency")
 	HistoryEventNotificationFanoutLatency        = NewTimerDef("history_event_notification_fanout_latency")
 	HistoryEventNotificationInFlightMessageGauge = NewGaugeDef("history_event_notification_inflight_message_gauge")
@@ -1330,6 +1410,8 @@ var (
 	TaskNamespaceHandoverCounter                      = NewCounterDef("task_errors_namespace_handover")
 	TaskThrottledCounter                              = NewCounterDef("task_errors_throttled")
 	TaskCorruptionCounter                             = NewCounterDef("task_errors_corruption")
+	TaskDLQEnqueued                                   = NewCounterDef("task_dlq_enqueued")
+	TaskDLQEnqueueFailures                            = NewCounterDef("task_dlq_enqueue_errors")
 	TaskScheduleToStartLatency                        = NewTimerDef("task_schedule_to_start_latency")
 	TransferTaskMissingEventCounter                   = NewCounterDef("transfer_task_missing_event_counter")
 	TaskBatchCompleteCounter                          = NewCounterDef("task_batch_complete_counter")
@@ -1500,6 +1582,7 @@ var (
 	ConditionFailedErrorPerTaskQueueCounter   = NewCounterDef("condition_failed_errors")
 	RespondQueryTaskFailedPerTaskQueueCounter = NewCounterDef("respond_query_failed")
 	SyncThrottlePerTaskQueueCounter           = NewCounterDef("sync_throttle_count")
+	PollThrottlePerTaskQueueCounter           = NewCounterDef("poll_throttle_count")
 	BufferThrottlePerTaskQueueCounter         = NewCounterDef("buffer_throttle_count")
 	ExpiredTasksPerTaskQueueCounter           = NewCounterDef("tasks_expired")
 	ForwardedPerTaskQueueCounter              = NewCounterDef("forwarded_per_tl")
@@ -1522,7 +1605,15 @@ var (
 	TaskWriteThrottlePerTaskQueueCounter      = NewCounterDef("task_write_throttle_count")
 	TaskWriteLatencyPerTaskQueue              = NewTimerDef("task_write_latency")
 	TaskLagPerTaskQueueGauge                  = NewGaugeDef("task_lag_per_tl")
+	FairnessKeyCountGauge                     = NewGaugeDef("fairness_key_count")
+	FairnessKeyBacklogGauge                   = NewGaugeDef("fairness_key_backlog")
+	FairnessKeyMaxBacklogGauge                = NewGaugeDef("fairness_key_max_backlog")
 	NoRecentPollerTasksPerTaskQueueCounter    = NewCounterDef("no_poller_tasks")
+	StickyWorkerSaturatedCounter              = NewCounterDef("sticky_worker_saturated")
+	StickyBacklogRedirectedCounter            = NewCounterDef("sticky_backlog_redirected")
+	BuildIdRampedWorkflowTasksCounter         = NewCounterDef("build_id_ramped_workflow_tasks")
+	BuildIdRampPercentageGauge                = NewGaugeDef("build_id_ramp_percentage")
+	BuildIdScavengerRemovedBuildIdsCounter    = NewCounterDef("build_id_scavenger_removed_build_ids")
 
 	// Worker
 	ExecutorTasksDoneCount                                    = NewCounterDef("executor_done")
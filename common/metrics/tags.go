@@ -41,16 +41,17 @@ const (
 	buildPlatformTag = "build_platform"
 	goVersionTag     = "go_version"
 
-	instance       = "instance"
-	namespace      = "namespace"
-	namespaceState = "namespace_state"
-	targetCluster  = "target_cluster"
-	taskQueue      = "taskqueue"
-	workflowType   = "workflowType"
-	activityType   = "activityType"
-	commandType    = "commandType"
-	serviceName    = "service_name"
-	actionType     = "action_type"
+	instance          = "instance"
+	namespace         = "namespace"
+	namespaceState    = "namespace_state"
+	targetCluster     = "target_cluster"
+	taskQueue         = "taskqueue"
+	fairnessKeyBucket = "fairness_key_bucket"
+	workflowType      = "workflowType"
+	activityType      = "activityType"
+	commandType       = "commandType"
+	serviceName       = "service_name"
+	actionType        = "action_type"
 	// Generic reason tag can be used anywhere a reason is needed.
 	reason = "reason"
 
@@ -145,6 +146,12 @@ func TaskQueueTag(value string) Tag {
 	return &tagImpl{key: taskQueue, value: sanitizer.Value(value)}
 }
 
+// FairnessKeyBucketTag returns a new tag for a bucket of fairness keys. Fairness keys are unbounded, so they are
+// hashed to a bounded number of buckets.
+func FairnessKeyBucketTag(bucket int) Tag {
+	return &tagImpl{key: fairnessKeyBucket, value: strconv.Itoa(bucket)}
+}
+
 func TaskQueueTypeTag(tqType enumspb.TaskQueueType) Tag {
 	return &tagImpl{key: TaskTypeTagName, value: tqType.String()}
 }
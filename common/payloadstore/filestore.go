@@ -0,0 +1,164 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+)
+
+var (
+	errEmptyDirectoryPath = errors.New("payload store directory path is empty")
+	errInvalidFileMode    = errors.New("invalid file mode")
+	errInvalidDirMode     = errors.New("invalid directory mode")
+)
+
+type (
+	filestore struct {
+		root     string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+var _ Store = (*filestore)(nil)
+
+// NewFilestore creates a Store which keeps payloads as files under the given directory
+func NewFilestore(
+	root string,
+	config *config.FilestoreArchiver,
+) (Store, error) {
+	if len(root) == 0 {
+		return nil, errEmptyDirectoryPath
+	}
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	return &filestore{
+		root:     filepath.Clean(root),
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}, nil
+}
+
+func (s *filestore) Put(
+	_ context.Context,
+	key string,
+	data []byte,
+) (retErr error) {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, s.dirMode); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so readers never see a partially written payload
+	f, err := os.CreateTemp(dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if err := f.Chmod(s.fileMode); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *filestore) Get(
+	_ context.Context,
+	key string,
+) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec path is validated to be within the store root
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrPayloadNotFound
+	}
+	return data, err
+}
+
+func (s *filestore) Delete(
+	_ context.Context,
+	key string,
+) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *filestore) DeletePrefix(
+	_ context.Context,
+	prefix string,
+) error {
+	if !strings.HasSuffix(prefix, "/") {
+		return errInvalidKey
+	}
+	dir, err := s.path(strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+func (s *filestore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
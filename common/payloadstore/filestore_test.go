@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	filestoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		root  string
+		store Store
+	}
+)
+
+func TestFilestoreSuite(t *testing.T) {
+	suite.Run(t, new(filestoreSuite))
+}
+
+func (s *filestoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.root = s.T().TempDir()
+
+	store, err := NewFilestore(s.root, &config.FilestoreArchiver{
+		FileMode: "0666",
+		DirMode:  "0766",
+	})
+	s.NoError(err)
+	s.store = store
+}
+
+func (s *filestoreSuite) TestNewFilestore_InvalidConfig() {
+	_, err := NewFilestore("", &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.Equal(errEmptyDirectoryPath, err)
+	_, err = NewFilestore(s.root, &config.FilestoreArchiver{FileMode: "invalid", DirMode: "0766"})
+	s.Equal(errInvalidFileMode, err)
+	_, err = NewFilestore(s.root, &config.FilestoreArchiver{FileMode: "0666", DirMode: "invalid"})
+	s.Equal(errInvalidDirMode, err)
+}
+
+func (s *filestoreSuite) TestPutGet() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, "namespace/workflow/payload", []byte("data")))
+
+	data, err := s.store.Get(ctx, "namespace/workflow/payload")
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+
+	_, err = s.store.Get(ctx, "namespace/workflow/other-payload")
+	s.Equal(ErrPayloadNotFound, err)
+}
+
+func (s *filestoreSuite) TestInvalidKey() {
+	ctx := context.Background()
+	for _, key := range []string{"", "/etc/passwd", "namespace/../../etc/passwd", `namespace\workflow`} {
+		s.Equal(errInvalidKey, s.store.Put(ctx, key, []byte("data")), key)
+		_, err := s.store.Get(ctx, key)
+		s.Equal(errInvalidKey, err, key)
+	}
+	s.Equal(errInvalidKey, s.store.Delete(ctx, "namespace/../payload"))
+	s.Equal(errInvalidKey, s.store.DeletePrefix(ctx, "namespace/.."))
+}
+
+func (s *filestoreSuite) TestDelete() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, "namespace/workflow/payload", []byte("data")))
+
+	s.NoError(s.store.Delete(ctx, "namespace/workflow/payload"))
+	_, err := s.store.Get(ctx, "namespace/workflow/payload")
+	s.Equal(ErrPayloadNotFound, err)
+
+	// deleting a payload which does not exist is a no-op
+	s.NoError(s.store.Delete(ctx, "namespace/workflow/payload"))
+}
+
+func (s *filestoreSuite) TestDeletePrefix() {
+	ctx := context.Background()
+	s.NoError(s.store.Put(ctx, "namespace/workflow/run/payload", []byte("data")))
+	s.NoError(s.store.Put(ctx, "namespace/workflow/other-run/payload", []byte("other")))
+
+	s.NoError(s.store.DeletePrefix(ctx, "namespace/workflow/run/"))
+	_, err := s.store.Get(ctx, "namespace/workflow/run/payload")
+	s.Equal(ErrPayloadNotFound, err)
+	_, err = os.Stat(filepath.Join(s.root, "namespace", "workflow", "run"))
+	s.True(os.IsNotExist(err))
+	_, err = s.store.Get(ctx, "namespace/workflow/other-run/payload")
+	s.NoError(err)
+
+	// deleting payloads of a run which never offloaded any is a no-op
+	s.NoError(s.store.DeletePrefix(ctx, "namespace/workflow/unknown-run/"))
+}
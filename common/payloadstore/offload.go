@@ -0,0 +1,404 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// ReferenceEncoding is the encoding of payloads which reference an offloaded payload.
+	// The data of such a payload is the key the original payload is stored under.
+	ReferenceEncoding = "temporal/offloaded-payload"
+
+	metadataEncoding = "encoding"
+)
+
+var (
+	errReservedEncoding = serviceerror.NewInvalidArgument(fmt.Sprintf("Payload encoding %q is reserved.", ReferenceEncoding))
+
+	serializer = serialization.NewSerializer()
+)
+
+// RejectReferences returns an error if msg contains a payload with the reserved reference encoding.
+// References are only created by the server, clients must never be able to supply them.
+func RejectReferences(
+	ctx context.Context,
+	msg proto.Message,
+) error {
+	if hasReference(ctx, msg) {
+		return errReservedEncoding
+	}
+	return nil
+}
+
+// RejectMutableStateReferences returns an error if the memo, the activities or the buffered events of a
+// mutable state contain a payload with the reserved reference encoding, see RejectReferences.
+func RejectMutableStateReferences(
+	ctx context.Context,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	msgs := mutableStatePayloads(state.GetExecutionInfo(), state.GetActivityInfos())
+	msgs = append(msgs, &historypb.History{Events: state.GetBufferedEvents()})
+	for _, msg := range msgs {
+		if err := RejectReferences(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Offload writes every payload of msg larger than threshold bytes to the store and replaces it with a reference.
+// Search attributes are never offloaded as they must be readable by visibility.
+//
+// Payloads are staged until history claims them for the run persisting them, the returned keys of the staged
+// payloads must be deleted once the request completes, even if Offload fails.
+func Offload(
+	ctx context.Context,
+	store Store,
+	msg proto.Message,
+	namespaceID namespace.ID,
+	threshold int,
+) ([]string, error) {
+	var keys []string
+	err := proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(ctx *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := make([]*commonpb.Payload, len(payloads))
+			for i, payload := range payloads {
+				if payload.Size() <= threshold {
+					result[i] = payload
+					continue
+				}
+				data, err := payload.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				key := stagedKeyPrefix(namespaceID) + uuid.New()
+				keys = append(keys, key)
+				if err := store.Put(ctx, key, data); err != nil {
+					return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to offload payload: %v", err))
+				}
+				result[i] = newReference(key)
+			}
+			return result, nil
+		},
+	})
+	return keys, err
+}
+
+// Claim copies every offloaded payload referenced by msg which is not owned by the given run under the key
+// prefix of the run and updates the references in place, so that the payloads of a run can be deleted with it.
+// This covers payloads staged by the frontend as well as payloads carried over from another run, e.g. the
+// input of a retry, cron or continue as new run, or of a child workflow. Messages shared with another run
+// must be copied before they are claimed.
+func Claim(
+	ctx context.Context,
+	store Store,
+	msg proto.Message,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+) error {
+	prefix := RunKeyPrefix(namespaceID, workflowID, runID)
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(ctx *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for i, payload := range payloads {
+				if !isUnclaimedReference(payload, prefix) {
+					continue
+				}
+				// references are only created by the server, those of another namespace are carried over
+				// from a parent or a signaling workflow
+				data, err := getAny(ctx, store, string(payload.GetData()))
+				if err != nil {
+					return nil, err
+				}
+				key := prefix + uuid.New()
+				if err := store.Put(ctx, key, data); err != nil {
+					return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to offload payload: %v", err))
+				}
+				payloads[i] = newReference(key)
+			}
+			return payloads, nil
+		},
+	})
+}
+
+// NeedsClaim returns whether msg references offloaded payloads which are not owned by the given run.
+func NeedsClaim(
+	ctx context.Context,
+	msg proto.Message,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+) bool {
+	prefix := RunKeyPrefix(namespaceID, workflowID, runID)
+	found := false
+	_ = proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for _, payload := range payloads {
+				found = found || isUnclaimedReference(payload, prefix)
+			}
+			return payloads, nil
+		},
+	})
+	return found
+}
+
+// ClaimMutableState claims the offloaded payloads of the memo and of the given activities of a run, see Claim.
+// Payloads are claimed on copies which replace the originals within the mutable state, as they may be shared
+// with the events of the run or of another run.
+func ClaimMutableState(
+	ctx context.Context,
+	store Store,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	runID string,
+	activityInfos map[int64]*persistencespb.ActivityInfo,
+) error {
+	namespaceID := namespace.ID(executionInfo.GetNamespaceId())
+	workflowID := executionInfo.GetWorkflowId()
+	claim := func(msg proto.Message) (proto.Message, error) {
+		if !NeedsClaim(ctx, msg, namespaceID, workflowID, runID) {
+			return msg, nil
+		}
+		msg = proto.Clone(msg)
+		if err := Claim(ctx, store, msg, namespaceID, workflowID, runID); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+
+	if len(executionInfo.GetMemo()) > 0 {
+		memo, err := claim(&commonpb.Memo{Fields: executionInfo.GetMemo()})
+		if err != nil {
+			return err
+		}
+		executionInfo.Memo = memo.(*commonpb.Memo).GetFields()
+	}
+	for _, activityInfo := range activityInfos {
+		if activityInfo.GetLastHeartbeatDetails() != nil {
+			details, err := claim(activityInfo.GetLastHeartbeatDetails())
+			if err != nil {
+				return err
+			}
+			activityInfo.LastHeartbeatDetails = details.(*commonpb.Payloads)
+		}
+		if activityInfo.GetRetryLastFailure() != nil {
+			failure, err := claim(activityInfo.GetRetryLastFailure())
+			if err != nil {
+				return err
+			}
+			activityInfo.RetryLastFailure = failure.(*failurepb.Failure)
+		}
+	}
+	return nil
+}
+
+// Resolve replaces every reference to an offloaded payload within msg with the payload it references.
+// References to payloads of other namespaces are rejected.
+func Resolve(
+	ctx context.Context,
+	store Store,
+	msg proto.Message,
+	namespaceID namespace.ID,
+) error {
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(ctx *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			var result []*commonpb.Payload
+			for i, payload := range payloads {
+				if !IsReference(payload) {
+					if result != nil {
+						result[i] = payload
+					}
+					continue
+				}
+				if result == nil {
+					result = make([]*commonpb.Payload, len(payloads))
+					copy(result, payloads[:i])
+				}
+				data, err := get(ctx, store, namespaceID, string(payload.GetData()))
+				if err != nil {
+					return nil, err
+				}
+				resolved := &commonpb.Payload{}
+				if err := resolved.Unmarshal(data); err != nil {
+					return nil, serviceerror.NewInternal(fmt.Sprintf("Unable to decode offloaded payload %q: %v", payload.GetData(), err))
+				}
+				result[i] = resolved
+			}
+			if result == nil {
+				return payloads, nil
+			}
+			return result, nil
+		},
+	})
+}
+
+// ResolveHistory resolves the offloaded payloads of encoded history batches, re-encoding only the batches
+// which contain references.
+func ResolveHistory(
+	ctx context.Context,
+	store Store,
+	blobs []*commonpb.DataBlob,
+	namespaceID namespace.ID,
+) error {
+	for _, blob := range blobs {
+		events, err := serializer.DeserializeEvents(blob)
+		if err != nil {
+			return err
+		}
+		history := &historypb.History{Events: events}
+		if !hasReference(ctx, history) {
+			continue
+		}
+		if err := Resolve(ctx, store, history, namespaceID); err != nil {
+			return err
+		}
+		resolved, err := serializer.SerializeEvents(history.Events, blob.GetEncodingType())
+		if err != nil {
+			return err
+		}
+		blob.Data = resolved.Data
+	}
+	return nil
+}
+
+// ResolveMutableState resolves the offloaded payloads of the memo and of the activities of a mutable state.
+func ResolveMutableState(
+	ctx context.Context,
+	store Store,
+	state *persistencespb.WorkflowMutableState,
+) error {
+	namespaceID := namespace.ID(state.GetExecutionInfo().GetNamespaceId())
+	for _, msg := range mutableStatePayloads(state.GetExecutionInfo(), state.GetActivityInfos()) {
+		if err := Resolve(ctx, store, msg, namespaceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isUnclaimedReference(payload *commonpb.Payload, prefix string) bool {
+	return IsReference(payload) && !strings.HasPrefix(string(payload.GetData()), prefix)
+}
+
+// IsReference returns whether the payload is a reference to an offloaded payload.
+func IsReference(payload *commonpb.Payload) bool {
+	return string(payload.GetMetadata()[metadataEncoding]) == ReferenceEncoding
+}
+
+func newReference(key string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncoding: []byte(ReferenceEncoding),
+		},
+		Data: []byte(key),
+	}
+}
+
+func hasReference(ctx context.Context, msg proto.Message) bool {
+	found := false
+	_ = proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for _, payload := range payloads {
+				found = found || IsReference(payload)
+			}
+			return payloads, nil
+		},
+	})
+	return found
+}
+
+// mutableStatePayloads returns the messages of a mutable state which hold payloads that may be offloaded.
+// The memo is wrapped in a message sharing its fields, so that updates of its payloads apply to the mutable state.
+func mutableStatePayloads(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	activityInfos map[int64]*persistencespb.ActivityInfo,
+) []proto.Message {
+	var msgs []proto.Message
+	if len(executionInfo.GetMemo()) > 0 {
+		msgs = append(msgs, &commonpb.Memo{Fields: executionInfo.GetMemo()})
+	}
+	for _, activityInfo := range activityInfos {
+		if activityInfo.GetLastHeartbeatDetails() != nil {
+			msgs = append(msgs, activityInfo.GetLastHeartbeatDetails())
+		}
+		if activityInfo.GetRetryLastFailure() != nil {
+			msgs = append(msgs, activityInfo.GetRetryLastFailure())
+		}
+	}
+	return msgs
+}
+
+// get reads the encoded payload a reference of the given namespace points to
+func get(
+	ctx context.Context,
+	store Store,
+	namespaceID namespace.ID,
+	key string,
+) ([]byte, error) {
+	if !strings.HasPrefix(key, namespaceID.String()+"/") {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid offloaded payload reference %q.", key))
+	}
+	return getAny(ctx, store, key)
+}
+
+// getAny reads the encoded payload a reference of any namespace points to
+func getAny(
+	ctx context.Context,
+	store Store,
+	key string,
+) ([]byte, error) {
+	if validateKey(key) != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid offloaded payload reference %q.", key))
+	}
+	data, err := store.Get(ctx, key)
+	switch err {
+	case nil:
+		return data, nil
+	case ErrPayloadNotFound:
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Offloaded payload %q not found, it may have been deleted with its workflow.", key))
+	default:
+		return nil, serviceerror.NewUnavailable(fmt.Sprintf("Unable to read offloaded payload: %v", err))
+	}
+}
@@ -0,0 +1,232 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	testNamespaceID = namespace.ID("0a5b4c35-6d59-4c6f-9c1c-7c2d3d7b1c42")
+	testWorkflowID  = "workflow-id"
+	testRunID       = "3f6b1c1e-2b7d-4a55-9a8e-0d6f3c2e9b11"
+	testThreshold   = 1024
+)
+
+type (
+	offloadSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		store Store
+	}
+)
+
+func TestOffloadSuite(t *testing.T) {
+	suite.Run(t, new(offloadSuite))
+}
+
+func (s *offloadSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	store, err := NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{
+		FileMode: "0666",
+		DirMode:  "0766",
+	})
+	s.NoError(err)
+	s.store = store
+}
+
+func (s *offloadSuite) TestOffloadResolve() {
+	ctx := context.Background()
+	small := payload.EncodeString("small")
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2*testThreshold))
+	searchAttribute := payload.EncodeBytes(bytes.Repeat([]byte{2}, 2*testThreshold))
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		WorkflowId: testWorkflowID,
+		Input:      &commonpb.Payloads{Payloads: []*commonpb.Payload{small, large}},
+		Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"memo": large,
+		}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"attribute": searchAttribute,
+		}},
+	}
+	original := &workflowservice.StartWorkflowExecutionRequest{}
+	s.NoError(original.Unmarshal(s.marshal(request)))
+
+	keys, err := Offload(ctx, s.store, request, testNamespaceID, testThreshold)
+	s.NoError(err)
+	s.Len(keys, 2)
+	s.Equal(small, request.Input.Payloads[0])
+	s.True(IsReference(request.Input.Payloads[1]))
+	s.True(IsReference(request.Memo.Fields["memo"]))
+	s.Equal(searchAttribute, request.SearchAttributes.IndexedFields["attribute"])
+
+	s.NoError(Resolve(ctx, s.store, request, testNamespaceID))
+	s.Equal(original, request)
+}
+
+func (s *offloadSuite) TestRejectReferences() {
+	ctx := context.Background()
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("input")}},
+	}
+	s.NoError(RejectReferences(ctx, request))
+
+	request.Input.Payloads = append(request.Input.Payloads, newReference(RunKeyPrefix(testNamespaceID, "other", testRunID)+"key"))
+	s.IsType(&serviceerror.InvalidArgument{}, RejectReferences(ctx, request))
+}
+
+func (s *offloadSuite) TestClaim() {
+	ctx := context.Background()
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2*testThreshold))
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{large}},
+	}
+	stagedKeys, err := Offload(ctx, s.store, request, testNamespaceID, testThreshold)
+	s.NoError(err)
+	s.True(NeedsClaim(ctx, request, testNamespaceID, testWorkflowID, testRunID))
+
+	s.NoError(Claim(ctx, s.store, request, testNamespaceID, testWorkflowID, testRunID))
+	s.False(NeedsClaim(ctx, request, testNamespaceID, testWorkflowID, testRunID))
+	key := string(request.Input.Payloads[0].GetData())
+	s.True(strings.HasPrefix(key, RunKeyPrefix(testNamespaceID, testWorkflowID, testRunID)))
+
+	// the claimed payload outlives the staged one and is deleted with the run
+	s.NoError(s.store.Delete(ctx, stagedKeys[0]))
+	resolved := &workflowservice.SignalWorkflowExecutionRequest{}
+	s.NoError(resolved.Unmarshal(s.marshal(request)))
+	s.NoError(Resolve(ctx, s.store, resolved, testNamespaceID))
+	s.Equal(large, resolved.Input.Payloads[0])
+
+	s.NoError(s.store.DeletePrefix(ctx, RunKeyPrefix(testNamespaceID, testWorkflowID, testRunID)))
+	s.IsType(&serviceerror.NotFound{}, Resolve(ctx, s.store, request, testNamespaceID))
+}
+
+func (s *offloadSuite) TestClaimMutableState() {
+	ctx := context.Background()
+	newLarge := func() *commonpb.Payload {
+		return payload.EncodeBytes(bytes.Repeat([]byte{1}, 2*testThreshold))
+	}
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"memo": newLarge()}}
+	_, err := Offload(ctx, s.store, memo, testNamespaceID, testThreshold)
+	s.NoError(err)
+	details := &commonpb.Payloads{Payloads: []*commonpb.Payload{newLarge()}}
+	_, err = Offload(ctx, s.store, details, testNamespaceID, testThreshold)
+	s.NoError(err)
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: testNamespaceID.String(),
+		WorkflowId:  testWorkflowID,
+		Memo:        memo.Fields,
+	}
+	activityInfos := map[int64]*persistencespb.ActivityInfo{5: {LastHeartbeatDetails: details}}
+	s.NoError(ClaimMutableState(ctx, s.store, executionInfo, testRunID, activityInfos))
+
+	prefix := RunKeyPrefix(testNamespaceID, testWorkflowID, testRunID)
+	s.True(strings.HasPrefix(string(executionInfo.Memo["memo"].GetData()), prefix))
+	s.True(strings.HasPrefix(string(activityInfos[5].LastHeartbeatDetails.Payloads[0].GetData()), prefix))
+	// the originals may be shared with another run and are left untouched
+	s.False(strings.HasPrefix(string(memo.Fields["memo"].GetData()), prefix))
+	s.False(strings.HasPrefix(string(details.Payloads[0].GetData()), prefix))
+
+	s.NoError(ResolveMutableState(ctx, s.store, &persistencespb.WorkflowMutableState{
+		ExecutionInfo: executionInfo,
+		ActivityInfos: activityInfos,
+	}))
+	s.Equal(newLarge(), executionInfo.Memo["memo"])
+	s.Equal(newLarge(), activityInfos[5].LastHeartbeatDetails.Payloads[0])
+}
+
+func (s *offloadSuite) TestResolve_InvalidReference() {
+	otherNamespaceID := namespace.ID("6c0b9b0a-4b5f-4a3e-8d55-3c1c1b9f4c2a")
+	for _, key := range []string{
+		RunKeyPrefix(otherNamespaceID, testWorkflowID, testRunID) + "key",
+		testNamespaceID.String() + "/../" + otherNamespaceID.String() + "/key",
+	} {
+		response := &workflowservice.PollActivityTaskQueueResponse{
+			Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{newReference(key)}},
+		}
+		err := Resolve(context.Background(), s.store, response, testNamespaceID)
+		s.IsType(&serviceerror.InvalidArgument{}, err, key)
+	}
+}
+
+func (s *offloadSuite) TestResolve_NotFound() {
+	response := &workflowservice.PollActivityTaskQueueResponse{
+		Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{newReference(RunKeyPrefix(testNamespaceID, testWorkflowID, testRunID) + "key")}},
+	}
+	err := Resolve(context.Background(), s.store, response, testNamespaceID)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *offloadSuite) TestResolveHistory() {
+	ctx := context.Background()
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2*testThreshold))
+	history := &historypb.History{Events: []*historypb.HistoryEvent{{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{large}},
+			},
+		},
+	}}}
+	offloaded := &historypb.History{}
+	s.NoError(offloaded.Unmarshal(s.marshal(history)))
+	_, err := Offload(ctx, s.store, offloaded, testNamespaceID, testThreshold)
+	s.NoError(err)
+	untouched := &historypb.History{Events: []*historypb.HistoryEvent{{EventId: 2}}}
+
+	blobs := []*commonpb.DataBlob{
+		{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: s.marshal(offloaded)},
+		{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: s.marshal(untouched)},
+	}
+	s.NoError(ResolveHistory(ctx, s.store, blobs, testNamespaceID))
+	s.Equal(s.marshal(history), blobs[0].Data)
+	s.Equal(s.marshal(untouched), blobs[1].Data)
+}
+
+func (s *offloadSuite) marshal(msg interface{ Marshal() ([]byte, error) }) []byte {
+	data, err := msg.Marshal()
+	s.NoError(err)
+	return data
+}
@@ -0,0 +1,191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// deleteObjectsBatchSize is the maximum number of keys S3 accepts in one DeleteObjects call
+	deleteObjectsBatchSize = 1000
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified for payload store")
+	errEmptyAwsRegion    = errors.New("empty aws region")
+)
+
+type (
+	s3store struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+var _ Store = (*s3store)(nil)
+
+// NewS3store creates a Store which keeps payloads as objects of an S3 compatible bucket, optionally under a key prefix
+func NewS3store(
+	bucket string,
+	prefix string,
+	config *config.S3Archiver,
+) (Store, error) {
+	if len(bucket) == 0 {
+		return nil, errNoBucketSpecified
+	}
+	if len(config.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         config.Endpoint,
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newS3store(s3.New(sess), bucket, prefix), nil
+}
+
+func newS3store(
+	s3cli s3iface.S3API,
+	bucket string,
+	prefix string,
+) *s3store {
+	if len(prefix) != 0 {
+		prefix += "/"
+	}
+	return &s3store{
+		s3cli:  s3cli,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (s *s3store) Put(
+	ctx context.Context,
+	key string,
+	data []byte,
+) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	_, err := s.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *s3store) Get(
+	ctx context.Context,
+	key string,
+) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	result, err := s.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrPayloadNotFound
+		}
+		return nil, err
+	}
+	defer func() { _ = result.Body.Close() }()
+	return io.ReadAll(result.Body)
+}
+
+func (s *s3store) Delete(
+	ctx context.Context,
+	key string,
+) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	// deleting a missing object succeeds
+	_, err := s.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+	return err
+}
+
+func (s *s3store) DeletePrefix(
+	ctx context.Context,
+	prefix string,
+) error {
+	if err := validateKey(prefix); err != nil {
+		return err
+	}
+
+	var objects []*s3.ObjectIdentifier
+	err := s.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.prefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(objects) > 0 {
+		batch := objects
+		if len(batch) > deleteObjectsBatchSize {
+			batch = batch[:deleteObjectsBatchSize]
+		}
+		objects = objects[len(batch):]
+		if _, err := s.s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &s3.Delete{
+				Objects: batch,
+				Quiet:   aws.Bool(true),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
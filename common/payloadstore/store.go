@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination store_mock.go
+
+package payloadstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// FilestoreURIScheme is the URI scheme of the filesystem store
+	FilestoreURIScheme = "file"
+	// S3storeURIScheme is the URI scheme of the S3 compatible store
+	S3storeURIScheme = "s3"
+
+	stagedKeyDir = "staged"
+)
+
+var (
+	// ErrPayloadNotFound is returned by Get when no payload is stored under the key
+	ErrPayloadNotFound = errors.New("offloaded payload not found")
+
+	errInvalidKey = errors.New("invalid offloaded payload key")
+)
+
+type (
+	// Store is the external blob store large payloads are offloaded to.
+	// Keys are slash separated paths, see RunKeyPrefix.
+	Store interface {
+		Put(ctx context.Context, key string, data []byte) error
+		Get(ctx context.Context, key string) ([]byte, error)
+		// Delete deletes the blob stored under key, it is not an error if there is none.
+		Delete(ctx context.Context, key string) error
+		// DeletePrefix deletes every blob whose key starts with prefix.
+		DeletePrefix(ctx context.Context, prefix string) error
+	}
+)
+
+// NewStore creates the Store described by cfg. It returns nil if cfg is nil, i.e. payload offloading is not configured.
+func NewStore(cfg *config.PayloadStore) (Store, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	uri, err := url.Parse(cfg.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload store URI %q: %w", cfg.URI, err)
+	}
+	switch uri.Scheme {
+	case FilestoreURIScheme:
+		if cfg.Filestore == nil {
+			return nil, errors.New("filestore config is required for payload store with file scheme")
+		}
+		return NewFilestore(uri.Path, cfg.Filestore)
+	case S3storeURIScheme:
+		if cfg.S3store == nil {
+			return nil, errors.New("s3store config is required for payload store with s3 scheme")
+		}
+		return NewS3store(uri.Host, strings.Trim(uri.Path, "/"), cfg.S3store)
+	default:
+		return nil, fmt.Errorf("unsupported payload store URI scheme %q", uri.Scheme)
+	}
+}
+
+// RunKeyPrefix returns the prefix of the keys of the payloads owned by a run, which are deleted with the run.
+// Workflow IDs are arbitrary strings, so they are hashed to keep keys valid file names and object keys.
+func RunKeyPrefix(namespaceID namespace.ID, workflowID string, runID string) string {
+	hash := sha256.Sum256([]byte(workflowID))
+	return namespaceID.String() + "/" + hex.EncodeToString(hash[:]) + "/" + runID + "/"
+}
+
+// stagedKeyPrefix returns the prefix of the keys of payloads offloaded by the frontend for a request. Staged
+// payloads are copied under the key prefix of the run when history persists them, and are deleted once the
+// request completes.
+func stagedKeyPrefix(namespaceID namespace.ID) string {
+	return namespaceID.String() + "/" + stagedKeyDir + "/"
+}
+
+// validateKey makes sure a key can't escape the root of the store, as keys of references are read from payloads
+// supplied by users.
+func validateKey(key string) error {
+	if len(key) == 0 || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "." || part == ".." {
+			return errInvalidKey
+		}
+	}
+	return nil
+}
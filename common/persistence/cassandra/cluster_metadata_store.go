@@ -301,7 +301,7 @@ func (m *ClusterMetadataStore) PruneClusterMembership(
 }
 
 func (m *ClusterMetadataStore) GetName() string {
-	return cassandraPersistenceName
+	return CassandraPersistenceName
 }
 
 func (m *ClusterMetadataStore) Close() {
@@ -181,7 +181,7 @@ func (d *ExecutionStore) ConflictResolveWorkflowExecution(
 }
 
 func (d *ExecutionStore) GetName() string {
-	return cassandraPersistenceName
+	return CassandraPersistenceName
 }
 
 func (d *ExecutionStore) Close() {
@@ -32,7 +32,7 @@ import (
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
 )
 
-const cassandraPersistenceName = "cassandra"
+const CassandraPersistenceName = "cassandra"
 
 // CreateCassandraKeyspace creates the keyspace using this session for given replica count
 func CreateCassandraKeyspace(s gocql.Session, keyspace string, replicas int, overwrite bool, logger log.Logger) (err error) {
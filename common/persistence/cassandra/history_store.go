@@ -33,6 +33,7 @@ import (
 	"go.temporal.io/server/common/log"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 )
 
@@ -115,7 +116,7 @@ func (h *HistoryStore) AppendHistoryNodes(
 		request.Node.PrevTransactionID,
 		request.Node.TransactionID,
 		request.Node.Events.Data,
-		request.Node.Events.EncodingType.String(),
+		serialization.EncodingTypeToString(request.Node.Events.EncodingType),
 	).WithContext(ctx)
 	if err := query.Exec(); err != nil {
 		return convertTimeoutError(gocql.ConvertError("AppendHistoryNodes", err))
@@ -495,7 +495,7 @@ func (d *MatchingTaskStore) CompleteTasksLessThan(
 }
 
 func (d *MatchingTaskStore) GetName() string {
-	return cassandraPersistenceName
+	return CassandraPersistenceName
 }
 
 func (d *MatchingTaskStore) Close() {
@@ -476,7 +476,7 @@ func (m *MetadataStore) deleteNamespace(ctx context.Context, name string, ID []b
 }
 
 func (m *MetadataStore) GetName() string {
-	return cassandraPersistenceName
+	return CassandraPersistenceName
 }
 
 func (m *MetadataStore) Close() {
@@ -188,7 +188,7 @@ func (d *ShardStore) AssertShardOwnership(
 }
 
 func (d *ShardStore) GetName() string {
-	return cassandraPersistenceName
+	return CassandraPersistenceName
 }
 
 func (d *ShardStore) GetClusterName() string {
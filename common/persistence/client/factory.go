@@ -57,6 +57,8 @@ type (
 		NewExecutionManager() (p.ExecutionManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewHistoryTaskDLQ returns a new DLQ for the history tasks which kept failing
+		NewHistoryTaskDLQ() (p.HistoryTaskDLQ, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 	}
@@ -204,6 +206,25 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsHandler, f.logger)
 }
 
+func (f *factoryImpl) NewHistoryTaskDLQ() (p.HistoryTaskDLQ, error) {
+	newQueue := func(queueType p.QueueType) (p.Queue, error) {
+		result, err := f.dataStoreFactory.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.ratelimiter != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+		}
+		if f.metricsHandler != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.logger)
+		}
+		result = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
+		return result, nil
+	}
+	return p.NewHistoryTaskDLQ(newQueue, f.serializer), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
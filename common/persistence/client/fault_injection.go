@@ -27,11 +27,13 @@ package client
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -41,13 +43,16 @@ type (
 		baseFactory    DataStoreFactory
 		config         *config.FaultInjection
 		ErrorGenerator ErrorGenerator
+		Scenarios      *FaultInjectionScenarios
 
 		TaskStore      *FaultInjectionTaskStore
 		ShardStore     *FaultInjectionShardStore
 		MetadataStore  *FaultInjectionMetadataStore
 		ExecutionStore *FaultInjectionExecutionStore
-		Queue          *FaultInjectionQueue
 		ClusterMDStore *FaultInjectionClusterMetadataStore
+
+		queuesLock sync.Mutex
+		Queues     map[persistence.QueueType]*FaultInjectionQueue
 	}
 
 	FaultInjectionShardStore struct {
@@ -122,10 +127,19 @@ func NewFaultInjectionDatastoreFactory(
 			},
 		},
 	)
+	scenarios := NewFaultInjectionScenarios(config.Scenarios, clock.NewRealTimeSource())
+	if len(config.Scenarios) != 0 {
+		baseFactory = &interceptedDataStoreFactory{
+			baseFactory: baseFactory,
+			interceptor: scenarios,
+		}
+	}
 	return &FaultInjectionDataStoreFactory{
 		baseFactory:    baseFactory,
 		config:         config,
 		ErrorGenerator: errorGenerator,
+		Scenarios:      scenarios,
+		Queues:         make(map[persistence.QueueType]*FaultInjectionQueue),
 	}
 }
 
@@ -135,12 +149,27 @@ func (d *FaultInjectionDataStoreFactory) Close() {
 
 func (d *FaultInjectionDataStoreFactory) UpdateRate(rate float64) {
 	d.ErrorGenerator.UpdateRate(rate)
-	d.TaskStore.UpdateRate(rate)
-	d.ShardStore.UpdateRate(rate)
-	d.MetadataStore.UpdateRate(rate)
-	d.ExecutionStore.UpdateRate(rate)
-	d.Queue.UpdateRate(rate)
-	d.ClusterMDStore.UpdateRate(rate)
+	// stores are created lazily, those which don't exist yet pick up the rate from ErrorGenerator
+	if d.TaskStore != nil {
+		d.TaskStore.UpdateRate(rate)
+	}
+	if d.ShardStore != nil {
+		d.ShardStore.UpdateRate(rate)
+	}
+	if d.MetadataStore != nil {
+		d.MetadataStore.UpdateRate(rate)
+	}
+	if d.ExecutionStore != nil {
+		d.ExecutionStore.UpdateRate(rate)
+	}
+	d.queuesLock.Lock()
+	for _, queue := range d.Queues {
+		queue.UpdateRate(rate)
+	}
+	d.queuesLock.Unlock()
+	if d.ClusterMDStore != nil {
+		d.ClusterMDStore.UpdateRate(rate)
+	}
 }
 func (d *FaultInjectionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
 	if d.TaskStore == nil {
@@ -228,24 +257,30 @@ func (d *FaultInjectionDataStoreFactory) NewExecutionStore() (persistence.Execut
 }
 
 func (d *FaultInjectionDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
-	if d.Queue == nil {
-		baseQueue, err := d.baseFactory.NewQueue(queueType)
-		if err != nil {
-			return baseQueue, err
+	d.queuesLock.Lock()
+	defer d.queuesLock.Unlock()
+
+	if queue, ok := d.Queues[queueType]; ok {
+		return queue, nil
+	}
+	baseQueue, err := d.baseFactory.NewQueue(queueType)
+	if err != nil {
+		return baseQueue, err
+	}
+	var queue *FaultInjectionQueue
+	if storeConfig, ok := d.config.Targets.DataStores[config.QueueName]; ok {
+		queue = &FaultInjectionQueue{
+			baseQueue:      baseQueue,
+			ErrorGenerator: NewTargetedDataStoreErrorGenerator(&storeConfig),
 		}
-		if storeConfig, ok := d.config.Targets.DataStores[config.QueueName]; ok {
-			d.Queue = &FaultInjectionQueue{
-				baseQueue:      baseQueue,
-				ErrorGenerator: NewTargetedDataStoreErrorGenerator(&storeConfig),
-			}
-		} else {
-			d.Queue, err = NewFaultInjectionQueue(d.ErrorGenerator.Rate(), baseQueue)
-			if err != nil {
-				return nil, err
-			}
+	} else {
+		queue, err = NewFaultInjectionQueue(d.ErrorGenerator.Rate(), baseQueue)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return d.Queue, nil
+	d.Queues[queueType] = queue
+	return queue, nil
 }
 
 func (d *FaultInjectionDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
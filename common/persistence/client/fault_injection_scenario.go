@@ -0,0 +1,314 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// FaultInjectionScenarios applies the fault injection scenarios of config.FaultInjection to data store calls.
+	// Scenarios can be enabled and disabled at runtime.
+	FaultInjectionScenarios struct {
+		timeSource clock.TimeSource
+		sleep      func(time.Duration)
+
+		sync.RWMutex
+		scenarios map[string]*faultInjectionScenario
+	}
+
+	// FaultInjectionScenarioState is the runtime state of a fault injection scenario.
+	FaultInjectionScenarioState struct {
+		Name    string
+		Enabled bool
+		// ExpireTime is when the scenario is disabled automatically, zero if it stays enabled until disabled.
+		ExpireTime time.Time
+	}
+
+	faultInjectionScenario struct {
+		name           string
+		config         config.FaultInjectionScenario
+		errorGenerator ErrorGenerator
+
+		sync.Mutex
+		r          *rand.Rand // rand is not thread-safe
+		enabled    bool
+		expireTime time.Time
+	}
+)
+
+// NewFaultInjectionScenarios returns FaultInjectionScenarios for the given scenario configs. Scenarios
+// enabled in the config start out enabled.
+func NewFaultInjectionScenarios(
+	scenarioConfigs map[string]config.FaultInjectionScenario,
+	timeSource clock.TimeSource,
+) *FaultInjectionScenarios {
+	scenarios := make(map[string]*faultInjectionScenario, len(scenarioConfigs))
+	for name, scenarioConfig := range scenarioConfigs {
+		seed := scenarioConfig.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		scenario := &faultInjectionScenario{
+			name:           name,
+			config:         scenarioConfig,
+			errorGenerator: newNamedErrorGenerator(scenarioConfig.Errors, seed),
+			r:              rand.New(rand.NewSource(seed)),
+		}
+		if scenarioConfig.Enabled {
+			scenario.enable(timeSource.Now(), scenarioConfig.Duration)
+		}
+		scenarios[name] = scenario
+	}
+	return &FaultInjectionScenarios{
+		timeSource: timeSource,
+		sleep:      time.Sleep,
+		scenarios:  scenarios,
+	}
+}
+
+// List returns the state of all scenarios, ordered by name.
+func (s *FaultInjectionScenarios) List() []FaultInjectionScenarioState {
+	s.RLock()
+	defer s.RUnlock()
+
+	now := s.timeSource.Now()
+	states := make([]FaultInjectionScenarioState, 0, len(s.scenarios))
+	for _, scenario := range s.scenarios {
+		states = append(states, scenario.state(now))
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Name < states[j].Name })
+	return states
+}
+
+// Update enables or disables the named scenario. A non-zero duration disables the scenario automatically once
+// it has elapsed, otherwise the duration from the scenario config is used.
+func (s *FaultInjectionScenarios) Update(
+	name string,
+	enabled bool,
+	duration time.Duration,
+) (FaultInjectionScenarioState, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	scenario, ok := s.scenarios[name]
+	if !ok {
+		return FaultInjectionScenarioState{}, serviceerror.NewNotFound(fmt.Sprintf("fault injection scenario %q not found", name))
+	}
+
+	now := s.timeSource.Now()
+	if !enabled {
+		scenario.disable()
+		return scenario.state(now), nil
+	}
+	if duration == 0 {
+		duration = scenario.config.Duration
+	}
+	scenario.enable(now, duration)
+	return scenario.state(now), nil
+}
+
+func (s *FaultInjectionScenarios) intercept(
+	store config.DataStoreName,
+	method string,
+	request any,
+	_ any,
+	invoke func() error,
+) error {
+	scenarios := s.activeScenarios(store, method, request)
+	if len(scenarios) == 0 {
+		return invoke()
+	}
+
+	for _, scenario := range scenarios {
+		if latency := scenario.latency(); latency > 0 {
+			s.sleep(latency)
+		}
+		if err := scenario.errorGenerator.Generate(); err != nil {
+			return err
+		}
+	}
+
+	if err := invoke(); err != nil {
+		return err
+	}
+
+	for _, scenario := range scenarios {
+		if scenario.ambiguousCommit() {
+			return &persistence.TimeoutError{
+				Msg: fmt.Sprintf("fault injection scenario %v: timeout after %v.%v succeeded", scenario.name, store, method),
+			}
+		}
+	}
+	return nil
+}
+
+func (s *FaultInjectionScenarios) activeScenarios(
+	store config.DataStoreName,
+	method string,
+	request any,
+) []*faultInjectionScenario {
+	s.RLock()
+	defer s.RUnlock()
+
+	now := s.timeSource.Now()
+	var scenarios []*faultInjectionScenario
+	for _, scenario := range s.scenarios {
+		if scenario.isEnabled(now) && scenario.targets(store, method, request) {
+			scenarios = append(scenarios, scenario)
+		}
+	}
+	// apply scenarios in a stable order so that seeded scenarios are reproducible
+	sort.Slice(scenarios, func(i, j int) bool { return scenarios[i].name < scenarios[j].name })
+	return scenarios
+}
+
+func (s *faultInjectionScenario) enable(now time.Time, duration time.Duration) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.enabled = true
+	s.expireTime = time.Time{}
+	if duration > 0 {
+		s.expireTime = now.Add(duration)
+	}
+}
+
+func (s *faultInjectionScenario) disable() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.enabled = false
+	s.expireTime = time.Time{}
+}
+
+func (s *faultInjectionScenario) isEnabled(now time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.enabled && !s.expireTime.IsZero() && !now.Before(s.expireTime) {
+		s.enabled = false
+		s.expireTime = time.Time{}
+	}
+	return s.enabled
+}
+
+func (s *faultInjectionScenario) state(now time.Time) FaultInjectionScenarioState {
+	enabled := s.isEnabled(now)
+
+	s.Lock()
+	defer s.Unlock()
+	return FaultInjectionScenarioState{
+		Name:       s.name,
+		Enabled:    enabled,
+		ExpireTime: s.expireTime,
+	}
+}
+
+// targets returns true if the call is for a data store method, shard and namespace targeted by the scenario.
+// Calls which can't be attributed to a shard or namespace are not targeted by scenarios filtering on those.
+func (s *faultInjectionScenario) targets(
+	store config.DataStoreName,
+	method string,
+	request any,
+) bool {
+	if len(s.config.DataStores) != 0 {
+		methods, ok := s.config.DataStores[store]
+		if !ok {
+			return false
+		}
+		if len(methods) != 0 && !slices.Contains(methods, method) {
+			return false
+		}
+	}
+	if s.config.ShardID == 0 && s.config.NamespaceID == "" {
+		return true
+	}
+
+	shardMatched := s.config.ShardID == 0
+	namespaceMatched := s.config.NamespaceID == ""
+	visitRequestFields(request, func(name string, field reflect.Value) bool {
+		switch name {
+		case "ShardID", "ShardId":
+			if !shardMatched && field.CanInt() {
+				shardMatched = field.Int() == int64(s.config.ShardID)
+			}
+		case "NamespaceID", "NamespaceId":
+			if !namespaceMatched && field.Kind() == reflect.String {
+				namespaceMatched = field.String() == s.config.NamespaceID
+			}
+		}
+		return !shardMatched || !namespaceMatched
+	})
+	return shardMatched && namespaceMatched
+}
+
+func (s *faultInjectionScenario) latency() time.Duration {
+	latencyConfig := s.config.Latency
+	if latencyConfig == nil {
+		return 0
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	switch latencyConfig.Distribution {
+	case "uniform":
+		if latencyConfig.Max <= latencyConfig.Min {
+			return latencyConfig.Min
+		}
+		return latencyConfig.Min + time.Duration(s.r.Int63n(int64(latencyConfig.Max-latencyConfig.Min)))
+	case "exponential":
+		latency := latencyConfig.Min + time.Duration(s.r.ExpFloat64()*float64(latencyConfig.Mean))
+		if latencyConfig.Max > 0 && latency > latencyConfig.Max {
+			return latencyConfig.Max
+		}
+		return latency
+	default:
+		return latencyConfig.Min
+	}
+}
+
+func (s *faultInjectionScenario) ambiguousCommit() bool {
+	if s.config.AmbiguousCommitRate <= 0 {
+		return false
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	return s.r.Float64() < s.config.AmbiguousCommitRate
+}
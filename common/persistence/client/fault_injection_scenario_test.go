@@ -0,0 +1,171 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+)
+
+type (
+	faultInjectionScenarioSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller     *gomock.Controller
+		mockShardStore *mock.MockShardStore
+		timeSource     *clock.EventTimeSource
+	}
+)
+
+func TestFaultInjectionScenarioSuite(t *testing.T) {
+	s := new(faultInjectionScenarioSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionScenarioSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShardStore = mock.NewMockShardStore(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+}
+
+func (s *faultInjectionScenarioSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *faultInjectionScenarioSuite) TestTargetedShard() {
+	scenarios := NewFaultInjectionScenarios(map[string]config.FaultInjectionScenario{
+		"shard-3": {
+			Enabled:    true,
+			ShardID:    3,
+			DataStores: map[config.DataStoreName][]string{config.ShardStoreName: {"UpdateShard"}},
+			Errors:     map[string]float64{"ShardOwnershipLostError": 1.0},
+		},
+	}, s.timeSource)
+	shardStore := &interceptedShardStore{baseShardStore: s.mockShardStore, interceptor: scenarios}
+
+	err := shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 3})
+	s.IsType(&persistence.ShardOwnershipLostError{}, err)
+
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	err = shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 4})
+	s.NoError(err)
+
+	s.mockShardStore.EXPECT().AssertShardOwnership(gomock.Any(), gomock.Any()).Return(nil)
+	err = shardStore.AssertShardOwnership(context.Background(), &persistence.AssertShardOwnershipRequest{ShardID: 3})
+	s.NoError(err)
+}
+
+func (s *faultInjectionScenarioSuite) TestAmbiguousCommit() {
+	scenarios := NewFaultInjectionScenarios(map[string]config.FaultInjectionScenario{
+		"ambiguous": {
+			Enabled:             true,
+			AmbiguousCommitRate: 1.0,
+		},
+	}, s.timeSource)
+	shardStore := &interceptedShardStore{baseShardStore: s.mockShardStore, interceptor: scenarios}
+
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	err := shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1})
+	s.IsType(&persistence.TimeoutError{}, err)
+
+	// failed calls are returned as is
+	ownershipLostErr := &persistence.ShardOwnershipLostError{ShardID: 1}
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(ownershipLostErr)
+	err = shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1})
+	s.Equal(ownershipLostErr, err)
+}
+
+func (s *faultInjectionScenarioSuite) TestLatency() {
+	scenarios := NewFaultInjectionScenarios(map[string]config.FaultInjectionScenario{
+		"slow": {
+			Enabled: true,
+			Seed:    42,
+			Latency: &config.FaultInjectionLatency{
+				Distribution: "uniform",
+				Min:          10 * time.Millisecond,
+				Max:          20 * time.Millisecond,
+			},
+		},
+	}, s.timeSource)
+	var latencies []time.Duration
+	scenarios.sleep = func(d time.Duration) { latencies = append(latencies, d) }
+	shardStore := &interceptedShardStore{baseShardStore: s.mockShardStore, interceptor: scenarios}
+
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil).Times(10)
+	for i := 0; i < 10; i++ {
+		s.NoError(shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1}))
+	}
+	s.Len(latencies, 10)
+	for _, latency := range latencies {
+		s.GreaterOrEqual(latency, 10*time.Millisecond)
+		s.Less(latency, 20*time.Millisecond)
+	}
+}
+
+func (s *faultInjectionScenarioSuite) TestUpdate() {
+	scenarios := NewFaultInjectionScenarios(map[string]config.FaultInjectionScenario{
+		"unavailable": {
+			Errors: map[string]float64{"UnavailableError": 1.0},
+		},
+	}, s.timeSource)
+	shardStore := &interceptedShardStore{baseShardStore: s.mockShardStore, interceptor: scenarios}
+
+	s.Equal([]FaultInjectionScenarioState{{Name: "unavailable"}}, scenarios.List())
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	s.NoError(shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1}))
+
+	state, err := scenarios.Update("unavailable", true, time.Minute)
+	s.NoError(err)
+	s.Equal(FaultInjectionScenarioState{
+		Name:       "unavailable",
+		Enabled:    true,
+		ExpireTime: s.timeSource.Now().Add(time.Minute),
+	}, state)
+	err = shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1})
+	s.IsType(&serviceerror.Unavailable{}, err)
+
+	// the scenario is disabled once the duration has elapsed
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.Equal([]FaultInjectionScenarioState{{Name: "unavailable"}}, scenarios.List())
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).Return(nil)
+	s.NoError(shardStore.UpdateShard(context.Background(), &persistence.InternalUpdateShardRequest{ShardID: 1}))
+
+	_, err = scenarios.Update("unknown", true, 0)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
@@ -0,0 +1,875 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"reflect"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// maxRequestFieldDepth limits how deep nested request structs are searched by visitRequestFields
+	maxRequestFieldDepth = 3
+)
+
+type (
+	// callInterceptor is invoked around every data store call made through an intercepted data store.
+	// Implementations either call through to the wrapped data store with invoke, or fill response
+	// themselves.
+	callInterceptor interface {
+		intercept(store config.DataStoreName, method string, request any, response any, invoke func() error) error
+	}
+
+	interceptedDataStoreFactory struct {
+		baseFactory DataStoreFactory
+		interceptor callInterceptor
+	}
+
+	interceptedShardStore struct {
+		baseShardStore persistence.ShardStore
+		interceptor    callInterceptor
+	}
+
+	interceptedTaskStore struct {
+		baseTaskStore persistence.TaskStore
+		interceptor   callInterceptor
+	}
+
+	interceptedMetadataStore struct {
+		baseMetadataStore persistence.MetadataStore
+		interceptor       callInterceptor
+	}
+
+	interceptedClusterMetadataStore struct {
+		baseCMStore persistence.ClusterMetadataStore
+		interceptor callInterceptor
+	}
+
+	interceptedExecutionStore struct {
+		baseExecutionStore persistence.ExecutionStore
+		interceptor        callInterceptor
+	}
+
+	interceptedQueue struct {
+		baseQueue   persistence.Queue
+		interceptor callInterceptor
+	}
+
+	queueMessagesPage struct {
+		Messages      []*persistence.QueueMessage
+		NextPageToken []byte
+	}
+)
+
+func intercept[T any](
+	interceptor callInterceptor,
+	store config.DataStoreName,
+	method string,
+	request any,
+	call func() (T, error),
+) (T, error) {
+	var response T
+	err := interceptor.intercept(store, method, request, &response, func() error {
+		var err error
+		response, err = call()
+		return err
+	})
+	return response, err
+}
+
+func interceptNoResponse(
+	interceptor callInterceptor,
+	store config.DataStoreName,
+	method string,
+	request any,
+	call func() error,
+) error {
+	_, err := intercept(interceptor, store, method, request, func() (struct{}, error) {
+		return struct{}{}, call()
+	})
+	return err
+}
+
+// visitRequestFields calls visit for every exported field of request, including the fields of nested structs
+// up to maxRequestFieldDepth levels deep. Visiting stops as soon as visit returns false.
+func visitRequestFields(request any, visit func(name string, field reflect.Value) bool) {
+	visitStructFields(reflect.ValueOf(request), visit, 0)
+}
+
+func visitStructFields(
+	value reflect.Value,
+	visit func(name string, field reflect.Value) bool,
+	depth int,
+) bool {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return true
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct || depth > maxRequestFieldDepth {
+		return true
+	}
+
+	valueType := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		if !valueType.Field(i).IsExported() {
+			continue
+		}
+		if !visit(valueType.Field(i).Name, value.Field(i)) {
+			return false
+		}
+		if !visitStructFields(value.Field(i), visit, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *interceptedDataStoreFactory) Close() {
+	d.baseFactory.Close()
+}
+
+func (d *interceptedDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	baseStore, err := d.baseFactory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedTaskStore{baseTaskStore: baseStore, interceptor: d.interceptor}, nil
+}
+
+func (d *interceptedDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	baseStore, err := d.baseFactory.NewShardStore()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedShardStore{baseShardStore: baseStore, interceptor: d.interceptor}, nil
+}
+
+func (d *interceptedDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	baseStore, err := d.baseFactory.NewMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedMetadataStore{baseMetadataStore: baseStore, interceptor: d.interceptor}, nil
+}
+
+func (d *interceptedDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	baseStore, err := d.baseFactory.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedExecutionStore{baseExecutionStore: baseStore, interceptor: d.interceptor}, nil
+}
+
+func (d *interceptedDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	baseQueue, err := d.baseFactory.NewQueue(queueType)
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedQueue{baseQueue: baseQueue, interceptor: d.interceptor}, nil
+}
+
+func (d *interceptedDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	baseStore, err := d.baseFactory.NewClusterMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptedClusterMetadataStore{baseCMStore: baseStore, interceptor: d.interceptor}, nil
+}
+
+func (s *interceptedShardStore) Close() {
+	s.baseShardStore.Close()
+}
+
+func (s *interceptedShardStore) GetName() string {
+	return s.baseShardStore.GetName()
+}
+
+func (s *interceptedShardStore) GetClusterName() string {
+	return s.baseShardStore.GetClusterName()
+}
+
+func (s *interceptedShardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.InternalGetOrCreateShardRequest,
+) (*persistence.InternalGetOrCreateShardResponse, error) {
+	return intercept(s.interceptor, config.ShardStoreName, "GetOrCreateShard", request, func() (*persistence.InternalGetOrCreateShardResponse, error) {
+		return s.baseShardStore.GetOrCreateShard(ctx, request)
+	})
+}
+
+func (s *interceptedShardStore) UpdateShard(
+	ctx context.Context,
+	request *persistence.InternalUpdateShardRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ShardStoreName, "UpdateShard", request, func() error {
+		return s.baseShardStore.UpdateShard(ctx, request)
+	})
+}
+
+func (s *interceptedShardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ShardStoreName, "AssertShardOwnership", request, func() error {
+		return s.baseShardStore.AssertShardOwnership(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) Close() {
+	s.baseTaskStore.Close()
+}
+
+func (s *interceptedTaskStore) GetName() string {
+	return s.baseTaskStore.GetName()
+}
+
+func (s *interceptedTaskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateTaskQueueRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.TaskStoreName, "CreateTaskQueue", request, func() error {
+		return s.baseTaskStore.CreateTaskQueue(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) GetTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalGetTaskQueueRequest,
+) (*persistence.InternalGetTaskQueueResponse, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "GetTaskQueue", request, func() (*persistence.InternalGetTaskQueueResponse, error) {
+		return s.baseTaskStore.GetTaskQueue(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueRequest,
+) (*persistence.UpdateTaskQueueResponse, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "UpdateTaskQueue", request, func() (*persistence.UpdateTaskQueueResponse, error) {
+		return s.baseTaskStore.UpdateTaskQueue(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) ListTaskQueue(
+	ctx context.Context,
+	request *persistence.ListTaskQueueRequest,
+) (*persistence.InternalListTaskQueueResponse, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "ListTaskQueue", request, func() (*persistence.InternalListTaskQueueResponse, error) {
+		return s.baseTaskStore.ListTaskQueue(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *persistence.DeleteTaskQueueRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.TaskStoreName, "DeleteTaskQueue", request, func() error {
+		return s.baseTaskStore.DeleteTaskQueue(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "CreateTasks", request, func() (*persistence.CreateTasksResponse, error) {
+		return s.baseTaskStore.CreateTasks(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) GetTasks(
+	ctx context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.InternalGetTasksResponse, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "GetTasks", request, func() (*persistence.InternalGetTasksResponse, error) {
+		return s.baseTaskStore.GetTasks(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) CompleteTask(
+	ctx context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.TaskStoreName, "CompleteTask", request, func() error {
+		return s.baseTaskStore.CompleteTask(ctx, request)
+	})
+}
+
+func (s *interceptedTaskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *persistence.CompleteTasksLessThanRequest,
+) (int, error) {
+	return intercept(s.interceptor, config.TaskStoreName, "CompleteTasksLessThan", request, func() (int, error) {
+		return s.baseTaskStore.CompleteTasksLessThan(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) Close() {
+	s.baseMetadataStore.Close()
+}
+
+func (s *interceptedMetadataStore) GetName() string {
+	return s.baseMetadataStore.GetName()
+}
+
+func (s *interceptedMetadataStore) CreateNamespace(
+	ctx context.Context,
+	request *persistence.InternalCreateNamespaceRequest,
+) (*persistence.CreateNamespaceResponse, error) {
+	return intercept(s.interceptor, config.MetadataStoreName, "CreateNamespace", request, func() (*persistence.CreateNamespaceResponse, error) {
+		return s.baseMetadataStore.CreateNamespace(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) GetNamespace(
+	ctx context.Context,
+	request *persistence.GetNamespaceRequest,
+) (*persistence.InternalGetNamespaceResponse, error) {
+	return intercept(s.interceptor, config.MetadataStoreName, "GetNamespace", request, func() (*persistence.InternalGetNamespaceResponse, error) {
+		return s.baseMetadataStore.GetNamespace(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.MetadataStoreName, "UpdateNamespace", request, func() error {
+		return s.baseMetadataStore.UpdateNamespace(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) RenameNamespace(
+	ctx context.Context,
+	request *persistence.InternalRenameNamespaceRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.MetadataStoreName, "RenameNamespace", request, func() error {
+		return s.baseMetadataStore.RenameNamespace(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.MetadataStoreName, "DeleteNamespace", request, func() error {
+		return s.baseMetadataStore.DeleteNamespace(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceByNameRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.MetadataStoreName, "DeleteNamespaceByName", request, func() error {
+		return s.baseMetadataStore.DeleteNamespaceByName(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) ListNamespaces(
+	ctx context.Context,
+	request *persistence.InternalListNamespacesRequest,
+) (*persistence.InternalListNamespacesResponse, error) {
+	return intercept(s.interceptor, config.MetadataStoreName, "ListNamespaces", request, func() (*persistence.InternalListNamespacesResponse, error) {
+		return s.baseMetadataStore.ListNamespaces(ctx, request)
+	})
+}
+
+func (s *interceptedMetadataStore) GetMetadata(
+	ctx context.Context,
+) (*persistence.GetMetadataResponse, error) {
+	return intercept(s.interceptor, config.MetadataStoreName, "GetMetadata", nil, func() (*persistence.GetMetadataResponse, error) {
+		return s.baseMetadataStore.GetMetadata(ctx)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) Close() {
+	s.baseCMStore.Close()
+}
+
+func (s *interceptedClusterMetadataStore) GetName() string {
+	return s.baseCMStore.GetName()
+}
+
+func (s *interceptedClusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalListClusterMetadataRequest,
+) (*persistence.InternalListClusterMetadataResponse, error) {
+	return intercept(s.interceptor, config.ClusterMDStoreName, "ListClusterMetadata", request, func() (*persistence.InternalListClusterMetadataResponse, error) {
+		return s.baseCMStore.ListClusterMetadata(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalGetClusterMetadataRequest,
+) (*persistence.InternalGetClusterMetadataResponse, error) {
+	return intercept(s.interceptor, config.ClusterMDStoreName, "GetClusterMetadata", request, func() (*persistence.InternalGetClusterMetadataResponse, error) {
+		return s.baseCMStore.GetClusterMetadata(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	return intercept(s.interceptor, config.ClusterMDStoreName, "SaveClusterMetadata", request, func() (bool, error) {
+		return s.baseCMStore.SaveClusterMetadata(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalDeleteClusterMetadataRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ClusterMDStoreName, "DeleteClusterMetadata", request, func() error {
+		return s.baseCMStore.DeleteClusterMetadata(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *persistence.GetClusterMembersRequest,
+) (*persistence.GetClusterMembersResponse, error) {
+	return intercept(s.interceptor, config.ClusterMDStoreName, "GetClusterMembers", request, func() (*persistence.GetClusterMembersResponse, error) {
+		return s.baseCMStore.GetClusterMembers(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *persistence.UpsertClusterMembershipRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ClusterMDStoreName, "UpsertClusterMembership", request, func() error {
+		return s.baseCMStore.UpsertClusterMembership(ctx, request)
+	})
+}
+
+func (s *interceptedClusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *persistence.PruneClusterMembershipRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ClusterMDStoreName, "PruneClusterMembership", request, func() error {
+		return s.baseCMStore.PruneClusterMembership(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) Close() {
+	s.baseExecutionStore.Close()
+}
+
+func (s *interceptedExecutionStore) GetName() string {
+	return s.baseExecutionStore.GetName()
+}
+
+func (s *interceptedExecutionStore) GetHistoryBranchUtil() persistence.HistoryBranchUtil {
+	return s.baseExecutionStore.GetHistoryBranchUtil()
+}
+
+func (s *interceptedExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "CreateWorkflowExecution", request, func() (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+		return s.baseExecutionStore.CreateWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "UpdateWorkflowExecution", request, func() error {
+		return s.baseExecutionStore.UpdateWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "ConflictResolveWorkflowExecution", request, func() error {
+		return s.baseExecutionStore.ConflictResolveWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "DeleteWorkflowExecution", request, func() error {
+		return s.baseExecutionStore.DeleteWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "DeleteCurrentWorkflowExecution", request, func() error {
+		return s.baseExecutionStore.DeleteCurrentWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetCurrentExecution", request, func() (*persistence.InternalGetCurrentExecutionResponse, error) {
+		return s.baseExecutionStore.GetCurrentExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetWorkflowExecution", request, func() (*persistence.InternalGetWorkflowExecutionResponse, error) {
+		return s.baseExecutionStore.GetWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "SetWorkflowExecution", request, func() error {
+		return s.baseExecutionStore.SetWorkflowExecution(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "ListConcreteExecutions", request, func() (*persistence.InternalListConcreteExecutionsResponse, error) {
+		return s.baseExecutionStore.ListConcreteExecutions(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.RegisterHistoryTaskReaderRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "RegisterHistoryTaskReader", request, func() error {
+		return s.baseExecutionStore.RegisterHistoryTaskReader(ctx, request)
+	})
+}
+
+// UnregisterHistoryTaskReader is an in-memory hint without a result, so it is not intercepted
+func (s *interceptedExecutionStore) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.UnregisterHistoryTaskReaderRequest,
+) {
+	s.baseExecutionStore.UnregisterHistoryTaskReader(ctx, request)
+}
+
+// UpdateHistoryTaskReaderProgress is an in-memory hint without a result, so it is not intercepted
+func (s *interceptedExecutionStore) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *persistence.UpdateHistoryTaskReaderProgressRequest,
+) {
+	s.baseExecutionStore.UpdateHistoryTaskReaderProgress(ctx, request)
+}
+
+func (s *interceptedExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.InternalAddHistoryTasksRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "AddHistoryTasks", request, func() error {
+		return s.baseExecutionStore.AddHistoryTasks(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetHistoryTasks", request, func() (*persistence.InternalGetHistoryTasksResponse, error) {
+		return s.baseExecutionStore.GetHistoryTasks(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "CompleteHistoryTask", request, func() error {
+		return s.baseExecutionStore.CompleteHistoryTask(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "RangeCompleteHistoryTasks", request, func() error {
+		return s.baseExecutionStore.RangeCompleteHistoryTasks(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "PutReplicationTaskToDLQ", request, func() error {
+		return s.baseExecutionStore.PutReplicationTaskToDLQ(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.InternalGetReplicationTasksFromDLQResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetReplicationTasksFromDLQ", request, func() (*persistence.InternalGetReplicationTasksFromDLQResponse, error) {
+		return s.baseExecutionStore.GetReplicationTasksFromDLQ(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "DeleteReplicationTaskFromDLQ", request, func() error {
+		return s.baseExecutionStore.DeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "RangeDeleteReplicationTaskFromDLQ", request, func() error {
+		return s.baseExecutionStore.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "IsReplicationDLQEmpty", request, func() (bool, error) {
+		return s.baseExecutionStore.IsReplicationDLQEmpty(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) InsertHistoryTree(
+	ctx context.Context,
+	request *persistence.InternalInsertHistoryTreeRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "InsertHistoryTree", request, func() error {
+		return s.baseExecutionStore.InsertHistoryTree(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "AppendHistoryNodes", request, func() error {
+		return s.baseExecutionStore.AppendHistoryNodes(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryNodesRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "DeleteHistoryNodes", request, func() error {
+		return s.baseExecutionStore.DeleteHistoryNodes(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "ReadHistoryBranch", request, func() (*persistence.InternalReadHistoryBranchResponse, error) {
+		return s.baseExecutionStore.ReadHistoryBranch(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalForkHistoryBranchRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "ForkHistoryBranch", request, func() error {
+		return s.baseExecutionStore.ForkHistoryBranch(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	return interceptNoResponse(s.interceptor, config.ExecutionStoreName, "DeleteHistoryBranch", request, func() error {
+		return s.baseExecutionStore.DeleteHistoryBranch(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *persistence.GetHistoryTreeRequest,
+) (*persistence.InternalGetHistoryTreeResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetHistoryTree", request, func() (*persistence.InternalGetHistoryTreeResponse, error) {
+		return s.baseExecutionStore.GetHistoryTree(ctx, request)
+	})
+}
+
+func (s *interceptedExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	return intercept(s.interceptor, config.ExecutionStoreName, "GetAllHistoryTreeBranches", request, func() (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+		return s.baseExecutionStore.GetAllHistoryTreeBranches(ctx, request)
+	})
+}
+
+func (q *interceptedQueue) Close() {
+	q.baseQueue.Close()
+}
+
+func (q *interceptedQueue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "Init", blob, func() error {
+		return q.baseQueue.Init(ctx, blob)
+	})
+}
+
+func (q *interceptedQueue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "EnqueueMessage", blob, func() error {
+		return q.baseQueue.EnqueueMessage(ctx, blob)
+	})
+}
+
+func (q *interceptedQueue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	request := []int64{lastMessageID, int64(maxCount)}
+	return intercept(q.interceptor, config.QueueName, "ReadMessages", request, func() ([]*persistence.QueueMessage, error) {
+		return q.baseQueue.ReadMessages(ctx, lastMessageID, maxCount)
+	})
+}
+
+func (q *interceptedQueue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "DeleteMessagesBefore", messageID, func() error {
+		return q.baseQueue.DeleteMessagesBefore(ctx, messageID)
+	})
+}
+
+func (q *interceptedQueue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "UpdateAckLevel", metadata, func() error {
+		return q.baseQueue.UpdateAckLevel(ctx, metadata)
+	})
+}
+
+func (q *interceptedQueue) GetAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	return intercept(q.interceptor, config.QueueName, "GetAckLevels", nil, func() (*persistence.InternalQueueMetadata, error) {
+		return q.baseQueue.GetAckLevels(ctx)
+	})
+}
+
+func (q *interceptedQueue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	return intercept(q.interceptor, config.QueueName, "EnqueueMessageToDLQ", blob, func() (int64, error) {
+		return q.baseQueue.EnqueueMessageToDLQ(ctx, blob)
+	})
+}
+
+func (q *interceptedQueue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.QueueMessage, []byte, error) {
+	request := []any{firstMessageID, lastMessageID, pageSize, pageToken}
+	page, err := intercept(q.interceptor, config.QueueName, "ReadMessagesFromDLQ", request, func() (queueMessagesPage, error) {
+		messages, nextPageToken, err := q.baseQueue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+		return queueMessagesPage{Messages: messages, NextPageToken: nextPageToken}, err
+	})
+	return page.Messages, page.NextPageToken, err
+}
+
+func (q *interceptedQueue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "DeleteMessageFromDLQ", messageID, func() error {
+		return q.baseQueue.DeleteMessageFromDLQ(ctx, messageID)
+	})
+}
+
+func (q *interceptedQueue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	request := []int64{firstMessageID, lastMessageID}
+	return interceptNoResponse(q.interceptor, config.QueueName, "RangeDeleteMessagesFromDLQ", request, func() error {
+		return q.baseQueue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+	})
+}
+
+func (q *interceptedQueue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return interceptNoResponse(q.interceptor, config.QueueName, "UpdateDLQAckLevel", metadata, func() error {
+		return q.baseQueue.UpdateDLQAckLevel(ctx, metadata)
+	})
+}
+
+func (q *interceptedQueue) GetDLQAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	return intercept(q.interceptor, config.QueueName, "GetDLQAckLevels", nil, func() (*persistence.InternalQueueMetadata, error) {
+		return q.baseQueue.GetDLQAckLevels(ctx)
+	})
+}
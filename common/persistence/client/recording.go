@@ -0,0 +1,253 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// RecordingDataStoreFactory wraps a DataStoreFactory and appends every call made to its stores,
+	// together with the result, to a file which can later be served by ReplayDataStoreFactory.
+	RecordingDataStoreFactory struct {
+		interceptedDataStoreFactory
+		recorder *recorder
+	}
+
+	recorder struct {
+		namespaceID string
+		workflowID  string
+		logger      log.Logger
+
+		sync.Mutex
+		file    *os.File
+		encoder *json.Encoder
+	}
+
+	// recordedCall is a single line of a recording file
+	recordedCall struct {
+		Time     time.Time
+		Store    config.DataStoreName
+		Method   string
+		Request  json.RawMessage `json:",omitempty"`
+		Response json.RawMessage `json:",omitempty"`
+		Error    *recordedError  `json:",omitempty"`
+	}
+
+	recordedError struct {
+		Type    string
+		Message string
+		Details json.RawMessage `json:",omitempty"`
+	}
+)
+
+// recordedErrorTypes are the errors which are recreated with their original type on replay.
+// Errors of other types are replayed as serviceerror.Unavailable.
+var recordedErrorTypes = map[string]func(message string) error{
+	"ConditionFailedError":                func(string) error { return &persistence.ConditionFailedError{} },
+	"CurrentWorkflowConditionFailedError": func(string) error { return &persistence.CurrentWorkflowConditionFailedError{} },
+	"WorkflowConditionFailedError":        func(string) error { return &persistence.WorkflowConditionFailedError{} },
+	"ShardAlreadyExistError":              func(string) error { return &persistence.ShardAlreadyExistError{} },
+	"ShardOwnershipLostError":             func(string) error { return &persistence.ShardOwnershipLostError{} },
+	"TimeoutError":                        func(string) error { return &persistence.TimeoutError{} },
+	"TransactionSizeLimitError":           func(string) error { return &persistence.TransactionSizeLimitError{} },
+	"InvalidPersistenceRequestError":      func(string) error { return &persistence.InvalidPersistenceRequestError{} },
+	"InsertHistoryTimeoutError":           func(string) error { return &persistence.InsertHistoryTimeoutError{} },
+	"AppendHistoryTimeoutError":           func(string) error { return &persistence.AppendHistoryTimeoutError{} },
+	"NotFound":                            func(message string) error { return serviceerror.NewNotFound(message) },
+	"InvalidArgument":                     func(message string) error { return serviceerror.NewInvalidArgument(message) },
+	"Internal":                            func(message string) error { return serviceerror.NewInternal(message) },
+	"Unavailable":                         func(message string) error { return serviceerror.NewUnavailable(message) },
+	"Canceled":                            func(message string) error { return serviceerror.NewCanceled(message) },
+	"DeadlineExceeded":                    func(message string) error { return serviceerror.NewDeadlineExceeded(message) },
+	"context.Canceled":                    func(string) error { return context.Canceled },
+	"context.DeadlineExceeded":            func(string) error { return context.DeadlineExceeded },
+}
+
+// NewRecordingDataStoreFactory returns a RecordingDataStoreFactory which appends to the file in cfg.
+func NewRecordingDataStoreFactory(
+	cfg *config.PersistenceRecording,
+	baseFactory DataStoreFactory,
+	logger log.Logger,
+) (*RecordingDataStoreFactory, error) {
+	file, err := os.OpenFile(cfg.File, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	r := &recorder{
+		namespaceID: cfg.NamespaceID,
+		workflowID:  cfg.WorkflowID,
+		logger:      logger,
+		file:        file,
+		encoder:     json.NewEncoder(file),
+	}
+	return &RecordingDataStoreFactory{
+		interceptedDataStoreFactory: interceptedDataStoreFactory{
+			baseFactory: baseFactory,
+			interceptor: r,
+		},
+		recorder: r,
+	}, nil
+}
+
+func (d *RecordingDataStoreFactory) Close() {
+	d.interceptedDataStoreFactory.Close()
+	d.recorder.close()
+}
+
+func (r *recorder) intercept(
+	store config.DataStoreName,
+	method string,
+	request any,
+	response any,
+	invoke func() error,
+) error {
+	err := invoke()
+	if !r.matches(request) {
+		return err
+	}
+
+	// the recording only keeps a serialized copy of err, callers always get the original error
+	call := &recordedCall{
+		Time:   time.Now().UTC(),
+		Store:  store,
+		Method: method,
+		Error:  newRecordedError(err),
+	}
+	if request != nil {
+		// requests may carry values which can't be encoded (e.g. callbacks), those are recorded without request
+		var encodeErr error
+		if call.Request, encodeErr = json.Marshal(request); encodeErr != nil {
+			r.logger.Warn("Unable to encode recorded persistence request.", tag.StoreType(string(store)), tag.Operation(method), tag.Error(encodeErr))
+		}
+	}
+	if call.Error == nil {
+		var encodeErr error
+		if call.Response, encodeErr = json.Marshal(response); encodeErr != nil {
+			r.logger.Warn("Unable to encode recorded persistence response.", tag.StoreType(string(store)), tag.Operation(method), tag.Error(encodeErr))
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.file == nil {
+		return err
+	}
+	if encodeErr := r.encoder.Encode(call); encodeErr != nil {
+		r.logger.Error("Unable to write persistence recording.", tag.Error(encodeErr))
+	}
+	return err
+}
+
+func (r *recorder) close() {
+	r.Lock()
+	defer r.Unlock()
+	if r.file == nil {
+		return
+	}
+	if err := r.file.Close(); err != nil {
+		r.logger.Error("Unable to close persistence recording.", tag.Error(err))
+	}
+	r.file = nil
+}
+
+// matches returns false only if request explicitly refers to a namespace or workflow other than the
+// ones the recording is restricted to.
+func (r *recorder) matches(request any) bool {
+	if r.namespaceID == "" && r.workflowID == "" {
+		return true
+	}
+
+	matches := true
+	visitRequestFields(request, func(name string, field reflect.Value) bool {
+		if field.Kind() != reflect.String || field.String() == "" {
+			return true
+		}
+		switch name {
+		case "NamespaceID", "NamespaceId":
+			matches = r.namespaceID == "" || field.String() == r.namespaceID
+		case "WorkflowID", "WorkflowId":
+			matches = r.workflowID == "" || field.String() == r.workflowID
+		}
+		return matches
+	})
+	return matches
+}
+
+func newRecordedError(err error) *recordedError {
+	if err == nil {
+		return nil
+	}
+
+	errType := reflect.TypeOf(err)
+	if errType.Kind() == reflect.Pointer {
+		errType = errType.Elem()
+	}
+	recorded := &recordedError{
+		Type:    errType.Name(),
+		Message: err.Error(),
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		recorded.Type = "context.Canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		recorded.Type = "context.DeadlineExceeded"
+	}
+	if errType.PkgPath() == reflect.TypeOf(persistence.ConditionFailedError{}).PkgPath() {
+		recorded.Details, _ = json.Marshal(err)
+	}
+	return recorded
+}
+
+func (c *recordedCall) err() error {
+	if c.Error == nil {
+		return nil
+	}
+
+	newErr, ok := recordedErrorTypes[c.Error.Type]
+	if !ok {
+		return serviceerror.NewUnavailable(c.Error.Message)
+	}
+	err := newErr(c.Error.Message)
+	if len(c.Error.Details) != 0 {
+		if unmarshalErr := json.Unmarshal(c.Error.Details, err); unmarshalErr != nil {
+			return serviceerror.NewUnavailable(c.Error.Message)
+		}
+	}
+	return err
+}
@@ -0,0 +1,193 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+)
+
+type (
+	recordingSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockShardStore       *mock.MockShardStore
+		mockExecutionStore   *mock.MockExecutionStore
+		baseDataStoreFactory *testDataStoreFactory
+		recordingFile        string
+	}
+
+	testDataStoreFactory struct {
+		DataStoreFactory
+		shardStore     persistence.ShardStore
+		executionStore persistence.ExecutionStore
+	}
+)
+
+func TestRecordingSuite(t *testing.T) {
+	s := new(recordingSuite)
+	suite.Run(t, s)
+}
+
+func (s *recordingSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockShardStore = mock.NewMockShardStore(s.controller)
+	s.mockExecutionStore = mock.NewMockExecutionStore(s.controller)
+	s.baseDataStoreFactory = &testDataStoreFactory{
+		shardStore:     s.mockShardStore,
+		executionStore: s.mockExecutionStore,
+	}
+	s.recordingFile = filepath.Join(s.T().TempDir(), "recording.jsonl")
+}
+
+func (s *recordingSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *recordingSuite) TestRecordAndReplay() {
+	getShardRequest := &persistence.InternalGetOrCreateShardRequest{ShardID: 1}
+	getShardResponse := &persistence.InternalGetOrCreateShardResponse{
+		ShardInfo: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("shard info")},
+	}
+	updateShardRequest := &persistence.InternalUpdateShardRequest{ShardID: 1, RangeID: 2, PreviousRangeID: 1}
+	ownershipLostErr := &persistence.ShardOwnershipLostError{ShardID: 1, Msg: "range ID mismatch"}
+
+	s.mockShardStore.EXPECT().GetOrCreateShard(gomock.Any(), getShardRequest).Return(getShardResponse, nil)
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), updateShardRequest).Return(ownershipLostErr)
+
+	recordingFactory, err := NewRecordingDataStoreFactory(
+		&config.PersistenceRecording{File: s.recordingFile},
+		s.baseDataStoreFactory,
+		log.NewTestLogger(),
+	)
+	s.NoError(err)
+	shardStore, err := recordingFactory.NewShardStore()
+	s.NoError(err)
+	resp, err := shardStore.GetOrCreateShard(context.Background(), getShardRequest)
+	s.NoError(err)
+	s.Equal(getShardResponse, resp)
+	err = shardStore.UpdateShard(context.Background(), updateShardRequest)
+	s.Equal(ownershipLostErr, err)
+	recordingFactory.Close()
+
+	// replay must not reach the base stores until the recording is exhausted
+	replayFactory, err := NewReplayDataStoreFactory(
+		&config.PersistenceReplay{File: s.recordingFile},
+		s.baseDataStoreFactory,
+		log.NewTestLogger(),
+	)
+	s.NoError(err)
+	shardStore, err = replayFactory.NewShardStore()
+	s.NoError(err)
+	resp, err = shardStore.GetOrCreateShard(context.Background(), getShardRequest)
+	s.NoError(err)
+	s.Equal(getShardResponse, resp)
+	err = shardStore.UpdateShard(context.Background(), updateShardRequest)
+	s.Equal(ownershipLostErr, err)
+
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), updateShardRequest).Return(nil)
+	err = shardStore.UpdateShard(context.Background(), updateShardRequest)
+	s.NoError(err)
+}
+
+func (s *recordingSuite) TestRecord_FilterWorkflow() {
+	recordedRequest := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-id",
+		RunID:       "run-id",
+	}
+	skippedRequest := &persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "namespace-id",
+		WorkflowID:  "other-workflow-id",
+		RunID:       "run-id",
+	}
+	s.mockExecutionStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetWorkflowExecutionResponse{DBRecordVersion: 3}, nil).Times(2)
+
+	recordingFactory, err := NewRecordingDataStoreFactory(
+		&config.PersistenceRecording{File: s.recordingFile, NamespaceID: "namespace-id", WorkflowID: "workflow-id"},
+		s.baseDataStoreFactory,
+		log.NewTestLogger(),
+	)
+	s.NoError(err)
+	executionStore, err := recordingFactory.NewExecutionStore()
+	s.NoError(err)
+	_, err = executionStore.GetWorkflowExecution(context.Background(), skippedRequest)
+	s.NoError(err)
+	_, err = executionStore.GetWorkflowExecution(context.Background(), recordedRequest)
+	s.NoError(err)
+	recordingFactory.Close()
+
+	calls, err := loadRecordedCalls(s.recordingFile)
+	s.NoError(err)
+	s.Len(calls, 1)
+	s.Len(calls[replayKey{store: config.ExecutionStoreName, method: "GetWorkflowExecution"}], 1)
+}
+
+func (s *recordingSuite) TestRecord_ReturnsOriginalError() {
+	updateShardRequest := &persistence.InternalUpdateShardRequest{ShardID: 1, RangeID: 2, PreviousRangeID: 1}
+	unknownErr := errors.New("not a persistence error")
+	s.mockShardStore.EXPECT().UpdateShard(gomock.Any(), updateShardRequest).Return(unknownErr)
+
+	recordingFactory, err := NewRecordingDataStoreFactory(
+		&config.PersistenceRecording{File: s.recordingFile},
+		s.baseDataStoreFactory,
+		log.NewTestLogger(),
+	)
+	s.NoError(err)
+	shardStore, err := recordingFactory.NewShardStore()
+	s.NoError(err)
+	err = shardStore.UpdateShard(context.Background(), updateShardRequest)
+	s.Same(unknownErr, err)
+	recordingFactory.Close()
+}
+
+func (f *testDataStoreFactory) Close() {}
+
+func (f *testDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	return f.shardStore, nil
+}
+
+func (f *testDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	return f.executionStore, nil
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// maxRecordedCallSize is the largest line accepted when reading a recording
+	maxRecordedCallSize = 64 * 1024 * 1024
+)
+
+type (
+	// ReplayDataStoreFactory wraps a DataStoreFactory and serves calls to its stores from a file written
+	// by RecordingDataStoreFactory. Calls are served in recorded order, separately for every store method.
+	// Once the recording for a method is exhausted, calls go to the wrapped stores.
+	ReplayDataStoreFactory struct {
+		interceptedDataStoreFactory
+	}
+
+	replayer struct {
+		logger log.Logger
+
+		sync.Mutex
+		calls map[replayKey][]*recordedCall
+	}
+
+	replayKey struct {
+		store  config.DataStoreName
+		method string
+	}
+)
+
+// NewReplayDataStoreFactory returns a ReplayDataStoreFactory which serves the recording in cfg.
+func NewReplayDataStoreFactory(
+	cfg *config.PersistenceReplay,
+	baseFactory DataStoreFactory,
+	logger log.Logger,
+) (*ReplayDataStoreFactory, error) {
+	calls, err := loadRecordedCalls(cfg.File)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplayDataStoreFactory{
+		interceptedDataStoreFactory: interceptedDataStoreFactory{
+			baseFactory: baseFactory,
+			interceptor: &replayer{
+				logger: logger,
+				calls:  calls,
+			},
+		},
+	}, nil
+}
+
+func loadRecordedCalls(
+	fileName string,
+) (map[replayKey][]*recordedCall, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	calls := make(map[replayKey][]*recordedCall)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxRecordedCallSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		call := &recordedCall{}
+		if err := json.Unmarshal(line, call); err != nil {
+			return nil, err
+		}
+		key := replayKey{store: call.Store, method: call.Method}
+		calls[key] = append(calls[key], call)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
+
+func (r *replayer) intercept(
+	store config.DataStoreName,
+	method string,
+	request any,
+	response any,
+	invoke func() error,
+) error {
+	call := r.next(store, method)
+	if call == nil {
+		return invoke()
+	}
+
+	if len(call.Request) != 0 {
+		if encoded, err := json.Marshal(request); err == nil && !bytes.Equal(encoded, call.Request) {
+			r.logger.Warn("Persistence request differs from recording.", tag.StoreType(string(store)), tag.Operation(method))
+		}
+	}
+	if len(call.Response) != 0 {
+		if err := json.Unmarshal(call.Response, response); err != nil {
+			r.logger.Error("Unable to decode recorded persistence response.", tag.StoreType(string(store)), tag.Operation(method), tag.Error(err))
+			return invoke()
+		}
+	}
+	return call.err()
+}
+
+func (r *replayer) next(
+	store config.DataStoreName,
+	method string,
+) *recordedCall {
+	r.Lock()
+	defer r.Unlock()
+
+	key := replayKey{store: store, method: method}
+	calls := r.calls[key]
+	if len(calls) == 0 {
+		return nil
+	}
+	r.calls[key] = calls[1:]
+	return calls[0]
+}
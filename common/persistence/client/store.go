@@ -27,6 +27,7 @@ package client
 import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
@@ -88,9 +89,26 @@ func DataStoreFactoryProvider(
 		logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
 
+	if defaultCfg.Replay != nil {
+		replayFactory, err := NewReplayDataStoreFactory(defaultCfg.Replay, dataStoreFactory, logger)
+		if err != nil {
+			logger.Fatal("unable to load persistence replay", tag.Error(err))
+		}
+		dataStoreFactory = replayFactory
+	}
+
+	if defaultCfg.Recording != nil {
+		recordingFactory, err := NewRecordingDataStoreFactory(defaultCfg.Recording, dataStoreFactory, logger)
+		if err != nil {
+			logger.Fatal("unable to open persistence recording", tag.Error(err))
+		}
+		dataStoreFactory = recordingFactory
+	}
+
 	var faultInjection *FaultInjectionDataStoreFactory
 	if defaultCfg.FaultInjection != nil {
-		dataStoreFactory = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory)
+		faultInjection = NewFaultInjectionDatastoreFactory(defaultCfg.FaultInjection, dataStoreFactory)
+		dataStoreFactory = faultInjection
 	}
 
 	return dataStoreFactory, faultInjection
@@ -29,9 +29,12 @@ import (
 	"fmt"
 	"math/rand"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/persistence"
 )
@@ -41,29 +44,42 @@ import (
 func NewTargetedDataStoreErrorGenerator(cfg *config.FaultInjectionDataStoreConfig) ErrorGenerator {
 	methods := make(map[string]ErrorGenerator, len(cfg.Methods))
 	for methodName, methodConfig := range cfg.Methods {
-		var faultWeights []FaultWeight
-		methodErrorRate := 0.0
-		for errorName, errorRate := range methodConfig.Errors {
-			err := getErrorFromName(errorName)
-			faultWeights = append(faultWeights, FaultWeight{
-				errFactory: func(data string) error {
-					return err
-				},
-				weight: errorRate,
-			})
-			methodErrorRate += errorRate
-		}
-		errorGenerator := NewDefaultErrorGenerator(methodErrorRate, faultWeights)
-		seed := methodConfig.Seed
-		if seed == 0 {
-			seed = time.Now().UnixNano()
-		}
-		errorGenerator.r = rand.New(rand.NewSource(seed))
-		methods[methodName] = errorGenerator
+		methods[methodName] = newNamedErrorGenerator(methodConfig.Errors, methodConfig.Seed)
 	}
 	return &dataStoreErrorGenerator{MethodErrorGenerators: methods}
 }
 
+// newNamedErrorGenerator returns an error generator for a map of error name to the rate of that error. See
+// getErrorFromName for the supported error names. If seed is zero, the current time is used instead.
+func newNamedErrorGenerator(errors map[string]float64, seed int64) *DefaultErrorGenerator {
+	// sample errors in a stable order so that a fixed seed produces the same faults on every run
+	errorNames := make([]string, 0, len(errors))
+	for errorName := range errors {
+		errorNames = append(errorNames, errorName)
+	}
+	sort.Strings(errorNames)
+
+	var faultWeights []FaultWeight
+	errorRate := 0.0
+	for _, errorName := range errorNames {
+		rate := errors[errorName]
+		err := getErrorFromName(errorName)
+		faultWeights = append(faultWeights, FaultWeight{
+			errFactory: func(data string) error {
+				return err
+			},
+			weight: rate,
+		})
+		errorRate += rate
+	}
+	errorGenerator := NewDefaultErrorGenerator(errorRate, faultWeights)
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	errorGenerator.r = rand.New(rand.NewSource(seed))
+	return errorGenerator
+}
+
 // dataStoreErrorGenerator is an implementation of ErrorGenerator that will inject errors into the persistence layer
 // using a per-method configuration.
 type dataStoreErrorGenerator struct {
@@ -103,6 +119,10 @@ func getErrorFromName(name string) error {
 		return &persistence.ShardOwnershipLostError{}
 	case "DeadlineExceededError":
 		return context.DeadlineExceeded
+	case "TimeoutError":
+		return &persistence.TimeoutError{Msg: "fault injection timeout"}
+	case "UnavailableError":
+		return serviceerror.NewUnavailable("fault injection unavailable")
 	default:
 		panic(fmt.Sprintf("unknown error type: %v", name))
 	}
@@ -234,7 +234,7 @@ func (m *clusterMetadataManagerImpl) convertInternalGetClusterMetadataResponse(
 func immutableFieldsChanged(old persistencespb.ClusterMetadata, cur persistencespb.ClusterMetadata) bool {
 	if (old.ClusterName != "" && old.ClusterName != cur.ClusterName) ||
 		(old.ClusterId != "" && old.ClusterId != cur.ClusterId) ||
-		(old.HistoryShardCount != 0 && old.HistoryShardCount != cur.HistoryShardCount) ||
+		(old.HistoryShardCount != 0 && old.HistoryShardCount != cur.HistoryShardCount && !historyShardCountMigrated(old, cur)) ||
 		(old.IsGlobalNamespaceEnabled && !cur.IsGlobalNamespaceEnabled) {
 		return true
 	}
@@ -246,3 +246,12 @@ func immutableFieldsChanged(old persistencespb.ClusterMetadata, cur persistences
 	}
 	return false
 }
+
+// historyShardCountMigrated returns true if the history shard count is changed to the target of
+// a history shard count migration after all source shards are migrated.
+func historyShardCountMigrated(old persistencespb.ClusterMetadata, cur persistencespb.ClusterMetadata) bool {
+	migration := old.HistoryShardCountMigration
+	return migration != nil &&
+		cur.HistoryShardCount == migration.TargetShardCount &&
+		int32(len(migration.MigratedShardIds)) == old.HistoryShardCount
+}
@@ -0,0 +1,575 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/historyshard"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	defaultPageSize = 100
+
+	// historyBranchMinAge skips branches which may belong to a workflow execution
+	// whose mutable state is not persisted yet.
+	historyBranchMinAge = time.Hour
+)
+
+type (
+	// Checker detects, and optionally repairs, records in one persistence store
+	// which are not backed by the corresponding records in another store.
+	// Each call to Check processes a single page so it can be driven by a client
+	// which persists the page token between calls.
+	Checker struct {
+		executionManager  persistence.ExecutionManager
+		taskManager       persistence.TaskManager
+		visibilityManager manager.VisibilityManager
+		namespaceRegistry namespace.Registry
+		shardResolver     historyshard.Resolver
+		logger            log.Logger
+	}
+)
+
+var (
+	// cleanupTaskTypes reference workflow executions which are being or have been deleted on purpose.
+	cleanupTaskTypes = map[enumsspb.TaskType]struct{}{
+		enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION:   {},
+		enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION: {},
+		enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:        {},
+	}
+)
+
+func NewChecker(
+	executionManager persistence.ExecutionManager,
+	taskManager persistence.TaskManager,
+	visibilityManager manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	shardResolver historyshard.Resolver,
+	logger log.Logger,
+) *Checker {
+	return &Checker{
+		executionManager:  executionManager,
+		taskManager:       taskManager,
+		visibilityManager: visibilityManager,
+		namespaceRegistry: namespaceRegistry,
+		shardResolver:     shardResolver,
+		logger:            logger,
+	}
+}
+
+// Check runs the requested check against one page of records.
+func (c *Checker) Check(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if request.GetRepair() &&
+		request.GetCheckType() != enumsspb.CONSISTENCY_CHECK_TYPE_TASK_QUEUE &&
+		c.shardResolver.Layout().IsMigrating() {
+		// executions are moved between shards during the migration, records which look
+		// orphaned on one shard may be backed by an execution on another shard
+		return nil, serviceerror.NewFailedPrecondition(
+			"repair is not allowed while the history shard count is being migrated",
+		)
+	}
+
+	switch request.GetCheckType() {
+	case enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_BRANCH:
+		return c.checkHistoryBranches(ctx, request, pageSize)
+	case enumsspb.CONSISTENCY_CHECK_TYPE_CURRENT_EXECUTION:
+		if err := c.validateShardID(request.GetShardId()); err != nil {
+			return nil, err
+		}
+		return c.checkCurrentExecutions(ctx, request, pageSize)
+	case enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_TASK:
+		if err := c.validateShardID(request.GetShardId()); err != nil {
+			return nil, err
+		}
+		return c.checkHistoryTasks(ctx, request, pageSize)
+	case enumsspb.CONSISTENCY_CHECK_TYPE_VISIBILITY:
+		return c.checkVisibility(ctx, request, pageSize)
+	case enumsspb.CONSISTENCY_CHECK_TYPE_TASK_QUEUE:
+		return c.checkTaskQueues(ctx, request, pageSize)
+	default:
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("unknown consistency check type: %v", request.GetCheckType()),
+		)
+	}
+}
+
+func (c *Checker) checkHistoryBranches(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	pageSize int,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	resp, err := c.executionManager.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.CheckPersistenceConsistencyResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, branch := range resp.Branches {
+		result.CheckedCount++
+		if time.Now().UTC().Add(-historyBranchMinAge).Before(timestamp.TimeValue(branch.ForkTime)) {
+			continue
+		}
+
+		namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+		if err != nil {
+			c.logger.Warn("unable to parse history branch info", tag.DetailInfo(branch.Info), tag.Error(err))
+			continue
+		}
+		shardID, err := c.shardResolver.WorkflowShardID(namespace.ID(namespaceID), workflowID)
+		if err != nil {
+			// the workflow is being moved to another shard
+			continue
+		}
+		exists, err := c.executionExists(ctx, shardID, namespaceID, workflowID, runID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		issue := &adminservice.ConsistencyIssue{
+			ShardId:     shardID,
+			NamespaceId: namespaceID,
+			WorkflowId:  workflowID,
+			RunId:       runID,
+			Details:     "history branch without workflow execution",
+		}
+		if request.GetRepair() {
+			c.repairHistoryBranch(ctx, issue, branch.BranchToken)
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+func (c *Checker) checkCurrentExecutions(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	pageSize int,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	shardID := request.GetShardId()
+	resp, err := c.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  pageSize,
+		PageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.CheckPersistenceConsistencyResponse{
+		NextPageToken: resp.PageToken,
+	}
+	// Current executions are only reachable through the runs of the same workflow.
+	checked := make(map[string]struct{}, len(resp.States))
+	for _, state := range resp.States {
+		namespaceID := state.GetExecutionInfo().GetNamespaceId()
+		workflowID := state.GetExecutionInfo().GetWorkflowId()
+		key := namespaceID + "/" + workflowID
+		if _, ok := checked[key]; ok {
+			continue
+		}
+		checked[key] = struct{}{}
+		result.CheckedCount++
+
+		current, err := c.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: namespaceID,
+			WorkflowID:  workflowID,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			continue
+		default:
+			return nil, err
+		}
+		if current.RunID == state.GetExecutionState().GetRunId() {
+			continue
+		}
+		exists, err := c.executionExists(ctx, shardID, namespaceID, workflowID, current.RunID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		issue := &adminservice.ConsistencyIssue{
+			ShardId:     shardID,
+			NamespaceId: namespaceID,
+			WorkflowId:  workflowID,
+			RunId:       current.RunID,
+			Details:     "current execution pointing to missing run",
+		}
+		if request.GetRepair() {
+			c.repair(issue, c.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: namespaceID,
+				WorkflowID:  workflowID,
+				RunID:       current.RunID,
+			}))
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+func (c *Checker) checkHistoryTasks(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	pageSize int,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	shardID := request.GetShardId()
+	category, ok := tasks.GetCategoryByID(int32(request.GetCategory()))
+	if !ok || category == tasks.CategoryMemoryTimer {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("invalid task category: %v", request.GetCategory()),
+		)
+	}
+
+	var minTaskKey, maxTaskKey tasks.Key
+	switch category.Type() {
+	case tasks.CategoryTypeImmediate:
+		minTaskKey = tasks.NewImmediateKey(0)
+		maxTaskKey = tasks.NewImmediateKey(math.MaxInt64)
+	default:
+		minTaskKey = tasks.NewKey(tasks.DefaultFireTime, 0)
+		maxTaskKey = tasks.NewKey(tasks.MaximumKey.FireTime, 0)
+	}
+	resp, err := c.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+		ShardID:             shardID,
+		TaskCategory:        category,
+		ReaderID:            common.DefaultQueueReaderID,
+		InclusiveMinTaskKey: minTaskKey,
+		ExclusiveMaxTaskKey: maxTaskKey,
+		BatchSize:           pageSize,
+		NextPageToken:       request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.CheckPersistenceConsistencyResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, task := range resp.Tasks {
+		result.CheckedCount++
+		if _, ok := cleanupTaskTypes[task.GetType()]; ok {
+			continue
+		}
+		exists, err := c.executionExists(ctx, shardID, task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		issue := &adminservice.ConsistencyIssue{
+			ShardId:     shardID,
+			NamespaceId: task.GetNamespaceID(),
+			WorkflowId:  task.GetWorkflowID(),
+			RunId:       task.GetRunID(),
+			Details: fmt.Sprintf(
+				"%v task %v referencing missing workflow execution",
+				task.GetType(),
+				task.GetKey(),
+			),
+		}
+		if request.GetRepair() {
+			c.repair(issue, c.executionManager.CompleteHistoryTask(ctx, &persistence.CompleteHistoryTaskRequest{
+				ShardID:      shardID,
+				TaskCategory: category,
+				TaskKey:      task.GetKey(),
+			}))
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+func (c *Checker) checkVisibility(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	pageSize int,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	ns, err := c.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   ns.ID(),
+		Namespace:     ns.Name(),
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.CheckPersistenceConsistencyResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, execution := range resp.Executions {
+		result.CheckedCount++
+		workflowID := execution.GetExecution().GetWorkflowId()
+		runID := execution.GetExecution().GetRunId()
+		shardID, err := c.shardResolver.WorkflowShardID(ns.ID(), workflowID)
+		if err != nil {
+			// the workflow is being moved to another shard
+			continue
+		}
+		exists, err := c.executionExists(ctx, shardID, ns.ID().String(), workflowID, runID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		issue := &adminservice.ConsistencyIssue{
+			ShardId:     shardID,
+			NamespaceId: ns.ID().String(),
+			WorkflowId:  workflowID,
+			RunId:       runID,
+			Details:     "visibility record without workflow execution",
+		}
+		if request.GetRepair() {
+			deleteRequest := &manager.VisibilityDeleteWorkflowExecutionRequest{
+				NamespaceID: ns.ID(),
+				WorkflowID:  workflowID,
+				RunID:       runID,
+				// Same as the history service, max int64 makes sure the deletion is applied last.
+				TaskID: math.MaxInt64,
+			}
+			// Cassandra requires the time to locate either the open or the closed record.
+			if execution.GetCloseTime() != nil {
+				deleteRequest.CloseTime = execution.GetCloseTime()
+			} else {
+				deleteRequest.StartTime = execution.GetStartTime()
+			}
+			c.repair(issue, c.visibilityManager.DeleteWorkflowExecution(ctx, deleteRequest))
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+func (c *Checker) checkTaskQueues(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+	pageSize int,
+) (*adminservice.CheckPersistenceConsistencyResponse, error) {
+	resp, err := c.taskManager.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+		PageSize:  pageSize,
+		PageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adminservice.CheckPersistenceConsistencyResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, item := range resp.Items {
+		result.CheckedCount++
+		namespaceID := item.Data.GetNamespaceId()
+		_, err := c.namespaceRegistry.GetNamespaceByID(namespace.ID(namespaceID))
+		switch err.(type) {
+		case nil:
+			continue
+		case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		default:
+			return nil, err
+		}
+
+		issue := &adminservice.ConsistencyIssue{
+			NamespaceId: namespaceID,
+			Details: fmt.Sprintf(
+				"task queue %v of type %v belonging to missing namespace",
+				item.Data.GetName(),
+				item.Data.GetTaskType(),
+			),
+		}
+		if request.GetRepair() {
+			c.repair(issue, c.taskManager.DeleteTaskQueue(ctx, &persistence.DeleteTaskQueueRequest{
+				TaskQueue: &persistence.TaskQueueKey{
+					NamespaceID:   namespaceID,
+					TaskQueueName: item.Data.GetName(),
+					TaskQueueType: item.Data.GetTaskType(),
+				},
+				RangeID: item.RangeID,
+			}))
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+// repairHistoryBranch deletes the history branch only once it is confirmed to be orphaned under
+// the shard owning its workflow: the shard layout is reloaded, and neither the execution nor a
+// current execution record pointing to the run, which is written before the execution is
+// created, may exist on the owning shard.
+func (c *Checker) repairHistoryBranch(
+	ctx context.Context,
+	issue *adminservice.ConsistencyIssue,
+	branchToken []byte,
+) {
+	layout, err := c.shardResolver.Refresh(ctx)
+	if err != nil {
+		c.repair(issue, err)
+		return
+	}
+	shardID, err := layout.WorkflowShardID(namespace.ID(issue.NamespaceId), issue.WorkflowId)
+	if err != nil {
+		c.repair(issue, err)
+		return
+	}
+	if layout.IsMigrating() || shardID != issue.ShardId {
+		c.repair(issue, serviceerror.NewFailedPrecondition("history shard layout changed during the check"))
+		return
+	}
+
+	exists, err := c.executionExists(ctx, shardID, issue.NamespaceId, issue.WorkflowId, issue.RunId)
+	if err != nil {
+		c.repair(issue, err)
+		return
+	}
+	if exists {
+		c.repair(issue, serviceerror.NewFailedPrecondition("workflow execution of the history branch exists"))
+		return
+	}
+	current, err := c.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: issue.NamespaceId,
+		WorkflowID:  issue.WorkflowId,
+	})
+	switch err.(type) {
+	case nil:
+		if current.RunID == issue.RunId {
+			c.repair(issue, serviceerror.NewFailedPrecondition("history branch belongs to the current run of the workflow"))
+			return
+		}
+	case *serviceerror.NotFound:
+	default:
+		c.repair(issue, err)
+		return
+	}
+
+	c.repair(issue, c.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: branchToken,
+	}))
+}
+
+func (c *Checker) executionExists(
+	ctx context.Context,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	_, err := c.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (c *Checker) repair(
+	issue *adminservice.ConsistencyIssue,
+	err error,
+) {
+	if err != nil {
+		c.logger.Warn("unable to repair persistence inconsistency",
+			tag.ShardID(issue.ShardId),
+			tag.WorkflowNamespaceID(issue.NamespaceId),
+			tag.WorkflowID(issue.WorkflowId),
+			tag.WorkflowRunID(issue.RunId),
+			tag.Error(err),
+		)
+		issue.RepairError = err.Error()
+		return
+	}
+	c.logger.Info("repaired persistence inconsistency",
+		tag.ShardID(issue.ShardId),
+		tag.WorkflowNamespaceID(issue.NamespaceId),
+		tag.WorkflowID(issue.WorkflowId),
+		tag.WorkflowRunID(issue.RunId),
+		tag.Value(issue.Details),
+	)
+	issue.Repaired = true
+}
+
+func (c *Checker) validateShardID(shardID int32) error {
+	maxShardCount := c.shardResolver.Layout().MaxShardCount()
+	if shardID <= 0 || shardID > maxShardCount {
+		return serviceerror.NewInvalidArgument(
+			fmt.Sprintf("invalid shard ID: %v, must be in [1, %v]", shardID, maxShardCount),
+		)
+	}
+	return nil
+}
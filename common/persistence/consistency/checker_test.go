@@ -0,0 +1,321 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package consistency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/historyshard"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	testNumHistoryShards = 4
+	testNamespaceID      = "deadbeef-0123-4567-890a-bcdef0123456"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+)
+
+type (
+	checkerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		executionManager  *persistence.MockExecutionManager
+		taskManager       *persistence.MockTaskManager
+		visibilityManager *manager.MockVisibilityManager
+		namespaceRegistry *namespace.MockRegistry
+
+		shardID int32
+		checker *Checker
+	}
+)
+
+func TestCheckerSuite(t *testing.T) {
+	s := new(checkerSuite)
+	suite.Run(t, s)
+}
+
+func (s *checkerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.executionManager = persistence.NewMockExecutionManager(s.controller)
+	s.taskManager = persistence.NewMockTaskManager(s.controller)
+	s.visibilityManager = manager.NewMockVisibilityManager(s.controller)
+	s.namespaceRegistry = namespace.NewMockRegistry(s.controller)
+
+	s.shardID = common.WorkflowIDToHistoryShard(testNamespaceID, testWorkflowID, testNumHistoryShards)
+	s.checker = NewChecker(
+		s.executionManager,
+		s.taskManager,
+		s.visibilityManager,
+		s.namespaceRegistry,
+		historyshard.NewStaticResolver(testNumHistoryShards),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *checkerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *checkerSuite) TestHistoryBranch_Repair() {
+	branchToken := []byte("branch-token")
+	s.executionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize: defaultPageSize,
+	}).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchToken: branchToken,
+				ForkTime:    timestamp.TimePtr(time.Now().UTC().Add(-2 * historyBranchMinAge)),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo(testNamespaceID, testWorkflowID, testRunID),
+			},
+			{
+				// too recent to be checked
+				BranchToken: []byte("new-branch-token"),
+				ForkTime:    timestamp.TimePtr(time.Now().UTC()),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo(testNamespaceID, testWorkflowID, "new-run-id"),
+			},
+		},
+		NextPageToken: []byte("next-page-token"),
+	}, nil)
+	s.expectExecutionNotFound(testRunID).Times(2)
+	s.expectCurrentExecutionNotFound()
+	s.executionManager.EXPECT().DeleteHistoryBranch(gomock.Any(), &persistence.DeleteHistoryBranchRequest{
+		ShardID:     s.shardID,
+		BranchToken: branchToken,
+	}).Return(nil)
+
+	resp, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_BRANCH,
+		Repair:    true,
+	})
+	s.NoError(err)
+	s.Equal(int32(2), resp.CheckedCount)
+	s.Equal([]byte("next-page-token"), resp.NextPageToken)
+	s.Len(resp.Issues, 1)
+	s.Equal(testRunID, resp.Issues[0].RunId)
+	s.True(resp.Issues[0].Repaired)
+}
+
+func (s *checkerSuite) TestHistoryBranch_RepairSkipCurrentRun() {
+	s.executionManager.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			{
+				BranchToken: []byte("branch-token"),
+				ForkTime:    timestamp.TimePtr(time.Now().UTC().Add(-2 * historyBranchMinAge)),
+				Info:        persistence.BuildHistoryGarbageCleanupInfo(testNamespaceID, testWorkflowID, testRunID),
+			},
+		},
+	}, nil)
+	s.expectExecutionNotFound(testRunID).Times(2)
+	s.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+		ShardID:     s.shardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: testRunID,
+	}, nil)
+
+	resp, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_BRANCH,
+		Repair:    true,
+	})
+	s.NoError(err)
+	s.Len(resp.Issues, 1)
+	s.False(resp.Issues[0].Repaired)
+	s.NotEmpty(resp.Issues[0].RepairError)
+}
+
+func (s *checkerSuite) TestRepair_ShardMigrating() {
+	checker := NewChecker(
+		s.executionManager,
+		s.taskManager,
+		s.visibilityManager,
+		s.namespaceRegistry,
+		historyshard.NewMockResolver(s.controller),
+		log.NewNoopLogger(),
+	)
+	checker.shardResolver.(*historyshard.MockResolver).EXPECT().Layout().Return(&historyshard.Layout{
+		ShardCount:       testNumHistoryShards,
+		TargetShardCount: 2 * testNumHistoryShards,
+	})
+
+	_, err := checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_BRANCH,
+		Repair:    true,
+	})
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+}
+
+func (s *checkerSuite) TestCurrentExecution_ReportOnly() {
+	s.executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).Return(&persistence.ListConcreteExecutionsResponse{
+		States: []*persistencespb.WorkflowMutableState{
+			s.mutableState(testRunID),
+			s.mutableState("other-run-id"),
+		},
+	}, nil)
+	s.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+		ShardID:     s.shardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+	}).Return(&persistence.GetCurrentExecutionResponse{
+		RunID: "missing-run-id",
+	}, nil)
+	s.expectExecutionNotFound("missing-run-id")
+
+	resp, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_CURRENT_EXECUTION,
+		ShardId:   s.shardID,
+	})
+	s.NoError(err)
+	s.Equal(int32(1), resp.CheckedCount)
+	s.Len(resp.Issues, 1)
+	s.Equal("missing-run-id", resp.Issues[0].RunId)
+	s.False(resp.Issues[0].Repaired)
+}
+
+func (s *checkerSuite) TestHistoryTask_SkipCleanupTasks() {
+	workflowKey := definition.NewWorkflowKey(testNamespaceID, testWorkflowID, testRunID)
+	s.executionManager.EXPECT().GetHistoryTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			&tasks.ActivityTask{WorkflowKey: workflowKey, TaskID: 1},
+			&tasks.DeleteExecutionTask{WorkflowKey: workflowKey, TaskID: 2},
+		},
+	}, nil)
+	s.expectExecutionNotFound(testRunID)
+	s.executionManager.EXPECT().CompleteHistoryTask(gomock.Any(), &persistence.CompleteHistoryTaskRequest{
+		ShardID:      s.shardID,
+		TaskCategory: tasks.CategoryTransfer,
+		TaskKey:      tasks.NewImmediateKey(1),
+	}).Return(serviceerror.NewUnavailable("unavailable"))
+
+	resp, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_TASK,
+		ShardId:   s.shardID,
+		Category:  enumsspb.TASK_CATEGORY_TRANSFER,
+		Repair:    true,
+	})
+	s.NoError(err)
+	s.Equal(int32(2), resp.CheckedCount)
+	s.Len(resp.Issues, 1)
+	s.False(resp.Issues[0].Repaired)
+	s.Equal("unavailable", resp.Issues[0].RepairError)
+}
+
+func (s *checkerSuite) TestTaskQueue_Repair() {
+	s.taskManager.EXPECT().ListTaskQueue(gomock.Any(), gomock.Any()).Return(&persistence.ListTaskQueueResponse{
+		Items: []*persistence.PersistedTaskQueueInfo{
+			{
+				Data: &persistencespb.TaskQueueInfo{
+					NamespaceId: testNamespaceID,
+					Name:        "test-task-queue",
+					TaskType:    enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				},
+				RangeID: 10,
+			},
+		},
+	}, nil)
+	s.namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID(testNamespaceID)).
+		Return(nil, serviceerror.NewNamespaceNotFound(testNamespaceID))
+	s.taskManager.EXPECT().DeleteTaskQueue(gomock.Any(), &persistence.DeleteTaskQueueRequest{
+		TaskQueue: &persistence.TaskQueueKey{
+			NamespaceID:   testNamespaceID,
+			TaskQueueName: "test-task-queue",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		},
+		RangeID: 10,
+	}).Return(nil)
+
+	resp, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_TASK_QUEUE,
+		Repair:    true,
+	})
+	s.NoError(err)
+	s.Len(resp.Issues, 1)
+	s.True(resp.Issues[0].Repaired)
+}
+
+func (s *checkerSuite) TestInvalidRequest() {
+	_, err := s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_CURRENT_EXECUTION,
+		ShardId:   testNumHistoryShards + 1,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.checker.Check(context.Background(), &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: enumsspb.CONSISTENCY_CHECK_TYPE_UNSPECIFIED,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *checkerSuite) expectExecutionNotFound(runID string) *gomock.Call {
+	return s.executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		ShardID:     s.shardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       runID,
+	}).Return(nil, serviceerror.NewNotFound("workflow execution not found"))
+}
+
+func (s *checkerSuite) expectCurrentExecutionNotFound() {
+	s.executionManager.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
+		ShardID:     s.shardID,
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+	}).Return(nil, serviceerror.NewNotFound("current workflow execution not found"))
+}
+
+func (s *checkerSuite) mutableState(runID string) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId: testNamespaceID,
+			WorkflowId:  testWorkflowID,
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId: runID,
+		},
+	}
+}
@@ -57,6 +57,15 @@ const (
 	NamespaceReplicationQueueType QueueType = iota + 1
 )
 
+// historyTaskQueueTypeBase is the queue type of the history task queue of shard 0. Each shard has its own
+// history task queue, which only uses the DLQ side of the queue to store the history tasks which kept failing.
+const historyTaskQueueTypeBase QueueType = 1 << 20
+
+// HistoryTaskQueueType returns the type of the history task queue of the shard
+func HistoryTaskQueueType(shardID int32) QueueType {
+	return historyTaskQueueTypeBase + QueueType(shardID)
+}
+
 // Create Workflow Execution Mode
 const (
 	// CreateWorkflowModeBrandNew fail if current record exists
@@ -339,6 +348,8 @@ type (
 		PrevTxnID   int64
 		TxnID       int64
 		Events      []*historypb.HistoryEvent
+		// EncodingType of the persisted events, defaults to proto3 if unspecified
+		EncodingType enumspb.EncodingType
 	}
 
 	// WorkflowMutation is used as generic workflow execution state mutation
@@ -731,6 +742,8 @@ type (
 		PrevTransactionID int64
 		// requested TransactionID for this write operation. For the same eventID, the node with larger TransactionID always wins
 		TransactionID int64
+		// EncodingType of the persisted events, defaults to proto3 if unspecified
+		EncodingType enumspb.EncodingType
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -837,6 +850,8 @@ type (
 		HistoryEventBlobs []*commonpb.DataBlob
 		// NodeIDs is the first event id of each history blob
 		NodeIDs []int64
+		// TransactionIDs is the transaction ID of each history blob
+		TransactionIDs []int64
 		// Token to read next page if there are more events beyond page size.
 		// Use this to set NextPageToken on ReadHistoryBranchRequest to read the next page.
 		// Empty means we have reached the last page, not need to continue
@@ -27,6 +27,8 @@ package persistence
 import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 // NewDataBlob returns a new DataBlob
@@ -36,14 +38,14 @@ func NewDataBlob(data []byte, encodingTypeStr string) *commonpb.DataBlob {
 		return nil
 	}
 
-	encodingType, ok := enumspb.EncodingType_value[encodingTypeStr]
+	encodingType, ok := serialization.EncodingTypeFromString(encodingTypeStr)
 	if !ok {
 		// encodingTypeStr not valid, an error will be returned on deserialization
-		encodingType = int32(enumspb.ENCODING_TYPE_UNSPECIFIED)
+		encodingType = enumspb.ENCODING_TYPE_UNSPECIFIED
 	}
 
 	return &commonpb.DataBlob{
 		Data:         data,
-		EncodingType: enumspb.EncodingType(encodingType),
+		EncodingType: encodingType,
 	}
 }
@@ -451,6 +451,7 @@ func (m *executionManagerImpl) serializeWorkflowEvents(
 		Events:            workflowEvents.Events,
 		PrevTransactionID: workflowEvents.PrevTxnID,
 		TransactionID:     workflowEvents.TxnID,
+		EncodingType:      workflowEvents.EncodingType,
 	}
 
 	if workflowEvents.Events[0].EventId == common.FirstEventID {
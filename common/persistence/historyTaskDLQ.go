@@ -0,0 +1,240 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination historyTaskDLQ_mock.go
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// HistoryTaskDLQ stores the history tasks which kept failing, so they stop blocking the progress of their queue.
+	// Each shard has its own queue, so the tasks of a shard are read and purged without going through the tasks of
+	// the other shards.
+	HistoryTaskDLQ interface {
+		EnqueueTask(ctx context.Context, request *EnqueueHistoryTaskDLQRequest) (int64, error)
+		ReadTasks(ctx context.Context, request *ReadHistoryTaskDLQRequest) (*ReadHistoryTaskDLQResponse, error)
+		DeleteTask(ctx context.Context, shardID int32, messageID int64) error
+		// RangeDeleteTasks deletes the tasks of the shard with a message ID up to inclusiveEndMessageID
+		RangeDeleteTasks(ctx context.Context, shardID int32, inclusiveEndMessageID int64) error
+	}
+
+	// NewQueueFn creates the queue of the given type
+	NewQueueFn func(queueType QueueType) (Queue, error)
+
+	// EnqueueHistoryTaskDLQRequest is used to move a history task to the DLQ
+	EnqueueHistoryTaskDLQRequest struct {
+		ShardID   int32
+		Task      tasks.Task
+		Attempt   int32
+		LastError string
+	}
+
+	// ReadHistoryTaskDLQRequest is used to read the history tasks of a shard in the DLQ with a message ID in
+	// (FirstMessageID, LastMessageID]
+	ReadHistoryTaskDLQRequest struct {
+		ShardID        int32
+		FirstMessageID int64
+		LastMessageID  int64
+		PageSize       int
+		NextPageToken  []byte
+	}
+
+	// ReadHistoryTaskDLQResponse is the response to ReadHistoryTaskDLQRequest
+	ReadHistoryTaskDLQResponse struct {
+		Tasks         []*HistoryDLQTask
+		NextPageToken []byte
+	}
+
+	// HistoryDLQTask is a history task stored in the DLQ
+	HistoryDLQTask struct {
+		MessageID   int64
+		ShardID     int32
+		Task        tasks.Task
+		Attempt     int32
+		LastError   string
+		EnqueueTime time.Time
+	}
+
+	historyTaskDLQImpl struct {
+		newQueue   NewQueueFn
+		serializer serialization.Serializer
+
+		sync.Mutex
+		queues map[int32]Queue
+	}
+)
+
+var _ HistoryTaskDLQ = (*historyTaskDLQImpl)(nil)
+
+// NewHistoryTaskDLQ creates a new HistoryTaskDLQ instance
+func NewHistoryTaskDLQ(
+	newQueue NewQueueFn,
+	serializer serialization.Serializer,
+) HistoryTaskDLQ {
+	return &historyTaskDLQImpl{
+		newQueue:   newQueue,
+		serializer: serializer,
+		queues:     make(map[int32]Queue),
+	}
+}
+
+func (q *historyTaskDLQImpl) EnqueueTask(
+	ctx context.Context,
+	request *EnqueueHistoryTaskDLQRequest,
+) (int64, error) {
+	taskBlob, err := q.serializer.SerializeTask(request.Task)
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode task: %v", err)
+	}
+	category := request.Task.GetCategory()
+	message := &persistencespb.HistoryTaskDLQMessage{
+		ShardId:     request.ShardID,
+		CategoryId:  category.ID(),
+		Task:        &taskBlob,
+		Attempt:     request.Attempt,
+		LastError:   request.LastError,
+		EnqueueTime: timestamp.TimeNowPtrUtc(),
+	}
+	data, err := message.Marshal()
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode message: %v", err)
+	}
+	queue, err := q.getQueue(request.ShardID)
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+	return queue.EnqueueMessageToDLQ(ctx, *NewDataBlob(data, enumspb.ENCODING_TYPE_PROTO3.String()))
+}
+
+func (q *historyTaskDLQImpl) ReadTasks(
+	ctx context.Context,
+	request *ReadHistoryTaskDLQRequest,
+) (*ReadHistoryTaskDLQResponse, error) {
+	queue, err := q.getQueue(request.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	messages, token, err := queue.ReadMessagesFromDLQ(
+		ctx,
+		request.FirstMessageID,
+		request.LastMessageID,
+		request.PageSize,
+		request.NextPageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dlqTasks := make([]*HistoryDLQTask, 0, len(messages))
+	for _, message := range messages {
+		dlqTask, err := q.taskFromMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		dlqTasks = append(dlqTasks, dlqTask)
+	}
+	return &ReadHistoryTaskDLQResponse{
+		Tasks:         dlqTasks,
+		NextPageToken: token,
+	}, nil
+}
+
+func (q *historyTaskDLQImpl) DeleteTask(
+	ctx context.Context,
+	shardID int32,
+	messageID int64,
+) error {
+	queue, err := q.getQueue(shardID)
+	if err != nil {
+		return err
+	}
+	return queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (q *historyTaskDLQImpl) RangeDeleteTasks(
+	ctx context.Context,
+	shardID int32,
+	inclusiveEndMessageID int64,
+) error {
+	queue, err := q.getQueue(shardID)
+	if err != nil {
+		return err
+	}
+	return queue.RangeDeleteMessagesFromDLQ(ctx, EmptyQueueMessageID, inclusiveEndMessageID)
+}
+
+func (q *historyTaskDLQImpl) getQueue(
+	shardID int32,
+) (Queue, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	if queue, ok := q.queues[shardID]; ok {
+		return queue, nil
+	}
+	queue, err := q.newQueue(HistoryTaskQueueType(shardID))
+	if err != nil {
+		return nil, err
+	}
+	q.queues[shardID] = queue
+	return queue, nil
+}
+
+func (q *historyTaskDLQImpl) taskFromMessage(
+	message *QueueMessage,
+) (*HistoryDLQTask, error) {
+	dlqMessage := &persistencespb.HistoryTaskDLQMessage{}
+	if err := dlqMessage.Unmarshal(message.Data); err != nil {
+		return nil, fmt.Errorf("failed to decode dlq message: %v", err)
+	}
+	category, ok := tasks.GetCategoryByID(dlqMessage.GetCategoryId())
+	if !ok {
+		return nil, fmt.Errorf("unknown task category %v in dlq message %v", dlqMessage.GetCategoryId(), message.ID)
+	}
+	task, err := q.serializer.DeserializeTask(category, *dlqMessage.GetTask())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode dlq task: %v", err)
+	}
+	return &HistoryDLQTask{
+		MessageID:   message.ID,
+		ShardID:     dlqMessage.GetShardId(),
+		Task:        task,
+		Attempt:     dlqMessage.GetAttempt(),
+		LastError:   dlqMessage.GetLastError(),
+		EnqueueTime: *dlqMessage.GetEnqueueTime(),
+	}, nil
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: historyTaskDLQ.go
+
+// Package persistence is a generated GoMock package.
+package persistence
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHistoryTaskDLQ is a mock of HistoryTaskDLQ interface.
+type MockHistoryTaskDLQ struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryTaskDLQMockRecorder
+}
+
+// MockHistoryTaskDLQMockRecorder is the mock recorder for MockHistoryTaskDLQ.
+type MockHistoryTaskDLQMockRecorder struct {
+	mock *MockHistoryTaskDLQ
+}
+
+// NewMockHistoryTaskDLQ creates a new mock instance.
+func NewMockHistoryTaskDLQ(ctrl *gomock.Controller) *MockHistoryTaskDLQ {
+	mock := &MockHistoryTaskDLQ{ctrl: ctrl}
+	mock.recorder = &MockHistoryTaskDLQMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryTaskDLQ) EXPECT() *MockHistoryTaskDLQMockRecorder {
+	return m.recorder
+}
+
+// DeleteTask mocks base method.
+func (m *MockHistoryTaskDLQ) DeleteTask(ctx context.Context, shardID int32, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, shardID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockHistoryTaskDLQMockRecorder) DeleteTask(ctx, shardID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockHistoryTaskDLQ)(nil).DeleteTask), ctx, shardID, messageID)
+}
+
+// EnqueueTask mocks base method.
+func (m *MockHistoryTaskDLQ) EnqueueTask(ctx context.Context, request *EnqueueHistoryTaskDLQRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueTask", ctx, request)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueTask indicates an expected call of EnqueueTask.
+func (mr *MockHistoryTaskDLQMockRecorder) EnqueueTask(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTask", reflect.TypeOf((*MockHistoryTaskDLQ)(nil).EnqueueTask), ctx, request)
+}
+
+// RangeDeleteTasks mocks base method.
+func (m *MockHistoryTaskDLQ) RangeDeleteTasks(ctx context.Context, shardID int32, inclusiveEndMessageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteTasks", ctx, shardID, inclusiveEndMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RangeDeleteTasks indicates an expected call of RangeDeleteTasks.
+func (mr *MockHistoryTaskDLQMockRecorder) RangeDeleteTasks(ctx, shardID, inclusiveEndMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteTasks", reflect.TypeOf((*MockHistoryTaskDLQ)(nil).RangeDeleteTasks), ctx, shardID, inclusiveEndMessageID)
+}
+
+// ReadTasks mocks base method.
+func (m *MockHistoryTaskDLQ) ReadTasks(ctx context.Context, request *ReadHistoryTaskDLQRequest) (*ReadHistoryTaskDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTasks", ctx, request)
+	ret0, _ := ret[0].(*ReadHistoryTaskDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTasks indicates an expected call of ReadTasks.
+func (mr *MockHistoryTaskDLQMockRecorder) ReadTasks(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskDLQ)(nil).ReadTasks), ctx, request)
+}
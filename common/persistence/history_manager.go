@@ -434,8 +434,13 @@ func (m *executionManagerImpl) serializeAppendHistoryNodesRequest(
 		lastID++
 	}
 
+	encodingType := request.EncodingType
+	if encodingType == enumspb.ENCODING_TYPE_UNSPECIFIED {
+		encodingType = enumspb.ENCODING_TYPE_PROTO3
+	}
+
 	// nodeID will be the first eventID
-	blob, err := m.serializer.SerializeEvents(request.Events, enumspb.ENCODING_TYPE_PROTO3)
+	blob, err := m.serializer.SerializeEvents(request.Events, encodingType)
 	if err != nil {
 		return nil, err
 	}
@@ -607,7 +612,7 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 	request *ReadHistoryBranchRequest,
 ) (*ReadRawHistoryBranchResponse, error) {
 
-	dataBlobs, _, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
+	dataBlobs, transactionIDs, nodeIDs, token, dataSize, err := m.readRawHistoryBranchAndFilter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -620,6 +625,7 @@ func (m *executionManagerImpl) ReadRawHistoryBranch(
 	return &ReadRawHistoryBranchResponse{
 		HistoryEventBlobs: dataBlobs,
 		NodeIDs:           nodeIDs,
+		TransactionIDs:    transactionIDs,
 		NextPageToken:     nextPageToken,
 		Size:              dataSize,
 	}, nil
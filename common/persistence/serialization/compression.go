@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const (
+	// EncodingTypeProto3Zstd is a server internal encoding for proto3 blobs compressed with zstd
+	// using a shared dictionary. It is not part of the public API, so blobs with this encoding
+	// must go through DecompressBlob before they are handed to clients.
+	EncodingTypeProto3Zstd enumspb.EncodingType = 1001
+
+	encodingTypeProto3ZstdName = "Proto3Zstd"
+
+	// historyEventsDictID identifies historyEventsDict in zstd frame headers. The dictionary content
+	// must never change once released: add a new dictionary with a new ID instead and keep the old
+	// one registered with the decoder, so that existing blobs remain readable.
+	historyEventsDictID uint32 = 1
+)
+
+var (
+	// historyEventsDict is a raw content dictionary made of byte sequences that show up in most
+	// history events: payload metadata keys and values, and well known SDK identifiers.
+	historyEventsDict = []byte(
+		"encoding" + "json/plain" + "binary/null" + "binary/plain" + "binary/protobuf" + "json/protobuf" +
+			"binary/encrypted" + "messageType" + "encryption-key-id" + "__temporal_" + "__stack_trace" +
+			"TemporalChangeVersion" + "TemporalScheduledById" + "TemporalScheduledStartTime" +
+			"temporal-sys-" + "TimeoutError" + "ApplicationError" + "CanceledError" + "PanicError" +
+			"temporal-go" + "temporal-java" + "temporal-typescript" + "temporal-python" + "temporal-dotnet",
+	)
+
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+// EncodingTypeFromString parses the encoding type names written to persistence, including the
+// server internal ones which are not known to the public EncodingType enum.
+func EncodingTypeFromString(encodingTypeStr string) (enumspb.EncodingType, bool) {
+	if encodingTypeStr == encodingTypeProto3ZstdName {
+		return EncodingTypeProto3Zstd, true
+	}
+	encodingType, ok := enumspb.EncodingType_value[encodingTypeStr]
+	return enumspb.EncodingType(encodingType), ok
+}
+
+// EncodingTypeToString is the inverse of EncodingTypeFromString and should be used instead of
+// EncodingType.String() whenever the name is written to persistence.
+func EncodingTypeToString(encodingType enumspb.EncodingType) string {
+	if encodingType == EncodingTypeProto3Zstd {
+		return encodingTypeProto3ZstdName
+	}
+	return encodingType.String()
+}
+
+// DecompressBlob returns a proto3 encoded copy of a compressed blob, or the blob itself if it is
+// not compressed.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || blob.EncodingType != EncodingTypeProto3Zstd {
+		return blob, nil
+	}
+	data, err := decompressZstd(blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         data,
+	}, nil
+}
+
+func compressZstd(data []byte) []byte {
+	initZstd()
+	return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data)/2))
+}
+
+func decompressZstd(data []byte) ([]byte, error) {
+	initZstd()
+	result, err := zstdDecoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeProto3Zstd, err)
+	}
+	return result, nil
+}
+
+func initZstd() {
+	zstdOnce.Do(func() {
+		var err error
+		// encoder and decoder are safe for concurrent use through EncodeAll and DecodeAll, and
+		// keep up to GOMAXPROCS internal states by default so concurrent calls don't serialize
+		zstdEncoder, err = zstd.NewWriter(
+			nil,
+			zstd.WithEncoderDictRaw(historyEventsDictID, historyEventsDict),
+		)
+		if err != nil {
+			panic(err)
+		}
+		zstdDecoder, err = zstd.NewReader(
+			nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderDictRaw(historyEventsDictID, historyEventsDict),
+		)
+		if err != nil {
+			panic(err)
+		}
+	})
+}
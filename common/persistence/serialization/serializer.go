@@ -156,8 +156,10 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
 		err = events.Unmarshal(data.Data)
+	case EncodingTypeProto3Zstd:
+		err = unmarshalZstd(data.Data, events)
 	default:
-		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+		return nil, NewUnknownEncodingTypeError(EncodingTypeToString(data.EncodingType), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 	if err != nil {
 		return nil, err
@@ -186,8 +188,10 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
 		err = event.Unmarshal(data.Data)
+	case EncodingTypeProto3Zstd:
+		err = unmarshalZstd(data.Data, event)
 	default:
-		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+		return nil, NewUnknownEncodingTypeError(EncodingTypeToString(data.EncodingType), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 
 	if err != nil {
@@ -242,12 +246,16 @@ func (t *serializerImpl) serialize(p proto.Marshaler, encodingType enumspb.Encod
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
 		data, err = p.Marshal()
+	case EncodingTypeProto3Zstd:
+		if data, err = p.Marshal(); err == nil {
+			data = compressZstd(data)
+		}
 	default:
-		return nil, NewUnknownEncodingTypeError(encodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
+		return nil, NewUnknownEncodingTypeError(EncodingTypeToString(encodingType), enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Zstd)
 	}
 
 	if err != nil {
-		return nil, NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+		return nil, NewSerializationError(encodingType, err)
 	}
 
 	// Shouldn't happen, but keeping
@@ -261,6 +269,14 @@ func (t *serializerImpl) serialize(p proto.Marshaler, encodingType enumspb.Encod
 	}, nil
 }
 
+func unmarshalZstd(data []byte, result proto.Unmarshaler) error {
+	decompressed, err := decompressZstd(data)
+	if err != nil {
+		return err
+	}
+	return result.Unmarshal(decompressed)
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(
 	encodingTypeStr string,
@@ -273,7 +289,7 @@ func NewUnknownEncodingTypeError(
 	}
 	expectedEncodingStr := make([]string, 0, len(expectedEncoding))
 	for _, encodingType := range expectedEncoding {
-		expectedEncodingStr = append(expectedEncodingStr, encodingType.String())
+		expectedEncodingStr = append(expectedEncodingStr, EncodingTypeToString(encodingType))
 	}
 	return &UnknownEncodingTypeError{
 		encodingTypeStr:     encodingTypeStr,
@@ -300,7 +316,7 @@ func NewSerializationError(
 }
 
 func (e *SerializationError) Error() string {
-	return fmt.Sprintf("error serializing using %v encoding: %v", e.encodingType, e.wrappedErr)
+	return fmt.Sprintf("error serializing using %v encoding: %v", EncodingTypeToString(e.encodingType), e.wrappedErr)
 }
 
 func (e *SerializationError) Unwrap() error {
@@ -319,7 +335,7 @@ func NewDeserializationError(
 }
 
 func (e *DeserializationError) Error() string {
-	return fmt.Sprintf("error deserializing using %v encoding: %v", e.encodingType, e.wrappedErr)
+	return fmt.Sprintf("error deserializing using %v encoding: %v", EncodingTypeToString(e.encodingType), e.wrappedErr)
 }
 
 func (e *DeserializationError) Unwrap() error {
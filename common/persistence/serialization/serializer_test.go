@@ -27,6 +27,7 @@ package serialization
 import (
 	"math/rand"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
@@ -160,6 +162,70 @@ func (s *temporalSerializerSuite) TestSerializer() {
 	s.True(succ, "test timed out")
 }
 
+func (s *temporalSerializerSuite) TestSerializeEvents_Compressed() {
+	event := &historypb.HistoryEvent{
+		EventId:   999,
+		EventTime: timestamp.TimePtr(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)),
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+		Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+				Result:           payloads.EncodeString(strings.Repeat("result-1-event-1", 100)),
+				ScheduledEventId: 4,
+				StartedEventId:   5,
+				Identity:         "event-1",
+			},
+		},
+	}
+	events := []*historypb.HistoryEvent{event, event}
+
+	plainBlob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	compressedBlob, err := s.serializer.SerializeEvents(events, EncodingTypeProto3Zstd)
+	s.NoError(err)
+	s.Equal(EncodingTypeProto3Zstd, compressedBlob.EncodingType)
+	s.Less(len(compressedBlob.Data), len(plainBlob.Data))
+
+	// old and new blobs are both readable
+	for _, blob := range []*commonpb.DataBlob{plainBlob, compressedBlob} {
+		deserializedEvents, err := s.serializer.DeserializeEvents(blob)
+		s.NoError(err)
+		s.Equal(events, deserializedEvents)
+	}
+
+	eventBlob, err := s.serializer.SerializeEvent(event, EncodingTypeProto3Zstd)
+	s.NoError(err)
+	deserializedEvent, err := s.serializer.DeserializeEvent(eventBlob)
+	s.NoError(err)
+	s.Equal(event, deserializedEvent)
+
+	decompressedBlob, err := DecompressBlob(compressedBlob)
+	s.NoError(err)
+	s.Equal(plainBlob, decompressedBlob)
+
+	sameBlob, err := DecompressBlob(plainBlob)
+	s.NoError(err)
+	s.Equal(plainBlob, sameBlob)
+
+	_, err = s.serializer.DeserializeEvents(&commonpb.DataBlob{EncodingType: EncodingTypeProto3Zstd, Data: plainBlob.Data})
+	s.Error(err)
+}
+
+func (s *temporalSerializerSuite) TestEncodingTypeString() {
+	for _, encodingType := range []enumspb.EncodingType{
+		enumspb.ENCODING_TYPE_PROTO3,
+		enumspb.ENCODING_TYPE_JSON,
+		EncodingTypeProto3Zstd,
+	} {
+		parsed, ok := EncodingTypeFromString(EncodingTypeToString(encodingType))
+		s.True(ok)
+		s.Equal(encodingType, parsed)
+	}
+	s.Equal("Proto3Zstd", EncodingTypeToString(EncodingTypeProto3Zstd))
+
+	_, ok := EncodingTypeFromString("Proto3Snappy")
+	s.False(ok)
+}
+
 func (s *temporalSerializerSuite) TestSerializeShardInfo_EmptyMapSlice() {
 	var shardInfo persistencespb.ShardInfo
 
@@ -109,6 +109,8 @@ func (s *TaskSerializer) serializeTransferTask(
 		transferTask = s.transferResetTaskToProto(task)
 	case *tasks.DeleteExecutionTask:
 		transferTask = s.transferDeleteExecutionTaskToProto(task)
+	case *tasks.CompletionCallbackTask:
+		transferTask = s.transferCompletionCallbackTaskToProto(task)
 	default:
 		return commonpb.DataBlob{}, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", task))
 	}
@@ -146,6 +148,8 @@ func (s *TaskSerializer) deserializeTransferTasks(
 		task = s.transferResetTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION:
 		task = s.transferDeleteExecutionTaskFromProto(transferTask)
+	case enumsspb.TASK_TYPE_TRANSFER_COMPLETION_CALLBACK:
+		task = s.transferCompletionCallbackTaskFromProto(transferTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", transferTask.TaskType))
 	}
@@ -171,6 +175,8 @@ func (s *TaskSerializer) serializeTimerTask(
 		timerTask = s.timerWorkflowRunToProto(task)
 	case *tasks.DeleteHistoryEventTask:
 		timerTask = s.timerWorkflowCleanupTaskToProto(task)
+	case *tasks.CompletionCallbackBackoffTask:
+		timerTask = s.timerCompletionCallbackBackoffTaskToProto(task)
 	default:
 		return commonpb.DataBlob{}, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", task))
 	}
@@ -206,6 +212,8 @@ func (s *TaskSerializer) deserializeTimerTasks(
 		timer = s.timerWorkflowRunFromProto(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		timer = s.timerWorkflowCleanupTaskFromProto(timerTask)
+	case enumsspb.TASK_TYPE_COMPLETION_CALLBACK_BACKOFF:
+		timer = s.timerCompletionCallbackBackoffTaskFromProto(timerTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown timer task type: %v", timerTask.TaskType))
 	}
@@ -648,6 +656,41 @@ func (s *TaskSerializer) transferDeleteExecutionTaskFromProto(
 	}
 }
 
+func (s *TaskSerializer) transferCompletionCallbackTaskToProto(
+	callbackTask *tasks.CompletionCallbackTask,
+) *persistencespb.TransferTaskInfo {
+	return &persistencespb.TransferTaskInfo{
+		NamespaceId:    callbackTask.WorkflowKey.NamespaceID,
+		WorkflowId:     callbackTask.WorkflowKey.WorkflowID,
+		RunId:          callbackTask.WorkflowKey.RunID,
+		TaskType:       enumsspb.TASK_TYPE_TRANSFER_COMPLETION_CALLBACK,
+		Version:        callbackTask.Version,
+		TaskId:         callbackTask.TaskID,
+		VisibilityTime: timestamp.TimePtr(callbackTask.VisibilityTimestamp),
+		TaskDetails: &persistencespb.TransferTaskInfo_CompletionCallbackTaskDetails_{
+			CompletionCallbackTaskDetails: &persistencespb.TransferTaskInfo_CompletionCallbackTaskDetails{
+				CallbackIndex: callbackTask.CallbackIndex,
+			},
+		},
+	}
+}
+
+func (s *TaskSerializer) transferCompletionCallbackTaskFromProto(
+	callbackTask *persistencespb.TransferTaskInfo,
+) *tasks.CompletionCallbackTask {
+	return &tasks.CompletionCallbackTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			callbackTask.NamespaceId,
+			callbackTask.WorkflowId,
+			callbackTask.RunId,
+		),
+		VisibilityTimestamp: *callbackTask.VisibilityTime,
+		TaskID:              callbackTask.TaskId,
+		Version:             callbackTask.Version,
+		CallbackIndex:       callbackTask.GetCompletionCallbackTaskDetails().GetCallbackIndex(),
+	}
+}
+
 func (s *TaskSerializer) timerWorkflowTaskToProto(
 	workflowTimer *tasks.WorkflowTaskTimeoutTask,
 ) *persistencespb.TimerTaskInfo {
@@ -769,6 +812,7 @@ func (s *TaskSerializer) timerActivityRetryTaskToProto(
 		EventId:             activityRetryTimer.EventID,
 		TaskId:              activityRetryTimer.TaskID,
 		VisibilityTime:      &activityRetryTimer.VisibilityTimestamp,
+		Stamp:               activityRetryTimer.Stamp,
 	}
 }
 
@@ -786,6 +830,42 @@ func (s *TaskSerializer) timerActivityRetryTaskFromProto(
 		EventID:             activityRetryTimer.EventId,
 		Version:             activityRetryTimer.Version,
 		Attempt:             activityRetryTimer.ScheduleAttempt,
+		Stamp:               activityRetryTimer.Stamp,
+	}
+}
+
+func (s *TaskSerializer) timerCompletionCallbackBackoffTaskToProto(
+	backoffTimer *tasks.CompletionCallbackBackoffTask,
+) *persistencespb.TimerTaskInfo {
+	return &persistencespb.TimerTaskInfo{
+		NamespaceId:         backoffTimer.WorkflowKey.NamespaceID,
+		WorkflowId:          backoffTimer.WorkflowKey.WorkflowID,
+		RunId:               backoffTimer.WorkflowKey.RunID,
+		TaskType:            enumsspb.TASK_TYPE_COMPLETION_CALLBACK_BACKOFF,
+		TimeoutType:         enumspb.TIMEOUT_TYPE_UNSPECIFIED,
+		WorkflowBackoffType: enumsspb.WORKFLOW_BACKOFF_TYPE_UNSPECIFIED,
+		Version:             backoffTimer.Version,
+		ScheduleAttempt:     backoffTimer.Attempt,
+		TaskId:              backoffTimer.TaskID,
+		VisibilityTime:      &backoffTimer.VisibilityTimestamp,
+		CallbackIndex:       backoffTimer.CallbackIndex,
+	}
+}
+
+func (s *TaskSerializer) timerCompletionCallbackBackoffTaskFromProto(
+	backoffTimer *persistencespb.TimerTaskInfo,
+) *tasks.CompletionCallbackBackoffTask {
+	return &tasks.CompletionCallbackBackoffTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			backoffTimer.NamespaceId,
+			backoffTimer.WorkflowId,
+			backoffTimer.RunId,
+		),
+		VisibilityTimestamp: *backoffTimer.VisibilityTime,
+		TaskID:              backoffTimer.TaskId,
+		Version:             backoffTimer.Version,
+		CallbackIndex:       backoffTimer.CallbackIndex,
+		Attempt:             backoffTimer.ScheduleAttempt,
 	}
 }
 
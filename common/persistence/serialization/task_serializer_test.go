@@ -173,6 +173,31 @@ func (s *taskSerializerSuite) TestTransferResetTask() {
 	s.assertEqualTasks(resetTask)
 }
 
+func (s *taskSerializerSuite) TestTransferCompletionCallbackTask() {
+	callbackTask := &tasks.CompletionCallbackTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		CallbackIndex:       rand.Int31(),
+	}
+
+	s.assertEqualTasks(callbackTask)
+}
+
+func (s *taskSerializerSuite) TestTimerCompletionCallbackBackoffTask() {
+	backoffTask := &tasks.CompletionCallbackBackoffTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		CallbackIndex:       rand.Int31(),
+		Attempt:             rand.Int31(),
+	}
+
+	s.assertEqualTasks(backoffTask)
+}
+
 func (s *taskSerializerSuite) TestTimerWorkflowTask() {
 	workflowTaskTimer := &tasks.WorkflowTaskTimeoutTask{
 		WorkflowKey:         s.workflowKey,
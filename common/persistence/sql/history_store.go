@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/primitives"
 )
@@ -109,7 +110,7 @@ func (m *sqlExecutionStore) AppendHistoryNodes(
 		PrevTxnID:    node.PrevTransactionID,
 		TxnID:        node.TransactionID,
 		Data:         node.Events.Data,
-		DataEncoding: node.Events.EncodingType.String(),
+		DataEncoding: serialization.EncodingTypeToString(node.Events.EncodingType),
 		ShardID:      request.ShardID,
 	}
 
@@ -599,6 +599,60 @@ func (s *HistoryEventsSuite) TestAppendBatches() {
 	s.Equal(expectedEvents, events)
 }
 
+func (s *HistoryEventsSuite) TestAppendSelect_MixedEncoding() {
+	shardID := rand.Int31()
+	treeID := uuid.New()
+	branchID := uuid.New()
+	branchToken, err := s.store.GetHistoryBranchUtil().NewHistoryBranch(
+		uuid.New(),
+		treeID,
+		&branchID,
+		[]*persistencespb.HistoryBranchRange{},
+		nil,
+		nil,
+		nil,
+	)
+	s.NoError(err)
+
+	eventsPacket0 := s.newHistoryEvents(
+		[]int64{1, 2, 3},
+		rand.Int63(),
+		0,
+	)
+	s.appendHistoryEvents(shardID, branchToken, eventsPacket0)
+
+	eventsPacket1 := s.newHistoryEvents(
+		[]int64{4, 5},
+		eventsPacket0.transactionID+1,
+		eventsPacket0.transactionID,
+	)
+	_, err = s.store.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:           shardID,
+		BranchToken:       branchToken,
+		Events:            eventsPacket1.events,
+		TransactionID:     eventsPacket1.transactionID,
+		PrevTransactionID: eventsPacket1.prevTransactionID,
+		EncodingType:      serialization.EncodingTypeProto3Zstd,
+	})
+	s.NoError(err)
+
+	events := eventsPacket0.events
+	events = append(events, eventsPacket1.events...)
+	s.Equal(events, s.listAllHistoryEvents(shardID, branchToken))
+
+	resp, err := s.store.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     shardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.LastEventID,
+		PageSize:    10,
+	})
+	s.NoError(err)
+	s.Len(resp.HistoryEventBlobs, 2)
+	s.Equal(enumspb.ENCODING_TYPE_PROTO3, resp.HistoryEventBlobs[0].EncodingType)
+	s.Equal(serialization.EncodingTypeProto3Zstd, resp.HistoryEventBlobs[1].EncodingType)
+}
+
 func (s *HistoryEventsSuite) TestForkDeleteBranch_DeleteBaseBranchFirst() {
 	shardID := rand.Int31()
 	treeID := uuid.New()
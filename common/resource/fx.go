@@ -51,12 +51,14 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/deadlock"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/membership/ringpop"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -108,6 +110,7 @@ var Module = fx.Options(
 	fx.Provide(serialization.NewSerializer),
 	fx.Provide(HistoryBootstrapContainerProvider),
 	fx.Provide(VisibilityBootstrapContainerProvider),
+	fx.Provide(HistoryShardResolverProvider),
 	fx.Provide(ClientFactoryProvider),
 	fx.Provide(ClientBeanProvider),
 	fx.Provide(FrontendClientProvider),
@@ -131,6 +134,7 @@ var DefaultOptions = fx.Options(
 	fx.Provide(RPCFactoryProvider),
 	fx.Provide(ArchivalMetadataProvider),
 	fx.Provide(ArchiverProviderProvider),
+	fx.Provide(PayloadStoreProvider),
 	fx.Provide(ThrottledLoggerProvider),
 	fx.Provide(SdkClientFactoryProvider),
 	fx.Provide(DCRedirectionPolicyProvider),
@@ -215,13 +219,27 @@ func NamespaceRegistryProvider(
 	)
 }
 
+func HistoryShardResolverProvider(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	persistenceConfig *config.Persistence,
+	timeSource clock.TimeSource,
+	logger log.SnTaggedLogger,
+) historyshard.Resolver {
+	return historyshard.NewResolver(
+		clusterMetadataManager,
+		persistenceConfig.NumHistoryShards,
+		timeSource,
+		logger,
+	)
+}
+
 func ClientFactoryProvider(
 	factoryProvider client.FactoryProvider,
 	rpcFactory common.RPCFactory,
 	membershipMonitor membership.Monitor,
 	metricsHandler metrics.Handler,
 	dynamicCollection *dynamicconfig.Collection,
-	persistenceConfig *config.Persistence,
+	historyShardResolver historyshard.Resolver,
 	logger log.SnTaggedLogger,
 	throttledLogger log.ThrottledLogger,
 ) client.Factory {
@@ -230,7 +248,7 @@ func ClientFactoryProvider(
 		membershipMonitor,
 		metricsHandler,
 		dynamicCollection,
-		persistenceConfig.NumHistoryShards,
+		historyShardResolver,
 		logger,
 		throttledLogger,
 	)
@@ -283,12 +301,14 @@ func HistoryBootstrapContainerProvider(
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
 	executionManager persistence.ExecutionManager,
+	payloadStore payloadstore.Store,
 ) *archiver.HistoryBootstrapContainer {
 	return &archiver.HistoryBootstrapContainer{
 		ExecutionManager: executionManager,
 		Logger:           logger,
 		MetricsHandler:   metricsHandler,
 		ClusterMetadata:  clusterMetadata,
+		PayloadStore:     payloadStore,
 	}
 }
 
@@ -350,6 +370,11 @@ func ArchiverProviderProvider(cfg *config.Config) provider.ArchiverProvider {
 	return provider.NewArchiverProvider(cfg.Archival.History.Provider, cfg.Archival.Visibility.Provider)
 }
 
+// PayloadStoreProvider returns the store large payloads are offloaded to, or nil if it is not configured.
+func PayloadStoreProvider(cfg *config.Config) (payloadstore.Store, error) {
+	return payloadstore.NewStore(cfg.PayloadStore)
+}
+
 func SdkClientFactoryProvider(
 	cfg *config.Config,
 	tlsConfigProvider encryption.TLSConfigProvider,
@@ -60,16 +60,22 @@ var (
 	}
 
 	historyAPIExcluded = map[string]struct{}{
-		"CloseShard":                {},
-		"GetShard":                  {},
-		"GetDLQMessages":            {},
-		"GetDLQReplicationMessages": {},
-		"GetReplicationMessages":    {},
-		"MergeDLQMessages":          {},
-		"PurgeDLQMessages":          {},
-		"RemoveTask":                {},
-		"SyncShardStatus":           {},
-		"GetReplicationStatus":      {},
+		"CloseShard":                   {},
+		"GetShard":                     {},
+		"GetDLQMessages":               {},
+		"GetDLQReplicationMessages":    {},
+		"GetReplicationMessages":       {},
+		"MergeDLQMessages":             {},
+		"PurgeDLQMessages":             {},
+		"RemoveTask":                   {},
+		"SyncShardStatus":              {},
+		"GetReplicationStatus":         {},
+		"ListFaultInjectionScenarios":  {},
+		"UpdateFaultInjectionScenario": {},
+		"MigrateHistoryShard":          {},
+		"CopyMigratedExecution":        {},
+		// streaming API, the request is not the 1th parameter
+		"StreamWorkflowExecutionHistory": {},
 	}
 )
 
@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+)
+
+const (
+	// stagedPayloadDeleteTimeout bounds the deletion of staged payloads, which does not use the context
+	// of the request as it may already be done
+	stagedPayloadDeleteTimeout = 10 * time.Second
+)
+
+type (
+	// PayloadOffloadInterceptor offloads payloads above a size threshold of requests to the payload store,
+	// for namespaces which enabled it, and resolves offloaded payloads within responses. Offloaded payloads
+	// are staged until history claims them for the run persisting them, so they are deleted once the request
+	// completes, whether it succeeded or not.
+	PayloadOffloadInterceptor struct {
+		namespaceRegistry namespace.Registry
+		clusterMetadata   cluster.Metadata
+		store             payloadstore.Store
+		logger            log.Logger
+		enabled           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		threshold         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = (*PayloadOffloadInterceptor)(nil).Intercept
+
+func NewPayloadOffloadInterceptor(
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	store payloadstore.Store,
+	logger log.Logger,
+	enabled dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	threshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) *PayloadOffloadInterceptor {
+	return &PayloadOffloadInterceptor{
+		namespaceRegistry: namespaceRegistry,
+		clusterMetadata:   clusterMetadata,
+		store:             store,
+		logger:            logger,
+		enabled:           enabled,
+		threshold:         threshold,
+	}
+}
+
+func (i *PayloadOffloadInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if i.store == nil {
+		return handler(ctx, req)
+	}
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+	// references are resolved in every response, a client supplying one may only try to read payloads of others
+	if err := payloadstore.RejectReferences(ctx, reqMsg); err != nil {
+		return nil, err
+	}
+	namespaceNameGetter, ok := req.(NamespaceNameGetter)
+	if !ok {
+		return handler(ctx, req)
+	}
+	namespaceEntry, err := i.namespaceRegistry.GetNamespace(namespace.Name(namespaceNameGetter.GetNamespace()))
+	if err != nil {
+		// let the handler deal with unknown namespaces
+		return handler(ctx, req)
+	}
+
+	// requests of namespaces active in another cluster are forwarded, that cluster offloads their payloads
+	namespaceName := namespaceEntry.Name().String()
+	if isPersisted(req) && i.enabled(namespaceName) && namespaceEntry.ActiveInCluster(i.clusterMetadata.GetCurrentClusterName()) {
+		stagedKeys, err := payloadstore.Offload(ctx, i.store, reqMsg, namespaceEntry.ID(), i.threshold(namespaceName))
+		defer i.deleteStagedPayloads(stagedKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// payloads are resolved even if offloading has since been disabled for the namespace
+	if historyResp, ok := resp.(*workflowservice.GetWorkflowExecutionHistoryResponse); ok && len(historyResp.RawHistory) > 0 {
+		if err := payloadstore.ResolveHistory(ctx, i.store, historyResp.RawHistory, namespaceEntry.ID()); err != nil {
+			return nil, err
+		}
+	}
+	if respMsg, ok := resp.(proto.Message); ok {
+		if err := payloadstore.Resolve(ctx, i.store, respMsg, namespaceEntry.ID()); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (i *PayloadOffloadInterceptor) deleteStagedPayloads(
+	keys []string,
+) {
+	if len(keys) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stagedPayloadDeleteTimeout)
+	defer cancel()
+	for _, key := range keys {
+		if err := i.store.Delete(ctx, key); err != nil {
+			i.logger.Warn("Unable to delete staged offloaded payload.", tag.Key(key), tag.Error(err))
+		}
+	}
+}
+
+// isPersisted returns whether the payloads of the request are persisted by history and may be offloaded
+func isPersisted(
+	req interface{},
+) bool {
+	switch req.(type) {
+	case *workflowservice.StartWorkflowExecutionRequest,
+		*workflowservice.SignalWithStartWorkflowExecutionRequest,
+		*workflowservice.SignalWorkflowExecutionRequest,
+		*workflowservice.TerminateWorkflowExecutionRequest,
+		*workflowservice.RecordActivityTaskHeartbeatByIdRequest,
+		*workflowservice.RespondActivityTaskCompletedByIdRequest,
+		*workflowservice.RespondActivityTaskFailedByIdRequest,
+		*workflowservice.RespondActivityTaskCanceledByIdRequest,
+		*workflowservice.RespondWorkflowTaskCompletedRequest,
+		*workflowservice.RespondWorkflowTaskFailedRequest,
+		*workflowservice.RecordActivityTaskHeartbeatRequest,
+		*workflowservice.RespondActivityTaskCompletedRequest,
+		*workflowservice.RespondActivityTaskFailedRequest,
+		*workflowservice.RespondActivityTaskCanceledRequest:
+		return true
+	default:
+		return false
+	}
+}
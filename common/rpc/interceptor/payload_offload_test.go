@@ -0,0 +1,248 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	querypb "go.temporal.io/api/query/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloadstore"
+)
+
+type (
+	payloadOffloadSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller          *gomock.Controller
+		mockRegistry        *namespace.MockRegistry
+		mockClusterMetadata *cluster.MockMetadata
+		mockStore           *payloadstore.MockStore
+
+		namespaceEntry *namespace.Namespace
+		offloadEnabled bool
+		interceptor    *PayloadOffloadInterceptor
+	}
+)
+
+func TestPayloadOffloadSuite(t *testing.T) {
+	suite.Run(t, new(payloadOffloadSuite))
+}
+
+func (s *payloadOffloadSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.mockStore = payloadstore.NewMockStore(s.controller)
+
+	s.namespaceEntry = namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: namespace.NewID().String(), Name: "test-namespace"},
+		nil,
+		"active",
+	)
+	s.mockRegistry.EXPECT().GetNamespace(s.namespaceEntry.Name()).Return(s.namespaceEntry, nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return("active").AnyTimes()
+
+	s.offloadEnabled = true
+	s.interceptor = NewPayloadOffloadInterceptor(
+		s.mockRegistry,
+		s.mockClusterMetadata,
+		s.mockStore,
+		log.NewNoopLogger(),
+		func(string) bool { return s.offloadEnabled },
+		dynamicconfig.GetIntPropertyFilteredByNamespace(1024),
+	)
+}
+
+func (s *payloadOffloadSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *payloadOffloadSuite) TestIntercept_OffloadAndResolve() {
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2048))
+	largeData, err := large.Marshal()
+	s.NoError(err)
+	small := payload.EncodeString("small")
+
+	var key string
+	s.mockStore.EXPECT().Put(gomock.Any(), gomock.Any(), largeData).DoAndReturn(
+		func(_ context.Context, k string, _ []byte) error {
+			key = k
+			return nil
+		})
+	s.mockStore.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k string) ([]byte, error) {
+			s.Equal(key, k)
+			return largeData, nil
+		})
+	s.mockStore.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k string) error {
+			s.Equal(key, k)
+			return nil
+		})
+
+	request := &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         s.namespaceEntry.Name().String(),
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+		Input:             &commonpb.Payloads{Payloads: []*commonpb.Payload{small, payload.EncodeBytes(bytes.Repeat([]byte{1}, 2048))}},
+	}
+	resp, err := s.interceptor.Intercept(
+		context.Background(),
+		request,
+		&grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			input := req.(*workflowservice.SignalWorkflowExecutionRequest).Input
+			s.Equal(small, input.Payloads[0])
+			s.True(payloadstore.IsReference(input.Payloads[1]))
+			s.True(strings.HasPrefix(key, s.namespaceEntry.ID().String()+"/"))
+			// the offloaded payload is later returned to a worker
+			return &workflowservice.PollActivityTaskQueueResponse{Input: input}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal([]*commonpb.Payload{small, large}, resp.(*workflowservice.PollActivityTaskQueueResponse).Input.Payloads)
+}
+
+func (s *payloadOffloadSuite) TestIntercept_HandlerFailed() {
+	var key string
+	s.mockStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k string, _ []byte) error {
+			key = k
+			return nil
+		})
+	s.mockStore.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k string) error {
+			s.Equal(key, k)
+			return nil
+		})
+
+	request := &workflowservice.RespondActivityTaskCompletedRequest{
+		Namespace: s.namespaceEntry.Name().String(),
+		Result:    &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeBytes(bytes.Repeat([]byte{1}, 2048))}},
+	}
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		request,
+		&grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("handler failed")
+		},
+	)
+	s.Error(err)
+	s.True(payloadstore.IsReference(request.Result.Payloads[0]))
+}
+
+func (s *payloadOffloadSuite) TestIntercept_RejectReferences() {
+	reference := &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte(payloadstore.ReferenceEncoding)},
+		Data:     []byte(namespace.NewID().String() + "/staged/key"),
+	}
+	// offloading is disabled, yet references are never accepted from clients
+	s.offloadEnabled = false
+
+	request := &workflowservice.QueryWorkflowRequest{
+		Namespace: s.namespaceEntry.Name().String(),
+		Query:     &querypb.WorkflowQuery{QueryArgs: &commonpb.Payloads{Payloads: []*commonpb.Payload{reference}}},
+	}
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		request,
+		&grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			s.Fail("handler must not be called")
+			return nil, nil
+		},
+	)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *payloadOffloadSuite) TestIntercept_Disabled() {
+	s.offloadEnabled = false
+
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2048))
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  s.namespaceEntry.Name().String(),
+		WorkflowId: "workflow-id",
+		Input:      &commonpb.Payloads{Payloads: []*commonpb.Payload{large}},
+	}
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		request,
+		&grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			return &workflowservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal(large, request.Input.Payloads[0])
+}
+
+func (s *payloadOffloadSuite) TestIntercept_NotActive() {
+	s.namespaceEntry = namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: namespace.NewID().String(), Name: "global-namespace"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{ActiveClusterName: "standby", Clusters: []string{"active", "standby"}},
+		1,
+	)
+	s.mockRegistry.EXPECT().GetNamespace(s.namespaceEntry.Name()).Return(s.namespaceEntry, nil).AnyTimes()
+
+	large := payload.EncodeBytes(bytes.Repeat([]byte{1}, 2048))
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  s.namespaceEntry.Name().String(),
+		WorkflowId: "workflow-id",
+		Input:      &commonpb.Payloads{Payloads: []*commonpb.Payload{large}},
+	}
+	_, err := s.interceptor.Intercept(
+		context.Background(),
+		request,
+		&grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			return &workflowservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.NoError(err)
+	s.Equal(large, request.Input.Payloads[0])
+}
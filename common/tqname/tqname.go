@@ -37,6 +37,8 @@ const (
 
 	suffixDelimiter     = "/"
 	versionSetDelimiter = ":"
+	delayedTasksSuffix  = "delayed"
+	prioritySuffix      = "priority"
 )
 
 type (
@@ -188,3 +190,17 @@ func (tn Name) FullName() string {
 	// versioned always use prefix
 	return fmt.Sprintf("%s%s%s%s%s%d", mangledTaskQueuePrefix, tn.baseName, suffixDelimiter, tn.versionSet, versionSetDelimiter, tn.partition)
 }
+
+// DelayedTasksFullName returns the name under which the tasks of the low-level task queue which are not due yet
+// are persisted, apart from its backlog. It is not a valid mangled name, so it never clashes with the name of a
+// task queue.
+func (tn Name) DelayedTasksFullName() string {
+	return fmt.Sprintf("%s%s%s%s", mangledTaskQueuePrefix, tn.FullName(), suffixDelimiter, delayedTasksSuffix)
+}
+
+// PriorityTasksFullName returns the name under which the backlog of the tasks of the low-level task queue with the
+// given priority is persisted, apart from the backlog of the default priority. It is not a valid mangled name, so
+// it never clashes with the name of a task queue.
+func (tn Name) PriorityTasksFullName(priority int) string {
+	return fmt.Sprintf("%s%s%s%s%d", mangledTaskQueuePrefix, tn.FullName(), suffixDelimiter, prioritySuffix, priority)
+}
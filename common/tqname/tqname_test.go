@@ -111,6 +111,32 @@ func TestWithPartitionAndVersionSet(t *testing.T) {
 	a.Equal("/_sys/tq/abc3:11", n.FullName())
 }
 
+func TestDelayedTasksFullName(t *testing.T) {
+	a := assert.New(t)
+
+	n, err := FromBaseName("tq")
+	a.NoError(err)
+	a.Equal("/_sys/tq/delayed", n.DelayedTasksFullName())
+	a.Equal("/_sys//_sys/tq/23/delayed", n.WithPartition(23).DelayedTasksFullName())
+	_, err = Parse(n.DelayedTasksFullName())
+	a.Error(err)
+	_, err = Parse(n.WithPartition(23).DelayedTasksFullName())
+	a.Error(err)
+}
+
+func TestPriorityTasksFullName(t *testing.T) {
+	a := assert.New(t)
+
+	n, err := FromBaseName("tq")
+	a.NoError(err)
+	a.Equal("/_sys/tq/priority1", n.PriorityTasksFullName(1))
+	a.Equal("/_sys//_sys/tq/23/priority5", n.WithPartition(23).PriorityTasksFullName(5))
+	_, err = Parse(n.PriorityTasksFullName(1))
+	a.Error(err)
+	_, err = Parse(n.WithPartition(23).PriorityTasksFullName(5))
+	a.Error(err)
+}
+
 func TestValidTaskQueueNames(t *testing.T) {
 	testCases := []struct {
 		input     string
@@ -37,6 +37,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
@@ -785,3 +786,17 @@ func OverrideWorkflowTaskTimeout(
 func CloneProto[T proto.Message](v T) T {
 	return proto.Clone(v).(T)
 }
+
+// IsWorkflowPauseMarker returns true if the event is a marker recorded by pausing or unpausing the workflow
+// execution. These markers are not generated by workflow commands and are never sent to workers.
+func IsWorkflowPauseMarker(event *historypb.HistoryEvent) bool {
+	if event.GetEventType() != enumspb.EVENT_TYPE_MARKER_RECORDED {
+		return false
+	}
+	switch event.GetMarkerRecordedEventAttributes().GetMarkerName() {
+	case WorkflowPausedMarkerName, WorkflowUnpausedMarkerName:
+		return true
+	default:
+		return false
+	}
+}
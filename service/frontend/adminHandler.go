@@ -28,7 +28,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -45,6 +47,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
@@ -57,8 +60,10 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
@@ -70,12 +75,16 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/consistency"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -85,16 +94,21 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tqname"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/shardmigration"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	exportHistoryPageSize                   = 100
+	listWorkersPageSize                     = 100
 )
 
 type (
@@ -103,7 +117,7 @@ type (
 		status int32
 
 		logger                      log.Logger
-		numberOfHistoryShards       int32
+		historyShardResolver        historyshard.Resolver
 		ESClient                    esclient.Client
 		config                      *Config
 		namespaceDLQHandler         namespace.DLQMessageHandler
@@ -117,6 +131,7 @@ type (
 		clientFactory               serverClient.Factory
 		clientBean                  serverClient.Bean
 		historyClient               historyservice.HistoryServiceClient
+		matchingClient              matchingservice.MatchingServiceClient
 		sdkClientFactory            sdk.ClientFactory
 		membershipMonitor           membership.Monitor
 		metricsHandler              metrics.Handler
@@ -125,6 +140,8 @@ type (
 		saManager                   searchattribute.Manager
 		clusterMetadata             cluster.Metadata
 		healthServer                *health.Server
+		payloadStore                payloadstore.Store
+		consistencyChecker          *consistency.Checker
 	}
 
 	NewAdminHandlerArgs struct {
@@ -142,6 +159,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		sdkClientFactory                    sdk.ClientFactory
 		MembershipMonitor                   membership.Monitor
 		ArchiverProvider                    provider.ArchiverProvider
@@ -154,6 +172,8 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		PayloadStore                        payloadstore.Store
+		HistoryShardResolver                historyshard.Resolver
 	}
 )
 
@@ -174,10 +194,10 @@ func NewAdminHandler(
 	)
 
 	return &AdminHandler{
-		logger:                args.Logger,
-		status:                common.DaemonStatusInitialized,
-		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
-		config:                args.Config,
+		logger:               args.Logger,
+		status:               common.DaemonStatusInitialized,
+		historyShardResolver: args.HistoryShardResolver,
+		config:               args.Config,
 		namespaceDLQHandler: namespace.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
 			args.NamespaceReplicationQueue,
@@ -194,6 +214,7 @@ func NewAdminHandler(
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClientFactory:            args.sdkClientFactory,
 		membershipMonitor:           args.MembershipMonitor,
 		metricsHandler:              args.MetricsHandler,
@@ -202,6 +223,15 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		payloadStore:                args.PayloadStore,
+		consistencyChecker: consistency.NewChecker(
+			args.PersistenceExecutionManager,
+			args.TaskManager,
+			args.VisibilityMrg,
+			args.NamespaceRegistry,
+			args.HistoryShardResolver,
+			args.Logger,
+		),
 	}
 }
 
@@ -674,7 +704,10 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 		return nil, err
 	}
 
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), request.Execution.WorkflowId, adh.numberOfHistoryShards)
+	shardID, err := adh.historyShardResolver.WorkflowShardID(namespaceID, request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
 	shardIDStr := convert.Int32ToString(shardID)
 
 	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
@@ -743,6 +776,116 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	return &adminservice.CloseShardResponse{}, err
 }
 
+// ListFaultInjectionScenarios returns the persistence fault injection scenarios of the history host owning a shard
+func (adh *AdminHandler) ListFaultInjectionScenarios(
+	ctx context.Context,
+	request *adminservice.ListFaultInjectionScenariosRequest,
+) (_ *adminservice.ListFaultInjectionScenariosResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 {
+		return nil, errShardIDNotSet
+	}
+
+	resp, err := adh.historyClient.ListFaultInjectionScenarios(ctx, &historyservice.ListFaultInjectionScenariosRequest{
+		ShardId: request.GetShardId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListFaultInjectionScenariosResponse{
+		Scenarios: resp.GetScenarios(),
+		Address:   resp.GetAddress(),
+	}, nil
+}
+
+// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of the history host
+// owning a shard
+func (adh *AdminHandler) UpdateFaultInjectionScenario(
+	ctx context.Context,
+	request *adminservice.UpdateFaultInjectionScenarioRequest,
+) (_ *adminservice.UpdateFaultInjectionScenarioResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 {
+		return nil, errShardIDNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errFaultInjectionScenarioNameNotSet
+	}
+
+	resp, err := adh.historyClient.UpdateFaultInjectionScenario(ctx, &historyservice.UpdateFaultInjectionScenarioRequest{
+		ShardId:  request.GetShardId(),
+		Name:     request.GetName(),
+		Enabled:  request.GetEnabled(),
+		Duration: request.GetDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateFaultInjectionScenarioResponse{
+		Scenario: resp.GetScenario(),
+		Address:  resp.GetAddress(),
+	}, nil
+}
+
+// StartHistoryShardCountMigration starts the system workflow increasing the history shard count.
+func (adh *AdminHandler) StartHistoryShardCountMigration(
+	ctx context.Context,
+	request *adminservice.StartHistoryShardCountMigrationRequest,
+) (_ *adminservice.StartHistoryShardCountMigrationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTargetShardCount() <= 0 {
+		return nil, errTargetShardCountNotSet
+	}
+
+	sdkClient := adh.sdkClientFactory.GetSystemClient()
+	run, err := sdkClient.ExecuteWorkflow(
+		ctx,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue: worker.DefaultWorkerTaskQueue,
+			ID:        shardmigration.WorkflowName,
+		},
+		shardmigration.WorkflowName,
+		shardmigration.WorkflowParams{
+			TargetShardCount: request.GetTargetShardCount(),
+		},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf(errUnableToStartWorkflowMessage, shardmigration.WorkflowName, err),
+		)
+	}
+	return &adminservice.StartHistoryShardCountMigrationResponse{
+		WorkflowId: run.GetID(),
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// CheckPersistenceConsistency checks one page of persisted data for inconsistencies between stores
+// and repairs them if requested.
+func (adh *AdminHandler) CheckPersistenceConsistency(
+	ctx context.Context,
+	request *adminservice.CheckPersistenceConsistencyRequest,
+) (_ *adminservice.CheckPersistenceConsistencyResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	return adh.consistencyChecker.Check(ctx, request)
+}
+
 func (adh *AdminHandler) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -957,11 +1100,10 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 		}, nil
 	}
 	pageSize := int(request.GetMaximumPageSize())
-	shardID := common.WorkflowIDToHistoryShard(
-		ns.ID().String(),
-		execution.GetWorkflowId(),
-		adh.numberOfHistoryShards,
-	)
+	shardID, err := adh.historyShardResolver.WorkflowShardID(ns.ID(), execution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
 	rawHistoryResponse, err := adh.persistenceExecutionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken: targetVersionHistory.GetBranchToken(),
 		// GetWorkflowExecutionRawHistoryV2 is exclusive exclusive.
@@ -994,6 +1136,13 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, r
 		metrics.OperationTag(metrics.AdminGetWorkflowExecutionRawHistoryV2Scope))
 	taggedMetricsHandler.Histogram(metrics.HistorySize.GetMetricName(), metrics.HistorySize.GetMetricUnit()).Record(int64(size))
 
+	// other clusters may not have access to the payload store of this cluster
+	if adh.payloadStore != nil {
+		if err := payloadstore.ResolveHistory(ctx, adh.payloadStore, rawHistoryResponse.HistoryEventBlobs, ns.ID()); err != nil {
+			return nil, err
+		}
+	}
+
 	result := &adminservice.GetWorkflowExecutionRawHistoryV2Response{
 		HistoryBatches: rawHistoryResponse.HistoryEventBlobs,
 		VersionHistory: targetVersionHistory,
@@ -1403,7 +1552,7 @@ func (adh *AdminHandler) GetDLQMessages(
 	}
 
 	switch request.GetType() {
-	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
 		resp, err := adh.historyClient.GetDLQMessages(ctx, &historyservice.GetDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -1421,6 +1570,7 @@ func (adh *AdminHandler) GetDLQMessages(
 			Type:                 resp.GetType(),
 			ReplicationTasks:     resp.GetReplicationTasks(),
 			ReplicationTasksInfo: resp.GetReplicationTasksInfo(),
+			HistoryTasks:         resp.GetHistoryTasks(),
 			NextPageToken:        resp.GetNextPageToken(),
 		}, err
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
@@ -1457,7 +1607,7 @@ func (adh *AdminHandler) PurgeDLQMessages(
 	}
 
 	switch request.GetType() {
-	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION:
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
 		resp, err := adh.historyClient.PurgeDLQMessages(ctx, &historyservice.PurgeDLQMessagesRequest{
 			Type:                  request.GetType(),
 			ShardId:               request.GetShardId(),
@@ -1513,6 +1663,21 @@ func (adh *AdminHandler) MergeDLQMessages(
 		return &adminservice.MergeDLQMessagesResponse{
 			NextPageToken: request.GetNextPageToken(),
 		}, nil
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
+		resp, err := adh.historyClient.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
+			Type:                  request.GetType(),
+			ShardId:               request.GetShardId(),
+			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
+			MaximumPageSize:       request.GetMaximumPageSize(),
+			NextPageToken:         request.GetNextPageToken(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminservice.MergeDLQMessagesResponse{
+			NextPageToken: resp.GetNextPageToken(),
+		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 		token, err := adh.namespaceDLQHandler.Merge(
 			ctx,
@@ -1632,173 +1797,1085 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
-func (adh *AdminHandler) DeleteWorkflowExecution(
+// DescribeTaskQueueBacklog summarizes the backlog of all partitions of a task queue
+func (adh *AdminHandler) DescribeTaskQueueBacklog(
 	ctx context.Context,
-	request *adminservice.DeleteWorkflowExecutionRequest,
-) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	request *adminservice.DescribeTaskQueueBacklogRequest,
+) (_ *adminservice.DescribeTaskQueueBacklogResponse, err error) {
 	defer log.CapturePanic(adh.logger, &err)
 
 	if request == nil {
 		return nil, errRequestNotSet
 	}
 
-	if err := validateExecution(request.Execution); err != nil {
-		return nil, err
-	}
-
-	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	namespaceID, partitions, err := adh.getTaskQueuePartitions(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType())
 	if err != nil {
 		return nil, err
 	}
-	execution := request.Execution
-
-	shardID := common.WorkflowIDToHistoryShard(
-		namespaceID.String(),
-		execution.GetWorkflowId(),
-		adh.numberOfHistoryShards,
-	)
-	logger := log.With(adh.logger,
-		tag.WorkflowNamespace(request.Namespace),
-		tag.WorkflowID(execution.WorkflowId),
-		tag.WorkflowRunID(execution.RunId),
-	)
 
-	if execution.RunId == "" {
-		resp, err := adh.persistenceExecutionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
-			ShardID:     shardID,
-			NamespaceID: namespaceID.String(),
-			WorkflowID:  execution.WorkflowId,
+	var summary *taskqueuespb.BacklogSummary
+	for _, partition := range partitions {
+		resp, err := adh.matchingClient.DescribeTaskQueueBacklog(ctx, &matchingservice.DescribeTaskQueueBacklogRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     partition,
+			TaskQueueType: request.GetTaskQueueType(),
 		})
 		if err != nil {
 			return nil, err
 		}
-		execution.RunId = resp.RunID
+		summary = mergeBacklogSummaries(summary, resp.GetSummary())
 	}
+	return &adminservice.DescribeTaskQueueBacklogResponse{Summary: summary}, nil
+}
 
-	var warnings []string
-	var branchTokens [][]byte
-	var startTime, closeTime *time.Time
-	cassVisBackend := adh.visibilityMgr.HasStoreName(cassandra.CassandraPersistenceName)
+// MoveTaskQueueTasks moves backlog tasks matching a filter from all partitions of a task queue to another task queue
+func (adh *AdminHandler) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.MoveTaskQueueTasksRequest,
+) (_ *adminservice.MoveTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
 
-	resp, err := adh.persistenceExecutionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
-		ShardID:     shardID,
-		NamespaceID: namespaceID.String(),
-		WorkflowID:  execution.WorkflowId,
-		RunID:       execution.RunId,
-	})
-	if err != nil {
-		if common.IsContextCanceledErr(err) || common.IsContextDeadlineExceededErr(err) {
-			return nil, err
-		}
-		// continue to deletion
-		warnMsg := "Unable to load mutable state when deleting workflow execution, " +
-			"will skip deleting workflow history and cassandra visibility record"
-		logger.Warn(warnMsg, tag.Error(err))
-		warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
-	} else {
-		// load necessary information from mutable state
-		executionInfo := resp.State.GetExecutionInfo()
-		histories := executionInfo.GetVersionHistories().GetHistories()
-		branchTokens = make([][]byte, 0, len(histories))
-		for _, historyItem := range histories {
-			branchTokens = append(branchTokens, historyItem.GetBranchToken())
-		}
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetDestinationTaskQueue() == "" {
+		return nil, errDestinationTaskQueueNotSet
+	}
+	if request.GetDestinationTaskQueue() == request.GetTaskQueue() {
+		return nil, errDestinationTaskQueueIsSource
+	}
+	if request.GetMaxTasks() <= 0 {
+		return nil, errInvalidMaxTasks
+	}
 
-		if cassVisBackend {
-			if resp.State.ExecutionState.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
-				startTime = executionInfo.GetStartTime()
-			} else if executionInfo.GetCloseTime() != nil {
-				closeTime = executionInfo.GetCloseTime()
-			} else {
-				completionEvent, err := adh.getWorkflowCompletionEvent(ctx, shardID, resp.State)
-				if err != nil {
-					warnMsg := "Unable to load workflow completion event, will skip deleting visibility record"
-					adh.logger.Warn(warnMsg, tag.Error(err))
-					warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
-				} else {
-					closeTime = completionEvent.GetEventTime()
-				}
-			}
-		}
+	namespaceID, partitions, err := adh.getTaskQueuePartitions(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
 	}
 
-	if !cassVisBackend || (startTime != nil || closeTime != nil) {
-		// if using cass visibility, then either start or close time should be non-nil
-		// NOTE: the deletion is best effort, for sql and cassandra visibility implementation,
-		// we can't guarantee there's no update or record close request for this workflow since
-		// visibility queue processing is async. Operator can call this api again to delete visibility
-		// record again if this happens.
-		if _, err := adh.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
-			NamespaceId:       namespaceID.String(),
-			Execution:         execution,
-			WorkflowStartTime: startTime,
-			WorkflowCloseTime: closeTime,
-		}); err != nil {
+	var moved int64
+	for _, partition := range partitions {
+		if moved >= int64(request.GetMaxTasks()) {
+			break
+		}
+		resp, err := adh.matchingClient.MoveTaskQueueTasks(ctx, &matchingservice.MoveTaskQueueTasksRequest{
+			NamespaceId:          namespaceID.String(),
+			TaskQueue:            partition,
+			TaskQueueType:        request.GetTaskQueueType(),
+			DestinationTaskQueue: request.GetDestinationTaskQueue(),
+			Filter:               request.GetFilter(),
+			MaxTasks:             request.GetMaxTasks() - int32(moved),
+		})
+		if err != nil {
 			return nil, err
 		}
+		moved += resp.GetMovedTaskCount()
 	}
+	return &adminservice.MoveTaskQueueTasksResponse{MovedTaskCount: moved}, nil
+}
 
-	if err := adh.persistenceExecutionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
-		ShardID:     shardID,
-		NamespaceID: namespaceID.String(),
-		WorkflowID:  execution.WorkflowId,
-		RunID:       execution.RunId,
-	}); err != nil {
-		return nil, err
+// PurgeTaskQueueTasks deletes backlog tasks matching a filter from all partitions of a task queue
+func (adh *AdminHandler) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueTasksRequest,
+) (_ *adminservice.PurgeTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetMaxTasks() <= 0 {
+		return nil, errInvalidMaxTasks
 	}
 
-	if err := adh.persistenceExecutionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
-		ShardID:     shardID,
-		NamespaceID: namespaceID.String(),
-		WorkflowID:  execution.WorkflowId,
-		RunID:       execution.RunId,
-	}); err != nil {
+	namespaceID, partitions, err := adh.getTaskQueuePartitions(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType())
+	if err != nil {
 		return nil, err
 	}
 
-	for _, branchToken := range branchTokens {
-		if err := adh.persistenceExecutionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
-			ShardID:     shardID,
-			BranchToken: branchToken,
-		}); err != nil {
-			warnMsg := "Failed to delete history branch, skip"
-			adh.logger.Warn(warnMsg, tag.WorkflowBranchID(string(branchToken)), tag.Error(err))
-			warnings = append(warnings, fmt.Sprintf("%s. BranchToken: %v, Error: %v", warnMsg, branchToken, err.Error()))
+	var purged int64
+	for _, partition := range partitions {
+		if purged >= int64(request.GetMaxTasks()) {
+			break
+		}
+		resp, err := adh.matchingClient.PurgeTaskQueueTasks(ctx, &matchingservice.PurgeTaskQueueTasksRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     partition,
+			TaskQueueType: request.GetTaskQueueType(),
+			Filter:        request.GetFilter(),
+			MaxTasks:      request.GetMaxTasks() - int32(purged),
+		})
+		if err != nil {
+			return nil, err
 		}
+		purged += resp.GetPurgedTaskCount()
 	}
-
-	return &adminservice.DeleteWorkflowExecutionResponse{
-		Warnings: warnings,
-	}, nil
+	return &adminservice.PurgeTaskQueueTasksResponse{PurgedTaskCount: purged}, nil
 }
 
-func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-) error {
+// ListWorkers lists the workers which polled a task queue, or any task queue of the cluster, recently.
+// Without a task queue, one page of the task queues persisted by matching is read and each of them is
+// described, so that the matching hosts are only called for the task queues they own.
+func (adh *AdminHandler) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+) (_ *adminservice.ListWorkersResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
 
-	execution := request.Execution
-	if execution.GetWorkflowId() == "" {
-		return errWorkflowIDNotSet
+	if request == nil {
+		return nil, errRequestNotSet
 	}
-	// TODO currently, this API is only going to be used by re-send history events
-	// to remote cluster if kafka is lossy again, in the future, this API can be used
-	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
-	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
-		return errInvalidRunID
+
+	if request.GetTaskQueue() != "" {
+		workers, err := adh.describeTaskQueueWorkers(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType())
+		if err != nil {
+			return nil, err
+		}
+		return &adminservice.ListWorkersResponse{Workers: mergeWorkerInfos(workers)}, nil
 	}
 
-	pageSize := int(request.GetMaximumPageSize())
+	var namespaceID namespace.ID
+	if request.GetNamespace() != "" {
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	pageSize := int(request.GetPageSize())
 	if pageSize <= 0 {
-		return errInvalidPageSize
+		pageSize = listWorkersPageSize
 	}
-
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
+	resp, err := adh.taskManager.ListTaskQueue(ctx, &persistence.ListTaskQueueRequest{
+		PageSize:  pageSize,
+		PageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
 	}
-
+	var workers []*taskqueuespb.WorkerInfo
+	for _, item := range resp.Items {
+		info := item.Data
+		if namespaceID != "" && info.GetNamespaceId() != namespaceID.String() {
+			continue
+		}
+		if request.GetTaskQueueType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED && info.GetTaskType() != request.GetTaskQueueType() {
+			continue
+		}
+		// sticky task queues are polled by a single worker, which also polls the normal task queue, and
+		// the other partitions are described together with the unversioned root partition
+		if info.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+			continue
+		}
+		name, err := tqname.Parse(info.GetName())
+		if err != nil || !name.IsRoot() || name.VersionSet() != "" {
+			continue
+		}
+		nsEntry, err := adh.namespaceRegistry.GetNamespaceByID(namespace.ID(info.GetNamespaceId()))
+		if err != nil {
+			// the task queues of deleted namespaces are left to the task queue scavenger
+			continue
+		}
+		tqWorkers, err := adh.describeTaskQueueWorkers(ctx, nsEntry.Name().String(), name.BaseNameString(), info.GetTaskType())
+		if err != nil {
+			return nil, err
+		}
+		workers = append(workers, tqWorkers...)
+	}
+	return &adminservice.ListWorkersResponse{
+		Workers:       mergeWorkerInfos(workers),
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// describeTaskQueueWorkers returns the workers which polled the partitions of a normal task queue recently,
+// with one entry per worker and partition
+func (adh *AdminHandler) describeTaskQueueWorkers(
+	ctx context.Context,
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) ([]*taskqueuespb.WorkerInfo, error) {
+	namespaceID, partitions, err := adh.getTaskQueuePartitions(ctx, namespaceName, taskQueue, taskQueueType)
+	if err != nil {
+		return nil, err
+	}
+	var workers []*taskqueuespb.WorkerInfo
+	for _, partition := range partitions {
+		resp, err := adh.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace:     namespaceName,
+				TaskQueue:     &taskqueuepb.TaskQueue{Name: partition, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType: taskQueueType,
+			},
+			IncludeWorkers: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		workers = append(workers, resp.GetWorkers()...)
+	}
+	return workers, nil
+}
+
+// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions of a
+// task queue
+func (adh *AdminHandler) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *adminservice.UpdateBuildIdRampRequest,
+) (_ *adminservice.UpdateBuildIdRampResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	_, err = adh.matchingClient.UpdateBuildIdRamp(ctx, &matchingservice.UpdateBuildIdRampRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      request.GetTaskQueue(),
+		BuildId:        request.GetBuildId(),
+		RampPercentage: request.GetRampPercentage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateBuildIdRampResponse{}, nil
+}
+
+// getTaskQueuePartitions returns the names of the partitions of a normal task queue of the given type
+func (adh *AdminHandler) getTaskQueuePartitions(
+	ctx context.Context,
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+) (namespace.ID, []string, error) {
+	if taskQueue == "" {
+		return "", nil, errTaskQueueNotSet
+	}
+	if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return "", nil, errInvalidTaskQueueType
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := adh.matchingClient.ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace:   namespaceName,
+		NamespaceId: namespaceID.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: taskQueue,
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	partitionMetadata := resp.GetWorkflowTaskQueuePartitions()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitionMetadata = resp.GetActivityTaskQueuePartitions()
+	}
+	partitions := make([]string, 0, len(partitionMetadata))
+	for _, partition := range partitionMetadata {
+		partitions = append(partitions, partition.GetKey())
+	}
+	return namespaceID, partitions, nil
+}
+
+// mergeWorkerInfos merges the entries of workers which polled multiple partitions of the same task queue
+func mergeWorkerInfos(workers []*taskqueuespb.WorkerInfo) []*taskqueuespb.WorkerInfo {
+	type workerKey struct {
+		namespaceID   string
+		taskQueue     string
+		taskQueueType enumspb.TaskQueueType
+		identity      string
+	}
+	merged := make(map[workerKey]*taskqueuespb.WorkerInfo, len(workers))
+	result := make([]*taskqueuespb.WorkerInfo, 0, len(workers))
+	for _, worker := range workers {
+		key := workerKey{worker.GetNamespaceId(), worker.GetTaskQueue(), worker.GetTaskQueueType(), worker.GetIdentity()}
+		existing, ok := merged[key]
+		if !ok {
+			merged[key] = worker
+			result = append(result, worker)
+			continue
+		}
+		// each poll is counted by the partition the worker sent it to
+		existing.PollCount += worker.GetPollCount()
+		existing.PollRate += worker.GetPollRate()
+		if timestamp.TimeValue(worker.GetFirstPollTime()).Before(timestamp.TimeValue(existing.GetFirstPollTime())) {
+			existing.FirstPollTime = worker.GetFirstPollTime()
+		}
+		if timestamp.TimeValue(worker.GetLastPollTime()).After(timestamp.TimeValue(existing.GetLastPollTime())) {
+			// the latest poll reports the current state of the worker
+			existing.LastPollTime = worker.GetLastPollTime()
+			existing.SdkName = worker.GetSdkName()
+			existing.SdkVersion = worker.GetSdkVersion()
+			existing.BuildId = worker.GetBuildId()
+			existing.MaxTasksPerSecond = worker.GetMaxTasksPerSecond()
+			existing.TaskSlotsAvailable = worker.GetTaskSlotsAvailable()
+			existing.TaskSlotsUsed = worker.GetTaskSlotsUsed()
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.GetNamespaceId() != b.GetNamespaceId() {
+			return a.GetNamespaceId() < b.GetNamespaceId()
+		}
+		if a.GetTaskQueue() != b.GetTaskQueue() {
+			return a.GetTaskQueue() < b.GetTaskQueue()
+		}
+		if a.GetTaskQueueType() != b.GetTaskQueueType() {
+			return a.GetTaskQueueType() < b.GetTaskQueueType()
+		}
+		return a.GetIdentity() < b.GetIdentity()
+	})
+	return result
+}
+
+// mergeBacklogSummaries adds the backlog summary of a partition to the summary of the task queue
+func mergeBacklogSummaries(
+	summary *taskqueuespb.BacklogSummary,
+	partition *taskqueuespb.BacklogSummary,
+) *taskqueuespb.BacklogSummary {
+	if summary == nil {
+		return partition
+	}
+
+	summary.TaskCount += partition.GetTaskCount()
+	if summary.WorkflowTypeTaskCounts == nil {
+		summary.WorkflowTypeTaskCounts = make(map[string]int64)
+	}
+	for workflowType, count := range partition.GetWorkflowTypeTaskCounts() {
+		summary.WorkflowTypeTaskCounts[workflowType] += count
+	}
+	// all partitions use the same histogram buckets
+	for i, bucket := range partition.GetAgeHistogram() {
+		if i < len(summary.AgeHistogram) {
+			summary.AgeHistogram[i].TaskCount += bucket.GetTaskCount()
+		} else {
+			summary.AgeHistogram = append(summary.AgeHistogram, bucket)
+		}
+	}
+	oldest := partition.GetOldestTask()
+	if oldest != nil && (summary.OldestTask == nil ||
+		timestamp.TimeValue(oldest.Data.GetCreateTime()).Before(timestamp.TimeValue(summary.OldestTask.Data.GetCreateTime()))) {
+		summary.OldestTask = oldest
+	}
+	summary.Truncated = summary.Truncated || partition.GetTruncated()
+	return summary
+}
+
+func (adh *AdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	execution := request.Execution
+
+	shardID, err := adh.historyShardResolver.WorkflowShardID(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	logger := log.With(adh.logger,
+		tag.WorkflowNamespace(request.Namespace),
+		tag.WorkflowID(execution.WorkflowId),
+		tag.WorkflowRunID(execution.RunId),
+	)
+
+	if execution.RunId == "" {
+		resp, err := adh.persistenceExecutionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: namespaceID.String(),
+			WorkflowID:  execution.WorkflowId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		execution.RunId = resp.RunID
+	}
+
+	var warnings []string
+	var branchTokens [][]byte
+	var startTime, closeTime *time.Time
+	cassVisBackend := adh.visibilityMgr.HasStoreName(cassandra.CassandraPersistenceName)
+
+	resp, err := adh.persistenceExecutionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.WorkflowId,
+		RunID:       execution.RunId,
+	})
+	if err != nil {
+		if common.IsContextCanceledErr(err) || common.IsContextDeadlineExceededErr(err) {
+			return nil, err
+		}
+		// continue to deletion
+		warnMsg := "Unable to load mutable state when deleting workflow execution, " +
+			"will skip deleting workflow history and cassandra visibility record"
+		logger.Warn(warnMsg, tag.Error(err))
+		warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
+	} else {
+		// load necessary information from mutable state
+		executionInfo := resp.State.GetExecutionInfo()
+		histories := executionInfo.GetVersionHistories().GetHistories()
+		branchTokens = make([][]byte, 0, len(histories))
+		for _, historyItem := range histories {
+			branchTokens = append(branchTokens, historyItem.GetBranchToken())
+		}
+
+		if cassVisBackend {
+			if resp.State.ExecutionState.State != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+				startTime = executionInfo.GetStartTime()
+			} else if executionInfo.GetCloseTime() != nil {
+				closeTime = executionInfo.GetCloseTime()
+			} else {
+				completionEvent, err := adh.getWorkflowCompletionEvent(ctx, shardID, resp.State)
+				if err != nil {
+					warnMsg := "Unable to load workflow completion event, will skip deleting visibility record"
+					adh.logger.Warn(warnMsg, tag.Error(err))
+					warnings = append(warnings, fmt.Sprintf("%s. Error: %v", warnMsg, err.Error()))
+				} else {
+					closeTime = completionEvent.GetEventTime()
+				}
+			}
+		}
+	}
+
+	if !cassVisBackend || (startTime != nil || closeTime != nil) {
+		// if using cass visibility, then either start or close time should be non-nil
+		// NOTE: the deletion is best effort, for sql and cassandra visibility implementation,
+		// we can't guarantee there's no update or record close request for this workflow since
+		// visibility queue processing is async. Operator can call this api again to delete visibility
+		// record again if this happens.
+		if _, err := adh.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+			NamespaceId:       namespaceID.String(),
+			Execution:         execution,
+			WorkflowStartTime: startTime,
+			WorkflowCloseTime: closeTime,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := adh.persistenceExecutionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.WorkflowId,
+		RunID:       execution.RunId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := adh.persistenceExecutionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.WorkflowId,
+		RunID:       execution.RunId,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, branchToken := range branchTokens {
+		if err := adh.persistenceExecutionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: branchToken,
+		}); err != nil {
+			warnMsg := "Failed to delete history branch, skip"
+			adh.logger.Warn(warnMsg, tag.WorkflowBranchID(string(branchToken)), tag.Error(err))
+			warnings = append(warnings, fmt.Sprintf("%s. BranchToken: %v, Error: %v", warnMsg, branchToken, err.Error()))
+		}
+	}
+
+	return &adminservice.DeleteWorkflowExecutionResponse{
+		Warnings: warnings,
+	}, nil
+}
+
+// PauseWorkflowExecution stops dispatching workflow & activity tasks and firing user timers of the workflow execution
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		Identity:          request.GetIdentity(),
+		Reason:            request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// UpdateActivityOptions updates the task queue, timeouts and retry policy of a pending activity
+func (adh *AdminHandler) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+) (_ *adminservice.UpdateActivityOptionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.UpdateActivityOptions(ctx, &historyservice.UpdateActivityOptionsRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		ActivityId:        request.GetActivityId(),
+		Options:           request.GetOptions(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateActivityOptionsResponse{
+		Options: resp.GetOptions(),
+	}, nil
+}
+
+func (adh *AdminHandler) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+) (_ *adminservice.ResetActivityResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.historyClient.ResetActivity(ctx, &historyservice.ResetActivityRequest{
+		NamespaceId:       namespaceID.String(),
+		WorkflowExecution: request.Execution,
+		ActivityId:        request.GetActivityId(),
+		ResetAttempts:     request.GetResetAttempts(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResetActivityResponse{
+		ActivityIds: resp.GetActivityIds(),
+	}, nil
+}
+
+// StartResetActivitiesBatchOperation starts a batch operation which resets the pending activities waiting for
+// their next retry, it is not part of the public batch operation API as it is an operator tool to unblock
+// workflow executions after an outage of the activity dependencies is fixed.
+func (adh *AdminHandler) StartResetActivitiesBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartResetActivitiesBatchOperationRequest,
+) (_ *adminservice.StartResetActivitiesBatchOperationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetJobId()) == 0 {
+		return nil, errBatchJobIDNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetVisibilityQuery()) == 0 && len(request.GetExecutions()) == 0 {
+		return nil, errBatchOpsWorkflowFilterNotSet
+	}
+	if len(request.GetVisibilityQuery()) != 0 && len(request.GetExecutions()) != 0 {
+		return nil, errBatchOpsWorkflowFiltersNotAllowed
+	}
+	if len(request.GetExecutions()) > adh.config.MaxExecutionCountBatchOperation(request.GetNamespace()) {
+		return nil, errBatchOpsMaxWorkflowExecutionCount
+	}
+	if len(request.GetReason()) == 0 {
+		return nil, errReasonNotSet
+	}
+	if !adh.config.EnableBatcher(request.GetNamespace()) {
+		return nil, errBatchAPINotAllowed
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate concurrent batch operation
+	countResp, err := adh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       batcher.OpenBatchOperationQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if countResp.Count >= int64(adh.config.MaxConcurrentBatchOperation(request.GetNamespace())) {
+		return nil, serviceerror.NewUnavailable("Max concurrent batch operations is reached")
+	}
+
+	input := &batcher.BatchParams{
+		Namespace:  request.GetNamespace(),
+		Query:      request.GetVisibilityQuery(),
+		Executions: request.GetExecutions(),
+		Reason:     request.GetReason(),
+		BatchType:  batcher.BatchTypeResetActivities,
+		ResetActivitiesParams: batcher.ResetActivitiesParams{
+			ResetAttempts: request.GetResetAttempts(),
+			Identity:      request.GetIdentity(),
+		},
+	}
+	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := &commonpb.Memo{
+		Fields: map[string]*commonpb.Payload{
+			batcher.BatchOperationTypeMemo: payload.EncodeString(batcher.BatchTypeResetActivities),
+			batcher.BatchReasonMemo:        payload.EncodeString(request.GetReason()),
+		},
+	}
+
+	// Add pre-define search attributes
+	var searchAttributes *commonpb.SearchAttributes
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.BatcherUser, payload.EncodeString(request.GetIdentity()))
+	searchattribute.AddSearchAttribute(&searchAttributes, searchattribute.TemporalNamespaceDivision, payload.EncodeString(batcher.NamespaceDivision))
+
+	startReq := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:             request.GetNamespace(),
+		WorkflowId:            request.GetJobId(),
+		WorkflowType:          &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+		TaskQueue:             &taskqueuepb.TaskQueue{Name: primitives.PerNSWorkerTaskQueue},
+		Input:                 inputPayload,
+		Identity:              request.GetIdentity(),
+		RequestId:             uuid.New(),
+		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo:                  memo,
+		SearchAttributes:      searchAttributes,
+	}
+
+	_, err = adh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), startReq, nil, time.Now().UTC()))
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartResetActivitiesBatchOperationResponse{}, nil
+}
+
+// ExportWorkflowExecution exports the history and mutable state of a workflow execution and of the other runs of its
+// continued as new chain into a bundle, one page of history batches of a run at a time
+func (adh *AdminHandler) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+) (_ *adminservice.ExportWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 || pageSize > exportHistoryPageSize {
+		pageSize = exportHistoryPageSize
+	}
+	maxRunCount := int32(adh.config.ExportWorkflowMaxRunCount(request.GetNamespace()))
+
+	response := &adminservice.ExportWorkflowExecutionResponse{}
+	var token *tokenspb.ExportWorkflowExecutionContinuation
+	if len(request.GetNextPageToken()) == 0 {
+		response.Bundle, token, err = adh.startWorkflowExport(ctx, namespaceID, request, maxRunCount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		token, err = deserializeExportWorkflowToken(request.GetNextPageToken())
+		if err != nil || token.GetNamespaceId() != namespaceID.String() || token.GetWorkflowId() != request.Execution.GetWorkflowId() {
+			return nil, errInvalidNextPageToken
+		}
+	}
+
+	response.Run, token, err = adh.exportWorkflowRunPage(ctx, token, pageSize, maxRunCount)
+	if err != nil {
+		return nil, err
+	}
+	response.NextPageToken, err = serializeExportWorkflowToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ImportWorkflowExecution creates the workflow executions of a bundle exported by ExportWorkflowExecution
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetBundle() == nil {
+		return nil, errBundleNotSet
+	}
+	if err := validateExecution(request.GetBundle().GetExecution()); err != nil {
+		return nil, err
+	}
+	namespaceName := request.GetNamespace()
+	if namespaceName == "" {
+		namespaceName = request.GetBundle().GetNamespace()
+	}
+	if !adh.config.EnableWorkflowImport(namespaceName) {
+		return nil, errWorkflowImportNotAllowed
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Bundle:      request.GetBundle(),
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{}, nil
+}
+
+// startWorkflowExport returns the bundle without its runs and the token of the first run of the chain to export.
+// The chain is walked back from the requested run by less than the max number of exported runs, so that the
+// requested run is always exported.
+func (adh *AdminHandler) startWorkflowExport(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	maxRunCount int32,
+) (*adminservice.WorkflowExecutionBundle, *tokenspb.ExportWorkflowExecutionContinuation, error) {
+	workflowID := request.Execution.GetWorkflowId()
+	mutableState, err := adh.describeWorkflowRun(ctx, namespaceID, request.Execution)
+	if err != nil {
+		return nil, nil, err
+	}
+	requestedRunID := mutableState.GetExecutionState().GetRunId()
+
+	for runCount := int32(1); runCount < maxRunCount; runCount++ {
+		branchToken, nextEventID, err := getCurrentBranch(mutableState)
+		if err != nil {
+			return nil, nil, err
+		}
+		firstBatch, _, err := adh.readWorkflowRunHistory(ctx, namespaceID, workflowID, branchToken, nextEventID, 1, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(firstBatch) == 0 {
+			break
+		}
+		previousRunID := firstBatch[0].Events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+		if previousRunID == "" {
+			break
+		}
+		previousMutableState, err := adh.describeWorkflowRun(ctx, namespaceID, &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      previousRunID,
+		})
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+			// the chain is cut where runs are already deleted
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		mutableState = previousMutableState
+	}
+
+	bundle := &adminservice.WorkflowExecutionBundle{
+		Version:   common.WorkflowExecutionBundleVersion,
+		Namespace: request.GetNamespace(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      requestedRunID,
+		},
+		ExportTime: timestamp.TimePtr(time.Now().UTC()),
+	}
+	return bundle, &tokenspb.ExportWorkflowExecutionContinuation{
+		NamespaceId: namespaceID.String(),
+		WorkflowId:  workflowID,
+		RunId:       mutableState.GetExecutionState().GetRunId(),
+		RunCount:    1,
+	}, nil
+}
+
+// exportWorkflowRunPage exports a page of history batches of the run of the token, together with the mutable state
+// of the run on its first page, and returns the token of the next page.
+func (adh *AdminHandler) exportWorkflowRunPage(
+	ctx context.Context,
+	token *tokenspb.ExportWorkflowExecutionContinuation,
+	pageSize int,
+	maxRunCount int32,
+) (*adminservice.WorkflowRunBundle, *tokenspb.ExportWorkflowExecutionContinuation, error) {
+	namespaceID := namespace.ID(token.GetNamespaceId())
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: token.GetWorkflowId(),
+		RunId:      token.GetRunId(),
+	}
+	run := &adminservice.WorkflowRunBundle{Execution: execution}
+
+	nextToken := *token
+	if len(token.GetBranchToken()) == 0 {
+		mutableState, err := adh.describeWorkflowRun(ctx, namespaceID, execution)
+		if _, isNotFound := err.(*serviceerror.NotFound); isNotFound && token.GetRunCount() > 1 {
+			// the chain is cut where runs are already deleted
+			return nil, nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		nextToken.BranchToken, nextToken.NextEventId, err = getCurrentBranch(mutableState)
+		if err != nil {
+			return nil, nil, err
+		}
+		if adh.payloadStore != nil {
+			if err := payloadstore.ResolveMutableState(ctx, adh.payloadStore, mutableState); err != nil {
+				return nil, nil, err
+			}
+		}
+		executionInfo := mutableState.GetExecutionInfo()
+		run.MutableState = mutableState
+		run.Memo = &commonpb.Memo{Fields: executionInfo.GetMemo()}
+		run.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+	}
+
+	historyBatches, pageToken, err := adh.readWorkflowRunHistory(
+		ctx,
+		namespaceID,
+		execution.GetWorkflowId(),
+		nextToken.GetBranchToken(),
+		nextToken.GetNextEventId(),
+		pageSize,
+		token.GetPersistenceToken(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// history is re-encoded so that the bundle does not depend on the history encoding of the cluster,
+	// nor on its payload store
+	for _, batch := range historyBatches {
+		if adh.payloadStore != nil {
+			if err := payloadstore.Resolve(ctx, adh.payloadStore, batch, namespaceID); err != nil {
+				return nil, nil, err
+			}
+		}
+		blob, err := adh.eventSerializer.SerializeEvents(batch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, nil, err
+		}
+		run.HistoryBatches = append(run.HistoryBatches, blob)
+	}
+	if len(historyBatches) > 0 {
+		lastBatch := historyBatches[len(historyBatches)-1]
+		nextToken.NextRunId = getNextRunID(lastBatch.Events[len(lastBatch.Events)-1])
+	}
+
+	if len(pageToken) > 0 {
+		nextToken.PersistenceToken = pageToken
+		return run, &nextToken, nil
+	}
+	// the run is exported, continue with the next run of the chain
+	if nextToken.GetNextRunId() == "" || token.GetRunCount() >= maxRunCount {
+		return run, nil, nil
+	}
+	return run, &tokenspb.ExportWorkflowExecutionContinuation{
+		NamespaceId: token.GetNamespaceId(),
+		WorkflowId:  token.GetWorkflowId(),
+		RunId:       nextToken.GetNextRunId(),
+		RunCount:    token.GetRunCount() + 1,
+	}, nil
+}
+
+func (adh *AdminHandler) describeWorkflowRun(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) (*persistencespb.WorkflowMutableState, error) {
+	resp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetDatabaseMutableState(), nil
+}
+
+// readWorkflowRunHistory reads a page of history batches of the branch of the run, up to nextEventID.
+func (adh *AdminHandler) readWorkflowRunHistory(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	branchToken []byte,
+	nextEventID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*historypb.History, []byte, error) {
+	shardID, err := adh.historyShardResolver.WorkflowShardID(namespaceID, workflowID)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := adh.persistenceExecutionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+		ShardID:       shardID,
+		BranchToken:   branchToken,
+		MinEventID:    common.FirstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      pageSize,
+		NextPageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.History, resp.NextPageToken, nil
+}
+
+// getCurrentBranch returns the branch token of the current history branch of the run and the ID of its next event.
+func getCurrentBranch(mutableState *persistencespb.WorkflowMutableState) ([]byte, int64, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(mutableState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, 0, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return nil, 0, err
+	}
+	return currentVersionHistory.GetBranchToken(), lastItem.GetEventId() + 1, nil
+}
+
+// getNextRunID returns the ID of the run started by the run with the given last event continuing as new,
+// retrying or starting the next cron run.
+func getNextRunID(lastEvent *historypb.HistoryEvent) string {
+	switch lastEvent.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return lastEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return lastEvent.GetWorkflowExecutionCompletedEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return lastEvent.GetWorkflowExecutionFailedEventAttributes().GetNewExecutionRunId()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return lastEvent.GetWorkflowExecutionTimedOutEventAttributes().GetNewExecutionRunId()
+	default:
+		return ""
+	}
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// TODO currently, this API is only going to be used by re-send history events
+	// to remote cluster if kafka is lossy again, in the future, this API can be used
+	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
+	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+
+	if request.GetStartEventId() == common.EmptyEventID &&
+		request.GetStartEventVersion() == common.EmptyVersion &&
+		request.GetEndEventId() == common.EmptyEventID &&
+		request.GetEndEventVersion() == common.EmptyVersion {
+		return errInvalidEventQueryRange
+	}
+
 	return nil
 }
 
@@ -2023,3 +3100,56 @@ func (adh *AdminHandler) StreamWorkflowReplicationMessages(
 	})
 	return errGroup.Wait()
 }
+
+// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are committed
+func (adh *AdminHandler) StreamWorkflowExecutionHistory(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	server adminservice.AdminService_StreamWorkflowExecutionHistoryServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return err
+	}
+	if request.GetFirstEventId() < 0 {
+		return errInvalidFirstEventID
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return err
+	}
+
+	stream, err := adh.historyClient.StreamWorkflowExecutionHistory(server.Context(), &historyservice.StreamWorkflowExecutionHistoryRequest{
+		NamespaceId:  namespaceID.String(),
+		Execution:    request.Execution,
+		FirstEventId: request.GetFirstEventId(),
+		EventTypes:   request.GetEventTypes(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if adh.payloadStore != nil {
+			if err := payloadstore.Resolve(server.Context(), adh.payloadStore, resp.GetHistory(), namespaceID); err != nil {
+				return err
+			}
+		}
+		if err := server.Send(&adminservice.StreamWorkflowExecutionHistoryResponse{
+			History:     resp.GetHistory(),
+			NextEventId: resp.GetNextEventId(),
+			RunId:       resp.GetRunId(),
+		}); err != nil {
+			return err
+		}
+	}
+}
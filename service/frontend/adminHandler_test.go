@@ -32,9 +32,11 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
 	"go.temporal.io/server/common/resourcetest"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
@@ -45,6 +47,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/mocksdk"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
@@ -53,23 +56,31 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
 )
 
 type (
@@ -153,6 +164,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockResource.GetSDKClientFactory(),
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetArchiverProvider(),
@@ -165,6 +177,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		nil,
+		historyshard.NewStaticResolver(persistenceConfig.NumHistoryShards),
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -1278,11 +1292,8 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_DeleteCurrentExecution()
 		},
 	}
 
-	shardID := common.WorkflowIDToHistoryShard(
-		s.namespaceID.String(),
-		execution.GetWorkflowId(),
-		s.handler.numberOfHistoryShards,
-	)
+	shardID, err := s.handler.historyShardResolver.WorkflowShardID(s.namespaceID, execution.GetWorkflowId())
+	s.NoError(err)
 	runID := uuid.New()
 	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{
 		StartRequestID: uuid.New(),
@@ -1403,11 +1414,8 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	mutableState.ExecutionState.State = enums.WORKFLOW_EXECUTION_STATE_COMPLETED
 	mutableState.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
 
-	shardID := common.WorkflowIDToHistoryShard(
-		s.namespaceID.String(),
-		execution.GetWorkflowId(),
-		s.handler.numberOfHistoryShards,
-	)
+	shardID, err := s.handler.historyShardResolver.WorkflowShardID(s.namespaceID, execution.GetWorkflowId())
+	s.NoError(err)
 	closeTime := time.Now()
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: mutableState}, nil)
 	s.mockExecutionMgr.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
@@ -1444,3 +1452,278 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	_, err = s.handler.DeleteWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
+
+func (s *adminHandlerSuite) TestListWorkers() {
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Minute)
+	persistedTaskQueue := func(namespaceID namespace.ID, name string, kind enumspb.TaskQueueKind) *persistence.PersistedTaskQueueInfo {
+		return &persistence.PersistedTaskQueueInfo{Data: &persistencespb.TaskQueueInfo{
+			NamespaceId: namespaceID.String(),
+			Name:        name,
+			TaskType:    enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			Kind:        kind,
+		}}
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(s.namespaceID).Return(s.namespaceEntry, nil)
+	s.mockResource.TaskMgr.EXPECT().ListTaskQueue(gomock.Any(), &persistence.ListTaskQueueRequest{
+		PageSize:  2,
+		PageToken: []byte("token"),
+	}).Return(&persistence.ListTaskQueueResponse{
+		Items: []*persistence.PersistedTaskQueueInfo{
+			persistedTaskQueue(s.namespaceID, "tq", enumspb.TASK_QUEUE_KIND_NORMAL),
+			// described together with the root partition
+			persistedTaskQueue(s.namespaceID, "/_sys/tq/1", enumspb.TASK_QUEUE_KIND_NORMAL),
+			persistedTaskQueue(s.namespaceID, "sticky", enumspb.TASK_QUEUE_KIND_STICKY),
+			persistedTaskQueue("other-namespace", "tq", enumspb.TASK_QUEUE_KIND_NORMAL),
+		},
+		NextPageToken: []byte("next"),
+	}, nil)
+	s.mockResource.MatchingClient.EXPECT().ListTaskQueuePartitions(gomock.Any(), gomock.Any()).Return(
+		&matchingservice.ListTaskQueuePartitionsResponse{
+			WorkflowTaskQueuePartitions: []*taskqueuepb.TaskQueuePartitionMetadata{{Key: "tq"}, {Key: "/_sys/tq/1"}},
+		}, nil)
+	describeResponse := func(workers ...*taskqueuespb.WorkerInfo) *matchingservice.DescribeTaskQueueResponse {
+		return &matchingservice.DescribeTaskQueueResponse{Workers: workers}
+	}
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
+			s.True(request.GetIncludeWorkers())
+			if request.GetDescRequest().GetTaskQueue().GetName() == "tq" {
+				return describeResponse(
+					&taskqueuespb.WorkerInfo{
+						Identity:      "worker2",
+						NamespaceId:   s.namespaceID.String(),
+						TaskQueue:     "tq",
+						TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+						FirstPollTime: &now,
+						LastPollTime:  &now,
+						PollCount:     1,
+					},
+					&taskqueuespb.WorkerInfo{
+						Identity:           "worker1",
+						NamespaceId:        s.namespaceID.String(),
+						TaskQueue:          "tq",
+						TaskQueueType:      enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+						BuildId:            "new",
+						FirstPollTime:      &now,
+						LastPollTime:       &now,
+						PollCount:          2,
+						PollRate:           0.5,
+						TaskSlotsAvailable: 1,
+						TaskSlotsUsed:      9,
+					},
+				), nil
+			}
+			// the same worker polled another partition of the task queue
+			return describeResponse(&taskqueuespb.WorkerInfo{
+				Identity:           "worker1",
+				NamespaceId:        s.namespaceID.String(),
+				TaskQueue:          "tq",
+				TaskQueueType:      enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				BuildId:            "old",
+				FirstPollTime:      &earlier,
+				LastPollTime:       &earlier,
+				PollCount:          3,
+				PollRate:           0.25,
+				TaskSlotsAvailable: 10,
+			}), nil
+		}).Times(2)
+
+	resp, err := s.handler.ListWorkers(context.Background(), &adminservice.ListWorkersRequest{
+		Namespace:     s.namespace.String(),
+		PageSize:      2,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), resp.NextPageToken)
+	s.Len(resp.Workers, 2)
+	s.Equal("worker1", resp.Workers[0].Identity)
+	s.Equal("new", resp.Workers[0].BuildId)
+	s.Equal(int64(5), resp.Workers[0].PollCount)
+	s.Equal(0.75, resp.Workers[0].PollRate)
+	s.Equal(int32(1), resp.Workers[0].TaskSlotsAvailable)
+	s.Equal(int32(9), resp.Workers[0].TaskSlotsUsed)
+	s.Equal(earlier, *resp.Workers[0].FirstPollTime)
+	s.Equal(now, *resp.Workers[0].LastPollTime)
+	s.Equal("worker2", resp.Workers[1].Identity)
+}
+
+func (s *adminHandlerSuite) TestExportWorkflowExecution() {
+	s.handler.config.ExportWorkflowMaxRunCount = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	workflowID := "some random workflow ID"
+	firstRunID := uuid.New()
+	secondRunID := uuid.New()
+	histories := map[string][]*historypb.History{
+		firstRunID: {
+			{Events: []*historypb.HistoryEvent{{
+				EventId:    1,
+				EventType:  enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{}},
+			}}},
+			{Events: []*historypb.HistoryEvent{{
+				EventId:   2,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+					NewExecutionRunId: secondRunID,
+				}},
+			}}},
+		},
+		secondRunID: {
+			{Events: []*historypb.HistoryEvent{{
+				EventId:   1,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					ContinuedExecutionRunId: firstRunID,
+				}},
+			}}},
+		},
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DescribeMutableStateRequest, _ ...interface{}) (*historyservice.DescribeMutableStateResponse, error) {
+			runID := request.Execution.RunId
+			return &historyservice.DescribeMutableStateResponse{
+				DatabaseMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+						WorkflowId: workflowID,
+						VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+							[]byte(runID),
+							[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(int64(len(histories[runID])), 0)},
+						)),
+					},
+					ExecutionState: &persistencespb.WorkflowExecutionState{RunId: runID},
+				},
+			}, nil
+		},
+	).AnyTimes()
+	// history is read in pages of batches, the page token is the index of the next batch
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+			history := histories[string(request.BranchToken)]
+			start := 0
+			if len(request.NextPageToken) > 0 {
+				start = int(request.NextPageToken[0])
+			}
+			end := util.Min(start+request.PageSize, len(history))
+			resp := &persistence.ReadHistoryBranchByBatchResponse{History: history[start:end]}
+			if end < len(history) {
+				resp.NextPageToken = []byte{byte(end)}
+			}
+			return resp, nil
+		},
+	).AnyTimes()
+
+	exportWorkflow := func(runID string) (*adminservice.WorkflowExecutionBundle, []*adminservice.WorkflowRunBundle) {
+		request := &adminservice.ExportWorkflowExecutionRequest{
+			Namespace:       s.namespace.String(),
+			Execution:       &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			MaximumPageSize: 1,
+		}
+		resp, err := s.handler.ExportWorkflowExecution(context.Background(), request)
+		s.NoError(err)
+		bundle := resp.GetBundle()
+		s.Empty(bundle.GetRuns())
+		pages := []*adminservice.WorkflowRunBundle{resp.GetRun()}
+		for len(resp.GetNextPageToken()) > 0 {
+			request.NextPageToken = resp.GetNextPageToken()
+			resp, err = s.handler.ExportWorkflowExecution(context.Background(), request)
+			s.NoError(err)
+			s.Nil(resp.GetBundle())
+			pages = append(pages, resp.GetRun())
+		}
+		return bundle, pages
+	}
+
+	// the chain is walked back to its first run, and each run is exported one history batch at a time
+	bundle, pages := exportWorkflow(secondRunID)
+	s.Equal(common.WorkflowExecutionBundleVersion, bundle.GetVersion())
+	s.Equal(secondRunID, bundle.GetExecution().GetRunId())
+	s.Len(pages, 3)
+	s.Equal(firstRunID, pages[0].GetExecution().GetRunId())
+	s.NotNil(pages[0].GetMutableState())
+	s.Len(pages[0].GetHistoryBatches(), 1)
+	s.Equal(firstRunID, pages[1].GetExecution().GetRunId())
+	s.Nil(pages[1].GetMutableState())
+	s.Len(pages[1].GetHistoryBatches(), 1)
+	s.Equal(secondRunID, pages[2].GetExecution().GetRunId())
+	s.NotNil(pages[2].GetMutableState())
+	s.Len(pages[2].GetHistoryBatches(), 1)
+
+	// the requested run is exported even if the chain is longer than the max number of runs
+	s.handler.config.ExportWorkflowMaxRunCount = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	_, pages = exportWorkflow(secondRunID)
+	s.Len(pages, 1)
+	s.Equal(secondRunID, pages[0].GetExecution().GetRunId())
+	_, pages = exportWorkflow(firstRunID)
+	s.Len(pages, 2)
+	s.Equal(firstRunID, pages[1].GetExecution().GetRunId())
+}
+
+func (s *adminHandlerSuite) TestExportWorkflowExecution_ResolvesOffloadedPayloads() {
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+	s.handler.payloadStore = store
+	s.handler.config.ExportWorkflowMaxRunCount = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	input := payloads.EncodeString("input")
+	offloadedInput := payloads.EncodeString("input")
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")}}
+	offloadedMemo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")}}
+	for _, msg := range []proto.Message{offloadedInput, offloadedMemo} {
+		_, err := payloadstore.Offload(context.Background(), store, msg, s.namespaceID, 0)
+		s.NoError(err)
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: s.namespaceID.String(),
+				WorkflowId:  workflowID,
+				Memo:        offloadedMemo.Fields,
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+					[]byte(runID),
+					[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(1, 0)},
+				)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: runID},
+		},
+	}, nil).AnyTimes()
+	s.mockExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), gomock.Any()).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{{Events: []*historypb.HistoryEvent{{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: offloadedInput,
+			}},
+		}}}},
+	}, nil).AnyTimes()
+
+	resp, err := s.handler.ExportWorkflowExecution(context.Background(), &adminservice.ExportWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+	})
+	s.NoError(err)
+	s.Equal(memo.Fields, resp.GetRun().GetMemo().GetFields())
+	s.Equal(memo.Fields, resp.GetRun().GetMutableState().GetExecutionInfo().GetMemo())
+	events, err := serialization.NewSerializer().DeserializeEvents(resp.GetRun().GetHistoryBatches()[0])
+	s.NoError(err)
+	s.Equal(input, events[0].GetWorkflowExecutionStartedEventAttributes().GetInput())
+}
+
+func (s *adminHandlerSuite) TestImportWorkflowExecution_Disabled() {
+	s.handler.config.EnableWorkflowImport = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+
+	_, err := s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Bundle: &adminservice.WorkflowExecutionBundle{
+			Version:   common.WorkflowExecutionBundleVersion,
+			Namespace: s.namespace.String(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID"},
+		},
+	})
+	s.Equal(errWorkflowImportNotAllowed, err)
+}
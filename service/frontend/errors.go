@@ -40,6 +40,7 @@ var (
 	errQueryTypeNotSet                                    = serviceerror.NewInvalidArgument("QueryType is not set on request.")
 	errRequestNotSet                                      = serviceerror.NewInvalidArgument("Request is nil.")
 	errRequestIDNotSet                                    = serviceerror.NewInvalidArgument("RequestId is not set on request.")
+	errBundleNotSet                                       = serviceerror.NewInvalidArgument("Bundle is not set on request.")
 	errWorkflowTypeNotSet                                 = serviceerror.NewInvalidArgument("WorkflowType is not set on request.")
 	errInvalidWorkflowExecutionTimeoutSeconds             = serviceerror.NewInvalidArgument("An invalid WorkflowExecutionTimeoutSeconds is set on request.")
 	errInvalidWorkflowRunTimeoutSeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowRunTimeoutSeconds is set on request.")
@@ -48,6 +49,13 @@ var (
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
 	errTaskRangeNotSet                                    = serviceerror.NewInvalidArgument("Task range is not set")
+	errShardIDNotSet                                      = serviceerror.NewInvalidArgument("ShardId is not set on request.")
+	errFaultInjectionScenarioNameNotSet                   = serviceerror.NewInvalidArgument("Fault injection scenario name is not set on request.")
+	errTargetShardCountNotSet                             = serviceerror.NewInvalidArgument("TargetShardCount is not set on request.")
+	errInvalidTaskQueueType                               = serviceerror.NewInvalidArgument("TaskQueueType must be workflow or activity.")
+	errDestinationTaskQueueNotSet                         = serviceerror.NewInvalidArgument("DestinationTaskQueue is not set on request.")
+	errDestinationTaskQueueIsSource                       = serviceerror.NewInvalidArgument("DestinationTaskQueue must differ from TaskQueue.")
+	errInvalidMaxTasks                                    = serviceerror.NewInvalidArgument("MaxTasks must be positive.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
@@ -65,6 +73,7 @@ var (
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")
 	errInvalidFirstNextEventCombination                   = serviceerror.NewInvalidArgument("Invalid FirstEventId and NextEventId combination.")
+	errInvalidFirstEventID                                = serviceerror.NewInvalidArgument("Invalid FirstEventId.")
 	errInvalidVersionHistories                            = serviceerror.NewInvalidArgument("Invalid version histories.")
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
@@ -79,6 +88,7 @@ var (
 	errBatchOperationNotSet                               = serviceerror.NewInvalidArgument("Batch operation is not set on request.")
 	errCronAndStartDelaySet                               = serviceerror.NewInvalidArgument("CronSchedule and WorkflowStartDelay may not be used together.")
 	errInvalidWorkflowStartDelaySeconds                   = serviceerror.NewInvalidArgument("An invalid WorkflowStartDelaySeconds is set on request.")
+	errInvalidCompletionCallbackURL                       = serviceerror.NewInvalidArgument("Completion callback URL must be an absolute http or https URL.")
 	errRaceConditionAddingSearchAttributes                = serviceerror.NewUnavailable("Generated search attributes mapping unavailble.")
 
 	errUpdateMetaNotSet       = serviceerror.NewInvalidArgument("Update meta is not set on request.")
@@ -106,6 +116,10 @@ var (
 	errUnableToGetNamespaceInfoMessage                = "Unable to get namespace info with error: %v"
 	errUnableToCreateFrontendClientMessage            = "Unable to create frontend client with error: %v."
 	errTooManySearchAttributesMessage                 = "Unable to create search attributes: cannot have more than %d search attribute of type %s."
+	errInvalidCompletionCallbackMessage               = "Unable to decode completion callback: %v."
+	errInvalidWorkflowIDConflictPolicyMessage         = "Invalid workflow ID conflict policy: %v."
+	errTooManyCompletionCallbacksMessage              = "Cannot have more than %d completion callbacks."
+	errCompletionCallbackNotAllowedMessage            = "Completion callback is not allowed: %v."
 
 	errListNotAllowed      = serviceerror.NewPermissionDenied("List is disabled on this namespace.", "")
 	errSchedulesNotAllowed = serviceerror.NewPermissionDenied("Schedules are disabled on this namespace.", "")
@@ -116,5 +130,7 @@ var (
 	errBatchOpsMaxWorkflowExecutionCount = serviceerror.NewInvalidArgument("Workflow executions count exceeded.")
 
 	errUpdateWorkflowExecutionAPINotAllowed = serviceerror.NewPermissionDenied("UpdateWorkflowExecution operation is disabled on this namespace.", "")
+	errWorkflowImportNotAllowed             = serviceerror.NewPermissionDenied("ImportWorkflowExecution operation is disabled on this namespace.", "")
+	errCompletionCallbacksNotAllowed        = serviceerror.NewPermissionDenied("Completion callbacks are disabled on this namespace.", "")
 	errWorkerVersioningNotAllowed           = serviceerror.NewPermissionDenied("Worker versioning operations are disabled on this namespace.", "")
 )
@@ -44,11 +44,13 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -84,6 +86,7 @@ var Module = fx.Options(
 	fx.Provide(NamespaceRateLimitInterceptorProvider),
 	fx.Provide(SDKVersionInterceptorProvider),
 	fx.Provide(CallerInfoInterceptorProvider),
+	fx.Provide(PayloadOffloadInterceptorProvider),
 	fx.Provide(GrpcServerOptionsProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -145,6 +148,7 @@ func GrpcServerOptionsProvider(
 	traceInterceptor telemetry.ServerTraceInterceptor,
 	sdkVersionInterceptor *interceptor.SDKVersionInterceptor,
 	callerInfoInterceptor *interceptor.CallerInfoInterceptor,
+	payloadOffloadInterceptor *interceptor.PayloadOffloadInterceptor,
 	authorizer authorization.Authorizer,
 	claimMapper authorization.ClaimMapper,
 	audienceGetter authorization.JWTAudienceMapper,
@@ -197,6 +201,7 @@ func GrpcServerOptionsProvider(
 		rateLimitInterceptor.Intercept,
 		sdkVersionInterceptor.Intercept,
 		callerInfoInterceptor.Intercept,
+		payloadOffloadInterceptor.Intercept,
 	}
 	if len(customInterceptors) > 0 {
 		// TODO: Deprecate WithChainedFrontendGrpcInterceptors and provide a inner custom interceptor
@@ -380,6 +385,23 @@ func CallerInfoInterceptorProvider(
 	return interceptor.NewCallerInfoInterceptor(namespaceRegistry)
 }
 
+func PayloadOffloadInterceptorProvider(
+	serviceConfig *Config,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	payloadStore payloadstore.Store,
+	logger log.Logger,
+) *interceptor.PayloadOffloadInterceptor {
+	return interceptor.NewPayloadOffloadInterceptor(
+		namespaceRegistry,
+		clusterMetadata,
+		payloadStore,
+		logger,
+		serviceConfig.EnablePayloadOffload,
+		serviceConfig.PayloadOffloadThreshold,
+	)
+}
+
 func PersistenceRateLimitingParamsProvider(
 	serviceConfig *Config,
 ) service.PersistenceRateLimitingParams {
@@ -452,6 +474,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
+	matchingClient resource.MatchingClient,
 	sdkClientFactory sdk.ClientFactory,
 	membershipMonitor membership.Monitor,
 	archiverProvider provider.ArchiverProvider,
@@ -464,6 +487,8 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	payloadStore payloadstore.Store,
+	historyShardResolver historyshard.Resolver,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -480,6 +505,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkClientFactory,
 		membershipMonitor,
 		archiverProvider,
@@ -492,6 +518,8 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		payloadStore,
+		historyShardResolver,
 	}
 	return NewAdminHandler(args)
 }
@@ -554,6 +582,7 @@ func HandlerProvider(
 	archivalMetadata archiver.ArchivalMetadata,
 	healthServer *health.Server,
 	membershipMonitor membership.Monitor,
+	historyShardResolver historyshard.Resolver,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -576,6 +605,7 @@ func HandlerProvider(
 		healthServer,
 		timeSource,
 		membershipMonitor,
+		historyShardResolver,
 	)
 	return wfHandler
 }
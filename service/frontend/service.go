@@ -164,6 +164,16 @@ type Config struct {
 	MaxConcurrentBatchOperation     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxExecutionCountBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	EnableCompletionCallbacks         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CompletionCallbackAllowedHosts    dynamicconfig.StringPropertyFnWithNamespaceFilter
+	MaxCompletionCallbacksPerWorkflow dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	EnableWorkflowImport      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	ExportWorkflowMaxRunCount dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	EnablePayloadOffload    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	EnableUpdateWorkflowExecution dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	EnableWorkerVersioning        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 }
@@ -240,6 +250,16 @@ func NewConfig(
 		MaxConcurrentBatchOperation:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxConcurrentBatchOperationPerNamespace, 1),
 		MaxExecutionCountBatchOperation: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxExecutionCountBatchOperationPerNamespace, 1000),
 
+		EnableCompletionCallbacks:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableCompletionCallbacks, false),
+		CompletionCallbackAllowedHosts:    dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.CompletionCallbackAllowedHosts, ""),
+		MaxCompletionCallbacksPerWorkflow: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxCompletionCallbacksPerWorkflow, 8),
+
+		EnableWorkflowImport:      dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableWorkflowImport, false),
+		ExportWorkflowMaxRunCount: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendExportWorkflowMaxRunCount, 100),
+
+		EnablePayloadOffload:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnablePayloadOffload, false),
+		PayloadOffloadThreshold: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadOffloadThreshold, 256*1024),
+
 		EnableUpdateWorkflowExecution: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableUpdateWorkflowExecution, false),
 		EnableWorkerVersioning:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableWorkerVersioningDataAPIs, false),
 	}
@@ -94,3 +94,17 @@ func deserializeHistoryToken(bytes []byte) (*tokenspb.HistoryContinuation, error
 	err := token.Unmarshal(bytes)
 	return token, err
 }
+
+func serializeExportWorkflowToken(token *tokenspb.ExportWorkflowExecutionContinuation) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	return token.Marshal()
+}
+
+func deserializeExportWorkflowToken(bytes []byte) (*tokenspb.ExportWorkflowExecutionContinuation, error) {
+	token := &tokenspb.ExportWorkflowExecutionContinuation{}
+	err := token.Unmarshal(bytes)
+	return token, err
+}
@@ -29,6 +29,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -49,12 +50,15 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
@@ -62,10 +66,13 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/completioncallback"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/enums"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -82,6 +89,7 @@ import (
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tqname"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/scheduler"
@@ -126,6 +134,7 @@ type (
 		healthServer                    *health.Server
 		overrides                       *Overrides
 		membershipMonitor               membership.Monitor
+		historyShardResolver            historyshard.Resolver
 	}
 )
 
@@ -151,6 +160,7 @@ func NewWorkflowHandler(
 	healthServer *health.Server,
 	timeSource clock.TimeSource,
 	membershipMonitor membership.Monitor,
+	historyShardResolver historyshard.Resolver,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -191,10 +201,11 @@ func NewWorkflowHandler(
 			visibilityMrg.GetIndexName(),
 			visibility.AllowListForValidation(visibilityMrg.GetStoreNames()),
 		),
-		archivalMetadata:  archivalMetadata,
-		healthServer:      healthServer,
-		overrides:         NewOverrides(),
-		membershipMonitor: membershipMonitor,
+		archivalMetadata:     archivalMetadata,
+		healthServer:         healthServer,
+		overrides:            NewOverrides(),
+		membershipMonitor:    membershipMonitor,
+		historyShardResolver: historyShardResolver,
 	}
 
 	return handler
@@ -382,6 +393,16 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, err
 	}
 
+	completionCallbacks, err := wh.getCompletionCallbacks(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	conflictPolicy, err := wh.getWorkflowIDConflictPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	enums.SetDefaultWorkflowIdReusePolicy(&request.WorkflowIdReusePolicy)
 
 	wh.logger.Debug("Start workflow execution request namespace.", tag.WorkflowNamespace(namespaceName.String()))
@@ -391,7 +412,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	}
 	wh.logger.Debug("Start workflow execution request namespaceID.", tag.WorkflowNamespaceID(namespaceID.String()))
 
-	resp, err := wh.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC()))
+	historyRequest := common.CreateHistoryStartWorkflowRequest(namespaceID.String(), request, nil, time.Now().UTC())
+	historyRequest.CompletionCallbacks = completionCallbacks
+	historyRequest.WorkflowIdConflictPolicy = conflictPolicy
+	resp, err := wh.historyClient.StartWorkflowExecution(ctx, historyRequest)
 
 	if err != nil {
 		return nil, err
@@ -634,6 +658,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 			if err != nil {
 				return nil, err
 			}
+			if history != nil && continuationToken.GetForWorkflowTask() {
+				history.Events = filterWorkflowPauseMarkers(history.Events)
+			}
 
 			// here, for long pull on history events, we need to intercept the paging token from cassandra
 			// and do something clever
@@ -2653,7 +2680,9 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
-// pollers which polled this taskqueue in last few minutes.
+// pollers which polled this taskqueue in last few minutes. The pollers of a normal task queue are
+// aggregated across its partitions, the SDK and task slots of the workers are only reported by the
+// admin ListWorkers API since the public PollerInfo has no fields for them.
 func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workflowservice.DescribeTaskQueueRequest) (_ *workflowservice.DescribeTaskQueueResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
 
@@ -2678,12 +2707,84 @@ func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workf
 		return nil, err
 	}
 
+	pollers := matchingResponse.Pollers
+	if request.TaskQueue.GetKind() != enumspb.TASK_QUEUE_KIND_STICKY {
+		if pollers, err = wh.describeTaskQueuePartitionPollers(ctx, namespaceID, request, pollers); err != nil {
+			return nil, err
+		}
+	}
+
 	return &workflowservice.DescribeTaskQueueResponse{
-		Pollers:         matchingResponse.Pollers,
+		Pollers:         pollers,
 		TaskQueueStatus: matchingResponse.TaskQueueStatus,
 	}, nil
 }
 
+// describeTaskQueuePartitionPollers adds the pollers of the other partitions of the task queue to the
+// pollers of the root partition, keeping the latest access of each poller. Only the root partition is
+// described with the task queue status, which is per partition.
+func (wh *WorkflowHandler) describeTaskQueuePartitionPollers(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	request *workflowservice.DescribeTaskQueueRequest,
+	rootPollers []*taskqueuepb.PollerInfo,
+) ([]*taskqueuepb.PollerInfo, error) {
+	name, err := tqname.Parse(request.TaskQueue.GetName())
+	if err != nil || !name.IsRoot() || name.VersionSet() != "" {
+		// a specific partition was requested
+		return rootPollers, nil
+	}
+	partitionsResponse, err := wh.matchingClient.ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace:   request.GetNamespace(),
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   request.TaskQueue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	partitions := partitionsResponse.GetWorkflowTaskQueuePartitions()
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitions = partitionsResponse.GetActivityTaskQueuePartitions()
+	}
+
+	pollers := make(map[string]*taskqueuepb.PollerInfo, len(rootPollers))
+	result := make([]*taskqueuepb.PollerInfo, 0, len(rootPollers))
+	addPoller := func(poller *taskqueuepb.PollerInfo) {
+		existing, ok := pollers[poller.GetIdentity()]
+		if !ok {
+			pollers[poller.GetIdentity()] = poller
+			result = append(result, poller)
+			return
+		}
+		if timestamp.TimeValue(poller.GetLastAccessTime()).After(timestamp.TimeValue(existing.GetLastAccessTime())) {
+			*existing = *poller
+		}
+	}
+	for _, poller := range rootPollers {
+		addPoller(poller)
+	}
+	for _, partition := range partitions {
+		if partition.GetKey() == request.TaskQueue.GetName() {
+			continue
+		}
+		resp, err := wh.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace:     request.GetNamespace(),
+				TaskQueue:     &taskqueuepb.TaskQueue{Name: partition.GetKey(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType: request.GetTaskQueueType(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, poller := range resp.GetPollers() {
+			addPoller(poller)
+		}
+	}
+	return result, nil
+}
+
 // GetClusterInfo return information about Temporal deployment.
 func (wh *WorkflowHandler) GetClusterInfo(ctx context.Context, _ *workflowservice.GetClusterInfoRequest) (_ *workflowservice.GetClusterInfoResponse, retError error) {
 	defer log.CapturePanic(wh.logger, &retError)
@@ -3931,7 +4032,10 @@ func (wh *WorkflowHandler) getRawHistory(
 	branchToken []byte,
 ) ([]*commonpb.DataBlob, []byte, error) {
 	var rawHistory []*commonpb.DataBlob
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), wh.config.NumHistoryShards)
+	shardID, err := wh.historyShardResolver.WorkflowShardID(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, nil, err
+	}
 
 	resp, err := wh.persistenceExecutionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
@@ -3946,9 +4050,14 @@ func (wh *WorkflowHandler) getRawHistory(
 	}
 
 	for _, data := range resp.HistoryEventBlobs {
+		// clients only understand public encoding types
+		blob, err := serialization.DecompressBlob(data)
+		if err != nil {
+			return nil, nil, err
+		}
 		rawHistory = append(rawHistory, &commonpb.DataBlob{
-			EncodingType: data.EncodingType,
-			Data:         data.Data,
+			EncodingType: blob.EncodingType,
+			Data:         blob.Data,
 		})
 	}
 
@@ -3994,8 +4103,10 @@ func (wh *WorkflowHandler) getHistory(
 
 	var size int
 	isFirstPage := len(nextPageToken) == 0
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), wh.config.NumHistoryShards)
-	var err error
+	shardID, err := wh.historyShardResolver.WorkflowShardID(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, nil, err
+	}
 	var historyEvents []*historypb.HistoryEvent
 	historyEvents, size, nextPageToken, err = persistence.ReadFullPageEvents(ctx, wh.persistenceExecutionManager, &persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
@@ -4070,8 +4181,10 @@ func (wh *WorkflowHandler) getHistoryReverse(
 	branchToken []byte,
 ) (*historypb.History, []byte, int64, error) {
 	var size int
-	shardID := common.WorkflowIDToHistoryShard(namespaceID.String(), execution.GetWorkflowId(), wh.config.NumHistoryShards)
-	var err error
+	shardID, err := wh.historyShardResolver.WorkflowShardID(namespaceID, execution.GetWorkflowId())
+	if err != nil {
+		return nil, nil, 0, err
+	}
 	var historyEvents []*historypb.HistoryEvent
 
 	historyEvents, size, nextPageToken, err = persistence.ReadFullPageEventsReverse(ctx, wh.persistenceExecutionManager, &persistence.ReadHistoryBranchReverseRequest{
@@ -4114,6 +4227,18 @@ func (wh *WorkflowHandler) getHistoryReverse(
 	return executionHistory, nextPageToken, newNextEventID, nil
 }
 
+// filterWorkflowPauseMarkers removes the markers recorded by pausing and unpausing the workflow execution from
+// the history sent to workers. These markers are not the result of any command and workers don't know them.
+func filterWorkflowPauseMarkers(events []*historypb.HistoryEvent) []*historypb.HistoryEvent {
+	filtered := events[:0]
+	for _, event := range events {
+		if !common.IsWorkflowPauseMarker(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
 func (wh *WorkflowHandler) processOutgoingSearchAttributes(events []*historypb.HistoryEvent, namespace namespace.Name) error {
 	saTypeMap, err := wh.saProvider.GetSearchAttributes(wh.visibilityMrg.GetIndexName(), false)
 	if err != nil {
@@ -4306,6 +4431,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 		if err != nil {
 			return nil, err
 		}
+		history.Events = filterWorkflowPauseMarkers(history.Events)
 
 		if len(persistenceToken) != 0 {
 			continuation, err = serializeHistoryToken(&tokenspb.HistoryContinuation{
@@ -4315,6 +4441,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 				PersistenceToken:      persistenceToken,
 				TransientWorkflowTask: matchingResp.GetTransientWorkflowTask(),
 				BranchToken:           branchToken,
+				ForWorkflowTask:       true,
 			})
 			if err != nil {
 				return nil, err
@@ -4563,6 +4690,67 @@ func (wh *WorkflowHandler) validateWorkflowStartDelay(
 	return nil
 }
 
+// getCompletionCallbacks decodes and validates the completion callbacks passed in the request metadata.
+func (wh *WorkflowHandler) getCompletionCallbacks(
+	ctx context.Context,
+	namespaceName namespace.Name,
+) ([]*workflowspb.CompletionCallback, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(headers.CompletionCallbacksHeaderName)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if !wh.config.EnableCompletionCallbacks(namespaceName.String()) {
+		return nil, errCompletionCallbacksNotAllowed
+	}
+	if maxCallbacks := wh.config.MaxCompletionCallbacksPerWorkflow(namespaceName.String()); len(values) > maxCallbacks {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errTooManyCompletionCallbacksMessage, maxCallbacks))
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	callbacks := make([]*workflowspb.CompletionCallback, 0, len(values))
+	for _, value := range values {
+		callback := &workflowspb.CompletionCallback{}
+		if err := encoder.Decode([]byte(value), callback); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errInvalidCompletionCallbackMessage, err))
+		}
+		callbackURL, err := url.Parse(callback.GetUrl())
+		if err != nil || (callbackURL.Scheme != "http" && callbackURL.Scheme != "https") || callbackURL.Host == "" {
+			return nil, errInvalidCompletionCallbackURL
+		}
+		allowedHosts := wh.config.CompletionCallbackAllowedHosts(namespaceName.String())
+		if err := completioncallback.Validate(callback, allowedHosts); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(errCompletionCallbackNotAllowedMessage, err))
+		}
+		callbacks = append(callbacks, callback)
+	}
+	return callbacks, nil
+}
+
+// getWorkflowIDConflictPolicy decodes the workflow ID conflict policy passed in the request metadata.
+func (wh *WorkflowHandler) getWorkflowIDConflictPolicy(
+	ctx context.Context,
+) (enumsspb.WorkflowIdConflictPolicy, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, nil
+	}
+	values := md.Get(headers.WorkflowIDConflictPolicyHeaderName)
+	if len(values) == 0 {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, nil
+	}
+	policy, ok := enumsspb.WorkflowIdConflictPolicy_value[values[0]]
+	if !ok || len(values) > 1 {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, serviceerror.NewInvalidArgument(
+			fmt.Sprintf(errInvalidWorkflowIDConflictPolicyMessage, values),
+		)
+	}
+	return enumsspb.WorkflowIdConflictPolicy(policy), nil
+}
+
 func (wh *WorkflowHandler) metricsScope(ctx context.Context) metrics.Handler {
 	return interceptor.GetMetricsHandlerFromContext(ctx, wh.logger)
 }
@@ -4672,9 +4860,13 @@ func (wh *WorkflowHandler) trimHistoryNode(
 	if err != nil {
 		return // abort
 	}
+	shardID, err := wh.historyShardResolver.WorkflowShardID(namespace.ID(namespaceID), workflowID)
+	if err != nil {
+		return // abort
+	}
 
 	_, err = wh.persistenceExecutionManager.TrimHistoryBranch(ctx, &persistence.TrimHistoryBranchRequest{
-		ShardID:       common.WorkflowIDToHistoryShard(namespaceID, workflowID, wh.config.NumHistoryShards),
+		ShardID:       shardID,
 		BranchToken:   response.CurrentBranchToken,
 		NodeID:        response.GetLastFirstEventId(),
 		TransactionID: response.GetLastFirstEventTxnId(),
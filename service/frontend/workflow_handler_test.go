@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -59,6 +60,7 @@ import (
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
@@ -66,6 +68,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	dc "go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
@@ -189,6 +192,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		health.NewServer(),
 		clock.NewRealTimeSource(),
 		s.mockResource.GetMembershipMonitor(),
+		historyshard.NewStaticResolver(config.NumHistoryShards),
 	)
 }
 
@@ -717,6 +721,91 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidStartDel
 	s.ErrorIs(err, errInvalidWorkflowStartDelaySeconds)
 }
 
+func (s *workflowHandlerSuite) TestGetCompletionCallbacks() {
+	config := s.newConfig()
+	config.MaxCompletionCallbacksPerWorkflow = dc.GetIntPropertyFilteredByNamespace(2)
+	config.EnableCompletionCallbacks = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	config.CompletionCallbackAllowedHosts = func(string) string { return "example.com" }
+	wh := s.getWorkflowHandler(config)
+	namespaceName := namespace.Name("test-namespace")
+
+	callbacks, err := wh.getCompletionCallbacks(context.Background(), namespaceName)
+	s.NoError(err)
+	s.Nil(callbacks)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"https://example.com/callback","header":{"Authorization":"token"}}`,
+	))
+	callbacks, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.NoError(err)
+	s.Equal([]*workflowspb.CompletionCallback{{
+		Url:    "https://example.com/callback",
+		Header: map[string]string{"Authorization": "token"},
+	}}, callbacks)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"ftp://example.com/callback"}`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.ErrorIs(err, errInvalidCompletionCallbackURL)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `not json`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"http://example.com/1"}`,
+		headers.CompletionCallbacksHeaderName, `{"url":"http://example.com/2"}`,
+		headers.CompletionCallbacksHeaderName, `{"url":"http://example.com/3"}`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.ErrorAs(err, &invalidArgument)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"http://169.254.169.254/latest/meta-data"}`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.ErrorAs(err, &invalidArgument)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"https://example.com/callback","header":{"Host":"internal"}}`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.ErrorAs(err, &invalidArgument)
+
+	config.EnableCompletionCallbacks = dc.GetBoolPropertyFnFilteredByNamespace(false)
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.CompletionCallbacksHeaderName, `{"url":"https://example.com/callback"}`,
+	))
+	_, err = wh.getCompletionCallbacks(ctx, namespaceName)
+	s.ErrorIs(err, errCompletionCallbacksNotAllowed)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowIDConflictPolicy() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	policy, err := wh.getWorkflowIDConflictPolicy(context.Background())
+	s.NoError(err)
+	s.Equal(enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED, policy)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.WorkflowIDConflictPolicyHeaderName, enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING.String(),
+	))
+	policy, err = wh.getWorkflowIDConflictPolicy(ctx)
+	s.NoError(err)
+	s.Equal(enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING, policy)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.WorkflowIDConflictPolicyHeaderName, "NotAPolicy",
+	))
+	_, err = wh.getWorkflowIDConflictPolicy(ctx)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *workflowHandlerSuite) TestRegisterNamespace_Failure_InvalidArchivalURI() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
@@ -2064,6 +2153,54 @@ func (s *workflowHandlerSuite) TestGetSystemInfo() {
 	s.True(resp.Capabilities.UpsertMemo)
 }
 
+func (s *workflowHandlerSuite) TestDescribeTaskQueue_AggregatesPartitionPollers() {
+	testNamespace := namespace.Name("test-namespace")
+	namespaceID := namespace.ID(uuid.New())
+	wh := s.getWorkflowHandler(s.newConfig())
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Minute)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(testNamespace).Return(namespaceID, nil)
+	s.mockMatchingClient.EXPECT().ListTaskQueuePartitions(gomock.Any(), gomock.Any()).Return(
+		&matchingservice.ListTaskQueuePartitionsResponse{
+			ActivityTaskQueuePartitions: []*taskqueuepb.TaskQueuePartitionMetadata{{Key: "tq"}, {Key: "/_sys/tq/1"}},
+		}, nil)
+	s.mockMatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
+			if request.GetDescRequest().GetTaskQueue().GetName() == "tq" {
+				return &matchingservice.DescribeTaskQueueResponse{
+					Pollers: []*taskqueuepb.PollerInfo{
+						{Identity: "worker1", LastAccessTime: &earlier},
+						{Identity: "worker2", LastAccessTime: &now},
+					},
+					TaskQueueStatus: &taskqueuepb.TaskQueueStatus{AckLevel: 10},
+				}, nil
+			}
+			s.False(request.GetDescRequest().GetIncludeTaskQueueStatus())
+			return &matchingservice.DescribeTaskQueueResponse{
+				Pollers: []*taskqueuepb.PollerInfo{
+					{Identity: "worker1", LastAccessTime: &now, RatePerSecond: 5},
+					{Identity: "worker3", LastAccessTime: &now},
+				},
+			}, nil
+		}).Times(2)
+
+	resp, err := wh.DescribeTaskQueue(context.Background(), &workflowservice.DescribeTaskQueueRequest{
+		Namespace:              testNamespace.String(),
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: "tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		TaskQueueType:          enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		IncludeTaskQueueStatus: true,
+	})
+	s.NoError(err)
+	s.Equal(int64(10), resp.TaskQueueStatus.GetAckLevel())
+	s.Len(resp.Pollers, 3)
+	s.Equal("worker1", resp.Pollers[0].GetIdentity())
+	s.Equal(now, *resp.Pollers[0].LastAccessTime)
+	s.Equal(float64(5), resp.Pollers[0].GetRatePerSecond())
+	s.Equal("worker2", resp.Pollers[1].GetIdentity())
+	s.Equal("worker3", resp.Pollers[2].GetIdentity())
+}
+
 func (s *workflowHandlerSuite) TestStartBatchOperation_Terminate() {
 	testNamespace := namespace.Name("test-namespace")
 	namespaceID := namespace.ID(uuid.New())
@@ -2843,3 +2980,25 @@ func (s *workflowHandlerSuite) Test_DeleteWorkflowExecution() {
 	s.NoError(err)
 	s.NotNil(resp)
 }
+
+func TestFilterWorkflowPauseMarkers(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED},
+		{EventId: 6, EventType: enumspb.EVENT_TYPE_MARKER_RECORDED, Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+			MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{MarkerName: common.WorkflowPausedMarkerName},
+		}},
+		{EventId: 7, EventType: enumspb.EVENT_TYPE_MARKER_RECORDED, Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+			MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{MarkerName: "Version"},
+		}},
+		{EventId: 8, EventType: enumspb.EVENT_TYPE_MARKER_RECORDED, Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+			MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{MarkerName: common.WorkflowUnpausedMarkerName},
+		}},
+		{EventId: 9, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+	}
+
+	filtered := filterWorkflowPauseMarkers(events)
+	require.Len(t, filtered, 3)
+	require.Equal(t, int64(5), filtered[0].GetEventId())
+	require.Equal(t, int64(7), filtered[1].GetEventId())
+	require.Equal(t, int64(9), filtered[2].GetEventId())
+}
@@ -26,11 +26,17 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/api/matchingservice/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
 )
 
@@ -76,3 +82,45 @@ func GetActivityScheduledEventID(
 	}
 	return activityInfo.ScheduledEventId, nil
 }
+
+// RecordActivityTaskClosed tells the task queue partition which dispatched the activity task of the token that the
+// task is closed, to release its slot in the inflight activity task limit of the partition. respondErr is the result
+// of responding to the task: the slot is kept while the task may still be running. Failures are only logged since
+// the slot is also released once the task times out.
+func RecordActivityTaskClosed(
+	ctx context.Context,
+	shard shard.Context,
+	matchingClient matchingservice.MatchingServiceClient,
+	token *tokenspb.Task,
+	respondErr error,
+) {
+	if token.GetInflightTaskQueue() == "" {
+		return
+	}
+	if respondErr != nil &&
+		!errors.Is(respondErr, consts.ErrActivityTaskNotFound) &&
+		!errors.Is(respondErr, consts.ErrWorkflowCompleted) {
+		return
+	}
+
+	_, err := matchingClient.RecordActivityTaskClosed(ctx, &matchingservice.RecordActivityTaskClosedRequest{
+		NamespaceId: token.GetNamespaceId(),
+		TaskQueue:   token.GetInflightTaskQueue(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: token.GetWorkflowId(),
+			RunId:      token.GetRunId(),
+		},
+		ScheduledEventId: token.GetScheduledEventId(),
+		Attempt:          token.GetAttempt(),
+	})
+	if err != nil {
+		shard.GetLogger().Warn("Failed to release inflight slot of activity task",
+			tag.WorkflowNamespaceID(token.GetNamespaceId()),
+			tag.WorkflowID(token.GetWorkflowId()),
+			tag.WorkflowRunID(token.GetRunId()),
+			tag.WorkflowScheduledEventID(token.GetScheduledEventId()),
+			tag.WorkflowTaskQueueName(token.GetInflightTaskQueue()),
+			tag.Error(err),
+		)
+	}
+}
@@ -26,13 +26,16 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
@@ -172,6 +175,46 @@ func GenerateFirstWorkflowTask(
 	return 0, nil
 }
 
+// ResolveWorkflowIDConflictPolicy returns the policy applied when a workflow with the same ID is running.
+// An unspecified conflict policy falls back to the workflow ID reuse policy, which only allows terminating
+// the running workflow through TERMINATE_IF_RUNNING.
+func ResolveWorkflowIDConflictPolicy(
+	conflictPolicy enumsspb.WorkflowIdConflictPolicy,
+	reusePolicy enumspb.WorkflowIdReusePolicy,
+) enumsspb.WorkflowIdConflictPolicy {
+	if conflictPolicy != enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		return conflictPolicy
+	}
+	if reusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+		return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING
+	}
+	return enumsspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL
+}
+
+// ValidateWorkflowIDConflictPolicy rejects conflict policies contradicting the workflow ID reuse policy.
+func ValidateWorkflowIDConflictPolicy(
+	conflictPolicy enumsspb.WorkflowIdConflictPolicy,
+	reusePolicy enumspb.WorkflowIdReusePolicy,
+) error {
+	switch conflictPolicy {
+	case enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED,
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING:
+		return nil
+	case enumsspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING:
+		if reusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Workflow ID conflict policy %v cannot be used with workflow ID reuse policy %v.",
+				conflictPolicy,
+				reusePolicy,
+			))
+		}
+		return nil
+	default:
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid workflow ID conflict policy: %v.", conflictPolicy))
+	}
+}
+
 func NewWorkflowVersionCheck(
 	shard shard.Context,
 	prevLastWriteVersion int64,
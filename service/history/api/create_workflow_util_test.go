@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+func TestResolveWorkflowIDConflictPolicy(t *testing.T) {
+	testCases := []struct {
+		conflictPolicy enumsspb.WorkflowIdConflictPolicy
+		reusePolicy    enumspb.WorkflowIdReusePolicy
+		expected       enumsspb.WorkflowIdConflictPolicy
+	}{
+		{
+			conflictPolicy: enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED,
+			reusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			expected:       enumsspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		},
+		{
+			conflictPolicy: enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED,
+			reusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING,
+			expected:       enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+		},
+		{
+			conflictPolicy: enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+			reusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+			expected:       enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		{
+			conflictPolicy: enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+			reusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			expected:       enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, ResolveWorkflowIDConflictPolicy(tc.conflictPolicy, tc.reusePolicy))
+	}
+}
+
+func TestValidateWorkflowIDConflictPolicy(t *testing.T) {
+	assert.NoError(t, ValidateWorkflowIDConflictPolicy(
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	))
+	assert.NoError(t, ValidateWorkflowIDConflictPolicy(
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+		enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING,
+	))
+
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, ValidateWorkflowIDConflictPolicy(
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING,
+	), &invalidArgument)
+	assert.ErrorAs(t, ValidateWorkflowIDConflictPolicy(
+		enumsspb.WorkflowIdConflictPolicy(100),
+		enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	), &invalidArgument)
+}
+
+func TestApplyWorkflowIDReusePolicy_ConflictPolicy(t *testing.T) {
+	action, err := ApplyWorkflowIDReusePolicy(
+		"prevRequestID",
+		"prevRunID",
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		"workflowID",
+		"runID",
+		enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING,
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, action)
+
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	_, err = ApplyWorkflowIDReusePolicy(
+		"prevRequestID",
+		"prevRunID",
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		"workflowID",
+		"runID",
+		enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+	)
+	assert.ErrorAs(t, err, &alreadyStarted)
+}
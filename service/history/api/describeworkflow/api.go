@@ -105,6 +105,7 @@ func Invoke(
 			StateTransitionCount: executionInfo.StateTransitionCount,
 			HistorySizeBytes:     executionInfo.GetExecutionStats().GetHistorySize(),
 		},
+		CompletionCallbacks: executionInfo.CompletionCallbacks,
 	}
 
 	if executionInfo.ParentRunId != "" {
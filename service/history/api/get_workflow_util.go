@@ -214,5 +214,6 @@ func MutableStateToGetResponse(
 			mutableState.GetExecutionInfo().GetVersionHistories(),
 		),
 		FirstExecutionRunId: executionInfo.FirstExecutionRunId,
+		BuildIdAssignment:   executionInfo.BuildIdAssignment,
 	}, nil
 }
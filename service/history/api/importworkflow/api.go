@@ -0,0 +1,411 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importworkflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+const (
+	// terminateReason is the reason of the termination of runs that were still running when exported
+	terminateReason = "workflow execution imported"
+
+	cleanupTimeout = 30 * time.Second
+)
+
+type (
+	// importedRun tracks what was persisted for a run so that a failed import can be cleaned up
+	importedRun struct {
+		runID       string
+		branchToken []byte
+		isCurrent   bool
+		created     bool
+	}
+)
+
+// Invoke creates the runs of an exported workflow execution bundle. The history of each run is
+// copied into a new history branch and its mutable state is created from the exported snapshot.
+//
+// The history tasks of closed runs are regenerated, so they are recorded in visibility and deleted
+// after the retention of the namespace. Runs which were still running are terminated, as they
+// cannot be progressed in this cluster. Either all runs are imported, or whatever was persisted is
+// deleted again.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+	shardContext shard.Context,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if err := api.ValidateNamespaceUUID(namespaceID); err != nil {
+		return nil, err
+	}
+	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	bundle := request.GetBundle()
+	if err := validateBundle(bundle); err != nil {
+		return nil, err
+	}
+	if err := rejectOffloadedPayloads(ctx, shardContext, bundle); err != nil {
+		return nil, err
+	}
+
+	executionManager := shardContext.GetExecutionManager()
+	workflowID := bundle.GetExecution().GetWorkflowId()
+	for _, run := range bundle.GetRuns() {
+		_, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+			ShardID:     shardContext.GetShardID(),
+			NamespaceID: namespaceID.String(),
+			WorkflowID:  workflowID,
+			RunID:       run.GetExecution().GetRunId(),
+		})
+		switch err.(type) {
+		case nil:
+			return nil, serviceerror.NewAlreadyExist(fmt.Sprintf(
+				"workflow execution %v of workflow %v already exists", run.GetExecution().GetRunId(), workflowID,
+			))
+		case *serviceerror.NotFound:
+		default:
+			return nil, err
+		}
+	}
+
+	var importedRuns []*importedRun
+	for i, run := range bundle.GetRuns() {
+		// the last run of the chain becomes the current run of the workflow
+		imported := &importedRun{
+			runID:     run.GetExecution().GetRunId(),
+			isCurrent: i == len(bundle.GetRuns())-1,
+		}
+		importedRuns = append(importedRuns, imported)
+		if err := importRun(ctx, shardContext, namespaceEntry, run, imported); err != nil {
+			cleanupRuns(shardContext, namespaceEntry, workflowID, importedRuns)
+			return nil, err
+		}
+	}
+	return &historyservice.ImportWorkflowExecutionResponse{}, nil
+}
+
+func validateBundle(
+	bundle *adminservice.WorkflowExecutionBundle,
+) error {
+	if bundle == nil {
+		return serviceerror.NewInvalidArgument("Bundle is not set on request.")
+	}
+	if bundle.GetVersion() <= 0 || bundle.GetVersion() > common.WorkflowExecutionBundleVersion {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Unsupported bundle version %v.", bundle.GetVersion()))
+	}
+	if len(bundle.GetRuns()) == 0 {
+		return serviceerror.NewInvalidArgument("Bundle has no runs.")
+	}
+	for _, run := range bundle.GetRuns() {
+		if run.GetExecution().GetWorkflowId() != bundle.GetExecution().GetWorkflowId() {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Run %v of the bundle has a different workflow ID.", run.GetExecution().GetRunId(),
+			))
+		}
+		if run.GetMutableState().GetExecutionInfo() == nil || run.GetMutableState().GetExecutionState() == nil {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Run %v of the bundle has no mutable state.", run.GetExecution().GetRunId(),
+			))
+		}
+		if run.GetMutableState().GetExecutionState().GetRunId() != run.GetExecution().GetRunId() {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Run %v of the bundle has the mutable state of a different run.", run.GetExecution().GetRunId(),
+			))
+		}
+		if len(run.GetHistoryBatches()) == 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Run %v of the bundle has no history.", run.GetExecution().GetRunId(),
+			))
+		}
+	}
+	return nil
+}
+
+// rejectOffloadedPayloads rejects bundles referencing offloaded payloads. Imported history is persisted as is,
+// and references would point to payloads of the source cluster, or of another workflow of this cluster.
+func rejectOffloadedPayloads(
+	ctx context.Context,
+	shardContext shard.Context,
+	bundle *adminservice.WorkflowExecutionBundle,
+) error {
+	if shardContext.GetPayloadStore() == nil {
+		return nil
+	}
+	for _, run := range bundle.GetRuns() {
+		if err := payloadstore.RejectMutableStateReferences(ctx, run.GetMutableState()); err != nil {
+			return err
+		}
+		for _, blob := range run.GetHistoryBatches() {
+			historyEvents, err := shardContext.GetPayloadSerializer().DeserializeEvents(blob)
+			if err != nil {
+				return err
+			}
+			if err := payloadstore.RejectReferences(ctx, &historypb.History{Events: historyEvents}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func importRun(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	run *adminservice.WorkflowRunBundle,
+	imported *importedRun,
+) error {
+	namespaceID := namespaceEntry.ID()
+	workflowID := run.GetExecution().GetWorkflowId()
+	runID := run.GetExecution().GetRunId()
+	state := run.GetMutableState()
+	executionInfo := state.GetExecutionInfo()
+
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return err
+	}
+
+	branchToken, err := shardContext.GetExecutionManager().GetHistoryBranchUtil().NewHistoryBranch(
+		namespaceID.String(),
+		runID,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	imported.branchToken = branchToken
+	lastEventID, lastTransactionID, err := appendHistory(ctx, shardContext, namespaceID, workflowID, runID, branchToken, run)
+	if err != nil {
+		return err
+	}
+	if lastEventID != lastItem.GetEventId() {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"History of run %v ends at event %v, but its mutable state at event %v.", runID, lastEventID, lastItem.GetEventId(),
+		))
+	}
+
+	// the exported mutable state refers to the namespace, history branch, checksum and
+	// completion callbacks of the source cluster
+	newVersionHistory := versionhistory.CopyVersionHistory(currentVersionHistory)
+	newVersionHistory.BranchToken = branchToken
+	executionInfo.NamespaceId = namespaceID.String()
+	executionInfo.VersionHistories = versionhistory.NewVersionHistories(newVersionHistory)
+	executionInfo.CompletionCallbacks = nil
+	if executionInfo.ExecutionStats == nil {
+		executionInfo.ExecutionStats = &persistencespb.ExecutionStats{}
+	}
+	state.Checksum = nil
+
+	mutableState, err := workflow.NewSanitizedMutableState(
+		shardContext,
+		shardContext.GetEventsCache(),
+		shardContext.GetLogger(),
+		namespaceEntry,
+		state,
+		lastTransactionID,
+		lastItem.GetVersion(),
+	)
+	if err != nil {
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		if err := workflow.TerminateWorkflow(
+			mutableState,
+			mutableState.GetNextEventID(),
+			terminateReason,
+			nil,
+			consts.IdentityHistoryService,
+			false,
+		); err != nil {
+			return err
+		}
+	} else {
+		if err := workflow.NewTaskRefresher(
+			shardContext,
+			shardContext.GetConfig(),
+			shardContext.GetNamespaceRegistry(),
+			shardContext.GetEventsCache(),
+			shardContext.GetLogger(),
+		).RefreshTasks(ctx, mutableState); err != nil {
+			return err
+		}
+	}
+	snapshot, events, err := mutableState.CloseTransactionAsSnapshot(workflow.TransactionPolicyPassive)
+	if err != nil {
+		return err
+	}
+
+	createMode := persistence.CreateWorkflowModeBypassCurrent
+	if imported.isCurrent {
+		createMode = persistence.CreateWorkflowModeBrandNew
+	}
+	imported.created = true
+	return workflow.NewContext(
+		shardContext,
+		definition.NewWorkflowKey(namespaceID.String(), workflowID, runID),
+		shardContext.GetLogger(),
+	).CreateWorkflowExecution(
+		ctx,
+		createMode,
+		"",
+		common.EmptyVersion,
+		mutableState,
+		snapshot,
+		events,
+	)
+}
+
+// appendHistory appends the history batches of the run to the branch and returns the ID of the last event
+// and the transaction ID of the last batch.
+func appendHistory(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+	branchToken []byte,
+	run *adminservice.WorkflowRunBundle,
+) (int64, int64, error) {
+	lastEventID := common.FirstEventID - 1
+	var prevTransactionID int64
+	for i, blob := range run.GetHistoryBatches() {
+		historyEvents, err := shardContext.GetPayloadSerializer().DeserializeEvents(blob)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(historyEvents) == 0 || historyEvents[0].GetEventId() != lastEventID+1 {
+			return 0, 0, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"History of run %v is not contiguous after event %v.", runID, lastEventID,
+			))
+		}
+
+		transactionID, err := shardContext.GenerateTaskID()
+		if err != nil {
+			return 0, 0, err
+		}
+		if _, err := shardContext.GetExecutionManager().AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
+			ShardID:           shardContext.GetShardID(),
+			IsNewBranch:       i == 0,
+			Info:              persistence.BuildHistoryGarbageCleanupInfo(namespaceID.String(), workflowID, runID),
+			BranchToken:       branchToken,
+			History:           blob,
+			PrevTransactionID: prevTransactionID,
+			TransactionID:     transactionID,
+			NodeID:            historyEvents[0].GetEventId(),
+		}); err != nil {
+			return 0, 0, err
+		}
+		prevTransactionID = transactionID
+		lastEventID = historyEvents[len(historyEvents)-1].GetEventId()
+	}
+	return lastEventID, prevTransactionID, nil
+}
+
+// cleanupRuns deletes the executions and history branches persisted by a failed import. It does not
+// use the context of the request, which may be the reason the import failed.
+func cleanupRuns(
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	workflowID string,
+	importedRuns []*importedRun,
+) {
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo(namespaceEntry.Name().String())),
+		cleanupTimeout,
+	)
+	defer cancel()
+
+	shardID := shardContext.GetShardID()
+	executionManager := shardContext.GetExecutionManager()
+	logger := shardContext.GetLogger()
+	for _, imported := range importedRuns {
+		logTags := []tag.Tag{
+			tag.WorkflowNamespaceID(namespaceEntry.ID().String()),
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(imported.runID),
+		}
+		if imported.created {
+			if imported.isCurrent {
+				if err := executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+					ShardID:     shardID,
+					NamespaceID: namespaceEntry.ID().String(),
+					WorkflowID:  workflowID,
+					RunID:       imported.runID,
+				}); err != nil {
+					logger.Error("Unable to delete current workflow execution of failed import.", append(logTags, tag.Error(err))...)
+				}
+			}
+			if err := executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+				ShardID:     shardID,
+				NamespaceID: namespaceEntry.ID().String(),
+				WorkflowID:  workflowID,
+				RunID:       imported.runID,
+			}); err != nil {
+				logger.Error("Unable to delete workflow execution of failed import.", append(logTags, tag.Error(err))...)
+			}
+		}
+		if imported.branchToken != nil {
+			if err := executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+				ShardID:     shardID,
+				BranchToken: imported.branchToken,
+			}); err != nil {
+				logger.Error("Unable to delete history branch of failed import.", append(logTags, tag.Error(err))...)
+			}
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package importworkflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func TestInvoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := newTestShard(ctrl)
+
+	firstRun := newRunBundle(t, "first-run-id", 2)
+	secondRun := newRunBundle(t, "second-run-id", 3)
+	secondRun.MutableState.ExecutionInfo.CompletionCallbacks = []*workflowspb.CompletionCallbackInfo{{}}
+	request := newImportRequest(firstRun, secondRun)
+
+	mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewNotFound("not found"),
+	).Times(2)
+	mockShard.Resource.ExecutionMgr.EXPECT().AppendRawHistoryNodes(gomock.Any(), gomock.Any()).Return(
+		&persistence.AppendHistoryNodesResponse{}, nil,
+	).Times(4)
+	var createRequests []*persistence.CreateWorkflowExecutionRequest
+	mockShard.Resource.ExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			createRequests = append(createRequests, request)
+			return tests.CreateWorkflowExecutionResponse, nil
+		},
+	).Times(2)
+
+	_, err := Invoke(context.Background(), request, mockShard)
+	require.NoError(t, err)
+
+	require.Len(t, createRequests, 2)
+	require.Equal(t, persistence.CreateWorkflowModeBypassCurrent, createRequests[0].Mode)
+	require.Equal(t, persistence.CreateWorkflowModeBrandNew, createRequests[1].Mode)
+	for i, createRequest := range createRequests {
+		executionInfo := createRequest.NewWorkflowSnapshot.ExecutionInfo
+		require.Equal(t, tests.NamespaceID.String(), executionInfo.NamespaceId)
+		require.Equal(t, request.Bundle.Runs[i].Execution.RunId, createRequest.NewWorkflowSnapshot.ExecutionState.RunId)
+		require.Empty(t, executionInfo.CompletionCallbacks)
+		require.Empty(t, createRequest.NewWorkflowEvents)
+
+		currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.VersionHistories)
+		require.NoError(t, err)
+		branch, err := (&persistence.HistoryBranchUtilImpl{}).ParseHistoryBranchInfo(currentVersionHistory.BranchToken)
+		require.NoError(t, err)
+		require.Equal(t, request.Bundle.Runs[i].Execution.RunId, branch.TreeId)
+
+		// closed runs are deleted after the retention of the namespace
+		require.True(t, hasDeleteHistoryEventTask(createRequest.NewWorkflowSnapshot.Tasks))
+	}
+}
+
+func TestInvoke_TerminatesRunningRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := newTestShard(ctrl)
+
+	run := newRunBundle(t, tests.RunID, 2)
+	run.MutableState.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING
+	run.MutableState.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+	mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewNotFound("not found"),
+	)
+	mockShard.Resource.ExecutionMgr.EXPECT().AppendRawHistoryNodes(gomock.Any(), gomock.Any()).Return(
+		&persistence.AppendHistoryNodesResponse{}, nil,
+	).Times(2)
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	mockShard.Resource.ExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			createRequest = request
+			return tests.CreateWorkflowExecutionResponse, nil
+		},
+	)
+
+	_, err := Invoke(context.Background(), newImportRequest(run), mockShard)
+	require.NoError(t, err)
+
+	require.Equal(t, persistence.CreateWorkflowModeBrandNew, createRequest.Mode)
+	require.Equal(t, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, createRequest.NewWorkflowSnapshot.ExecutionState.State)
+	require.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, createRequest.NewWorkflowSnapshot.ExecutionState.Status)
+	require.Len(t, createRequest.NewWorkflowEvents, 1)
+	terminatedEvents := createRequest.NewWorkflowEvents[0].Events
+	require.Len(t, terminatedEvents, 1)
+	require.Equal(t, int64(3), terminatedEvents[0].GetEventId())
+	require.Equal(t, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED, terminatedEvents[0].GetEventType())
+	require.True(t, hasDeleteHistoryEventTask(createRequest.NewWorkflowSnapshot.Tasks))
+}
+
+func TestInvoke_CleansUpOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := newTestShard(ctrl)
+
+	firstRun := newRunBundle(t, "first-run-id", 2)
+	secondRun := newRunBundle(t, "second-run-id", 3)
+
+	mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil, serviceerror.NewNotFound("not found"),
+	).Times(2)
+	mockShard.Resource.ExecutionMgr.EXPECT().AppendRawHistoryNodes(gomock.Any(), gomock.Any()).Return(
+		&persistence.AppendHistoryNodesResponse{}, nil,
+	).Times(4)
+	mockShard.Resource.ExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		tests.CreateWorkflowExecutionResponse, nil,
+	)
+	createErr := serviceerror.NewUnavailable("unavailable")
+	mockShard.Resource.ExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, createErr)
+
+	mockShard.Resource.ExecutionMgr.EXPECT().DeleteCurrentWorkflowExecution(gomock.Any(), &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     mockShard.GetShardID(),
+		NamespaceID: tests.NamespaceID.String(),
+		WorkflowID:  tests.WorkflowID,
+		RunID:       "second-run-id",
+	}).Return(nil)
+	var deletedRunIDs []string
+	mockShard.Resource.ExecutionMgr.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.DeleteWorkflowExecutionRequest) error {
+			deletedRunIDs = append(deletedRunIDs, request.RunID)
+			return nil
+		},
+	).Times(2)
+	mockShard.Resource.ExecutionMgr.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	_, err := Invoke(context.Background(), newImportRequest(firstRun, secondRun), mockShard)
+	require.ErrorIs(t, err, createErr)
+	require.Equal(t, []string{"first-run-id", "second-run-id"}, deletedRunIDs)
+}
+
+func TestInvoke_AlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := newTestShard(ctrl)
+	run := newRunBundle(t, tests.RunID, 2)
+	mockShard.Resource.ExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&persistence.GetWorkflowExecutionResponse{}, nil,
+	)
+
+	_, err := Invoke(context.Background(), newImportRequest(run), mockShard)
+	var alreadyExists *serviceerror.AlreadyExists
+	require.ErrorAs(t, err, &alreadyExists)
+}
+
+func TestInvoke_RejectsOffloadedPayloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := newTestShard(ctrl)
+	mockShard.SetPayloadStoreForTesting(payloadstore.NewMockStore(ctrl))
+	reference := &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte(payloadstore.ReferenceEncoding)},
+		Data:     []byte(tests.NamespaceID.String() + "/staged/key"),
+	}
+
+	run := newRunBundle(t, tests.RunID, 2)
+	run.MutableState.ExecutionInfo.Memo = map[string]*commonpb.Payload{"key": reference}
+	_, err := Invoke(context.Background(), newImportRequest(run), mockShard)
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+
+	run = newRunBundle(t, tests.RunID, 2)
+	blob, err := serialization.NewSerializer().SerializeEvents([]*historypb.HistoryEvent{{
+		EventId:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{reference}},
+			},
+		},
+	}}, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	run.HistoryBatches[0] = blob
+	_, err = Invoke(context.Background(), newImportRequest(run), mockShard)
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestValidateBundle(t *testing.T) {
+	run := newRunBundle(t, tests.RunID, 2)
+	newBundle := func() *adminservice.WorkflowExecutionBundle {
+		return &adminservice.WorkflowExecutionBundle{
+			Version:   common.WorkflowExecutionBundleVersion,
+			Execution: run.Execution,
+			Runs:      []*adminservice.WorkflowRunBundle{run},
+		}
+	}
+	require.NoError(t, validateBundle(newBundle()))
+
+	bundle := newBundle()
+	bundle.Version = common.WorkflowExecutionBundleVersion + 1
+	require.Error(t, validateBundle(bundle))
+
+	bundle = newBundle()
+	bundle.Runs = nil
+	require.Error(t, validateBundle(bundle))
+
+	bundle = newBundle()
+	bundle.Execution = &commonpb.WorkflowExecution{WorkflowId: "other-workflow-id"}
+	require.Error(t, validateBundle(bundle))
+
+	require.Error(t, validateBundle(nil))
+}
+
+func newTestShard(ctrl *gomock.Controller) *shard.ContextTest {
+	mockShard := shard.NewTestContext(
+		ctrl,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		tests.NewDynamicConfig(),
+	)
+	mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	mockShard.Resource.ExecutionMgr.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+
+	mockEngine := shard.NewMockEngine(ctrl)
+	mockEngine.EXPECT().NotifyNewTasks(gomock.Any()).AnyTimes()
+	mockShard.SetEngineForTesting(mockEngine)
+
+	mockShard.MockEventsCache.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key events.EventKey, _ int64, _ []byte) (*historypb.HistoryEvent, error) {
+			if key.EventID == common.FirstEventID {
+				return &historypb.HistoryEvent{
+					EventId:   key.EventID,
+					EventTime: timestamp.TimePtr(time.Now()),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+						WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{},
+					},
+				}, nil
+			}
+			return &historypb.HistoryEvent{
+				EventId:   key.EventID,
+				EventTime: timestamp.TimePtr(time.Now()),
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			}, nil
+		},
+	).AnyTimes()
+	mockShard.MockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	return mockShard
+}
+
+func newImportRequest(runs ...*adminservice.WorkflowRunBundle) *historyservice.ImportWorkflowExecutionRequest {
+	return &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Bundle: &adminservice.WorkflowExecutionBundle{
+			Version:   common.WorkflowExecutionBundleVersion,
+			Namespace: tests.Namespace.String(),
+			Execution: runs[len(runs)-1].Execution,
+			Runs:      runs,
+		},
+	}
+}
+
+func hasDeleteHistoryEventTask(categorizedTasks map[tasks.Category][]tasks.Task) bool {
+	for _, task := range categorizedTasks[tasks.CategoryTimer] {
+		if _, ok := task.(*tasks.DeleteHistoryEventTask); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// newRunBundle returns a run with two history batches, the second one ending at lastEventID
+func newRunBundle(t *testing.T, runID string, lastEventID int64) *adminservice.WorkflowRunBundle {
+	serializer := serialization.NewSerializer()
+	var historyBatches []*commonpb.DataBlob
+	for _, batch := range [][]int64{{1}, {2, lastEventID}} {
+		var historyEvents []*historypb.HistoryEvent
+		for eventID := batch[0]; eventID <= batch[len(batch)-1]; eventID++ {
+			historyEvents = append(historyEvents, &historypb.HistoryEvent{
+				EventId:   eventID,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			})
+		}
+		blob, err := serializer.SerializeEvents(historyEvents, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		historyBatches = append(historyBatches, blob)
+	}
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: tests.WorkflowID, RunId: runID}
+	return &adminservice.WorkflowRunBundle{
+		Execution:      execution,
+		HistoryBatches: historyBatches,
+		MutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId: "source-namespace-id",
+				WorkflowId:  tests.WorkflowID,
+				VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(
+					[]byte("source-branch-token"),
+					[]*historyspb.VersionHistoryItem{versionhistory.NewVersionHistoryItem(lastEventID, 0)},
+				)),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				RunId:  runID,
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			},
+			NextEventId: lastEventID + 1,
+		},
+	}
+}
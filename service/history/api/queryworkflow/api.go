@@ -245,9 +245,10 @@ func queryDirectlyThroughMatching(
 		shard.GetConfig().EnableStickyQuery(queryRequest.GetNamespace()) {
 
 		stickyMatchingRequest := &matchingservice.QueryWorkflowRequest{
-			NamespaceId:  namespaceID,
-			QueryRequest: queryRequest,
-			TaskQueue:    msResp.GetStickyTaskQueue(),
+			NamespaceId:       namespaceID,
+			QueryRequest:      queryRequest,
+			TaskQueue:         msResp.GetStickyTaskQueue(),
+			BuildIdAssignment: msResp.GetBuildIdAssignment(),
 		}
 
 		// using a clean new context in case customer provide a context which has
@@ -290,9 +291,10 @@ func queryDirectlyThroughMatching(
 	}
 
 	nonStickyMatchingRequest := &matchingservice.QueryWorkflowRequest{
-		NamespaceId:  namespaceID,
-		QueryRequest: queryRequest,
-		TaskQueue:    msResp.TaskQueue,
+		NamespaceId:       namespaceID,
+		QueryRequest:      queryRequest,
+		TaskQueue:         msResp.TaskQueue,
+		BuildIdAssignment: msResp.GetBuildIdAssignment(),
 	}
 
 	nonStickyStartTime := time.Now().UTC()
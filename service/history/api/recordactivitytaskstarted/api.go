@@ -67,6 +67,9 @@ func Invoke(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, consts.ErrWorkflowPaused
+			}
 
 			scheduledEventID := request.GetScheduledEventId()
 			requestID := request.GetRequestId()
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resetactivity
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+var (
+	errActivityStarted         = serviceerror.NewFailedPrecondition("Activity is already started.")
+	errActivityNotInRetryDelay = serviceerror.NewFailedPrecondition("Activity is not waiting for its next retry.")
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.ResetActivityRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.ResetActivityResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			req.WorkflowExecution.GetWorkflowId(),
+			req.WorkflowExecution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			activities, err := activitiesToReset(mutableState, req.GetActivityId(), shard.GetTimeSource().Now())
+			if err != nil {
+				return nil, err
+			}
+			resp = &historyservice.ResetActivityResponse{}
+			if len(activities) == 0 {
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
+			for _, ai := range activities {
+				if err := mutableState.ResetActivity(ai.ScheduledEventId, req.GetResetAttempts()); err != nil {
+					return nil, err
+				}
+				resp.ActivityIds = append(resp.ActivityIds, ai.ActivityId)
+			}
+			return api.UpdateWorkflowWithoutWorkflowTask, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// activitiesToReset returns the activity with the given activity ID, or all pending activities
+// waiting for their next retry if activity ID is not set, ordered by scheduled event ID.
+func activitiesToReset(
+	mutableState workflow.MutableState,
+	activityID string,
+	now time.Time,
+) ([]*persistencespb.ActivityInfo, error) {
+	if activityID != "" {
+		ai, ok := mutableState.GetActivityByActivityID(activityID)
+		if !ok {
+			return nil, consts.ErrActivityTaskNotFound
+		}
+		if ai.StartedEventId != common.EmptyEventID {
+			return nil, errActivityStarted
+		}
+		if !isInRetryDelay(ai, now) {
+			return nil, errActivityNotInRetryDelay
+		}
+		return []*persistencespb.ActivityInfo{ai}, nil
+	}
+
+	var activities []*persistencespb.ActivityInfo
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.StartedEventId == common.EmptyEventID && isInRetryDelay(ai, now) {
+			activities = append(activities, ai)
+		}
+	}
+	sort.Slice(activities, func(i, j int) bool {
+		return activities[i].ScheduledEventId < activities[j].ScheduledEventId
+	})
+	return activities, nil
+}
+
+func isInRetryDelay(
+	ai *persistencespb.ActivityInfo,
+	now time.Time,
+) bool {
+	return ai.Attempt > 1 && timestamp.TimeValue(ai.ScheduledTime).After(now)
+}
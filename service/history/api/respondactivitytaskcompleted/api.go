@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -43,6 +44,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskCompletedRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	matchingClient matchingservice.MatchingServiceClient,
 ) (resp *historyservice.RespondActivityTaskCompletedResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -127,5 +129,6 @@ func Invoke(
 			metrics.TaskQueueTag(taskQueue),
 		)
 	}
+	api.RecordActivityTaskClosed(ctx, shard, matchingClient, token, err)
 	return &historyservice.RespondActivityTaskCompletedResponse{}, err
 }
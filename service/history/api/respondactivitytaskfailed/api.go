@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -46,6 +47,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskFailedRequest,
 	shard shard.Context,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	matchingClient matchingservice.MatchingServiceClient,
 ) (resp *historyservice.RespondActivityTaskFailedResponse, retError error) {
 	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
 	if err != nil {
@@ -148,5 +150,6 @@ func Invoke(
 			metrics.TaskQueueTag(taskQueue),
 		)
 	}
+	api.RecordActivityTaskClosed(ctx, shard, matchingClient, token, err)
 	return &historyservice.RespondActivityTaskFailedResponse{}, err
 }
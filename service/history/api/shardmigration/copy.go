@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+)
+
+// Copy creates the mutable state of an execution copied out of a migrating source shard in the shard,
+// replacing the leftovers of a previous attempt, and regenerates its history tasks, as executions are
+// copied without them.
+func Copy(
+	ctx context.Context,
+	request *historyservice.CopyMigratedExecutionRequest,
+	shardContext shard.Context,
+	workflowCache wcache.Cache,
+) (_ *historyservice.CopyMigratedExecutionResponse, retError error) {
+	state := request.GetMutableState()
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+	if executionInfo == nil || executionState == nil {
+		return nil, serviceerror.NewInvalidArgument("MutableState is not set on request.")
+	}
+
+	weContext, release, err := workflowCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespace.ID(executionInfo.GetNamespaceId()),
+		commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		workflow.LockPriorityLow,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+	// the execution is replaced in persistence, so the cached mutable state of a previous attempt must not be used
+	weContext.Clear()
+
+	if err := createMigratedExecution(ctx, request, shardContext); err != nil {
+		return nil, err
+	}
+
+	mutableState, err := weContext.LoadMutableState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	taskRefresher := workflow.NewTaskRefresher(
+		shardContext,
+		shardContext.GetConfig(),
+		shardContext.GetNamespaceRegistry(),
+		shardContext.GetEventsCache(),
+		shardContext.GetLogger(),
+	)
+	if err := taskRefresher.RefreshTasks(ctx, mutableState); err != nil {
+		return nil, err
+	}
+	if err := shardContext.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: shardContext.GetShardID(),
+		// RangeID is set by shard
+		NamespaceID: executionInfo.GetNamespaceId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+		Tasks:       mutableState.PopTasks(),
+	}); err != nil {
+		return nil, err
+	}
+	return &historyservice.CopyMigratedExecutionResponse{}, nil
+}
+
+func createMigratedExecution(
+	ctx context.Context,
+	request *historyservice.CopyMigratedExecutionRequest,
+	shardContext shard.Context,
+) error {
+	state := request.GetMutableState()
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	shardID := shardContext.GetShardID()
+	executionManager := shardContext.GetExecutionManager()
+	if request.GetIsCurrent() {
+		if err := executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+			ShardID:     shardID,
+			NamespaceID: executionInfo.GetNamespaceId(),
+			WorkflowID:  executionInfo.GetWorkflowId(),
+			RunID:       executionState.GetRunId(),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: executionInfo.GetNamespaceId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	}); err != nil {
+		return err
+	}
+
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, requestID := range state.SignalRequestedIds {
+		signalRequestedIDs[requestID] = struct{}{}
+	}
+	createMode := persistence.CreateWorkflowModeBypassCurrent
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	if request.GetIsCurrent() {
+		createMode = persistence.CreateWorkflowModeBrandNew
+		updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+	}
+
+	if _, err := shardContext.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID: shardID,
+		Mode:    createMode,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       executionInfo,
+			ExecutionState:      executionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			UpdateInfos:         state.UpdateInfos,
+			Condition:           state.NextEventId,
+			DBRecordVersion:     request.GetDbRecordVersion(),
+			Checksum:            state.Checksum,
+		},
+	}); err != nil {
+		return err
+	}
+
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+	// buffered events can only be persisted by an update
+	_, err := shardContext.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID: shardID,
+		Mode:    updateMode,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     executionInfo,
+			ExecutionState:    executionState,
+			NextEventID:       state.NextEventId,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         state.NextEventId,
+			DBRecordVersion:   request.GetDbRecordVersion() + 1,
+			Checksum:          state.Checksum,
+		},
+	})
+	return err
+}
@@ -0,0 +1,358 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+	wcache "go.temporal.io/server/service/history/workflow/cache"
+)
+
+const (
+	defaultPageSize = 100
+)
+
+type (
+	executionMigrator struct {
+		shardContext     shard.Context
+		executionManager persistence.ExecutionManager
+		workflowCache    wcache.Cache
+		// true if history is keyed by shard ID, in which case it is copied to the target shard
+		copyHistory bool
+	}
+)
+
+// Migrate copies a page of executions of the shard, which are mapped to other shards under the
+// target shard count, into their target shards and removes them from the shard. Executions are
+// copied without their history tasks, which are regenerated by Copy on the target shards.
+//
+// History branches are copied and then deleted from the shard where history is keyed by shard ID,
+// which is the case for SQL stores. Cassandra stores history by tree and branch only, so the
+// branches are read by the target shards as they are.
+func Migrate(
+	ctx context.Context,
+	request *historyservice.MigrateHistoryShardRequest,
+	shardContext shard.Context,
+	workflowCache wcache.Cache,
+) (*historyservice.MigrateHistoryShardResponse, error) {
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	executionManager := shardContext.GetExecutionManager()
+	resp, err := executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardContext.GetShardID(),
+		PageSize:  pageSize,
+		PageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	migrator := &executionMigrator{
+		shardContext:     shardContext,
+		executionManager: executionManager,
+		workflowCache:    workflowCache,
+		copyHistory:      executionManager.GetName() != cassandra.CassandraPersistenceName,
+	}
+	var migratedCount int64
+	for _, state := range resp.States {
+		executionInfo := state.GetExecutionInfo()
+		targetShardID := common.WorkflowIDToHistoryShard(
+			executionInfo.GetNamespaceId(),
+			executionInfo.GetWorkflowId(),
+			request.GetTargetShardCount(),
+		)
+		if targetShardID == shardContext.GetShardID() {
+			continue
+		}
+
+		workflowKey := definition.NewWorkflowKey(
+			executionInfo.GetNamespaceId(),
+			executionInfo.GetWorkflowId(),
+			state.GetExecutionState().GetRunId(),
+		)
+		if err := migrator.migrate(ctx, workflowKey, targetShardID); err != nil {
+			return nil, err
+		}
+		migratedCount++
+	}
+
+	return &historyservice.MigrateHistoryShardResponse{
+		NextPageToken:          resp.PageToken,
+		MigratedExecutionCount: migratedCount,
+	}, nil
+}
+
+func (m *executionMigrator) migrate(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	targetShardID int32,
+) (retError error) {
+	weContext, release, err := m.workflowCache.GetOrCreateWorkflowExecution(
+		ctx,
+		namespace.ID(workflowKey.NamespaceID),
+		commonpb.WorkflowExecution{
+			WorkflowId: workflowKey.WorkflowID,
+			RunId:      workflowKey.RunID,
+		},
+		workflow.LockPriorityLow,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+	// cached mutable state must not be used once the execution is removed from this shard
+	defer weContext.Clear()
+
+	sourceShardID := m.shardContext.GetShardID()
+	resp, err := m.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     sourceShardID,
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		// execution is already migrated or deleted
+		return nil
+	default:
+		return err
+	}
+
+	isCurrent := false
+	currentResp, err := m.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     sourceShardID,
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+	})
+	switch err.(type) {
+	case nil:
+		isCurrent = currentResp.RunID == workflowKey.RunID
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+
+	versionHistories := resp.State.GetExecutionInfo().GetVersionHistories().GetHistories()
+	if m.copyHistory {
+		for _, versionHistory := range versionHistories {
+			if err := m.copyHistoryBranch(ctx, workflowKey, targetShardID, versionHistory); err != nil {
+				return err
+			}
+		}
+	}
+	if err := m.copyMutableState(ctx, targetShardID, resp, isCurrent); err != nil {
+		return err
+	}
+
+	if m.copyHistory {
+		// branches are deleted before the mutable state, so that a retry still finds them. A retry
+		// after they are deleted finds no nodes to copy, as they are all copied already.
+		for _, versionHistory := range versionHistories {
+			if err := m.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+				ShardID:     sourceShardID,
+				BranchToken: versionHistory.GetBranchToken(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if isCurrent {
+		if err := m.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+			ShardID:     sourceShardID,
+			NamespaceID: workflowKey.NamespaceID,
+			WorkflowID:  workflowKey.WorkflowID,
+			RunID:       workflowKey.RunID,
+		}); err != nil {
+			return err
+		}
+	}
+	return m.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     sourceShardID,
+		NamespaceID: workflowKey.NamespaceID,
+		WorkflowID:  workflowKey.WorkflowID,
+		RunID:       workflowKey.RunID,
+	})
+}
+
+// copyMutableState creates the mutable state in the target shard through the owner of the target shard,
+// so that the write is fenced by the range ID of the target shard.
+func (m *executionMigrator) copyMutableState(
+	ctx context.Context,
+	targetShardID int32,
+	resp *persistence.GetWorkflowExecutionResponse,
+	isCurrent bool,
+) error {
+	_, err := m.shardContext.GetHistoryClient().CopyMigratedExecution(ctx, &historyservice.CopyMigratedExecutionRequest{
+		ShardId:         targetShardID,
+		MutableState:    resp.State,
+		DbRecordVersion: resp.DBRecordVersion,
+		IsCurrent:       isCurrent,
+	})
+	return err
+}
+
+// copyHistoryBranch copies the history nodes of a branch, including the nodes of its ancestors,
+// into the target shard.
+func (m *executionMigrator) copyHistoryBranch(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	targetShardID int32,
+	versionHistory *historyspb.VersionHistory,
+) error {
+	branchToken := versionHistory.GetBranchToken()
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return err
+	}
+
+	branchUtil := m.executionManager.GetHistoryBranchUtil()
+	branch, err := branchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+
+	var prevTransactionID int64
+	for i, ancestor := range branch.Ancestors {
+		ancestorToken, err := branchUtil.UpdateHistoryBranchInfo(branchToken, &persistencespb.HistoryBranch{
+			TreeId:    branch.TreeId,
+			BranchId:  ancestor.BranchId,
+			Ancestors: branch.Ancestors[:i],
+		})
+		if err != nil {
+			return err
+		}
+		prevTransactionID, err = m.copyHistoryNodes(
+			ctx,
+			workflowKey,
+			targetShardID,
+			branchToken,
+			ancestorToken,
+			util.Max(ancestor.BeginNodeId, common.FirstEventID),
+			ancestor.EndNodeId,
+			prevTransactionID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = m.copyHistoryNodes(
+		ctx,
+		workflowKey,
+		targetShardID,
+		branchToken,
+		branchToken,
+		persistence.GetBeginNodeID(branch),
+		lastItem.GetEventId()+1,
+		prevTransactionID,
+	)
+	return err
+}
+
+// copyHistoryNodes copies the history nodes in [minNodeID, maxNodeID) read from sourceBranchToken
+// to targetBranchToken in the target shard, and returns the transaction ID of the last node.
+func (m *executionMigrator) copyHistoryNodes(
+	ctx context.Context,
+	workflowKey definition.WorkflowKey,
+	targetShardID int32,
+	sourceBranchToken []byte,
+	targetBranchToken []byte,
+	minNodeID int64,
+	maxNodeID int64,
+	prevTransactionID int64,
+) (int64, error) {
+	if minNodeID >= maxNodeID {
+		return prevTransactionID, nil
+	}
+
+	isNewBranch := true
+	var pageToken []byte
+	for {
+		// nodes are copied as they are stored, so that they keep their encoding
+		resp, err := m.executionManager.ReadRawHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       m.shardContext.GetShardID(),
+			BranchToken:   sourceBranchToken,
+			MinEventID:    minNodeID,
+			MaxEventID:    maxNodeID,
+			PageSize:      defaultPageSize,
+			NextPageToken: pageToken,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// nodes are deleted by a previous attempt once they are all copied
+			return prevTransactionID, nil
+		default:
+			return 0, err
+		}
+
+		for i, blob := range resp.HistoryEventBlobs {
+			transactionID := resp.TransactionIDs[i]
+			_, err := m.executionManager.AppendRawHistoryNodes(ctx, &persistence.AppendRawHistoryNodesRequest{
+				ShardID:           targetShardID,
+				IsNewBranch:       isNewBranch,
+				Info:              persistence.BuildHistoryGarbageCleanupInfo(workflowKey.NamespaceID, workflowKey.WorkflowID, workflowKey.RunID),
+				BranchToken:       targetBranchToken,
+				History:           blob,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     transactionID,
+				NodeID:            resp.NodeIDs[i],
+			})
+			switch err.(type) {
+			case nil, *persistence.ConditionFailedError:
+				// node is already copied by a previous attempt
+			default:
+				return 0, err
+			}
+			isNewBranch = false
+			prevTransactionID = transactionID
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return prevTransactionID, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
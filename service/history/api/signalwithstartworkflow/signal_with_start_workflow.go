@@ -158,6 +158,7 @@ func startAndSignalWorkflowActionFn(
 		currentWorkflowContext.GetWorkflowKey().WorkflowID,
 		newRunID,
 		workflowIDReusePolicy,
+		enumsspb.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED,
 	)
 	if err != nil {
 		return nil, nil, err
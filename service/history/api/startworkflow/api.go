@@ -34,6 +34,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -55,6 +56,7 @@ const (
 	eagerStartDeniedReasonDynamicConfigDisabled    eagerStartDeniedReason = "dynamic_config_disabled"
 	eagerStartDeniedReasonFirstWorkflowTaskBackoff eagerStartDeniedReason = "first_workflow_task_backoff"
 	eagerStartDeniedReasonTaskAlreadyDispatched    eagerStartDeniedReason = "task_already_dispatched"
+	eagerStartDeniedReasonWorkflowAlreadyRunning   eagerStartDeniedReason = "workflow_already_running"
 )
 
 // Starter starts a new workflow execution.
@@ -115,6 +117,10 @@ func (s *Starter) prepare(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = api.ValidateWorkflowIDConflictPolicy(s.request.GetWorkflowIdConflictPolicy(), request.GetWorkflowIdReusePolicy())
+	if err != nil {
+		return err
+	}
 
 	if request.RequestEagerExecution {
 		metricsHandler.Counter(metrics.WorkflowEagerExecutionCounter.GetMetricName()).Record(
@@ -271,6 +277,9 @@ func (s *Starter) handleConflict(
 	if err := s.verifyNamespaceActive(creationParams, currentWorkflowConditionFailed); err != nil {
 		return nil, err
 	}
+	if s.useExistingWorkflow(currentWorkflowConditionFailed) {
+		return s.respondWithExistingWorkflow(currentWorkflowConditionFailed.RunID), nil
+	}
 	response, err := s.applyWorkflowIDReusePolicy(ctx, currentWorkflowConditionFailed, creationParams)
 	if err != nil {
 		return nil, err
@@ -313,10 +322,40 @@ func (s *Starter) verifyNamespaceActive(creationParams *creationParams, currentW
 	return nil
 }
 
+// useExistingWorkflow returns true if the current execution is running and the start request's workflow ID conflict
+// policy is USE_EXISTING.
+func (s *Starter) useExistingWorkflow(
+	currentWorkflowConditionFailed *persistence.CurrentWorkflowConditionFailedError,
+) bool {
+	switch currentWorkflowConditionFailed.State {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		return api.ResolveWorkflowIDConflictPolicy(
+			s.request.GetWorkflowIdConflictPolicy(),
+			s.request.StartRequest.GetWorkflowIdReusePolicy(),
+		) == enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	default:
+		return false
+	}
+}
+
+// respondWithExistingWorkflow provides a response attaching to the running execution instead of starting a new one.
+// The running execution's workflow task cannot be handed out for eager execution.
+func (s *Starter) respondWithExistingWorkflow(
+	runID string,
+) *historyservice.StartWorkflowExecutionResponse {
+	if s.requestEagerStart() {
+		s.recordEagerDenied(eagerStartDeniedReasonWorkflowAlreadyRunning)
+	}
+	return &historyservice.StartWorkflowExecutionResponse{
+		RunId: runID,
+	}
+}
+
 // applyWorkflowIDReusePolicy applies the workflow ID reuse policy in case a workflow start requests fails with a
 // duplicate execution.
 // At the time of this writing, the only possible action here is to terminate the current execution in case the start
-// request's ID reuse policy is TERMINATE_IF_RUNNING.
+// request's ID conflict policy is TERMINATE_EXISTING or its ID reuse policy is TERMINATE_IF_RUNNING.
 // Returns non-nil response if an action was required and completed successfully resulting in a newly created execution.
 func (s *Starter) applyWorkflowIDReusePolicy(
 	ctx context.Context,
@@ -332,6 +371,7 @@ func (s *Starter) applyWorkflowIDReusePolicy(
 		workflowID,
 		creationParams.runID,
 		s.request.StartRequest.GetWorkflowIdReusePolicy(),
+		s.request.GetWorkflowIdConflictPolicy(),
 	)
 	if err != nil {
 		return nil, err
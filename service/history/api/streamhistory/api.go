@@ -0,0 +1,320 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package streamhistory
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/events"
+	"go.temporal.io/server/service/history/shard"
+)
+
+const (
+	// readHistoryPageSize is the number of event batches read from persistence at a time
+	readHistoryPageSize = 100
+)
+
+var (
+	errStreamMaxDurationReached = serviceerror.NewDeadlineExceeded("Workflow history stream reached its maximum duration, resume it from the last received next event ID.")
+)
+
+type (
+	historyStream struct {
+		server      historyservice.HistoryService_StreamWorkflowExecutionHistoryServer
+		shard       shard.Context
+		workflowKey definition.WorkflowKey
+		branchToken []byte
+		eventTypes  map[enumspb.EventType]struct{}
+		// events before firstEventID are read but not sent
+		firstEventID int64
+		// ID of the first event of the next batch to read, always at a batch boundary
+		nextEventID int64
+	}
+)
+
+func Invoke(
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	eventNotifier events.Notifier,
+) error {
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	err := api.ValidateNamespaceUUID(namespaceID)
+	if err != nil {
+		return err
+	}
+	namespaceEntry, err := shard.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+
+	// streams are closed after a maximum duration so that they do not pin resources of the host forever,
+	// the client resumes them from the last received event batch.
+	ctx, cancel := context.WithTimeout(
+		server.Context(),
+		shard.GetConfig().WorkflowHistoryStreamMaxDuration(namespaceEntry.Name().String()),
+	)
+	defer cancel()
+
+	runID := request.GetExecution().GetRunId()
+	if len(runID) == 0 {
+		runID, err = workflowConsistencyChecker.GetCurrentRunID(
+			ctx,
+			request.NamespaceId,
+			request.GetExecution().GetWorkflowId(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	workflowKey := definition.NewWorkflowKey(
+		request.NamespaceId,
+		request.GetExecution().GetWorkflowId(),
+		runID,
+	)
+
+	// watch before loading the mutable state, so that no event batch committed in between is missed
+	subscriberID, channel, err := eventNotifier.WatchHistoryEvent(workflowKey)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = eventNotifier.UnwatchHistoryEvent(workflowKey, subscriberID) }()
+
+	response, err := api.GetMutableState(ctx, workflowKey, workflowConsistencyChecker)
+	if err != nil {
+		return err
+	}
+
+	stream := newHistoryStream(request, server, shard, workflowKey, response.CurrentBranchToken)
+	if err := stream.seekFirstEvent(ctx, response.GetNextEventId(), response.GetLastFirstEventTxnId()); err != nil {
+		return convertStreamError(ctx, server, err)
+	}
+	longPollInterval := shard.GetConfig().LongPollExpirationInterval(namespaceEntry.Name().String())
+	for {
+		if !bytes.Equal(stream.branchToken, response.CurrentBranchToken) {
+			return serviceerrors.NewCurrentBranchChanged(response.CurrentBranchToken, stream.branchToken)
+		}
+		if err := stream.sendHistory(ctx, response.GetNextEventId()); err != nil {
+			return convertStreamError(ctx, server, err)
+		}
+		if response.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			return nil
+		}
+
+		// notifications only wake up the stream, the mutable state is reloaded in any case since
+		// a notification can be dropped if the stream is slow to consume them.
+		if err := waitForNotification(ctx, channel, longPollInterval); err != nil {
+			return convertStreamError(ctx, server, err)
+		}
+		response, err = api.GetMutableState(ctx, workflowKey, workflowConsistencyChecker)
+		if err != nil {
+			return convertStreamError(ctx, server, err)
+		}
+	}
+}
+
+func newHistoryStream(
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer,
+	shard shard.Context,
+	workflowKey definition.WorkflowKey,
+	branchToken []byte,
+) *historyStream {
+	var eventTypes map[enumspb.EventType]struct{}
+	if len(request.GetEventTypes()) > 0 {
+		eventTypes = make(map[enumspb.EventType]struct{}, len(request.GetEventTypes()))
+		for _, eventType := range request.GetEventTypes() {
+			eventTypes[eventType] = struct{}{}
+		}
+	}
+	return &historyStream{
+		server:       server,
+		shard:        shard,
+		workflowKey:  workflowKey,
+		branchToken:  branchToken,
+		eventTypes:   eventTypes,
+		firstEventID: util.Max(request.GetFirstEventId(), common.FirstEventID),
+		nextEventID:  common.FirstEventID,
+	}
+}
+
+// convertStreamError returns errStreamMaxDurationReached if the stream was closed because it reached
+// its maximum duration rather than because the client went away.
+func convertStreamError(
+	ctx context.Context,
+	server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer,
+	err error,
+) error {
+	if ctx.Err() != nil && server.Context().Err() == nil {
+		return errStreamMaxDurationReached
+	}
+	return err
+}
+
+func waitForNotification(
+	ctx context.Context,
+	channel chan *events.Notification,
+	longPollInterval time.Duration,
+) error {
+	timer := time.NewTimer(longPollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-channel:
+		return nil
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// seekFirstEvent moves the stream to the batch containing the requested first event ID, since history can
+// only be read starting at a batch boundary. The history is read backwards from its last batch, one page
+// of batches at a time, so only the events after the page containing the first event ID are read.
+func (s *historyStream) seekFirstEvent(
+	ctx context.Context,
+	nextEventID int64,
+	lastFirstTxnID int64,
+) error {
+	if s.firstEventID <= common.FirstEventID {
+		return nil
+	}
+	if s.firstEventID >= nextEventID {
+		// the batch containing the first event ID is not committed yet and starts at or after nextEventID
+		s.nextEventID = nextEventID
+		return nil
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := s.shard.GetExecutionManager().ReadHistoryBranchReverse(ctx, &persistence.ReadHistoryBranchReverseRequest{
+			ShardID:                s.shard.GetShardID(),
+			BranchToken:            s.branchToken,
+			MaxEventID:             nextEventID,
+			LastFirstTransactionID: lastFirstTxnID,
+			PageSize:               readHistoryPageSize,
+			NextPageToken:          pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		// pages contain whole batches, the last event of a reversed page is the first event of a batch
+		if len(resp.HistoryEvents) > 0 {
+			batchFirstEventID := resp.HistoryEvents[len(resp.HistoryEvents)-1].GetEventId()
+			if batchFirstEventID <= s.firstEventID {
+				s.nextEventID = batchFirstEventID
+				return nil
+			}
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// sendHistory sends the event batches committed before nextEventID which are not sent yet.
+func (s *historyStream) sendHistory(
+	ctx context.Context,
+	nextEventID int64,
+) error {
+	if s.nextEventID >= nextEventID {
+		return nil
+	}
+
+	minEventID := s.nextEventID
+	var pageToken []byte
+	for {
+		resp, err := s.shard.GetExecutionManager().ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       s.shard.GetShardID(),
+			BranchToken:   s.branchToken,
+			MinEventID:    minEventID,
+			MaxEventID:    nextEventID,
+			PageSize:      readHistoryPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, batch := range resp.History {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			batchNextEventID := batch.Events[len(batch.Events)-1].GetEventId() + 1
+			if historyEvents := s.filterEvents(batch.Events); len(historyEvents) > 0 {
+				if err := s.server.Send(&historyservice.StreamWorkflowExecutionHistoryResponse{
+					History:     &historypb.History{Events: historyEvents},
+					NextEventId: batchNextEventID,
+					RunId:       s.workflowKey.RunID,
+				}); err != nil {
+					return err
+				}
+			}
+			s.nextEventID = batchNextEventID
+		}
+
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (s *historyStream) filterEvents(
+	historyEvents []*historypb.HistoryEvent,
+) []*historypb.HistoryEvent {
+	var result []*historypb.HistoryEvent
+	for _, event := range historyEvents {
+		if event.GetEventId() < s.firstEventID {
+			continue
+		}
+		if s.eventTypes != nil {
+			if _, ok := s.eventTypes[event.GetEventType()]; !ok {
+				continue
+			}
+		}
+		result = append(result, event)
+	}
+	return result
+}
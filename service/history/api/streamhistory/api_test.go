@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package streamhistory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+func TestSendHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := shard.NewTestContext(
+		ctrl,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		tests.NewDynamicConfig(),
+	)
+	server := historyservicemock.NewMockHistoryService_StreamWorkflowExecutionHistoryServer(ctrl)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	branchToken := []byte("branch-token")
+
+	stream := newHistoryStream(
+		&historyservice.StreamWorkflowExecutionHistoryRequest{
+			FirstEventId: 3,
+			EventTypes: []enumspb.EventType{
+				enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+				enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			},
+		},
+		server,
+		mockShard,
+		workflowKey,
+		branchToken,
+	)
+
+	newEvent := func(eventID int64, eventType enumspb.EventType) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{EventId: eventID, EventType: eventType}
+	}
+	batch1 := &historypb.History{Events: []*historypb.HistoryEvent{
+		newEvent(1, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+		newEvent(2, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED),
+	}}
+	batch2 := &historypb.History{Events: []*historypb.HistoryEvent{
+		newEvent(3, enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED),
+	}}
+	batch3 := &historypb.History{Events: []*historypb.HistoryEvent{
+		newEvent(4, enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED),
+		newEvent(5, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED),
+		newEvent(6, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED),
+	}}
+	pageToken := []byte("page-token")
+
+	// without seeking history is read from the beginning, events before the first event ID and of other types are skipped
+	mockShard.Resource.ExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     mockShard.GetShardID(),
+		BranchToken: branchToken,
+		MinEventID:  1,
+		MaxEventID:  7,
+		PageSize:    readHistoryPageSize,
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*historypb.History{batch1, batch2},
+		NextPageToken: pageToken,
+	}, nil)
+	mockShard.Resource.ExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:       mockShard.GetShardID(),
+		BranchToken:   branchToken,
+		MinEventID:    1,
+		MaxEventID:    7,
+		PageSize:      readHistoryPageSize,
+		NextPageToken: pageToken,
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{batch3},
+	}, nil)
+	server.EXPECT().Send(&historyservice.StreamWorkflowExecutionHistoryResponse{
+		History:     &historypb.History{Events: batch3.Events[1:]},
+		NextEventId: 7,
+		RunId:       tests.RunID,
+	}).Return(nil)
+
+	err := stream.sendHistory(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), stream.nextEventID)
+
+	// nothing is read if there are no new events
+	err = stream.sendHistory(context.Background(), 7)
+	require.NoError(t, err)
+
+	// new events are read starting at the last batch boundary
+	batch4 := &historypb.History{Events: []*historypb.HistoryEvent{
+		newEvent(7, enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED),
+	}}
+	mockShard.Resource.ExecutionMgr.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+		ShardID:     mockShard.GetShardID(),
+		BranchToken: branchToken,
+		MinEventID:  7,
+		MaxEventID:  8,
+		PageSize:    readHistoryPageSize,
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{batch4},
+	}, nil)
+	server.EXPECT().Send(&historyservice.StreamWorkflowExecutionHistoryResponse{
+		History:     batch4,
+		NextEventId: 8,
+		RunId:       tests.RunID,
+	}).Return(nil)
+
+	err = stream.sendHistory(context.Background(), 8)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), stream.nextEventID)
+}
+
+func TestSeekFirstEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockShard := shard.NewTestContext(
+		ctrl,
+		&persistencespb.ShardInfo{ShardId: 1, RangeId: 1},
+		tests.NewDynamicConfig(),
+	)
+	server := historyservicemock.NewMockHistoryService_StreamWorkflowExecutionHistoryServer(ctrl)
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	branchToken := []byte("branch-token")
+	newStream := func(firstEventID int64) *historyStream {
+		return newHistoryStream(
+			&historyservice.StreamWorkflowExecutionHistoryRequest{FirstEventId: firstEventID},
+			server,
+			mockShard,
+			workflowKey,
+			branchToken,
+		)
+	}
+	newEvents := func(eventIDs ...int64) []*historypb.HistoryEvent {
+		var historyEvents []*historypb.HistoryEvent
+		for _, eventID := range eventIDs {
+			historyEvents = append(historyEvents, &historypb.HistoryEvent{EventId: eventID})
+		}
+		return historyEvents
+	}
+
+	// nothing is read if the stream starts at the beginning or after the last committed event
+	stream := newStream(0)
+	require.NoError(t, stream.seekFirstEvent(context.Background(), 20, 100))
+	require.Equal(t, int64(1), stream.nextEventID)
+	stream = newStream(25)
+	require.NoError(t, stream.seekFirstEvent(context.Background(), 20, 100))
+	require.Equal(t, int64(20), stream.nextEventID)
+
+	// history is read backwards until the page containing the first event ID
+	pageToken := []byte("page-token")
+	mockShard.Resource.ExecutionMgr.EXPECT().ReadHistoryBranchReverse(gomock.Any(), &persistence.ReadHistoryBranchReverseRequest{
+		ShardID:                mockShard.GetShardID(),
+		BranchToken:            branchToken,
+		MaxEventID:             20,
+		LastFirstTransactionID: 100,
+		PageSize:               readHistoryPageSize,
+	}).Return(&persistence.ReadHistoryBranchReverseResponse{
+		HistoryEvents: newEvents(19, 18, 17, 16, 15),
+		NextPageToken: pageToken,
+	}, nil)
+	mockShard.Resource.ExecutionMgr.EXPECT().ReadHistoryBranchReverse(gomock.Any(), &persistence.ReadHistoryBranchReverseRequest{
+		ShardID:                mockShard.GetShardID(),
+		BranchToken:            branchToken,
+		MaxEventID:             20,
+		LastFirstTransactionID: 100,
+		PageSize:               readHistoryPageSize,
+		NextPageToken:          pageToken,
+	}).Return(&persistence.ReadHistoryBranchReverseResponse{
+		HistoryEvents: newEvents(14, 13, 12, 11, 10, 9),
+		NextPageToken: []byte("next-page-token"),
+	}, nil)
+
+	stream = newStream(12)
+	require.NoError(t, stream.seekFirstEvent(context.Background(), 20, 100))
+	require.Equal(t, int64(9), stream.nextEventID)
+}
@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskdlq
+
+import (
+	"context"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func GetDLQ(
+	ctx context.Context,
+	request *historyservice.GetDLQMessagesRequest,
+	shard shard.Context,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
+) (*historyservice.GetDLQMessagesResponse, error) {
+	dlqTasks, token, err := readShardTasks(
+		ctx,
+		historyTaskDLQ,
+		shard.GetShardID(),
+		request.GetInclusiveEndMessageId(),
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tasksInfo := make([]*historyspb.HistoryDLQTaskInfo, 0, len(dlqTasks))
+	for _, dlqTask := range dlqTasks {
+		tasksInfo = append(tasksInfo, toTaskInfo(dlqTask))
+	}
+	return &historyservice.GetDLQMessagesResponse{
+		Type:          request.GetType(),
+		HistoryTasks:  tasksInfo,
+		NextPageToken: token,
+	}, nil
+}
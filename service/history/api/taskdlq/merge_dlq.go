@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskdlq
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// MergeDLQ re-enqueues the tasks in the DLQ to their original queue, new task IDs are
+// allocated for them. The tasks of a page are removed from the DLQ only after all of them are re-enqueued.
+func MergeDLQ(
+	ctx context.Context,
+	request *historyservice.MergeDLQMessagesRequest,
+	shard shard.Context,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
+) (*historyservice.MergeDLQMessagesResponse, error) {
+	dlqTasks, token, err := readShardTasks(
+		ctx,
+		historyTaskDLQ,
+		shard.GetShardID(),
+		request.GetInclusiveEndMessageId(),
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dlqTask := range dlqTasks {
+		task := dlqTask.Task
+		if err := shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+			ShardID:     shard.GetShardID(),
+			NamespaceID: task.GetNamespaceID(),
+			WorkflowID:  task.GetWorkflowID(),
+			RunID:       task.GetRunID(),
+			Tasks: map[tasks.Category][]tasks.Task{
+				task.GetCategory(): {task},
+			},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(dlqTasks) > 0 {
+		// the pages are read in message ID order from the start of the DLQ, so every task up to the last one
+		// of this page was re-enqueued, either now or by a previous call.
+		lastMessageID := dlqTasks[len(dlqTasks)-1].MessageID
+		if err := historyTaskDLQ.RangeDeleteTasks(ctx, shard.GetShardID(), lastMessageID); err != nil {
+			return nil, err
+		}
+	}
+	return &historyservice.MergeDLQMessagesResponse{
+		NextPageToken: token,
+	}, nil
+}
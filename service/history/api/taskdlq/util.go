@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package taskdlq
+
+import (
+	"context"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// readShardTasks reads one page of the tasks moved to the history task DLQ from the given shard.
+func readShardTasks(
+	ctx context.Context,
+	dlq persistence.HistoryTaskDLQ,
+	shardID int32,
+	inclusiveEndMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.HistoryDLQTask, []byte, error) {
+	if inclusiveEndMessageID <= 0 {
+		inclusiveEndMessageID = common.EndMessageID
+	}
+	if pageSize <= 0 {
+		pageSize = common.ReadDLQMessagesPageSize
+	}
+	resp, err := dlq.ReadTasks(ctx, &persistence.ReadHistoryTaskDLQRequest{
+		ShardID:        shardID,
+		FirstMessageID: persistence.EmptyQueueMessageID,
+		LastMessageID:  inclusiveEndMessageID,
+		PageSize:       pageSize,
+		NextPageToken:  pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Tasks, resp.NextPageToken, nil
+}
+
+func toTaskInfo(
+	dlqTask *persistence.HistoryDLQTask,
+) *historyspb.HistoryDLQTaskInfo {
+	task := dlqTask.Task
+	category := task.GetCategory()
+	return &historyspb.HistoryDLQTaskInfo{
+		MessageId:      dlqTask.MessageID,
+		ShardId:        dlqTask.ShardID,
+		CategoryId:     category.ID(),
+		TaskType:       task.GetType(),
+		NamespaceId:    task.GetNamespaceID(),
+		WorkflowId:     task.GetWorkflowID(),
+		RunId:          task.GetRunID(),
+		TaskId:         task.GetTaskID(),
+		VisibilityTime: timestamp.TimePtr(task.GetVisibilityTime()),
+		Attempt:        dlqTask.Attempt,
+		LastError:      dlqTask.LastError,
+		EnqueueTime:    timestamp.TimePtr(dlqTask.EnqueueTime),
+	}
+}
@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package updateactivityoptions
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func Invoke(
+	ctx context.Context,
+	req *historyservice.UpdateActivityOptionsRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (resp *historyservice.UpdateActivityOptionsResponse, retError error) {
+	namespaceEntry, err := api.GetActiveNamespace(shard, namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOptions(req, namespaceEntry.Name(), shard); err != nil {
+		return nil, err
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			namespaceEntry.ID().String(),
+			req.WorkflowExecution.GetWorkflowId(),
+			req.WorkflowExecution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(req.GetActivityId())
+			if !ok {
+				return nil, consts.ErrActivityTaskNotFound
+			}
+
+			options, err := mutableState.UpdateActivityOptions(ctx, ai.ScheduledEventId, req.GetOptions())
+			if err != nil {
+				return nil, err
+			}
+			resp = &historyservice.UpdateActivityOptionsResponse{
+				Options: options,
+			}
+			return api.UpdateWorkflowWithoutWorkflowTask, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func validateOptions(
+	req *historyservice.UpdateActivityOptionsRequest,
+	namespaceName namespace.Name,
+	shard shard.Context,
+) error {
+	if req.GetActivityId() == "" {
+		return serviceerror.NewInvalidArgument("ActivityId is not set on request.")
+	}
+	options := req.GetOptions()
+	if options == nil {
+		return serviceerror.NewInvalidArgument("Options is not set on request.")
+	}
+	// timeouts can only be validated once merged with the current options of the activity,
+	// they are validated and deduced the same way as the timeouts of a newly scheduled activity,
+	// see workflow.NormalizeActivityTimeouts
+	if len(options.GetTaskQueue()) > shard.GetConfig().MaxIDLengthLimit() {
+		return serviceerror.NewInvalidArgument("TaskQueue exceeds length limit.")
+	}
+	if options.RetryPolicy != nil {
+		defaultActivityRetrySettings := common.FromConfigToDefaultRetrySettings(
+			shard.GetConfig().DefaultActivityRetryPolicy(namespaceName.String()),
+		)
+		common.EnsureRetryPolicyDefaults(options.RetryPolicy, defaultActivityRetrySettings)
+		if err := common.ValidateRetryPolicy(options.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -116,7 +116,8 @@ func Invoke(
 	// If WT is scheduled, but not started, updates will be attached to it, when WT is started.
 	// If WT has already started, new speculative WT will be created when started WT completes.
 	// If update is duplicate, then WT for this update was already created.
-	createNewWorkflowTask := !ms.HasPendingWorkflowTask() && !alreadyExisted
+	// If workflow execution is paused, WT for this update will be created when workflow execution is unpaused.
+	createNewWorkflowTask := !ms.HasPendingWorkflowTask() && !alreadyExisted && !ms.IsWorkflowExecutionPaused()
 
 	if createNewWorkflowTask {
 		// This will try not to add an event but will create speculative WT in mutable state.
@@ -170,6 +171,10 @@ func addWorkflowTaskToMatching(
 	nsID namespace.ID,
 ) error {
 	_, scheduleToStartTimeout := ms.TaskQueueScheduleToStartTimeout(wt.TaskQueue.Name)
+	var normalTaskQueueName string
+	if wt.TaskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		normalTaskQueueName = ms.GetExecutionInfo().TaskQueue
+	}
 	wfKey := ms.GetWorkflowKey()
 	clock, err := shardCtx.NewVectorClock()
 	if err != nil {
@@ -186,6 +191,11 @@ func addWorkflowTaskToMatching(
 		ScheduledEventId:       wt.ScheduledEventID,
 		ScheduleToStartTimeout: scheduleToStartTimeout,
 		Clock:                  clock,
+		Priority:               workflow.TaskPriority(shardCtx.GetConfig(), ms),
+		FairnessKey:            workflow.TaskFairnessKey(shardCtx.GetConfig(), ms),
+		WorkflowTypeName:       ms.GetExecutionInfo().WorkflowTypeName,
+		NormalTaskQueue:        normalTaskQueueName,
+		BuildIdAssignment:      ms.GetExecutionInfo().GetBuildIdAssignment(),
 	})
 	if err != nil {
 		return err
@@ -49,6 +49,7 @@ func ApplyWorkflowIDReusePolicy(
 	workflowID string,
 	runID string,
 	wfIDReusePolicy enumspb.WorkflowIdReusePolicy,
+	wfIDConflictPolicy enumsspb.WorkflowIdConflictPolicy,
 ) (UpdateWorkflowActionFunc, error) {
 
 	// here we know there is some information about the prev workflow, i.e. either running right now
@@ -56,7 +57,7 @@ func ApplyWorkflowIDReusePolicy(
 	switch prevState {
 	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
 		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
-		if wfIDReusePolicy == enumspb.WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+		if ResolveWorkflowIDConflictPolicy(wfIDConflictPolicy, wfIDReusePolicy) == enumsspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING {
 			return func(workflowContext WorkflowContext) (*UpdateWorkflowAction, error) {
 				mutableState := workflowContext.GetMutableState()
 				if !mutableState.IsWorkflowExecutionRunning() {
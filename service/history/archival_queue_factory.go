@@ -164,6 +164,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		rescheduler,
 		f.HostPriorityAssigner,
 		executor,
+		newQueueDLQWriter(shard, f.HistoryTaskDLQ, f.Config),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.ArchivalTaskBatchSize,
@@ -519,6 +519,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				namespaceRegistry,
 				mockMetadata,
 				nil,
+				nil,
 				metrics.NoopMetricsHandler,
 			)
 			err := executable.Execute()
@@ -35,6 +35,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
@@ -308,55 +309,19 @@ func (v *commandAttrValidator) validateActivityScheduleAttributes(
 		return failedCause, serviceerror.NewInvalidArgument("ActivityType exceeds length limit.")
 	}
 
-	// Only attempt to deduce and fill in unspecified timeouts only when all timeouts are non-negative.
-	if timestamp.DurationValue(attributes.GetScheduleToCloseTimeout()) < 0 || timestamp.DurationValue(attributes.GetScheduleToStartTimeout()) < 0 ||
-		timestamp.DurationValue(attributes.GetStartToCloseTimeout()) < 0 || timestamp.DurationValue(attributes.GetHeartbeatTimeout()) < 0 {
-		return failedCause, serviceerror.NewInvalidArgument("A valid timeout may not be negative.")
+	timeouts := &historyspb.ActivityOptions{
+		ScheduleToCloseTimeout: attributes.GetScheduleToCloseTimeout(),
+		ScheduleToStartTimeout: attributes.GetScheduleToStartTimeout(),
+		StartToCloseTimeout:    attributes.GetStartToCloseTimeout(),
+		HeartbeatTimeout:       attributes.GetHeartbeatTimeout(),
 	}
-
-	validScheduleToClose := timestamp.DurationValue(attributes.GetScheduleToCloseTimeout()) > 0
-	validScheduleToStart := timestamp.DurationValue(attributes.GetScheduleToStartTimeout()) > 0
-	validStartToClose := timestamp.DurationValue(attributes.GetStartToCloseTimeout()) > 0
-
-	if validScheduleToClose {
-		if validScheduleToStart {
-			attributes.ScheduleToStartTimeout = timestamp.MinDurationPtr(attributes.GetScheduleToStartTimeout(),
-				attributes.GetScheduleToCloseTimeout())
-		} else {
-			attributes.ScheduleToStartTimeout = attributes.GetScheduleToCloseTimeout()
-		}
-		if validStartToClose {
-			attributes.StartToCloseTimeout = timestamp.MinDurationPtr(attributes.GetStartToCloseTimeout(),
-				attributes.GetScheduleToCloseTimeout())
-		} else {
-			attributes.StartToCloseTimeout = attributes.GetScheduleToCloseTimeout()
-		}
-	} else if validStartToClose {
-		// We are in !validScheduleToClose due to the first if above
-		attributes.ScheduleToCloseTimeout = &runTimeout
-		if !validScheduleToStart {
-			attributes.ScheduleToStartTimeout = &runTimeout
-		}
-	} else {
-		// Deduction failed as there's not enough information to fill in missing timeouts.
-		return failedCause, serviceerror.NewInvalidArgument("A valid StartToClose or ScheduleToCloseTimeout is not set on command.")
-	}
-	// ensure activity timeout never larger than workflow timeout
-	if runTimeout > 0 {
-		if timestamp.DurationValue(attributes.GetScheduleToCloseTimeout()) > runTimeout {
-			attributes.ScheduleToCloseTimeout = &runTimeout
-		}
-		if timestamp.DurationValue(attributes.GetScheduleToStartTimeout()) > runTimeout {
-			attributes.ScheduleToStartTimeout = &runTimeout
-		}
-		if timestamp.DurationValue(attributes.GetStartToCloseTimeout()) > runTimeout {
-			attributes.StartToCloseTimeout = &runTimeout
-		}
-		if timestamp.DurationValue(attributes.GetHeartbeatTimeout()) > runTimeout {
-			attributes.HeartbeatTimeout = &runTimeout
-		}
+	if err := workflow.NormalizeActivityTimeouts(timeouts, runTimeout); err != nil {
+		return failedCause, err
 	}
-	attributes.HeartbeatTimeout = timestamp.MinDurationPtr(attributes.GetHeartbeatTimeout(), attributes.GetStartToCloseTimeout())
+	attributes.ScheduleToCloseTimeout = timeouts.ScheduleToCloseTimeout
+	attributes.ScheduleToStartTimeout = timeouts.ScheduleToStartTimeout
+	attributes.StartToCloseTimeout = timeouts.StartToCloseTimeout
+	attributes.HeartbeatTimeout = timeouts.HeartbeatTimeout
 
 	return enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, nil
 }
@@ -426,6 +391,10 @@ func (v *commandAttrValidator) validateRecordMarkerAttributes(
 	if len(attributes.GetMarkerName()) > v.maxIDLengthLimit {
 		return failedCause, serviceerror.NewInvalidArgument("MarkerName exceeds length limit.")
 	}
+	switch attributes.GetMarkerName() {
+	case common.WorkflowPausedMarkerName, common.WorkflowUnpausedMarkerName:
+		return failedCause, serviceerror.NewInvalidArgument(fmt.Sprintf("MarkerName %v is reserved.", attributes.GetMarkerName()))
+	}
 
 	return enumspb.WORKFLOW_TASK_FAILED_CAUSE_UNSPECIFIED, nil
 }
@@ -0,0 +1,279 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/completioncallback"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	completionCallbackInitialBackoff = time.Second
+	completionCallbackMaxBackoff     = 10 * time.Minute
+
+	completionCallbackDispatcherQueueSize = 1000
+	// completionCallbackDeliveryGracePeriodMultiplier times the callback timeout is how long a delivery handed to
+	// the dispatcher may take before it's considered lost, e.g. because the host shut down, and is rescheduled.
+	completionCallbackDeliveryGracePeriodMultiplier = 2
+)
+
+type (
+	completionCallbackBody struct {
+		Namespace       string          `json:"namespace"`
+		WorkflowID      string          `json:"workflowId"`
+		RunID           string          `json:"runId"`
+		Status          string          `json:"status"`
+		CloseTime       time.Time       `json:"closeTime"`
+		CompletionEvent json.RawMessage `json:"completionEvent,omitempty"`
+	}
+
+	completionCallbackRequest struct {
+		namespaceName string
+		callback      *workflowspb.CompletionCallback
+		attempt       int32
+		body          []byte
+	}
+
+	completionCallbackError struct {
+		retryable bool
+		message   string
+	}
+
+	// completionCallbackDeliveryTask delivers a completion callback on the completion callback dispatcher,
+	// outside of the transfer queue processor, and records the result of the attempt.
+	completionCallbackDeliveryTask struct {
+		executor *transferQueueActiveTaskExecutor
+		task     *tasks.CompletionCallbackTask
+		request  *completionCallbackRequest
+		state    int32
+	}
+)
+
+var (
+	errCompletionCallbacksDisabled = errors.New("completion callbacks are disabled on the namespace")
+)
+
+var (
+	completionCallbackRetryPolicy = backoff.NewExponentialRetryPolicy(completionCallbackInitialBackoff).
+		WithBackoffCoefficient(2).
+		WithMaximumInterval(completionCallbackMaxBackoff).
+		WithExpirationInterval(backoff.NoInterval)
+)
+
+var (
+	// delivery attempts are retried durably through completion callback backoff tasks
+	completionCallbackDeliveryRetryPolicy = backoff.NewExponentialRetryPolicy(completionCallbackInitialBackoff).
+		WithMaximumAttempts(1)
+)
+
+var _ ctasks.Task = (*completionCallbackDeliveryTask)(nil)
+
+func (e *completionCallbackError) Error() string {
+	return e.message
+}
+
+func newCompletionCallbackBody(
+	namespaceName string,
+	workflowKey definition.WorkflowKey,
+	status enumspb.WorkflowExecutionStatus,
+	closeTime time.Time,
+	completionEvent *historypb.HistoryEvent,
+) ([]byte, error) {
+	body := completionCallbackBody{
+		Namespace:  namespaceName,
+		WorkflowID: workflowKey.WorkflowID,
+		RunID:      workflowKey.RunID,
+		Status:     status.String(),
+		CloseTime:  closeTime,
+	}
+	if completionEvent != nil {
+		event, err := codec.NewJSONPBEncoder().Encode(completionEvent)
+		if err != nil {
+			return nil, err
+		}
+		body.CompletionEvent = event
+	}
+	return json.Marshal(body)
+}
+
+func signCompletionCallbackBody(
+	signingKey string,
+	body []byte,
+) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliverCompletionCallback POSTs the body to the callback URL. The returned error is a
+// *completionCallbackError which tells whether the delivery is worth retrying.
+func deliverCompletionCallback(
+	ctx context.Context,
+	client *http.Client,
+	callback *workflowspb.CompletionCallback,
+	body []byte,
+	signingKey string,
+) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		return &completionCallbackError{retryable: false, message: err.Error()}
+	}
+	for key, value := range callback.GetHeader() {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if signingKey != "" {
+		request.Header.Set(completioncallback.SignatureHeaderName, signCompletionCallbackBody(signingKey, body))
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return &completionCallbackError{
+			retryable: !errors.Is(err, completioncallback.ErrAddressNotAllowed),
+			message:   err.Error(),
+		}
+	}
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+	return &completionCallbackError{
+		retryable: isRetryableCompletionCallbackStatus(response.StatusCode),
+		message:   fmt.Sprintf("completion callback request failed with status: %v", response.Status),
+	}
+}
+
+func isRetryableCompletionCallbackStatus(
+	statusCode int,
+) bool {
+	return statusCode >= 500 || statusCode == http.StatusTooManyRequests || statusCode == http.StatusRequestTimeout
+}
+
+func completionCallbackBackoff(
+	attempt int32,
+) time.Duration {
+	return completionCallbackRetryPolicy.ComputeNextDelay(0, int(attempt))
+}
+
+func newCompletionCallbackDispatcher(
+	config *configs.Config,
+	logger log.Logger,
+) ctasks.Scheduler[*completionCallbackDeliveryTask] {
+	return ctasks.NewFIFOScheduler[*completionCallbackDeliveryTask](
+		&ctasks.FIFOSchedulerOptions{
+			QueueSize:   completionCallbackDispatcherQueueSize,
+			WorkerCount: config.CompletionCallbackDeliveryWorkerCount,
+		},
+		log.With(logger, tag.ComponentCallbackDispatcher),
+	)
+}
+
+func newCompletionCallbackDeliveryTask(
+	executor *transferQueueActiveTaskExecutor,
+	task *tasks.CompletionCallbackTask,
+	request *completionCallbackRequest,
+) *completionCallbackDeliveryTask {
+	return &completionCallbackDeliveryTask{
+		executor: executor,
+		task:     task,
+		request:  request,
+		state:    int32(ctasks.TaskStatePending),
+	}
+}
+
+func (d *completionCallbackDeliveryTask) Execute() error {
+	ctx := headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo(d.request.namespaceName))
+	return d.executor.deliverCompletionCallback(ctx, d.task, d.request)
+}
+
+func (d *completionCallbackDeliveryTask) HandleErr(err error) error {
+	return err
+}
+
+func (d *completionCallbackDeliveryTask) IsRetryableError(_ error) bool {
+	return false
+}
+
+func (d *completionCallbackDeliveryTask) RetryPolicy() backoff.RetryPolicy {
+	return completionCallbackDeliveryRetryPolicy
+}
+
+func (d *completionCallbackDeliveryTask) Abort() {
+	atomic.StoreInt32(&d.state, int32(ctasks.TaskStateAborted))
+}
+
+func (d *completionCallbackDeliveryTask) Cancel() {
+	atomic.StoreInt32(&d.state, int32(ctasks.TaskStateCancelled))
+}
+
+func (d *completionCallbackDeliveryTask) Ack() {
+	atomic.StoreInt32(&d.state, int32(ctasks.TaskStateAcked))
+}
+
+func (d *completionCallbackDeliveryTask) Nack(err error) {
+	atomic.StoreInt32(&d.state, int32(ctasks.TaskStateNacked))
+	d.executor.logger.Warn("Failed to record completion callback attempt, delivery will be rescheduled",
+		tag.WorkflowNamespaceID(d.task.GetNamespaceID()),
+		tag.WorkflowID(d.task.GetWorkflowID()),
+		tag.WorkflowRunID(d.task.GetRunID()),
+		tag.Error(err),
+	)
+}
+
+func (d *completionCallbackDeliveryTask) Reschedule() {
+	atomic.StoreInt32(&d.state, int32(ctasks.TaskStatePending))
+}
+
+func (d *completionCallbackDeliveryTask) State() ctasks.State {
+	return ctasks.State(atomic.LoadInt32(&d.state))
+}
@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+	"go.temporal.io/server/common/completioncallback"
+	"go.temporal.io/server/common/definition"
+)
+
+func TestNewCompletionCallbackBody(t *testing.T) {
+	closeTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	body, err := newCompletionCallbackBody(
+		"test-namespace",
+		definition.NewWorkflowKey("namespace-id", "workflow-id", "run-id"),
+		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		closeTime,
+		&historypb.HistoryEvent{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+		},
+	)
+	require.NoError(t, err)
+
+	var decoded completionCallbackBody
+	require.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, "test-namespace", decoded.Namespace)
+	assert.Equal(t, "workflow-id", decoded.WorkflowID)
+	assert.Equal(t, "run-id", decoded.RunID)
+	assert.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED.String(), decoded.Status)
+	assert.True(t, closeTime.Equal(decoded.CloseTime))
+	assert.Contains(t, string(decoded.CompletionEvent), `"eventType":"WorkflowExecutionCompleted"`)
+}
+
+func TestDeliverCompletionCallback(t *testing.T) {
+	body := []byte(`{"workflowId":"workflow-id"}`)
+	signingKey := "signing-key"
+
+	testCases := []struct {
+		name       string
+		statusCode int
+		success    bool
+		retryable  bool
+	}{
+		{name: "success", statusCode: http.StatusOK, success: true},
+		{name: "accepted", statusCode: http.StatusAccepted, success: true},
+		{name: "server error", statusCode: http.StatusServiceUnavailable, retryable: true},
+		{name: "throttled", statusCode: http.StatusTooManyRequests, retryable: true},
+		{name: "bad request", statusCode: http.StatusBadRequest, retryable: false},
+		{name: "not found", statusCode: http.StatusNotFound, retryable: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, body, received)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "token", r.Header.Get("Authorization"))
+				assert.Equal(t, signCompletionCallbackBody(signingKey, body), r.Header.Get(completioncallback.SignatureHeaderName))
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			err := deliverCompletionCallback(
+				context.Background(),
+				server.Client(),
+				&workflowspb.CompletionCallback{
+					Url:    server.URL,
+					Header: map[string]string{"Authorization": "token"},
+				},
+				body,
+				signingKey,
+			)
+			if tc.success {
+				require.NoError(t, err)
+				return
+			}
+			var callbackErr *completionCallbackError
+			require.ErrorAs(t, err, &callbackErr)
+			assert.Equal(t, tc.retryable, callbackErr.retryable)
+		})
+	}
+}
+
+func TestDeliverCompletionCallback_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := deliverCompletionCallback(
+		context.Background(),
+		http.DefaultClient,
+		&workflowspb.CompletionCallback{Url: url},
+		nil,
+		"",
+	)
+	var callbackErr *completionCallbackError
+	require.ErrorAs(t, err, &callbackErr)
+	assert.True(t, callbackErr.retryable)
+}
+
+func TestCompletionCallbackBackoff(t *testing.T) {
+	assert.LessOrEqual(t, completionCallbackBackoff(1), completionCallbackInitialBackoff)
+	assert.LessOrEqual(t, completionCallbackBackoff(100), completionCallbackMaxBackoff)
+	assert.Greater(t, completionCallbackBackoff(100), completionCallbackInitialBackoff)
+}
@@ -63,9 +63,11 @@ type Config struct {
 
 	// HistoryCache settings
 	// Change of these configs require shard restart
-	HistoryCacheInitialSize dynamicconfig.IntPropertyFn
-	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
-	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
+	HistoryCacheInitialSize    dynamicconfig.IntPropertyFn
+	HistoryCacheMaxSize        dynamicconfig.IntPropertyFn
+	HistoryCacheTTL            dynamicconfig.DurationPropertyFn
+	HistoryCacheLimitSizeBased dynamicconfig.BoolPropertyFn
+	HistoryCacheMaxSizeBytes   dynamicconfig.IntPropertyFn
 
 	// EventsCache settings
 	// Change of these configs require shard restart
@@ -89,6 +91,17 @@ type Config struct {
 	QueuePendingTaskMaxCount         dynamicconfig.IntPropertyFn
 	QueueMaxReaderCount              dynamicconfig.IntPropertyFn
 
+	TaskDLQEnabled     dynamicconfig.BoolPropertyFn
+	TaskDLQMaxAttempts dynamicconfig.IntPropertyFn
+	TaskDLQMaxTaskAge  dynamicconfig.DurationPropertyFn
+
+	EnableCompletionCallbacks             dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	CompletionCallbackAllowedHosts        dynamicconfig.StringPropertyFnWithNamespaceFilter
+	CompletionCallbackTimeout             dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	CompletionCallbackMaxAttempts         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	CompletionCallbackSigningKey          dynamicconfig.StringPropertyFnWithNamespaceFilter
+	CompletionCallbackDeliveryWorkerCount dynamicconfig.IntPropertyFn
+
 	TaskSchedulerEnableRateLimiter           dynamicconfig.BoolPropertyFn
 	TaskSchedulerEnableRateLimiterShadowMode dynamicconfig.BoolPropertyFn
 	TaskSchedulerThrottleDuration            dynamicconfig.DurationPropertyFn
@@ -162,9 +175,21 @@ type Config struct {
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
 	LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// Maximum duration of a workflow history stream, the client resumes the stream afterwards
+	WorkflowHistoryStreamMaxDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// Maximum number of concurrent workflow history streams served by a host
+	MaxWorkflowHistoryStreams dynamicconfig.IntPropertyFn
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// search attribute holding the priority of workflow and activity tasks
+	TaskPrioritySearchAttribute dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// header field holding the priority of workflow and activity tasks
+	TaskPriorityHeader dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// search attribute holding the fairness key of workflow and activity tasks
+	TaskFairnessKeySearchAttribute dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// whether or not activity retries are delayed by matching instead of history timers
+	EnableActivityRetryDelayInMatching dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -341,6 +366,8 @@ func NewConfig(
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		HistoryCacheLimitSizeBased:           dc.GetBoolProperty(dynamicconfig.HistoryCacheLimitSizeBased, false),
+		HistoryCacheMaxSizeBytes:             dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSizeBytes, 4*1024*1024),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
@@ -357,6 +384,17 @@ func NewConfig(
 		QueuePendingTaskMaxCount:         dc.GetIntProperty(dynamicconfig.QueuePendingTaskMaxCount, 10000),
 		QueueMaxReaderCount:              dc.GetIntProperty(dynamicconfig.QueueMaxReaderCount, 2),
 
+		TaskDLQEnabled:     dc.GetBoolProperty(dynamicconfig.TaskDLQEnabled, false),
+		TaskDLQMaxAttempts: dc.GetIntProperty(dynamicconfig.TaskDLQMaxAttempts, 100),
+		TaskDLQMaxTaskAge:  dc.GetDurationProperty(dynamicconfig.TaskDLQMaxTaskAge, 0),
+
+		EnableCompletionCallbacks:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableCompletionCallbacks, false),
+		CompletionCallbackAllowedHosts:        dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.CompletionCallbackAllowedHosts, ""),
+		CompletionCallbackTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.CompletionCallbackTimeout, 10*time.Second),
+		CompletionCallbackMaxAttempts:         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.CompletionCallbackMaxAttempts, 10),
+		CompletionCallbackSigningKey:          dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.CompletionCallbackSigningKey, ""),
+		CompletionCallbackDeliveryWorkerCount: dc.GetIntProperty(dynamicconfig.CompletionCallbackDeliveryWorkerCount, 64),
+
 		TaskSchedulerEnableRateLimiter:           dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableRateLimiter, false),
 		TaskSchedulerEnableRateLimiterShadowMode: dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableRateLimiterShadowMode, true),
 		TaskSchedulerThrottleDuration:            dc.GetDurationProperty(dynamicconfig.TaskSchedulerThrottleDuration, time.Second),
@@ -429,7 +467,13 @@ func NewConfig(
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
+		WorkflowHistoryStreamMaxDuration:    dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.WorkflowHistoryStreamMaxDuration, 10*time.Minute),
+		MaxWorkflowHistoryStreams:           dc.GetIntProperty(dynamicconfig.MaxWorkflowHistoryStreams, 1000),
 		EventEncodingType:                   dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.DefaultEventEncoding, enumspb.ENCODING_TYPE_PROTO3.String()),
+		TaskPrioritySearchAttribute:         dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.TaskPrioritySearchAttribute, ""),
+		TaskPriorityHeader:                  dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.TaskPriorityHeader, ""),
+		TaskFairnessKeySearchAttribute:      dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.TaskFairnessKeySearchAttribute, ""),
+		EnableActivityRetryDelayInMatching:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableActivityRetryDelayInMatching, false),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableParentClosePolicy, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -545,7 +589,9 @@ func NewConfig(
 	return cfg
 }
 
-// GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair
+// GetShardID return the corresponding shard ID for a given namespaceID and workflowID pair under the
+// configured number of shards. It doesn't follow history shard count migrations, so it must only be used
+// to hash workflows, requests for workflows are routed with historyshard.Resolver.
 func (config *Config) GetShardID(namespaceID namespace.ID, workflowID string) int32 {
 	return common.WorkflowIDToHistoryShard(namespaceID.String(), workflowID, config.NumberOfShards)
 }
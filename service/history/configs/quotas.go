@@ -47,6 +47,7 @@ var (
 		"ReapplyEvents":                          0,
 		"RebuildMutableState":                    0,
 		"RecordActivityTaskHeartbeat":            0,
+		"PauseWorkflowExecution":                 0,
 		"RecordActivityTaskStarted":              0,
 		"RecordChildExecutionCompleted":          0,
 		"VerifyChildExecutionCompletionRecorded": 0,
@@ -72,12 +73,21 @@ var (
 		"SyncActivity":                           0,
 		"SyncShardStatus":                        0,
 		"TerminateWorkflowExecution":             0,
+		"UnpauseWorkflowExecution":               0,
+		"UpdateActivityOptions":                  0,
+		"ResetActivity":                          0,
 		"GenerateLastHistoryReplicationTasks":    0,
 		"GetReplicationStatus":                   0,
 		"DeleteWorkflowVisibilityRecord":         0,
 		"UpdateWorkflowExecution":                0,
 		"PollWorkflowExecutionUpdate":            0,
 		"StreamWorkflowReplicationMessages":      0,
+		"StreamWorkflowExecutionHistory":         0,
+		"ListFaultInjectionScenarios":            0,
+		"UpdateFaultInjectionScenario":           0,
+		"MigrateHistoryShard":                    0,
+		"CopyMigratedExecution":                  0,
+		"ImportWorkflowExecution":                0,
 	}
 
 	APIPrioritiesOrdered = []int{0}
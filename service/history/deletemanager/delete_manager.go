@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
@@ -242,6 +243,11 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 		}
 	}
 
+	// Offloaded payloads are deleted first, the task can't be retried once the workflow data is gone.
+	if err := m.deleteOffloadedPayloads(ctx, namespaceID, we); err != nil {
+		return err
+	}
+
 	if err := m.shard.DeleteWorkflowExecution(
 		ctx,
 		definition.WorkflowKey{
@@ -265,6 +271,23 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	return nil
 }
 
+// deleteOffloadedPayloads deletes the payloads offloaded for the run. Payloads are claimed per run, runs
+// inheriting payloads of a previous run (retries, cron and continue-as-new) own copies of them.
+func (m *DeleteManagerImpl) deleteOffloadedPayloads(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	we commonpb.WorkflowExecution,
+) error {
+	payloadStore := m.shard.GetPayloadStore()
+	if payloadStore == nil {
+		return nil
+	}
+	return payloadStore.DeletePrefix(
+		ctx,
+		payloadstore.RunKeyPrefix(namespaceID, we.GetWorkflowId(), we.GetRunId()),
+	)
+}
+
 func (m *DeleteManagerImpl) archiveWorkflowIfEnabled(
 	ctx context.Context,
 	namespaceID namespace.ID,
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/searchattribute"
@@ -70,6 +71,7 @@ type (
 		mockNamespaceRegistry *namespace.MockRegistry
 		mockMetadata          *cluster.MockMetadata
 		mockVisibilityManager *manager.MockVisibilityManager
+		payloadStore          payloadstore.Store
 
 		deleteManager DeleteManager
 	}
@@ -105,6 +107,8 @@ func (s *deleteManagerWorkflowSuite) SetupTest() {
 	s.mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
 	s.mockShardContext.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceRegistry).AnyTimes()
 	s.mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.payloadStore = nil
+	s.mockShardContext.EXPECT().GetPayloadStore().DoAndReturn(func() payloadstore.Store { return s.payloadStore }).AnyTimes()
 
 	s.deleteManager = NewDeleteManager(
 		s.mockShardContext,
@@ -161,6 +165,46 @@ func (s *deleteManagerWorkflowSuite) TestDeleteDeletedWorkflowExecution() {
 	s.NoError(err)
 }
 
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_OffloadedPayloads() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	mockPayloadStore := payloadstore.NewMockStore(s.controller)
+	s.payloadStore = mockPayloadStore
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return([]byte{22, 8, 78}, nil)
+	mockMutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{State: enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED})
+	closeTime := time.Date(1978, 8, 22, 1, 2, 3, 4, time.UTC)
+	mockMutableState.EXPECT().GetWorkflowCloseTime(gomock.Any()).Return(&closeTime, nil)
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{})
+	stage := tasks.DeleteWorkflowExecutionStageNone
+
+	gomock.InOrder(
+		mockPayloadStore.EXPECT().DeletePrefix(
+			gomock.Any(),
+			payloadstore.RunKeyPrefix(tests.NamespaceID, tests.WorkflowID, tests.RunID),
+		).Return(nil),
+		s.mockShardContext.EXPECT().DeleteWorkflowExecution(
+			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		).Return(nil),
+	)
+	mockWeCtx.EXPECT().Clear()
+
+	err := s.deleteManager.DeleteWorkflowExecution(
+		context.Background(),
+		tests.NamespaceID,
+		we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	s.NoError(err)
+}
+
 func (s *deleteManagerWorkflowSuite) TestDeleteDeletedWorkflowExecution_Error() {
 	we := commonpb.WorkflowExecution{
 		WorkflowId: tests.WorkflowID,
@@ -139,11 +139,13 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		archivalMetadata:             args.ArchivalMetadata,
 		hostInfoProvider:             args.HostInfoProvider,
 		controller:                   args.ShardController,
+		historyShardResolver:         args.HistoryShardResolver,
 		eventNotifier:                args.EventNotifier,
 		tracer:                       args.TracerProvider.Tracer(consts.LibraryName),
 
 		replicationTaskFetcherFactory: args.ReplicationTaskFetcherFactory,
 		streamReceiverMonitor:         args.StreamReceiverMonitor,
+		faultInjection:                args.FaultInjection,
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -39,6 +39,7 @@ import (
 	"go.uber.org/fx"
 	"google.golang.org/grpc/metadata"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	namespacespb "go.temporal.io/server/api/namespace/v1"
@@ -50,12 +51,14 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/standard/cassandra"
@@ -76,6 +79,8 @@ type (
 	// Handler - gRPC handler interface for historyservice
 	Handler struct {
 		status int32
+		// number of workflow history streams currently served by this host
+		workflowHistoryStreams int32
 
 		tokenSerializer              common.TaskTokenSerializer
 		startWG                      sync.WaitGroup
@@ -96,10 +101,12 @@ type (
 		archivalMetadata             archiver.ArchivalMetadata
 		hostInfoProvider             membership.HostInfoProvider
 		controller                   shard.Controller
+		historyShardResolver         historyshard.Resolver
 		tracer                       trace.Tracer
 
 		replicationTaskFetcherFactory replication.TaskFetcherFactory
 		streamReceiverMonitor         replication.StreamReceiverMonitor
+		faultInjection                *persistenceClient.FaultInjectionDataStoreFactory
 	}
 
 	NewHandlerArgs struct {
@@ -121,11 +128,13 @@ type (
 		ArchivalMetadata             archiver.ArchivalMetadata
 		HostInfoProvider             membership.HostInfoProvider
 		ShardController              shard.Controller
+		HistoryShardResolver         historyshard.Resolver
 		EventNotifier                events.Notifier
 		TracerProvider               trace.TracerProvider
 
 		ReplicationTaskFetcherFactory replication.TaskFetcherFactory
 		StreamReceiverMonitor         replication.StreamReceiverMonitor
+		FaultInjection                *persistenceClient.FaultInjectionDataStoreFactory
 	}
 )
 
@@ -148,6 +157,8 @@ var (
 	errDeserializeTaskTokenMessage = "Error to deserialize task token. Error: %v."
 
 	errShuttingDown = serviceerror.NewUnavailable("Shutting down")
+
+	errTooManyWorkflowHistoryStreams = serviceerror.NewResourceExhausted(enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, "Too many workflow history streams on this host.")
 )
 
 // Start starts the handler
@@ -609,6 +620,58 @@ func (h *Handler) CloseShard(_ context.Context, request *historyservice.CloseSha
 	return &historyservice.CloseShardResponse{}, nil
 }
 
+// ListFaultInjectionScenarios returns the persistence fault injection scenarios of this instance
+func (h *Handler) ListFaultInjectionScenarios(_ context.Context, _ *historyservice.ListFaultInjectionScenariosRequest) (_ *historyservice.ListFaultInjectionScenariosResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	resp := &historyservice.ListFaultInjectionScenariosResponse{
+		Address: h.hostInfoProvider.HostInfo().GetAddress(),
+	}
+	if h.faultInjection == nil {
+		return resp, nil
+	}
+	for _, state := range h.faultInjection.Scenarios.List() {
+		resp.Scenarios = append(resp.Scenarios, faultInjectionScenarioToProto(state))
+	}
+	return resp, nil
+}
+
+// UpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of this instance
+func (h *Handler) UpdateFaultInjectionScenario(_ context.Context, request *historyservice.UpdateFaultInjectionScenarioRequest) (_ *historyservice.UpdateFaultInjectionScenarioResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	if h.faultInjection == nil {
+		return nil, serviceerror.NewFailedPrecondition("persistence fault injection is not configured")
+	}
+	state, err := h.faultInjection.Scenarios.Update(
+		request.GetName(),
+		request.GetEnabled(),
+		timestamp.DurationValue(request.GetDuration()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("Updated persistence fault injection scenario.",
+		tag.Name(state.Name),
+		tag.NewBoolTag("enabled", state.Enabled),
+	)
+	return &historyservice.UpdateFaultInjectionScenarioResponse{
+		Scenario: faultInjectionScenarioToProto(state),
+		Address:  h.hostInfoProvider.HostInfo().GetAddress(),
+	}, nil
+}
+
+func faultInjectionScenarioToProto(state persistenceClient.FaultInjectionScenarioState) *adminservice.FaultInjectionScenario {
+	scenario := &adminservice.FaultInjectionScenario{
+		Name:    state.Name,
+		Enabled: state.Enabled,
+	}
+	if !state.ExpireTime.IsZero() {
+		scenario.ExpireTime = timestamp.TimePtr(state.ExpireTime)
+	}
+	return scenario
+}
+
 // GetShard gets a shard hosted by this instance
 func (h *Handler) GetShard(ctx context.Context, request *historyservice.GetShardRequest) (_ *historyservice.GetShardResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -751,6 +814,44 @@ func (h *Handler) PollMutableState(ctx context.Context, request *historyservice.
 	return resp, nil
 }
 
+// StreamWorkflowExecutionHistory streams the history event batches of a workflow execution as they are committed
+func (h *Handler) StreamWorkflowExecutionHistory(
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer,
+) (retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return errShuttingDown
+	}
+
+	defer atomic.AddInt32(&h.workflowHistoryStreams, -1)
+	if atomic.AddInt32(&h.workflowHistoryStreams, 1) > int32(h.config.MaxWorkflowHistoryStreams()) {
+		return errTooManyWorkflowHistoryStreams
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(server.Context())
+	if err != nil {
+		return h.convertError(err)
+	}
+
+	if err := engine.StreamWorkflowExecutionHistory(request, server); err != nil {
+		return h.convertError(err)
+	}
+	return nil
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (h *Handler) DescribeWorkflowExecution(ctx context.Context, request *historyservice.DescribeWorkflowExecutionRequest) (_ *historyservice.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -984,6 +1085,134 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context, request *histo
 	return resp, nil
 }
 
+// PauseWorkflowExecution - pauses the dispatch of workflow & activity tasks and the firing of user timers
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.PauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// UnpauseWorkflowExecution - resumes a paused workflow execution
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.UnpauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// UpdateActivityOptions - updates the task queue, timeouts and retry policy of a pending activity
+func (h *Handler) UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) (_ *historyservice.UpdateActivityOptionsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.UpdateActivityOptions(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
+// ResetActivity - retries pending activities waiting for their next retry immediately
+func (h *Handler) ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (_ *historyservice.ResetActivityResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.WorkflowExecution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err2 := engine.ResetActivity(ctx, request)
+	if err2 != nil {
+		return nil, h.convertError(err2)
+	}
+
+	return resp, nil
+}
+
 func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
 	h.startWG.Wait()
@@ -1498,10 +1727,13 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 	taskInfoPerShard := map[int32][]*replicationspb.ReplicationTaskInfo{}
 	// do batch based on workflow ID and run ID
 	for _, taskInfo := range request.GetTaskInfos() {
-		shardID := h.config.GetShardID(
+		shardID, err := h.historyShardResolver.WorkflowShardID(
 			namespace.ID(taskInfo.GetNamespaceId()),
 			taskInfo.GetWorkflowId(),
 		)
+		if err != nil {
+			return nil, h.convertError(err)
+		}
 		if _, ok := taskInfoPerShard[shardID]; !ok {
 			taskInfoPerShard[shardID] = []*replicationspb.ReplicationTaskInfo{}
 		}
@@ -1715,6 +1947,108 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+func (h *Handler) MigrateHistoryShard(ctx context.Context, request *historyservice.MigrateHistoryShardRequest) (_ *historyservice.MigrateHistoryShardResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	// executions can only be copied once no history host serves them from the source shard
+	layout, err := h.controller.RefreshLayout(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	if _, ok := layout.MigratingShardIDs[request.GetShardId()]; !ok || layout.TargetShardCount != request.GetTargetShardCount() {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"history shard %v is not being migrated to %v history shards", request.GetShardId(), request.GetTargetShardCount(),
+		))
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.MigrateHistoryShard(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) CopyMigratedExecution(ctx context.Context, request *historyservice.CopyMigratedExecutionRequest) (_ *historyservice.CopyMigratedExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	executionInfo := request.GetMutableState().GetExecutionInfo()
+	layout := h.historyShardResolver.Layout()
+	if !layout.IsMigrating() || common.WorkflowIDToHistoryShard(
+		executionInfo.GetNamespaceId(),
+		executionInfo.GetWorkflowId(),
+		layout.TargetShardCount,
+	) != request.GetShardId() {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"history shard %v is not the target history shard of workflow %v", request.GetShardId(), executionInfo.GetWorkflowId(),
+		))
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.CopyMigratedExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
+// ImportWorkflowExecution creates the workflow executions of an exported workflow execution bundle
+func (h *Handler) ImportWorkflowExecution(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest) (_ *historyservice.ImportWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.GetBundle().GetExecution().GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.ImportWorkflowExecution(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 func (h *Handler) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -33,6 +33,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
@@ -57,6 +58,8 @@ import (
 	"go.temporal.io/server/service/history/api/deleteworkflow"
 	"go.temporal.io/server/service/history/api/describemutablestate"
 	"go.temporal.io/server/service/history/api/describeworkflow"
+	"go.temporal.io/server/service/history/api/importworkflow"
+	"go.temporal.io/server/service/history/api/pauseworkflow"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
@@ -68,15 +71,21 @@ import (
 	replicationapi "go.temporal.io/server/service/history/api/replication"
 	"go.temporal.io/server/service/history/api/replicationadmin"
 	"go.temporal.io/server/service/history/api/requestcancelworkflow"
+	"go.temporal.io/server/service/history/api/resetactivity"
 	"go.temporal.io/server/service/history/api/resetstickytaskqueue"
 	"go.temporal.io/server/service/history/api/resetworkflow"
 	"go.temporal.io/server/service/history/api/respondactivitytaskcanceled"
 	"go.temporal.io/server/service/history/api/respondactivitytaskcompleted"
 	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
+	"go.temporal.io/server/service/history/api/shardmigration"
 	"go.temporal.io/server/service/history/api/signalwithstartworkflow"
 	"go.temporal.io/server/service/history/api/signalworkflow"
 	"go.temporal.io/server/service/history/api/startworkflow"
+	"go.temporal.io/server/service/history/api/streamhistory"
+	"go.temporal.io/server/service/history/api/taskdlq"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
+	"go.temporal.io/server/service/history/api/unpauseworkflow"
+	"go.temporal.io/server/service/history/api/updateactivityoptions"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
@@ -123,6 +132,7 @@ type (
 		matchingClient             matchingservice.MatchingServiceClient
 		rawMatchingClient          matchingservice.MatchingServiceClient
 		replicationDLQHandler      replication.DLQHandler
+		historyTaskDLQ             persistence.HistoryTaskDLQ
 		persistenceVisibilityMgr   manager.VisibilityManager
 		searchAttributesValidator  *searchattribute.Validator
 		workflowDeleteManager      deletemanager.DeleteManager
@@ -150,6 +160,7 @@ func NewEngineWithShardContext(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	tracerProvider trace.TracerProvider,
 	persistenceVisibilityMgr manager.VisibilityManager,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -183,6 +194,7 @@ func NewEngineWithShardContext(
 		rawMatchingClient:          rawMatchingClient,
 		persistenceVisibilityMgr:   persistenceVisibilityMgr,
 		workflowDeleteManager:      workflowDeleteManager,
+		historyTaskDLQ:             historyTaskDLQ,
 		eventSerializer:            eventSerializer,
 		workflowConsistencyChecker: workflowConsistencyChecker,
 		tracer:                     tracerProvider.Tracer(consts.LibraryName),
@@ -394,6 +406,14 @@ func (e *historyEngineImpl) PollMutableState(
 	}, nil
 }
 
+// StreamWorkflowExecutionHistory streams the history event batches of the workflow execution as they are committed
+func (e *historyEngineImpl) StreamWorkflowExecutionHistory(
+	request *historyservice.StreamWorkflowExecutionHistoryRequest,
+	server historyservice.HistoryService_StreamWorkflowExecutionHistoryServer,
+) error {
+	return streamhistory.Invoke(request, server, e.shard, e.workflowConsistencyChecker, e.eventNotifier)
+}
+
 func (e *historyEngineImpl) QueryWorkflow(
 	ctx context.Context,
 	request *historyservice.QueryWorkflowRequest,
@@ -484,7 +504,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCompletedRequest,
 ) (*historyservice.RespondActivityTaskCompletedResponse, error) {
-	return respondactivitytaskcompleted.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskcompleted.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -492,7 +512,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskFailedRequest,
 ) (*historyservice.RespondActivityTaskFailedResponse, error) {
-	return respondactivitytaskfailed.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskfailed.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -500,7 +520,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCanceledRequest,
 ) (*historyservice.RespondActivityTaskCanceledResponse, error) {
-	return respondactivitytaskcanceled.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+	return respondactivitytaskcanceled.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RecordActivityTaskHeartbeat records an hearbeat for a task.
@@ -522,6 +542,34 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 	return requestcancelworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
 }
 
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.PauseWorkflowExecutionRequest,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	return pauseworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	req *historyservice.UnpauseWorkflowExecutionRequest,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	return unpauseworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	req *historyservice.UpdateActivityOptionsRequest,
+) (*historyservice.UpdateActivityOptionsResponse, error) {
+	return updateactivityoptions.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	req *historyservice.ResetActivityRequest,
+) (*historyservice.ResetActivityResponse, error) {
+	return resetactivity.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) SignalWorkflowExecution(
 	ctx context.Context,
 	req *historyservice.SignalWorkflowExecutionRequest,
@@ -726,6 +774,9 @@ func (e *historyEngineImpl) GetDLQMessages(
 	ctx context.Context,
 	request *historyservice.GetDLQMessagesRequest,
 ) (*historyservice.GetDLQMessagesResponse, error) {
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		return taskdlq.GetDLQ(ctx, request, e.shard, e.historyTaskDLQ)
+	}
 	return replicationadmin.GetDLQ(ctx, request, e.shard, e.replicationDLQHandler)
 }
 
@@ -733,6 +784,9 @@ func (e *historyEngineImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *historyservice.PurgeDLQMessagesRequest,
 ) (*historyservice.PurgeDLQMessagesResponse, error) {
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		return taskdlq.PurgeDLQ(ctx, request, e.shard, e.historyTaskDLQ)
+	}
 	return replicationadmin.PurgeDLQ(ctx, request, e.shard, e.replicationDLQHandler)
 }
 
@@ -740,6 +794,9 @@ func (e *historyEngineImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
 ) (*historyservice.MergeDLQMessagesResponse, error) {
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		return taskdlq.MergeDLQ(ctx, request, e.shard, e.historyTaskDLQ)
+	}
 	return replicationadmin.MergeDLQ(ctx, request, e.shard, e.replicationDLQHandler)
 }
 
@@ -771,6 +828,27 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	)
 }
 
+func (e *historyEngineImpl) MigrateHistoryShard(
+	ctx context.Context,
+	request *historyservice.MigrateHistoryShardRequest,
+) (*historyservice.MigrateHistoryShardResponse, error) {
+	return shardmigration.Migrate(ctx, request, e.shard, e.workflowConsistencyChecker.GetWorkflowCache())
+}
+
+func (e *historyEngineImpl) CopyMigratedExecution(
+	ctx context.Context,
+	request *historyservice.CopyMigratedExecutionRequest,
+) (*historyservice.CopyMigratedExecutionResponse, error) {
+	return shardmigration.Copy(ctx, request, e.shard, e.workflowConsistencyChecker.GetWorkflowCache())
+}
+
+func (e *historyEngineImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ImportWorkflowExecutionRequest,
+) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	return importworkflow.Invoke(ctx, request, e.shard)
+}
+
 func (e *historyEngineImpl) GenerateLastHistoryReplicationTasks(
 	ctx context.Context,
 	request *historyservice.GenerateLastHistoryReplicationTasksRequest,
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -505,6 +506,50 @@ func (s *engine2Suite) TestRecordWorkflowTaskStartedSuccess() {
 	s.Equal(expectedQueryMap, response.Queries)
 }
 
+func (s *engine2Suite) TestRecordWorkflowTaskStartedAssignsBuildId() {
+	tl := "testTaskQueue"
+	identity := "testIdentity"
+
+	for i, tc := range []struct {
+		existing *persistencespb.BuildIdAssignment
+		expected string
+	}{
+		{existing: nil, expected: "v2"},
+		// the run keeps the build ID it was assigned to by its first workflow task
+		{existing: &persistencespb.BuildIdAssignment{BuildId: "v1"}, expected: "v1"},
+	} {
+		workflowExecution := commonpb.WorkflowExecution{
+			WorkflowId: fmt.Sprintf("wId%d", i),
+			RunId:      tests.RunID,
+		}
+		ms := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+		ms.GetExecutionInfo().BuildIdAssignment = tc.existing
+		wfMs := workflow.TestCloneToProto(ms)
+		s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: wfMs}, nil)
+		var assignment *persistencespb.BuildIdAssignment
+		s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.UpdateWorkflowExecutionRequest) (*persistence.UpdateWorkflowExecutionResponse, error) {
+				assignment = request.UpdateWorkflowMutation.ExecutionInfo.GetBuildIdAssignment()
+				return tests.UpdateWorkflowExecutionResponse, nil
+			})
+
+		_, err := s.historyEngine.RecordWorkflowTaskStarted(metrics.AddMetricsContext(context.Background()), &historyservice.RecordWorkflowTaskStartedRequest{
+			NamespaceId:       tests.NamespaceID.String(),
+			WorkflowExecution: &workflowExecution,
+			ScheduledEventId:  2,
+			TaskId:            100,
+			RequestId:         "reqId",
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+				TaskQueue:                 &taskqueuepb.TaskQueue{Name: tl},
+				Identity:                  identity,
+				WorkerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: "v2"},
+			},
+		})
+		s.NoError(err)
+		s.Equal(tc.expected, assignment.GetBuildId())
+	}
+}
+
 func (s *engine2Suite) TestRecordActivityTaskStartedIfNoExecution() {
 	namespaceID := tests.NamespaceID
 	workflowExecution := &commonpb.WorkflowExecution{
@@ -1168,6 +1213,43 @@ func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_NonDeDup() {
 	s.Nil(resp)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_UseExisting() {
+	namespaceID := tests.NamespaceID
+	workflowID := "workflowID"
+	runID := "runID"
+	workflowType := "workflowType"
+	taskQueue := "testTaskQueue"
+	identity := "testIdentity"
+	lastWriteVersion := common.EmptyVersion
+
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &persistence.CurrentWorkflowConditionFailedError{
+		Msg:              "random message",
+		RequestID:        "oldRequestID",
+		RunID:            runID,
+		State:            enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		LastWriteVersion: lastWriteVersion,
+	})
+
+	resp, err := s.historyEngine.StartWorkflowExecution(metrics.AddMetricsContext(context.Background()), &historyservice.StartWorkflowExecutionRequest{
+		Attempt:     1,
+		NamespaceId: namespaceID.String(),
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                namespaceID.String(),
+			WorkflowId:               workflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueue},
+			WorkflowExecutionTimeout: timestamp.DurationPtr(1 * time.Second),
+			WorkflowTaskTimeout:      timestamp.DurationPtr(2 * time.Second),
+			Identity:                 identity,
+			RequestId:                "newRequestID",
+		},
+		WorkflowIdConflictPolicy: enumsspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+	})
+	s.NoError(err)
+	s.Equal(runID, resp.GetRunId())
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_NotRunning_PrevSuccess() {
 	namespaceID := tests.NamespaceID
 	workflowID := "workflowID"
@@ -31,6 +31,7 @@ import (
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
@@ -105,6 +106,10 @@ type (
 
 		taskQueue                          string
 		activityTaskScheduleToStartTimeout time.Duration
+		notBefore                          *time.Time
+		priority                           int32
+		fairnessKey                        string
+		workflowTypeName                   string
 	}
 
 	workflowTaskPostActionInfo struct {
@@ -112,6 +117,10 @@ type (
 
 		workflowTaskScheduleToStartTimeout *time.Duration
 		taskqueue                          taskqueuepb.TaskQueue
+		priority                           int32
+		fairnessKey                        string
+		workflowTypeName                   string
+		buildIdAssignment                  *persistencespb.BuildIdAssignment
 	}
 
 	startChildExecutionPostActionInfo struct {
@@ -140,6 +149,9 @@ func newHistoryResendInfo(
 func newActivityTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	activityScheduleToStartTimeout time.Duration,
+	notBefore *time.Time,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -149,6 +161,10 @@ func newActivityTaskPostActionInfo(
 	return &activityTaskPostActionInfo{
 		historyResendInfo:                  resendInfo,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		notBefore:                          notBefore,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
+		workflowTypeName:                   mutableState.GetExecutionInfo().WorkflowTypeName,
 	}, nil
 }
 
@@ -156,6 +172,8 @@ func newActivityRetryTimePostActionInfo(
 	mutableState workflow.MutableState,
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
+	priority int32,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -166,6 +184,9 @@ func newActivityRetryTimePostActionInfo(
 		historyResendInfo:                  resendInfo,
 		taskQueue:                          taskQueue,
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
+		workflowTypeName:                   mutableState.GetExecutionInfo().WorkflowTypeName,
 	}, nil
 }
 
@@ -173,6 +194,8 @@ func newWorkflowTaskPostActionInfo(
 	mutableState workflow.MutableState,
 	workflowTaskScheduleToStartTimeout *time.Duration,
 	taskqueue taskqueuepb.TaskQueue,
+	priority int32,
+	fairnessKey string,
 ) (*workflowTaskPostActionInfo, error) {
 	resendInfo, err := getHistoryResendInfo(mutableState)
 	if err != nil {
@@ -183,6 +206,10 @@ func newWorkflowTaskPostActionInfo(
 		historyResendInfo:                  resendInfo,
 		workflowTaskScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		taskqueue:                          taskqueue,
+		priority:                           priority,
+		fairnessKey:                        fairnessKey,
+		workflowTypeName:                   mutableState.GetExecutionInfo().WorkflowTypeName,
+		buildIdAssignment:                  mutableState.GetExecutionInfo().GetBuildIdAssignment(),
 	}, nil
 }
 
@@ -168,6 +168,7 @@ func (r *ActivityReplicatorImpl) SyncActivity(
 			EventID:             request.GetScheduledEventId(),
 			Version:             request.GetVersion(),
 			Attempt:             request.GetAttempt(),
+			Stamp:               activityInfo.GetStamp(),
 		})
 	}
 
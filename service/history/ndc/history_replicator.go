@@ -707,16 +707,18 @@ func (r *HistoryReplicatorImpl) applyNonStartEventsToNonCurrentBranchWithoutCont
 		return err
 	}
 
+	encodingType := workflow.EventsEncodingType(r.shard.GetConfig(), mutableState.GetNamespaceEntry().Name())
 	eventsSlice := make([]*persistence.WorkflowEvents, len(task.getEvents()))
 	for i, events := range task.getEvents() {
 		eventsSlice[i] = &persistence.WorkflowEvents{
-			NamespaceID: task.getNamespaceID().String(),
-			WorkflowID:  task.getExecution().GetWorkflowId(),
-			RunID:       task.getExecution().GetRunId(),
-			BranchToken: versionHistory.GetBranchToken(),
-			PrevTxnID:   0, // TODO @wxing1292 events chaining will not work for backfill case
-			TxnID:       transactionID,
-			Events:      events,
+			NamespaceID:  task.getNamespaceID().String(),
+			WorkflowID:   task.getExecution().GetWorkflowId(),
+			RunID:        task.getExecution().GetRunId(),
+			BranchToken:  versionHistory.GetBranchToken(),
+			PrevTxnID:    0, // TODO @wxing1292 events chaining will not work for backfill case
+			TxnID:        transactionID,
+			Events:       events,
+			EncodingType: encodingType,
 		}
 	}
 	err = r.transactionMgr.backfillWorkflow(
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/queues"
@@ -71,6 +72,7 @@ type (
 		MetricsHandler       metrics.Handler
 		Logger               log.SnTaggedLogger
 		SchedulerRateLimiter queues.SchedulerRateLimiter
+		HistoryTaskDLQ       persistence.HistoryTaskDLQ
 	}
 
 	QueueFactoryBase struct {
@@ -196,6 +198,22 @@ func (f *QueueFactoryBase) Stop() {
 	}
 }
 
+func newQueueDLQWriter(
+	shard shard.Context,
+	dlq persistence.HistoryTaskDLQ,
+	config *configs.Config,
+) queues.DLQWriter {
+	return queues.NewDLQWriter(
+		shard.GetShardID(),
+		dlq,
+		&queues.DLQWriterOptions{
+			Enabled:     config.TaskDLQEnabled,
+			MaxAttempts: config.TaskDLQMaxAttempts,
+			MaxTaskAge:  config.TaskDLQMaxTaskAge,
+		},
+	)
+}
+
 func NewQueueHostRateLimiter(
 	hostRPS dynamicconfig.IntPropertyFn,
 	persistenceMaxRPS dynamicconfig.IntPropertyFn,
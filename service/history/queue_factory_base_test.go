@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
@@ -180,6 +181,7 @@ type compileTimeDependencies struct {
 	manager.VisibilityManager
 	archival.Archiver
 	workflow.RelocatableAttributesFetcher
+	persistence.HistoryTaskDLQ
 }
 
 // getArchivalMetadata returns a mock ArchivalMetadata that contains the static archival config specified in the given
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../../LICENSE -package $GOPACKAGE -source $GOFILE -destination dlq_writer_mock.go
+
+package queues
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// DLQWriter moves the tasks which keep failing to the history task DLQ,
+	// so that they stop blocking the progress of their queue.
+	DLQWriter interface {
+		// ShouldWrite returns true if a task which failed the given number of attempts with a non-transient error
+		// and was loaded the given duration ago should be moved to the DLQ.
+		ShouldWrite(failures int, age time.Duration) bool
+		// Write moves the task to the DLQ.
+		Write(ctx context.Context, task tasks.Task, attempt int, taskErr error) error
+	}
+
+	DLQWriterOptions struct {
+		Enabled     dynamicconfig.BoolPropertyFn
+		MaxAttempts dynamicconfig.IntPropertyFn
+		MaxTaskAge  dynamicconfig.DurationPropertyFn
+	}
+
+	dlqWriterImpl struct {
+		shardID int32
+		dlq     persistence.HistoryTaskDLQ
+		options *DLQWriterOptions
+	}
+)
+
+func NewDLQWriter(
+	shardID int32,
+	dlq persistence.HistoryTaskDLQ,
+	options *DLQWriterOptions,
+) DLQWriter {
+	return &dlqWriterImpl{
+		shardID: shardID,
+		dlq:     dlq,
+		options: options,
+	}
+}
+
+func (w *dlqWriterImpl) ShouldWrite(
+	failures int,
+	age time.Duration,
+) bool {
+	if !w.options.Enabled() {
+		return false
+	}
+
+	if maxAttempts := w.options.MaxAttempts(); maxAttempts > 0 && failures >= maxAttempts {
+		return true
+	}
+	if maxTaskAge := w.options.MaxTaskAge(); maxTaskAge > 0 && age >= maxTaskAge {
+		return true
+	}
+	return false
+}
+
+func (w *dlqWriterImpl) Write(
+	ctx context.Context,
+	task tasks.Task,
+	attempt int,
+	taskErr error,
+) error {
+	_, err := w.dlq.EnqueueTask(ctx, &persistence.EnqueueHistoryTaskDLQRequest{
+		ShardID:   w.shardID,
+		Task:      task,
+		Attempt:   int32(attempt),
+		LastError: taskErr.Error(),
+	})
+	return err
+}
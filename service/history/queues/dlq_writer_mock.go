@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dlq_writer.go
+
+// Package queues is a generated GoMock package.
+package queues
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	tasks "go.temporal.io/server/service/history/tasks"
+)
+
+// MockDLQWriter is a mock of DLQWriter interface.
+type MockDLQWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDLQWriterMockRecorder
+}
+
+// MockDLQWriterMockRecorder is the mock recorder for MockDLQWriter.
+type MockDLQWriterMockRecorder struct {
+	mock *MockDLQWriter
+}
+
+// NewMockDLQWriter creates a new mock instance.
+func NewMockDLQWriter(ctrl *gomock.Controller) *MockDLQWriter {
+	mock := &MockDLQWriter{ctrl: ctrl}
+	mock.recorder = &MockDLQWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDLQWriter) EXPECT() *MockDLQWriterMockRecorder {
+	return m.recorder
+}
+
+// ShouldWrite mocks base method.
+func (m *MockDLQWriter) ShouldWrite(failures int, age time.Duration) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShouldWrite", failures, age)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShouldWrite indicates an expected call of ShouldWrite.
+func (mr *MockDLQWriterMockRecorder) ShouldWrite(failures, age interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShouldWrite", reflect.TypeOf((*MockDLQWriter)(nil).ShouldWrite), failures, age)
+}
+
+// Write mocks base method.
+func (m *MockDLQWriter) Write(ctx context.Context, task tasks.Task, attempt int, taskErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", ctx, task, attempt, taskErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockDLQWriterMockRecorder) Write(ctx, task, attempt, taskErr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockDLQWriter)(nil).Write), ctx, task, attempt, taskErr)
+}
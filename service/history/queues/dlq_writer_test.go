@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	dlqWriterSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller *gomock.Controller
+		mockDLQ    *persistence.MockHistoryTaskDLQ
+
+		enabled     bool
+		maxAttempts int
+		maxTaskAge  time.Duration
+
+		dlqWriter DLQWriter
+	}
+)
+
+func TestDLQWriterSuite(t *testing.T) {
+	s := new(dlqWriterSuite)
+	suite.Run(t, s)
+}
+
+func (s *dlqWriterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockDLQ = persistence.NewMockHistoryTaskDLQ(s.controller)
+
+	s.enabled = true
+	s.maxAttempts = 10
+	s.maxTaskAge = 0
+	s.dlqWriter = NewDLQWriter(
+		int32(1),
+		s.mockDLQ,
+		&DLQWriterOptions{
+			Enabled:     func() bool { return s.enabled },
+			MaxAttempts: func() int { return s.maxAttempts },
+			MaxTaskAge:  dynamicconfig.DurationPropertyFn(func() time.Duration { return s.maxTaskAge }),
+		},
+	)
+}
+
+func (s *dlqWriterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *dlqWriterSuite) TestShouldWrite_Disabled() {
+	s.enabled = false
+
+	s.False(s.dlqWriter.ShouldWrite(100, time.Hour))
+}
+
+func (s *dlqWriterSuite) TestShouldWrite_MaxAttempts() {
+	s.False(s.dlqWriter.ShouldWrite(9, time.Hour))
+	s.True(s.dlqWriter.ShouldWrite(10, time.Second))
+
+	s.maxAttempts = 0
+	s.False(s.dlqWriter.ShouldWrite(100, time.Hour))
+}
+
+func (s *dlqWriterSuite) TestShouldWrite_MaxTaskAge() {
+	s.maxAttempts = 0
+	s.maxTaskAge = time.Minute
+
+	s.False(s.dlqWriter.ShouldWrite(100, time.Second))
+	s.True(s.dlqWriter.ShouldWrite(1, time.Minute))
+}
+
+func (s *dlqWriterSuite) TestWrite() {
+	task := tasks.NewFakeTask(
+		definition.NewWorkflowKey(
+			tests.NamespaceID.String(),
+			tests.WorkflowID,
+			tests.RunID,
+		),
+		tasks.CategoryTransfer,
+		time.Now(),
+	)
+	taskErr := errors.New("some random error")
+
+	s.mockDLQ.EXPECT().EnqueueTask(gomock.Any(), &persistence.EnqueueHistoryTaskDLQRequest{
+		ShardID:   1,
+		Task:      task,
+		Attempt:   5,
+		LastError: taskErr.Error(),
+	}).Return(int64(1), nil)
+	s.NoError(s.dlqWriter.Write(context.Background(), task, 5, taskErr))
+}
@@ -97,6 +97,8 @@ const (
 	// taskCriticalLogMetricAttempts, if exceeded, task attempts metrics and critical processing error log will be emitted
 	// while task is retrying
 	taskCriticalLogMetricAttempts = 30
+	// dlqWriteTimeout is the timeout for moving a task to the DLQ
+	dlqWriteTimeout = 5 * time.Second
 )
 
 type (
@@ -116,6 +118,7 @@ type (
 		timeSource        clock.TimeSource
 		namespaceRegistry namespace.Registry
 		clusterMetadata   cluster.Metadata
+		dlqWriter         DLQWriter
 
 		readerID               int64
 		loadTime               time.Time
@@ -123,6 +126,7 @@ type (
 		userLatency            time.Duration
 		lastActiveness         bool
 		resourceExhaustedCount int
+		failureCount           int // number of attempts which failed with a non-transient error
 		logger                 log.Logger
 		metricsHandler         metrics.Handler
 		taggedMetricsHandler   metrics.Handler
@@ -139,6 +143,7 @@ func NewExecutable(
 	timeSource clock.TimeSource,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	dlqWriter DLQWriter,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) Executable {
@@ -153,6 +158,7 @@ func NewExecutable(
 		timeSource:        timeSource,
 		namespaceRegistry: namespaceRegistry,
 		clusterMetadata:   clusterMetadata,
+		dlqWriter:         dlqWriter,
 		readerID:          readerID,
 		loadTime:          util.MaxTime(timeSource.Now(), task.GetKey().FireTime),
 		logger: log.NewLazyLogger(
@@ -317,10 +323,46 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 
 	e.taggedMetricsHandler.Counter(metrics.TaskFailures.GetMetricName()).Record(1)
 
+	if e.dlqWriter != nil && !isTransientTaskError(err) {
+		// only the failures caused by the task itself count towards moving it to the DLQ,
+		// an outage of a dependency must not move every task it fails.
+		e.failureCount++
+		if e.dlqWriter.ShouldWrite(e.failureCount, e.timeSource.Now().Sub(e.loadTime)) {
+			if dlqErr := e.writeToDLQ(err); dlqErr == nil {
+				// task is moved to the DLQ, drop it by returning nil so that it will be marked as completed.
+				return nil
+			}
+		}
+	}
+
 	e.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
 	return err
 }
 
+func (e *executableImpl) writeToDLQ(taskErr error) error {
+	ns, _ := e.namespaceRegistry.GetNamespaceName(namespace.ID(e.GetNamespaceID()))
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.NewBackgroundCallerInfo(ns.String())),
+		dlqWriteTimeout,
+	)
+	defer cancel()
+
+	attempt := e.Attempt()
+	if err := e.dlqWriter.Write(ctx, e.GetTask(), attempt, taskErr); err != nil {
+		e.taggedMetricsHandler.Counter(metrics.TaskDLQEnqueueFailures.GetMetricName()).Record(1)
+		e.logger.Error("Fail to move task to DLQ", tag.Attempt(int32(attempt)), tag.Error(err))
+		return err
+	}
+
+	e.taggedMetricsHandler.Counter(metrics.TaskDLQEnqueued.GetMetricName()).Record(1)
+	e.logger.Error("Task moved to DLQ after repeated failures",
+		tag.Attempt(int32(attempt)),
+		tag.Error(taskErr),
+		tag.OperationCritical,
+	)
+	return nil
+}
+
 func (e *executableImpl) IsRetryableError(err error) bool {
 	// this determines if the executable should be retried when hold the worker goroutine
 
@@ -539,6 +581,18 @@ func (e *executableImpl) resetAttempt() {
 	defer e.Unlock()
 
 	e.attempt = 1
+	e.failureCount = 0
+}
+
+// isTransientTaskError returns true if the error is caused by a temporary condition of the dependencies
+// of the task processing, rather than by the task itself.
+func isTransientTaskError(err error) bool {
+	switch err.(type) {
+	case *serviceerror.Unavailable,
+		*serviceerror.ResourceExhausted:
+		return true
+	}
+	return common.IsContextDeadlineExceededErr(err)
 }
 
 func (e *executableImpl) estimateTaskMetricTag() []metrics.Tag {
@@ -62,6 +62,7 @@ type (
 		mockRescheduler       *MockRescheduler
 		mockNamespaceRegistry *namespace.MockRegistry
 		mockClusterMetadata   *cluster.MockMetadata
+		mockDLQWriter         *MockDLQWriter
 
 		timeSource *clock.EventTimeSource
 	}
@@ -81,6 +82,7 @@ func (s *executableSuite) SetupTest() {
 	s.mockRescheduler = NewMockRescheduler(s.controller)
 	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
 	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.mockDLQWriter = NewMockDLQWriter(s.controller)
 
 	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).Return(tests.Namespace, nil).AnyTimes()
 	s.mockNamespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
@@ -218,6 +220,45 @@ func (s *executableSuite) TestHandleErr_RandomErr() {
 	s.Error(executable.HandleErr(errors.New("random error")))
 }
 
+func (s *executableSuite) TestHandleErr_MoveToDLQ() {
+	executable := s.newTestExecutableWithDLQWriter(s.mockDLQWriter)
+	taskErr := errors.New("random error")
+
+	s.mockDLQWriter.EXPECT().ShouldWrite(1, gomock.Any()).Return(false)
+	s.Equal(taskErr, executable.HandleErr(taskErr))
+
+	s.mockDLQWriter.EXPECT().ShouldWrite(2, gomock.Any()).Return(true)
+	s.mockDLQWriter.EXPECT().Write(gomock.Any(), executable.GetTask(), 2, taskErr).Return(nil)
+	s.NoError(executable.HandleErr(taskErr))
+}
+
+func (s *executableSuite) TestHandleErr_TransientErrNotCountedForDLQ() {
+	executable := s.newTestExecutableWithDLQWriter(s.mockDLQWriter)
+
+	for _, transientErr := range []error{
+		serviceerror.NewUnavailable("some unavailable error"),
+		serviceerror.NewDeadlineExceeded("some deadline exceeded error"),
+		context.DeadlineExceeded,
+	} {
+		s.Equal(transientErr, executable.HandleErr(transientErr))
+	}
+
+	taskErr := errors.New("random error")
+	s.mockDLQWriter.EXPECT().ShouldWrite(1, gomock.Any()).Return(false)
+	s.Equal(taskErr, executable.HandleErr(taskErr))
+	s.Equal(5, executable.Attempt())
+}
+
+func (s *executableSuite) TestHandleErr_MoveToDLQFailed() {
+	executable := s.newTestExecutableWithDLQWriter(s.mockDLQWriter)
+	taskErr := errors.New("random error")
+
+	s.mockDLQWriter.EXPECT().ShouldWrite(1, gomock.Any()).Return(true)
+	s.mockDLQWriter.EXPECT().Write(gomock.Any(), executable.GetTask(), 1, taskErr).Return(errors.New("some persistence error"))
+	s.Equal(taskErr, executable.HandleErr(taskErr))
+	s.Equal(2, executable.Attempt())
+}
+
 func (s *executableSuite) TestTaskAck() {
 	executable := s.newTestExecutable()
 
@@ -288,6 +329,12 @@ func (s *executableSuite) TestTaskCancellation() {
 }
 
 func (s *executableSuite) newTestExecutable() Executable {
+	return s.newTestExecutableWithDLQWriter(nil)
+}
+
+func (s *executableSuite) newTestExecutableWithDLQWriter(
+	dlqWriter DLQWriter,
+) Executable {
 	return NewExecutable(
 		DefaultReaderId,
 		tasks.NewFakeTask(
@@ -306,6 +353,7 @@ func (s *executableSuite) newTestExecutable() Executable {
 		s.timeSource,
 		s.mockNamespaceRegistry,
 		s.mockClusterMetadata,
+		dlqWriter,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
 	)
@@ -56,6 +56,8 @@ func GetActiveTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferActiveTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferActiveTaskDeleteExecution
+	case *tasks.CompletionCallbackTask:
+		return metrics.TaskTypeTransferActiveTaskCompletionCallback
 	default:
 		return "TransferActive" + task.GetType().String()
 	}
@@ -81,6 +83,8 @@ func GetStandbyTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferStandbyTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferStandbyTaskDeleteExecution
+	case *tasks.CompletionCallbackTask:
+		return metrics.TaskTypeTransferStandbyTaskCompletionCallback
 	default:
 		return "TransferStandby" + task.GetType().String()
 	}
@@ -110,6 +114,8 @@ func GetActiveTimerTaskTypeTagValue(
 		return metrics.TaskTypeTimerActiveTaskActivityRetryTimer
 	case *tasks.WorkflowBackoffTimerTask:
 		return metrics.TaskTypeTimerActiveTaskWorkflowBackoffTimer
+	case *tasks.CompletionCallbackBackoffTask:
+		return metrics.TaskTypeTimerActiveTaskCallbackBackoff
 	default:
 		return "TimerActive" + task.GetType().String()
 	}
@@ -133,6 +139,8 @@ func GetStandbyTimerTaskTypeTagValue(
 		return metrics.TaskTypeTimerStandbyTaskActivityRetryTimer
 	case *tasks.WorkflowBackoffTimerTask:
 		return metrics.TaskTypeTimerStandbyTaskWorkflowBackoffTimer
+	case *tasks.CompletionCallbackBackoffTask:
+		return metrics.TaskTypeTimerStandbyTaskCallbackBackoff
 	default:
 		return "TimerStandby" + task.GetType().String()
 	}
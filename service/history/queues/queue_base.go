@@ -130,6 +130,7 @@ func newQueueBase(
 	rescheduler Rescheduler,
 	priorityAssigner PriorityAssigner,
 	executor Executor,
+	dlqWriter DLQWriter,
 	options *Options,
 	hostReaderRateLimiter quotas.RequestRateLimiter,
 	completionFn ReaderCompletionFn,
@@ -165,6 +166,7 @@ func newQueueBase(
 			timeSource,
 			shard.GetNamespaceRegistry(),
 			shard.GetClusterMetadata(),
+			dlqWriter,
 			logger,
 			metricsHandler,
 		)
@@ -142,6 +142,7 @@ func (s *queueBaseSuite) TestNewProcessBase_NoPreviousState() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -227,6 +228,7 @@ func (s *queueBaseSuite) TestNewProcessBase_WithPreviousState_RestoreSucceed() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -302,6 +304,7 @@ func (s *queueBaseSuite) TestNewProcessBase_WithPreviousState_RestoreFailed() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -363,6 +366,7 @@ func (s *queueBaseSuite) TestStartStop() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -414,6 +418,7 @@ func (s *queueBaseSuite) TestProcessNewRange() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -473,6 +478,7 @@ func (s *queueBaseSuite) TestCheckPoint_WithPendingTasks() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -547,6 +553,7 @@ func (s *queueBaseSuite) TestCheckPoint_NoPendingTasks() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -636,6 +643,7 @@ func (s *queueBaseSuite) TestCheckPoint_MoveSlices() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -707,6 +715,7 @@ func (s *queueBaseSuite) TestUpdateReaderProgress() {
 		s.mockRescheduler,
 		NewNoopPriorityAssigner(),
 		nil,
+		nil,
 		s.options,
 		s.rateLimiter,
 		NoopReaderCompletionFn,
@@ -57,6 +57,7 @@ func NewImmediateQueue(
 	rescheduler Rescheduler,
 	priorityAssigner PriorityAssigner,
 	executor Executor,
+	dlqWriter DLQWriter,
 	options *Options,
 	hostRateLimiter quotas.RequestRateLimiter,
 	logger log.Logger,
@@ -95,6 +96,7 @@ func NewImmediateQueue(
 			rescheduler,
 			priorityAssigner,
 			executor,
+			dlqWriter,
 			options,
 			hostRateLimiter,
 			NoopReaderCompletionFn,
@@ -72,6 +72,7 @@ func NewScheduledQueue(
 	rescheduler Rescheduler,
 	priorityAssigner PriorityAssigner,
 	executor Executor,
+	dlqWriter DLQWriter,
 	options *Options,
 	hostRateLimiter quotas.RequestRateLimiter,
 	logger log.Logger,
@@ -131,6 +132,7 @@ func NewScheduledQueue(
 			rescheduler,
 			priorityAssigner,
 			executor,
+			dlqWriter,
 			options,
 			hostRateLimiter,
 			readerCompletionFn,
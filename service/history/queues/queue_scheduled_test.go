@@ -113,6 +113,7 @@ func (s *scheduledQueueSuite) SetupTest() {
 		rescheduler,
 		nil,
 		nil,
+		nil,
 		testQueueOptions,
 		NewReaderPriorityRateLimiter(
 			func() float64 { return 10 },
@@ -104,6 +104,7 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 				q.timeSource,
 				q.namespaceRegistry,
 				q.clusterMetadata,
+				nil,
 				q.logger,
 				q.metricsHandler,
 			), wttt)
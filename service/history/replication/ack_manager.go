@@ -478,21 +478,23 @@ func (p *ackMgrImpl) ConvertTask(
 	ctx context.Context,
 	task tasks.Task,
 ) (*replicationspb.ReplicationTask, error) {
+	var replicationTask *replicationspb.ReplicationTask
+	var err error
 	switch task := task.(type) {
 	case *tasks.SyncActivityTask:
-		return convertActivityStateReplicationTask(
+		replicationTask, err = convertActivityStateReplicationTask(
 			ctx,
 			task,
 			p.workflowCache,
 		)
 	case *tasks.SyncWorkflowStateTask:
-		return convertWorkflowStateReplicationTask(
+		replicationTask, err = convertWorkflowStateReplicationTask(
 			ctx,
 			task,
 			p.workflowCache,
 		)
 	case *tasks.HistoryReplicationTask:
-		return convertHistoryReplicationTask(
+		replicationTask, err = convertHistoryReplicationTask(
 			ctx,
 			task,
 			p.shard.GetShardID(),
@@ -503,6 +505,13 @@ func (p *ackMgrImpl) ConvertTask(
 	default:
 		return nil, errUnknownReplicationTask
 	}
+	if err != nil || replicationTask == nil {
+		return replicationTask, err
+	}
+	if err := resolveOffloadedPayloads(ctx, p.shard.GetPayloadStore(), replicationTask); err != nil {
+		return nil, err
+	}
+	return replicationTask, nil
 }
 
 func (p *ackMgrImpl) SubscribeNotification() (<-chan struct{}, string) {
@@ -77,21 +77,23 @@ func NewExecutableActivityStateTask(
 			time.Now().UTC(),
 		),
 		req: &historyservice.SyncActivityRequest{
-			NamespaceId:        task.NamespaceId,
-			WorkflowId:         task.WorkflowId,
-			RunId:              task.RunId,
-			Version:            task.Version,
-			ScheduledEventId:   task.ScheduledEventId,
-			ScheduledTime:      task.ScheduledTime,
-			StartedEventId:     task.StartedEventId,
-			StartedTime:        task.StartedTime,
-			LastHeartbeatTime:  task.LastHeartbeatTime,
-			Details:            task.Details,
-			Attempt:            task.Attempt,
-			LastFailure:        task.LastFailure,
-			LastWorkerIdentity: task.LastWorkerIdentity,
-			BaseExecutionInfo:  task.BaseExecutionInfo,
-			VersionHistory:     task.VersionHistory,
+			NamespaceId:         task.NamespaceId,
+			WorkflowId:          task.WorkflowId,
+			RunId:               task.RunId,
+			Version:             task.Version,
+			ScheduledEventId:    task.ScheduledEventId,
+			ScheduledTime:       task.ScheduledTime,
+			StartedEventId:      task.StartedEventId,
+			StartedTime:         task.StartedTime,
+			LastHeartbeatTime:   task.LastHeartbeatTime,
+			Details:             task.Details,
+			Attempt:             task.Attempt,
+			LastFailure:         task.LastFailure,
+			LastWorkerIdentity:  task.LastWorkerIdentity,
+			BaseExecutionInfo:   task.BaseExecutionInfo,
+			VersionHistory:      task.VersionHistory,
+			Options:             task.Options,
+			RetryExpirationTime: task.RetryExpirationTime,
 		},
 
 		sourceClusterName: sourceClusterName,
@@ -28,7 +28,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -40,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -112,21 +115,23 @@ func convertActivityStateReplicationTask(
 				SourceTaskId: taskInfo.TaskID,
 				Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
 					SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
-						NamespaceId:        taskInfo.NamespaceID,
-						WorkflowId:         taskInfo.WorkflowID,
-						RunId:              taskInfo.RunID,
-						Version:            activityInfo.Version,
-						ScheduledEventId:   activityInfo.ScheduledEventId,
-						ScheduledTime:      activityInfo.ScheduledTime,
-						StartedEventId:     activityInfo.StartedEventId,
-						StartedTime:        startedTime,
-						LastHeartbeatTime:  activityInfo.LastHeartbeatUpdateTime,
-						Details:            activityInfo.LastHeartbeatDetails,
-						Attempt:            activityInfo.Attempt,
-						LastFailure:        activityInfo.RetryLastFailure,
-						LastWorkerIdentity: activityInfo.RetryLastWorkerIdentity,
-						BaseExecutionInfo:  copyBaseWorkflowInfo(mutableState.GetBaseWorkflowInfo()),
-						VersionHistory:     versionhistory.CopyVersionHistory(currentVersionHistory),
+						NamespaceId:         taskInfo.NamespaceID,
+						WorkflowId:          taskInfo.WorkflowID,
+						RunId:               taskInfo.RunID,
+						Version:             activityInfo.Version,
+						ScheduledEventId:    activityInfo.ScheduledEventId,
+						ScheduledTime:       activityInfo.ScheduledTime,
+						StartedEventId:      activityInfo.StartedEventId,
+						StartedTime:         startedTime,
+						LastHeartbeatTime:   activityInfo.LastHeartbeatUpdateTime,
+						Details:             activityInfo.LastHeartbeatDetails,
+						Attempt:             activityInfo.Attempt,
+						LastFailure:         activityInfo.RetryLastFailure,
+						LastWorkerIdentity:  activityInfo.RetryLastWorkerIdentity,
+						BaseExecutionInfo:   copyBaseWorkflowInfo(mutableState.GetBaseWorkflowInfo()),
+						VersionHistory:      versionhistory.CopyVersionHistory(currentVersionHistory),
+						Options:             workflow.ActivityOptionsFromInfo(activityInfo),
+						RetryExpirationTime: activityInfo.RetryExpirationTime,
 					},
 				},
 				VisibilityTime: &taskInfo.VisibilityTimestamp,
@@ -227,6 +232,51 @@ func convertHistoryReplicationTask(
 	}, nil
 }
 
+// resolveOffloadedPayloads resolves the offloaded payloads of a replication task, as other clusters may not
+// have access to the payload store of this cluster. The activity payloads of sync activity tasks are shared
+// with the mutable state and are resolved on copies.
+func resolveOffloadedPayloads(
+	ctx context.Context,
+	store payloadstore.Store,
+	replicationTask *replicationspb.ReplicationTask,
+) error {
+	if store == nil {
+		return nil
+	}
+	if attr := replicationTask.GetSyncActivityTaskAttributes(); attr != nil {
+		namespaceID := namespace.ID(attr.GetNamespaceId())
+		if attr.Details != nil {
+			attr.Details = proto.Clone(attr.Details).(*commonpb.Payloads)
+			if err := payloadstore.Resolve(ctx, store, attr.Details, namespaceID); err != nil {
+				return err
+			}
+		}
+		if attr.LastFailure != nil {
+			attr.LastFailure = proto.Clone(attr.LastFailure).(*failurepb.Failure)
+			if err := payloadstore.Resolve(ctx, store, attr.LastFailure, namespaceID); err != nil {
+				return err
+			}
+		}
+	}
+	if attr := replicationTask.GetSyncWorkflowStateTaskAttributes(); attr != nil {
+		if err := payloadstore.ResolveMutableState(ctx, store, attr.GetWorkflowState()); err != nil {
+			return err
+		}
+	}
+	if attr := replicationTask.GetHistoryTaskAttributes(); attr != nil {
+		var blobs []*commonpb.DataBlob
+		for _, blob := range []*commonpb.DataBlob{attr.GetEvents(), attr.GetNewRunEvents()} {
+			if blob != nil {
+				blobs = append(blobs, blob)
+			}
+		}
+		if err := payloadstore.ResolveHistory(ctx, store, blobs, namespace.ID(attr.GetNamespaceId())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func generateStateReplicationTask(
 	ctx context.Context,
 	workflowKey definition.WorkflowKey,
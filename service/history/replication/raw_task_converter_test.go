@@ -44,11 +44,13 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/shard"
@@ -271,6 +273,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 	activityDetails := payloads.EncodeString("some random activity progress")
 	activityLastFailure := failure.NewServerFailure("some random reason", false)
 	activityLastWorkerIdentity := "some random worker identity"
+	activityTaskQueue := "some random task queue"
 	baseWorkflowInfo := &workflowspb.BaseExecutionInfo{
 		RunId:                            uuid.New(),
 		LowestCommonAncestorEventId:      rand.Int63(),
@@ -304,6 +307,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 		Attempt:                 activityAttempt,
 		RetryLastFailure:        activityLastFailure,
 		RetryLastWorkerIdentity: activityLastWorkerIdentity,
+		TaskQueue:               activityTaskQueue,
 	}, true).AnyTimes()
 	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		VersionHistories: versionHistories,
@@ -332,6 +336,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 				LastWorkerIdentity: activityLastWorkerIdentity,
 				BaseExecutionInfo:  baseWorkflowInfo,
 				VersionHistory:     versionHistory,
+				Options:            &historyspb.ActivityOptions{TaskQueue: activityTaskQueue},
 			},
 		},
 		VisibilityTime: timestamp.TimePtr(task.VisibilityTimestamp),
@@ -375,6 +380,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 	activityDetails := payloads.EncodeString("some random activity progress")
 	activityLastFailure := failure.NewServerFailure("some random reason", false)
 	activityLastWorkerIdentity := "some random worker identity"
+	activityTaskQueue := "some random task queue"
 	baseWorkflowInfo := &workflowspb.BaseExecutionInfo{
 		RunId:                            uuid.New(),
 		LowestCommonAncestorEventId:      rand.Int63(),
@@ -408,6 +414,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 		Attempt:                 activityAttempt,
 		RetryLastFailure:        activityLastFailure,
 		RetryLastWorkerIdentity: activityLastWorkerIdentity,
+		TaskQueue:               activityTaskQueue,
 	}, true).AnyTimes()
 	s.mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
 		VersionHistories: versionHistories,
@@ -436,6 +443,7 @@ func (s *rawTaskConverterSuite) TestConvertActivityStateReplicationTask_Activity
 				LastWorkerIdentity: activityLastWorkerIdentity,
 				BaseExecutionInfo:  baseWorkflowInfo,
 				VersionHistory:     versionHistory,
+				Options:            &historyspb.ActivityOptions{TaskQueue: activityTaskQueue},
 			},
 		},
 		VisibilityTime: timestamp.TimePtr(task.VisibilityTimestamp),
@@ -797,3 +805,28 @@ func (s *rawTaskConverterSuite) TestConvertHistoryReplicationTask_WithoutNewRun(
 	}, result)
 	s.True(s.lockReleased)
 }
+
+func (s *rawTaskConverterSuite) TestResolveOffloadedPayloads_SyncActivityTask() {
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+
+	details := payloads.EncodeString("details")
+	offloaded := payloads.EncodeString("details")
+	_, err = payloadstore.Offload(context.Background(), store, offloaded, namespace.ID(s.namespaceID), 0)
+	s.NoError(err)
+	replicationTask := &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+		Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
+			SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
+				NamespaceId: s.namespaceID,
+				Details:     offloaded,
+			},
+		},
+	}
+
+	err = resolveOffloadedPayloads(context.Background(), store, replicationTask)
+	s.NoError(err)
+	s.Equal(details, replicationTask.GetSyncActivityTaskAttributes().GetDetails())
+	// the payloads of the mutable state are left untouched
+	s.True(payloadstore.IsReference(offloaded.Payloads[0]))
+}
@@ -145,20 +145,22 @@ func (e *taskExecutorImpl) handleActivityTask(
 	}()
 
 	request := &historyservice.SyncActivityRequest{
-		NamespaceId:        attr.NamespaceId,
-		WorkflowId:         attr.WorkflowId,
-		RunId:              attr.RunId,
-		Version:            attr.Version,
-		ScheduledEventId:   attr.ScheduledEventId,
-		ScheduledTime:      attr.ScheduledTime,
-		StartedEventId:     attr.StartedEventId,
-		StartedTime:        attr.StartedTime,
-		LastHeartbeatTime:  attr.LastHeartbeatTime,
-		Details:            attr.Details,
-		Attempt:            attr.Attempt,
-		LastFailure:        attr.LastFailure,
-		LastWorkerIdentity: attr.LastWorkerIdentity,
-		VersionHistory:     attr.GetVersionHistory(),
+		NamespaceId:         attr.NamespaceId,
+		WorkflowId:          attr.WorkflowId,
+		RunId:               attr.RunId,
+		Version:             attr.Version,
+		ScheduledEventId:    attr.ScheduledEventId,
+		ScheduledTime:       attr.ScheduledTime,
+		StartedEventId:      attr.StartedEventId,
+		StartedTime:         attr.StartedTime,
+		LastHeartbeatTime:   attr.LastHeartbeatTime,
+		Details:             attr.Details,
+		Attempt:             attr.Attempt,
+		LastFailure:         attr.LastFailure,
+		LastWorkerIdentity:  attr.LastWorkerIdentity,
+		VersionHistory:      attr.GetVersionHistory(),
+		Options:             attr.GetOptions(),
+		RetryExpirationTime: attr.GetRetryExpirationTime(),
 	}
 	ctx, cancel := e.newTaskContext(ctx, attr.NamespaceId)
 	defer cancel()
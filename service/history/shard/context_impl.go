@@ -60,6 +60,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -121,6 +122,7 @@ type (
 		saMapperProvider        searchattribute.MapperProvider
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
+		payloadStore            payloadstore.Store
 		hostInfoProvider        membership.HostInfoProvider
 
 		// Context that lives for the lifetime of the shard context
@@ -537,6 +539,9 @@ func (s *ContextImpl) CreateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.claimWorkflowSnapshot(ctx, &request.NewWorkflowSnapshot, request.NewWorkflowEvents, false); err != nil {
+		return nil, err
+	}
 
 	s.wLock()
 	defer s.wUnlock()
@@ -587,6 +592,12 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.claimWorkflowMutation(ctx, &request.UpdateWorkflowMutation, request.UpdateWorkflowEvents); err != nil {
+		return nil, err
+	}
+	if err := s.claimWorkflowSnapshot(ctx, request.NewWorkflowSnapshot, request.NewWorkflowEvents, true); err != nil {
+		return nil, err
+	}
 
 	s.wLock()
 	defer s.wUnlock()
@@ -663,6 +674,15 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.claimWorkflowMutation(ctx, request.CurrentWorkflowMutation, request.CurrentWorkflowEvents); err != nil {
+		return nil, err
+	}
+	if err := s.claimWorkflowSnapshot(ctx, &request.ResetWorkflowSnapshot, request.ResetWorkflowEvents, true); err != nil {
+		return nil, err
+	}
+	if err := s.claimWorkflowSnapshot(ctx, request.NewWorkflowSnapshot, request.NewWorkflowEvents, true); err != nil {
+		return nil, err
+	}
 
 	s.wLock()
 	defer s.wUnlock()
@@ -732,6 +752,9 @@ func (s *ContextImpl) SetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.claimWorkflowSnapshot(ctx, &request.SetWorkflowSnapshot, nil, false); err != nil {
+		return nil, err
+	}
 
 	s.wLock()
 	defer s.wUnlock()
@@ -831,6 +854,14 @@ func (s *ContextImpl) AppendHistoryEvents(
 	}
 
 	request.ShardID = s.shardID
+	if err := s.claimWorkflowEvents(ctx, []*persistence.WorkflowEvents{{
+		NamespaceID: namespaceID.String(),
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+		Events:      request.Events,
+	}}, false); err != nil {
+		return 0, err
+	}
 
 	size := 0
 	defer func() {
@@ -1835,6 +1866,7 @@ func newContext(
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	payloadStore payloadstore.Store,
 	hostInfoProvider membership.HostInfoProvider,
 ) (*ContextImpl, error) {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
@@ -1864,6 +1896,7 @@ func newContext(
 		saMapperProvider:        saMapperProvider,
 		clusterMetadata:         clusterMetadata,
 		archivalMetadata:        archivalMetadata,
+		payloadStore:            payloadStore,
 		hostInfoProvider:        hostInfoProvider,
 		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
 		lifecycleCtx:            lifecycleCtx,
@@ -1953,6 +1986,10 @@ func (s *ContextImpl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return s.archivalMetadata
 }
 
+func (s *ContextImpl) GetPayloadStore() payloadstore.Store {
+	return s.payloadStore
+}
+
 // newDetachedContext creates a detached context with the same deadline
 // and values from the given context. Detached context won't be affected
 // if the context it bases on is cancelled.
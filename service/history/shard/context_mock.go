@@ -46,6 +46,7 @@ import (
 	log "go.temporal.io/server/common/log"
 	metrics "go.temporal.io/server/common/metrics"
 	namespace "go.temporal.io/server/common/namespace"
+	payloadstore "go.temporal.io/server/common/payloadstore"
 	persistence "go.temporal.io/server/common/persistence"
 	serialization "go.temporal.io/server/common/persistence/serialization"
 	searchattribute "go.temporal.io/server/common/searchattribute"
@@ -434,6 +435,20 @@ func (mr *MockContextMockRecorder) GetPayloadSerializer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadSerializer", reflect.TypeOf((*MockContext)(nil).GetPayloadSerializer))
 }
 
+// GetPayloadStore mocks base method.
+func (m *MockContext) GetPayloadStore() payloadstore.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadStore")
+	ret0, _ := ret[0].(payloadstore.Store)
+	return ret0
+}
+
+// GetPayloadStore indicates an expected call of GetPayloadStore.
+func (mr *MockContextMockRecorder) GetPayloadStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadStore", reflect.TypeOf((*MockContext)(nil).GetPayloadStore))
+}
+
 // GetQueueState mocks base method.
 func (m *MockContext) GetQueueState(category tasks.Category) (*v13.QueueState, bool) {
 	m.ctrl.T.Helper()
@@ -28,22 +28,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
@@ -390,3 +397,73 @@ func (s *contextSuite) TestHandoverNamespace() {
 	_, ok = handoverNS[namespaceEntry.Name().String()]
 	s.False(ok)
 }
+
+func (s *contextSuite) TestUpdateWorkflowExecution_ClaimsOffloadedPayloads() {
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+	s.mockShard.payloadStore = store
+
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{payload.EncodeString("offloaded")}}
+	_, err = payloadstore.Offload(context.Background(), store, input, tests.NamespaceID, 0)
+	s.NoError(err)
+
+	// the input of the continue as new run is shared with the event of the current run
+	newRunID := "a4ba1eb8-5c7f-4a6e-9d65-4c8f5f6a2b1e"
+	currentEvent := &historypb.HistoryEvent{
+		EventId: 5,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{
+			WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{Input: input},
+		},
+	}
+	newEvent := &historypb.HistoryEvent{
+		EventId: 1,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{Input: input},
+		},
+	}
+	request := &persistence.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: tests.NamespaceID.String(), WorkflowId: tests.WorkflowID},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: tests.RunID},
+		},
+		UpdateWorkflowEvents: []*persistence.WorkflowEvents{{
+			NamespaceID: tests.NamespaceID.String(),
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+			Events:      []*historypb.HistoryEvent{currentEvent},
+		}},
+		NewWorkflowSnapshot: &persistence.WorkflowSnapshot{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: tests.NamespaceID.String(), WorkflowId: tests.WorkflowID},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: newRunID},
+		},
+		NewWorkflowEvents: []*persistence.WorkflowEvents{{
+			NamespaceID: tests.NamespaceID.String(),
+			WorkflowID:  tests.WorkflowID,
+			RunID:       newRunID,
+			Events:      []*historypb.HistoryEvent{newEvent},
+		}},
+	}
+
+	s.mockShard.MockEventsCache.EXPECT().PutEvent(events.EventKey{
+		NamespaceID: tests.NamespaceID,
+		WorkflowID:  tests.WorkflowID,
+		RunID:       newRunID,
+		EventID:     1,
+	}, gomock.Any())
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), request).Return(tests.UpdateWorkflowExecutionResponse, nil)
+
+	_, err = s.mockShard.UpdateWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+
+	currentInput := request.UpdateWorkflowEvents[0].Events[0].GetWorkflowExecutionContinuedAsNewEventAttributes().GetInput()
+	s.True(strings.HasPrefix(
+		string(currentInput.Payloads[0].GetData()),
+		payloadstore.RunKeyPrefix(tests.NamespaceID, tests.WorkflowID, tests.RunID),
+	))
+	newInput := request.NewWorkflowEvents[0].Events[0].GetWorkflowExecutionStartedEventAttributes().GetInput()
+	s.True(strings.HasPrefix(
+		string(newInput.Payloads[0].GetData()),
+		payloadstore.RunKeyPrefix(tests.NamespaceID, tests.WorkflowID, newRunID),
+	))
+	s.Equal(currentEvent, request.UpdateWorkflowEvents[0].Events[0])
+}
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/resourcetest"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
@@ -112,6 +113,7 @@ func NewTestContext(
 		saMapperProvider:        resourceTest.GetSearchAttributesMapperProvider(),
 		historyClient:           resourceTest.GetHistoryClient(),
 		archivalMetadata:        resourceTest.GetArchivalMetadata(),
+		payloadSerializer:       resourceTest.GetPayloadSerializer(),
 		hostInfoProvider:        hostInfoProvider,
 	}
 	return &ContextTest{
@@ -133,6 +135,12 @@ func (s *ContextTest) SetEventsCacheForTesting(c events.Cache) {
 	s.eventsCache = c
 }
 
+// SetPayloadStoreForTesting sets s.payloadStore. Only used by tests.
+func (s *ContextTest) SetPayloadStoreForTesting(store payloadstore.Store) {
+	// for testing only, will only be called immediately after initialization
+	s.payloadStore = store
+}
+
 // SetHistoryClientForTesting sets history client. Only used by tests.
 func (s *ContextTest) SetHistoryClientForTesting(client historyservice.HistoryServiceClient) {
 	s.historyClient = client
@@ -25,7 +25,10 @@
 package shard
 
 import (
+	"context"
+
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/namespace"
 )
 
@@ -40,5 +43,6 @@ type (
 		GetShardByNamespaceWorkflow(namespaceID namespace.ID, workflowID string) (Context, error)
 		CloseShardByID(shardID int32)
 		ShardIDs() []int32
+		RefreshLayout(ctx context.Context) (*historyshard.Layout, error)
 	}
 )
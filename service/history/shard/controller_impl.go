@@ -42,11 +42,13 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -80,6 +82,7 @@ type (
 		logger                      log.Logger
 		persistenceExecutionManager persistence.ExecutionManager
 		persistenceShardManager     persistence.ShardManager
+		historyShardResolver        historyshard.Resolver
 		clientBean                  client.Bean
 		historyClient               historyservice.HistoryServiceClient
 		historyServiceResolver      membership.ServiceResolver
@@ -92,6 +95,7 @@ type (
 		saMapperProvider            searchattribute.MapperProvider
 		clusterMetadata             cluster.Metadata
 		archivalMetadata            archiver.ArchivalMetadata
+		payloadStore                payloadstore.Store
 		hostInfoProvider            membership.HostInfoProvider
 		tracer                      trace.Tracer
 	}
@@ -183,10 +187,18 @@ func (c *ControllerImpl) GetShardByNamespaceWorkflow(
 	namespaceID namespace.ID,
 	workflowID string,
 ) (Context, error) {
-	shardID := c.config.GetShardID(namespaceID, workflowID)
+	shardID, err := c.historyShardResolver.WorkflowShardID(namespaceID, workflowID)
+	if err != nil {
+		return nil, err
+	}
 	return c.GetShardByID(shardID)
 }
 
+// RefreshLayout reloads the history shard layout from cluster metadata.
+func (c *ControllerImpl) RefreshLayout(ctx context.Context) (*historyshard.Layout, error) {
+	return c.historyShardResolver.Refresh(ctx)
+}
+
 // GetShardByID returns a shard context for the given shard id.
 // The shard context may not have acquired a rangeid lease yet.
 // Callers can use GetEngine on the shard to block on rangeid lease acquisition.
@@ -308,6 +320,7 @@ func (c *ControllerImpl) getOrCreateShardContext(shardID int32) (*ContextImpl, e
 		c.saMapperProvider,
 		c.clusterMetadata,
 		c.archivalMetadata,
+		c.payloadStore,
 		c.hostInfoProvider,
 	)
 	if err != nil {
@@ -381,6 +394,14 @@ func (c *ControllerImpl) acquireShards() {
 		c.taggedMetricsHandler.Timer(metrics.AcquireShardsLatency.GetMetricName()).Record(time.Since(startTime))
 	}()
 
+	// Shards of the target layout need to be acquired once a history shard count migration starts.
+	ctx, cancel := context.WithTimeout(context.Background(), shardIOTimeout)
+	if _, err := c.RefreshLayout(ctx); err != nil {
+		c.contextTaggedLogger.Error("Error refreshing history shard layout", tag.Error(err), tag.OperationFailed)
+	}
+	cancel()
+	numShards := c.historyShardResolver.Layout().MaxShardCount()
+
 	tryAcquire := func(shardID int32) {
 		info, err := c.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
 		if err != nil {
@@ -412,7 +433,7 @@ func (c *ControllerImpl) acquireShards() {
 	}
 
 	concurrency := util.Max(c.config.AcquireShardConcurrency(), 1)
-	shardActionCh := make(chan int32, c.config.NumberOfShards)
+	shardActionCh := make(chan int32, numShards)
 	var wg sync.WaitGroup
 	wg.Add(concurrency)
 	// Spawn workers that would lookup and add/remove shards concurrently.
@@ -431,7 +452,6 @@ func (c *ControllerImpl) acquireShards() {
 	}
 
 	// Submit tasks to the channel.
-	numShards := c.config.NumberOfShards
 	randomStartOffset := rand.Int31n(numShards)
 LoopSubmit:
 	for index := int32(0); index < numShards; index++ {
@@ -468,7 +488,7 @@ func (c *ControllerImpl) validateShardId(shardID int32) error {
 	if shardID <= 0 {
 		return invalidShardIdLowerBound
 	}
-	if shardID > c.config.NumberOfShards {
+	if shardID > c.historyShardResolver.Layout().MaxShardCount() {
 		return invalidShardIdUpperBound
 	}
 	return nil
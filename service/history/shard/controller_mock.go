@@ -29,10 +29,12 @@
 package shard
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	common "go.temporal.io/server/common"
+	historyshard "go.temporal.io/server/common/historyshard"
 	namespace "go.temporal.io/server/common/namespace"
 )
 
@@ -115,6 +117,21 @@ func (mr *MockControllerMockRecorder) GetShardByNamespaceWorkflow(namespaceID, w
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardByNamespaceWorkflow", reflect.TypeOf((*MockController)(nil).GetShardByNamespaceWorkflow), namespaceID, workflowID)
 }
 
+// RefreshLayout mocks base method.
+func (m *MockController) RefreshLayout(ctx context.Context) (*historyshard.Layout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshLayout", ctx)
+	ret0, _ := ret[0].(*historyshard.Layout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshLayout indicates an expected call of RefreshLayout.
+func (mr *MockControllerMockRecorder) RefreshLayout(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshLayout", reflect.TypeOf((*MockController)(nil).RefreshLayout), ctx)
+}
+
 // ShardIDs mocks base method.
 func (m *MockController) ShardIDs() []int32 {
 	m.ctrl.T.Helper()
@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -94,6 +95,7 @@ func NewTestController(
 		contextTaggedLogger:         log.With(resource.GetLogger(), tag.ComponentShardController, tag.Address(resource.GetHostInfo().Identity())),
 		persistenceExecutionManager: resource.GetExecutionManager(),
 		persistenceShardManager:     resource.GetShardManager(),
+		historyShardResolver:        historyshard.NewResolver(resource.GetClusterMetadataManager(), config.NumberOfShards, resource.GetTimeSource(), resource.GetLogger()),
 		clientBean:                  resource.GetClientBean(),
 		historyClient:               resource.GetHistoryClient(),
 		historyServiceResolver:      resource.GetHistoryServiceResolver(),
@@ -138,6 +140,13 @@ func (s *controllerSuite) SetupTest() {
 
 	s.logger = s.mockResource.Logger
 	s.config = tests.NewDynamicConfig()
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetCurrentClusterMetadata(gomock.Any()).DoAndReturn(
+		func(_ context.Context) (*persistence.GetClusterMetadataResponse, error) {
+			return &persistence.GetClusterMetadataResponse{
+				ClusterMetadata: persistencespb.ClusterMetadata{HistoryShardCount: s.config.NumberOfShards},
+			}, nil
+		},
+	).AnyTimes()
 
 	s.shardController = NewTestController(
 		s.mockEngineFactory,
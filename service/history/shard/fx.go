@@ -34,10 +34,12 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -59,6 +61,7 @@ func ControllerProvider(
 	throttledLogger log.ThrottledLogger,
 	persistenceExecutionManager persistence.ExecutionManager,
 	persistenceShardManager persistence.ShardManager,
+	historyShardResolver historyshard.Resolver,
 	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
 	historyServiceResolver membership.ServiceResolver,
@@ -70,6 +73,7 @@ func ControllerProvider(
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	payloadStore payloadstore.Store,
 	hostInfoProvider membership.HostInfoProvider,
 	engineFactory EngineFactory,
 	tracerProvider trace.TracerProvider,
@@ -85,6 +89,7 @@ func ControllerProvider(
 		config:                      config,
 		persistenceExecutionManager: persistenceExecutionManager,
 		persistenceShardManager:     persistenceShardManager,
+		historyShardResolver:        historyShardResolver,
 		clientBean:                  clientBean,
 		historyClient:               historyClient,
 		historyServiceResolver:      historyServiceResolver,
@@ -97,6 +102,7 @@ func ControllerProvider(
 		saMapperProvider:            saMapperProvider,
 		clusterMetadata:             clusterMetadata,
 		archivalMetadata:            archivalMetadata,
+		payloadStore:                payloadStore,
 		hostInfoProvider:            hostInfoProvider,
 		engineFactory:               engineFactory,
 		tracer:                      tracerProvider.Tracer(consts.LibraryName),
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/events"
+)
+
+// claimWorkflowSnapshot claims the offloaded payloads of a run created or overwritten by a write, so that they
+// can be deleted with the run, see payloadstore.Claim.
+func (s *ContextImpl) claimWorkflowSnapshot(
+	ctx context.Context,
+	snapshot *persistence.WorkflowSnapshot,
+	eventsSeq []*persistence.WorkflowEvents,
+	shared bool,
+) error {
+	if s.payloadStore == nil || snapshot == nil {
+		return nil
+	}
+	if err := payloadstore.ClaimMutableState(
+		ctx,
+		s.payloadStore,
+		snapshot.ExecutionInfo,
+		snapshot.ExecutionState.GetRunId(),
+		snapshot.ActivityInfos,
+	); err != nil {
+		return err
+	}
+	return s.claimWorkflowEvents(ctx, eventsSeq, shared)
+}
+
+// claimWorkflowMutation claims the offloaded payloads of the current run of a write.
+func (s *ContextImpl) claimWorkflowMutation(
+	ctx context.Context,
+	mutation *persistence.WorkflowMutation,
+	eventsSeq []*persistence.WorkflowEvents,
+) error {
+	if s.payloadStore == nil || mutation == nil {
+		return nil
+	}
+	if err := payloadstore.ClaimMutableState(
+		ctx,
+		s.payloadStore,
+		mutation.ExecutionInfo,
+		mutation.ExecutionState.GetRunId(),
+		mutation.UpsertActivityInfos,
+	); err != nil {
+		return err
+	}
+	for _, event := range mutation.NewBufferedEvents {
+		if err := payloadstore.Claim(
+			ctx,
+			s.payloadStore,
+			event,
+			namespace.ID(mutation.ExecutionInfo.GetNamespaceId()),
+			mutation.ExecutionInfo.GetWorkflowId(),
+			mutation.ExecutionState.GetRunId(),
+		); err != nil {
+			return err
+		}
+	}
+	return s.claimWorkflowEvents(ctx, eventsSeq, false)
+}
+
+// claimWorkflowEvents claims the offloaded payloads of events in place, unless they are shared with another
+// run, e.g. the events of a continue as new run share their input with the current run. Shared events are
+// claimed on copies which replace the originals within the request and the events cache.
+func (s *ContextImpl) claimWorkflowEvents(
+	ctx context.Context,
+	eventsSeq []*persistence.WorkflowEvents,
+	shared bool,
+) error {
+	if s.payloadStore == nil {
+		return nil
+	}
+	for _, workflowEvents := range eventsSeq {
+		namespaceID := namespace.ID(workflowEvents.NamespaceID)
+		for i, event := range workflowEvents.Events {
+			if !payloadstore.NeedsClaim(ctx, event, namespaceID, workflowEvents.WorkflowID, workflowEvents.RunID) {
+				continue
+			}
+			if shared {
+				event = proto.Clone(event).(*historypb.HistoryEvent)
+			}
+			if err := payloadstore.Claim(
+				ctx,
+				s.payloadStore,
+				event,
+				namespaceID,
+				workflowEvents.WorkflowID,
+				workflowEvents.RunID,
+			); err != nil {
+				return err
+			}
+			if shared {
+				workflowEvents.Events[i] = event
+				s.eventsCache.PutEvent(events.EventKey{
+					NamespaceID: namespaceID,
+					WorkflowID:  workflowEvents.WorkflowID,
+					RunID:       workflowEvents.RunID,
+					EventID:     event.GetEventId(),
+					Version:     event.GetVersion(),
+				}, event)
+			}
+		}
+	}
+	return nil
+}
@@ -41,6 +41,7 @@ type (
 		EventID             int64
 		Version             int64
 		Attempt             int32
+		Stamp               int32
 	}
 )
 
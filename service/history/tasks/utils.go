@@ -92,6 +92,8 @@ func GetTransferTaskEventID(
 		eventID = task.InitiatedEventID
 	case *ResetWorkflowTask:
 		eventID = common.FirstEventID
+	case *CompletionCallbackTask:
+		eventID = common.FirstEventID
 	case *FakeTask:
 		// no-op
 	default:
@@ -120,6 +122,8 @@ func GetTimerTaskEventID(
 		eventID = common.FirstEventID
 	case *DeleteHistoryEventTask:
 		eventID = common.FirstEventID
+	case *CompletionCallbackBackoffTask:
+		eventID = common.FirstEventID
 	case *FakeTask:
 		// no-op
 	default:
@@ -124,6 +124,8 @@ func (t *timerQueueActiveTaskExecutor) Execute(
 		err = t.executeWorkflowBackoffTimerTask(ctx, task)
 	case *tasks.DeleteHistoryEventTask:
 		err = t.executeDeleteHistoryEventTask(ctx, task)
+	case *tasks.CompletionCallbackBackoffTask:
+		err = t.executeCompletionCallbackBackoffTask(ctx, task)
 	default:
 		err = errUnknownTimerTask
 	}
@@ -151,6 +153,10 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// user timer tasks will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -204,6 +210,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity timer tasks will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
@@ -312,6 +322,10 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
 	if workflowTask == nil {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() && workflowTask.Type != enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE {
+		// workflow task timeout tasks will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	if workflowTask.Type == enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE {
 		// Check if mutable state still points to this task.
@@ -436,7 +450,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 
 	// generate activity task
 	activityInfo, ok := mutableState.GetActivityInfo(task.EventID)
-	if !ok || task.Attempt < activityInfo.Attempt || activityInfo.StartedEventId != common.EmptyEventID {
+	if !ok || task.Attempt < activityInfo.Attempt || task.Stamp != activityInfo.Stamp || activityInfo.StartedEventId != common.EmptyEventID {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowId),
@@ -454,12 +468,19 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity task will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	taskQueue := &taskqueuepb.TaskQueue{
 		Name: activityInfo.TaskQueue,
 		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
 	}
 	scheduleToStartTimeout := timestamp.DurationValue(activityInfo.ScheduleToStartTimeout)
+	priority := workflow.ActivityTaskPriority(t.config, mutableState, activityInfo)
+	fairnessKey := workflow.TaskFairnessKey(t.config, mutableState)
+	workflowTypeName := mutableState.GetExecutionInfo().WorkflowTypeName
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -474,11 +495,62 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduledEventId:       task.EventID,
 		ScheduleToStartTimeout: timestamp.DurationPtr(scheduleToStartTimeout),
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), task.TaskID),
+		Priority:               priority,
+		FairnessKey:            fairnessKey,
+		WorkflowTypeName:       workflowTypeName,
 	})
 
 	return retError
 }
 
+func (t *timerQueueActiveTaskExecutor) executeCompletionCallbackBackoffTask(
+	ctx context.Context,
+	task *tasks.CompletionCallbackBackoffTask,
+) (retError error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.cache, task)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(ctx, weContext, task, t.metricHandler, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	callbacks := mutableState.GetExecutionInfo().CompletionCallbacks
+	if int(task.CallbackIndex) >= len(callbacks) {
+		return nil
+	}
+	info := callbacks[task.CallbackIndex]
+	if info.State != enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF || task.Attempt != info.Attempt {
+		return nil
+	}
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	err = CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), lastWriteVersion, task.Version, task)
+	if err != nil {
+		return err
+	}
+
+	info.State = enumsspb.COMPLETION_CALLBACK_STATE_STANDBY
+	mutableState.AddTasks(&tasks.CompletionCallbackTask{
+		// TaskID, VisibilityTimestamp is set by shard
+		WorkflowKey:   task.WorkflowKey,
+		Version:       lastWriteVersion,
+		CallbackIndex: task.CallbackIndex,
+	})
+	return weContext.SetWorkflowExecution(ctx)
+}
+
 func (t *timerQueueActiveTaskExecutor) executeWorkflowTimeoutTask(
 	ctx context.Context,
 	task *tasks.WorkflowTimeoutTask,
@@ -180,6 +180,7 @@ func (f *timerQueueFactory) CreateQueue(
 		rescheduler,
 		f.HostPriorityAssigner,
 		executor,
+		newQueueDLQWriter(shard, f.HistoryTaskDLQ, f.Config),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.TimerTaskBatchSize,
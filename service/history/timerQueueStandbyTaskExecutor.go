@@ -115,6 +115,9 @@ func (t *timerQueueStandbyTaskExecutor) Execute(
 		err = t.executeWorkflowTimeoutTask(ctx, task)
 	case *tasks.DeleteHistoryEventTask:
 		err = t.executeDeleteHistoryEventTask(ctx, task)
+	case *tasks.CompletionCallbackBackoffTask:
+		// completion callbacks are only delivered by the active cluster
+		err = nil
 	default:
 		err = errUnknownTimerTask
 	}
@@ -280,7 +283,7 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 			return nil, err
 		}
 
-		if activityInfo.Attempt > task.Attempt {
+		if activityInfo.Attempt > task.Attempt || activityInfo.Stamp != task.Stamp {
 			return nil, nil
 		}
 
@@ -288,7 +291,13 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 			return nil, nil
 		}
 
-		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, *activityInfo.ScheduleToStartTimeout)
+		return newActivityRetryTimePostActionInfo(
+			mutableState,
+			activityInfo.TaskQueue,
+			*activityInfo.ScheduleToStartTimeout,
+			workflow.ActivityTaskPriority(t.config, mutableState, activityInfo),
+			workflow.TaskFairnessKey(t.config, mutableState),
+		)
 	}
 
 	return t.processTimer(
@@ -570,6 +579,9 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		ScheduledEventId:       activityTask.EventID,
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), activityTask.TaskID),
+		Priority:               pushActivityInfo.priority,
+		FairnessKey:            pushActivityInfo.fairnessKey,
+		WorkflowTypeName:       pushActivityInfo.workflowTypeName,
 	})
 	return err
 }
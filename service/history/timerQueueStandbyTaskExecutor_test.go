@@ -1468,6 +1468,7 @@ func (s *timerQueueStandbyTaskExecutorSuite) TestProcessActivityRetryTimer_Pendi
 			ScheduledEventId:       scheduledEvent.EventId,
 			ScheduleToStartTimeout: &timerTimeout,
 			Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), timerTask.TaskID),
+			WorkflowTypeName:       mutableState.GetExecutionInfo().WorkflowTypeName,
 		},
 		gomock.Any(),
 	).Return(&matchingservice.AddActivityTaskResponse{}, nil)
@@ -1504,6 +1505,7 @@ func (s *timerQueueStandbyTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
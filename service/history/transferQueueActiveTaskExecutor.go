@@ -27,6 +27,8 @@ package history
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -38,11 +40,13 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 
 	clockspb "go.temporal.io/server/api/clock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/completioncallback"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -53,6 +57,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/sdk"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/ndc"
@@ -72,6 +77,8 @@ type (
 
 		workflowResetter        ndc.WorkflowResetter
 		parentClosePolicyClient parentclosepolicy.Client
+		callbackHTTPClient      *http.Client
+		callbackDispatcher      ctasks.Scheduler[*completionCallbackDeliveryTask]
 	}
 )
 
@@ -85,6 +92,8 @@ func newTransferQueueActiveTaskExecutor(
 	config *configs.Config,
 	matchingClient matchingservice.MatchingServiceClient,
 	visibilityManager manager.VisibilityManager,
+	callbackHTTPClient *http.Client,
+	callbackDispatcher ctasks.Scheduler[*completionCallbackDeliveryTask],
 ) queues.Executor {
 	return &transferQueueActiveTaskExecutor{
 		transferQueueTaskExecutorBase: newTransferQueueTaskExecutorBase(
@@ -107,6 +116,8 @@ func newTransferQueueActiveTaskExecutor(
 			sdkClientFactory,
 			config.NumParentClosePolicySystemWorkflows(),
 		),
+		callbackHTTPClient: callbackHTTPClient,
+		callbackDispatcher: callbackDispatcher,
 	}
 }
 
@@ -152,6 +163,8 @@ func (t *transferQueueActiveTaskExecutor) Execute(
 		err = t.processResetWorkflow(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task)
+	case *tasks.CompletionCallbackTask:
+		err = t.processCompletionCallbackTask(ctx, task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -194,14 +207,26 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	if err != nil {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// activity task will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
+	var notBefore *time.Time
+	if ai.ScheduledTime.After(t.shard.GetTimeSource().Now()) {
+		// activity retry which is delayed by matching
+		notBefore = ai.ScheduledTime
+	}
+	priority := workflow.ActivityTaskPriority(t.config, mutableState, ai)
+	fairnessKey := workflow.TaskFairnessKey(t.config, mutableState)
+	workflowTypeName := mutableState.GetExecutionInfo().WorkflowTypeName
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, &timeout)
+	return t.pushActivity(ctx, task, &timeout, notBefore, priority, fairnessKey, workflowTypeName)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -233,6 +258,10 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	if err != nil {
 		return err
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// workflow task will be regenerated when workflow execution is unpaused
+		return nil
+	}
 
 	// Task queue from transfer task (not current one from mutable state) must be used here.
 	// If current task queue becomes sticky since this transfer task was created,
@@ -241,13 +270,17 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 	taskQueue, scheduleToStartTimeout := mutableState.TaskQueueScheduleToStartTimeout(transferTask.TaskQueue)
 
 	normalTaskQueueName := mutableState.GetExecutionInfo().TaskQueue
+	priority := workflow.TaskPriority(t.config, mutableState)
+	fairnessKey := workflow.TaskFairnessKey(t.config, mutableState)
+	workflowTypeName := mutableState.GetExecutionInfo().WorkflowTypeName
+	buildIdAssignment := mutableState.GetExecutionInfo().GetBuildIdAssignment()
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
 	// which will call history back (with RecordWorkflowTaskStarted), and it will try to get workflow lock again.
 	release(nil)
 
-	err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout)
+	err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, priority, fairnessKey, workflowTypeName, normalTaskQueueName, buildIdAssignment)
 
 	if _, ok := err.(*serviceerrors.StickyWorkerUnavailable); ok {
 		// sticky worker is unavailable, switch to original normal task queue
@@ -262,7 +295,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout)
+		err = t.pushWorkflowTask(ctx, transferTask, taskQueue, scheduleToStartTimeout, priority, fairnessKey, workflowTypeName, normalTaskQueueName, buildIdAssignment)
 	}
 	return err
 }
@@ -988,6 +1021,215 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 	)
 }
 
+func (t *transferQueueActiveTaskExecutor) processCompletionCallbackTask(
+	ctx context.Context,
+	task *tasks.CompletionCallbackTask,
+) error {
+	request, err := t.prepareCompletionCallback(ctx, task)
+	if err != nil || request == nil {
+		return err
+	}
+
+	// callback endpoints may be slow, deliveries are handed to the completion callback dispatcher so that
+	// they don't hold the transfer queue workers. A delivery which is never recorded, e.g. because the
+	// dispatcher is full, is rescheduled by the backoff task created when preparing the delivery.
+	if !t.callbackDispatcher.TrySubmit(newCompletionCallbackDeliveryTask(t, task, request)) {
+		t.logger.Warn("Completion callback dispatcher is full, delivery is rescheduled",
+			tag.WorkflowNamespaceID(task.GetNamespaceID()),
+			tag.WorkflowID(task.GetWorkflowID()),
+			tag.WorkflowRunID(task.GetRunID()),
+		)
+	}
+	return nil
+}
+
+func (t *transferQueueActiveTaskExecutor) deliverCompletionCallback(
+	ctx context.Context,
+	task *tasks.CompletionCallbackTask,
+	request *completionCallbackRequest,
+) error {
+	callbackCtx, cancel := context.WithTimeout(ctx, t.config.CompletionCallbackTimeout(request.namespaceName))
+	defer cancel()
+	deliveryErr := deliverCompletionCallback(
+		callbackCtx,
+		t.callbackHTTPClient,
+		request.callback,
+		request.body,
+		t.config.CompletionCallbackSigningKey(request.namespaceName),
+	)
+
+	return t.recordCompletionCallbackAttempt(ctx, task, request.attempt, deliveryErr)
+}
+
+func (t *transferQueueActiveTaskExecutor) prepareCompletionCallback(
+	ctx context.Context,
+	task *tasks.CompletionCallbackTask,
+) (_ *completionCallbackRequest, retError error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.cache, task)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, weContext, task, t.metricHandler, t.logger)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil, nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return nil, err
+	}
+	err = CheckTaskVersion(t.shard, t.logger, mutableState.GetNamespaceEntry(), lastWriteVersion, task.Version, task)
+	if err != nil {
+		return nil, err
+	}
+
+	callbacks := mutableState.GetExecutionInfo().CompletionCallbacks
+	if int(task.CallbackIndex) >= len(callbacks) {
+		return nil, nil
+	}
+	info := callbacks[task.CallbackIndex]
+	if info.State != enumsspb.COMPLETION_CALLBACK_STATE_STANDBY {
+		// the callback is either done or its delivery is already scheduled
+		return nil, nil
+	}
+
+	now := t.shard.GetTimeSource().Now()
+	namespaceName := mutableState.GetNamespaceEntry().Name().String()
+	// the callback is validated again as the allowed hosts may have changed since the workflow started
+	if !t.config.EnableCompletionCallbacks(namespaceName) {
+		err = errCompletionCallbacksDisabled
+	} else {
+		err = completioncallback.Validate(info.Callback, t.config.CompletionCallbackAllowedHosts(namespaceName))
+	}
+	if err != nil {
+		info.State = enumsspb.COMPLETION_CALLBACK_STATE_FAILED
+		info.LastAttemptCompleteTime = &now
+		info.LastAttemptFailure = err.Error()
+		return nil, weContext.SetWorkflowExecution(ctx)
+	}
+
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	closeTime, err := mutableState.GetWorkflowCloseTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := newCompletionCallbackBody(
+		namespaceName,
+		task.WorkflowKey,
+		mutableState.GetExecutionState().Status,
+		timestamp.TimeValue(closeTime),
+		completionEvent,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the delivery is rescheduled by this backoff task unless its attempt is recorded before the task fires
+	info.State = enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF
+	mutableState.AddTasks(&tasks.CompletionCallbackBackoffTask{
+		// TaskID is set by shard
+		WorkflowKey:         task.WorkflowKey,
+		VisibilityTimestamp: now.Add(completionCallbackDeliveryGracePeriodMultiplier * t.config.CompletionCallbackTimeout(namespaceName)),
+		Version:             lastWriteVersion,
+		CallbackIndex:       task.CallbackIndex,
+		Attempt:             info.Attempt,
+	})
+	if err := weContext.SetWorkflowExecution(ctx); err != nil {
+		return nil, err
+	}
+
+	return &completionCallbackRequest{
+		namespaceName: namespaceName,
+		callback:      info.Callback,
+		attempt:       info.Attempt,
+		body:          body,
+	}, nil
+}
+
+func (t *transferQueueActiveTaskExecutor) recordCompletionCallbackAttempt(
+	ctx context.Context,
+	task *tasks.CompletionCallbackTask,
+	attempt int32,
+	deliveryErr error,
+) (retError error) {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	weContext, release, err := getWorkflowExecutionContextForTask(ctx, t.cache, task)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(ctx, weContext, task, t.metricHandler, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	callbacks := mutableState.GetExecutionInfo().CompletionCallbacks
+	if int(task.CallbackIndex) >= len(callbacks) {
+		return nil
+	}
+	info := callbacks[task.CallbackIndex]
+	if info.State != enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF || info.Attempt != attempt {
+		// the attempt was already recorded, or considered lost and rescheduled
+		return nil
+	}
+
+	now := t.shard.GetTimeSource().Now()
+	info.Attempt++
+	info.LastAttemptCompleteTime = &now
+	if deliveryErr == nil {
+		info.State = enumsspb.COMPLETION_CALLBACK_STATE_SUCCEEDED
+		info.LastAttemptFailure = ""
+		return weContext.SetWorkflowExecution(ctx)
+	}
+
+	info.LastAttemptFailure = deliveryErr.Error()
+	namespaceName := mutableState.GetNamespaceEntry().Name().String()
+	callbackErr, ok := deliveryErr.(*completionCallbackError)
+	if !ok || !callbackErr.retryable || info.Attempt >= int32(t.config.CompletionCallbackMaxAttempts(namespaceName)) {
+		info.State = enumsspb.COMPLETION_CALLBACK_STATE_FAILED
+		t.logger.Warn("Completion callback failed permanently",
+			tag.WorkflowNamespaceID(task.GetNamespaceID()),
+			tag.WorkflowID(task.GetWorkflowID()),
+			tag.WorkflowRunID(task.GetRunID()),
+			tag.Attempt(info.Attempt),
+			tag.Error(deliveryErr),
+		)
+		return weContext.SetWorkflowExecution(ctx)
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	info.State = enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF
+	mutableState.AddTasks(&tasks.CompletionCallbackBackoffTask{
+		// TaskID is set by shard
+		WorkflowKey:         task.WorkflowKey,
+		VisibilityTimestamp: now.Add(completionCallbackBackoff(info.Attempt)),
+		Version:             lastWriteVersion,
+		CallbackIndex:       task.CallbackIndex,
+		Attempt:             info.Attempt,
+	})
+	return weContext.SetWorkflowExecution(ctx)
+}
+
 func (t *transferQueueActiveTaskExecutor) recordChildExecutionStarted(
 	ctx context.Context,
 	task *tasks.StartChildExecutionTask,
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"testing"
 	"time"
 
@@ -45,6 +46,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -70,6 +72,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -106,6 +109,7 @@ type (
 		mockArchivalMetadata        archiver.MetadataMock
 		mockArchiverProvider        *provider.MockArchiverProvider
 		mockParentClosePolicyClient *parentclosepolicy.MockClient
+		mockCallbackDispatcher      *ctasks.MockScheduler[*completionCallbackDeliveryTask]
 
 		workflowCache                   wcache.Cache
 		logger                          log.Logger
@@ -182,6 +186,7 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 	))
 
 	s.mockParentClosePolicyClient = parentclosepolicy.NewMockClient(s.controller)
+	s.mockCallbackDispatcher = ctasks.NewMockScheduler[*completionCallbackDeliveryTask](s.controller)
 	s.mockArchivalClient = warchiver.NewMockClient(s.controller)
 	s.mockMatchingClient = s.mockShard.Resource.MatchingClient
 	s.mockHistoryClient = s.mockShard.Resource.HistoryClient
@@ -240,6 +245,8 @@ func (s *transferQueueActiveTaskExecutorSuite) SetupTest() {
 		config,
 		s.mockShard.Resource.MatchingClient,
 		s.mockVisibilityManager,
+		http.DefaultClient,
+		s.mockCallbackDispatcher,
 	).(*transferQueueActiveTaskExecutor)
 	s.transferQueueActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
 }
@@ -301,7 +308,7 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessActivityTask_Success()
 
 	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
 	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), s.createAddActivityTaskRequest(transferTask, ai), gomock.Any()).Return(&matchingservice.AddActivityTaskResponse{}, nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), s.createAddActivityTaskRequest(transferTask, ai, mutableState), gomock.Any()).Return(&matchingservice.AddActivityTaskResponse{}, nil)
 
 	_, _, err = s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
 	s.Nil(err)
@@ -367,6 +374,63 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessActivityTask_Duplicati
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessActivityTask_Paused() {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	taskID := int64(59)
+	activityID := "activity-1"
+	activityType := "some random activity type"
+	event, _ = addActivityTaskScheduledEvent(mutableState, event.GetEventId(), activityID, activityType, taskQueueName, &commonpb.Payloads{}, 1*time.Second, 1*time.Second, 1*time.Second, 1*time.Second)
+
+	transferTask := &tasks.ActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              taskID,
+		TaskQueue:           taskQueueName,
+		ScheduledEventID:    event.GetEventId(),
+		VisibilityTimestamp: time.Now().UTC(),
+	}
+
+	_, err = mutableState.AddWorkflowExecutionPausedEvent("some random identity", "some random reason")
+	s.NoError(err)
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	_, _, err = s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessWorkflowTask_FirstWorkflowTask() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -1396,6 +1460,104 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_DeleteA
 	s.NoError(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessCompletionCallback_Dispatched() {
+	s.mockShard.GetConfig().EnableCompletionCallbacks = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockShard.GetConfig().CompletionCallbackAllowedHosts = func(string) string { return "*.example.com" }
+
+	transferTask, persistenceMutableState := s.completedWorkflowWithCompletionCallback("https://callback.example.com/complete")
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	var request *persistence.SetWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().SetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *persistence.SetWorkflowExecutionRequest) (*persistence.SetWorkflowExecutionResponse, error) {
+			request = r
+			return &persistence.SetWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.mockCallbackDispatcher.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(
+		func(task *completionCallbackDeliveryTask) bool {
+			s.Equal(int32(0), task.request.attempt)
+			s.Equal("https://callback.example.com/complete", task.request.callback.GetUrl())
+			return true
+		},
+	)
+
+	_, _, err := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(err)
+	info := request.SetWorkflowSnapshot.ExecutionInfo.CompletionCallbacks[0]
+	s.Equal(enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF, info.State)
+	s.Len(request.SetWorkflowSnapshot.Tasks[tasks.CategoryTimer], 1)
+	backoffTask, ok := request.SetWorkflowSnapshot.Tasks[tasks.CategoryTimer][0].(*tasks.CompletionCallbackBackoffTask)
+	s.True(ok)
+	s.Equal(int32(0), backoffTask.Attempt)
+}
+
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessCompletionCallback_HostNotAllowed() {
+	s.mockShard.GetConfig().EnableCompletionCallbacks = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	s.mockShard.GetConfig().CompletionCallbackAllowedHosts = func(string) string { return "callback.example.com" }
+
+	transferTask, persistenceMutableState := s.completedWorkflowWithCompletionCallback("http://169.254.169.254/latest")
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	var request *persistence.SetWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().SetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *persistence.SetWorkflowExecutionRequest) (*persistence.SetWorkflowExecutionResponse, error) {
+			request = r
+			return &persistence.SetWorkflowExecutionResponse{}, nil
+		},
+	)
+
+	_, _, err := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(err)
+	info := request.SetWorkflowSnapshot.ExecutionInfo.CompletionCallbacks[0]
+	s.Equal(enumsspb.COMPLETION_CALLBACK_STATE_FAILED, info.State)
+	s.Contains(info.LastAttemptFailure, "is not allowed")
+}
+
+func (s *transferQueueActiveTaskExecutorSuite) completedWorkflowWithCompletionCallback(
+	callbackURL string,
+) (*tasks.CompletionCallbackTask, *persistencespb.WorkflowMutableState) {
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+			CompletionCallbacks: []*workflowspb.CompletionCallback{{Url: callbackURL}},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+
+	transferTask := &tasks.CompletionCallbackTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              int64(59),
+		VisibilityTimestamp: time.Now().UTC(),
+	}
+	return transferTask, s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessCancelExecution_Success() {
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -2485,6 +2647,7 @@ func (s *transferQueueActiveTaskExecutorSuite) TestCopySearchAttributes() {
 func (s *transferQueueActiveTaskExecutorSuite) createAddActivityTaskRequest(
 	task *tasks.ActivityTask,
 	ai *persistencespb.ActivityInfo,
+	mutableState workflow.MutableState,
 ) *matchingservice.AddActivityTaskRequest {
 	return &matchingservice.AddActivityTaskRequest{
 		NamespaceId: task.NamespaceID,
@@ -2499,6 +2662,7 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddActivityTaskRequest(
 		ScheduledEventId:       task.ScheduledEventID,
 		ScheduleToStartTimeout: ai.ScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
+		WorkflowTypeName:       mutableState.GetExecutionInfo().WorkflowTypeName,
 	}
 }
 
@@ -2639,9 +2803,11 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddWorkflowTaskRequest(
 	}
 	executionInfo := mutableState.GetExecutionInfo()
 	timeout := executionInfo.WorkflowRunTimeout
+	var normalTaskQueueName string
 	if mutableState.GetExecutionInfo().TaskQueue != task.TaskQueue {
 		taskQueue.Kind = enumspb.TASK_QUEUE_KIND_STICKY
 		timeout = executionInfo.StickyScheduleToStartTimeout
+		normalTaskQueueName = executionInfo.TaskQueue
 	}
 
 	return &matchingservice.AddWorkflowTaskRequest{
@@ -2654,6 +2820,8 @@ func (s *transferQueueActiveTaskExecutorSuite) createAddWorkflowTaskRequest(
 		ScheduledEventId:       task.ScheduledEventID,
 		ScheduleToStartTimeout: timeout,
 		Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), task.TaskID),
+		WorkflowTypeName:       executionInfo.WorkflowTypeName,
+		NormalTaskQueue:        normalTaskQueueName,
 	}
 }
 
@@ -2793,6 +2961,7 @@ func (s *transferQueueActiveTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
@@ -26,17 +26,20 @@ package history
 
 import (
 	"context"
+	"net/http"
 
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/completioncallback"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
+	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
@@ -66,6 +69,9 @@ type (
 	transferQueueFactory struct {
 		transferQueueFactoryParams
 		QueueFactoryBase
+
+		callbackHTTPClient *http.Client
+		callbackDispatcher ctasks.Scheduler[*completionCallbackDeliveryTask]
 	}
 )
 
@@ -101,9 +107,21 @@ func NewTransferQueueFactory(
 				int64(params.Config.QueueMaxReaderCount()),
 			),
 		},
+		callbackHTTPClient: completioncallback.NewHTTPClient(),
+		callbackDispatcher: newCompletionCallbackDispatcher(params.Config, params.Logger),
 	}
 }
 
+func (f *transferQueueFactory) Start() {
+	f.QueueFactoryBase.Start()
+	f.callbackDispatcher.Start()
+}
+
+func (f *transferQueueFactory) Stop() {
+	f.callbackDispatcher.Stop()
+	f.QueueFactoryBase.Stop()
+}
+
 func (f *transferQueueFactory) CreateQueue(
 	shard shard.Context,
 	workflowCache wcache.Cache,
@@ -129,6 +147,8 @@ func (f *transferQueueFactory) CreateQueue(
 		f.Config,
 		f.MatchingClient,
 		f.VisibilityManager,
+		f.callbackHTTPClient,
+		f.callbackDispatcher,
 	)
 
 	standbyExecutor := newTransferQueueStandbyTaskExecutor(
@@ -171,6 +191,7 @@ func (f *transferQueueFactory) CreateQueue(
 		rescheduler,
 		f.HostPriorityAssigner,
 		executor,
+		newQueueDLQWriter(shard, f.HistoryTaskDLQ, f.Config),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.TransferTaskBatchSize,
@@ -125,6 +125,9 @@ func (t *transferQueueStandbyTaskExecutor) Execute(
 		err = t.processCloseExecution(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task, false)
+	case *tasks.CompletionCallbackTask:
+		// completion callbacks are only delivered by the active cluster
+		err = nil
 	default:
 		err = errUnknownTransferTask
 	}
@@ -149,7 +152,19 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, *activityInfo.ScheduleToStartTimeout)
+			var notBefore *time.Time
+			if activityInfo.ScheduledTime.After(t.getCurrentTime()) {
+				// activity retry which is delayed by matching of the active cluster, it is verified like any
+				// pending activity and pushed to matching with the delay if it is still pending then
+				notBefore = activityInfo.ScheduledTime
+			}
+			return newActivityTaskPostActionInfo(
+				mutableState,
+				*activityInfo.ScheduleToStartTimeout,
+				notBefore,
+				workflow.ActivityTaskPriority(t.config, mutableState, activityInfo),
+				workflow.TaskFairnessKey(t.config, mutableState),
+			)
 		}
 
 		return nil, nil
@@ -202,6 +217,8 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 				mutableState,
 				scheduleToStartTimeout,
 				*taskQueue,
+				workflow.TaskPriority(t.config, mutableState),
+				workflow.TaskFairnessKey(t.config, mutableState),
 			)
 		}
 
@@ -551,6 +568,10 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*tasks.ActivityTask),
 		&timeout,
+		pushActivityInfo.notBefore,
+		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
+		pushActivityInfo.workflowTypeName,
 	)
 }
 
@@ -570,6 +591,11 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		task.(*tasks.WorkflowTask),
 		&pushwtInfo.taskqueue,
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
+		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
+		pushwtInfo.workflowTypeName,
+		"",
+		pushwtInfo.buildIdAssignment,
 	)
 }
 
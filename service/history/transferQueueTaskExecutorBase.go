@@ -35,6 +35,7 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/log"
@@ -119,6 +120,10 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	ctx context.Context,
 	task *tasks.ActivityTask,
 	activityScheduleToStartTimeout *time.Duration,
+	notBefore *time.Time,
+	priority int32,
+	fairnessKey string,
+	workflowTypeName string,
 ) error {
 	_, err := t.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
 		NamespaceId: task.NamespaceID,
@@ -133,6 +138,10 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		ScheduledEventId:       task.ScheduledEventID,
 		ScheduleToStartTimeout: activityScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), task.TaskID),
+		Priority:               priority,
+		FairnessKey:            fairnessKey,
+		WorkflowTypeName:       workflowTypeName,
+		NotBefore:              notBefore,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -148,7 +157,15 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	task *tasks.WorkflowTask,
 	taskqueue *taskqueuepb.TaskQueue,
 	workflowTaskScheduleToStartTimeout *time.Duration,
+	priority int32,
+	fairnessKey string,
+	workflowTypeName string,
+	normalTaskQueueName string,
+	buildIdAssignment *persistencespb.BuildIdAssignment,
 ) error {
+	if taskqueue.GetKind() != enumspb.TASK_QUEUE_KIND_STICKY {
+		normalTaskQueueName = ""
+	}
 	_, err := t.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
 		NamespaceId: task.NamespaceID,
 		Execution: &commonpb.WorkflowExecution{
@@ -159,6 +176,11 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 		ScheduledEventId:       task.ScheduledEventID,
 		ScheduleToStartTimeout: workflowTaskScheduleToStartTimeout,
 		Clock:                  vclock.NewVectorClock(t.shard.GetClusterMetadata().GetClusterID(), t.shard.GetShardID(), task.TaskID),
+		Priority:               priority,
+		FairnessKey:            fairnessKey,
+		WorkflowTypeName:       workflowTypeName,
+		NormalTaskQueue:        normalTaskQueueName,
+		BuildIdAssignment:      buildIdAssignment,
 	})
 	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
 		// NotFound error is not expected for AddTasks calls
@@ -122,6 +122,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		rescheduler,
 		f.HostPriorityAssigner,
 		executor,
+		newQueueDLQWriter(shard, f.HistoryTaskDLQ, f.Config),
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
 				BatchSize:            f.Config.VisibilityTaskBatchSize,
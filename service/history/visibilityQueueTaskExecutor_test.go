@@ -617,6 +617,7 @@ func (s *visibilityQueueTaskExecutorSuite) newTaskExecutable(
 		s.mockShard.GetNamespaceRegistry(),
 		s.mockShard.GetClusterMetadata(),
 		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
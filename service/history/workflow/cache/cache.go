@@ -77,6 +77,7 @@ type (
 		logger         log.Logger
 		metricsHandler metrics.Handler
 		config         *configs.Config
+		limitSizeBased bool
 	}
 
 	NewCacheFn func(shard shard.Context) Cache
@@ -96,12 +97,26 @@ func NewCache(shard shard.Context) Cache {
 	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
 
+	metricsHandler := shard.GetMetricsHandler().WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue))
+	opts.EvictedFunc = func(interface{}) {
+		metricsHandler.Counter(metrics.CacheEvictions.GetMetricName()).Record(1)
+	}
+
+	// the cache belongs to a single shard so the limits apply per shard
+	maxSize := config.HistoryCacheMaxSize()
+	limitSizeBased := config.HistoryCacheLimitSizeBased()
+	if limitSizeBased {
+		opts.LimitSizeBased = true
+		maxSize = config.HistoryCacheMaxSizeBytes()
+	}
+
 	return &CacheImpl{
-		Cache:          cache.New(config.HistoryCacheMaxSize(), opts),
+		Cache:          cache.New(maxSize, opts),
 		shard:          shard,
 		logger:         log.With(shard.GetLogger(), tag.ComponentHistoryCache),
-		metricsHandler: shard.GetMetricsHandler().WithTags(metrics.CacheTypeTag(metrics.MutableStateCacheTypeTagValue)),
+		metricsHandler: metricsHandler,
 		config:         config,
+		limitSizeBased: limitSizeBased,
 	}
 }
 
@@ -231,6 +246,9 @@ func (c *CacheImpl) makeReleaseFunc(
 				context.Unlock(lockPriority)
 				c.Release(key)
 			}
+			if c.limitSizeBased {
+				c.metricsHandler.Gauge(metrics.CacheUsage.GetMetricName()).Record(float64(c.Size()))
+			}
 		}
 	}
 }
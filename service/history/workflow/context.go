@@ -29,6 +29,7 @@ package workflow
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -158,6 +159,8 @@ type (
 		MutableState   MutableState
 		updateRegistry update.Registry
 		stats          *persistencespb.ExecutionStats
+		// cacheSize is updated while holding the lock and read by the cache without it
+		cacheSize int64
 	}
 )
 
@@ -201,6 +204,9 @@ func (c *ContextImpl) Lock(
 func (c *ContextImpl) Unlock(
 	lockPriority LockPriority,
 ) {
+	if c.config.HistoryCacheLimitSizeBased() {
+		c.updateCacheSize()
+	}
 	switch lockPriority {
 	case LockPriorityHigh:
 		c.mutex.UnlockHigh()
@@ -222,6 +228,19 @@ func (c *ContextImpl) Clear() {
 	}
 }
 
+// CacheSize returns the approximate size in bytes of the workflow context as of the last time it was unlocked.
+func (c *ContextImpl) CacheSize() int {
+	return int(atomic.LoadInt64(&c.cacheSize))
+}
+
+func (c *ContextImpl) updateCacheSize() {
+	size := len(c.workflowKey.NamespaceID) + len(c.workflowKey.WorkflowID) + len(c.workflowKey.RunID)
+	if c.MutableState != nil {
+		size += c.MutableState.GetApproximatePersistedSize()
+	}
+	atomic.StoreInt64(&c.cacheSize, int64(size))
+}
+
 func (c *ContextImpl) GetWorkflowKey() definition.WorkflowKey {
 	return c.workflowKey
 }
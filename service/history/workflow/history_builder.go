@@ -253,6 +253,7 @@ func (b *HistoryBuilder) AddWorkflowTaskCompletedEvent(
 	startedEventID int64,
 	identity string,
 	checksum string,
+	workerVersion *commonpb.WorkerVersionStamp,
 	sdkMetadata *sdkpb.WorkflowTaskCompletedMetadata,
 	meteringMetadata *commonpb.MeteringMetadata,
 ) *historypb.HistoryEvent {
@@ -263,6 +264,7 @@ func (b *HistoryBuilder) AddWorkflowTaskCompletedEvent(
 			StartedEventId:   startedEventID,
 			Identity:         identity,
 			BinaryChecksum:   checksum,
+			WorkerVersion:    workerVersion,
 			SdkMetadata:      sdkMetadata,
 			MeteringMetadata: meteringMetadata,
 		},
@@ -861,6 +863,27 @@ func (b *HistoryBuilder) AddMarkerRecordedEvent(
 	return b.appendEvents(event)
 }
 
+// AddWorkflowExecutionPauseMarkerEvent records a pause or unpause of the workflow execution as a marker
+// with a reserved name. Unlike markers recorded by commands, the marker is always buffered so it never
+// lands inside a started workflow task.
+func (b *HistoryBuilder) AddWorkflowExecutionPauseMarkerEvent(
+	markerName string,
+	details map[string]*commonpb.Payloads,
+) *historypb.HistoryEvent {
+	event := b.createNewBufferedHistoryEvent(enumspb.EVENT_TYPE_MARKER_RECORDED, b.timeSource.Now())
+	event.WorkerMayIgnore = true
+	event.Attributes = &historypb.HistoryEvent_MarkerRecordedEventAttributes{
+		MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+			MarkerName:                   markerName,
+			Details:                      details,
+			WorkflowTaskCompletedEventId: common.EmptyEventID,
+		},
+	}
+
+	b.memBufferBatch = append(b.memBufferBatch, event)
+	return event
+}
+
 func (b *HistoryBuilder) AddWorkflowExecutionSignaledEvent(
 	signalName string,
 	input *commonpb.Payloads,
@@ -1273,6 +1296,21 @@ func (b *HistoryBuilder) assertNotSealed() {
 func (b *HistoryBuilder) createNewHistoryEvent(
 	eventType enumspb.EventType,
 	time time.Time,
+) *historypb.HistoryEvent {
+	return b.createNewHistoryEventWithBuffer(eventType, time, b.bufferEvent(eventType))
+}
+
+func (b *HistoryBuilder) createNewBufferedHistoryEvent(
+	eventType enumspb.EventType,
+	time time.Time,
+) *historypb.HistoryEvent {
+	return b.createNewHistoryEventWithBuffer(eventType, time, true)
+}
+
+func (b *HistoryBuilder) createNewHistoryEventWithBuffer(
+	eventType enumspb.EventType,
+	time time.Time,
+	buffer bool,
 ) *historypb.HistoryEvent {
 	b.assertMutable()
 
@@ -1288,7 +1326,7 @@ func (b *HistoryBuilder) createNewHistoryEvent(
 	historyEvent.EventType = eventType
 	historyEvent.Version = b.version
 
-	if b.bufferEvent(eventType) {
+	if buffer {
 		historyEvent.EventId = common.BufferedEventID
 		historyEvent.TaskId = common.EmptyEventTaskID
 	} else {
@@ -669,6 +669,7 @@ func (s *historyBuilderSuite) TestWorkflowTaskCompleted() {
 	scheduledEventID := rand.Int63()
 	startedEventID := rand.Int63()
 	checksum := "random checksum"
+	workerVersion := &commonpb.WorkerVersionStamp{BuildId: "random build ID"}
 	sdkMetadata := &sdkpb.WorkflowTaskCompletedMetadata{CoreUsedFlags: []uint32{1, 2, 3}, LangUsedFlags: []uint32{4, 5, 6}}
 	meteringMeta := &commonpb.MeteringMetadata{NonfirstLocalActivityExecutionAttempts: 42}
 	event := s.historyBuilder.AddWorkflowTaskCompletedEvent(
@@ -676,6 +677,7 @@ func (s *historyBuilderSuite) TestWorkflowTaskCompleted() {
 		startedEventID,
 		testIdentity,
 		checksum,
+		workerVersion,
 		sdkMetadata,
 		meteringMeta,
 	)
@@ -692,6 +694,7 @@ func (s *historyBuilderSuite) TestWorkflowTaskCompleted() {
 				StartedEventId:   startedEventID,
 				Identity:         testIdentity,
 				BinaryChecksum:   checksum,
+				WorkerVersion:    workerVersion,
 				SdkMetadata:      sdkMetadata,
 				MeteringMetadata: meteringMeta,
 			},
@@ -60,6 +60,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -76,6 +77,10 @@ const (
 
 	mutableStateInvalidHistoryActionMsg         = "invalid history builder state for action"
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v, %v"
+
+	// details of the workflow paused marker
+	workflowPauseIdentityDetail = "identity"
+	workflowPauseReasonDetail   = "reason"
 )
 
 var (
@@ -153,6 +158,10 @@ type (
 		nextEventIDInDB int64
 		// indicates the DB record version, for conditional update
 		dbRecordVersion int64
+		// approximate size of the persisted mutable state in bytes, computed lazily
+		// and valid as long as the state transition count does not change
+		approximateSize                     int
+		approximateSizeStateTransitionCount int64
 		// namespace entry contains a snapshot of namespace
 		// NOTE: do not use the failover version inside, use currentVersion above
 		namespaceEntry *namespace.Namespace
@@ -506,6 +515,44 @@ func (ms *MutableStateImpl) GetExecutionState() *persistencespb.WorkflowExecutio
 	return ms.executionState
 }
 
+// GetApproximatePersistedSize returns an estimate in bytes of the persisted mutable state,
+// used to bound the mutable state cache by memory usage.
+func (ms *MutableStateImpl) GetApproximatePersistedSize() int {
+	if ms.approximateSize != 0 && ms.approximateSizeStateTransitionCount == ms.executionInfo.StateTransitionCount {
+		return ms.approximateSize
+	}
+
+	size := ms.executionInfo.Size() + ms.executionState.Size()
+	for _, activityInfo := range ms.pendingActivityInfoIDs {
+		size += activityInfo.Size()
+	}
+	for _, timerInfo := range ms.pendingTimerInfoIDs {
+		size += timerInfo.Size()
+	}
+	for _, childExecutionInfo := range ms.pendingChildExecutionInfoIDs {
+		size += childExecutionInfo.Size()
+	}
+	for _, requestCancelInfo := range ms.pendingRequestCancelInfoIDs {
+		size += requestCancelInfo.Size()
+	}
+	for _, signalInfo := range ms.pendingSignalInfoIDs {
+		size += signalInfo.Size()
+	}
+	for requestID := range ms.pendingSignalRequestedIDs {
+		size += len(requestID)
+	}
+	for _, updateInfo := range ms.updateInfos {
+		size += updateInfo.Size()
+	}
+	for _, event := range ms.bufferEventsInDB {
+		size += event.Size()
+	}
+
+	ms.approximateSize = size
+	ms.approximateSizeStateTransitionCount = ms.executionInfo.StateTransitionCount
+	return size
+}
+
 func (ms *MutableStateImpl) FlushBufferedEvents() {
 	if ms.HasStartedWorkflowTask() {
 		return
@@ -1183,6 +1230,12 @@ func (ms *MutableStateImpl) ReplicateActivityInfo(
 	ai.Attempt = request.GetAttempt()
 	ai.RetryLastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.RetryLastFailure = request.GetLastFailure()
+	// options are not sent by clusters which don't support updating them
+	if options := request.GetOptions(); options != nil && !ActivityOptionsFromInfo(ai).Equal(options) {
+		applyActivityOptions(ai, options, request.GetRetryExpirationTime())
+		// timeouts may have changed, activity timer tasks will be regenerated based on the new timeouts
+		resetActivityTimerTaskStatus = true
+	}
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1580,6 +1633,8 @@ func (ms *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 		ContinueAsNewInitiator: command.Initiator,
 		// enforce minimal interval between runs to prevent tight loop continue as new spin.
 		FirstWorkflowTaskBackoff: previousExecutionState.ContinueAsNewMinBackoff(command.BackoffStartInterval),
+		// completion callbacks are only invoked when the whole chain of runs is closed
+		CompletionCallbacks: completionCallbacksFromInfos(previousExecutionInfo.CompletionCallbacks),
 	}
 	if command.GetInitiator() == enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY {
 		req.Attempt = previousExecutionState.GetExecutionInfo().Attempt + 1
@@ -1685,6 +1740,8 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 	); err != nil {
 		return nil, err
 	}
+	// completion callbacks are not part of the started event and therefore are not replicated
+	ms.executionInfo.CompletionCallbacks = newCompletionCallbackInfos(startRequest.GetCompletionCallbacks())
 
 	// TODO merge active & passive task generation
 	if err := ms.taskGenerator.GenerateWorkflowStartTasks(
@@ -1735,6 +1792,7 @@ func (ms *MutableStateImpl) ReplicateWorkflowExecutionStartedEvent(
 	ms.executionInfo.WorkflowTaskTimeout = timestamp.DurationFromSeconds(0)
 
 	ms.executionInfo.CronSchedule = event.GetCronSchedule()
+	ms.executionInfo.TaskPriority = headerTaskPriority(ms.config, ms.namespaceEntry.Name(), event.GetHeader())
 
 	if event.ParentWorkflowExecution != nil {
 		ms.executionInfo.ParentNamespaceId = event.GetParentWorkflowNamespaceId()
@@ -1971,6 +2029,47 @@ func (ms *MutableStateImpl) addBinaryCheckSumIfNotExists(
 	return ms.taskGenerator.GenerateUpsertVisibilityTask()
 }
 
+// add the build ID of the worker which completed the workflow task to the BuildIds search attribute, so visibility can
+// tell which workflow executions ran on a build ID
+func (ms *MutableStateImpl) addBuildIdIfNotExists(
+	event *historypb.HistoryEvent,
+	maxBuildIds int,
+) error {
+	buildID := event.GetWorkflowTaskCompletedEventAttributes().GetWorkerVersion().GetBuildId()
+	if len(buildID) == 0 {
+		return nil
+	}
+	exeInfo := ms.executionInfo
+	var buildIDs []string
+	if payload, ok := exeInfo.SearchAttributes[searchattribute.BuildIds]; ok {
+		decoded, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+		if err != nil {
+			return err
+		}
+		buildIDs, _ = decoded.([]string)
+	}
+	for _, id := range buildIDs {
+		if id == buildID {
+			return nil
+		}
+	}
+	if maxBuildIds > 0 && len(buildIDs) >= maxBuildIds {
+		// If exceeding the max limit, do rotation by taking the oldest ones out.
+		buildIDs = buildIDs[len(buildIDs)-maxBuildIds+1:]
+	}
+	buildIDs = append(buildIDs, buildID)
+
+	buildIDsPayload, err := searchattribute.EncodeValue(buildIDs, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload, 1)
+	}
+	exeInfo.SearchAttributes[searchattribute.BuildIds] = buildIDsPayload
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
 // TODO: we will release the restriction when reset API allow those pending
 
 // CheckResettable check if workflow can be reset
@@ -2123,6 +2222,7 @@ func (ms *MutableStateImpl) ReplicateActivityTaskScheduledEvent(
 		TaskQueue:               attributes.TaskQueue.GetName(),
 		HasRetryPolicy:          attributes.RetryPolicy != nil,
 		Attempt:                 1,
+		TaskPriority:            headerTaskPriority(ms.config, ms.namespaceEntry.Name(), attributes.GetHeader()),
 	}
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
@@ -2975,6 +3075,170 @@ func (ms *MutableStateImpl) ReplicateWorkflowPropertiesModifiedEvent(
 	}
 }
 
+func (ms *MutableStateImpl) IsWorkflowExecutionPaused() bool {
+	return ms.executionInfo.PauseInfo != nil
+}
+
+func (ms *MutableStateImpl) AddWorkflowExecutionPausedEvent(
+	identity string,
+	reason string,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := ms.hBuilder.AddWorkflowExecutionPauseMarkerEvent(
+		common.WorkflowPausedMarkerName,
+		map[string]*commonpb.Payloads{
+			workflowPauseIdentityDetail: payloads.EncodeString(identity),
+			workflowPauseReasonDetail:   payloads.EncodeString(reason),
+		},
+	)
+	if err := ms.ReplicateWorkflowExecutionPauseMarkerEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) AddWorkflowExecutionUnpausedEvent() (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := ms.hBuilder.AddWorkflowExecutionPauseMarkerEvent(common.WorkflowUnpausedMarkerName, nil)
+	if err := ms.ReplicateWorkflowExecutionPauseMarkerEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ReplicateWorkflowExecutionPauseMarkerEvent(
+	event *historypb.HistoryEvent,
+) error {
+	attr := event.GetMarkerRecordedEventAttributes()
+	switch attr.GetMarkerName() {
+	case common.WorkflowPausedMarkerName:
+		var identity, reason string
+		if err := payloads.Decode(attr.GetDetails()[workflowPauseIdentityDetail], &identity); err != nil {
+			return err
+		}
+		if err := payloads.Decode(attr.GetDetails()[workflowPauseReasonDetail], &reason); err != nil {
+			return err
+		}
+		ms.executionInfo.PauseInfo = &persistencespb.WorkflowPauseInfo{
+			PauseTime: event.GetEventTime(),
+			Identity:  identity,
+			Reason:    reason,
+		}
+	case common.WorkflowUnpausedMarkerName:
+		ms.executionInfo.PauseInfo = nil
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("unknown workflow pause marker: %v", attr.GetMarkerName()))
+	}
+	return nil
+}
+
+// UpdateActivityOptions applies the options which are set in the given options to a pending activity and
+// returns the resulting options. This is recorded in mutable state only and replicated by the sync activity task,
+// workers don't need to know about the options of an activity task they are not yet given.
+func (ms *MutableStateImpl) UpdateActivityOptions(
+	ctx context.Context,
+	scheduledEventID int64,
+	options *historyspb.ActivityOptions,
+) (*historyspb.ActivityOptions, error) {
+	opTag := tag.WorkflowActionActivityOptionsUpdated
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	ai, ok := ms.GetActivityInfo(scheduledEventID)
+	if !ok {
+		ms.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(ms.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowScheduledEventID(scheduledEventID))
+		return nil, ms.createInternalServerError(opTag)
+	}
+	scheduledEvent, err := ms.GetActivityScheduledEvent(ctx, scheduledEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	newOptions := mergeActivityOptions(ActivityOptionsFromInfo(ai), options)
+	if err := NormalizeActivityTimeouts(
+		newOptions,
+		timestamp.DurationValue(ms.executionInfo.WorkflowRunTimeout),
+	); err != nil {
+		return nil, err
+	}
+	taskQueueChanged := newOptions.GetTaskQueue() != ai.TaskQueue
+
+	var retryExpirationTime *time.Time
+	if newOptions.RetryPolicy != nil {
+		retryExpirationTime = timestamp.TimePtr(time.Time{})
+		if scheduleToCloseTimeout := timestamp.DurationValue(newOptions.ScheduleToCloseTimeout); scheduleToCloseTimeout > 0 {
+			retryExpirationTime = timestamp.TimePtr(timestamp.TimeValue(scheduledEvent.GetEventTime()).Add(scheduleToCloseTimeout))
+		}
+	}
+	ai.Version = ms.GetCurrentVersion()
+	applyActivityOptions(ai, newOptions, retryExpirationTime)
+	// timeouts may have changed, activity timer tasks will be regenerated based on the new timeouts
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := ms.UpdateActivity(ai); err != nil {
+		return nil, err
+	}
+	// options are replicated by the sync activity task
+	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+
+	// activity task already dispatched to the old task queue may never be picked up,
+	// dispatch it again to the new task queue, whichever is started first wins
+	if taskQueueChanged && ai.StartedEventId == common.EmptyEventID {
+		if err := ms.taskGenerator.GenerateActivityTasks(scheduledEvent); err != nil {
+			return nil, err
+		}
+	}
+	return newOptions, nil
+}
+
+// ResetActivity forces a pending activity which is not started to be retried immediately. This is
+// recorded in mutable state only.
+func (ms *MutableStateImpl) ResetActivity(
+	scheduledEventID int64,
+	resetAttempts bool,
+) error {
+	opTag := tag.WorkflowActionActivityReset
+	if err := ms.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	ai, ok := ms.GetActivityInfo(scheduledEventID)
+	if !ok || ai.StartedEventId != common.EmptyEventID {
+		ms.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(ms.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ok),
+			tag.WorkflowScheduledEventID(scheduledEventID))
+		return ms.createInternalServerError(opTag)
+	}
+
+	ai.Version = ms.GetCurrentVersion()
+	ai.ScheduledTime = timestamp.TimePtr(ms.timeSource.Now())
+	if resetAttempts {
+		ai.Attempt = 1
+	}
+	// retry timer task generated for the previous scheduled time is ignored as it has the previous stamp
+	ai.Stamp++
+	// schedule to start timeout is based on the scheduled time, activity timer tasks will be regenerated
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	if err := ms.UpdateActivity(ai); err != nil {
+		return err
+	}
+
+	return ms.taskGenerator.GenerateActivityRetryTasks(scheduledEventID)
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -4351,15 +4615,17 @@ func (ms *MutableStateImpl) prepareEventsAndReplicationTasks(
 	if err != nil {
 		return nil, nil, false, err
 	}
+	encodingType := EventsEncodingType(ms.config, ms.namespaceEntry.Name())
 	for index, eventBatch := range newEventsBatches {
 		workflowEventsSeq[index] = &persistence.WorkflowEvents{
-			NamespaceID: ms.executionInfo.NamespaceId,
-			WorkflowID:  ms.executionInfo.WorkflowId,
-			RunID:       ms.executionState.RunId,
-			BranchToken: currentBranchToken,
-			PrevTxnID:   ms.executionInfo.LastFirstEventTxnId,
-			TxnID:       historyNodeTxnIDs[index],
-			Events:      eventBatch,
+			NamespaceID:  ms.executionInfo.NamespaceId,
+			WorkflowID:   ms.executionInfo.WorkflowId,
+			RunID:        ms.executionState.RunId,
+			BranchToken:  currentBranchToken,
+			PrevTxnID:    ms.executionInfo.LastFirstEventTxnId,
+			TxnID:        historyNodeTxnIDs[index],
+			Events:       eventBatch,
+			EncodingType: encodingType,
 		}
 		ms.executionInfo.LastFirstEventId = eventBatch[0].GetEventId()
 		ms.executionInfo.LastFirstEventTxnId = historyNodeTxnIDs[index]
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/api/clock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -54,12 +55,15 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 )
 
@@ -391,6 +395,41 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestAddBuildIdIfNotExists() {
+	completedEvent := func(buildID string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+				WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+					WorkerVersion: &commonpb.WorkerVersionStamp{BuildId: buildID},
+				},
+			},
+		}
+	}
+	buildIDs := func() []string {
+		payload, ok := s.mutableState.GetExecutionInfo().SearchAttributes[searchattribute.BuildIds]
+		if !ok {
+			return nil
+		}
+		decoded, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, false)
+		s.NoError(err)
+		return decoded.([]string)
+	}
+
+	s.NoError(s.mutableState.addBuildIdIfNotExists(completedEvent(""), 2))
+	s.Nil(buildIDs())
+
+	s.NoError(s.mutableState.addBuildIdIfNotExists(completedEvent("v1"), 2))
+	s.NoError(s.mutableState.addBuildIdIfNotExists(completedEvent("v1"), 2))
+	s.Equal([]string{"v1"}, buildIDs())
+
+	s.NoError(s.mutableState.addBuildIdIfNotExists(completedEvent("v2"), 2))
+	s.Equal([]string{"v1", "v2"}, buildIDs())
+
+	s.NoError(s.mutableState.addBuildIdIfNotExists(completedEvent("v3"), 2))
+	s.Equal([]string{"v2", "v3"}, buildIDs())
+}
+
 func (s *mutableStateSuite) TestTransientWorkflowTaskSchedule_CurrentVersionChanged() {
 	version := int64(2000)
 	runID := uuid.New()
@@ -1048,3 +1087,238 @@ func (s *mutableStateSuite) TestSpeculativeWorkflowTaskNotPersisted() {
 		})
 	}
 }
+
+func (s *mutableStateSuite) TestPauseUnpause() {
+	dbState := s.buildWorkflowMutableState()
+	var err error
+	s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	err = s.mutableState.UpdateCurrentVersion(dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].Version, false)
+	s.NoError(err)
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+	nextEventID := s.mutableState.GetNextEventID()
+
+	pausedEvent, err := s.mutableState.AddWorkflowExecutionPausedEvent("test-identity", "test-reason")
+	s.NoError(err)
+	s.True(s.mutableState.IsWorkflowExecutionPaused())
+	pauseInfo := s.mutableState.GetExecutionInfo().GetPauseInfo()
+	s.Equal("test-identity", pauseInfo.GetIdentity())
+	s.Equal("test-reason", pauseInfo.GetReason())
+	s.Equal(pausedEvent.GetEventTime(), pauseInfo.GetPauseTime())
+	s.Equal(common.BufferedEventID, pausedEvent.GetEventId())
+	s.True(pausedEvent.GetWorkerMayIgnore())
+	s.True(common.IsWorkflowPauseMarker(pausedEvent))
+
+	unpausedEvent, err := s.mutableState.AddWorkflowExecutionUnpausedEvent()
+	s.NoError(err)
+	s.False(s.mutableState.IsWorkflowExecutionPaused())
+	s.Equal(common.BufferedEventID, unpausedEvent.GetEventId())
+	s.True(common.IsWorkflowPauseMarker(unpausedEvent))
+	s.True(s.mutableState.HasBufferedEvents())
+
+	// the pause is restored from the markers when the mutable state is rebuilt or replicated
+	rebuiltMutableState, err := newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, s.buildWorkflowMutableState(), 123)
+	s.NoError(err)
+	s.NoError(rebuiltMutableState.ReplicateWorkflowExecutionPauseMarkerEvent(pausedEvent))
+	s.Equal(pauseInfo, rebuiltMutableState.GetExecutionInfo().GetPauseInfo())
+	s.NoError(rebuiltMutableState.ReplicateWorkflowExecutionPauseMarkerEvent(unpausedEvent))
+	s.False(rebuiltMutableState.IsWorkflowExecutionPaused())
+	s.Equal(nextEventID, s.mutableState.GetNextEventID())
+}
+
+func (s *mutableStateSuite) TestUpdateActivityOptions() {
+	dbState := s.buildWorkflowMutableState()
+	ai := dbState.ActivityInfos[5]
+	ai.TaskQueue = "old-task-queue"
+	ai.TimerTaskStatus = TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose
+	dbState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{ai.ScheduledEventId: ai}
+
+	var err error
+	s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	err = s.mutableState.UpdateCurrentVersion(dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].Version, false)
+	s.NoError(err)
+
+	nextEventID := s.mutableState.GetNextEventID()
+	scheduledTime := time.Now().UTC().Add(-time.Minute)
+	s.mockEventsCache.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&historypb.HistoryEvent{
+		EventId:   ai.ScheduledEventId,
+		EventTime: &scheduledTime,
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+	}, nil).AnyTimes()
+
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval:    timestamp.DurationPtr(time.Second),
+		BackoffCoefficient: 2,
+		MaximumInterval:    timestamp.DurationPtr(time.Minute),
+		MaximumAttempts:    10,
+	}
+	options, err := s.mutableState.UpdateActivityOptions(
+		context.Background(),
+		ai.ScheduledEventId,
+		&historyspb.ActivityOptions{
+			TaskQueue:              "new-task-queue",
+			ScheduleToCloseTimeout: timestamp.DurationFromSeconds(100),
+			// explicit zero timeout is deduced the same way as for a newly scheduled activity
+			ScheduleToStartTimeout: timestamp.DurationPtr(0),
+			RetryPolicy:            retryPolicy,
+		},
+	)
+	s.NoError(err)
+	s.Equal(&historyspb.ActivityOptions{
+		TaskQueue:              "new-task-queue",
+		ScheduleToCloseTimeout: timestamp.DurationFromSeconds(100),
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		StartToCloseTimeout:    timestamp.DurationFromSeconds(100),
+		HeartbeatTimeout:       timestamp.DurationFromSeconds(50),
+		RetryPolicy:            retryPolicy,
+	}, options)
+	s.Equal(nextEventID, s.mutableState.GetNextEventID())
+
+	updatedInfo, ok := s.mutableState.GetActivityInfo(ai.ScheduledEventId)
+	s.True(ok)
+	s.Equal("new-task-queue", updatedInfo.TaskQueue)
+	s.Equal(100*time.Second, timestamp.DurationValue(updatedInfo.ScheduleToCloseTimeout))
+	s.Equal(100*time.Second, timestamp.DurationValue(updatedInfo.ScheduleToStartTimeout))
+	s.True(updatedInfo.HasRetryPolicy)
+	s.Equal(int32(10), updatedInfo.RetryMaximumAttempts)
+	s.Equal(scheduledTime.Add(100*time.Second), timestamp.TimeValue(updatedInfo.RetryExpirationTime))
+	s.Equal(int32(TimerTaskStatusNone), updatedInfo.TimerTaskStatus)
+	s.Len(s.mutableState.InsertTasks[tasks.CategoryTransfer], 1)
+	s.Contains(s.mutableState.syncActivityTasks, ai.ScheduledEventId)
+
+	// the options are applied to the standby activity by the sync activity replication task
+	standbyDBState := s.buildWorkflowMutableState()
+	standbyActivityInfo := standbyDBState.ActivityInfos[5]
+	standbyActivityInfo.TimerTaskStatus = TimerTaskStatusCreatedScheduleToStart
+	standbyDBState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{standbyActivityInfo.ScheduledEventId: standbyActivityInfo}
+	standbyMutableState, err := newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, standbyDBState, 123)
+	s.NoError(err)
+	s.NoError(standbyMutableState.ReplicateActivityInfo(&historyservice.SyncActivityRequest{
+		Version:             updatedInfo.Version,
+		ScheduledEventId:    updatedInfo.ScheduledEventId,
+		ScheduledTime:       updatedInfo.ScheduledTime,
+		StartedEventId:      updatedInfo.StartedEventId,
+		Attempt:             updatedInfo.Attempt,
+		Options:             ActivityOptionsFromInfo(updatedInfo),
+		RetryExpirationTime: updatedInfo.RetryExpirationTime,
+	}, false))
+	standbyActivityInfo, ok = standbyMutableState.GetActivityInfo(ai.ScheduledEventId)
+	s.True(ok)
+	s.Equal(options, ActivityOptionsFromInfo(standbyActivityInfo))
+	s.Equal(updatedInfo.RetryExpirationTime, standbyActivityInfo.RetryExpirationTime)
+	s.Equal(int32(TimerTaskStatusNone), standbyActivityInfo.TimerTaskStatus)
+
+	// timeouts can not all be unset
+	_, err = s.mutableState.UpdateActivityOptions(
+		context.Background(),
+		ai.ScheduledEventId,
+		&historyspb.ActivityOptions{
+			ScheduleToCloseTimeout: timestamp.DurationPtr(0),
+			StartToCloseTimeout:    timestamp.DurationPtr(0),
+		},
+	)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *mutableStateSuite) TestResetActivity() {
+	dbState := s.buildWorkflowMutableState()
+	ai := dbState.ActivityInfos[5]
+	ai.Attempt = 5
+	ai.ScheduledTime = timestamp.TimePtr(time.Now().UTC().Add(time.Hour))
+	ai.TimerTaskStatus = TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose
+	dbState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{ai.ScheduledEventId: ai}
+
+	var err error
+	s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	err = s.mutableState.UpdateCurrentVersion(dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].Version, false)
+	s.NoError(err)
+
+	nextEventID := s.mutableState.GetNextEventID()
+	s.NoError(s.mutableState.ResetActivity(ai.ScheduledEventId, true))
+	s.Equal(nextEventID, s.mutableState.GetNextEventID())
+
+	resetInfo, ok := s.mutableState.GetActivityInfo(ai.ScheduledEventId)
+	s.True(ok)
+	s.Equal(int32(1), resetInfo.Attempt)
+	s.Equal(int32(1), resetInfo.Stamp)
+	s.False(timestamp.TimeValue(resetInfo.ScheduledTime).After(time.Now().UTC()))
+	s.Equal(int32(TimerTaskStatusNone), resetInfo.TimerTaskStatus)
+	s.Len(s.mutableState.InsertTasks[tasks.CategoryTimer], 1)
+	retryTask, ok := s.mutableState.InsertTasks[tasks.CategoryTimer][0].(*tasks.ActivityRetryTimerTask)
+	s.True(ok)
+	s.Equal(ai.ScheduledEventId, retryTask.EventID)
+	s.Equal(int32(1), retryTask.Attempt)
+	s.Equal(int32(1), retryTask.Stamp)
+
+	// started activity can not be reset
+	resetInfo.StartedEventId = ai.ScheduledEventId + 1
+	s.Error(s.mutableState.ResetActivity(ai.ScheduledEventId, true))
+}
+
+func (s *mutableStateSuite) TestTaskPriorityFromHeader() {
+	s.mockConfig.TaskPriorityHeader = func(namespace string) string { return "priority" }
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	priorityHeader := func(priority int) *commonpb.Header {
+		value, err := payload.Encode(priority)
+		s.NoError(err)
+		return &commonpb.Header{Fields: map[string]*commonpb.Payload{"priority": value}}
+	}
+
+	err := s.mutableState.ReplicateWorkflowExecutionStartedEvent(
+		nil,
+		commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()},
+		uuid.New(),
+		&historypb.HistoryEvent{
+			EventId:   common.FirstEventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					TaskQueue: &taskqueuepb.TaskQueue{Name: "some random task queue"},
+					Header:    priorityHeader(2),
+				},
+			},
+		},
+	)
+	s.NoError(err)
+	s.Equal(int32(2), TaskPriority(s.mockConfig, s.mutableState))
+
+	scheduleActivity := func(scheduledEventID int64, header *commonpb.Header) *persistencespb.ActivityInfo {
+		ai, err := s.mutableState.ReplicateActivityTaskScheduledEvent(scheduledEventID, &historypb.HistoryEvent{
+			EventId:   scheduledEventID,
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+				ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+					ActivityId: uuid.New(),
+					TaskQueue:  &taskqueuepb.TaskQueue{Name: "some random task queue"},
+					Header:     header,
+				},
+			},
+		})
+		s.NoError(err)
+		return ai
+	}
+	// activities without priority have the priority of the workflow, larger priorities are capped
+	s.Equal(int32(2), ActivityTaskPriority(s.mockConfig, s.mutableState, scheduleActivity(5, nil)))
+	s.Equal(int32(common.MaxTaskPriority), ActivityTaskPriority(s.mockConfig, s.mutableState, scheduleActivity(6, priorityHeader(1000))))
+}
+
+func (s *mutableStateSuite) TestGetApproximatePersistedSize() {
+	dbState := s.buildWorkflowMutableState()
+	ai := dbState.ActivityInfos[5]
+	dbState.ActivityInfos = map[int64]*persistencespb.ActivityInfo{}
+
+	var err error
+	s.mutableState, err = newMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, dbState, 123)
+	s.NoError(err)
+	sizeWithoutActivity := s.mutableState.GetApproximatePersistedSize()
+	s.Positive(sizeWithoutActivity)
+
+	// size is cached until the state transition count changes
+	s.mutableState.pendingActivityInfoIDs[ai.ScheduledEventId] = ai
+	s.Equal(sizeWithoutActivity, s.mutableState.GetApproximatePersistedSize())
+
+	s.mutableState.executionInfo.StateTransitionCount++
+	s.GreaterOrEqual(s.mutableState.GetApproximatePersistedSize(), sizeWithoutActivity+ai.Size())
+}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
@@ -524,7 +525,14 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 			}
 
 		case enumspb.EVENT_TYPE_MARKER_RECORDED:
-			// No mutable state action is needed
+			if common.IsWorkflowPauseMarker(event) {
+				if err := b.mutableState.ReplicateWorkflowExecutionPauseMarkerEvent(
+					event,
+				); err != nil {
+					return nil, err
+				}
+			}
+			// No mutable state action is needed for other markers
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
@@ -721,6 +721,60 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	s.Equal(event.TaskId, s.executionInfo.LastEventTaskId)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded_WorkflowPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	evenType := enumspb.EVENT_TYPE_MARKER_RECORDED
+	pausedEvent := &historypb.HistoryEvent{
+		TaskId:          rand.Int63(),
+		Version:         version,
+		EventId:         130,
+		EventTime:       &now,
+		EventType:       evenType,
+		WorkerMayIgnore: true,
+		Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+			MarkerName: common.WorkflowPausedMarkerName,
+			Details: map[string]*commonpb.Payloads{
+				workflowPauseIdentityDetail: payloads.EncodeString("some random identity"),
+				workflowPauseReasonDetail:   payloads.EncodeString("some random reason"),
+			},
+		}},
+	}
+	unpausedEvent := &historypb.HistoryEvent{
+		TaskId:          rand.Int63(),
+		Version:         version,
+		EventId:         131,
+		EventTime:       &now,
+		EventType:       evenType,
+		WorkerMayIgnore: true,
+		Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+			MarkerName: common.WorkflowUnpausedMarkerName,
+		}},
+	}
+	s.mockUpdateVersion(pausedEvent)
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionPauseMarkerEvent(pausedEvent).Return(nil)
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(pausedEvent), nil)
+	s.Nil(err)
+	s.Equal(pausedEvent.TaskId, s.executionInfo.LastEventTaskId)
+
+	s.mockUpdateVersion(unpausedEvent)
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionPauseMarkerEvent(unpausedEvent).Return(nil)
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err = s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(unpausedEvent), nil)
+	s.Nil(err)
+	s.Equal(unpausedEvent.TaskId, s.executionInfo.LastEventTaskId)
+}
+
 // workflow task operations
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowTaskScheduled() {
 	version := int64(1)
@@ -226,6 +226,32 @@ func (r *TaskGeneratorImpl) GenerateWorkflowCloseTasks(
 		}
 	}
 
+	// completion callbacks are invoked only once the last run in the chain is closed
+	executionInfo := r.mutableState.GetExecutionInfo()
+	if executionInfo.NewExecutionRunId == "" {
+		for idx, info := range executionInfo.CompletionCallbacks {
+			switch info.GetState() {
+			case enumsspb.COMPLETION_CALLBACK_STATE_STANDBY:
+				closeTasks = append(closeTasks, &tasks.CompletionCallbackTask{
+					// TaskID, VisibilityTimestamp is set by shard
+					WorkflowKey:   r.mutableState.GetWorkflowKey(),
+					Version:       currentVersion,
+					CallbackIndex: int32(idx),
+				})
+			case enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF:
+				// only when refreshing tasks, the delivery is rescheduled right away
+				closeTasks = append(closeTasks, &tasks.CompletionCallbackBackoffTask{
+					// TaskID is set by the shard
+					WorkflowKey:         r.mutableState.GetWorkflowKey(),
+					VisibilityTimestamp: timestamp.TimeValue(info.LastAttemptCompleteTime),
+					Version:             currentVersion,
+					CallbackIndex:       int32(idx),
+					Attempt:             info.Attempt,
+				})
+			}
+		}
+	}
+
 	r.mutableState.AddTasks(closeTasks...)
 	return nil
 }
@@ -446,6 +472,18 @@ func (r *TaskGeneratorImpl) GenerateActivityRetryTasks(
 		return serviceerror.NewInternal(fmt.Sprintf("it could be a bug, cannot get pending activity: %v", activityScheduledEventID))
 	}
 
+	if r.config.EnableActivityRetryDelayInMatching(r.mutableState.GetNamespaceEntry().Name().String()) {
+		// the activity task is added to matching right away and dispatched after the scheduled time
+		r.mutableState.AddTasks(&tasks.ActivityTask{
+			// TaskID, VisibilityTimestamp is set by shard
+			WorkflowKey:      r.mutableState.GetWorkflowKey(),
+			TaskQueue:        ai.TaskQueue,
+			ScheduledEventID: ai.ScheduledEventId,
+			Version:          ai.Version,
+		})
+		return nil
+	}
+
 	r.mutableState.AddTasks(&tasks.ActivityRetryTimerTask{
 		// TaskID is set by shard
 		WorkflowKey:         r.mutableState.GetWorkflowKey(),
@@ -453,6 +491,7 @@ func (r *TaskGeneratorImpl) GenerateActivityRetryTasks(
 		VisibilityTimestamp: *ai.ScheduledTime,
 		EventID:             ai.ScheduledEventId,
 		Attempt:             ai.Attempt,
+		Stamp:               ai.Stamp,
 	})
 	return nil
 }
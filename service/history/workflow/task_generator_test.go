@@ -58,7 +58,9 @@ import (
 	"go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/persistence/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
@@ -362,3 +364,68 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks_CompletionCallbacks(t *testing.T) {
+	for _, c := range []struct {
+		Name                  string
+		NewExecutionRunID     string
+		ExpectedCallbackIndex []int32
+		ExpectedBackoffIndex  []int32
+	}{
+		{
+			Name:                  "last run in chain",
+			ExpectedCallbackIndex: []int32{0},
+			ExpectedBackoffIndex:  []int32{2},
+		},
+		{
+			Name:              "continued as new",
+			NewExecutionRunID: tests.RunID,
+		},
+	} {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mutableState := NewMockMutableState(ctrl)
+			mutableState.EXPECT().GetCurrentVersion().Return(int64(0)).AnyTimes()
+			mutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+				NamespaceId:       tests.NamespaceID.String(),
+				NewExecutionRunId: c.NewExecutionRunID,
+				CompletionCallbacks: []*workflowspb.CompletionCallbackInfo{
+					{State: enumsspb.COMPLETION_CALLBACK_STATE_STANDBY},
+					{State: enumsspb.COMPLETION_CALLBACK_STATE_SUCCEEDED},
+					{State: enumsspb.COMPLETION_CALLBACK_STATE_BACKING_OFF},
+					{State: enumsspb.COMPLETION_CALLBACK_STATE_FAILED},
+				},
+			}).AnyTimes()
+			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
+				tests.NamespaceID.String(), tests.WorkflowID, tests.RunID,
+			)).AnyTimes()
+			var allTasks []tasks.Task
+			mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...tasks.Task) {
+				allTasks = append(allTasks, ts...)
+			}).AnyTimes()
+
+			taskGenerator := NewTaskGenerator(namespace.NewMockRegistry(ctrl), mutableState, &configs.Config{}, archiver.NewMockArchivalMetadata(ctrl))
+			err := taskGenerator.GenerateWorkflowCloseTasks(&historypb.HistoryEvent{
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+					WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{},
+				},
+				EventTime: timestamp.TimePtr(time.Unix(0, 0)),
+			}, true)
+			require.NoError(t, err)
+
+			var callbackIndex []int32
+			var backoffIndex []int32
+			for _, task := range allTasks {
+				switch task := task.(type) {
+				case *tasks.CompletionCallbackTask:
+					callbackIndex = append(callbackIndex, task.CallbackIndex)
+				case *tasks.CompletionCallbackBackoffTask:
+					backoffIndex = append(backoffIndex, task.CallbackIndex)
+				}
+			}
+			assert.Equal(t, c.ExpectedCallbackIndex, callbackIndex)
+			assert.Equal(t, c.ExpectedBackoffIndex, backoffIndex)
+		})
+	}
+}
@@ -308,6 +308,7 @@ func persistFirstWorkflowEvents(
 			Events:            events,
 			PrevTransactionID: prevTxnID,
 			TransactionID:     txnID,
+			EncodingType:      workflowEvents.EncodingType,
 		},
 	)
 	return size, err
@@ -344,6 +345,7 @@ func persistNonFirstWorkflowEvents(
 			Events:            events,
 			PrevTransactionID: prevTxnID,
 			TransactionID:     txnID,
+			EncodingType:      workflowEvents.EncodingType,
 		},
 	)
 	return size, err
@@ -34,11 +34,16 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/internal/effect"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 )
 
@@ -165,6 +170,114 @@ func TerminateWorkflow(
 	return err
 }
 
+// EventsEncodingType returns the encoding type used to persist history events of the given namespace
+func EventsEncodingType(
+	config *configs.Config,
+	namespaceName namespace.Name,
+) enumspb.EncodingType {
+	encodingType, ok := serialization.EncodingTypeFromString(config.EventEncodingType(namespaceName.String()))
+	if !ok || encodingType == enumspb.ENCODING_TYPE_UNSPECIFIED {
+		return enumspb.ENCODING_TYPE_PROTO3
+	}
+	return encodingType
+}
+
+// TaskPriority returns the priority of the workflow tasks of the workflow, set in the header of its start request
+// or read from the Int search attribute configured for its namespace, capped at common.MaxTaskPriority. Zero
+// means the priority is not set.
+func TaskPriority(
+	config *configs.Config,
+	mutableState MutableState,
+) int32 {
+	if priority := mutableState.GetExecutionInfo().GetTaskPriority(); priority > 0 {
+		return priority
+	}
+	searchAttributeName := config.TaskPrioritySearchAttribute(mutableState.GetNamespaceEntry().Name().String())
+	if searchAttributeName == "" {
+		return 0
+	}
+	value, ok := mutableState.GetExecutionInfo().GetSearchAttributes()[searchAttributeName]
+	if !ok {
+		return 0
+	}
+	decoded, err := searchattribute.DecodeValue(value, enumspb.INDEXED_VALUE_TYPE_INT, false)
+	if err != nil {
+		return 0
+	}
+	priority, ok := decoded.(int64)
+	if !ok {
+		return 0
+	}
+	return capTaskPriority(priority)
+}
+
+// ActivityTaskPriority returns the priority of the tasks of an activity, set in the header of its schedule
+// command, or the priority of the workflow tasks of the workflow if not set.
+func ActivityTaskPriority(
+	config *configs.Config,
+	mutableState MutableState,
+	activityInfo *persistencespb.ActivityInfo,
+) int32 {
+	if priority := activityInfo.GetTaskPriority(); priority > 0 {
+		return priority
+	}
+	return TaskPriority(config, mutableState)
+}
+
+// headerTaskPriority returns the priority held by the header field configured for the namespace, capped at
+// common.MaxTaskPriority. Zero means the priority is not set.
+func headerTaskPriority(
+	config *configs.Config,
+	namespaceName namespace.Name,
+	header *commonpb.Header,
+) int32 {
+	headerName := config.TaskPriorityHeader(namespaceName.String())
+	if headerName == "" {
+		return 0
+	}
+	value, ok := header.GetFields()[headerName]
+	if !ok {
+		return 0
+	}
+	var priority int64
+	if err := payload.Decode(value, &priority); err != nil {
+		return 0
+	}
+	return capTaskPriority(priority)
+}
+
+func capTaskPriority(priority int64) int32 {
+	if priority <= 0 {
+		return 0
+	}
+	if priority > common.MaxTaskPriority {
+		return common.MaxTaskPriority
+	}
+	return int32(priority)
+}
+
+// TaskFairnessKey returns the fairness key of the workflow and activity tasks of the workflow, read from the
+// Keyword search attribute configured for its namespace. Empty means the fairness key is not set.
+func TaskFairnessKey(
+	config *configs.Config,
+	mutableState MutableState,
+) string {
+	searchAttributeName := config.TaskFairnessKeySearchAttribute(mutableState.GetNamespaceEntry().Name().String())
+	if searchAttributeName == "" {
+		return ""
+	}
+	value, ok := mutableState.GetExecutionInfo().GetSearchAttributes()[searchAttributeName]
+	if !ok {
+		return ""
+	}
+	decoded, err := searchattribute.DecodeValue(value, enumspb.INDEXED_VALUE_TYPE_KEYWORD, false)
+	if err != nil {
+		return ""
+	}
+	fairnessKey, _ := decoded.(string)
+	return fairnessKey
+}
+
 // FindAutoResetPoint returns the auto reset point
 func FindAutoResetPoint(
 	timeSource clock.TimeSource,
@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// NormalizeActivityTimeouts validates the timeouts of the activity options and deduces the ones which are not set,
+// the same way as it is done for the timeouts of a ScheduleActivityTask command.
+func NormalizeActivityTimeouts(
+	options *historyspb.ActivityOptions,
+	runTimeout time.Duration,
+) error {
+	// Only attempt to deduce and fill in unspecified timeouts only when all timeouts are non-negative.
+	if timestamp.DurationValue(options.GetScheduleToCloseTimeout()) < 0 || timestamp.DurationValue(options.GetScheduleToStartTimeout()) < 0 ||
+		timestamp.DurationValue(options.GetStartToCloseTimeout()) < 0 || timestamp.DurationValue(options.GetHeartbeatTimeout()) < 0 {
+		return serviceerror.NewInvalidArgument("A valid timeout may not be negative.")
+	}
+
+	validScheduleToClose := timestamp.DurationValue(options.GetScheduleToCloseTimeout()) > 0
+	validScheduleToStart := timestamp.DurationValue(options.GetScheduleToStartTimeout()) > 0
+	validStartToClose := timestamp.DurationValue(options.GetStartToCloseTimeout()) > 0
+
+	if validScheduleToClose {
+		if validScheduleToStart {
+			options.ScheduleToStartTimeout = timestamp.MinDurationPtr(options.GetScheduleToStartTimeout(),
+				options.GetScheduleToCloseTimeout())
+		} else {
+			options.ScheduleToStartTimeout = options.GetScheduleToCloseTimeout()
+		}
+		if validStartToClose {
+			options.StartToCloseTimeout = timestamp.MinDurationPtr(options.GetStartToCloseTimeout(),
+				options.GetScheduleToCloseTimeout())
+		} else {
+			options.StartToCloseTimeout = options.GetScheduleToCloseTimeout()
+		}
+	} else if validStartToClose {
+		// We are in !validScheduleToClose due to the first if above
+		options.ScheduleToCloseTimeout = &runTimeout
+		if !validScheduleToStart {
+			options.ScheduleToStartTimeout = &runTimeout
+		}
+	} else {
+		// Deduction failed as there's not enough information to fill in missing timeouts.
+		return serviceerror.NewInvalidArgument("A valid StartToClose or ScheduleToCloseTimeout is not set.")
+	}
+	// ensure activity timeout never larger than workflow timeout
+	if runTimeout > 0 {
+		if timestamp.DurationValue(options.GetScheduleToCloseTimeout()) > runTimeout {
+			options.ScheduleToCloseTimeout = &runTimeout
+		}
+		if timestamp.DurationValue(options.GetScheduleToStartTimeout()) > runTimeout {
+			options.ScheduleToStartTimeout = &runTimeout
+		}
+		if timestamp.DurationValue(options.GetStartToCloseTimeout()) > runTimeout {
+			options.StartToCloseTimeout = &runTimeout
+		}
+		if timestamp.DurationValue(options.GetHeartbeatTimeout()) > runTimeout {
+			options.HeartbeatTimeout = &runTimeout
+		}
+	}
+	options.HeartbeatTimeout = timestamp.MinDurationPtr(options.GetHeartbeatTimeout(), options.GetStartToCloseTimeout())
+	return nil
+}
+
+// ActivityOptionsFromInfo returns the options of the activity recorded in the activity info.
+func ActivityOptionsFromInfo(
+	ai *persistencespb.ActivityInfo,
+) *historyspb.ActivityOptions {
+	options := &historyspb.ActivityOptions{
+		TaskQueue:              ai.TaskQueue,
+		ScheduleToCloseTimeout: ai.ScheduleToCloseTimeout,
+		ScheduleToStartTimeout: ai.ScheduleToStartTimeout,
+		StartToCloseTimeout:    ai.StartToCloseTimeout,
+		HeartbeatTimeout:       ai.HeartbeatTimeout,
+	}
+	if ai.HasRetryPolicy {
+		options.RetryPolicy = &commonpb.RetryPolicy{
+			InitialInterval:        ai.RetryInitialInterval,
+			BackoffCoefficient:     ai.RetryBackoffCoefficient,
+			MaximumInterval:        ai.RetryMaximumInterval,
+			MaximumAttempts:        ai.RetryMaximumAttempts,
+			NonRetryableErrorTypes: ai.RetryNonRetryableErrorTypes,
+		}
+	}
+	return options
+}
+
+// applyActivityOptions records the options and the resulting retry expiration time in the activity info.
+func applyActivityOptions(
+	ai *persistencespb.ActivityInfo,
+	options *historyspb.ActivityOptions,
+	retryExpirationTime *time.Time,
+) {
+	ai.TaskQueue = options.GetTaskQueue()
+	ai.ScheduleToCloseTimeout = options.ScheduleToCloseTimeout
+	ai.ScheduleToStartTimeout = options.ScheduleToStartTimeout
+	ai.StartToCloseTimeout = options.StartToCloseTimeout
+	ai.HeartbeatTimeout = options.HeartbeatTimeout
+	ai.HasRetryPolicy = options.RetryPolicy != nil
+	ai.RetryInitialInterval = options.RetryPolicy.GetInitialInterval()
+	ai.RetryBackoffCoefficient = options.RetryPolicy.GetBackoffCoefficient()
+	ai.RetryMaximumInterval = options.RetryPolicy.GetMaximumInterval()
+	ai.RetryMaximumAttempts = options.RetryPolicy.GetMaximumAttempts()
+	ai.RetryNonRetryableErrorTypes = options.RetryPolicy.GetNonRetryableErrorTypes()
+	ai.RetryExpirationTime = retryExpirationTime
+}
+
+// mergeActivityOptions returns the options resulting from applying the options which are set in update
+// to the current options, retry policy is replaced as a whole. A timeout explicitly set to zero is unset
+// and deduced again by NormalizeActivityTimeouts.
+func mergeActivityOptions(
+	current *historyspb.ActivityOptions,
+	update *historyspb.ActivityOptions,
+) *historyspb.ActivityOptions {
+	merged := *current
+	if update.GetTaskQueue() != "" {
+		merged.TaskQueue = update.GetTaskQueue()
+	}
+	if update.ScheduleToCloseTimeout != nil {
+		merged.ScheduleToCloseTimeout = update.ScheduleToCloseTimeout
+	}
+	if update.ScheduleToStartTimeout != nil {
+		merged.ScheduleToStartTimeout = update.ScheduleToStartTimeout
+	}
+	if update.StartToCloseTimeout != nil {
+		merged.StartToCloseTimeout = update.StartToCloseTimeout
+	}
+	if update.HeartbeatTimeout != nil {
+		merged.HeartbeatTimeout = update.HeartbeatTimeout
+	}
+	if update.RetryPolicy != nil {
+		merged.RetryPolicy = update.RetryPolicy
+	}
+	return &merged
+}
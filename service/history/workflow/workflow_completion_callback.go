@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	workflowspb "go.temporal.io/server/api/workflow/v1"
+)
+
+func newCompletionCallbackInfos(
+	callbacks []*workflowspb.CompletionCallback,
+) []*workflowspb.CompletionCallbackInfo {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	infos := make([]*workflowspb.CompletionCallbackInfo, 0, len(callbacks))
+	for _, callback := range callbacks {
+		infos = append(infos, &workflowspb.CompletionCallbackInfo{
+			Callback: callback,
+			State:    enumsspb.COMPLETION_CALLBACK_STATE_STANDBY,
+		})
+	}
+	return infos
+}
+
+func completionCallbacksFromInfos(
+	infos []*workflowspb.CompletionCallbackInfo,
+) []*workflowspb.CompletionCallback {
+	if len(infos) == 0 {
+		return nil
+	}
+	callbacks := make([]*workflowspb.CompletionCallback, 0, len(infos))
+	for _, info := range infos {
+		callbacks = append(callbacks, info.GetCallback())
+	}
+	return callbacks
+}
@@ -556,6 +556,7 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskCompletedEvent(
 		workflowTask.StartedEventID,
 		request.Identity,
 		request.BinaryChecksum,
+		request.WorkerVersionStamp,
 		request.SdkMetadata,
 		request.MeteringMetadata,
 	)
@@ -916,6 +917,9 @@ func (m *workflowTaskStateMachine) afterAddWorkflowTaskCompletedEvent(
 	maxResetPoints int,
 ) error {
 	m.ms.executionInfo.LastWorkflowTaskStartedEventId = event.GetWorkflowTaskCompletedEventAttributes().GetStartedEventId()
+	if err := m.ms.addBuildIdIfNotExists(event, maxResetPoints); err != nil {
+		return err
+	}
 	return m.ms.addBinaryCheckSumIfNotExists(event, maxResetPoints)
 }
 
@@ -363,7 +363,8 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 
 	eagerStartActivity := false
 	namespace := handler.mutableState.GetNamespaceEntry().Name().String()
-	if attr.RequestEagerExecution && handler.config.EnableActivityEagerExecution(namespace) {
+	if attr.RequestEagerExecution && handler.config.EnableActivityEagerExecution(namespace) &&
+		!handler.mutableState.IsWorkflowExecutionPaused() {
 		eagerStartActivity = true
 	}
 
@@ -705,7 +706,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandCancelTimer(
 
 	// In case the timer was cancelled and its TimerFired event was deleted from buffered events, attempt
 	// to unset hasBufferedEvents to allow the workflow to complete.
-	handler.hasBufferedEvents = handler.hasBufferedEvents && handler.mutableState.HasBufferedEvents()
+	handler.hasBufferedEvents = handler.hasBufferedEvents && handler.mutableState.HasAnyBufferedEvent(eventShouldBeHandledByWorkflowTaskFilter)
 	return nil
 }
 
@@ -38,6 +38,7 @@ import (
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
@@ -187,6 +188,9 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, consts.ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, consts.ErrWorkflowPaused
+			}
 
 			workflowTask := mutableState.GetWorkflowTaskByID(scheduledEventID)
 			metricsScope := handler.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryRecordWorkflowTaskStartedScope))
@@ -251,6 +255,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				// Unable to add WorkflowTaskStarted event to history
 				return nil, err
 			}
+			if executionInfo := mutableState.GetExecutionInfo(); executionInfo.BuildIdAssignment == nil {
+				// the run keeps going to the build ID of the worker which started its first workflow task
+				executionInfo.BuildIdAssignment = &persistencespb.BuildIdAssignment{
+					BuildId: req.PollRequest.GetWorkerVersionCapabilities().GetBuildId(),
+				}
+			}
 
 			if workflowTask.Type == enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE {
 				updateAction.Noop = true
@@ -482,7 +492,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 		newMutableState             workflow.MutableState
 	)
 	// hasBufferedEvents indicates if there are any buffered events which should generate a new workflow task
-	hasBufferedEvents := ms.HasBufferedEvents()
+	hasBufferedEvents := ms.HasAnyBufferedEvent(eventShouldBeHandledByWorkflowTaskFilter)
 	if err := namespaceEntry.VerifyBinaryChecksum(request.GetBinaryChecksum()); err != nil {
 		wtFailedCause = newWorkflowTaskFailedCause(
 			enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_BINARY,
@@ -616,11 +626,13 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	newWorkflowTaskType := enumsspb.WORKFLOW_TASK_TYPE_UNSPECIFIED
 	if ms.IsWorkflowExecutionRunning() && (wtFailedShouldCreateNewTask || bufferedEventShouldCreateNewTask || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled) {
 		newWorkflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_NORMAL
-	} else if ms.IsWorkflowExecutionRunning() && weContext.UpdateRegistry(ctx).HasOutgoing() {
+	} else if ms.IsWorkflowExecutionRunning() && !ms.IsWorkflowExecutionPaused() && weContext.UpdateRegistry(ctx).HasOutgoing() {
 		newWorkflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_SPECULATIVE
 	}
 
-	bypassTaskGeneration := request.GetReturnNewWorkflowTask() && wtFailedCause == nil
+	// new workflow task of a paused workflow execution must not be handed to the worker,
+	// it is dispatched once the workflow execution is unpaused
+	bypassTaskGeneration := request.GetReturnNewWorkflowTask() && wtFailedCause == nil && !ms.IsWorkflowExecutionPaused()
 	// TODO (alex-update): Need to support case when ReturnNewWorkflowTask=false and WT.Type=Speculative.
 	// In this case WT needs to be added directly to matching.
 	// Current implementation will create normal WT.
@@ -1010,10 +1022,24 @@ func failWorkflowTask(
 
 // Filter function to be passed to mutable_state.HasAnyBufferedEvent
 // Returns true if the event should generate a new workflow task
-// Currently only signal events with SkipGenerateWorkflowTask=true flag set do not generate tasks
+// Signal events with SkipGenerateWorkflowTask=true flag set, workflow properties modified externally
+// events and workflow pause markers (which are ignored by workers) do not generate tasks
 func eventShouldGenerateNewTaskFilter(event *historypb.HistoryEvent) bool {
-	if event.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+		return !event.GetWorkflowExecutionSignaledEventAttributes().GetSkipGenerateWorkflowTask()
+	case enumspb.EVENT_TYPE_MARKER_RECORDED:
+		return !common.IsWorkflowPauseMarker(event)
+	case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+		return false
+	default:
 		return true
 	}
-	return !event.GetWorkflowExecutionSignaledEventAttributes().GetSkipGenerateWorkflowTask()
+}
+
+// Filter function to be passed to mutable_state.HasAnyBufferedEvent
+// Returns true if the event has to be handled by the workflow before it can complete
+// Workflow pause markers are never sent to workers so they can't block the completion of the workflow
+func eventShouldBeHandledByWorkflowTaskFilter(event *historypb.HistoryEvent) bool {
+	return !common.IsWorkflowPauseMarker(event)
 }
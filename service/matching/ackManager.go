@@ -38,15 +38,22 @@ import (
 // Used to convert out of order acks into ackLevel movement.
 type ackManager struct {
 	sync.RWMutex
-	outstandingTasks map[int64]bool // key->TaskID, value->(true for acked, false->for non acked)
-	readLevel        int64          // Maximum TaskID inserted into outstandingTasks
-	ackLevel         int64          // Maximum TaskID below which all tasks are acked
+	outstandingTasks map[int64]bool     // key->TaskID, value->(true for acked, false->for non acked)
+	removedTasks     map[int64]struct{} // outstanding tasks that were moved or purged from the backlog
+	readLevel        int64              // Maximum TaskID inserted into outstandingTasks
+	ackLevel         int64              // Maximum TaskID below which all tasks are acked
 	backlogCounter   atomic.Int64
 	logger           log.Logger
 }
 
 func newAckManager(logger log.Logger) ackManager {
-	return ackManager{logger: logger, outstandingTasks: make(map[int64]bool), readLevel: -1, ackLevel: -1}
+	return ackManager{
+		logger:           logger,
+		outstandingTasks: make(map[int64]bool),
+		removedTasks:     make(map[int64]struct{}),
+		readLevel:        -1,
+		ackLevel:         -1,
+	}
 }
 
 // Registers task as in-flight and moves read level to it. Tasks can be added in increasing order of taskID only.
@@ -119,6 +126,7 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 		m.outstandingTasks[taskID] = true
 		m.backlogCounter.Dec()
 	}
+	delete(m.removedTasks, taskID)
 
 	// TODO the ack level management shuld be done by a dedicated coroutine
 	//  this is only a temporarily solution
@@ -138,6 +146,23 @@ func (m *ackManager) completeTask(taskID int64) (ackLevel int64) {
 	return m.ackLevel
 }
 
+// Marks an outstanding task as removed from the backlog, so that it is dropped instead of dispatched.
+// Tasks that are not outstanding are ignored, as they are either not loaded yet or already completed.
+func (m *ackManager) markTaskRemoved(taskID int64) {
+	m.Lock()
+	defer m.Unlock()
+	if acked, ok := m.outstandingTasks[taskID]; ok && !acked {
+		m.removedTasks[taskID] = struct{}{}
+	}
+}
+
+func (m *ackManager) isTaskRemoved(taskID int64) bool {
+	m.RLock()
+	defer m.RUnlock()
+	_, ok := m.removedTasks[taskID]
+	return ok
+}
+
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
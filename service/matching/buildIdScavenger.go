@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+const buildIdScavengeTimeout = time.Minute
+
+type (
+	// buildIdScavenger runs on the root workflow partition of a task queue and removes from its versioning data the
+	// build IDs which were superseded for longer than the build ID retention and which are no longer reachable.
+	buildIdScavenger struct {
+		tqMgr        *taskQueueManagerImpl
+		config       *Config
+		shutdownChan chan struct{}
+	}
+
+	// buildIdReachability tells whether workflow executions may still need the workers running a build ID
+	buildIdReachability struct {
+		// open workflow executions of the task queue which ran a workflow task on the build ID
+		openWorkflows int64
+		// closed workflow executions of the task queue which ran a workflow task on the build ID
+		closedWorkflows int64
+		// true if the versioned task queues of the build ID or of a build ID compatible with it still have tasks
+		hasBacklog bool
+	}
+)
+
+func newBuildIdScavenger(tqMgr *taskQueueManagerImpl, config *Config) *buildIdScavenger {
+	return &buildIdScavenger{
+		tqMgr:        tqMgr,
+		config:       config,
+		shutdownChan: make(chan struct{}),
+	}
+}
+
+func (s *buildIdScavenger) Start() {
+	go s.scavengeLoop()
+}
+
+func (s *buildIdScavenger) Stop() {
+	close(s.shutdownChan)
+}
+
+func (s *buildIdScavenger) scavengeLoop() {
+	timer := time.NewTimer(s.config.BuildIdScavengerInterval(s.tqMgr.namespace.String()))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.shutdownChan:
+			return
+		case <-timer.C:
+			if s.config.EnableBuildIdScavenger(s.tqMgr.namespace.String()) {
+				ctx, cancel := context.WithTimeout(context.Background(), buildIdScavengeTimeout)
+				ctx = headers.SetCallerInfo(ctx, headers.NewBackgroundCallerInfo(s.tqMgr.namespace.String()))
+				s.scavenge(ctx, time.Now().UTC())
+				cancel()
+			}
+			timer.Reset(s.config.BuildIdScavengerInterval(s.tqMgr.namespace.String()))
+		}
+	}
+}
+
+func (s *buildIdScavenger) scavenge(ctx context.Context, now time.Time) {
+	data, err := s.tqMgr.GetVersioningData(ctx)
+	if err != nil {
+		s.tqMgr.logger.Warn("Failed to get versioning data for build ID scavenging", tag.Error(err))
+		return
+	}
+	cutoff := now.Add(-s.config.BuildIdRetention(s.tqMgr.namespace.String()))
+
+	unreachable := make(map[string]struct{})
+	for _, superseded := range data.GetSupersededBuildIds() {
+		if superseded.GetSupersededTime().After(cutoff) {
+			continue
+		}
+		reachability, err := s.tqMgr.GetBuildIdReachability(ctx, data, superseded.GetBuildId())
+		if err != nil {
+			s.tqMgr.logger.Warn("Failed to get build ID reachability",
+				tag.NewStringTag("build-id", superseded.GetBuildId()), tag.Error(err))
+			continue
+		}
+		if !reachability.reachable() {
+			unreachable[superseded.GetBuildId()] = struct{}{}
+		}
+	}
+	if len(unreachable) == 0 {
+		return
+	}
+
+	err = s.tqMgr.MutateVersioningData(ctx, func(data *persistencespb.VersioningData) error {
+		removeBuildIds(data, unreachable)
+		return nil
+	})
+	if err != nil {
+		s.tqMgr.logger.Warn("Failed to remove unreachable build IDs", tag.Error(err))
+		return
+	}
+	s.tqMgr.taggedMetricsHandler.Counter(metrics.BuildIdScavengerRemovedBuildIdsCounter.GetMetricName()).Record(int64(len(unreachable)))
+	s.tqMgr.logger.Info("Removed unreachable build IDs", tag.NewInt("build-ids", len(unreachable)))
+}
+
+// reachable returns true if new workflow tasks may still be dispatched to the workers running the build ID. Closed
+// workflow executions only need the workers to answer queries, they don't make a build ID reachable.
+func (r buildIdReachability) reachable() bool {
+	return r.openWorkflows > 0 || r.hasBacklog
+}
+
+// GetBuildIdReachability looks for the open and closed workflow executions of this task queue which ran a workflow
+// task on the build ID, and for the backlog of the versioned task queues of the build ID and of its compatible set.
+func (c *taskQueueManagerImpl) GetBuildIdReachability(
+	ctx context.Context,
+	data *persistencespb.VersioningData,
+	buildID string,
+) (buildIdReachability, error) {
+	var reachability buildIdReachability
+	hasBacklog, err := c.hasBuildIdBacklog(ctx, compatibleBuildIds(data, buildID))
+	if err != nil {
+		return reachability, err
+	}
+	reachability.hasBacklog = hasBacklog
+
+	query := buildIdReachabilityQuery(c.taskQueueID.BaseNameString(), buildID)
+	openResp, err := c.engine.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: c.taskQueueID.namespaceID,
+		Namespace:   c.namespace,
+		Query:       fmt.Sprintf("%s AND %s = %d", query, searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)),
+	})
+	if err != nil {
+		return reachability, err
+	}
+	allResp, err := c.engine.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: c.taskQueueID.namespaceID,
+		Namespace:   c.namespace,
+		Query:       query,
+	})
+	if err != nil {
+		return reachability, err
+	}
+	reachability.openWorkflows = openResp.Count
+	reachability.closedWorkflows = util.Max(0, allResp.Count-openResp.Count)
+	return reachability, nil
+}
+
+// buildIdReachabilityQuery returns the visibility query matching the workflow executions of the task queue which
+// completed a workflow task on the build ID. Both values come from users, so they are quoted as SQL string literals.
+func buildIdReachabilityQuery(taskQueue string, buildID string) string {
+	return fmt.Sprintf("%s = %s AND %s = %s",
+		searchattribute.TaskQueue,
+		sqlparser.String(sqlparser.NewStrVal([]byte(taskQueue))),
+		searchattribute.BuildIds,
+		sqlparser.String(sqlparser.NewStrVal([]byte(buildID))),
+	)
+}
+
+// compatibleBuildIds returns the build ID along with the other build IDs of its compatible version set. The workers
+// of a build ID are able to process the tasks of any build ID compatible with it.
+func compatibleBuildIds(data *persistencespb.VersioningData, buildID string) []string {
+	found := findVersion(data, buildID)
+	if found.setIdx < 0 {
+		return []string{buildID}
+	}
+	return data.GetVersionSets()[found.setIdx].GetBuildIds()
+}
+
+// hasBuildIdBacklog returns true if any partition of any versioned task queue of the given build IDs has tasks past
+// its ack level. Tasks already dispatched but not deleted yet count as backlog.
+func (c *taskQueueManagerImpl) hasBuildIdBacklog(ctx context.Context, buildIDs []string) (bool, error) {
+	numParts := util.Max(util.Max(c.config.NumReadPartitions(), c.config.NumWritePartitions()), maxPartitionCount(c.db.GetPartitionConfig()))
+	for _, buildID := range buildIDs {
+		for i := 0; i < numParts; i++ {
+			name := c.taskQueueID.WithPartition(i).WithVersionSet(buildIdVersionSet(buildID)).FullName()
+			hasBacklog, err := c.hasBacklog(ctx, name)
+			if err != nil || hasBacklog {
+				return hasBacklog, err
+			}
+		}
+	}
+	return false, nil
+}
+
+func (c *taskQueueManagerImpl) hasBacklog(ctx context.Context, name string) (bool, error) {
+	resp, err := c.db.store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: c.taskQueueID.namespaceID.String(),
+		TaskQueue:   name,
+		TaskType:    enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	tasks, err := c.db.store.GetTasks(ctx, &persistence.GetTasksRequest{
+		NamespaceID:        c.taskQueueID.namespaceID.String(),
+		TaskQueue:          name,
+		TaskType:           enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		InclusiveMinTaskID: resp.TaskQueueInfo.GetAckLevel() + 1,
+		ExclusiveMaxTaskID: math.MaxInt64,
+		PageSize:           1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(tasks.Tasks) > 0, nil
+}
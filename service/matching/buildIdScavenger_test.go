@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	persistencepb "go.temporal.io/server/api/persistence/v1"
+)
+
+func TestBuildIdReachabilityQuery(t *testing.T) {
+	assert.Equal(t, "TaskQueue = 'tq' AND BuildIds = 'v1'", buildIdReachabilityQuery("tq", "v1"))
+	assert.Equal(t, `TaskQueue = 'it\'s' AND BuildIds = 'v1\' OR \'\' = \''`, buildIdReachabilityQuery("it's", "v1' OR '' = '"))
+}
+
+func TestCompatibleBuildIds(t *testing.T) {
+	data := &persistencepb.VersioningData{
+		VersionSets: []*taskqueuepb.CompatibleVersionSet{
+			{BuildIds: []string{"v1", "v1.1"}},
+			{BuildIds: []string{"v2"}},
+		},
+	}
+	assert.Equal(t, []string{"v1", "v1.1"}, compatibleBuildIds(data, "v1"))
+	assert.Equal(t, []string{"v1", "v1.1"}, compatibleBuildIds(data, "v1.1"))
+	assert.Equal(t, []string{"v2"}, compatibleBuildIds(data, "v2"))
+	assert.Equal(t, []string{"v3"}, compatibleBuildIds(data, "v3"))
+}
@@ -27,8 +27,11 @@ package matching
 import (
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/util"
 )
@@ -59,10 +62,46 @@ type (
 		MaxVersionGraphSize          dynamicconfig.IntPropertyFn
 		MetadataPollFrequency        dynamicconfig.DurationPropertyFn
 
+		// partition auto scaling configuration
+		EnablePartitionAutoScale        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		PartitionAutoScaleMinPartitions dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PartitionAutoScaleMaxPartitions dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PartitionAutoScaleTargetAddRate dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		PartitionAutoScaleTargetBacklog dynamicconfig.IntPropertyFnWithNamespaceFilter
+		PartitionAutoScaleInterval      dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		PartitionConfigRefreshInterval  dynamicconfig.DurationPropertyFn
+
+		// build ID scavenger configuration
+		EnableBuildIdScavenger   dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		BuildIdScavengerInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		BuildIdRetention         dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MaxBacklogScanTasks        dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MaxDelayedTasks            dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+
+		// dispatch limits configuration
+		MaxInflightActivityTasks  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PollerIdentityDispatchRPS dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+
+		// sticky task queue configuration
+		StickyPollerUnavailableWindow dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		StickyMaxBacklog              dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+
+		// task priority configuration
+		EnableTaskPriority        dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		DefaultTaskPriority       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		TaskPriorityAgingInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+
+		// task fairness configuration
+		EnableTaskFairness   dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		FairnessKeyWeights   dynamicconfig.MapPropertyFnWithNamespaceFilter
+		FairnessTopKeysCount dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		// interval at which the backlog of each fairness key is counted for metrics
+		FairnessBacklogCountInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -72,6 +111,12 @@ type (
 
 		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertyFnWithNamespaceFilter
 		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+
+		VisibilityPersistenceMaxReadQPS   dynamicconfig.IntPropertyFn
+		VisibilityPersistenceMaxWriteQPS  dynamicconfig.IntPropertyFn
+		EnableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 
 	forwarderConfig struct {
@@ -92,6 +137,23 @@ type (
 		MaxTaskQueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
+		MaxBacklogScanTasks        func() int
+		MaxDelayedTasks            func() int
+		// dispatch limits configuration
+		MaxInflightActivityTasks  func() int
+		PollerIdentityDispatchRPS func() float64
+		// sticky task queue configuration
+		StickyPollerUnavailableWindow func() time.Duration
+		StickyMaxBacklog              func() int
+		// task priority configuration
+		EnableTaskPriority        func() bool
+		DefaultTaskPriority       func() int
+		TaskPriorityAgingInterval func() time.Duration
+		// task fairness configuration
+		EnableTaskFairness           func() bool
+		FairnessKeyWeights           func() map[string]any
+		FairnessTopKeysCount         func() int
+		FairnessBacklogCountInterval func() time.Duration
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
@@ -106,7 +168,11 @@ type (
 )
 
 // NewConfig returns new service config with default values
-func NewConfig(dc *dynamicconfig.Collection) *Config {
+func NewConfig(
+	dc *dynamicconfig.Collection,
+	visibilityStoreConfigExist bool,
+	advancedVisibilityStoreConfigExist bool,
+) *Config {
 	defaultUpdateAckInterval := []dynamicconfig.ConstrainedValue{
 		// Use a longer default interval for the per-namespace internal worker queues.
 		{
@@ -134,6 +200,19 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		LongPollExpirationInterval:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:            dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		MaxBacklogScanTasks:                   dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxBacklogScanTasks, 100000),
+		MaxDelayedTasks:                       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxDelayedTasks, 1000),
+		MaxInflightActivityTasks:              dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxInflightActivityTasks, 0),
+		PollerIdentityDispatchRPS:             dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPollerIdentityDispatchRPS, 0),
+		StickyPollerUnavailableWindow:         dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingStickyPollerUnavailableWindow, 10*time.Second),
+		StickyMaxBacklog:                      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingStickyMaxBacklog, 0),
+		EnableTaskPriority:                    dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnableTaskPriority, false),
+		DefaultTaskPriority:                   dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingDefaultTaskPriority, 3),
+		TaskPriorityAgingInterval:             dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingTaskPriorityAgingInterval, time.Minute),
+		EnableTaskFairness:                    dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnableTaskFairness, false),
+		FairnessKeyWeights:                    dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingFairnessKeyWeights, map[string]any{}),
+		FairnessTopKeysCount:                  dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingFairnessTopKeysCount, 10),
+		FairnessBacklogCountInterval:          dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingFairnessBacklogCountInterval, 5*time.Minute),
 		OutstandingTaskAppendsThreshold:       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
 		ThrottledLogRPS:                       dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
@@ -146,9 +225,25 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ShutdownDrainDuration:                 dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0*time.Second),
 		MaxVersionGraphSize:                   dc.GetIntProperty(dynamicconfig.VersionGraphNodeLimit, 1000),
 		MetadataPollFrequency:                 dc.GetDurationProperty(dynamicconfig.MatchingMetadataPollFrequency, 5*time.Minute),
+		EnablePartitionAutoScale:              dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.MatchingEnablePartitionAutoScale, false),
+		PartitionAutoScaleMinPartitions:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingPartitionAutoScaleMinPartitions, 1),
+		PartitionAutoScaleMaxPartitions:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingPartitionAutoScaleMaxPartitions, 8),
+		PartitionAutoScaleTargetAddRate:       dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.MatchingPartitionAutoScaleTargetAddRate, 100),
+		PartitionAutoScaleTargetBacklog:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MatchingPartitionAutoScaleTargetBacklog, 10000),
+		PartitionAutoScaleInterval:            dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MatchingPartitionAutoScaleInterval, time.Minute),
+		PartitionConfigRefreshInterval:        dc.GetDurationProperty(dynamicconfig.MatchingPartitionConfigRefreshInterval, 20*time.Second),
+		EnableBuildIdScavenger:                dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.MatchingEnableBuildIdScavenger, false),
+		BuildIdScavengerInterval:              dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MatchingBuildIdScavengerInterval, time.Hour),
+		BuildIdRetention:                      dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MatchingBuildIdRetention, 14*24*time.Hour),
 
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
+
+		VisibilityPersistenceMaxReadQPS:   visibility.GetVisibilityPersistenceMaxReadQPS(dc, advancedVisibilityStoreConfigExist),
+		VisibilityPersistenceMaxWriteQPS:  visibility.GetVisibilityPersistenceMaxWriteQPS(dc, advancedVisibilityStoreConfigExist),
+		EnableReadFromSecondaryVisibility: visibility.GetEnableReadFromSecondaryVisibilityConfig(dc, visibilityStoreConfigExist, advancedVisibilityStoreConfigExist),
+		VisibilityDisableOrderByClause:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityDisableOrderByClause, true),
+		VisibilityEnableManualPagination:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityEnableManualPagination, true),
 	}
 }
 
@@ -179,6 +274,48 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(namespace.String(), taskQueueName, taskType)
 		},
+		MaxBacklogScanTasks: func() int {
+			return config.MaxBacklogScanTasks(namespace.String(), taskQueueName, taskType)
+		},
+		MaxDelayedTasks: func() int {
+			return config.MaxDelayedTasks(namespace.String(), taskQueueName, taskType)
+		},
+		MaxInflightActivityTasks: func() int {
+			if taskType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+				return 0
+			}
+			return config.MaxInflightActivityTasks(namespace.String(), taskQueueName, taskType)
+		},
+		PollerIdentityDispatchRPS: func() float64 {
+			return config.PollerIdentityDispatchRPS(namespace.String(), taskQueueName, taskType)
+		},
+		StickyPollerUnavailableWindow: func() time.Duration {
+			return config.StickyPollerUnavailableWindow(namespace.String(), taskQueueName, taskType)
+		},
+		StickyMaxBacklog: func() int {
+			return config.StickyMaxBacklog(namespace.String(), taskQueueName, taskType)
+		},
+		EnableTaskPriority: func() bool {
+			return config.EnableTaskPriority(namespace.String(), taskQueueName, taskType)
+		},
+		DefaultTaskPriority: func() int {
+			return config.DefaultTaskPriority(namespace.String(), taskQueueName, taskType)
+		},
+		TaskPriorityAgingInterval: func() time.Duration {
+			return config.TaskPriorityAgingInterval(namespace.String(), taskQueueName, taskType)
+		},
+		EnableTaskFairness: func() bool {
+			return config.EnableTaskFairness(namespace.String(), taskQueueName, taskType)
+		},
+		FairnessKeyWeights: func() map[string]any {
+			return config.FairnessKeyWeights(namespace.String())
+		},
+		FairnessTopKeysCount: func() int {
+			return config.FairnessTopKeysCount(namespace.String(), taskQueueName, taskType)
+		},
+		FairnessBacklogCountInterval: func() time.Duration {
+			return config.FairnessBacklogCountInterval(namespace.String(), taskQueueName, taskType)
+		},
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(namespace.String(), taskQueueName, taskType)
 		},
@@ -43,6 +43,11 @@ var (
 		"UpdateWorkerBuildIdCompatibility": 0,
 		"InvalidateTaskQueueMetadata":      0,
 		"GetTaskQueueMetadata":             0,
+		"DescribeTaskQueueBacklog":         0,
+		"MoveTaskQueueTasks":               0,
+		"PurgeTaskQueueTasks":              0,
+		"RecordActivityTaskClosed":         0,
+		"UpdateBuildIdRamp":                0,
 	}
 
 	APIPrioritiesOrdered = []int{0}
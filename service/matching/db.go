@@ -52,12 +52,15 @@ type (
 		sync.Mutex
 		namespaceID    namespace.ID
 		taskQueue      *taskQueueID
+		fullName       string // name the task queue is persisted under
 		taskQueueKind  enumspb.TaskQueueKind
 		rangeID        int64
 		ackLevel       int64
 		versioningData *persistencespb.VersioningData
-		store          persistence.TaskManager
-		logger         log.Logger
+		// only set on the root workflow partition, when partition auto scaling is enabled
+		partitionConfig *persistencespb.TaskQueuePartitionConfig
+		store           persistence.TaskManager
+		logger          log.Logger
 	}
 	taskQueueState struct {
 		rangeID  int64
@@ -68,6 +71,8 @@ type (
 var (
 	errVersioningDataNotPresentOnPartition = errors.New("versioning data is only present on root workflow partition")
 	errVersioningDataNoMutateNonRoot       = errors.New("can only mutate versioning data on root workflow task queue")
+	errPartitionConfigNoMutateNonRoot      = errors.New("can only mutate partition config on root workflow task queue")
+	errTaskQueueNotInitialized             = errors.New("task queue is not initialized")
 )
 
 // newTaskQueueDB returns an instance of an object that represents
@@ -84,6 +89,35 @@ func newTaskQueueDB(store persistence.TaskManager, namespaceID namespace.ID, tas
 	return &taskQueueDB{
 		namespaceID:   namespaceID,
 		taskQueue:     taskQueue,
+		fullName:      taskQueue.FullName(),
+		taskQueueKind: kind,
+		store:         store,
+		logger:        logger,
+	}
+}
+
+// newDelayedTaskQueueDB returns an instance of an object that represents the persistence view of the tasks of
+// a taskQueue which are read from its backlog before they are due. They are persisted as a task queue of their
+// own, with its own range, so that the backlog can be deleted up to its ack level.
+func newDelayedTaskQueueDB(store persistence.TaskManager, namespaceID namespace.ID, taskQueue *taskQueueID, kind enumspb.TaskQueueKind, logger log.Logger) *taskQueueDB {
+	return &taskQueueDB{
+		namespaceID:   namespaceID,
+		taskQueue:     taskQueue,
+		fullName:      taskQueue.DelayedTasksFullName(),
+		taskQueueKind: kind,
+		store:         store,
+		logger:        logger,
+	}
+}
+
+// newPriorityTaskQueueDB returns an instance of an object that represents the persistence view of the backlog of
+// the tasks of a taskQueue with the given priority. It is persisted as a task queue of its own, with its own range,
+// so that it is read apart from the backlog of the other priorities.
+func newPriorityTaskQueueDB(store persistence.TaskManager, namespaceID namespace.ID, taskQueue *taskQueueID, kind enumspb.TaskQueueKind, priority int, logger log.Logger) *taskQueueDB {
+	return &taskQueueDB{
+		namespaceID:   namespaceID,
+		taskQueue:     taskQueue,
+		fullName:      taskQueue.PriorityTasksFullName(priority),
 		taskQueueKind: kind,
 		store:         store,
 		logger:        logger,
@@ -117,12 +151,31 @@ func (db *taskQueueDB) RenewLease(
 	return taskQueueState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
 }
 
+// Exists returns true if the taskqueue is persisted, without taking over its lease.
+func (db *taskQueueDB) Exists(
+	ctx context.Context,
+) (bool, error) {
+	_, err := db.store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+		NamespaceID: db.namespaceID.String(),
+		TaskQueue:   db.fullName,
+		TaskType:    db.taskQueue.taskType,
+	})
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case *serviceerror.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (db *taskQueueDB) takeOverTaskQueueLocked(
 	ctx context.Context,
 ) error {
 	response, err := db.store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
 		NamespaceID: db.namespaceID.String(),
-		TaskQueue:   db.taskQueue.FullName(),
+		TaskQueue:   db.fullName,
 		TaskType:    db.taskQueue.taskType,
 	})
 	switch err.(type) {
@@ -141,6 +194,7 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 		db.ackLevel = response.TaskQueueInfo.AckLevel
 		db.rangeID = response.RangeID + 1
 		db.versioningData = response.TaskQueueInfo.VersioningData
+		db.partitionConfig = response.TaskQueueInfo.PartitionConfig
 		return nil
 
 	case *serviceerror.NotFound:
@@ -221,7 +275,7 @@ func (db *taskQueueDB) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	return db.store.GetTasks(ctx, &persistence.GetTasksRequest{
 		NamespaceID:        db.namespaceID.String(),
-		TaskQueue:          db.taskQueue.FullName(),
+		TaskQueue:          db.fullName,
 		TaskType:           db.taskQueue.taskType,
 		PageSize:           batchSize,
 		InclusiveMinTaskID: inclusiveMinTaskID,
@@ -237,7 +291,7 @@ func (db *taskQueueDB) CompleteTask(
 	err := db.store.CompleteTask(ctx, &persistence.CompleteTaskRequest{
 		TaskQueue: &persistence.TaskQueueKey{
 			NamespaceID:   db.namespaceID.String(),
-			TaskQueueName: db.taskQueue.FullName(),
+			TaskQueueName: db.fullName,
 			TaskQueueType: db.taskQueue.taskType,
 		},
 		TaskID: taskID,
@@ -248,7 +302,7 @@ func (db *taskQueueDB) CompleteTask(
 			tag.Error(err),
 			tag.TaskID(taskID),
 			tag.WorkflowTaskQueueType(db.taskQueue.taskType),
-			tag.WorkflowTaskQueueName(db.taskQueue.FullName()),
+			tag.WorkflowTaskQueueName(db.fullName),
 		)
 	}
 	return err
@@ -264,7 +318,7 @@ func (db *taskQueueDB) CompleteTasksLessThan(
 ) (int, error) {
 	n, err := db.store.CompleteTasksLessThan(ctx, &persistence.CompleteTasksLessThanRequest{
 		NamespaceID:        db.namespaceID.String(),
-		TaskQueueName:      db.taskQueue.FullName(),
+		TaskQueueName:      db.fullName,
 		TaskType:           db.taskQueue.taskType,
 		ExclusiveMaxTaskID: exclusiveMaxTaskID,
 		Limit:              limit,
@@ -275,7 +329,7 @@ func (db *taskQueueDB) CompleteTasksLessThan(
 			tag.Error(err),
 			tag.TaskID(exclusiveMaxTaskID),
 			tag.WorkflowTaskQueueType(db.taskQueue.taskType),
-			tag.WorkflowTaskQueueName(db.taskQueue.FullName()),
+			tag.WorkflowTaskQueueName(db.fullName),
 		)
 	}
 	return n, err
@@ -305,7 +359,7 @@ func (db *taskQueueDB) getVersioningDataLocked(
 
 	tqInfo, err := db.store.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
 		NamespaceID: db.namespaceID.String(),
-		TaskQueue:   db.taskQueue.FullName(),
+		TaskQueue:   db.fullName,
 		TaskType:    db.taskQueue.taskType,
 	})
 	if err != nil {
@@ -316,6 +370,15 @@ func (db *taskQueueDB) getVersioningDataLocked(
 	return tqInfo.TaskQueueInfo.GetVersioningData(), nil
 }
 
+// GetBuildIdRamp returns the build ID ramp of the cached versioning data for this task queue. Unlike GetVersioningData,
+// it never loads the data, so it's cheap enough to be called for every task: the root workflow partition loads the data
+// with its lease and the other partitions are sent the data by the root one.
+func (db *taskQueueDB) GetBuildIdRamp() *persistencespb.BuildIdRamp {
+	db.Lock()
+	defer db.Unlock()
+	return db.versioningData.GetRamp()
+}
+
 // MutateVersioningData allows callers to update versioning data for this task queue. The pointer passed to the
 // mutating function is guaranteed to be non-nil.
 //
@@ -353,6 +416,41 @@ func (db *taskQueueDB) MutateVersioningData(ctx context.Context, mutator func(*p
 	return verDat, err
 }
 
+// GetPartitionConfig returns the partition counts chosen by partition auto scaling, nil if there are none. Do not
+// mutate the returned pointer.
+func (db *taskQueueDB) GetPartitionConfig() *persistencespb.TaskQueuePartitionConfig {
+	db.Lock()
+	defer db.Unlock()
+	return db.partitionConfig
+}
+
+// UpdatePartitionConfig persists the partition counts chosen by partition auto scaling. A nil config removes them.
+func (db *taskQueueDB) UpdatePartitionConfig(
+	ctx context.Context,
+	partitionConfig *persistencespb.TaskQueuePartitionConfig,
+) error {
+	if !db.taskQueue.IsRoot() || db.taskQueue.taskType != enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		return errPartitionConfigNoMutateNonRoot
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if db.rangeID == 0 {
+		return errTaskQueueNotInitialized
+	}
+	queueInfo := db.cachedQueueInfo()
+	queueInfo.PartitionConfig = partitionConfig
+	_, err := db.updateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       db.rangeID,
+		TaskQueueInfo: queueInfo,
+		PrevRangeID:   db.rangeID,
+	})
+	if err == nil {
+		db.partitionConfig = partitionConfig
+	}
+	return err
+}
+
 func (db *taskQueueDB) setVersioningDataForNonRootPartition(verDat *persistencespb.VersioningData) {
 	db.Lock()
 	defer db.Unlock()
@@ -389,13 +487,14 @@ func (db *taskQueueDB) expiryTime() *time.Time {
 
 func (db *taskQueueDB) cachedQueueInfo() *persistencespb.TaskQueueInfo {
 	return &persistencespb.TaskQueueInfo{
-		NamespaceId:    db.namespaceID.String(),
-		Name:           db.taskQueue.FullName(),
-		TaskType:       db.taskQueue.taskType,
-		Kind:           db.taskQueueKind,
-		AckLevel:       db.ackLevel,
-		VersioningData: db.versioningData,
-		ExpiryTime:     db.expiryTime(),
-		LastUpdateTime: timestamp.TimeNowPtrUtc(),
+		NamespaceId:     db.namespaceID.String(),
+		Name:            db.fullName,
+		TaskType:        db.taskQueue.taskType,
+		Kind:            db.taskQueueKind,
+		AckLevel:        db.ackLevel,
+		VersioningData:  db.versioningData,
+		PartitionConfig: db.partitionConfig,
+		ExpiryTime:      db.expiryTime(),
+		LastUpdateTime:  timestamp.TimeNowPtrUtc(),
 	}
 }
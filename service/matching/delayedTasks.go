@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// delayedTasks tracks the backlog tasks which are read before their not-before time. Such tasks are parked:
+	// they are copied to a task queue of their own and acked in the backlog, so that they hold back neither the
+	// ack level nor the deletion of the backlog. Parked tasks are kept in memory, and are loaded again from their
+	// task queue when the task queue is loaded.
+	delayedTasks struct {
+		sync.Mutex
+		db          *taskQueueDB
+		rangeSize   int64
+		taskIDBlock taskIDBlock
+		nextTaskID  int64
+		waiting     delayedTaskHeap // parked tasks which are not dispatched yet
+		timer       *time.Timer     // only used by the taskReader's dispatch loop
+		loadedC     chan struct{}   // signals the dispatch loop once the parked tasks are loaded
+	}
+
+	delayedTask struct {
+		task      *persistencespb.AllocatedTaskInfo
+		notBefore time.Time
+	}
+
+	delayedTaskHeap []*delayedTask
+)
+
+func newDelayedTasks(db *taskQueueDB, rangeSize int64) *delayedTasks {
+	return &delayedTasks{
+		db:        db,
+		rangeSize: rangeSize,
+		loadedC:   make(chan struct{}, 1),
+	}
+}
+
+// isTaskDelayed returns true if the task must not be dispatched yet.
+func isTaskDelayed(taskInfo *persistencespb.TaskInfo, now time.Time) bool {
+	return timestamp.TimeValue(taskInfo.GetNotBefore()).After(now)
+}
+
+// taskVisibleTime returns the time since when the task could be dispatched, which is the later of its create
+// and not-before times.
+func taskVisibleTime(taskInfo *persistencespb.TaskInfo) time.Time {
+	createTime := timestamp.TimeValue(taskInfo.GetCreateTime())
+	if notBefore := timestamp.TimeValue(taskInfo.GetNotBefore()); notBefore.After(createTime) {
+		return notBefore
+	}
+	return createTime
+}
+
+// load takes over the task queue of the parked tasks and parks the tasks persisted by the previous owners.
+func (d *delayedTasks) load(ctx context.Context, batchSize int, now time.Time) error {
+	d.Lock()
+	defer d.Unlock()
+	state, err := d.db.RenewLease(ctx)
+	if err != nil {
+		return err
+	}
+	d.setTaskIDBlockLocked(state.rangeID)
+	d.waiting = nil
+	// tasks persisted by the previous owners all have IDs below the task ID block of this lease
+	for minTaskID := int64(0); minTaskID < d.taskIDBlock.start; {
+		response, err := d.db.GetTasks(ctx, minTaskID, d.taskIDBlock.start, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(response.Tasks) == 0 {
+			break
+		}
+		for _, task := range response.Tasks {
+			heap.Push(&d.waiting, &delayedTask{task: task, notBefore: timestamp.TimeValue(task.Data.GetNotBefore())})
+		}
+		minTaskID = response.Tasks[len(response.Tasks)-1].GetTaskId() + 1
+	}
+	d.resetTimerLocked(now)
+	select {
+	case d.loadedC <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// park persists a copy of a backlog task which is not due yet to the task queue of the parked tasks. The caller
+// acks the backlog task once it is parked.
+func (d *delayedTasks) park(ctx context.Context, taskInfo *persistencespb.TaskInfo, now time.Time) error {
+	d.Lock()
+	defer d.Unlock()
+	if d.nextTaskID > d.taskIDBlock.end {
+		state, err := d.db.RenewLease(ctx)
+		if err != nil {
+			return err
+		}
+		d.setTaskIDBlockLocked(state.rangeID)
+	}
+	task := &persistencespb.AllocatedTaskInfo{Data: taskInfo, TaskId: d.nextTaskID}
+	if _, err := d.db.CreateTasks(ctx, []*persistencespb.AllocatedTaskInfo{task}); err != nil {
+		return err
+	}
+	d.nextTaskID++
+	heap.Push(&d.waiting, &delayedTask{task: task, notBefore: timestamp.TimeValue(taskInfo.GetNotBefore())})
+	d.resetTimerLocked(now)
+	return nil
+}
+
+func (d *delayedTasks) setTaskIDBlockLocked(rangeID int64) {
+	d.taskIDBlock = rangeIDToTaskIDBlock(rangeID, d.rangeSize)
+	d.nextTaskID = d.taskIDBlock.start
+}
+
+// popDue returns a parked task which is due. The task stays persisted until it is completed.
+func (d *delayedTasks) popDue(now time.Time) (*persistencespb.AllocatedTaskInfo, bool) {
+	d.Lock()
+	defer d.Unlock()
+	if len(d.waiting) == 0 || d.waiting[0].notBefore.After(now) {
+		return nil, false
+	}
+	task := heap.Pop(&d.waiting).(*delayedTask)
+	d.resetTimerLocked(now)
+	return task.task, true
+}
+
+// complete deletes a parked task once it is dispatched or written back to the backlog.
+func (d *delayedTasks) complete(ctx context.Context, taskID int64) error {
+	return d.db.CompleteTask(ctx, taskID)
+}
+
+// waitingLen returns the number of parked tasks which are not dispatched yet.
+func (d *delayedTasks) waitingLen() int {
+	d.Lock()
+	defer d.Unlock()
+	return len(d.waiting)
+}
+
+// timerC returns a channel which fires when the next parked task is due, or nil if none is waiting.
+func (d *delayedTasks) timerC() <-chan time.Time {
+	d.Lock()
+	defer d.Unlock()
+	if d.timer == nil || len(d.waiting) == 0 {
+		return nil
+	}
+	return d.timer.C
+}
+
+func (d *delayedTasks) resetTimerLocked(now time.Time) {
+	if d.timer != nil && !d.timer.Stop() {
+		// drain the channel if the timer fired but was not received from
+		select {
+		case <-d.timer.C:
+		default:
+		}
+	}
+	if len(d.waiting) == 0 {
+		return
+	}
+	wait := d.waiting[0].notBefore.Sub(now)
+	if d.timer == nil {
+		d.timer = time.NewTimer(wait)
+	} else {
+		d.timer.Reset(wait)
+	}
+}
+
+func (d *delayedTasks) stop() {
+	d.Lock()
+	defer d.Unlock()
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+}
+
+func (h delayedTaskHeap) Len() int {
+	return len(h)
+}
+
+func (h delayedTaskHeap) Less(i, j int) bool {
+	if h[i].notBefore.Equal(h[j].notBefore) {
+		return h[i].task.GetTaskId() < h[j].task.GetTaskId()
+	}
+	return h[i].notBefore.Before(h[j].notBefore)
+}
+
+func (h delayedTaskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *delayedTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*delayedTask))
+}
+
+func (h *delayedTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return task
+}
@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestIsTaskDelayed(t *testing.T) {
+	now := time.Now().UTC()
+	later := now.Add(time.Minute)
+	earlier := now.Add(-time.Minute)
+
+	require.False(t, isTaskDelayed(&persistencespb.TaskInfo{CreateTime: &now}, now))
+	require.False(t, isTaskDelayed(&persistencespb.TaskInfo{CreateTime: &now, NotBefore: &earlier}, now))
+	require.True(t, isTaskDelayed(&persistencespb.TaskInfo{CreateTime: &now, NotBefore: &later}, now))
+
+	require.Equal(t, now, taskVisibleTime(&persistencespb.TaskInfo{CreateTime: &now, NotBefore: &earlier}))
+	require.Equal(t, later, taskVisibleTime(&persistencespb.TaskInfo{CreateTime: &now, NotBefore: &later}))
+}
+
+func TestDelayedTasks(t *testing.T) {
+	logger := log.NewNoopLogger()
+	tm := newTestTaskManager(logger)
+	tlID := newTestTaskQueueID(namespace.ID(uuid.New()), "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	newDB := func() *taskQueueDB {
+		return newDelayedTaskQueueDB(tm, tlID.namespaceID, tlID, enumspb.TASK_QUEUE_KIND_NORMAL, logger)
+	}
+	delayedKey := testTaskQueueKey{namespaceID: tlID.namespaceID.String(), name: tlID.DelayedTasksFullName(), taskType: tlID.taskType}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	later := now.Add(time.Hour)
+
+	// the parked tasks of a previous owner are loaded
+	previous := newDelayedTasks(newDB(), 10)
+	require.NoError(t, previous.load(ctx, 10, now))
+	require.NoError(t, previous.park(ctx, &persistencespb.TaskInfo{NotBefore: &later}, now))
+	previous.stop()
+
+	delayed := newDelayedTasks(newDB(), 10)
+	defer delayed.stop()
+	require.NoError(t, delayed.load(ctx, 10, now))
+	require.NoError(t, delayed.park(ctx, &persistencespb.TaskInfo{NotBefore: timestamp.TimePtr(now.Add(50 * time.Millisecond))}, now))
+	require.Equal(t, 2, delayed.waitingLen())
+	require.Equal(t, 2, tm.getTaskQueueManagerByKey(delayedKey).tasks.Size())
+	_, ok := delayed.popDue(now)
+	require.False(t, ok)
+
+	// the task due first is popped first, and stays persisted until it is completed
+	<-delayed.timerC()
+	task, ok := delayed.popDue(time.Now())
+	require.True(t, ok)
+	require.Equal(t, int64(11), task.GetTaskId())
+	require.Equal(t, 1, delayed.waitingLen())
+	_, ok = delayed.popDue(time.Now())
+	require.False(t, ok)
+	require.NoError(t, delayed.complete(ctx, task.GetTaskId()))
+	require.Equal(t, 1, tm.getTaskQueueManagerByKey(delayedKey).tasks.Size())
+
+	task, ok = delayed.popDue(later)
+	require.True(t, ok)
+	require.Equal(t, int64(1), task.GetTaskId())
+	require.NoError(t, delayed.complete(ctx, task.GetTaskId()))
+	require.Zero(t, tm.getTaskQueueManagerByKey(delayedKey).tasks.Size())
+	require.Nil(t, delayed.timerC())
+
+	// a new task ID block is leased once the block is exhausted
+	for i := 0; i < 11; i++ {
+		require.NoError(t, delayed.park(ctx, &persistencespb.TaskInfo{NotBefore: &later}, now))
+	}
+	require.Equal(t, int64(3), tm.getTaskQueueManagerByKey(delayedKey).RangeID())
+}
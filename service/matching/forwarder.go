@@ -30,6 +30,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -154,6 +155,11 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.FullName(),
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
+			WorkflowTypeName:       task.event.Data.GetWorkflowTypeName(),
+			NotBefore:              task.event.Data.GetNotBefore(),
+			BuildIdAssignment:      task.event.Data.GetBuildIdAssignment(),
 		})
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -168,6 +174,10 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                 task.source,
 			ScheduleToStartTimeout: &expirationDuration,
 			ForwardedSource:        fwdr.taskQueueID.FullName(),
+			Priority:               task.event.Data.GetPriority(),
+			FairnessKey:            task.event.Data.GetFairnessKey(),
+			WorkflowTypeName:       task.event.Data.GetWorkflowTypeName(),
+			NotBefore:              task.event.Data.GetNotBefore(),
 		})
 	default:
 		return errInvalidTaskQueueType
@@ -198,8 +208,9 @@ func (fwdr *Forwarder) ForwardQueryTask(
 			Name: target.FullName(),
 			Kind: fwdr.taskQueueKind,
 		},
-		QueryRequest:    task.query.request.QueryRequest,
-		ForwardedSource: fwdr.taskQueueID.FullName(),
+		QueryRequest:      task.query.request.QueryRequest,
+		ForwardedSource:   fwdr.taskQueueID.FullName(),
+		BuildIdAssignment: task.query.request.GetBuildIdAssignment(),
 	})
 
 	return resp, fwdr.handleErr(err)
@@ -219,6 +230,11 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	metadata, _ := ctx.Value(pollMetadataKey).(*pollMetadata)
+	var capabilities *commonpb.WorkerVersionCapabilities
+	if metadata != nil {
+		capabilities = metadata.workerVersionCapabilities
+	}
 
 	switch fwdr.taskQueueID.taskType {
 	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
@@ -230,7 +246,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Name: target.FullName(),
 					Kind: fwdr.taskQueueKind,
 				},
-				Identity: identity,
+				Identity:                  identity,
+				WorkerVersionCapabilities: capabilities,
 			},
 			ForwardedSource: fwdr.taskQueueID.FullName(),
 		})
@@ -247,7 +264,8 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Name: target.FullName(),
 					Kind: fwdr.taskQueueKind,
 				},
-				Identity: identity,
+				Identity:                  identity,
+				WorkerVersionCapabilities: capabilities,
 			},
 			ForwardedSource: fwdr.taskQueueID.FullName(),
 		})
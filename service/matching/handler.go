@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
 type (
@@ -75,6 +76,7 @@ func NewHandler(
 	metricsHandler metrics.Handler,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	visibilityManager manager.VisibilityManager,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -91,6 +93,7 @@ func NewHandler(
 			namespaceRegistry,
 			matchingServiceResolver,
 			clusterMetadata,
+			visibilityManager,
 		),
 		namespaceRegistry: namespaceRegistry,
 	}
@@ -324,6 +327,52 @@ func (h *Handler) GetTaskQueueMetadata(
 	return h.engine.GetTaskQueueMetadata(ctx, request)
 }
 
+// DescribeTaskQueueBacklog summarizes the persisted backlog of a task queue partition
+func (h *Handler) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	request *matchingservice.DescribeTaskQueueBacklogRequest,
+) (_ *matchingservice.DescribeTaskQueueBacklogResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DescribeTaskQueueBacklog(ctx, request)
+}
+
+// MoveTaskQueueTasks moves backlog tasks of a task queue partition to another task queue
+func (h *Handler) MoveTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.MoveTaskQueueTasksRequest,
+) (_ *matchingservice.MoveTaskQueueTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.MoveTaskQueueTasks(ctx, request)
+}
+
+// PurgeTaskQueueTasks deletes backlog tasks of a task queue partition
+func (h *Handler) PurgeTaskQueueTasks(
+	ctx context.Context,
+	request *matchingservice.PurgeTaskQueueTasksRequest,
+) (_ *matchingservice.PurgeTaskQueueTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.PurgeTaskQueueTasks(ctx, request)
+}
+
+// RecordActivityTaskClosed releases the inflight slot of a closed activity task
+func (h *Handler) RecordActivityTaskClosed(
+	ctx context.Context,
+	request *matchingservice.RecordActivityTaskClosedRequest,
+) (_ *matchingservice.RecordActivityTaskClosedResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.RecordActivityTaskClosed(ctx, request)
+}
+
+// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions of a
+// task queue
+func (h *Handler) UpdateBuildIdRamp(
+	ctx context.Context,
+	request *matchingservice.UpdateBuildIdRampRequest,
+) (_ *matchingservice.UpdateBuildIdRampResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.UpdateBuildIdRamp(ctx, request)
+}
+
 func (h *Handler) namespaceName(id namespace.ID) namespace.Name {
 	entry, err := h.namespaceRegistry.GetNamespaceByID(id)
 	if err != nil {
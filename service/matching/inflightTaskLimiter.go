@@ -0,0 +1,163 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// inflightTaskLimiter limits the number of activity tasks of a task queue partition which were dispatched to
+	// workers and are not closed yet. A poller reserves a slot before it is matched with a task and keeps it while
+	// the task runs. The slot is released when history reports the task as closed, or once the start-to-close
+	// timeout of the task passes in case that report is lost.
+	//
+	// The task queue limit is divided evenly across the read partitions and each partition enforces its share
+	// on its own, so the total number of inflight tasks can stay below the limit while one partition is full.
+	inflightTaskLimiter struct {
+		limit func() int
+
+		sync.Mutex
+		reserved  int
+		started   map[inflightTaskKey]*time.Timer
+		releasedC chan struct{} // closed and replaced whenever a slot is released
+	}
+
+	inflightTaskKey struct {
+		runID            string
+		scheduledEventID int64
+		attempt          int32
+	}
+
+	// inflightSlot is a slot reserved by a poller. A nil slot is valid and means the number of inflight tasks is
+	// not limited.
+	inflightSlot struct {
+		limiter *inflightTaskLimiter
+	}
+)
+
+func newInflightTaskLimiter(limit func() int) *inflightTaskLimiter {
+	return &inflightTaskLimiter{
+		limit:     limit,
+		started:   make(map[inflightTaskKey]*time.Timer),
+		releasedC: make(chan struct{}),
+	}
+}
+
+// acquire blocks until a slot is available and reserves it. Returns a nil slot if the number of inflight tasks is
+// not limited.
+func (l *inflightTaskLimiter) acquire(ctx context.Context) (*inflightSlot, error) {
+	for {
+		limit := l.limit()
+		if limit <= 0 {
+			return nil, nil
+		}
+
+		l.Lock()
+		if l.reserved+len(l.started) < limit {
+			l.reserved++
+			l.Unlock()
+			return &inflightSlot{limiter: l}, nil
+		}
+		releasedC := l.releasedC
+		l.Unlock()
+
+		select {
+		case <-releasedC:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// close releases the slot held by a started task. Returns false if the task holds no slot.
+func (l *inflightTaskLimiter) close(key inflightTaskKey) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	timer, ok := l.started[key]
+	if !ok {
+		return false
+	}
+	timer.Stop()
+	delete(l.started, key)
+	l.signalReleasedLocked()
+	return true
+}
+
+// count returns the number of reserved slots and slots held by started tasks.
+func (l *inflightTaskLimiter) count() int {
+	l.Lock()
+	defer l.Unlock()
+	return l.reserved + len(l.started)
+}
+
+func (l *inflightTaskLimiter) signalReleasedLocked() {
+	close(l.releasedC)
+	l.releasedC = make(chan struct{})
+}
+
+// start keeps the slot for the started task until the task is closed or the timeout passes.
+func (s *inflightSlot) start(key inflightTaskKey, timeout time.Duration) {
+	if s == nil {
+		return
+	}
+	l := s.limiter
+	l.Lock()
+	defer l.Unlock()
+
+	l.reserved--
+	if timer, ok := l.started[key]; ok {
+		// task was started twice, e.g. after a retried RecordActivityTaskStarted call, and keeps a single slot
+		timer.Stop()
+		l.signalReleasedLocked()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(timeout, func() {
+		l.Lock()
+		defer l.Unlock()
+		// the task may have been closed and started again with the same key since the timer fired
+		if l.started[key] == timer {
+			delete(l.started, key)
+			l.signalReleasedLocked()
+		}
+	})
+	l.started[key] = timer
+}
+
+// release gives back the slot when the poller did not start a task with it.
+func (s *inflightSlot) release() {
+	if s == nil {
+		return
+	}
+	l := s.limiter
+	l.Lock()
+	defer l.Unlock()
+
+	l.reserved--
+	l.signalReleasedLocked()
+}
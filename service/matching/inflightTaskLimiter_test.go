@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInflightTaskLimiter(t *testing.T) {
+	limiter := newInflightTaskLimiter(func() int { return 2 })
+	key1 := inflightTaskKey{runID: "run", scheduledEventID: 5, attempt: 1}
+	key2 := inflightTaskKey{runID: "run", scheduledEventID: 7, attempt: 1}
+
+	slot1, err := limiter.acquire(context.Background())
+	require.NoError(t, err)
+	slot2, err := limiter.acquire(context.Background())
+	require.NoError(t, err)
+	slot1.start(key1, time.Hour)
+	slot2.start(key2, time.Hour)
+	require.Equal(t, 2, limiter.count())
+
+	// all slots are held by started tasks
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	_, err = limiter.acquire(ctx)
+	cancel()
+	require.Error(t, err)
+
+	acquiredC := make(chan *inflightSlot)
+	go func() {
+		slot, _ := limiter.acquire(context.Background())
+		acquiredC <- slot
+	}()
+	require.True(t, limiter.close(key1))
+	require.False(t, limiter.close(key1))
+	slot := <-acquiredC
+	require.NotNil(t, slot)
+
+	slot.release()
+	require.Equal(t, 1, limiter.count())
+}
+
+func TestInflightTaskLimiter_StartedTaskTimesOut(t *testing.T) {
+	limiter := newInflightTaskLimiter(func() int { return 1 })
+	key := inflightTaskKey{runID: "run", scheduledEventID: 5, attempt: 1}
+
+	slot, err := limiter.acquire(context.Background())
+	require.NoError(t, err)
+	slot.start(key, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = limiter.acquire(ctx)
+	require.NoError(t, err)
+	require.False(t, limiter.close(key))
+}
+
+func TestInflightTaskLimiter_Unlimited(t *testing.T) {
+	limiter := newInflightTaskLimiter(func() int { return 0 })
+
+	slot, err := limiter.acquire(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, slot)
+	// a nil slot is a no-op
+	slot.start(inflightTaskKey{runID: "run"}, time.Hour)
+	slot.release()
+	require.Equal(t, 0, limiter.count())
+}
@@ -30,8 +30,12 @@ import (
 	"sync"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
 )
@@ -58,6 +62,10 @@ type TaskMatcher struct {
 	forceRefreshRateOnce sync.Once
 	// rateLimiter that limits the rate at which tasks can be dispatched to consumers
 	rateLimiter quotas.RateLimiter
+	// identityRateLimiters limit the rate at which tasks are dispatched to pollers with the same identity
+	identityRateLimiters cache.Cache
+	// inflightTasks limits the number of dispatched activity tasks which are not closed yet
+	inflightTasks *inflightTaskLimiter
 
 	fwdr           *Forwarder
 	metricsHandler metrics.Handler // namespace metric scope
@@ -67,6 +75,9 @@ type TaskMatcher struct {
 const (
 	defaultTaskDispatchRPS    = 100000.0
 	defaultTaskDispatchRPSTTL = time.Minute
+
+	identityRateLimitersMaxSize = 1000
+	identityRateLimitersTTL     = 5 * time.Minute
 )
 
 // newTaskMatcher returns an task matcher instance. The returned instance can be
@@ -95,11 +106,23 @@ func newTaskMatcher(config *taskQueueConfig, fwdr *Forwarder, metricsHandler met
 		dynamicRateBurst:   dynamicRateBurst,
 		dynamicRateLimiter: dynamicRateLimiter,
 		rateLimiter:        limiter,
-		metricsHandler:     metricsHandler,
-		fwdr:               fwdr,
-		taskC:              make(chan *internalTask),
-		queryTaskC:         make(chan *internalTask),
-		numPartitions:      config.NumReadPartitions,
+		identityRateLimiters: cache.New(identityRateLimitersMaxSize, &cache.Options{
+			TTL: identityRateLimitersTTL,
+		}),
+		inflightTasks: newInflightTaskLimiter(func() int {
+			maxTasks := config.MaxInflightActivityTasks()
+			if maxTasks <= 0 {
+				return 0
+			}
+			// divide the limit across all partitions, rounding up so that every partition can dispatch tasks.
+			// Partitions do not share unused slots, so the limit is approximate.
+			return int(math.Ceil(float64(maxTasks) / float64(config.NumReadPartitions())))
+		}),
+		metricsHandler: metricsHandler,
+		fwdr:           fwdr,
+		taskC:          make(chan *internalTask),
+		queryTaskC:     make(chan *internalTask),
+		numPartitions:  config.NumReadPartitions,
 	}
 }
 
@@ -288,8 +311,34 @@ forLoop:
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
+//
+// Before matching with a task, the poller waits for the dispatch rate limit of its identity
+// and for a slot in the inflight activity task limit. The slot is handed over with the task.
+// Returns ResourceExhausted if the identity rate limit does not allow a task before the
+// context deadline.
 func (tm *TaskMatcher) Poll(ctx context.Context) (*internalTask, error) {
-	return tm.poll(ctx, false)
+	identity, _ := ctx.Value(identityKey).(string)
+	if err := tm.waitForIdentityRateLimit(ctx, identity); err != nil {
+		tm.metricsHandler.Counter(metrics.PollThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
+		if ctx.Err() != nil {
+			return nil, ErrNoTasks
+		}
+		return nil, err
+	}
+	slot, err := tm.inflightTasks.acquire(ctx)
+	if err != nil {
+		// only fails once the context is done, which is a poll without tasks like in poll
+		tm.metricsHandler.Counter(metrics.PollThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
+		return nil, ErrNoTasks
+	}
+
+	task, err := tm.poll(ctx, false)
+	if err != nil {
+		slot.release()
+		return nil, err
+	}
+	task.inflightSlot = slot
+	return task, nil
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
@@ -332,6 +381,46 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.rateLimiter.Rate()
 }
 
+// CloseInflightTask releases the slot held by a dispatched activity task in the inflight activity task limit.
+// Returns false if the task holds no slot.
+func (tm *TaskMatcher) CloseInflightTask(key inflightTaskKey) bool {
+	return tm.inflightTasks.close(key)
+}
+
+func (tm *TaskMatcher) waitForIdentityRateLimit(ctx context.Context, identity string) error {
+	if identity == "" || tm.identityDispatchRate() <= 0 {
+		return nil
+	}
+	limiter, ok := tm.identityRateLimiters.Get(identity).(quotas.RateLimiter)
+	if !ok {
+		value, err := tm.identityRateLimiters.PutIfNotExist(
+			identity,
+			quotas.RateLimiter(quotas.NewDefaultOutgoingRateLimiter(tm.identityDispatchRate)),
+		)
+		if err != nil {
+			return err
+		}
+		limiter = value.(quotas.RateLimiter)
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		// the wait would exceed the context deadline
+		return serviceerror.NewResourceExhausted(
+			enumspb.RESOURCE_EXHAUSTED_CAUSE_RPS_LIMIT,
+			"poller identity dispatch rate limit exceeded",
+		)
+	}
+	return nil
+}
+
+// identityDispatchRate returns the dispatch rate limit of a poller identity on this partition
+func (tm *TaskMatcher) identityDispatchRate() float64 {
+	// pollers are spread over all partitions, so divide the rate equally across them
+	return tm.config.PollerIdentityDispatchRPS() / float64(tm.numPartitions())
+}
+
 func (tm *TaskMatcher) poll(ctx context.Context, queryOnly bool) (*internalTask, error) {
 	taskC, queryTaskC := tm.taskC, tm.queryTaskC
 	if queryOnly {
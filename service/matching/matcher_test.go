@@ -69,7 +69,7 @@ func TestMatcherSuite(t *testing.T) {
 func (t *MatcherTestSuite) SetupTest() {
 	t.controller = gomock.NewController(t.T())
 	t.client = matchingservicemock.NewMockMatchingServiceClient(t.controller)
-	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 
 	n := mustFromBaseName("tl0").WithPartition(1)
 	t.taskQueue = newTestTaskQueueID(namespace.ID(uuid.New()), n.FullName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
@@ -479,6 +479,31 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestPollerIdentityRateLimit() {
+	t.rootMatcher.config.PollerIdentityDispatchRPS = func() float64 { return 1 }
+
+	go func() {
+		for i := 0; i < 2; i++ {
+			task := newInternalTask(randomTaskInfo(), nil, enumsspb.TASK_SOURCE_HISTORY, "", false)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			_ = t.rootMatcher.MustOffer(ctx, task)
+			cancel()
+		}
+	}()
+
+	poll := func(identity string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		_, err := t.rootMatcher.Poll(context.WithValue(ctx, identityKey, identity))
+		return err
+	}
+	t.NoError(poll("worker1"))
+	// the rate limit of worker1 allows its next task only after the poll times out
+	var resourceExhausted *serviceerror.ResourceExhausted
+	t.ErrorAs(poll("worker1"), &resourceExhausted)
+	t.NoError(poll("worker2"))
+}
+
 func randomTaskInfo() *persistencespb.AllocatedTaskInfo {
 	rt1 := time.Date(rand.Intn(9999), time.Month(rand.Intn(12)+1), rand.Intn(28)+1, rand.Intn(24)+1, rand.Intn(60), rand.Intn(60), rand.Intn(1e9), time.UTC)
 	rt2 := time.Date(rand.Intn(5000)+3000, time.Month(rand.Intn(12)+1), rand.Intn(28)+1, rand.Intn(24)+1, rand.Intn(60), rand.Intn(60), rand.Intn(1e9), time.UTC)
@@ -52,25 +52,26 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
-	// If sticky poller is not seem in last 10s, we treat it as sticky worker unavailable
-	// This seems aggressive, but the default sticky schedule_to_start timeout is 5s, so 10s seems reasonable.
-	stickyPollerUnavailableWindow = 10 * time.Second
-
 	recordTaskStartedDefaultTimeout   = 10 * time.Second
 	recordTaskStartedSyncMatchTimeout = 1 * time.Second
+
+	// Inflight slot of an activity task without timeouts is released after this duration
+	defaultInflightActivityTaskTimeout = 10 * time.Minute
 )
 
 // Implements matching.Engine
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey     string
+	identityCtxKey     string
+	pollMetadataCtxKey string
 
 	// lockableQueryTaskMap maps query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel
 	// that QueryWorkflow() will block on. The channel is unblocked either by worker sending response through
@@ -103,6 +104,7 @@ type (
 		namespaceRegistry    namespace.Registry
 		keyResolver          membership.ServiceResolver
 		clusterMeta          cluster.Metadata
+		visibilityManager    manager.VisibilityManager
 	}
 )
 
@@ -116,8 +118,9 @@ var (
 	ErrNoTasks    = errors.New("no tasks")
 	errPumpClosed = errors.New("task queue pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey     pollerIDCtxKey     = "pollerID"
+	identityKey     identityCtxKey     = "identity"
+	pollMetadataKey pollMetadataCtxKey = "pollMetadata"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -133,6 +136,7 @@ func NewEngine(
 	namespaceRegistry namespace.Registry,
 	resolver membership.ServiceResolver,
 	clusterMeta cluster.Metadata,
+	visibilityManager manager.VisibilityManager,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -150,6 +154,7 @@ func NewEngine(
 		namespaceRegistry:    namespaceRegistry,
 		keyResolver:          resolver,
 		clusterMeta:          clusterMeta,
+		visibilityManager:    visibilityManager,
 	}
 }
 
@@ -240,6 +245,59 @@ func (e *matchingEngineImpl) getTaskQueueManager(ctx context.Context, taskQueue
 	return tqm, nil
 }
 
+// getRampTaskQueueManager returns the manager of the task queue holding the tasks routed to the ramping build ID of the
+// given task queue if the workflow run goes to that build ID, nil otherwise. The runs which are not assigned to a build
+// ID yet are part of the ramp depending on their workflow ID, the other runs only if they are assigned to the ramping
+// build ID. Only the normal workflow task queues which are not already split by build ID have a ramp.
+func (e *matchingEngineImpl) getRampTaskQueueManager(
+	ctx context.Context,
+	tqm taskQueueManager,
+	workflowID string,
+	assignment *persistencespb.BuildIdAssignment,
+) (taskQueueManager, error) {
+	if tqm.TaskQueueKind() != enumspb.TASK_QUEUE_KIND_NORMAL || tqm.QueueID().VersionSet() != "" {
+		return nil, nil
+	}
+	ramp, err := tqm.GetBuildIdRamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if assignment != nil {
+		if assignment.GetBuildId() == "" || assignment.GetBuildId() != ramp.GetBuildId() {
+			return nil, nil
+		}
+	} else if !isWorkflowRamped(ramp, workflowID) {
+		return nil, nil
+	}
+	rampTaskQueue := newTaskQueueIDWithVersionSet(tqm.QueueID(), buildIdVersionSet(ramp.GetBuildId()))
+	return e.getTaskQueueManager(ctx, rampTaskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, true)
+}
+
+// getPollerBuildIdTaskQueue returns the task queue a workflow task poller running the given build ID polls from: the
+// task queue holding the tasks routed to the ramping build ID if the poller runs it, the given task queue otherwise.
+func (e *matchingEngineImpl) getPollerBuildIdTaskQueue(
+	ctx context.Context,
+	taskQueue *taskQueueID,
+	taskQueueKind enumspb.TaskQueueKind,
+	buildID string,
+) (*taskQueueID, error) {
+	if buildID == "" || taskQueueKind != enumspb.TASK_QUEUE_KIND_NORMAL || taskQueue.VersionSet() != "" {
+		return taskQueue, nil
+	}
+	tqm, err := e.getTaskQueueManager(ctx, taskQueue, taskQueueKind, true)
+	if err != nil {
+		return nil, err
+	}
+	ramp, err := tqm.GetBuildIdRamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ramp.GetBuildId() != buildID {
+		return taskQueue, nil
+	}
+	return newTaskQueueIDWithVersionSet(taskQueue, buildIdVersionSet(buildID)), nil
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskQueue(taskQueue *taskQueueID, mgr taskQueueManager) {
 	e.taskQueuesLock.Lock()
@@ -266,9 +324,25 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	tqm, err := e.getTaskQueueManager(ctx, taskQueue, taskQueueKind, !sticky)
 	if err != nil {
 		return false, err
-	} else if sticky && (tqm == nil || !tqm.HasPollerAfter(time.Now().Add(-stickyPollerUnavailableWindow))) {
+	} else if sticky && (tqm == nil || !tqm.IsStickyWorkerPolling()) {
+		return false, serviceerrors.NewStickyWorkerUnavailable()
+	} else if sticky && tqm.IsStickyWorkerSaturated() {
+		// history sends the task to the normal task queue instead, where any worker can pick it up
+		nsName, _ := e.namespaceRegistry.GetNamespaceName(namespaceID)
+		metrics.GetPerTaskQueueScope(e.metricsHandler, nsName.String(), taskQueue.FullName(), taskQueueKind).
+			Counter(metrics.StickyWorkerSaturatedCounter.GetMetricName()).Record(1)
 		return false, serviceerrors.NewStickyWorkerUnavailable()
 	}
+	if addRequest.GetForwardedSource() == "" {
+		// tasks forwarded by a child partition were already routed by it
+		rampTqm, err := e.getRampTaskQueueManager(ctx, tqm, addRequest.Execution.GetWorkflowId(), addRequest.GetBuildIdAssignment())
+		if err != nil {
+			return false, err
+		} else if rampTqm != nil {
+			e.metricsHandler.Counter(metrics.BuildIdRampedWorkflowTasksCounter.GetMetricName()).Record(1)
+			tqm = rampTqm
+		}
+	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *time.Time
@@ -276,17 +350,26 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
 	if expirationDuration == 0 {
 		// noop
+	} else if notBefore := timestamp.TimeValue(addRequest.GetNotBefore()); notBefore.After(*now) {
+		// schedule to start timeout starts when the task becomes visible
+		expirationTime = timestamp.TimePtr(notBefore.Add(expirationDuration))
 	} else {
 		expirationTime = timestamp.TimePtr(now.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
-		NamespaceId:      namespaceID.String(),
-		RunId:            addRequest.Execution.GetRunId(),
-		WorkflowId:       addRequest.Execution.GetWorkflowId(),
-		ScheduledEventId: addRequest.GetScheduledEventId(),
-		Clock:            addRequest.GetClock(),
-		ExpiryTime:       expirationTime,
-		CreateTime:       now,
+		NamespaceId:       namespaceID.String(),
+		RunId:             addRequest.Execution.GetRunId(),
+		WorkflowId:        addRequest.Execution.GetWorkflowId(),
+		ScheduledEventId:  addRequest.GetScheduledEventId(),
+		Clock:             addRequest.GetClock(),
+		ExpiryTime:        expirationTime,
+		CreateTime:        now,
+		Priority:          addRequest.GetPriority(),
+		FairnessKey:       addRequest.GetFairnessKey(),
+		WorkflowTypeName:  addRequest.GetWorkflowTypeName(),
+		NotBefore:         addRequest.GetNotBefore(),
+		NormalTaskQueue:   addRequest.GetNormalTaskQueue(),
+		BuildIdAssignment: addRequest.GetBuildIdAssignment(),
 	}
 
 	return tqm.AddTask(ctx, addTaskParams{
@@ -322,6 +405,9 @@ func (e *matchingEngineImpl) AddActivityTask(
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
 	if expirationDuration == 0 {
 		// noop
+	} else if notBefore := timestamp.TimeValue(addRequest.GetNotBefore()); notBefore.After(*now) {
+		// schedule to start timeout starts when the task becomes visible
+		expirationTime = timestamp.TimePtr(notBefore.Add(expirationDuration))
 	} else {
 		expirationTime = timestamp.TimePtr(now.Add(expirationDuration))
 	}
@@ -333,6 +419,10 @@ func (e *matchingEngineImpl) AddActivityTask(
 		Clock:            addRequest.GetClock(),
 		CreateTime:       now,
 		ExpiryTime:       expirationTime,
+		Priority:         addRequest.GetPriority(),
+		FairnessKey:      addRequest.GetFairnessKey(),
+		WorkflowTypeName: addRequest.GetWorkflowTypeName(),
+		NotBefore:        addRequest.GetNotBefore(),
 	}
 
 	return tlMgr.AddTask(ctx, addTaskParams{
@@ -364,11 +454,19 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, pollMetadataKey, newPollMetadata(ctx, request.GetWorkerVersionCapabilities(), req.GetForwardedSource()))
 		taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 		if err != nil {
 			return nil, err
 		}
 		taskQueueKind := request.TaskQueue.GetKind()
+		if req.GetForwardedSource() == "" {
+			// polls forwarded by a child partition were already routed by it
+			taskQueue, err = e.getPollerBuildIdTaskQueue(pollerCtx, taskQueue, taskQueueKind, request.GetWorkerVersionCapabilities().GetBuildId())
+			if err != nil {
+				return nil, err
+			}
+		}
 		task, err := e.getTask(pollerCtx, taskQueue, nil, taskQueueKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -436,6 +534,9 @@ pollLoop:
 			case *serviceerrors.TaskAlreadyStarted:
 				e.logger.Debug("Duplicated workflow task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
 				task.finish(nil)
+			case *serviceerror.FailedPrecondition: // workflow paused, the task is dispatched again when it's unpaused
+				e.logger.Debug("Workflow of workflow task is paused", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
+				task.finish(nil)
 			default:
 				task.finish(err)
 				if err.Error() == common.ErrNamespaceHandover.Error() {
@@ -485,6 +586,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, pollMetadataKey, newPollMetadata(ctx, request.GetWorkerVersionCapabilities(), req.GetForwardedSource()))
 		taskQueueKind := request.TaskQueue.GetKind()
 		task, err := e.getTask(pollerCtx, taskQueue, maxDispatch, taskQueueKind)
 		if err != nil {
@@ -499,11 +601,14 @@ pollLoop:
 
 		if task.isStarted() {
 			// tasks received from remote are already started. So, simply forward the response
+			// the partition which started the task holds its inflight slot
+			task.inflightSlot.release()
 			return task.pollActivityTaskQueueResponse(), nil
 		}
 
 		resp, err := e.recordActivityTaskStarted(ctx, request, task)
 		if err != nil {
+			task.inflightSlot.release()
 			switch err.(type) {
 			case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
 				e.logger.Info("Activity task not found",
@@ -520,6 +625,9 @@ pollLoop:
 			case *serviceerrors.TaskAlreadyStarted:
 				e.logger.Debug("Duplicated activity task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
 				task.finish(nil)
+			case *serviceerror.FailedPrecondition: // workflow paused, the task is dispatched again when it's unpaused
+				e.logger.Debug("Workflow of activity task is paused", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
+				task.finish(nil)
 			default:
 				task.finish(err)
 				if err.Error() == common.ErrNamespaceHandover.Error() {
@@ -532,7 +640,8 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		inflightTaskQueue := e.startInflightActivityTask(task, taskQueue, resp)
+		return e.createPollActivityTaskQueueResponse(task, resp, inflightTaskQueue, opMetrics), nil
 	}
 }
 
@@ -560,9 +669,18 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	tqm, err := e.getTaskQueueManager(ctx, taskQueue, taskQueueKind, !sticky)
 	if err != nil {
 		return nil, err
-	} else if sticky && (tqm == nil || !tqm.HasPollerAfter(time.Now().Add(-stickyPollerUnavailableWindow))) {
+	} else if sticky && (tqm == nil || !tqm.IsStickyWorkerPolling()) {
 		return nil, serviceerrors.NewStickyWorkerUnavailable()
 	}
+	if queryRequest.GetForwardedSource() == "" {
+		// queries go to the workers of the build ID the workflow's tasks are routed to
+		rampTqm, err := e.getRampTaskQueueManager(ctx, tqm, queryRequest.GetQueryRequest().GetExecution().GetWorkflowId(), queryRequest.GetBuildIdAssignment())
+		if err != nil {
+			return nil, err
+		} else if rampTqm != nil {
+			tqm = rampTqm
+		}
+	}
 
 	taskID := uuid.New()
 	resp, err := tqm.DispatchQueryTask(ctx, taskID, queryRequest)
@@ -660,7 +778,16 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
+	response := tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus())
+	if request.DescRequest.GetIncludeTaskQueueStatus() {
+		if response.FairnessKeyBacklogs, err = tlMgr.DescribeFairnessKeyBacklogs(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if request.GetIncludeWorkers() {
+		response.Workers = tlMgr.GetWorkers()
+	}
+	return response, nil
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
@@ -732,6 +859,28 @@ func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
 	return &matchingservice.UpdateWorkerBuildIdCompatibilityResponse{}, nil
 }
 
+func (e *matchingEngineImpl) UpdateBuildIdRamp(
+	ctx context.Context,
+	req *matchingservice.UpdateBuildIdRampRequest,
+) (*matchingservice.UpdateBuildIdRampResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, true)
+	if err != nil {
+		return nil, err
+	}
+	err = tqMgr.MutateVersioningData(ctx, func(data *persistencespb.VersioningData) error {
+		return UpdateBuildIdRamp(data, req.GetBuildId(), req.GetRampPercentage())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateBuildIdRampResponse{}, nil
+}
+
 func (e *matchingEngineImpl) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
 	req *matchingservice.GetWorkerBuildIdCompatibilityRequest,
@@ -756,11 +905,49 @@ func (e *matchingEngineImpl) GetWorkerBuildIdCompatibility(
 		}
 		return nil, err
 	}
+	resp := ToBuildIdOrderingResponse(verDat, int(req.GetRequest().GetMaxSets()))
+	if req.GetRequest().GetIncludeRetirementCandidates() {
+		resp.RetirementCandidates, err = e.getRetirementCandidates(ctx, tqMgr, verDat)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &matchingservice.GetWorkerBuildIdCompatibilityResponse{
-		Response: ToBuildIdOrderingResponse(verDat, int(req.GetRequest().GetMaxSets())),
+		Response: resp,
 	}, nil
 }
 
+// getRetirementCandidates returns the superseded build IDs of the task queue which are no longer reachable, along with
+// the pollers of the root partition still running them.
+func (e *matchingEngineImpl) getRetirementCandidates(
+	ctx context.Context,
+	tqMgr taskQueueManager,
+	data *persistencespb.VersioningData,
+) ([]*workflowservice.GetWorkerBuildIdCompatibilityResponse_RetirementCandidate, error) {
+	var candidates []*workflowservice.GetWorkerBuildIdCompatibilityResponse_RetirementCandidate
+	for _, superseded := range data.GetSupersededBuildIds() {
+		reachability, err := tqMgr.GetBuildIdReachability(ctx, data, superseded.GetBuildId())
+		if err != nil {
+			return nil, err
+		}
+		if reachability.reachable() {
+			continue
+		}
+		var pollers []*taskqueuepb.PollerInfo
+		for _, poller := range tqMgr.GetAllPollerInfo() {
+			if poller.GetWorkerVersionCapabilities().GetBuildId() == superseded.GetBuildId() {
+				pollers = append(pollers, poller)
+			}
+		}
+		candidates = append(candidates, &workflowservice.GetWorkerBuildIdCompatibilityResponse_RetirementCandidate{
+			BuildId:                 superseded.GetBuildId(),
+			AllWorkflowsAreArchived: reachability.closedWorkflows == 0,
+			Pollers:                 pollers,
+		})
+	}
+	return candidates, nil
+}
+
 func (e *matchingEngineImpl) InvalidateTaskQueueMetadata(
 	ctx context.Context,
 	req *matchingservice.InvalidateTaskQueueMetadataRequest,
@@ -813,9 +1000,93 @@ func (e *matchingEngineImpl) GetTaskQueueMetadata(
 			}
 		}
 	}
+	if req.GetWantPartitionConfig() {
+		resp.PartitionConfig = tqMgr.GetPartitionConfig()
+	}
 	return resp, nil
 }
 
+func (e *matchingEngineImpl) DescribeTaskQueueBacklog(
+	ctx context.Context,
+	req *matchingservice.DescribeTaskQueueBacklogRequest,
+) (*matchingservice.DescribeTaskQueueBacklogResponse, error) {
+	tqMgr, err := e.getBacklogTaskQueueManager(ctx, req.GetNamespaceId(), req.GetTaskQueue(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	summary, err := tqMgr.DescribeBacklog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.DescribeTaskQueueBacklogResponse{Summary: summary}, nil
+}
+
+func (e *matchingEngineImpl) MoveTaskQueueTasks(
+	ctx context.Context,
+	req *matchingservice.MoveTaskQueueTasksRequest,
+) (*matchingservice.MoveTaskQueueTasksResponse, error) {
+	tqMgr, err := e.getBacklogTaskQueueManager(ctx, req.GetNamespaceId(), req.GetTaskQueue(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	moved, err := tqMgr.MoveBacklogTasks(ctx, req.GetFilter(), req.GetDestinationTaskQueue(), int(req.GetMaxTasks()))
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.MoveTaskQueueTasksResponse{MovedTaskCount: moved}, nil
+}
+
+func (e *matchingEngineImpl) PurgeTaskQueueTasks(
+	ctx context.Context,
+	req *matchingservice.PurgeTaskQueueTasksRequest,
+) (*matchingservice.PurgeTaskQueueTasksResponse, error) {
+	tqMgr, err := e.getBacklogTaskQueueManager(ctx, req.GetNamespaceId(), req.GetTaskQueue(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	purged, err := tqMgr.PurgeBacklogTasks(ctx, req.GetFilter(), int(req.GetMaxTasks()))
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.PurgeTaskQueueTasksResponse{PurgedTaskCount: purged}, nil
+}
+
+func (e *matchingEngineImpl) RecordActivityTaskClosed(
+	ctx context.Context,
+	req *matchingservice.RecordActivityTaskClosedRequest,
+) (*matchingservice.RecordActivityTaskClosedResponse, error) {
+	taskQueue, err := newTaskQueueID(namespace.ID(req.GetNamespaceId()), req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	if err != nil {
+		return nil, err
+	}
+	// the slot is gone if the task queue was unloaded since the task was dispatched
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, false)
+	if err != nil || tqMgr == nil {
+		return &matchingservice.RecordActivityTaskClosedResponse{}, err
+	}
+	tqMgr.CloseInflightActivityTask(inflightTaskKey{
+		runID:            req.GetExecution().GetRunId(),
+		scheduledEventID: req.GetScheduledEventId(),
+		attempt:          req.GetAttempt(),
+	})
+	return &matchingservice.RecordActivityTaskClosedResponse{}, nil
+}
+
+// getBacklogTaskQueueManager returns the manager of a normal task queue partition, loading it if needed, for
+// inspecting or changing its backlog.
+func (e *matchingEngineImpl) getBacklogTaskQueueManager(
+	ctx context.Context,
+	namespaceID string,
+	taskQueueName string,
+	taskQueueType enumspb.TaskQueueType,
+) (taskQueueManager, error) {
+	taskQueue, err := newTaskQueueID(namespace.ID(namespaceID), taskQueueName, taskQueueType)
+	if err != nil {
+		return nil, err
+	}
+	return e.getTaskQueueManager(ctx, taskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, true)
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.keyResolver.Lookup(partitionKey)
 	if err != nil {
@@ -922,7 +1193,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 		}
 		serializedToken, _ = e.tokenSerializer.Serialize(taskToken)
 		if task.responseC == nil {
-			ct := timestamp.TimeValue(task.event.Data.CreateTime)
+			ct := taskVisibleTime(task.event.Data)
 			metricsHandler.Timer(metrics.AsyncMatchLatencyPerTaskQueue.GetMetricName()).Record(time.Since(ct))
 		}
 	}
@@ -943,6 +1214,7 @@ func (e *matchingEngineImpl) createPollWorkflowTaskQueueResponse(
 func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	task *internalTask,
 	historyResponse *historyservice.RecordActivityTaskStartedResponse,
+	inflightTaskQueue string,
 	metricsHandler metrics.Handler,
 ) *matchingservice.PollActivityTaskQueueResponse {
 
@@ -955,19 +1227,20 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		panic("ActivityTaskScheduledEventAttributes.ActivityID is not set")
 	}
 	if task.responseC == nil {
-		ct := timestamp.TimeValue(task.event.Data.CreateTime)
+		ct := taskVisibleTime(task.event.Data)
 		metricsHandler.Timer(metrics.AsyncMatchLatencyPerTaskQueue.GetMetricName()).Record(time.Since(ct))
 	}
 
 	taskToken := &tokenspb.Task{
-		NamespaceId:      task.event.Data.GetNamespaceId(),
-		WorkflowId:       task.event.Data.GetWorkflowId(),
-		RunId:            task.event.Data.GetRunId(),
-		ScheduledEventId: task.event.Data.GetScheduledEventId(),
-		Attempt:          historyResponse.GetAttempt(),
-		ActivityId:       attributes.GetActivityId(),
-		ActivityType:     attributes.GetActivityType().GetName(),
-		Clock:            historyResponse.GetClock(),
+		NamespaceId:       task.event.Data.GetNamespaceId(),
+		WorkflowId:        task.event.Data.GetWorkflowId(),
+		RunId:             task.event.Data.GetRunId(),
+		ScheduledEventId:  task.event.Data.GetScheduledEventId(),
+		Attempt:           historyResponse.GetAttempt(),
+		ActivityId:        attributes.GetActivityId(),
+		ActivityType:      attributes.GetActivityType().GetName(),
+		Clock:             historyResponse.GetClock(),
+		InflightTaskQueue: inflightTaskQueue,
 	}
 
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
@@ -992,6 +1265,33 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	}
 }
 
+// startInflightActivityTask keeps the inflight slot reserved by the poller for the started activity task. Returns the
+// task queue partition which holds the slot, or an empty string if the task holds no slot.
+func (e *matchingEngineImpl) startInflightActivityTask(
+	task *internalTask,
+	taskQueue *taskQueueID,
+	historyResponse *historyservice.RecordActivityTaskStartedResponse,
+) string {
+	if task.inflightSlot == nil {
+		return ""
+	}
+	// the slot is released after the task timed out in case history does not report it as closed
+	attributes := historyResponse.ScheduledEvent.GetActivityTaskScheduledEventAttributes()
+	timeout := timestamp.DurationValue(attributes.GetStartToCloseTimeout())
+	if timeout <= 0 {
+		timeout = timestamp.DurationValue(attributes.GetScheduleToCloseTimeout())
+	}
+	if timeout <= 0 {
+		timeout = defaultInflightActivityTaskTimeout
+	}
+	task.inflightSlot.start(inflightTaskKey{
+		runID:            task.event.Data.GetRunId(),
+		scheduledEventID: task.event.Data.GetScheduledEventId(),
+		attempt:          historyResponse.GetAttempt(),
+	}, timeout)
+	return taskQueue.FullName()
+}
+
 func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollWorkflowTaskQueueRequest,
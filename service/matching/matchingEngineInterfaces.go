@@ -48,5 +48,10 @@ type (
 		GetWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error)
 		InvalidateTaskQueueMetadata(ctx context.Context, request *matchingservice.InvalidateTaskQueueMetadataRequest) (*matchingservice.InvalidateTaskQueueMetadataResponse, error)
 		GetTaskQueueMetadata(ctx context.Context, request *matchingservice.GetTaskQueueMetadataRequest) (*matchingservice.GetTaskQueueMetadataResponse, error)
+		DescribeTaskQueueBacklog(ctx context.Context, request *matchingservice.DescribeTaskQueueBacklogRequest) (*matchingservice.DescribeTaskQueueBacklogResponse, error)
+		MoveTaskQueueTasks(ctx context.Context, request *matchingservice.MoveTaskQueueTasksRequest) (*matchingservice.MoveTaskQueueTasksResponse, error)
+		PurgeTaskQueueTasks(ctx context.Context, request *matchingservice.PurgeTaskQueueTasksRequest) (*matchingservice.PurgeTaskQueueTasksResponse, error)
+		RecordActivityTaskClosed(ctx context.Context, request *matchingservice.RecordActivityTaskClosedRequest) (*matchingservice.RecordActivityTaskClosedResponse, error)
+		UpdateBuildIdRamp(ctx context.Context, request *matchingservice.UpdateBuildIdRampRequest) (*matchingservice.UpdateBuildIdRampResponse, error)
 	}
 )
@@ -28,6 +28,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -39,6 +41,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally/v4"
+	"google.golang.org/grpc"
 
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
@@ -53,6 +56,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
@@ -64,9 +68,11 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
 )
@@ -74,10 +80,11 @@ import (
 type (
 	matchingEngineSuite struct {
 		suite.Suite
-		controller         *gomock.Controller
-		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
-		mockMatchingClient *matchingservicemock.MockMatchingServiceClient
-		mockNamespaceCache *namespace.MockRegistry
+		controller            *gomock.Controller
+		mockHistoryClient     *historyservicemock.MockHistoryServiceClient
+		mockMatchingClient    *matchingservicemock.MockMatchingServiceClient
+		mockNamespaceCache    *namespace.MockRegistry
+		mockVisibilityManager *manager.MockVisibilityManager
 
 		matchingEngine *matchingEngineImpl
 		taskManager    *testTaskManager
@@ -113,6 +120,7 @@ func (s *matchingEngineSuite) SetupTest() {
 		Return(&matchingservice.GetTaskQueueMetadataResponse{}, nil).AnyTimes()
 	s.taskManager = newTestTaskManager(s.logger)
 	s.mockNamespaceCache = namespace.NewMockRegistry(s.controller)
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
 	ns := namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: matchingTestNamespace}, nil, "")
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(ns, nil).AnyTimes()
 	s.mockNamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(ns.Name(), nil).AnyTimes()
@@ -129,12 +137,13 @@ func (s *matchingEngineSuite) TearDownTest() {
 func (s *matchingEngineSuite) newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager,
 ) *matchingEngineImpl {
-	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockNamespaceCache, s.mockMatchingClient)
+	return newMatchingEngine(config, taskMgr, s.mockHistoryClient, s.logger, s.mockNamespaceCache, s.mockMatchingClient, s.mockVisibilityManager)
 }
 
 func newMatchingEngine(
 	config *Config, taskMgr persistence.TaskManager, mockHistoryClient historyservice.HistoryServiceClient,
 	logger log.Logger, mockNamespaceCache namespace.Registry, mockMatchingClient matchingservice.MatchingServiceClient,
+	mockVisibilityManager manager.VisibilityManager,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
 		taskManager:       taskMgr,
@@ -148,6 +157,7 @@ func newMatchingEngine(
 		config:            config,
 		namespaceRegistry: mockNamespaceCache,
 		clusterMeta:       cluster.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true)),
+		visibilityManager: mockVisibilityManager,
 	}
 }
 
@@ -401,6 +411,109 @@ func (s *matchingEngineSuite) TestPollWorkflowTaskQueues() {
 	s.Equal(expectedResp, resp)
 }
 
+func (s *matchingEngineSuite) TestStickyWorkerUnavailable() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+	s.matchingEngine.config.StickyMaxBacklog = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	s.matchingEngine.config.StickyPollerUnavailableWindow = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(500 * time.Millisecond)
+
+	namespaceID := namespace.ID(uuid.New())
+	stickyTaskQueue := &taskqueuepb.TaskQueue{Name: "makeStickyToast", Kind: enumspb.TASK_QUEUE_KIND_STICKY}
+	stickyTlID := newTestTaskQueueID(namespaceID, stickyTaskQueue.Name, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+
+	addTask := func(scheduledEventID int64) error {
+		_, err := s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+			ScheduledEventId:       scheduledEventID,
+			TaskQueue:              stickyTaskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		return err
+	}
+
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: stickyTaskQueue,
+			Identity:  "selfDrivingToaster",
+		},
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Equal(emptyPollWorkflowTaskQueueResponse, resp)
+
+	// the worker does not poll again, so the first task goes to the backlog
+	s.NoError(addTask(1))
+	s.EqualValues(1, s.taskManager.getTaskCount(stickyTlID))
+	tqm, err := s.matchingEngine.getTaskQueueManager(context.Background(), stickyTlID, enumspb.TASK_QUEUE_KIND_STICKY, false)
+	s.NoError(err)
+	s.Eventually(tqm.IsStickyWorkerSaturated, time.Second, 10*time.Millisecond)
+
+	// the worker is saturated
+	s.ErrorAs(addTask(2), new(*serviceerrors.StickyWorkerUnavailable))
+	s.EqualValues(1, s.taskManager.getTaskCount(stickyTlID))
+
+	// the worker stopped polling
+	s.matchingEngine.config.StickyMaxBacklog = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(0)
+	s.NoError(addTask(3))
+	s.Eventually(func() bool { return !tqm.IsStickyWorkerPolling() }, time.Second, 10*time.Millisecond)
+	s.ErrorAs(addTask(4), new(*serviceerrors.StickyWorkerUnavailable))
+}
+
+func (s *matchingEngineSuite) TestStickyBacklogRedirected() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+	s.matchingEngine.config.StickyPollerUnavailableWindow = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(100 * time.Millisecond)
+
+	namespaceID := namespace.ID(uuid.New())
+	stickyTaskQueue := &taskqueuepb.TaskQueue{Name: "makeStickyToast", Kind: enumspb.TASK_QUEUE_KIND_STICKY}
+	stickyTlID := newTestTaskQueueID(namespaceID, stickyTaskQueue.Name, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: stickyTaskQueue,
+			Identity:  "selfDrivingToaster",
+		},
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Equal(emptyPollWorkflowTaskQueueResponse, resp)
+
+	redirected := make(chan *matchingservice.AddWorkflowTaskRequest, 1)
+	s.mockMatchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.AddWorkflowTaskRequest, _ ...grpc.CallOption) (*matchingservice.AddWorkflowTaskResponse, error) {
+			redirected <- request
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		})
+
+	// the worker does not poll again, so the task goes to the backlog and is moved to the normal task queue
+	_, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceID.String(),
+		Execution:              execution,
+		ScheduledEventId:       1,
+		TaskQueue:              stickyTaskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		NormalTaskQueue:        "makeToast",
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(stickyTlID))
+
+	select {
+	case request := <-redirected:
+		s.Equal("makeToast", request.GetTaskQueue().GetName())
+		s.Equal(enumspb.TASK_QUEUE_KIND_NORMAL, request.GetTaskQueue().GetKind())
+		s.Equal(execution, request.GetExecution())
+		s.EqualValues(1, request.GetScheduledEventId())
+		s.Empty(request.GetNormalTaskQueue())
+	case <-time.After(5 * time.Second):
+		s.Fail("sticky backlog task was not moved to the normal task queue")
+	}
+	tqm, err := s.matchingEngine.getTaskQueueManager(context.Background(), stickyTlID, enumspb.TASK_QUEUE_KIND_STICKY, false)
+	s.NoError(err)
+	s.Eventually(func() bool {
+		return tqm.(*taskQueueManagerImpl).taskAckManager.getBacklogCountHint() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType enumspb.TaskQueueType) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
@@ -1743,6 +1856,290 @@ func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch() {
 	s.True(isReadBatchDone)
 }
 
+func (s *matchingEngineSuite) TestPurgeTaskQueueTasks() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	const taskCount = 10
+	for i := int64(0); i < taskCount; i++ {
+		workflowType := "even"
+		if i%2 == 1 {
+			workflowType = "odd"
+		}
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+			ScheduledEventId:       i,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			WorkflowTypeName:       workflowType,
+		})
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	describeResp, err := s.matchingEngine.DescribeTaskQueueBacklog(context.Background(), &matchingservice.DescribeTaskQueueBacklogRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.EqualValues(taskCount, describeResp.Summary.TaskCount)
+	s.Equal(map[string]int64{"even": taskCount / 2, "odd": taskCount / 2}, describeResp.Summary.WorkflowTypeTaskCounts)
+	s.False(describeResp.Summary.Truncated)
+
+	purgeResp, err := s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Filter:        &taskqueuespb.BacklogTaskFilter{WorkflowTypeName: "odd"},
+		MaxTasks:      2,
+	})
+	s.NoError(err)
+	s.EqualValues(2, purgeResp.PurgedTaskCount)
+	s.EqualValues(taskCount-2, s.taskManager.getTaskCount(tlID))
+
+	purgeResp, err = s.matchingEngine.PurgeTaskQueueTasks(context.Background(), &matchingservice.PurgeTaskQueueTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tl,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Filter:        &taskqueuespb.BacklogTaskFilter{WorkflowTypeName: "odd"},
+		MaxTasks:      taskCount,
+	})
+	s.NoError(err)
+	s.EqualValues(taskCount/2-2, purgeResp.PurgedTaskCount)
+	s.EqualValues(taskCount/2, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestInflightActivityTaskLimit() {
+	s.matchingEngine.config.MaxInflightActivityTasks = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+	for i := int64(0); i < 2; i++ {
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              execution,
+			ScheduledEventId:       i,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+	s.setupRecordActivityTaskStartedMock(tl)
+
+	poll := func() *matchingservice.PollActivityTaskQueueResponse {
+		resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		return resp
+	}
+
+	resp := poll()
+	s.NotEmpty(resp.TaskToken)
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(resp.TaskToken)
+	s.NoError(err)
+	s.Equal(tl, token.GetInflightTaskQueue())
+
+	// the first task holds the only inflight slot
+	s.Equal(emptyPollActivityTaskQueueResponse, poll())
+
+	_, err = s.matchingEngine.RecordActivityTaskClosed(context.Background(), &matchingservice.RecordActivityTaskClosedRequest{
+		NamespaceId:      namespaceID.String(),
+		TaskQueue:        token.GetInflightTaskQueue(),
+		Execution:        execution,
+		ScheduledEventId: token.GetScheduledEventId(),
+		Attempt:          token.GetAttempt(),
+	})
+	s.NoError(err)
+	s.NotEmpty(poll().TaskToken)
+}
+
+func (s *matchingEngineSuite) TestDelayedActivityTask() {
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	s.setupRecordActivityTaskStartedMock(tl)
+
+	poll := func() *matchingservice.PollActivityTaskQueueResponse {
+		resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		return resp
+	}
+	// load the task queue with a poller so that the task could be sync matched if it was due
+	s.Equal(emptyPollActivityTaskQueueResponse, poll())
+
+	notBefore := time.Now().UTC().Add(500 * time.Millisecond)
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceID.String(),
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+		ScheduledEventId:       1,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		NotBefore:              &notBefore,
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	// the schedule to start timeout starts at the not-before time
+	tasks, err := s.taskManager.GetTasks(context.Background(), &persistence.GetTasksRequest{
+		NamespaceID:        namespaceID.String(),
+		TaskQueue:          tl,
+		TaskType:           enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		InclusiveMinTaskID: 0,
+		ExclusiveMaxTaskID: math.MaxInt64,
+		PageSize:           10,
+	})
+	s.NoError(err)
+	s.Len(tasks.Tasks, 1)
+	s.Equal(notBefore.Add(100*time.Second), timestamp.TimeValue(tasks.Tasks[0].Data.GetExpiryTime()))
+
+	for time.Now().Before(notBefore.Add(-100 * time.Millisecond)) {
+		s.Equal(emptyPollActivityTaskQueueResponse, poll())
+	}
+	// the task is parked in a task queue of its own, it is not written to the backlog again and does not hold
+	// back the ack level
+	delayedKey := testTaskQueueKey{namespaceID: namespaceID.String(), name: tlID.DelayedTasksFullName(), taskType: tlID.taskType}
+	s.EqualValues(1, s.taskManager.getTaskQueueManagerByKey(delayedKey).tasks.Size())
+	s.EqualValues(1, s.taskManager.getCreateTaskCount(tlID))
+	tlm, err := s.matchingEngine.getTaskQueueManager(context.Background(), tlID, enumspb.TASK_QUEUE_KIND_NORMAL, false)
+	s.NoError(err)
+	s.EqualValues(tasks.Tasks[0].GetTaskId(), tlm.(*taskQueueManagerImpl).taskAckManager.getAckLevel())
+
+	time.Sleep(time.Until(notBefore))
+	resp := poll()
+	s.NotEmpty(resp.TaskToken)
+	s.EqualValues(0, s.taskManager.getTaskQueueManagerByKey(delayedKey).tasks.Size())
+}
+
+func (s *matchingEngineSuite) TestPriorityActivityTask() {
+	s.matchingEngine.config.EnableTaskPriority = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	var startedEventIDs []int64
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordActivityTaskStartedRequest, arg2 ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			startedEventIDs = append(startedEventIDs, taskRequest.ScheduledEventId)
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(taskRequest.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:             "activityId1",
+						TaskQueue:              taskQueue,
+						ActivityType:           &commonpb.ActivityType{Name: "activity1"},
+						ScheduleToCloseTimeout: timestamp.DurationPtr(100 * time.Second),
+						ScheduleToStartTimeout: timestamp.DurationPtr(50 * time.Second),
+						StartToCloseTimeout:    timestamp.DurationPtr(50 * time.Second),
+					}),
+			}, nil
+		}).AnyTimes()
+
+	addTask := func(scheduledEventID int64, priority int32) {
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+			ScheduledEventId:       scheduledEventID,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			Priority:               priority,
+		})
+		s.NoError(err)
+	}
+	addTask(1, 0)
+	addTask(2, 0)
+	addTask(3, 1)
+
+	// the task with a higher priority than the default priority is persisted in the subqueue of its priority
+	subqueueKey := testTaskQueueKey{namespaceID: namespaceID.String(), name: tlID.PriorityTasksFullName(1), taskType: tlID.taskType}
+	s.EqualValues(2, s.taskManager.getCreateTaskCount(tlID))
+	s.EqualValues(1, s.taskManager.getTaskQueueManagerByKey(subqueueKey).tasks.Size())
+
+	for i := 0; i < 3; i++ {
+		resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceID.String(),
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "nobody",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		s.NotEmpty(resp.TaskToken)
+	}
+	// the backlog task read before the high priority task was added may be dispatched first, the other one is
+	// overtaken
+	s.Len(startedEventIDs, 3)
+	s.Equal(int64(2), startedEventIDs[2])
+	s.True(s.awaitCondition(func() bool { return s.taskManager.getTaskQueueManagerByKey(subqueueKey).tasks.Size() == 0 }, time.Second))
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskQueue_FairnessKeyBacklogs() {
+	s.matchingEngine.config.EnableTaskFairness = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	s.matchingEngine.config.FairnessTopKeysCount = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	namespaceID := namespace.ID(uuid.New())
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: "makeToast",
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	for i, fairnessKey := range []string{"flood", "a", "flood", "b", "flood", "a"} {
+		_, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+			ScheduledEventId:       int64(i + 1),
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			FairnessKey:            fairnessKey,
+		})
+		s.NoError(err)
+	}
+
+	// the backlog of each fairness key is counted from persistence, not only from the tasks read into memory
+	descResp, err := s.matchingEngine.DescribeTaskQueue(context.Background(), &matchingservice.DescribeTaskQueueRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskQueueRequest{
+			TaskQueue:              taskQueue,
+			TaskQueueType:          enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			IncludeTaskQueueStatus: true,
+		},
+	})
+	s.NoError(err)
+	s.Equal([]*matchingservice.FairnessKeyBacklog{
+		{FairnessKey: "flood", BacklogCount: 3},
+		{FairnessKey: "a", BacklogCount: 2},
+	}, descResp.FairnessKeyBacklogs)
+}
+
 func (s *matchingEngineSuite) TestTaskQueueManagerGetTaskBatch_ReadBatchDone() {
 	namespaceID := namespace.ID(uuid.New())
 	tl := "makeToast"
@@ -2003,6 +2400,153 @@ func (s *matchingEngineSuite) TestGetVersioningData() {
 	s.Equal("95", majorSets[0].GetBuildIds()[0])
 }
 
+func (s *matchingEngineSuite) TestBuildIdRamp() {
+	s.mockMatchingClient.EXPECT().InvalidateTaskQueueMetadata(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	rampTlID := newTaskQueueIDWithVersionSet(tlID, buildIdVersionSet("v2"))
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	updateRamp := func(buildID string, percentage float32) {
+		_, err := s.matchingEngine.UpdateBuildIdRamp(context.Background(), &matchingservice.UpdateBuildIdRampRequest{
+			NamespaceId:    namespaceID.String(),
+			TaskQueue:      tl,
+			BuildId:        buildID,
+			RampPercentage: percentage,
+		})
+		s.NoError(err)
+	}
+	addTask := func(assignment *persistencespb.BuildIdAssignment) {
+		_, err := s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            namespaceID.String(),
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: uuid.New()},
+			ScheduledEventId:       2,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			BuildIdAssignment:      assignment,
+		})
+		s.NoError(err)
+	}
+
+	updateRamp("v2", 100)
+	addTask(nil)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(1, s.taskManager.getTaskCount(rampTlID))
+	// runs which already started on another build ID, or on an unversioned worker, are not ramped
+	addTask(&persistencespb.BuildIdAssignment{BuildId: "v1"})
+	addTask(&persistencespb.BuildIdAssignment{})
+	s.EqualValues(2, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(1, s.taskManager.getTaskCount(rampTlID))
+
+	// only the pollers running the ramping build ID poll its task queue
+	pollTlID, err := s.matchingEngine.getPollerBuildIdTaskQueue(context.Background(), tlID, enumspb.TASK_QUEUE_KIND_NORMAL, "v2")
+	s.NoError(err)
+	s.Equal(rampTlID, pollTlID)
+	pollTlID, err = s.matchingEngine.getPollerBuildIdTaskQueue(context.Background(), tlID, enumspb.TASK_QUEUE_KIND_NORMAL, "v1")
+	s.NoError(err)
+	s.Equal(tlID, pollTlID)
+
+	updateRamp("v2", 0)
+	addTask(nil)
+	s.EqualValues(3, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(1, s.taskManager.getTaskCount(rampTlID))
+	// runs which started on the ramping build ID keep going to it
+	addTask(&persistencespb.BuildIdAssignment{BuildId: "v2"})
+	s.EqualValues(3, s.taskManager.getTaskCount(tlID))
+	s.EqualValues(2, s.taskManager.getTaskCount(rampTlID))
+
+	updateRamp("", 0)
+	pollTlID, err = s.matchingEngine.getPollerBuildIdTaskQueue(context.Background(), tlID, enumspb.TASK_QUEUE_KIND_NORMAL, "v2")
+	s.NoError(err)
+	s.Equal(tlID, pollTlID)
+}
+
+func (s *matchingEngineSuite) TestBuildIdReachability() {
+	s.mockMatchingClient.EXPECT().InvalidateTaskQueueMetadata(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	namespaceID := namespace.ID(uuid.New())
+	tl := "makeToast"
+	tlID := newTestTaskQueueID(namespaceID, tl, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	for _, buildID := range []string{"v0", "v1", "v2", "v3"} {
+		_, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+			NamespaceId: namespaceID.String(),
+			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+				TaskQueue: tl,
+				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+					AddNewBuildIdInNewDefaultSet: buildID,
+				},
+			},
+		})
+		s.NoError(err)
+	}
+	// v1 keeps a backlog in its ramp task queue
+	_, err := s.matchingEngine.UpdateBuildIdRamp(context.Background(), &matchingservice.UpdateBuildIdRampRequest{
+		NamespaceId:    namespaceID.String(),
+		TaskQueue:      tl,
+		BuildId:        "v1",
+		RampPercentage: 100,
+	})
+	s.NoError(err)
+	_, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceID.String(),
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: uuid.New()},
+		ScheduledEventId:       2,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: tl, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+	_, err = s.matchingEngine.UpdateBuildIdRamp(context.Background(), &matchingservice.UpdateBuildIdRampRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   tl,
+	})
+	s.NoError(err)
+
+	// v0 only has closed workflows and v2 has open ones
+	s.mockVisibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error) {
+			open := strings.Contains(request.Query, searchattribute.ExecutionStatus)
+			switch {
+			case strings.Contains(request.Query, "'v0'") && !open:
+				return &manager.CountWorkflowExecutionsResponse{Count: 2}, nil
+			case strings.Contains(request.Query, "'v2'"):
+				return &manager.CountWorkflowExecutionsResponse{Count: 1}, nil
+			}
+			return &manager.CountWorkflowExecutionsResponse{}, nil
+		}).AnyTimes()
+
+	resp, err := s.matchingEngine.GetWorkerBuildIdCompatibility(context.Background(), &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+			TaskQueue:                   tl,
+			IncludeRetirementCandidates: true,
+		},
+	})
+	s.NoError(err)
+	s.Equal([]*workflowservice.GetWorkerBuildIdCompatibilityResponse_RetirementCandidate{
+		{BuildId: "v0", AllWorkflowsAreArchived: false},
+	}, resp.GetResponse().GetRetirementCandidates())
+
+	tqm, err := s.matchingEngine.getTaskQueueManager(context.Background(), tlID, enumspb.TASK_QUEUE_KIND_NORMAL, true)
+	s.NoError(err)
+	scavenger := tqm.(*taskQueueManagerImpl).buildIdScavenger
+	// nothing is removed within the retention
+	scavenger.scavenge(context.Background(), time.Now().UTC())
+	data, err := tqm.GetVersioningData(context.Background())
+	s.NoError(err)
+	s.Len(data.GetVersionSets(), 4)
+
+	scavenger.scavenge(context.Background(), time.Now().UTC().Add(s.matchingEngine.config.BuildIdRetention(matchingTestNamespace)+time.Minute))
+	data, err = tqm.GetVersioningData(context.Background())
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{"v1"}},
+		{BuildIds: []string{"v2"}},
+		{BuildIds: []string{"v3"}},
+	}, data.GetVersionSets())
+}
+
 func (s *matchingEngineSuite) TestActivityQueueMetadataInvalidate() {
 	// Overwrite the matching mock - we expect one and only one fetch call here, after the activity queue is invalidated
 	mockMatch := matchingservicemock.NewMockMatchingServiceClient(s.controller)
@@ -2116,12 +2660,19 @@ var _ persistence.TaskManager = (*testTaskManager)(nil) // Asserts that interfac
 
 type testTaskManager struct {
 	sync.Mutex
-	taskQueues map[taskQueueID]*testTaskQueueManager
+	taskQueues map[testTaskQueueKey]*testTaskQueueManager
 	logger     log.Logger
 }
 
+// testTaskQueueKey identifies a task queue by its persisted name, which is not always a valid task queue name
+type testTaskQueueKey struct {
+	namespaceID string
+	name        string
+	taskType    enumspb.TaskQueueType
+}
+
 func newTestTaskManager(logger log.Logger) *testTaskManager {
-	return &testTaskManager{taskQueues: make(map[taskQueueID]*testTaskQueueManager), logger: logger}
+	return &testTaskManager{taskQueues: make(map[testTaskQueueKey]*testTaskQueueManager), logger: logger}
 }
 
 func (m *testTaskManager) GetName() string {
@@ -2132,14 +2683,18 @@ func (m *testTaskManager) Close() {
 }
 
 func (m *testTaskManager) getTaskQueueManager(id *taskQueueID) *testTaskQueueManager {
+	return m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: id.namespaceID.String(), name: id.FullName(), taskType: id.taskType})
+}
+
+func (m *testTaskManager) getTaskQueueManagerByKey(key testTaskQueueKey) *testTaskQueueManager {
 	m.Lock()
 	defer m.Unlock()
-	result, ok := m.taskQueues[*id]
+	result, ok := m.taskQueues[key]
 	if ok {
 		return result
 	}
 	result = newTestTaskQueueManager()
-	m.taskQueues[*id] = result
+	m.taskQueues[key] = result
 	return result
 }
 
@@ -2188,7 +2743,7 @@ func (m *testTaskManager) CreateTaskQueue(
 	request *persistence.CreateTaskQueueRequest,
 ) (*persistence.CreateTaskQueueResponse, error) {
 	tli := request.TaskQueueInfo
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(tli.GetNamespaceId()), tli.Name, tli.TaskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: tli.GetNamespaceId(), name: tli.Name, taskType: tli.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -2209,7 +2764,7 @@ func (m *testTaskManager) UpdateTaskQueue(
 	request *persistence.UpdateTaskQueueRequest,
 ) (*persistence.UpdateTaskQueueResponse, error) {
 	tli := request.TaskQueueInfo
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(tli.GetNamespaceId()), tli.Name, tli.TaskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: tli.GetNamespaceId(), name: tli.Name, taskType: tli.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -2227,7 +2782,7 @@ func (m *testTaskManager) GetTaskQueue(
 	_ context.Context,
 	request *persistence.GetTaskQueueRequest,
 ) (*persistence.GetTaskQueueResponse, error) {
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(request.NamespaceID), request.TaskQueue, request.TaskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: request.NamespaceID, name: request.TaskQueue, taskType: request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -2259,7 +2814,7 @@ func (m *testTaskManager) CompleteTask(
 	}
 
 	tli := request.TaskQueue
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(tli.NamespaceID), tli.TaskQueueName, tli.TaskQueueType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: tli.NamespaceID, name: tli.TaskQueueName, taskType: tli.TaskQueueType})
 
 	tlm.Lock()
 	defer tlm.Unlock()
@@ -2272,7 +2827,7 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	_ context.Context,
 	request *persistence.CompleteTasksLessThanRequest,
 ) (int, error) {
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(request.NamespaceID), request.TaskQueueName, request.TaskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: request.NamespaceID, name: request.TaskQueueName, taskType: request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	keys := tlm.tasks.Keys()
@@ -2298,8 +2853,8 @@ func (m *testTaskManager) DeleteTaskQueue(
 ) error {
 	m.Lock()
 	defer m.Unlock()
-	key := newTestTaskQueueID(namespace.ID(request.TaskQueue.NamespaceID), request.TaskQueue.TaskQueueName, request.TaskQueue.TaskQueueType)
-	delete(m.taskQueues, *key)
+	key := testTaskQueueKey{namespaceID: request.TaskQueue.NamespaceID, name: request.TaskQueue.TaskQueueName, taskType: request.TaskQueue.TaskQueueType}
+	delete(m.taskQueues, key)
 	return nil
 }
 
@@ -2308,12 +2863,12 @@ func (m *testTaskManager) CreateTasks(
 	_ context.Context,
 	request *persistence.CreateTasksRequest,
 ) (*persistence.CreateTasksResponse, error) {
-	namespaceID := namespace.ID(request.TaskQueueInfo.Data.GetNamespaceId())
+	namespaceID := request.TaskQueueInfo.Data.GetNamespaceId()
 	taskQueue := request.TaskQueueInfo.Data.Name
 	taskType := request.TaskQueueInfo.Data.TaskType
 	rangeID := request.TaskQueueInfo.RangeID
 
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespaceID, taskQueue, taskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: namespaceID, name: taskQueue, taskType: taskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 
@@ -2358,7 +2913,7 @@ func (m *testTaskManager) GetTasks(
 ) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug("testTaskManager.GetTasks", tag.MinLevel(request.InclusiveMinTaskID), tag.MaxLevel(request.ExclusiveMaxTaskID))
 
-	tlm := m.getTaskQueueManager(newTestTaskQueueID(namespace.ID(request.NamespaceID), request.TaskQueue, request.TaskType))
+	tlm := m.getTaskQueueManagerByKey(testTaskQueueKey{namespaceID: request.NamespaceID, name: request.TaskQueue, taskType: request.TaskType})
 	tlm.Lock()
 	defer tlm.Unlock()
 	var tasks []*persistencespb.AllocatedTaskInfo
@@ -2415,7 +2970,7 @@ func (m *testTaskManager) String() string {
 		} else {
 			result += "Workflow"
 		}
-		result += " task queue " + id.FullName()
+		result += " task queue " + id.name
 		result += "\n"
 		result += fmt.Sprintf("AckLevel=%v\n", tl.ackLevel)
 		result += fmt.Sprintf("CreateTaskCount=%v\n", tl.createTaskCount)
@@ -2440,7 +2995,7 @@ func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 }
 
 func defaultTestConfig() *Config {
-	config := NewConfig(dynamicconfig.NewNoopCollection())
+	config := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(100 * time.Millisecond)
 	config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
 	return config
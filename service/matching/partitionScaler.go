@@ -0,0 +1,349 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/util"
+)
+
+const partitionScaleTimeout = 30 * time.Second
+
+type (
+	// partitionScaler runs on the root workflow partition of a task queue and chooses the number of read and
+	// write partitions of its workflow and activity queues from the add rate and backlog observed on all
+	// partitions. The counts are persisted with the root partition, matching clients fetch them from there.
+	//
+	// Each round changes either the read or the write partitions of a queue: to add partitions, the read
+	// partitions grow first so pollers reach the new partitions before tasks are written to them, and the
+	// write partitions follow in the next round. To remove partitions, the write partitions shrink first and
+	// the read partitions follow once the removed partitions have no backlog left and all clients have
+	// stopped writing to them. When auto scaling is turned off, the counts move back to the configured
+	// counts the same way before they are removed.
+	partitionScaler struct {
+		tqMgr        *taskQueueManagerImpl
+		config       *Config
+		shutdownChan chan struct{}
+		// last added task count seen per partition, to compute the add rate
+		lastSamples map[partitionSampleKey]addedTaskSample
+		// time the write partitions were last seen below the read partitions, per task type
+		writeShrinkTimes map[enumspb.TaskQueueType]time.Time
+	}
+
+	partitionSampleKey struct {
+		taskType  enumspb.TaskQueueType
+		partition int
+	}
+
+	addedTaskSample struct {
+		count int64
+		time  time.Time
+	}
+
+	partitionStats struct {
+		// tasks added per second to the write partitions, only valid if rateKnown
+		addRate   float64
+		rateKnown bool
+		// backlog of the write partitions
+		backlog int64
+		// true if the partitions which are only read from have no backlog
+		drained bool
+	}
+)
+
+func newPartitionScaler(tqMgr *taskQueueManagerImpl, config *Config) *partitionScaler {
+	return &partitionScaler{
+		tqMgr:            tqMgr,
+		config:           config,
+		shutdownChan:     make(chan struct{}),
+		lastSamples:      make(map[partitionSampleKey]addedTaskSample),
+		writeShrinkTimes: make(map[enumspb.TaskQueueType]time.Time),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	go s.scaleLoop()
+}
+
+func (s *partitionScaler) Stop() {
+	close(s.shutdownChan)
+}
+
+func (s *partitionScaler) scaleLoop() {
+	timer := time.NewTimer(s.config.PartitionAutoScaleInterval(s.tqMgr.namespace.String()))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.shutdownChan:
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithTimeout(context.Background(), partitionScaleTimeout)
+			ctx = headers.SetCallerInfo(ctx, headers.NewBackgroundCallerInfo(s.tqMgr.namespace.String()))
+			s.scale(ctx)
+			cancel()
+			timer.Reset(s.config.PartitionAutoScaleInterval(s.tqMgr.namespace.String()))
+		}
+	}
+}
+
+func (s *partitionScaler) scale(ctx context.Context) {
+	current := s.tqMgr.db.GetPartitionConfig()
+	if !s.config.EnablePartitionAutoScale(s.tqMgr.namespace.String()) {
+		s.lastSamples = make(map[partitionSampleKey]addedTaskSample)
+		if current == nil {
+			return
+		}
+		s.restoreConfiguredPartitions(ctx, current)
+		return
+	}
+
+	next := &persistencespb.TaskQueuePartitionConfig{
+		WorkflowPartitions: s.scaleTaskType(ctx, enumspb.TASK_QUEUE_TYPE_WORKFLOW, current.GetWorkflowPartitions()),
+		ActivityPartitions: s.scaleTaskType(ctx, enumspb.TASK_QUEUE_TYPE_ACTIVITY, current.GetActivityPartitions()),
+	}
+	if current.Equal(next) {
+		return
+	}
+	if err := s.tqMgr.db.UpdatePartitionConfig(ctx, next); err != nil {
+		s.tqMgr.signalIfFatal(err)
+		s.tqMgr.logger.Warn("Failed to update auto scaled partition counts", tag.Error(err))
+	}
+}
+
+// restoreConfiguredPartitions moves the auto scaled partition counts towards the configured counts, and
+// removes them once they are equal, so that the partitions removed by turning off auto scaling are drained
+// before clients stop reading from them.
+func (s *partitionScaler) restoreConfiguredPartitions(
+	ctx context.Context,
+	current *persistencespb.TaskQueuePartitionConfig,
+) {
+	next := &persistencespb.TaskQueuePartitionConfig{
+		WorkflowPartitions: s.restoreTaskType(ctx, enumspb.TASK_QUEUE_TYPE_WORKFLOW, current.GetWorkflowPartitions()),
+		ActivityPartitions: s.restoreTaskType(ctx, enumspb.TASK_QUEUE_TYPE_ACTIVITY, current.GetActivityPartitions()),
+	}
+	if next.GetWorkflowPartitions().Equal(s.configuredPartitionCounts(enumspb.TASK_QUEUE_TYPE_WORKFLOW)) &&
+		next.GetActivityPartitions().Equal(s.configuredPartitionCounts(enumspb.TASK_QUEUE_TYPE_ACTIVITY)) {
+		if err := s.tqMgr.db.UpdatePartitionConfig(ctx, nil); err != nil {
+			s.tqMgr.signalIfFatal(err)
+			s.tqMgr.logger.Warn("Failed to remove auto scaled partition counts", tag.Error(err))
+			return
+		}
+		s.tqMgr.logger.Info("Removed auto scaled partition counts")
+		return
+	}
+	if current.Equal(next) {
+		return
+	}
+	if err := s.tqMgr.db.UpdatePartitionConfig(ctx, next); err != nil {
+		s.tqMgr.signalIfFatal(err)
+		s.tqMgr.logger.Warn("Failed to update auto scaled partition counts", tag.Error(err))
+	}
+}
+
+func (s *partitionScaler) restoreTaskType(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	counts *persistencespb.TaskQueuePartitionCounts,
+) *persistencespb.TaskQueuePartitionCounts {
+	configured := s.configuredPartitionCounts(taskType)
+	if counts == nil || counts.Equal(configured) {
+		return configured
+	}
+	stats, err := s.collectStats(ctx, taskType, counts)
+	if err != nil {
+		s.tqMgr.logger.Warn("Failed to collect task queue partition stats",
+			tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+		return counts
+	}
+	return nextPartitionCounts(counts, configured, s.canShrinkReadPartitions(taskType, counts, stats))
+}
+
+func (s *partitionScaler) scaleTaskType(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	counts *persistencespb.TaskQueuePartitionCounts,
+) *persistencespb.TaskQueuePartitionCounts {
+	if counts == nil {
+		counts = s.configuredPartitionCounts(taskType)
+	}
+	stats, err := s.collectStats(ctx, taskType, counts)
+	if err != nil {
+		s.tqMgr.logger.Warn("Failed to collect task queue partition stats",
+			tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+		return counts
+	}
+	if !stats.rateKnown {
+		// the add rate is only known from the second round on
+		return counts
+	}
+
+	desired := s.desiredPartitions(stats)
+	target := &persistencespb.TaskQueuePartitionCounts{ReadPartitions: desired, WritePartitions: desired}
+	next := nextPartitionCounts(counts, target, s.canShrinkReadPartitions(taskType, counts, stats))
+	if !next.Equal(counts) {
+		s.tqMgr.logger.Info("Scaling task queue partitions",
+			tag.WorkflowTaskQueueType(taskType),
+			tag.NewInt32("read-partitions", next.ReadPartitions),
+			tag.NewInt32("write-partitions", next.WritePartitions),
+			tag.NewInt32("previous-read-partitions", counts.ReadPartitions),
+			tag.NewInt32("previous-write-partitions", counts.WritePartitions),
+		)
+	}
+	return next
+}
+
+// canShrinkReadPartitions returns true if the partitions which are only read from have no backlog, and
+// have not been written to for longer than clients take to pick up the reduced write partitions.
+func (s *partitionScaler) canShrinkReadPartitions(
+	taskType enumspb.TaskQueueType,
+	counts *persistencespb.TaskQueuePartitionCounts,
+	stats partitionStats,
+) bool {
+	if counts.WritePartitions >= counts.ReadPartitions {
+		delete(s.writeShrinkTimes, taskType)
+		return false
+	}
+	shrinkTime, ok := s.writeShrinkTimes[taskType]
+	if !ok {
+		// the shrink time is not known after the root partition is reloaded, start waiting from now
+		shrinkTime = time.Now().UTC()
+		s.writeShrinkTimes[taskType] = shrinkTime
+	}
+	return stats.drained && time.Since(shrinkTime) >= s.config.PartitionConfigRefreshInterval()
+}
+
+func (s *partitionScaler) configuredPartitionCounts(
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	nsName := s.tqMgr.namespace.String()
+	baseName := s.tqMgr.taskQueueID.BaseNameString()
+	return &persistencespb.TaskQueuePartitionCounts{
+		ReadPartitions:  int32(util.Max(1, s.config.NumTaskqueueReadPartitions(nsName, baseName, taskType))),
+		WritePartitions: int32(util.Max(1, s.config.NumTaskqueueWritePartitions(nsName, baseName, taskType))),
+	}
+}
+
+func (s *partitionScaler) collectStats(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	counts *persistencespb.TaskQueuePartitionCounts,
+) (partitionStats, error) {
+	stats := partitionStats{rateKnown: true, drained: true}
+	for i := 0; i < int(counts.ReadPartitions); i++ {
+		resp, err := s.tqMgr.matchingClient.DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: s.tqMgr.taskQueueID.namespaceID.String(),
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: s.tqMgr.taskQueueID.WithPartition(i).FullName(),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType:          taskType,
+				IncludeTaskQueueStatus: true,
+			},
+		})
+		if err != nil {
+			return partitionStats{}, err
+		}
+		now := time.Now().UTC()
+
+		backlog := resp.GetTaskQueueStatus().GetBacklogCountHint()
+		if i >= int(counts.WritePartitions) {
+			if backlog > 0 {
+				stats.drained = false
+			}
+			continue
+		}
+		stats.backlog += backlog
+
+		key := partitionSampleKey{taskType: taskType, partition: i}
+		sample := addedTaskSample{count: resp.GetAddedTaskCount(), time: now}
+		last, ok := s.lastSamples[key]
+		s.lastSamples[key] = sample
+		if !ok || sample.count < last.count || !sample.time.After(last.time) {
+			// first round, or the partition was reloaded since the last round
+			stats.rateKnown = false
+			continue
+		}
+		stats.addRate += float64(sample.count-last.count) / sample.time.Sub(last.time).Seconds()
+	}
+	return stats, nil
+}
+
+func (s *partitionScaler) desiredPartitions(stats partitionStats) int32 {
+	nsName := s.tqMgr.namespace.String()
+	minPartitions := util.Max(1, s.config.PartitionAutoScaleMinPartitions(nsName))
+	maxPartitions := util.Max(minPartitions, s.config.PartitionAutoScaleMaxPartitions(nsName))
+
+	desired := float64(minPartitions)
+	if targetAddRate := s.config.PartitionAutoScaleTargetAddRate(nsName); targetAddRate > 0 {
+		desired = math.Max(desired, math.Ceil(stats.addRate/targetAddRate))
+	}
+	if targetBacklog := s.config.PartitionAutoScaleTargetBacklog(nsName); targetBacklog > 0 {
+		desired = math.Max(desired, math.Ceil(float64(stats.backlog)/float64(targetBacklog)))
+	}
+	return int32(math.Min(desired, float64(maxPartitions)))
+}
+
+// nextPartitionCounts moves the partition counts one step towards the target counts. Read partitions are
+// only removed if canShrinkRead is true.
+func nextPartitionCounts(
+	counts *persistencespb.TaskQueuePartitionCounts,
+	target *persistencespb.TaskQueuePartitionCounts,
+	canShrinkRead bool,
+) *persistencespb.TaskQueuePartitionCounts {
+	next := *counts
+	targetRead := util.Max(target.ReadPartitions, target.WritePartitions)
+	switch {
+	case targetRead > next.ReadPartitions:
+		// let pollers reach the new partitions before tasks are written to them
+		next.ReadPartitions = targetRead
+	case target.WritePartitions != next.WritePartitions:
+		// when shrinking, stop writing to the removed partitions, they are read from until drained
+		next.WritePartitions = target.WritePartitions
+	case targetRead < next.ReadPartitions && canShrinkRead:
+		next.ReadPartitions = targetRead
+	}
+	return &next
+}
+
+// maxPartitionCount returns the largest partition count in the given config.
+func maxPartitionCount(partitionConfig *persistencespb.TaskQueuePartitionConfig) int {
+	return int(util.Max(
+		util.Max(partitionConfig.GetWorkflowPartitions().GetReadPartitions(), partitionConfig.GetWorkflowPartitions().GetWritePartitions()),
+		util.Max(partitionConfig.GetActivityPartitions().GetReadPartitions(), partitionConfig.GetActivityPartitions().GetWritePartitions()),
+	))
+}
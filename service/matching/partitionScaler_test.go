@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/tqname"
+)
+
+func TestNextPartitionCounts(t *testing.T) {
+	counts := func(read, write int32) *persistencespb.TaskQueuePartitionCounts {
+		return &persistencespb.TaskQueuePartitionCounts{ReadPartitions: read, WritePartitions: write}
+	}
+	testCases := []struct {
+		name    string
+		current *persistencespb.TaskQueuePartitionCounts
+		target  *persistencespb.TaskQueuePartitionCounts
+		drained bool
+		next    *persistencespb.TaskQueuePartitionCounts
+	}{
+		{name: "unchanged", current: counts(2, 2), target: counts(2, 2), drained: true, next: counts(2, 2)},
+		{name: "grow read partitions first", current: counts(1, 1), target: counts(4, 4), next: counts(4, 1)},
+		{name: "then grow write partitions", current: counts(4, 1), target: counts(4, 4), next: counts(4, 4)},
+		{name: "shrink write partitions first", current: counts(4, 4), target: counts(2, 2), next: counts(4, 2)},
+		{name: "keep reading until drained", current: counts(4, 2), target: counts(2, 2), drained: false, next: counts(4, 2)},
+		{name: "then shrink read partitions", current: counts(4, 2), target: counts(2, 2), drained: true, next: counts(2, 2)},
+		{name: "grow again while draining", current: counts(4, 2), target: counts(3, 3), drained: false, next: counts(4, 3)},
+		{name: "restore configured partitions", current: counts(4, 4), target: counts(2, 1), next: counts(4, 1)},
+		{name: "restore configured read partitions", current: counts(4, 1), target: counts(2, 1), drained: true, next: counts(2, 1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.next, nextPartitionCounts(tc.current, tc.target, tc.drained))
+		})
+	}
+}
+
+func TestPartitionScaler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	testOpts := defaultTqmTestOpts(controller)
+	autoScaleEnabled := true
+	testOpts.config.EnablePartitionAutoScale = func(string) bool { return autoScaleEnabled }
+	testOpts.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	testOpts.config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	testOpts.config.PartitionAutoScaleMaxPartitions = dynamicconfig.GetIntPropertyFilteredByNamespace(4)
+	testOpts.config.PartitionAutoScaleTargetAddRate = func(string) float64 { return 100 }
+	partitionConfigRefreshInterval := time.Duration(0)
+	testOpts.config.PartitionConfigRefreshInterval = func() time.Duration { return partitionConfigRefreshInterval }
+	tqm := mustCreateTestTaskQueueManagerWithConfig(t, controller, testOpts)
+	_, err := tqm.db.RenewLease(context.Background())
+	require.NoError(t, err)
+	scaler := tqm.partitionScaler
+	require.NotNil(t, scaler)
+
+	var addedTaskCount, removedPartitionBacklog int64
+	testOpts.matchingClientMock.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...interface{}) (*matchingservice.DescribeTaskQueueResponse, error) {
+			name, err := tqname.Parse(request.DescRequest.TaskQueue.GetName())
+			require.NoError(t, err)
+			if name.IsRoot() {
+				return &matchingservice.DescribeTaskQueueResponse{
+					AddedTaskCount:  addedTaskCount,
+					TaskQueueStatus: &taskqueuepb.TaskQueueStatus{},
+				}, nil
+			}
+			return &matchingservice.DescribeTaskQueueResponse{
+				TaskQueueStatus: &taskqueuepb.TaskQueueStatus{BacklogCountHint: removedPartitionBacklog},
+			}, nil
+		}).AnyTimes()
+	partitionCounts := func() (*persistencespb.TaskQueuePartitionCounts, *persistencespb.TaskQueuePartitionCounts) {
+		partitionConfig := tqm.GetPartitionConfig()
+		return partitionConfig.GetWorkflowPartitions(), partitionConfig.GetActivityPartitions()
+	}
+	requireCounts := func(read, write int32) {
+		t.Helper()
+		workflowCounts, activityCounts := partitionCounts()
+		expected := &persistencespb.TaskQueuePartitionCounts{ReadPartitions: read, WritePartitions: write}
+		require.Equal(t, expected, workflowCounts)
+		require.Equal(t, expected, activityCounts)
+	}
+
+	// the add rate is unknown in the first round
+	scaler.scale(context.Background())
+	requireCounts(1, 1)
+
+	for _, taskType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		scaler.lastSamples[partitionSampleKey{taskType: taskType, partition: 0}] = addedTaskSample{
+			time: time.Now().UTC().Add(-time.Second),
+		}
+	}
+	addedTaskCount = 1000
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	addedTaskCount = 2000
+	scaler.scale(context.Background())
+	requireCounts(4, 4)
+
+	// no more tasks are added, shrink once the removed partitions are drained
+	removedPartitionBacklog = 10
+	scaler.scale(context.Background())
+	requireCounts(4, 4) // the add rate of the new write partitions is not known yet
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	removedPartitionBacklog = 0
+	scaler.scale(context.Background())
+	requireCounts(1, 1)
+
+	// read partitions are kept until clients stopped writing to the removed partitions
+	partitionConfigRefreshInterval = time.Hour
+	addedTaskCount = 3000
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	addedTaskCount = 4000
+	scaler.scale(context.Background())
+	requireCounts(4, 4)
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	partitionConfigRefreshInterval = 0
+
+	// turning auto scaling off drains the auto scaled partitions before the counts are removed
+	autoScaleEnabled = false
+	removedPartitionBacklog = 10
+	scaler.scale(context.Background())
+	requireCounts(4, 1)
+	removedPartitionBacklog = 0
+	scaler.scale(context.Background())
+	require.Nil(t, tqm.GetPartitionConfig())
+}
@@ -25,11 +25,16 @@
 package matching
 
 import (
+	"context"
+	"strconv"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/headers"
 )
 
 const (
@@ -41,8 +46,27 @@ const (
 type (
 	pollerIdentity string
 
+	// pollMetadata is what a worker reports about itself in its polls
+	pollMetadata struct {
+		ratePerSecond             *float64
+		sdkName                   string
+		sdkVersion                string
+		workerVersionCapabilities *commonpb.WorkerVersionCapabilities
+		taskSlotsAvailable        int32
+		taskSlotsUsed             int32
+		// forwarded is set for polls forwarded by a child partition, which were counted by it already
+		forwarded bool
+	}
+
 	pollerInfo struct {
-		ratePerSecond float64
+		ratePerSecond      float64
+		sdkName            string
+		sdkVersion         string
+		buildID            string
+		taskSlotsAvailable int32
+		taskSlotsUsed      int32
+		firstPollTime      time.Time
+		pollCount          int64
 	}
 )
 
@@ -64,12 +88,72 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+// newPollMetadata returns the metadata reported by the worker in a poll request, the rate limit of the task
+// queue requested by the worker is added by the task queue manager.
+func newPollMetadata(
+	ctx context.Context,
+	capabilities *commonpb.WorkerVersionCapabilities,
+	forwardedSource string,
+) *pollMetadata {
+	sdkName, sdkVersion := headers.GetClientNameAndVersion(ctx)
+	slots := headers.GetValues(ctx, headers.WorkerTaskSlotsAvailableHeaderName, headers.WorkerTaskSlotsUsedHeaderName)
+	return &pollMetadata{
+		sdkName:                   sdkName,
+		sdkVersion:                sdkVersion,
+		workerVersionCapabilities: capabilities,
+		taskSlotsAvailable:        parseTaskSlots(slots[0]),
+		taskSlotsUsed:             parseTaskSlots(slots[1]),
+		forwarded:                 forwardedSource != "",
+	}
+}
+
+// parseTaskSlots returns zero for a missing or malformed header, the inventory is best effort.
+func parseTaskSlots(value string) int32 {
+	slots, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || slots < 0 {
+		return 0
+	}
+	return int32(slots)
+}
+
+// updatePollerInfo records a poll of the poller.
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, metadata *pollMetadata) {
 	rps := defaultTaskDispatchRPS
-	if ratePerSecond != nil {
-		rps = *ratePerSecond
+	if metadata.ratePerSecond != nil {
+		rps = *metadata.ratePerSecond
+	}
+	info := &pollerInfo{
+		ratePerSecond:      rps,
+		sdkName:            metadata.sdkName,
+		sdkVersion:         metadata.sdkVersion,
+		buildID:            metadata.workerVersionCapabilities.GetBuildId(),
+		taskSlotsAvailable: metadata.taskSlotsAvailable,
+		taskSlotsUsed:      metadata.taskSlotsUsed,
+		firstPollTime:      time.Now().UTC(),
+	}
+	// a forwarded poll is counted by the partition the worker sent it to, so that aggregating the partitions
+	// counts each poll once
+	if !metadata.forwarded {
+		info.pollCount = 1
+	}
+	// concurrent polls of the same poller may lose a poll count, which is fine for an inventory
+	if existing, ok := pollers.history.Get(id).(*pollerInfo); ok {
+		info.firstPollTime = existing.firstPollTime
+		info.pollCount += existing.pollCount
+		if metadata.forwarded {
+			// the worker reported its slots to the partition it polled directly
+			info.taskSlotsAvailable = existing.taskSlotsAvailable
+			info.taskSlotsUsed = existing.taskSlotsUsed
+		}
+	}
+	pollers.history.Put(id, info)
+}
+
+// touchPollerInfo updates the last access time of the poller, without recording another poll.
+func (pollers *pollerHistory) touchPollerInfo(id pollerIdentity) {
+	if existing, ok := pollers.history.Get(id).(*pollerInfo); ok {
+		pollers.history.Put(id, existing)
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
 }
 
 func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*taskqueuepb.PollerInfo {
@@ -84,13 +168,61 @@ func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*tas
 		// TODO add IP, T1396795
 		lastAccessTime := entry.CreateTime()
 		if earliestAccessTime.Before(lastAccessTime) {
+			var capabilities *commonpb.WorkerVersionCapabilities
+			if value.buildID != "" {
+				capabilities = &commonpb.WorkerVersionCapabilities{BuildId: value.buildID}
+			}
 			result = append(result, &taskqueuepb.PollerInfo{
-				Identity:       string(key),
-				LastAccessTime: &lastAccessTime,
-				RatePerSecond:  value.ratePerSecond,
+				Identity:                  string(key),
+				LastAccessTime:            &lastAccessTime,
+				RatePerSecond:             value.ratePerSecond,
+				WorkerVersionCapabilities: capabilities,
 			})
 		}
 	}
 
 	return result
 }
+
+// getWorkerInfo returns the inventory of the pollers, without the namespace and task queue of the workers.
+// Pollers which only reached this partition through forwarded polls are left to the partitions they polled.
+func (pollers *pollerHistory) getWorkerInfo() []*taskqueuespb.WorkerInfo {
+	var result []*taskqueuespb.WorkerInfo
+
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		value := entry.Value().(*pollerInfo)
+		if value.pollCount == 0 {
+			continue
+		}
+		firstPollTime := value.firstPollTime
+		lastPollTime := entry.CreateTime()
+		result = append(result, &taskqueuespb.WorkerInfo{
+			Identity:           string(entry.Key().(pollerIdentity)),
+			SdkName:            value.sdkName,
+			SdkVersion:         value.sdkVersion,
+			BuildId:            value.buildID,
+			FirstPollTime:      &firstPollTime,
+			LastPollTime:       &lastPollTime,
+			PollCount:          value.pollCount,
+			MaxTasksPerSecond:  value.ratePerSecond,
+			PollRate:           pollRate(value.pollCount, firstPollTime, lastPollTime),
+			TaskSlotsAvailable: value.taskSlotsAvailable,
+			TaskSlotsUsed:      value.taskSlotsUsed,
+		})
+	}
+
+	return result
+}
+
+// pollRate returns the average number of polls per second between the first and the last poll, polls of the
+// first second are averaged over a second.
+func pollRate(pollCount int64, firstPollTime time.Time, lastPollTime time.Time) float64 {
+	elapsed := lastPollTime.Sub(firstPollTime)
+	if elapsed < time.Second {
+		elapsed = time.Second
+	}
+	return float64(pollCount) / elapsed.Seconds()
+}
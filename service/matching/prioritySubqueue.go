@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/service/worker/scanner/taskqueue"
+)
+
+type (
+	// prioritySubqueue is the backlog of the tasks of a task queue with one priority other than the default
+	// priority. Each priority is persisted as a task queue of its own, with its own range, and is read in task ID
+	// order, so that a high priority task is read as soon as it is the first of its priority, and not only once the
+	// backlog in front of it is read. Tasks are deleted one by one once they are dispatched.
+	prioritySubqueue struct {
+		sync.Mutex
+		db          *taskQueueDB
+		rangeSize   int64
+		leased      bool
+		taskIDBlock taskIDBlock
+		nextTaskID  int64
+		readLevel   int64                               // ID of the last task read
+		tasks       []*persistencespb.AllocatedTaskInfo // tasks read but not dispatched yet, in task ID order
+	}
+)
+
+func newPrioritySubqueue(db *taskQueueDB, rangeSize int64) *prioritySubqueue {
+	return &prioritySubqueue{
+		db:        db,
+		rangeSize: rangeSize,
+	}
+}
+
+// load takes over the subqueue if it is persisted already, so that the tasks persisted by the previous owners are
+// read. Otherwise the subqueue is persisted once the first task is added to it.
+func (q *prioritySubqueue) load(ctx context.Context) error {
+	q.Lock()
+	defer q.Unlock()
+	if q.leased {
+		return nil
+	}
+	exists, err := q.db.Exists(ctx)
+	if err != nil || !exists {
+		return err
+	}
+	return q.renewLeaseLocked(ctx)
+}
+
+func (q *prioritySubqueue) renewLeaseLocked(ctx context.Context) error {
+	state, err := q.db.RenewLease(ctx)
+	if err != nil {
+		return err
+	}
+	q.leased = true
+	q.taskIDBlock = rangeIDToTaskIDBlock(state.rangeID, q.rangeSize)
+	q.nextTaskID = q.taskIDBlock.start
+	return nil
+}
+
+// add persists a task to the subqueue.
+func (q *prioritySubqueue) add(ctx context.Context, taskInfo *persistencespb.TaskInfo) error {
+	q.Lock()
+	defer q.Unlock()
+	if !q.leased || q.nextTaskID > q.taskIDBlock.end {
+		if err := q.renewLeaseLocked(ctx); err != nil {
+			return err
+		}
+	}
+	task := &persistencespb.AllocatedTaskInfo{Data: taskInfo, TaskId: q.nextTaskID}
+	if _, err := q.db.CreateTasks(ctx, []*persistencespb.AllocatedTaskInfo{task}); err != nil {
+		return err
+	}
+	q.nextTaskID++
+	return nil
+}
+
+// peek returns the first task of the subqueue which is not dispatched yet, reading the next batch of tasks from
+// persistence if none is read. Expired tasks are deleted instead. It returns nil if the subqueue is empty.
+func (q *prioritySubqueue) peek(ctx context.Context, batchSize int) (*persistencespb.AllocatedTaskInfo, error) {
+	q.Lock()
+	defer q.Unlock()
+	for len(q.tasks) == 0 {
+		// all tasks are written by this owner and have IDs below nextTaskID, or by the previous owners and have
+		// IDs below the task ID block of this lease
+		if !q.leased || q.readLevel >= q.nextTaskID-1 {
+			return nil, nil
+		}
+		response, err := q.db.GetTasks(ctx, q.readLevel+1, q.nextTaskID, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Tasks) == 0 {
+			q.readLevel = q.nextTaskID - 1
+			return nil, nil
+		}
+		for _, task := range response.Tasks {
+			q.readLevel = task.GetTaskId()
+			if taskqueue.IsTaskExpired(task) {
+				_ = q.db.CompleteTask(ctx, task.GetTaskId())
+				continue
+			}
+			q.tasks = append(q.tasks, task)
+		}
+	}
+	return q.tasks[0], nil
+}
+
+// head returns the first task which is read and not dispatched yet, without reading from persistence.
+func (q *prioritySubqueue) head() *persistencespb.AllocatedTaskInfo {
+	q.Lock()
+	defer q.Unlock()
+	if len(q.tasks) == 0 {
+		return nil
+	}
+	return q.tasks[0]
+}
+
+// pop removes the first task returned by peek from the tasks waiting to be dispatched. The task stays persisted
+// until it is completed.
+func (q *prioritySubqueue) pop() {
+	q.Lock()
+	defer q.Unlock()
+	if len(q.tasks) > 0 {
+		q.tasks[0] = nil
+		q.tasks = q.tasks[1:]
+	}
+}
+
+// complete deletes a task once it is dispatched or written back to the backlog.
+func (q *prioritySubqueue) complete(ctx context.Context, taskID int64) error {
+	return q.db.CompleteTask(ctx, taskID)
+}
+
+// remove deletes a task which is moved or purged from the backlog. If the task is read already it is not
+// dispatched anymore.
+func (q *prioritySubqueue) remove(ctx context.Context, taskID int64) error {
+	if err := q.db.CompleteTask(ctx, taskID); err != nil {
+		return err
+	}
+	q.Lock()
+	defer q.Unlock()
+	for i, task := range q.tasks {
+		if task.GetTaskId() == taskID {
+			q.tasks = append(q.tasks[:i], q.tasks[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// maxTaskID returns the ID of the last task persisted to the subqueue, or zero if the subqueue is not leased.
+func (q *prioritySubqueue) maxTaskID() int64 {
+	q.Lock()
+	defer q.Unlock()
+	if !q.leased {
+		return 0
+	}
+	return q.nextTaskID - 1
+}
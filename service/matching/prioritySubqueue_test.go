@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+)
+
+func TestPrioritySubqueue(t *testing.T) {
+	logger := log.NewNoopLogger()
+	tm := newTestTaskManager(logger)
+	tlID := newTestTaskQueueID(namespace.ID(uuid.New()), "tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	newDB := func() *taskQueueDB {
+		return newPriorityTaskQueueDB(tm, tlID.namespaceID, tlID, enumspb.TASK_QUEUE_KIND_NORMAL, 1, logger)
+	}
+	subqueueKey := testTaskQueueKey{namespaceID: tlID.namespaceID.String(), name: tlID.PriorityTasksFullName(1), taskType: tlID.taskType}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	expired := now.Add(-time.Minute)
+
+	// a subqueue which is not persisted is not taken over and has no tasks
+	previous := newPrioritySubqueue(newDB(), 10)
+	require.NoError(t, previous.load(ctx))
+	require.Zero(t, tm.getTaskQueueManagerByKey(subqueueKey).RangeID())
+	task, err := previous.peek(ctx, 10)
+	require.NoError(t, err)
+	require.Nil(t, task)
+
+	// the subqueue is persisted once the first task is added
+	require.NoError(t, previous.add(ctx, &persistencespb.TaskInfo{CreateTime: &now}))
+	require.NoError(t, previous.add(ctx, &persistencespb.TaskInfo{CreateTime: &now, ExpiryTime: &expired}))
+	require.Equal(t, int64(1), tm.getTaskQueueManagerByKey(subqueueKey).RangeID())
+
+	// the tasks of a previous owner are read by the new owner, expired tasks are deleted
+	subqueue := newPrioritySubqueue(newDB(), 10)
+	require.NoError(t, subqueue.load(ctx))
+	require.NoError(t, subqueue.add(ctx, &persistencespb.TaskInfo{CreateTime: &now}))
+	require.Nil(t, subqueue.head())
+	task, err = subqueue.peek(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), task.GetTaskId())
+	require.Equal(t, task, subqueue.head())
+	require.Equal(t, 2, tm.getTaskQueueManagerByKey(subqueueKey).tasks.Size())
+
+	// tasks stay persisted until they are completed
+	subqueue.pop()
+	require.NoError(t, subqueue.complete(ctx, task.GetTaskId()))
+	task, err = subqueue.peek(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), task.GetTaskId())
+	require.Equal(t, int64(11), subqueue.maxTaskID())
+
+	// removed tasks are not dispatched
+	require.NoError(t, subqueue.remove(ctx, task.GetTaskId()))
+	require.Nil(t, subqueue.head())
+	task, err = subqueue.peek(ctx, 10)
+	require.NoError(t, err)
+	require.Nil(t, task)
+	require.Zero(t, tm.getTaskQueueManagerByKey(subqueueKey).tasks.Size())
+
+	// a new task ID block is leased once the block is exhausted
+	for i := 0; i < 10; i++ {
+		require.NoError(t, subqueue.add(ctx, &persistencespb.TaskInfo{CreateTime: &now}))
+	}
+	require.Equal(t, int64(3), tm.getTaskQueueManagerByKey(subqueueKey).RangeID())
+}
@@ -62,6 +62,7 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		inflightSlot     *inflightSlot // slot in the inflight activity task limit reserved by the poller, if any
 	}
 )
 
@@ -0,0 +1,149 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// taskDispatchBuffer holds the backlog tasks moved out of the taskReader's taskBuffer when task
+	// priority or fairness is enabled. Tasks are grouped by fairness key, the fairness keys take turns
+	// by smooth weighted round-robin, and the tasks of a fairness key are dispatched by dispatch key.
+	// Only the tasks read into the buffer take part, a fairness key with a large backlog in persistence
+	// can still delay the tasks of other keys which are not read yet. The backlog of each fairness key in
+	// persistence is counted by the taskReader for metrics and DescribeTaskQueue.
+	taskDispatchBuffer struct {
+		sync.Mutex
+		queues map[string]*fairnessKeyQueue
+		size   int
+	}
+
+	fairnessKeyQueue struct {
+		tasks         priorityTaskHeap
+		weight        int
+		currentWeight int
+	}
+)
+
+func (b *taskDispatchBuffer) push(
+	task *persistencespb.AllocatedTaskInfo,
+	fairnessKey string,
+	weight int,
+	key time.Time,
+) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.queues == nil {
+		b.queues = make(map[string]*fairnessKeyQueue)
+	}
+	queue, ok := b.queues[fairnessKey]
+	if !ok {
+		queue = &fairnessKeyQueue{}
+		b.queues[fairnessKey] = queue
+	}
+	if weight < 1 {
+		weight = 1
+	}
+	queue.weight = weight
+	heap.Push(&queue.tasks, priorityTask{task: task, key: key})
+	b.size++
+}
+
+func (b *taskDispatchBuffer) pop() *persistencespb.AllocatedTaskInfo {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.size == 0 {
+		return nil
+	}
+
+	var selectedKey string
+	var selected *fairnessKeyQueue
+	totalWeight := 0
+	for fairnessKey, queue := range b.queues {
+		queue.currentWeight += queue.weight
+		totalWeight += queue.weight
+		if selected == nil ||
+			queue.currentWeight > selected.currentWeight ||
+			(queue.currentWeight == selected.currentWeight && fairnessKey < selectedKey) {
+			selectedKey = fairnessKey
+			selected = queue
+		}
+	}
+	selected.currentWeight -= totalWeight
+
+	task := heap.Pop(&selected.tasks).(priorityTask).task
+	b.size--
+	if len(selected.tasks) == 0 {
+		delete(b.queues, selectedKey)
+	}
+	return task
+}
+
+func (b *taskDispatchBuffer) len() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.size
+}
+
+// hasTaskBefore returns true if a buffered task with the given fairness key should be dispatched before
+// a task with the given dispatch key.
+func (b *taskDispatchBuffer) hasTaskBefore(fairnessKey string, key time.Time) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	queue, ok := b.queues[fairnessKey]
+	return ok && !queue.tasks[0].key.After(key)
+}
+
+// topFairnessKeyBacklogs returns up to limit fairness keys with the largest backlog count, largest first. A
+// negative limit returns all keys.
+func topFairnessKeyBacklogs(counts map[string]int64, limit int) []*matchingservice.FairnessKeyBacklog {
+	backlogs := make([]*matchingservice.FairnessKeyBacklog, 0, len(counts))
+	for fairnessKey, count := range counts {
+		backlogs = append(backlogs, &matchingservice.FairnessKeyBacklog{
+			FairnessKey:  fairnessKey,
+			BacklogCount: count,
+		})
+	}
+	sort.Slice(backlogs, func(i, j int) bool {
+		if backlogs[i].BacklogCount == backlogs[j].BacklogCount {
+			return backlogs[i].FairnessKey < backlogs[j].FairnessKey
+		}
+		return backlogs[i].BacklogCount > backlogs[j].BacklogCount
+	})
+	if limit >= 0 && len(backlogs) > limit {
+		backlogs = backlogs[:limit]
+	}
+	return backlogs
+}
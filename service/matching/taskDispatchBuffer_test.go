@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func TestTaskDispatchBuffer_Priority(t *testing.T) {
+	cfg := newTaskQueueConfig(defaultTqId(), defaultTestConfig(), "test-namespace")
+	now := time.Now().UTC()
+
+	var buffer taskDispatchBuffer
+	require.Nil(t, buffer.pop())
+	for i, priority := range []int32{3, 1, 5, 1, 2} {
+		task := &persistencespb.AllocatedTaskInfo{
+			Data:   &persistencespb.TaskInfo{CreateTime: &now, Priority: priority},
+			TaskId: int64(i + 1),
+		}
+		buffer.push(task, "", 1, taskDispatchKey(task.Data, cfg))
+	}
+	require.Equal(t, 5, buffer.len())
+	require.True(t, buffer.hasTaskBefore("", taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now, Priority: 1}, cfg)))
+	earlier := now.Add(-time.Minute)
+	require.False(t, buffer.hasTaskBefore("", taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &earlier, Priority: 1}, cfg)))
+	require.False(t, buffer.hasTaskBefore("other", taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now, Priority: 5}, cfg)))
+
+	var taskIDs []int64
+	for buffer.len() > 0 {
+		taskIDs = append(taskIDs, buffer.pop().GetTaskId())
+	}
+	require.Equal(t, []int64{2, 4, 5, 1, 3}, taskIDs)
+}
+
+func TestTaskDispatchBuffer_WeightedRoundRobin(t *testing.T) {
+	var buffer taskDispatchBuffer
+	taskID := int64(0)
+	pushTasks := func(fairnessKey string, weight int, count int) {
+		for i := 0; i < count; i++ {
+			taskID++
+			task := &persistencespb.AllocatedTaskInfo{
+				Data:   &persistencespb.TaskInfo{FairnessKey: fairnessKey},
+				TaskId: taskID,
+			}
+			buffer.push(task, fairnessKey, weight, time.Time{})
+		}
+	}
+	// the flooding key is buffered first but does not delay the other keys
+	pushTasks("flood", 1, 10)
+	pushTasks("a", 1, 2)
+	pushTasks("b", 2, 4)
+
+	var fairnessKeys []string
+	var taskIDs []int64
+	for i := 0; i < 8; i++ {
+		task := buffer.pop()
+		fairnessKeys = append(fairnessKeys, task.Data.GetFairnessKey())
+		taskIDs = append(taskIDs, task.GetTaskId())
+	}
+	require.Equal(t, []string{"b", "a", "flood", "b", "b", "a", "flood", "b"}, fairnessKeys)
+	// tasks of the same key are dispatched in order
+	require.Equal(t, []int64{13, 11, 1, 14, 15, 12, 2, 16}, taskIDs)
+	require.Equal(t, 8, buffer.len())
+	for buffer.len() > 0 {
+		require.Equal(t, "flood", buffer.pop().Data.GetFairnessKey())
+	}
+}
+
+func TestTopFairnessKeyBacklogs(t *testing.T) {
+	counts := map[string]int64{"flood": 10, "a": 2, "b": 4, "c": 4}
+	require.Equal(t, []*matchingservice.FairnessKeyBacklog{
+		{FairnessKey: "flood", BacklogCount: 10},
+		{FairnessKey: "b", BacklogCount: 4},
+		{FairnessKey: "c", BacklogCount: 4},
+	}, topFairnessKeyBacklogs(counts, 3))
+	require.Len(t, topFairnessKeyBacklogs(counts, -1), 4)
+	require.Empty(t, topFairnessKeyBacklogs(nil, 10))
+}
+
+func TestSyncMatchSkippedForBufferedTaskWithSameFairnessKey(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	testOpts := defaultTqmTestOpts(controller)
+	testOpts.config.EnableTaskFairness = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	tqm := mustCreateTestTaskQueueManagerWithConfig(t, controller, testOpts)
+
+	tqm.taskReader.bufferTask(&persistencespb.AllocatedTaskInfo{
+		Data:   &persistencespb.TaskInfo{FairnessKey: "flood"},
+		TaskId: 1,
+	})
+	matched, err := tqm.trySyncMatch(context.Background(), addTaskParams{
+		execution: &commonpb.WorkflowExecution{},
+		taskInfo:  &persistencespb.TaskInfo{FairnessKey: "flood"},
+		source:    enumsspb.TASK_SOURCE_HISTORY,
+	})
+	require.NoError(t, err)
+	require.False(t, matched)
+}
@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+)
+
+type (
+	// priorityTask is a buffered task with its dispatch key. A task is dispatched before tasks with a
+	// later dispatch key, tasks with the same key are dispatched by task ID.
+	priorityTask struct {
+		task *persistencespb.AllocatedTaskInfo
+		key  time.Time
+	}
+
+	priorityTaskHeap []priorityTask
+)
+
+// taskPriorityLevel returns the priority of a task, or the default priority if the task has none. Priorities are
+// capped at common.MaxTaskPriority.
+func taskPriorityLevel(
+	taskInfo *persistencespb.TaskInfo,
+	config *taskQueueConfig,
+) int {
+	priority := int64(taskInfo.GetPriority())
+	if priority <= 0 {
+		priority = int64(config.DefaultTaskPriority())
+	}
+	if priority <= 0 {
+		priority = 1
+	}
+	if priority > common.MaxTaskPriority {
+		priority = common.MaxTaskPriority
+	}
+	return int(priority)
+}
+
+// taskDispatchKey returns the key tasks are dispatched by, lower keys are dispatched first. The key is the
+// time the task became visible pushed back by one aging interval per priority level, so tasks which wait longer than
+// the aging interval overtake newer tasks of the next higher priority and low priority tasks are not
+// starved by a constant stream of high priority tasks.
+//
+// Tasks of other priorities than the default priority are persisted in a subqueue per priority, see
+// prioritySubqueue, and the dispatch keys of the heads of the subqueues and of the buffered backlog tasks
+// decide which is dispatched next.
+func taskDispatchKey(
+	taskInfo *persistencespb.TaskInfo,
+	config *taskQueueConfig,
+) time.Time {
+	priority := taskPriorityLevel(taskInfo, config)
+	return taskVisibleTime(taskInfo).Add(time.Duration(priority) * config.TaskPriorityAgingInterval())
+}
+
+func (h priorityTaskHeap) Len() int {
+	return len(h)
+}
+
+func (h priorityTaskHeap) Less(i, j int) bool {
+	if h[i].key.Equal(h[j].key) {
+		return h[i].task.GetTaskId() < h[j].task.GetTaskId()
+	}
+	return h[i].key.Before(h[j].key)
+}
+
+func (h priorityTaskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *priorityTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(priorityTask))
+}
+
+func (h *priorityTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = priorityTask{}
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestTaskDispatchKey(t *testing.T) {
+	cfg := newTaskQueueConfig(defaultTqId(), defaultTestConfig(), "test-namespace")
+	now := time.Now().UTC()
+
+	key := taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now, Priority: 1}, cfg)
+	require.Equal(t, now.Add(time.Minute), key)
+
+	// unset priority falls back to the default priority
+	key = taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now}, cfg)
+	require.Equal(t, now.Add(3*time.Minute), key)
+
+	// a low priority task which waited long enough is dispatched before a newer high priority task
+	oldTime := now.Add(-5 * time.Minute)
+	oldKey := taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &oldTime, Priority: 5}, cfg)
+	newKey := taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now, Priority: 1}, cfg)
+	require.True(t, oldKey.Before(newKey))
+
+	// out of range priorities are capped instead of overflowing the key
+	key = taskDispatchKey(&persistencespb.TaskInfo{CreateTime: &now, Priority: math.MaxInt32}, cfg)
+	require.Equal(t, now.Add(common.MaxTaskPriority*time.Minute), key)
+}
+
+func TestSyncMatchSkippedForBufferedHigherPriorityTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	testOpts := defaultTqmTestOpts(controller)
+	testOpts.config.EnableTaskPriority = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	tqm := mustCreateTestTaskQueueManagerWithConfig(t, controller, testOpts)
+
+	now := time.Now().UTC()
+	buffered := &persistencespb.AllocatedTaskInfo{
+		Data:   &persistencespb.TaskInfo{CreateTime: &now, Priority: 1},
+		TaskId: 1,
+	}
+	tqm.taskReader.bufferTask(buffered)
+
+	matched, err := tqm.trySyncMatch(context.Background(), addTaskParams{
+		execution: &commonpb.WorkflowExecution{},
+		taskInfo:  &persistencespb.TaskInfo{CreateTime: timestamp.TimePtr(now), Priority: 5},
+		source:    enumsspb.TASK_SOURCE_HISTORY,
+	})
+	require.NoError(t, err)
+	require.False(t, matched)
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// backlogAgeBuckets are the upper bounds of the age histogram buckets of a backlog summary. Tasks older than the
+// last bound are counted in an extra, unbounded bucket.
+var backlogAgeBuckets = []time.Duration{
+	time.Minute,
+	10 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+func newBacklogSummary() *taskqueuespb.BacklogSummary {
+	summary := &taskqueuespb.BacklogSummary{
+		WorkflowTypeTaskCounts: make(map[string]int64),
+		AgeHistogram:           make([]*taskqueuespb.BacklogAgeBucket, 0, len(backlogAgeBuckets)+1),
+	}
+	for _, maxAge := range backlogAgeBuckets {
+		summary.AgeHistogram = append(summary.AgeHistogram, &taskqueuespb.BacklogAgeBucket{
+			MaxAge: timestamp.DurationPtr(maxAge),
+		})
+	}
+	summary.AgeHistogram = append(summary.AgeHistogram, &taskqueuespb.BacklogAgeBucket{})
+	return summary
+}
+
+// addBacklogTask adds a backlog task to the summary.
+func addBacklogTask(summary *taskqueuespb.BacklogSummary, task *persistencespb.AllocatedTaskInfo, now time.Time) {
+	summary.TaskCount++
+	summary.WorkflowTypeTaskCounts[task.Data.GetWorkflowTypeName()]++
+
+	createTime := timestamp.TimeValue(task.Data.GetCreateTime())
+	age := now.Sub(createTime)
+	for _, bucket := range summary.AgeHistogram {
+		if bucket.MaxAge == nil || age < *bucket.MaxAge {
+			bucket.TaskCount++
+			break
+		}
+	}
+
+	// task ids only roughly follow creation time, e.g. when tasks are written back after failing to start
+	if summary.OldestTask == nil || createTime.Before(timestamp.TimeValue(summary.OldestTask.Data.GetCreateTime())) {
+		summary.OldestTask = task
+	}
+}
+
+// matchesBacklogFilter returns true if the task is selected by the filter. A nil filter selects all tasks.
+func matchesBacklogFilter(filter *taskqueuespb.BacklogTaskFilter, task *persistencespb.TaskInfo) bool {
+	if filter.GetWorkflowId() != "" && filter.GetWorkflowId() != task.GetWorkflowId() {
+		return false
+	}
+	if filter.GetRunId() != "" && filter.GetRunId() != task.GetRunId() {
+		return false
+	}
+	if filter.GetWorkflowTypeName() != "" && filter.GetWorkflowTypeName() != task.GetWorkflowTypeName() {
+		return false
+	}
+	if filter.GetCreatedBefore() != nil && !timestamp.TimeValue(task.GetCreateTime()).Before(*filter.GetCreatedBefore()) {
+		return false
+	}
+	return true
+}
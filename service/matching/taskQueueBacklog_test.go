@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestMatchesBacklogFilter(t *testing.T) {
+	now := time.Now().UTC()
+	task := &persistencespb.TaskInfo{
+		WorkflowId:       "wid",
+		RunId:            "rid",
+		WorkflowTypeName: "wtype",
+		CreateTime:       timestamp.TimePtr(now),
+	}
+
+	testCases := []struct {
+		name    string
+		filter  *taskqueuespb.BacklogTaskFilter
+		matches bool
+	}{
+		{name: "nil filter", filter: nil, matches: true},
+		{name: "empty filter", filter: &taskqueuespb.BacklogTaskFilter{}, matches: true},
+		{name: "workflow id", filter: &taskqueuespb.BacklogTaskFilter{WorkflowId: "wid"}, matches: true},
+		{name: "other workflow id", filter: &taskqueuespb.BacklogTaskFilter{WorkflowId: "other"}, matches: false},
+		{name: "run id", filter: &taskqueuespb.BacklogTaskFilter{WorkflowId: "wid", RunId: "rid"}, matches: true},
+		{name: "other run id", filter: &taskqueuespb.BacklogTaskFilter{RunId: "other"}, matches: false},
+		{name: "workflow type", filter: &taskqueuespb.BacklogTaskFilter{WorkflowTypeName: "wtype"}, matches: true},
+		{name: "other workflow type", filter: &taskqueuespb.BacklogTaskFilter{WorkflowTypeName: "other"}, matches: false},
+		{name: "created before", filter: &taskqueuespb.BacklogTaskFilter{CreatedBefore: timestamp.TimePtr(now.Add(time.Second))}, matches: true},
+		{name: "created at", filter: &taskqueuespb.BacklogTaskFilter{CreatedBefore: timestamp.TimePtr(now)}, matches: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, matchesBacklogFilter(tc.filter, task))
+		})
+	}
+}
+
+func TestAddBacklogTask(t *testing.T) {
+	now := time.Now().UTC()
+	newTask := func(taskID int64, workflowType string, age time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: taskID,
+			Data: &persistencespb.TaskInfo{
+				WorkflowTypeName: workflowType,
+				CreateTime:       timestamp.TimePtr(now.Add(-age)),
+			},
+		}
+	}
+
+	summary := newBacklogSummary()
+	addBacklogTask(summary, newTask(1, "a", 5*time.Minute), now)
+	// written back after failing to start, so it has a higher task id than newer tasks
+	addBacklogTask(summary, newTask(2, "a", 30*24*time.Hour), now)
+	addBacklogTask(summary, newTask(3, "b", time.Second), now)
+
+	require.EqualValues(t, 3, summary.TaskCount)
+	require.Equal(t, map[string]int64{"a": 2, "b": 1}, summary.WorkflowTypeTaskCounts)
+	require.Len(t, summary.AgeHistogram, len(backlogAgeBuckets)+1)
+	require.EqualValues(t, 1, summary.AgeHistogram[0].TaskCount)
+	require.EqualValues(t, 1, summary.AgeHistogram[1].TaskCount)
+	require.Nil(t, summary.AgeHistogram[len(backlogAgeBuckets)].MaxAge)
+	require.EqualValues(t, 1, summary.AgeHistogram[len(backlogAgeBuckets)].TaskCount)
+	require.EqualValues(t, 2, summary.OldestTask.TaskId)
+}
@@ -44,6 +44,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cluster"
@@ -56,6 +57,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/util"
 )
 
@@ -118,13 +120,40 @@ type (
 		GetVersioningData(ctx context.Context) (*persistencespb.VersioningData, error)
 		// MutateVersioningData allows callers to update versioning data for this task queue
 		MutateVersioningData(ctx context.Context, mutator func(*persistencespb.VersioningData) error) error
+		// GetBuildIdRamp returns the build ID ramp of this task queue, if any
+		GetBuildIdRamp(ctx context.Context) (*persistencespb.BuildIdRamp, error)
+		// GetBuildIdReachability tells whether workflow executions may still need the workers running the build ID
+		GetBuildIdReachability(ctx context.Context, data *persistencespb.VersioningData, buildID string) (buildIdReachability, error)
 		// InvalidateMetadata allows callers to invalidate cached data on this task queue
 		InvalidateMetadata(request *matchingservice.InvalidateTaskQueueMetadataRequest) error
 		CancelPoller(pollerID string)
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
 		HasPollerAfter(accessTime time.Time) bool
+		// IsStickyWorkerPolling returns true if the worker of this sticky task queue polled within the sticky
+		// poller unavailable window
+		IsStickyWorkerPolling() bool
+		// IsStickyWorkerSaturated returns true if the backlog of this sticky task queue reached the sticky max
+		// backlog, which means its worker does not keep up with the workflow tasks sent to it
+		IsStickyWorkerSaturated() bool
 		// DescribeTaskQueue returns information about the target task queue
 		DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
+		// DescribeFairnessKeyBacklogs returns the fairness keys with the largest persisted backlog, only if task
+		// fairness is enabled
+		DescribeFairnessKeyBacklogs(ctx context.Context) ([]*matchingservice.FairnessKeyBacklog, error)
+		// GetPartitionConfig returns the partition counts chosen by partition auto scaling, only present on the
+		// root workflow partition
+		GetPartitionConfig() *persistencespb.TaskQueuePartitionConfig
+		// DescribeBacklog summarizes the persisted backlog of the task queue
+		DescribeBacklog(ctx context.Context) (*taskqueuespb.BacklogSummary, error)
+		// MoveBacklogTasks moves at most maxTasks backlog tasks selected by the filter to the destination task queue
+		MoveBacklogTasks(ctx context.Context, filter *taskqueuespb.BacklogTaskFilter, destination string, maxTasks int) (int64, error)
+		// PurgeBacklogTasks deletes at most maxTasks backlog tasks selected by the filter
+		PurgeBacklogTasks(ctx context.Context, filter *taskqueuespb.BacklogTaskFilter, maxTasks int) (int64, error)
+		// CloseInflightActivityTask releases the slot held by a dispatched activity task in the inflight
+		// activity task limit. Returns false if the task holds no slot.
+		CloseInflightActivityTask(key inflightTaskKey) bool
+		// GetWorkers returns the inventory of the workers which polled the task queue recently
+		GetWorkers() []*taskqueuespb.WorkerInfo
 		String() string
 		QueueID() *taskQueueID
 		TaskQueueKind() enumspb.TaskQueueKind
@@ -165,6 +194,13 @@ type (
 		// the root partition, it is fulfilled as soon as it is fetched from db.
 		metadataInitialFetch *future.FutureImpl[struct{}]
 		metadataPoller       metadataPoller
+		// only set on the root workflow partition
+		partitionScaler  *partitionScaler
+		buildIdScavenger *buildIdScavenger
+		// number of tasks added by the history service since this partition was loaded
+		addedTaskCount atomic.Int64
+		// set once the versioning data was fetched from the root partition, or is owned by this partition
+		metadataFetched atomic.Bool
 	}
 
 	metadataPoller struct {
@@ -240,6 +276,12 @@ func newTaskQueueManager(
 		},
 	}
 	tlMgr.metadataPoller.tqMgr = tlMgr
+	// the partitions of the task queues of a build ID ramp follow the ones of the task queue they are split from
+	if taskQueue.IsRoot() && taskQueue.VersionSet() == "" &&
+		taskQueue.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueKind == enumspb.TASK_QUEUE_KIND_NORMAL {
+		tlMgr.partitionScaler = newPartitionScaler(tlMgr, config)
+		tlMgr.buildIdScavenger = newBuildIdScavenger(tlMgr, config)
+	}
 
 	tlMgr.liveness = newLiveness(
 		clockwork.NewRealClock(),
@@ -294,6 +336,12 @@ func (c *taskQueueManagerImpl) Start() {
 	c.taskWriter.Start()
 	c.taskReader.Start()
 	go c.fetchMetadataFromRootPartitionOnInit(context.TODO())
+	if c.partitionScaler != nil {
+		c.partitionScaler.Start()
+	}
+	if c.buildIdScavenger != nil {
+		c.buildIdScavenger.Start()
+	}
 	c.logger.Info("", tag.LifeCycleStarted)
 	c.taggedMetricsHandler.Counter(metrics.TaskQueueStartedCounter.GetMetricName()).Record(1)
 }
@@ -320,6 +368,12 @@ func (c *taskQueueManagerImpl) Stop() {
 		c.taskGC.RunNow(ctx, ackLevel)
 	}
 	c.metadataPoller.Stop()
+	if c.partitionScaler != nil {
+		c.partitionScaler.Stop()
+	}
+	if c.buildIdScavenger != nil {
+		c.buildIdScavenger.Stop()
+	}
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
@@ -350,6 +404,7 @@ func (c *taskQueueManagerImpl) AddTask(
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive()
+		c.addedTaskCount.Add(1)
 	}
 
 	if c.QueueID().IsRoot() && !c.HasPollerAfter(time.Now().Add(-noPollerThreshold)) {
@@ -364,7 +419,8 @@ func (c *taskQueueManagerImpl) AddTask(
 		return false, err
 	}
 
-	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
+	// tasks which are not due yet always go to the backlog, and are never forwarded before they are due
+	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) && !isTaskDelayed(taskInfo, time.Now()) {
 		syncMatch, err := c.trySyncMatch(ctx, params)
 		if syncMatch {
 			return syncMatch, err
@@ -377,7 +433,7 @@ func (c *taskQueueManagerImpl) AddTask(
 		return false, errRemoteSyncMatchFailed
 	}
 
-	_, err = c.taskWriter.appendTask(params.execution, taskInfo)
+	err = c.appendTask(ctx, params.execution, taskInfo)
 	c.signalIfFatal(err)
 	if err == nil {
 		c.taskReader.Signal()
@@ -418,10 +474,15 @@ func (c *taskQueueManagerImpl) GetTask(
 
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		var metadata pollMetadata
+		if m, ok := ctx.Value(pollMetadataKey).(*pollMetadata); ok {
+			metadata = *m
+		}
+		metadata.ratePerSecond = maxDispatchPerSecond
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), &metadata)
 		defer func() {
 			// to update timestamp when long poll ends
-			c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+			c.pollerHistory.touchPollerInfo(pollerIdentity(identity))
 		}()
 	}
 
@@ -451,6 +512,11 @@ func (c *taskQueueManagerImpl) GetTask(
 	return task, nil
 }
 
+// CloseInflightActivityTask releases the slot held by a dispatched activity task in the inflight activity task limit
+func (c *taskQueueManagerImpl) CloseInflightActivityTask(key inflightTaskKey) bool {
+	return c.matcher.CloseInflightTask(key)
+}
+
 // DispatchTask dispatches a task to a poller. When there are no pollers to pick
 // up the task or if rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db
@@ -486,14 +552,21 @@ func (c *taskQueueManagerImpl) GetVersioningData(ctx context.Context) (*persiste
 }
 
 func (c *taskQueueManagerImpl) MutateVersioningData(ctx context.Context, mutator func(*persistencespb.VersioningData) error) error {
-	newDat, err := c.db.MutateVersioningData(ctx, mutator)
+	newDat, err := c.db.MutateVersioningData(ctx, func(data *persistencespb.VersioningData) error {
+		if err := mutator(data); err != nil {
+			return err
+		}
+		updateSupersededBuildIds(data, time.Now().UTC())
+		return nil
+	})
 	c.signalIfFatal(err)
 	if err != nil {
 		return err
 	}
+	c.taggedMetricsHandler.Gauge(metrics.BuildIdRampPercentageGauge.GetMetricName()).Record(float64(newDat.GetRamp().GetRampPercentage()))
 	// We will have errored already if this was not the root workflow partition.
 	// Now notify partitions that they should fetch changed data from us
-	numParts := util.Max(c.config.NumReadPartitions(), c.config.NumWritePartitions())
+	numParts := util.Max(util.Max(c.config.NumReadPartitions(), c.config.NumWritePartitions()), maxPartitionCount(c.db.GetPartitionConfig()))
 	wg := &sync.WaitGroup{}
 	for i := 0; i < numParts; i++ {
 		for _, tqt := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
@@ -522,6 +595,17 @@ func (c *taskQueueManagerImpl) MutateVersioningData(ctx context.Context, mutator
 	return nil
 }
 
+func (c *taskQueueManagerImpl) GetBuildIdRamp(ctx context.Context) (*persistencespb.BuildIdRamp, error) {
+	// the root workflow partition loads the versioning data with its lease, the other partitions have to fetch it
+	// from the root one, which may have failed so far
+	if !c.metadataFetched.Load() {
+		if _, err := c.fetchMetadataFromRootPartition(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.db.GetBuildIdRamp(), nil
+}
+
 func (c *taskQueueManagerImpl) InvalidateMetadata(request *matchingservice.InvalidateTaskQueueMetadataRequest) error {
 	if request.GetVersioningData() != nil {
 		if c.taskQueueID.IsRoot() && c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
@@ -535,6 +619,10 @@ func (c *taskQueueManagerImpl) InvalidateMetadata(request *matchingservice.Inval
 	return nil
 }
 
+func (c *taskQueueManagerImpl) GetPartitionConfig() *persistencespb.TaskQueuePartitionConfig {
+	return c.db.GetPartitionConfig()
+}
+
 // GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes
 func (c *taskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerInfo {
 	return c.pollerHistory.getPollerInfo(time.Time{})
@@ -552,6 +640,54 @@ func (c *taskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	return len(recentPollers) > 0
 }
 
+func (c *taskQueueManagerImpl) GetWorkers() []*taskqueuespb.WorkerInfo {
+	workers := c.pollerHistory.getWorkerInfo()
+	for _, worker := range workers {
+		worker.NamespaceId = c.taskQueueID.namespaceID.String()
+		worker.TaskQueue = c.taskQueueID.BaseNameString()
+		worker.TaskQueueType = c.taskQueueID.taskType
+	}
+	return workers
+}
+
+func (c *taskQueueManagerImpl) IsStickyWorkerPolling() bool {
+	return c.HasPollerAfter(time.Now().Add(-c.config.StickyPollerUnavailableWindow()))
+}
+
+func (c *taskQueueManagerImpl) IsStickyWorkerSaturated() bool {
+	maxBacklog := c.config.StickyMaxBacklog()
+	return maxBacklog > 0 && c.taskAckManager.getBacklogCountHint() >= int64(maxBacklog)
+}
+
+// redirectStickyTask adds a backlog task of this sticky task queue to the normal task queue of its workflow. History
+// accepts the task from a poller of the normal task queue and clears the stickiness of the workflow.
+func (c *taskQueueManagerImpl) redirectStickyTask(ctx context.Context, taskInfo *persistencespb.TaskInfo) error {
+	var scheduleToStartTimeout *time.Duration
+	if expiryTime := taskInfo.GetExpiryTime(); expiryTime != nil {
+		scheduleToStartTimeout = timestamp.DurationPtr(time.Until(*expiryTime))
+	}
+	_, err := c.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId: taskInfo.GetNamespaceId(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: taskInfo.GetWorkflowId(),
+			RunId:      taskInfo.GetRunId(),
+		},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: taskInfo.GetNormalTaskQueue(),
+			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+		},
+		ScheduledEventId:       taskInfo.GetScheduledEventId(),
+		ScheduleToStartTimeout: scheduleToStartTimeout,
+		Source:                 enumsspb.TASK_SOURCE_DB_BACKLOG,
+		Clock:                  taskInfo.GetClock(),
+		Priority:               taskInfo.GetPriority(),
+		FairnessKey:            taskInfo.GetFairnessKey(),
+		WorkflowTypeName:       taskInfo.GetWorkflowTypeName(),
+		BuildIdAssignment:      taskInfo.GetBuildIdAssignment(),
+	})
+	return err
+}
+
 func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
 	cancel, ok := c.outstandingPollsMap[pollerID]
@@ -566,7 +702,10 @@ func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 // pollers which polled this taskqueue in last few minutes and status of taskqueue's ackManager
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
-	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
+	response := &matchingservice.DescribeTaskQueueResponse{
+		Pollers:        c.GetAllPollerInfo(),
+		AddedTaskCount: c.addedTaskCount.Load(),
+	}
 	if !includeTaskQueueStatus {
 		return response
 	}
@@ -586,6 +725,127 @@ func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *m
 	return response
 }
 
+// DescribeFairnessKeyBacklogs returns the fairness keys with the largest persisted backlog, or nil if task fairness
+// is disabled. At most MaxBacklogScanTasks tasks are counted.
+func (c *taskQueueManagerImpl) DescribeFairnessKeyBacklogs(ctx context.Context) ([]*matchingservice.FairnessKeyBacklog, error) {
+	if !c.config.EnableTaskFairness() {
+		return nil, nil
+	}
+	counts, err := c.taskReader.countFairnessKeyBacklogs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return topFairnessKeyBacklogs(counts, c.config.FairnessTopKeysCount()), nil
+}
+
+func (c *taskQueueManagerImpl) DescribeBacklog(ctx context.Context) (*taskqueuespb.BacklogSummary, error) {
+	summary := newBacklogSummary()
+	now := time.Now().UTC()
+	truncated, err := c.taskReader.scanBacklog(ctx, func(subqueue *prioritySubqueue, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		addBacklogTask(summary, task, now)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	summary.Truncated = truncated
+	return summary, nil
+}
+
+func (c *taskQueueManagerImpl) MoveBacklogTasks(
+	ctx context.Context,
+	filter *taskqueuespb.BacklogTaskFilter,
+	destination string,
+	maxTasks int,
+) (int64, error) {
+	var moved int64
+	_, err := c.taskReader.scanBacklog(ctx, func(subqueue *prioritySubqueue, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		if !matchesBacklogFilter(filter, task.Data) {
+			return true, nil
+		}
+		// the task is added to the destination before it is removed here, so it is dispatched at least once
+		if err := c.addTaskToTaskQueue(ctx, task.Data, destination); err != nil {
+			return false, err
+		}
+		if err := c.taskReader.removeBacklogTask(ctx, subqueue, task.GetTaskId()); err != nil {
+			return false, err
+		}
+		moved++
+		return moved < int64(maxTasks), nil
+	})
+	return moved, err
+}
+
+func (c *taskQueueManagerImpl) PurgeBacklogTasks(
+	ctx context.Context,
+	filter *taskqueuespb.BacklogTaskFilter,
+	maxTasks int,
+) (int64, error) {
+	var purged int64
+	_, err := c.taskReader.scanBacklog(ctx, func(subqueue *prioritySubqueue, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		if !matchesBacklogFilter(filter, task.Data) {
+			return true, nil
+		}
+		if err := c.taskReader.removeBacklogTask(ctx, subqueue, task.GetTaskId()); err != nil {
+			return false, err
+		}
+		purged++
+		return purged < int64(maxTasks), nil
+	})
+	return purged, err
+}
+
+// addTaskToTaskQueue adds a backlog task to another task queue of the same namespace and type, the same way
+// history adds new tasks.
+func (c *taskQueueManagerImpl) addTaskToTaskQueue(ctx context.Context, task *persistencespb.TaskInfo, taskQueue string) error {
+	var expirationDuration time.Duration
+	if expirationTime := timestamp.TimeValue(task.GetExpiryTime()); !expirationTime.IsZero() {
+		expirationDuration = time.Until(expirationTime)
+		if notBefore := timestamp.TimeValue(task.GetNotBefore()); notBefore.After(time.Now()) {
+			// the destination starts the schedule to start timeout at the not-before time
+			expirationDuration = expirationTime.Sub(notBefore)
+		}
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: task.GetWorkflowId(), RunId: task.GetRunId()}
+	destination := &taskqueuepb.TaskQueue{Name: taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	var err error
+	switch c.taskQueueID.taskType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = c.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            task.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       task.GetScheduledEventId(),
+			Clock:                  task.GetClock(),
+			Source:                 enumsspb.TASK_SOURCE_DB_BACKLOG,
+			ScheduleToStartTimeout: &expirationDuration,
+			Priority:               task.GetPriority(),
+			FairnessKey:            task.GetFairnessKey(),
+			WorkflowTypeName:       task.GetWorkflowTypeName(),
+			NotBefore:              task.GetNotBefore(),
+			BuildIdAssignment:      task.GetBuildIdAssignment(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err = c.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            task.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       task.GetScheduledEventId(),
+			Clock:                  task.GetClock(),
+			Source:                 enumsspb.TASK_SOURCE_DB_BACKLOG,
+			ScheduleToStartTimeout: &expirationDuration,
+			Priority:               task.GetPriority(),
+			FairnessKey:            task.GetFairnessKey(),
+			WorkflowTypeName:       task.GetWorkflowTypeName(),
+			NotBefore:              task.GetNotBefore(),
+		})
+	default:
+		err = errInvalidTaskQueueType
+	}
+	return err
+}
+
 func (c *taskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -608,33 +868,11 @@ func (c *taskQueueManagerImpl) String() string {
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
 func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskInfo, err error) {
-	if err != nil {
-		// failed to start the task.
-		// We cannot just remove it from persistence because then it will be lost.
-		// We handle this by writing the task back to persistence with a higher taskID.
-		// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
-		// again the underlying reason for failing to start will be resolved.
-		// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
-		// re-written to persistence frequently.
-		err = executeWithRetry(context.Background(), func(_ context.Context) error {
-			wf := &commonpb.WorkflowExecution{WorkflowId: task.Data.GetWorkflowId(), RunId: task.Data.GetRunId()}
-			_, err := c.taskWriter.appendTask(wf, task.Data)
-			return err
-		})
-
-		if err != nil {
-			// OK, we also failed to write to persistence.
-			// This should only happen in very extreme cases where persistence is completely down.
-			// We still can't lose the old task so we just unload the entire task queue
-			c.logger.Error("Persistent store operation failure",
-				tag.StoreOperationStopTaskQueue,
-				tag.Error(err),
-				tag.WorkflowTaskQueueName(c.taskQueueID.FullName()),
-				tag.WorkflowTaskQueueType(c.taskQueueID.taskType))
-			c.unloadFromEngine()
+	// tasks moved or purged from the backlog are already deleted and must not be written back
+	if err != nil && !c.taskAckManager.isTaskRemoved(task.GetTaskId()) {
+		if !c.writeBackTask(task) {
 			return
 		}
-		c.taskReader.Signal()
 	}
 
 	ackLevel := c.taskAckManager.completeTask(task.GetTaskId())
@@ -645,6 +883,87 @@ func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskIn
 	c.taskGC.Run(ctx, ackLevel)
 }
 
+// completeDelayedTask marks a parked task, which was dispatched once due, as processed. Parked tasks are acked in
+// the backlog already, so they are only deleted from the task queue of the parked tasks. If the task is not deleted
+// it is dispatched again once the task queue is reloaded.
+func (c *taskQueueManagerImpl) completeDelayedTask(task *persistencespb.AllocatedTaskInfo, err error) {
+	if err != nil && !c.writeBackTask(task) {
+		return
+	}
+
+	ctx, cancel := c.newIOContext()
+	defer cancel()
+	_ = c.taskReader.delayedTasks.complete(ctx, task.GetTaskId())
+}
+
+// completePriorityTask marks a task of a priority subqueue, which was dispatched, as processed. It is deleted from
+// its subqueue, and is written back first if it failed to start. If the task is not deleted it is dispatched again
+// once the task queue is reloaded.
+func (c *taskQueueManagerImpl) completePriorityTask(subqueue *prioritySubqueue, task *persistencespb.AllocatedTaskInfo, err error) {
+	if err != nil && !c.writeBackTask(task) {
+		return
+	}
+
+	ctx, cancel := c.newIOContext()
+	defer cancel()
+	_ = subqueue.complete(ctx, task.GetTaskId())
+}
+
+// appendTask persists a task which is not dispatched right away to the subqueue of its priority, or to the backlog.
+func (c *taskQueueManagerImpl) appendTask(
+	ctx context.Context,
+	execution *commonpb.WorkflowExecution,
+	taskInfo *persistencespb.TaskInfo,
+) error {
+	subqueue := c.taskReader.prioritySubqueue(taskInfo)
+	if subqueue == nil {
+		_, err := c.taskWriter.appendTask(execution, taskInfo)
+		return err
+	}
+	// the subqueues are taken over once the task queue is initialized
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+	if err := subqueue.add(ctx, taskInfo); err != nil {
+		return err
+	}
+	c.taskReader.signalPrioritySubqueue()
+	return nil
+}
+
+// writeBackTask writes a task which failed to start back to the backlog. It returns false if the task could not be
+// written, in which case the task queue is unloaded.
+func (c *taskQueueManagerImpl) writeBackTask(task *persistencespb.AllocatedTaskInfo) bool {
+	// failed to start the task.
+	// We cannot just remove it from persistence because then it will be lost.
+	// We handle this by writing the task back to persistence with a higher taskID.
+	// This will allow subsequent tasks to make progress, and hopefully by the time this task is picked-up
+	// again the underlying reason for failing to start will be resolved.
+	// Note that RecordTaskStarted only fails after retrying for a long time, so a single task will not be
+	// re-written to persistence frequently.
+	err := executeWithRetry(context.Background(), func(_ context.Context) error {
+		ctx, cancel := c.newIOContext()
+		defer cancel()
+		wf := &commonpb.WorkflowExecution{WorkflowId: task.Data.GetWorkflowId(), RunId: task.Data.GetRunId()}
+		return c.appendTask(ctx, wf, task.Data)
+	})
+
+	if err != nil {
+		// OK, we also failed to write to persistence.
+		// This should only happen in very extreme cases where persistence is completely down.
+		// We still can't lose the old task so we just unload the entire task queue
+		c.logger.Error("Persistent store operation failure",
+			tag.StoreOperationStopTaskQueue,
+			tag.Error(err),
+			tag.WorkflowTaskQueueName(c.taskQueueID.FullName()),
+			tag.WorkflowTaskQueueType(c.taskQueueID.taskType))
+		c.unloadFromEngine()
+		return false
+	}
+	c.taskReader.Signal()
+	return true
+}
+
 func rangeIDToTaskIDBlock(rangeID int64, rangeSize int64) taskIDBlock {
 	return taskIDBlock{
 		start: (rangeID-1)*rangeSize + 1,
@@ -669,6 +988,12 @@ func executeWithRetry(
 }
 
 func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+	if c.taskReader.useDispatchBuffer() &&
+		(c.taskReader.hasTaskBefore(params.taskInfo) || c.taskReader.hasPriorityTaskBefore(params.taskInfo)) {
+		// a backlog task is due before this one, don't let the new task jump ahead of it
+		return false, nil
+	}
+
 	childCtx, cancel := c.newChildContext(ctx, c.config.SyncMatchWaitDuration(), time.Second)
 
 	// Mocking out TaskId for syncmatch as it hasn't been allocated yet
@@ -745,6 +1070,7 @@ func (c *taskQueueManagerImpl) fetchMetadataFromRootPartition(ctx context.Contex
 	// Nothing to do if we are the root partition of a workflow queue, since we should own the data.
 	// (for versioning - any later added metadata may need to not abort so early)
 	if c.taskQueueID.IsRoot() && c.taskQueueID.taskType == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		c.metadataFetched.Store(true)
 		return nil, nil
 	}
 
@@ -778,6 +1104,7 @@ func (c *taskQueueManagerImpl) fetchMetadataFromRootPartition(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
+	c.metadataFetched.Store(true)
 	return res.GetVersioningData(), nil
 }
 
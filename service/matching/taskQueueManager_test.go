@@ -42,12 +42,14 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"google.golang.org/grpc/metadata"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
@@ -209,7 +211,7 @@ func TestSyncMatchLeasingUnavailable(t *testing.T) {
 }
 
 func TestForeignPartitionOwnerCausesUnload(t *testing.T) {
-	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	cfg.RangeSize = 1 // TaskID block size
 	var leaseErr error = nil
 	tqm := mustCreateTestTaskQueueManager(t, gomock.NewController(t),
@@ -342,7 +344,7 @@ func createTestTaskQueueManagerWithConfig(
 	mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(&namespace.Namespace{}, nil).AnyTimes()
 	mockNamespaceCache.EXPECT().GetNamespaceName(gomock.Any()).Return(namespace.Name("ns-name"), nil).AnyTimes()
 	cmeta := cluster.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true))
-	me := newMatchingEngine(testOpts.config, tm, nil, logger, mockNamespaceCache, testOpts.matchingClientMock)
+	me := newMatchingEngine(testOpts.config, tm, nil, logger, mockNamespaceCache, testOpts.matchingClientMock, nil)
 	tlKind := enumspb.TASK_QUEUE_KIND_NORMAL
 	tlMgr, err := newTaskQueueManager(me, testOpts.tqId, tlKind, testOpts.config, cmeta, opts...)
 	if err != nil {
@@ -386,7 +388,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndId())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &pollMetadata{})
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.completeTask(startTaskID + i)
 	}
@@ -397,7 +399,7 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.NotEmpty(t, descResp.Pollers[0].GetLastAccessTime())
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &pollMetadata{ratePerSecond: &rps})
 	descResp = tlm.DescribeTaskQueue(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
@@ -409,11 +411,69 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
 }
 
+func TestGetWorkers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	rps := 5.0
+	metadata := &pollMetadata{
+		ratePerSecond:             &rps,
+		sdkName:                   "temporal-go",
+		sdkVersion:                "1.22.0",
+		workerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: "build1"},
+		taskSlotsAvailable:        3,
+		taskSlotsUsed:             7,
+	}
+	tlm.pollerHistory.updatePollerInfo("worker1", metadata)
+	tlm.pollerHistory.touchPollerInfo("worker1")
+	tlm.pollerHistory.updatePollerInfo("worker1", metadata)
+	// forwarded polls are counted by the child partition, which the worker polled
+	forwarded := *metadata
+	forwarded.forwarded = true
+	forwarded.taskSlotsAvailable = 0
+	tlm.pollerHistory.updatePollerInfo("worker1", &forwarded)
+	tlm.pollerHistory.updatePollerInfo("worker2", &forwarded)
+
+	workers := tlm.GetWorkers()
+	require.Len(t, workers, 1)
+	worker := workers[0]
+	require.Equal(t, "worker1", worker.GetIdentity())
+	require.Equal(t, tlm.taskQueueID.namespaceID.String(), worker.GetNamespaceId())
+	require.Equal(t, tlm.taskQueueID.BaseNameString(), worker.GetTaskQueue())
+	require.Equal(t, tlm.taskQueueID.taskType, worker.GetTaskQueueType())
+	require.Equal(t, "temporal-go", worker.GetSdkName())
+	require.Equal(t, "1.22.0", worker.GetSdkVersion())
+	require.Equal(t, "build1", worker.GetBuildId())
+	require.Equal(t, int64(2), worker.GetPollCount())
+	require.Equal(t, rps, worker.GetMaxTasksPerSecond())
+	require.Equal(t, 2.0, worker.GetPollRate())
+	require.Equal(t, int32(3), worker.GetTaskSlotsAvailable())
+	require.Equal(t, int32(7), worker.GetTaskSlotsUsed())
+	require.False(t, worker.GetLastPollTime().Before(*worker.GetFirstPollTime()))
+
+	pollers := tlm.GetAllPollerInfo()
+	require.Len(t, pollers, 2)
+	require.Equal(t, "build1", pollers[0].GetWorkerVersionCapabilities().GetBuildId())
+}
+
+func TestNewPollMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headers.WorkerTaskSlotsAvailableHeaderName, "4",
+		headers.WorkerTaskSlotsUsedHeaderName, "invalid",
+	))
+	m := newPollMetadata(ctx, nil, "")
+	require.Equal(t, int32(4), m.taskSlotsAvailable)
+	require.Zero(t, m.taskSlotsUsed)
+	require.False(t, m.forwarded)
+	require.True(t, newPollMetadata(ctx, nil, "/_sys/tq/1").forwarded)
+}
+
 func TestCheckIdleTaskQueue(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
-	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	cfg.MaxTaskQueueIdleTime = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(2 * time.Second)
 	tqCfg := defaultTqmTestOpts(controller)
 	tqCfg.config = cfg
@@ -427,7 +487,7 @@ func TestCheckIdleTaskQueue(t *testing.T) {
 	// Active poll-er
 	tlm = mustCreateTestTaskQueueManagerWithConfig(t, controller, tqCfg)
 	tlm.Start()
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), &pollMetadata{})
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	time.Sleep(1 * time.Second)
 	require.Equal(t, common.DaemonStatusStarted, atomic.LoadInt32(&tlm.status))
@@ -531,6 +591,42 @@ func TestTaskQueueSubParitionFetchesVersioningInfoFromRootPartitionOnInit(t *tes
 	subTq.Stop()
 }
 
+func TestTaskQueueSubParitionFetchesBuildIdRampAfterFailedInitialFetch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	ctx := context.Background()
+	subTqId, err := newTaskQueueIDWithPartition(defaultNamespaceId, defaultRootTqID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 1)
+	require.NoError(t, err)
+	tqCfg := defaultTqmTestOpts(controller)
+	tqCfg.tqId = subTqId
+
+	ramp := &persistencespb.BuildIdRamp{BuildId: "v2", RampPercentage: 10}
+	subTq := mustCreateTestTaskQueueManagerWithConfig(t, controller, tqCfg,
+		func(tqm *taskQueueManagerImpl) {
+			mockMatchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+			gomock.InOrder(
+				mockMatchingClient.EXPECT().GetTaskQueueMetadata(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("root partition unavailable")),
+				mockMatchingClient.EXPECT().GetTaskQueueMetadata(gomock.Any(), gomock.Any()).
+					Return(&matchingservice.GetTaskQueueMetadataResponse{
+						VersioningDataResp: &matchingservice.GetTaskQueueMetadataResponse_VersioningData{
+							VersioningData: &persistencespb.VersioningData{Ramp: ramp},
+						},
+					}, nil),
+			)
+			tqm.matchingClient = mockMatchingClient
+		})
+	subTq.Start()
+	require.NoError(t, subTq.WaitUntilInitialized(ctx))
+	// the ramp is fetched once the root partition is available, and then read from the cached data
+	for i := 0; i < 2; i++ {
+		fetched, err := subTq.GetBuildIdRamp(ctx)
+		require.NoError(t, err)
+		require.Equal(t, ramp, fetched)
+	}
+	subTq.Stop()
+}
+
 func TestTaskQueueSubParitionSendsCurrentHashOfVersioningDataWhenFetching(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -26,10 +26,13 @@ package matching
 
 import (
 	"context"
+	"hash/fnv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -45,6 +48,10 @@ import (
 const (
 	taskReaderOfferThrottleWait  = time.Second
 	taskReaderThrottleRetryDelay = 3 * time.Second
+	// how often a sticky backlog task waiting for its worker checks whether the worker is unavailable or saturated
+	taskReaderStickyWorkerCheckInterval = time.Second
+	// number of buckets fairness keys are hashed to in the fairness key backlog metric
+	fairnessKeyMetricBuckets = 16
 )
 
 type (
@@ -52,8 +59,18 @@ type (
 		status     int32
 		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
 		notifyC    chan struct{}                          // Used as signal to notify pump of new tasks
-		tlMgr      *taskQueueManagerImpl
-		gorogrp    goro.Group
+		// tasks moved out of taskBuffer to be dispatched by priority and fairness key, only used if
+		// task priority or fairness is enabled
+		dispatchBuffer taskDispatchBuffer
+		// tasks read before their not-before time which are parked until due
+		delayedTasks *delayedTasks
+		// backlog of the tasks of each priority other than the default priority, indexed by priority - 1,
+		// not used by sticky task queues
+		prioritySubqueues []*prioritySubqueue
+		// signals the dispatch loop once a task is added to a priority subqueue
+		prioritySubqueueC chan struct{}
+		tlMgr             *taskQueueManagerImpl
+		gorogrp           goro.Group
 
 		backoffTimerLock sync.Mutex
 		backoffTimer     *time.Timer
@@ -62,13 +79,28 @@ type (
 )
 
 func newTaskReader(tlMgr *taskQueueManagerImpl) *taskReader {
+	var prioritySubqueues []*prioritySubqueue
+	if tlMgr.db.taskQueueKind != enumspb.TASK_QUEUE_KIND_STICKY {
+		for priority := 1; priority <= common.MaxTaskPriority; priority++ {
+			prioritySubqueues = append(prioritySubqueues, newPrioritySubqueue(
+				newPriorityTaskQueueDB(tlMgr.db.store, tlMgr.db.namespaceID, tlMgr.db.taskQueue, tlMgr.db.taskQueueKind, priority, tlMgr.db.logger),
+				tlMgr.config.RangeSize,
+			))
+		}
+	}
 	return &taskReader{
-		status:  common.DaemonStatusInitialized,
-		tlMgr:   tlMgr,
-		notifyC: make(chan struct{}, 1),
+		status:            common.DaemonStatusInitialized,
+		tlMgr:             tlMgr,
+		notifyC:           make(chan struct{}, 1),
+		prioritySubqueues: prioritySubqueues,
+		prioritySubqueueC: make(chan struct{}, 1),
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffer: make(chan *persistencespb.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		delayedTasks: newDelayedTasks(
+			newDelayedTaskQueueDB(tlMgr.db.store, tlMgr.db.namespaceID, tlMgr.db.taskQueue, tlMgr.db.taskQueueKind, tlMgr.db.logger),
+			tlMgr.config.RangeSize,
+		),
 		retrier: backoff.NewRetrier(
 			common.CreateReadTaskRetryPolicy(),
 			backoff.SystemClock,
@@ -89,6 +121,7 @@ func (tr *taskReader) Start() {
 
 	tr.gorogrp.Go(tr.dispatchBufferedTasks)
 	tr.gorogrp.Go(tr.getTasksPump)
+	tr.gorogrp.Go(tr.fairnessKeyBacklogPump)
 }
 
 // Stop pump that fills up taskBuffer from persistence.
@@ -114,45 +147,322 @@ func (tr *taskReader) Signal() {
 
 func (tr *taskReader) dispatchBufferedTasks(ctx context.Context) error {
 	ctx = tr.initContext(ctx)
+	defer tr.delayedTasks.stop()
 
+	// a delayed task which is held because the max number of delayed tasks are parked already
+	var heldTask *persistencespb.AllocatedTaskInfo
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task queue getTasks pump is shutdown
-				break dispatchLoop
+		if taskInfo, ok := tr.delayedTasks.popDue(time.Now()); ok {
+			task := newInternalTask(taskInfo, tr.tlMgr.completeDelayedTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+			if err := tr.dispatchTask(ctx, task, false); err != nil {
+				return err
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
-			for {
-				// We checked if the task was expired before putting it in the buffer, but it
-				// might have expired while it sat in the buffer, so we should check again.
-				if taskqueue.IsTaskExpired(taskInfo) {
-					task.finish(nil)
-					tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
-					// Don't try to set read level here because it may have been advanced already.
-					break
+			continue dispatchLoop
+		}
+
+		if heldTask == nil {
+			subqueue, taskInfo, err := tr.nextPriorityTask(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				tr.logger().Error("taskReader: failed to read priority subqueue", tag.Error(err))
+				select {
+				case <-time.After(taskReaderThrottleRetryDelay):
+				case <-ctx.Done():
+					return nil
 				}
-				err := tr.tlMgr.DispatchTask(ctx, task)
-				if err == nil {
-					break
+				continue dispatchLoop
+			}
+			if subqueue != nil {
+				completionFunc := func(task *persistencespb.AllocatedTaskInfo, err error) {
+					tr.tlMgr.completePriorityTask(subqueue, task, err)
 				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+				task := newInternalTask(taskInfo, completionFunc, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+				if err := tr.dispatchTask(ctx, task, false); err != nil {
 					return err
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				time.Sleep(taskReaderOfferThrottleWait)
+				continue dispatchLoop
 			}
+		}
 
-		case <-ctx.Done():
+		var taskInfo *persistencespb.AllocatedTaskInfo
+		if heldTask != nil {
+			if tr.delayedTasks.waitingLen() >= tr.tlMgr.config.MaxDelayedTasks() {
+				select {
+				case <-tr.delayedTasks.timerC():
+				case <-ctx.Done():
+					return nil
+				}
+				continue dispatchLoop
+			}
+			taskInfo, heldTask = heldTask, nil
+		} else if tr.dispatchBuffer.len() > 0 {
+			taskInfo = tr.nextBufferedTask()
+		} else {
+			select {
+			case bufferedTask, ok := <-tr.taskBuffer:
+				if !ok { // Task queue getTasks pump is shutdown
+					break dispatchLoop
+				}
+				taskInfo = bufferedTask
+				if tr.useDispatchBuffer() {
+					tr.bufferTask(taskInfo)
+					taskInfo = tr.nextBufferedTask()
+				}
+			case <-tr.delayedTasks.timerC():
+				continue dispatchLoop
+			case <-tr.delayedTasks.loadedC:
+				continue dispatchLoop
+			case <-tr.prioritySubqueueC:
+				continue dispatchLoop
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		task := newInternalTask(taskInfo, tr.tlMgr.completeTask, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+		if now := time.Now(); isTaskDelayed(taskInfo.Data, now) && !tr.tlMgr.taskAckManager.isTaskRemoved(taskInfo.GetTaskId()) {
+			if tr.delayedTasks.waitingLen() >= tr.tlMgr.config.MaxDelayedTasks() {
+				// the task stays outstanding, which holds back the ack level, until a parked task is due
+				heldTask = taskInfo
+				continue dispatchLoop
+			}
+			// the task is acked once it is parked, so that it holds back neither the ack level nor the task GC
+			if err := tr.delayedTasks.park(ctx, taskInfo.Data, now); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if tr.tlMgr.signalIfFatal(err) {
+					return nil
+				}
+				tr.logger().Error("taskReader: failed to park delayed task", tag.TaskID(taskInfo.GetTaskId()), tag.Error(err))
+				heldTask = taskInfo
+				select {
+				case <-time.After(taskReaderThrottleRetryDelay):
+				case <-ctx.Done():
+					return nil
+				}
+				continue dispatchLoop
+			}
+			task.finish(nil)
+			continue dispatchLoop
+		}
+		if err := tr.dispatchTask(ctx, task, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dispatchTask dispatches a task read from the backlog, or a parked task or a task of a priority subqueue if
+// fromBacklog is false. Those have IDs of their own task queue, so they are not checked for removal from the backlog.
+func (tr *taskReader) dispatchTask(ctx context.Context, task *internalTask, fromBacklog bool) error {
+	taskInfo := task.event.AllocatedTaskInfo
+	for {
+		if fromBacklog && tr.tlMgr.taskAckManager.isTaskRemoved(taskInfo.GetTaskId()) {
+			// the task was moved or purged from the backlog while it sat in the buffer
+			task.finish(nil)
+			return nil
+		}
+		// We checked if the task was expired before putting it in the buffer, but it
+		// might have expired while it sat in the buffer, so we should check again.
+		if taskqueue.IsTaskExpired(taskInfo) {
+			task.finish(nil)
+			tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
+			// Don't try to set read level here because it may have been advanced already.
 			return nil
 		}
+		var err error
+		if tr.tlMgr.TaskQueueKind() == enumspb.TASK_QUEUE_KIND_STICKY && taskInfo.Data.GetNormalTaskQueue() != "" {
+			err = tr.dispatchStickyTask(ctx, task)
+		} else {
+			err = tr.tlMgr.DispatchTask(ctx, task)
+		}
+		if err == nil {
+			return nil
+		}
+		if err == context.Canceled {
+			tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
+			return err
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.taggedMetricsHandler().Counter(metrics.BufferThrottlePerTaskQueueCounter.GetMetricName()).Record(1)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		time.Sleep(taskReaderOfferThrottleWait)
+	}
+}
+
+// dispatchStickyTask dispatches a backlog task of a sticky task queue to its worker. While the worker is not polling or
+// is saturated, the task is moved to the normal task queue of the workflow instead, where any worker can pick it up.
+func (tr *taskReader) dispatchStickyTask(ctx context.Context, task *internalTask) error {
+	for {
+		if !tr.tlMgr.IsStickyWorkerPolling() || tr.tlMgr.IsStickyWorkerSaturated() {
+			err := tr.tlMgr.redirectStickyTask(ctx, task.event.Data)
+			if err == nil {
+				task.finish(nil)
+				tr.taggedMetricsHandler().Counter(metrics.StickyBacklogRedirectedCounter.GetMetricName()).Record(1)
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			tr.logger().Warn("taskReader: failed to move sticky task to normal task queue", tag.Error(err))
+		}
+		dispatchCtx, cancel := context.WithTimeout(ctx, taskReaderStickyWorkerCheckInterval)
+		err := tr.tlMgr.DispatchTask(dispatchCtx, task)
+		cancel()
+		if err != context.DeadlineExceeded || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// nextBufferedTask moves the tasks waiting in taskBuffer to the dispatch buffer, up to the
+// capacity of taskBuffer, and returns the task to dispatch next.
+func (tr *taskReader) nextBufferedTask() *persistencespb.AllocatedTaskInfo {
+	tr.fillDispatchBuffer()
+	return tr.dispatchBuffer.pop()
+}
+
+// fillDispatchBuffer moves the tasks waiting in taskBuffer to the dispatch buffer, up to the capacity of taskBuffer.
+func (tr *taskReader) fillDispatchBuffer() {
+fillLoop:
+	for tr.dispatchBuffer.len() <= cap(tr.taskBuffer) {
+		select {
+		case taskInfo, ok := <-tr.taskBuffer:
+			if !ok {
+				break fillLoop
+			}
+			tr.bufferTask(taskInfo)
+		default:
+			break fillLoop
+		}
+	}
+}
+
+func (tr *taskReader) useDispatchBuffer() bool {
+	return tr.tlMgr.config.EnableTaskPriority() || tr.tlMgr.config.EnableTaskFairness()
+}
+
+func (tr *taskReader) bufferTask(taskInfo *persistencespb.AllocatedTaskInfo) {
+	fairnessKey := tr.fairnessKey(taskInfo.Data)
+	tr.dispatchBuffer.push(taskInfo, fairnessKey, tr.fairnessKeyWeight(fairnessKey), tr.dispatchKey(taskInfo.Data))
+}
+
+// hasTaskBefore returns true if a buffered backlog task should be dispatched before the given task.
+func (tr *taskReader) hasTaskBefore(taskInfo *persistencespb.TaskInfo) bool {
+	return tr.dispatchBuffer.hasTaskBefore(tr.fairnessKey(taskInfo), tr.dispatchKey(taskInfo))
+}
+
+// hasPriorityTaskBefore returns true if a task read from a priority subqueue should be dispatched before the given
+// task.
+func (tr *taskReader) hasPriorityTaskBefore(taskInfo *persistencespb.TaskInfo) bool {
+	if !tr.tlMgr.config.EnableTaskPriority() {
+		return false
+	}
+	key := taskDispatchKey(taskInfo, tr.tlMgr.config)
+	for _, subqueue := range tr.prioritySubqueues {
+		if head := subqueue.head(); head != nil && !taskDispatchKey(head.Data, tr.tlMgr.config).After(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// prioritySubqueue returns the subqueue a task which is not dispatched right away is persisted to, or nil if it is
+// persisted to the backlog. Tasks which are not due yet are persisted to the backlog, where they are parked.
+func (tr *taskReader) prioritySubqueue(taskInfo *persistencespb.TaskInfo) *prioritySubqueue {
+	if len(tr.prioritySubqueues) == 0 || !tr.tlMgr.config.EnableTaskPriority() || isTaskDelayed(taskInfo, time.Now()) {
+		return nil
+	}
+	priority := taskPriorityLevel(taskInfo, tr.tlMgr.config)
+	if priority == taskPriorityLevel(&persistencespb.TaskInfo{}, tr.tlMgr.config) {
+		return nil
+	}
+	return tr.prioritySubqueues[priority-1]
+}
+
+// loadPrioritySubqueues takes over the priority subqueues persisted by the previous owners.
+func (tr *taskReader) loadPrioritySubqueues(ctx context.Context) error {
+	for _, subqueue := range tr.prioritySubqueues {
+		if err := subqueue.load(ctx); err != nil {
+			return err
+		}
 	}
+	tr.signalPrioritySubqueue()
 	return nil
 }
 
+func (tr *taskReader) signalPrioritySubqueue() {
+	select {
+	case tr.prioritySubqueueC <- struct{}{}:
+	default:
+	}
+}
+
+// nextPriorityTask returns the first task of the priority subqueues with the lowest dispatch key, if it is dispatched
+// before the buffered backlog tasks. The task is removed from its subqueue. Priority subqueues are read even while
+// task priority is disabled, so that they are drained.
+func (tr *taskReader) nextPriorityTask(ctx context.Context) (*prioritySubqueue, *persistencespb.AllocatedTaskInfo, error) {
+	var next *prioritySubqueue
+	var nextTask *persistencespb.AllocatedTaskInfo
+	var nextKey time.Time
+	for _, subqueue := range tr.prioritySubqueues {
+		task, err := subqueue.peek(ctx, tr.tlMgr.config.GetTasksBatchSize())
+		if err != nil {
+			return nil, nil, err
+		}
+		if task == nil {
+			continue
+		}
+		if key := taskDispatchKey(task.Data, tr.tlMgr.config); next == nil || key.Before(nextKey) {
+			next, nextTask, nextKey = subqueue, task, key
+		}
+	}
+	if next == nil {
+		return nil, nil, nil
+	}
+	if tr.useDispatchBuffer() {
+		tr.fillDispatchBuffer()
+		if tr.hasTaskBefore(nextTask.Data) {
+			return nil, nil, nil
+		}
+	}
+	next.pop()
+	return next, nextTask, nil
+}
+
+// dispatchKey returns the key tasks of the same fairness key are dispatched by. Without task priority all
+// tasks have the same key and are dispatched by task ID.
+func (tr *taskReader) dispatchKey(taskInfo *persistencespb.TaskInfo) time.Time {
+	if !tr.tlMgr.config.EnableTaskPriority() {
+		return time.Time{}
+	}
+	return taskDispatchKey(taskInfo, tr.tlMgr.config)
+}
+
+func (tr *taskReader) fairnessKey(taskInfo *persistencespb.TaskInfo) string {
+	if !tr.tlMgr.config.EnableTaskFairness() {
+		return ""
+	}
+	return taskInfo.GetFairnessKey()
+}
+
+func (tr *taskReader) fairnessKeyWeight(fairnessKey string) int {
+	switch weight := tr.tlMgr.config.FairnessKeyWeights()[fairnessKey].(type) {
+	case int:
+		return weight
+	case int64:
+		return int(weight)
+	case float64:
+		return int(weight)
+	default:
+		return 1
+	}
+}
+
 func (tr *taskReader) getTasksPump(ctx context.Context) error {
 	ctx = tr.initContext(ctx)
 
@@ -291,6 +601,84 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	}
 }
 
+// scanBacklog calls fn with the persisted backlog tasks, skipping expired tasks, until fn returns false or the whole
+// backlog is scanned. The backlog of the default priority is scanned first, then the subqueues of the other
+// priorities, each in task id order. subqueue is nil for the tasks of the backlog of the default priority. At most
+// MaxBacklogScanTasks tasks are scanned, truncated is true if the scan stopped at that limit.
+func (tr *taskReader) scanBacklog(
+	ctx context.Context,
+	fn func(subqueue *prioritySubqueue, task *persistencespb.AllocatedTaskInfo) (bool, error),
+) (truncated bool, err error) {
+	if err := tr.tlMgr.WaitUntilInitialized(ctx); err != nil {
+		return false, err
+	}
+	scanned := 0
+	done, truncated, err := tr.scanTasks(ctx, tr.tlMgr.db, tr.tlMgr.taskAckManager.getAckLevel()+1, tr.tlMgr.taskWriter.GetMaxReadLevel(), &scanned,
+		func(task *persistencespb.AllocatedTaskInfo) (bool, error) {
+			return fn(nil, task)
+		})
+	for _, subqueue := range tr.prioritySubqueues {
+		if done || err != nil {
+			break
+		}
+		subqueue := subqueue
+		done, truncated, err = tr.scanTasks(ctx, subqueue.db, 0, subqueue.maxTaskID(), &scanned,
+			func(task *persistencespb.AllocatedTaskInfo) (bool, error) {
+				return fn(subqueue, task)
+			})
+	}
+	return truncated, err
+}
+
+// scanTasks calls fn with the tasks of a task queue between the given task ids in task id order, counting them in
+// scanned. done is true if the scan must not go on with other task queues.
+func (tr *taskReader) scanTasks(
+	ctx context.Context,
+	db *taskQueueDB,
+	minTaskID int64,
+	maxTaskID int64,
+	scanned *int,
+	fn func(*persistencespb.AllocatedTaskInfo) (bool, error),
+) (done bool, truncated bool, err error) {
+	maxScanTasks := tr.tlMgr.config.MaxBacklogScanTasks()
+	for minTaskID <= maxTaskID {
+		response, err := db.GetTasks(ctx, minTaskID, maxTaskID+1, tr.tlMgr.config.GetTasksBatchSize())
+		if err != nil {
+			return true, false, err
+		}
+		if len(response.Tasks) == 0 {
+			return false, false, nil
+		}
+		for _, task := range response.Tasks {
+			if *scanned >= maxScanTasks {
+				return true, true, nil
+			}
+			*scanned++
+			if taskqueue.IsTaskExpired(task) {
+				continue
+			}
+			if ok, err := fn(task); err != nil || !ok {
+				return true, false, err
+			}
+		}
+		minTaskID = response.Tasks[len(response.Tasks)-1].GetTaskId() + 1
+	}
+	return false, false, nil
+}
+
+// removeBacklogTask deletes a backlog task, or a task of the given priority subqueue, from persistence. If the task
+// is already loaded it is dropped instead of dispatched.
+func (tr *taskReader) removeBacklogTask(ctx context.Context, subqueue *prioritySubqueue, taskID int64) error {
+	if subqueue != nil {
+		return subqueue.remove(ctx, taskID)
+	}
+	if err := tr.tlMgr.db.CompleteTask(ctx, taskID); err != nil {
+		return err
+	}
+	tr.tlMgr.taskAckManager.markTaskRemoved(taskID)
+	return nil
+}
+
 func (tr *taskReader) persistAckLevel(ctx context.Context) error {
 	ackLevel := tr.tlMgr.taskAckManager.getAckLevel()
 	tr.emitTaskLagMetric(ackLevel)
@@ -312,6 +700,76 @@ func (tr *taskReader) emitTaskLagMetric(ackLevel int64) {
 	tr.taggedMetricsHandler().Gauge(metrics.TaskLagPerTaskQueueGauge.GetMetricName()).Record(float64(maxReadLevel - ackLevel))
 }
 
+// fairnessKeyBacklogPump periodically counts the persisted backlog of each fairness key for the fairness key metrics.
+func (tr *taskReader) fairnessKeyBacklogPump(ctx context.Context) error {
+	ctx = tr.initContext(ctx)
+
+	if err := tr.tlMgr.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+
+	for {
+		timer := time.NewTimer(tr.tlMgr.config.FairnessBacklogCountInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		if !tr.tlMgr.config.EnableTaskFairness() {
+			continue
+		}
+		counts, err := tr.countFairnessKeyBacklogs(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tr.logger().Warn("taskReader: failed to count fairness key backlogs", tag.Error(err))
+			continue
+		}
+		tr.emitFairnessKeyBacklogMetrics(counts)
+	}
+}
+
+// countFairnessKeyBacklogs counts the persisted backlog tasks of each fairness key, including the tasks of the
+// priority subqueues. At most MaxBacklogScanTasks tasks are counted.
+func (tr *taskReader) countFairnessKeyBacklogs(ctx context.Context) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	_, err := tr.scanBacklog(ctx, func(_ *prioritySubqueue, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		counts[task.Data.GetFairnessKey()]++
+		return true, nil
+	})
+	return counts, err
+}
+
+// emitFairnessKeyBacklogMetrics emits the number of fairness keys with a backlog, the largest backlog of a fairness
+// key, and the backlog of each bucket of fairness keys. Fairness keys are unbounded, so they are not used as metric
+// tags, the backlog of individual keys is reported by DescribeTaskQueue.
+func (tr *taskReader) emitFairnessKeyBacklogMetrics(counts map[string]int64) {
+	var maxBacklog int64
+	bucketBacklogs := make([]int64, fairnessKeyMetricBuckets)
+	for fairnessKey, count := range counts {
+		if count > maxBacklog {
+			maxBacklog = count
+		}
+		bucketBacklogs[fairnessKeyMetricBucket(fairnessKey)] += count
+	}
+	tr.taggedMetricsHandler().Gauge(metrics.FairnessKeyCountGauge.GetMetricName()).Record(float64(len(counts)))
+	tr.taggedMetricsHandler().Gauge(metrics.FairnessKeyMaxBacklogGauge.GetMetricName()).Record(float64(maxBacklog))
+	// all buckets are emitted so that the backlog of a drained bucket drops to zero
+	for bucket, backlog := range bucketBacklogs {
+		tr.taggedMetricsHandler().Gauge(metrics.FairnessKeyBacklogGauge.GetMetricName()).
+			Record(float64(backlog), metrics.FairnessKeyBucketTag(bucket))
+	}
+}
+
+// fairnessKeyMetricBucket returns the bucket of a fairness key in the fairness key backlog metric.
+func fairnessKeyMetricBucket(fairnessKey string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(fairnessKey))
+	return int(h.Sum32() % fairnessKeyMetricBuckets)
+}
+
 func (tr *taskReader) initContext(ctx context.Context) context.Context {
 	namespace, _ := tr.tlMgr.namespaceRegistry.GetNamespaceName(tr.tlMgr.taskQueueID.namespaceID)
 
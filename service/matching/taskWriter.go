@@ -136,7 +136,12 @@ func (w *taskWriter) initReadWriteState(ctx context.Context) error {
 	w.taskIDBlock = rangeIDToTaskIDBlock(state.rangeID, w.config.RangeSize)
 	atomic.StoreInt64(&w.maxReadLevel, w.taskIDBlock.start-1)
 	w.tlMgr.taskAckManager.setAckLevel(state.ackLevel)
-	return nil
+	return backoff.ThrottleRetryContext(ctx, func(ctx context.Context) error {
+		if err := w.tlMgr.taskReader.delayedTasks.load(ctx, w.config.GetTasksBatchSize(), time.Now()); err != nil {
+			return err
+		}
+		return w.tlMgr.taskReader.loadPrioritySubqueues(ctx)
+	}, retryForever, common.IsPersistenceTransientError)
 }
 
 func (w *taskWriter) appendTask(
@@ -64,14 +64,13 @@ func newTaskQueueIDWithPartition(
 	}, nil
 }
 
-// To be used in a later versioning PR:
-// func newTaskQueueIDWithVersionSet(id *taskQueueID, versionSet string) *taskQueueID {
-// 	return &taskQueueID{
-// 		Name:        id.Name.WithVersionSet(versionSet),
-// 		namespaceID: id.namespaceID,
-// 		taskType:    id.taskType,
-// 	}
-// }
+func newTaskQueueIDWithVersionSet(id *taskQueueID, versionSet string) *taskQueueID {
+	return &taskQueueID{
+		Name:        id.Name.WithVersionSet(versionSet),
+		namespaceID: id.namespaceID,
+		taskType:    id.taskType,
+	}
+}
 
 func (tid *taskQueueID) String() string {
 	var b bytes.Buffer
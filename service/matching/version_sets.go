@@ -27,6 +27,7 @@ package matching
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/dgryski/go-farm"
 	"go.temporal.io/api/serviceerror"
@@ -186,6 +187,126 @@ func findVersion(data *persistence.VersioningData, buildID string) findVersionRe
 	}
 }
 
+// UpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions of a task
+// queue. The ramp routes the given percentage of the workflow executions to the build ID, the remaining ones keep going
+// to the current default build ID. An empty build ID removes the ramp.
+//
+// The ramping build ID doesn't have to be in the version sets, it's typically added as the new default once the ramp
+// reaches 100%, after which the ramp can be removed.
+func UpdateBuildIdRamp(existingData *persistence.VersioningData, buildID string, percentage float32) error {
+	if buildID == "" {
+		if percentage != 0 {
+			return serviceerror.NewInvalidArgument("ramp percentage requires a build ID")
+		}
+		existingData.Ramp = nil
+		return nil
+	}
+	if !(percentage >= 0 && percentage <= 100) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("ramp percentage %v is not in [0, 100]", percentage))
+	}
+	if buildID == defaultBuildID(existingData) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("version %s is already the default", buildID))
+	}
+	existingData.Ramp = &persistence.BuildIdRamp{
+		BuildId:        buildID,
+		RampPercentage: percentage,
+	}
+	return nil
+}
+
+// isWorkflowRamped returns true if the first workflow task of a new run of the given workflow is routed to the ramping
+// build ID. The decision only depends on the workflow ID, so the retries of that task go to the same build ID as long as
+// the ramp percentage isn't lowered. Once the task started, the run is assigned to the build ID of its worker, which
+// decides where its later tasks go.
+func isWorkflowRamped(ramp *persistence.BuildIdRamp, workflowID string) bool {
+	if ramp.GetBuildId() == "" {
+		return false
+	}
+	bucket := float32(farm.Fingerprint32([]byte(workflowID))%10000) / 100
+	return bucket < ramp.GetRampPercentage()
+}
+
+// buildIdVersionSet returns the version set part of the name of the task queue holding the tasks routed to a build ID.
+// Build IDs are opaque, so they are hashed to get a name which is safe to embed in a task queue name.
+func buildIdVersionSet(buildID string) string {
+	return fmt.Sprintf("%016x", farm.Fingerprint64([]byte(buildID)))
+}
+
+func defaultBuildID(data *persistence.VersioningData) string {
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		return ""
+	}
+	buildIDs := sets[len(sets)-1].GetBuildIds()
+	if len(buildIDs) == 0 {
+		return ""
+	}
+	return buildIDs[len(buildIDs)-1]
+}
+
+// updateSupersededBuildIds records when the build IDs of the versioning data stopped receiving new workflow executions.
+// A build ID is superseded when it's neither the current default nor ramping. The record of a build ID is dropped when
+// it becomes active again or when it's no longer in the version sets.
+func updateSupersededBuildIds(data *persistence.VersioningData, now time.Time) {
+	active := map[string]struct{}{defaultBuildID(data): {}}
+	if ramp := data.GetRamp().GetBuildId(); ramp != "" {
+		active[ramp] = struct{}{}
+	}
+	supersededTimes := make(map[string]*time.Time, len(data.GetSupersededBuildIds()))
+	for _, superseded := range data.GetSupersededBuildIds() {
+		supersededTimes[superseded.GetBuildId()] = superseded.GetSupersededTime()
+	}
+
+	var supersededBuildIds []*persistence.SupersededBuildId
+	for _, set := range data.GetVersionSets() {
+		for _, buildID := range set.GetBuildIds() {
+			if _, ok := active[buildID]; ok {
+				continue
+			}
+			supersededTime, ok := supersededTimes[buildID]
+			if !ok {
+				supersededTime = &now
+			}
+			supersededBuildIds = append(supersededBuildIds, &persistence.SupersededBuildId{
+				BuildId:        buildID,
+				SupersededTime: supersededTime,
+			})
+		}
+	}
+	data.SupersededBuildIds = supersededBuildIds
+}
+
+// removeBuildIds removes the given build IDs from the version sets, dropping the sets left empty. Only the build IDs
+// which are still superseded are removed, so a build ID which became active again since it was picked is kept.
+func removeBuildIds(data *persistence.VersioningData, buildIDs map[string]struct{}) {
+	removable := make(map[string]struct{}, len(buildIDs))
+	var supersededBuildIds []*persistence.SupersededBuildId
+	for _, superseded := range data.GetSupersededBuildIds() {
+		if _, ok := buildIDs[superseded.GetBuildId()]; ok {
+			removable[superseded.GetBuildId()] = struct{}{}
+			continue
+		}
+		supersededBuildIds = append(supersededBuildIds, superseded)
+	}
+	data.SupersededBuildIds = supersededBuildIds
+
+	var sets []*taskqueuepb.CompatibleVersionSet
+	for _, set := range data.GetVersionSets() {
+		var kept []string
+		for _, buildID := range set.GetBuildIds() {
+			if _, ok := removable[buildID]; !ok {
+				kept = append(kept, buildID)
+			}
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		set.BuildIds = kept
+		sets = append(sets, set)
+	}
+	data.VersionSets = sets
+}
+
 func makeDefaultSet(data *persistence.VersioningData, setIx int) {
 	if len(data.VersionSets) <= 1 {
 		return
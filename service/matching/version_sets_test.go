@@ -27,6 +27,7 @@ package matching
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -297,3 +298,81 @@ func TestPromoteWithinSetTargetingNonexistentVersionErrors(t *testing.T) {
 	assert.Error(t, err)
 	assert.IsType(t, &serviceerror.NotFound{}, err)
 }
+
+func TestUpdateBuildIdRamp(t *testing.T) {
+	data := mkInitialData(3)
+
+	assert.NoError(t, UpdateBuildIdRamp(data, "3", 10))
+	assert.Equal(t, "3", data.GetRamp().GetBuildId())
+	assert.Equal(t, float32(10), data.GetRamp().GetRampPercentage())
+
+	assert.IsType(t, &serviceerror.InvalidArgument{}, UpdateBuildIdRamp(data, "3", 101))
+	assert.IsType(t, &serviceerror.InvalidArgument{}, UpdateBuildIdRamp(data, "3", -1))
+	assert.IsType(t, &serviceerror.InvalidArgument{}, UpdateBuildIdRamp(data, "2", 10))
+	assert.IsType(t, &serviceerror.InvalidArgument{}, UpdateBuildIdRamp(data, "", 10))
+	assert.Equal(t, float32(10), data.GetRamp().GetRampPercentage())
+
+	assert.NoError(t, UpdateBuildIdRamp(data, "", 0))
+	assert.Nil(t, data.GetRamp())
+}
+
+func TestIsWorkflowRamped(t *testing.T) {
+	ramped := func(percentage float32) int {
+		ramp := &persistencepb.BuildIdRamp{BuildId: "3", RampPercentage: percentage}
+		count := 0
+		for i := 0; i < 1000; i++ {
+			if isWorkflowRamped(ramp, fmt.Sprintf("workflow-%v", i)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	assert.Equal(t, 0, ramped(0))
+	assert.Equal(t, 1000, ramped(100))
+	assert.InDelta(t, 500, ramped(50), 100)
+	assert.False(t, isWorkflowRamped(nil, "workflow"))
+	// raising the percentage only adds workflows to the ramp
+	for i := 0; i < 1000; i++ {
+		workflowID := fmt.Sprintf("workflow-%v", i)
+		if isWorkflowRamped(&persistencepb.BuildIdRamp{BuildId: "3", RampPercentage: 20}, workflowID) {
+			assert.True(t, isWorkflowRamped(&persistencepb.BuildIdRamp{BuildId: "3", RampPercentage: 30}, workflowID))
+		}
+	}
+}
+
+func TestUpdateSupersededBuildIds(t *testing.T) {
+	data := mkInitialData(3)
+	t0 := time.Now().UTC()
+	t1 := t0.Add(time.Hour)
+
+	updateSupersededBuildIds(data, t0)
+	assert.Equal(t, []*persistencepb.SupersededBuildId{
+		{BuildId: "0", SupersededTime: &t0},
+		{BuildId: "1", SupersededTime: &t0},
+	}, data.GetSupersededBuildIds())
+
+	// a ramping build ID is active, the superseded time of the others is kept
+	assert.NoError(t, UpdateBuildIdRamp(data, "1", 10))
+	assert.NoError(t, UpdateVersionSets(data, mkNewDefReq("3"), 0))
+	updateSupersededBuildIds(data, t1)
+	assert.Equal(t, []*persistencepb.SupersededBuildId{
+		{BuildId: "0", SupersededTime: &t0},
+		{BuildId: "2", SupersededTime: &t1},
+	}, data.GetSupersededBuildIds())
+}
+
+func TestRemoveBuildIds(t *testing.T) {
+	data := mkInitialData(3)
+	assert.NoError(t, UpdateVersionSets(data, mkNewCompatReq("1.1", "1", false), 0))
+	updateSupersededBuildIds(data, time.Now().UTC())
+
+	// active build IDs are never removed
+	removeBuildIds(data, map[string]struct{}{"0": {}, "1": {}, "2": {}})
+	assert.Equal(t, []*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{"1.1"}},
+		{BuildIds: []string{"2"}},
+	}, data.GetVersionSets())
+	assert.Equal(t, "1.1", data.GetSupersededBuildIds()[0].GetBuildId())
+	assert.Len(t, data.GetSupersededBuildIds(), 1)
+}
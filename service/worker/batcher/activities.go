@@ -38,6 +38,7 @@ import (
 	sdkclient "go.temporal.io/sdk/client"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -54,7 +55,6 @@ var (
 type activities struct {
 	activityDeps
 	namespace   namespace.Name
-	namespaceID namespace.ID
 	rps         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	concurrency dynamicconfig.IntPropertyFnWithNamespaceFilter
 }
@@ -80,6 +80,14 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		return hbd, err
 	}
 
+	// activities registered for the system namespace process batch operations of any namespace
+	namespaceID, err := a.NamespaceRegistry.GetNamespaceID(namespace.Name(batchParams.Namespace))
+	if err != nil {
+		metricsHandler.Counter(metrics.BatcherOperationFailures.GetMetricName()).Record(1)
+		logger.Error("Failed to resolve namespace ID of batch operation", tag.Error(err))
+		return hbd, err
+	}
+
 	sdkClient := a.ClientFactory.NewClient(sdkclient.Options{
 		Namespace:     batchParams.Namespace,
 		DataConverter: sdk.PreferProtoDataConverter,
@@ -113,7 +121,7 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, namespaceID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, a.HistoryClient, metricsHandler, logger)
 	}
 
 	for {
@@ -212,11 +220,13 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	namespaceID namespace.ID,
 	taskCh chan taskDetail,
 	respCh chan error,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
+	historyClient historyservice.HistoryServiceClient,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) {
@@ -279,6 +289,20 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeResetActivities:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						_, err := historyClient.ResetActivity(ctx, &historyservice.ResetActivityRequest{
+							NamespaceId: namespaceID.String(),
+							WorkflowExecution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							ResetAttempts: batchParams.ResetActivitiesParams.ResetAttempts,
+							Identity:      batchParams.ResetActivitiesParams.Identity,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				metricsHandler.Counter(metrics.BatcherProcessorFailures.GetMetricName()).Record(1)
@@ -30,11 +30,13 @@ import (
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
 )
@@ -48,11 +50,13 @@ type (
 	// Batcher is the background sub-system that execute workflow for batch operations
 	// It is also the context object that get's passed around within the scanner workflows / activities
 	Batcher struct {
-		sdkClientFactory sdk.ClientFactory
-		metricsHandler   metrics.Handler
-		logger           log.Logger
-		rps              dynamicconfig.IntPropertyFnWithNamespaceFilter
-		concurrency      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		sdkClientFactory  sdk.ClientFactory
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+		rps               dynamicconfig.IntPropertyFnWithNamespaceFilter
+		concurrency       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -61,15 +65,19 @@ func New(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	sdkClientFactory sdk.ClientFactory,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
 	rps dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	concurrency dynamicconfig.IntPropertyFnWithNamespaceFilter,
 ) *Batcher {
 	return &Batcher{
-		sdkClientFactory: sdkClientFactory,
-		metricsHandler:   metricsHandler,
-		logger:           log.With(logger, tag.ComponentBatcher),
-		rps:              rps,
-		concurrency:      concurrency,
+		sdkClientFactory:  sdkClientFactory,
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
+		metricsHandler:    metricsHandler,
+		logger:            log.With(logger, tag.ComponentBatcher),
+		rps:               rps,
+		concurrency:       concurrency,
 	}
 }
 
@@ -85,12 +93,13 @@ func (s *Batcher) Start() error {
 	batchWorker.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
 	batchWorker.RegisterActivity(&activities{
 		activityDeps: activityDeps{
-			MetricsHandler: s.metricsHandler,
-			Logger:         s.logger,
-			ClientFactory:  s.sdkClientFactory,
+			MetricsHandler:    s.metricsHandler,
+			Logger:            s.logger,
+			ClientFactory:     s.sdkClientFactory,
+			HistoryClient:     s.historyClient,
+			NamespaceRegistry: s.namespaceRegistry,
 		},
 		namespace:   primitives.SystemLocalNamespace,
-		namespaceID: primitives.SystemNamespaceID,
 		rps:         s.rps,
 		concurrency: s.concurrency,
 	})
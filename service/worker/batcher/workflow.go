@@ -64,6 +64,8 @@ const (
 	BatchTypeDelete = "delete"
 	// BatchTypeReset is batch type for resetting workflows
 	BatchTypeReset = "reset"
+	// BatchTypeResetActivities is batch type for retrying activities waiting for their next retry immediately
+	BatchTypeResetActivities = "reset_activities"
 )
 
 var (
@@ -100,6 +102,13 @@ type (
 		ResetReapplytType enumspb.ResetReapplyType
 	}
 
+	// ResetActivitiesParams is the parameters for resetting activities of workflow
+	ResetActivitiesParams struct {
+		// ResetAttempts resets the attempt of the activities to 1
+		ResetAttempts bool
+		Identity      string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -110,7 +119,7 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,reset_activities
 		BatchType string
 
 		// Below are all optional
@@ -124,6 +133,8 @@ type (
 		DeleteParams DeleteParams
 		// ResetParams is params only for BatchTypeReset
 		ResetParams ResetParams
+		// ResetActivitiesParams is params only for BatchTypeResetActivities
+		ResetActivitiesParams ResetActivitiesParams
 		// RPS of processing. Default to DefaultRPS
 		// This is moving to dynamic config.
 		// TODO: Remove it from BatchParams after 1.19+
@@ -231,7 +242,7 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset:
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete, BatchTypeReset, BatchTypeResetActivities:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -37,9 +37,9 @@ import (
 	"go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencepb "go.temporal.io/server/api/persistence/v1"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -62,7 +62,7 @@ type (
 
 	// Scavenger is the type that holds the state for history scavenger daemon
 	Scavenger struct {
-		numShards      int32
+		shardResolver  historyshard.Resolver
 		db             persistence.ExecutionManager
 		client         historyservice.HistoryServiceClient
 		adminClient    adminservice.AdminServiceClient
@@ -104,7 +104,7 @@ const (
 //   - describe the corresponding workflow execution
 //   - deletion of history itself, if there are no workflow execution
 func NewScavenger(
-	numShards int32,
+	shardResolver historyshard.Resolver,
 	db persistence.ExecutionManager,
 	rps int,
 	client historyservice.HistoryServiceClient,
@@ -119,11 +119,11 @@ func NewScavenger(
 ) *Scavenger {
 
 	return &Scavenger{
-		numShards:   numShards,
-		db:          db,
-		client:      client,
-		adminClient: adminClient,
-		registry:    registry,
+		shardResolver: shardResolver,
+		db:            db,
+		client:        client,
+		adminClient:   adminClient,
+		registry:      registry,
 		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
 			func() float64 { return float64(rps) },
 		),
@@ -248,7 +248,16 @@ func (s *Scavenger) filterTask(
 		s.hbd.ErrorCount++
 		return nil
 	}
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, s.numShards)
+	// branches of workflows which are being moved to another history shard are retried by the next run
+	shardID, err := s.shardResolver.WorkflowShardID(namespace.ID(namespaceID), workflowID)
+	if err != nil {
+		s.metricsHandler.Counter(metrics.HistoryScavengerSkipCount.GetMetricName()).Record(1)
+
+		s.Lock()
+		defer s.Unlock()
+		s.hbd.SkipCount++
+		return nil
+	}
 
 	return &taskDetail{
 		shardID:     shardID,
@@ -44,6 +44,7 @@ import (
 	persistencepb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -111,7 +112,7 @@ func (s *ScavengerTestSuite) createTestScavenger(
 	executionDataAge := dynamicconfig.GetDurationPropertyFn(time.Second)
 	enableRetentionVerification := dynamicconfig.GetBoolPropertyFn(true)
 	s.scavenger = NewScavenger(
-		s.numShards,
+		historyshard.NewStaticResolver(s.numShards),
 		s.mockExecutionManager,
 		rps,
 		s.mockHistoryClient,
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -89,15 +90,16 @@ type (
 	// scannerContext is the context object that get's
 	// passed around within the scanner workflows / activities
 	scannerContext struct {
-		cfg               *Config
-		logger            log.Logger
-		sdkClientFactory  sdk.ClientFactory
-		metricsHandler    metrics.Handler
-		executionManager  persistence.ExecutionManager
-		taskManager       persistence.TaskManager
-		historyClient     historyservice.HistoryServiceClient
-		adminClient       adminservice.AdminServiceClient
-		namespaceRegistry namespace.Registry
+		cfg                  *Config
+		logger               log.Logger
+		sdkClientFactory     sdk.ClientFactory
+		metricsHandler       metrics.Handler
+		executionManager     persistence.ExecutionManager
+		taskManager          persistence.TaskManager
+		historyClient        historyservice.HistoryServiceClient
+		adminClient          adminservice.AdminServiceClient
+		namespaceRegistry    namespace.Registry
+		historyShardResolver historyshard.Resolver
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -125,18 +127,20 @@ func New(
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
 	registry namespace.Registry,
+	historyShardResolver historyshard.Resolver,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
-			cfg:               cfg,
-			sdkClientFactory:  sdkClientFactory,
-			logger:            logger,
-			metricsHandler:    metricsHandler,
-			executionManager:  executionManager,
-			taskManager:       taskManager,
-			historyClient:     historyClient,
-			adminClient:       adminClient,
-			namespaceRegistry: registry,
+			cfg:                  cfg,
+			sdkClientFactory:     sdkClientFactory,
+			logger:               logger,
+			metricsHandler:       metricsHandler,
+			executionManager:     executionManager,
+			taskManager:          taskManager,
+			historyClient:        historyClient,
+			adminClient:          adminClient,
+			namespaceRegistry:    registry,
+			historyShardResolver: historyShardResolver,
 		},
 	}
 }
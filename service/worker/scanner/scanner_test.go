@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -188,6 +189,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				historyservicemock.NewMockHistoryServiceClient(ctrl),
 				mockAdminClient,
 				mockNamespaceRegistry,
+				historyshard.NewStaticResolver(1),
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -257,6 +259,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		historyservicemock.NewMockHistoryServiceClient(ctrl),
 		mockAdminClient,
 		mockNamespaceRegistry,
+		historyshard.NewStaticResolver(1),
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
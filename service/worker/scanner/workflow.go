@@ -136,7 +136,6 @@ func HistoryScavengerActivity(
 
 	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
 	rps := ctx.cfg.PersistenceMaxQPS()
-	numShards := ctx.cfg.Persistence.NumHistoryShards
 
 	hbd := history.ScavengerHeartbeatDetails{}
 	if activity.HasHeartbeatDetails(activityCtx) {
@@ -146,7 +145,7 @@ func HistoryScavengerActivity(
 	}
 
 	scavenger := history.NewScavenger(
-		numShards,
+		ctx.historyShardResolver,
 		ctx.executionManager,
 		rps,
 		ctx.historyClient,
@@ -191,7 +190,7 @@ func ExecutionsScavengerActivity(
 	metricsHandler := ctx.metricsHandler
 	scavenger := executions.NewScavenger(
 		activityCtx,
-		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.historyShardResolver.Layout().MaxShardCount(),
 		ctx.cfg.ExecutionScannerPerHostQPS,
 		ctx.cfg.ExecutionScannerPerShardQPS,
 		ctx.cfg.ExecutionDataDurationBuffer,
@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/historyshard"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -77,6 +78,7 @@ type (
 		namespaceRegistry      namespace.Registry
 		workerServiceResolver  membership.ServiceResolver
 		visibilityManager      manager.VisibilityManager
+		historyShardResolver   historyshard.Resolver
 
 		archiverProvider provider.ArchiverProvider
 
@@ -146,6 +148,7 @@ func NewService(
 	workerManager *workerManager,
 	perNamespaceWorkerManager *perNamespaceWorkerManager,
 	visibilityManager manager.VisibilityManager,
+	historyShardResolver historyshard.Resolver,
 ) (*Service, error) {
 	workerServiceResolver, err := membershipMonitor.GetResolver(primitives.WorkerService)
 	if err != nil {
@@ -175,6 +178,7 @@ func NewService(
 		taskManager:               taskManager,
 		historyClient:             historyClient,
 		visibilityManager:         visibilityManager,
+		historyShardResolver:      historyShardResolver,
 
 		workerManager:             workerManager,
 		perNamespaceWorkerManager: perNamespaceWorkerManager,
@@ -470,6 +474,8 @@ func (s *Service) startBatcher() {
 		s.metricsHandler,
 		s.logger,
 		s.sdkClientFactory,
+		s.clientBean.GetHistoryClient(),
+		s.namespaceRegistry,
 		s.config.BatcherRPS,
 		s.config.BatcherConcurrency,
 	).Start(); err != nil {
@@ -496,6 +502,7 @@ func (s *Service) initScanner() error {
 		s.historyClient,
 		adminClient,
 		s.namespaceRegistry,
+		s.historyShardResolver,
 	)
 	return nil
 }
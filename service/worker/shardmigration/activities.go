@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"golang.org/x/exp/slices"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	activities struct {
+		clusterMetadataManager persistence.ClusterMetadataManager
+		historyClient          historyservice.HistoryServiceClient
+		logger                 log.Logger
+	}
+)
+
+var (
+	errClusterMetadataChanged = errors.New("cluster metadata changed concurrently")
+)
+
+// StartMigrationActivity records the history shard count migration in cluster metadata.
+func (a *activities) StartMigrationActivity(ctx context.Context, targetShardCount int32) (startMigrationResult, error) {
+	resp, err := a.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return startMigrationResult{}, err
+	}
+	metadata := resp.ClusterMetadata
+	shardCount := metadata.GetHistoryShardCount()
+
+	if migration := metadata.GetHistoryShardCountMigration(); migration != nil {
+		if migration.GetTargetShardCount() != targetShardCount {
+			return startMigrationResult{}, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("history shard count is being migrated to %v shards", migration.GetTargetShardCount()), "", nil,
+			)
+		}
+		return startMigrationResult{ShardCount: shardCount}, nil
+	}
+	if targetShardCount <= shardCount || targetShardCount%shardCount != 0 {
+		return startMigrationResult{}, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("target history shard count %v is not a multiple of history shard count %v", targetShardCount, shardCount), "", nil,
+		)
+	}
+
+	metadata.HistoryShardCountMigration = &persistencespb.HistoryShardCountMigration{
+		TargetShardCount: targetShardCount,
+	}
+	if err := a.saveClusterMetadata(ctx, metadata, resp.Version); err != nil {
+		return startMigrationResult{}, err
+	}
+	a.logger.Info("Started history shard count migration.",
+		tag.NewInt32("shard-count", shardCount),
+		tag.NewInt32("target-shard-count", targetShardCount),
+	)
+	return startMigrationResult{ShardCount: shardCount}, nil
+}
+
+// UpdateShardsActivity marks source shards as migrating or migrated in cluster metadata.
+func (a *activities) UpdateShardsActivity(ctx context.Context, request updateShardsRequest) error {
+	resp, err := a.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	metadata := resp.ClusterMetadata
+	migration := metadata.GetHistoryShardCountMigration()
+	if migration.GetTargetShardCount() != request.TargetShardCount {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("history shard count is not being migrated to %v shards", request.TargetShardCount), "", nil,
+		)
+	}
+
+	for _, shardID := range request.ShardIDs {
+		if request.Migrated {
+			if i := slices.Index(migration.MigratingShardIds, shardID); i >= 0 {
+				migration.MigratingShardIds = slices.Delete(migration.MigratingShardIds, i, i+1)
+			}
+			if !slices.Contains(migration.MigratedShardIds, shardID) {
+				migration.MigratedShardIds = append(migration.MigratedShardIds, shardID)
+			}
+		} else if !slices.Contains(migration.MigratingShardIds, shardID) && !slices.Contains(migration.MigratedShardIds, shardID) {
+			migration.MigratingShardIds = append(migration.MigratingShardIds, shardID)
+		}
+	}
+	return a.saveClusterMetadata(ctx, metadata, resp.Version)
+}
+
+// MigrateShardActivity moves the executions of a source shard to their target shards.
+func (a *activities) MigrateShardActivity(ctx context.Context, request migrateShardRequest) error {
+	var pageToken []byte
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &pageToken); err != nil {
+			return err
+		}
+	}
+
+	var migratedCount int64
+	for {
+		resp, err := a.historyClient.MigrateHistoryShard(ctx, &historyservice.MigrateHistoryShardRequest{
+			ShardId:          request.ShardID,
+			TargetShardCount: request.TargetShardCount,
+			PageSize:         request.PageSize,
+			NextPageToken:    pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		migratedCount += resp.GetMigratedExecutionCount()
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+		activity.RecordHeartbeat(ctx, pageToken)
+	}
+
+	a.logger.Info("Migrated history shard executions.", tag.ShardID(request.ShardID), tag.Counter(int(migratedCount)))
+	return nil
+}
+
+// FinishMigrationActivity updates the history shard count once all source shards are migrated.
+func (a *activities) FinishMigrationActivity(ctx context.Context, targetShardCount int32) error {
+	resp, err := a.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	metadata := resp.ClusterMetadata
+	if metadata.GetHistoryShardCount() == targetShardCount && metadata.GetHistoryShardCountMigration() == nil {
+		return nil
+	}
+
+	metadata.HistoryShardCount = targetShardCount
+	metadata.HistoryShardCountMigration = nil
+	if err := a.saveClusterMetadata(ctx, metadata, resp.Version); err != nil {
+		return err
+	}
+	a.logger.Info("Finished history shard count migration.", tag.NewInt32("shard-count", targetShardCount))
+	return nil
+}
+
+func (a *activities) saveClusterMetadata(
+	ctx context.Context,
+	metadata persistencespb.ClusterMetadata,
+	version int64,
+) error {
+	applied, err := a.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata,
+		Version:         version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errClusterMetadataChanged
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// WorkflowName is the workflow ID and type of the system workflow increasing the history shard count
+	WorkflowName = "temporal-sys-history-shard-migration-workflow"
+
+	defaultLayoutPropagationDelay = 3 * time.Minute
+	defaultShardBatchSize         = 8
+	defaultPageSize               = 100
+)
+
+type (
+	// WorkflowParams is the parameters for the history shard migration workflow.
+	WorkflowParams struct {
+		// Must be a multiple of the current history shard count.
+		TargetShardCount int32
+		// Time for all history hosts to reload the history shard layout. Must be longer than
+		// history.acquireShardInterval, which is the interval history hosts reload the layout at,
+		// and historyshard.LayoutRefreshInterval, which is the interval other hosts reload it at.
+		LayoutPropagationDelay time.Duration
+		// Number of source shards migrated concurrently.
+		ShardBatchSize int
+		// Number of executions migrated per history request.
+		PageSize int32
+	}
+
+	startMigrationResult struct {
+		ShardCount int32
+	}
+
+	updateShardsRequest struct {
+		TargetShardCount int32
+		ShardIDs         []int32
+		Migrated         bool
+	}
+
+	migrateShardRequest struct {
+		ShardID          int32
+		TargetShardCount int32
+		PageSize         int32
+	}
+)
+
+var (
+	clusterMetadataActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 10 * time.Second,
+		},
+		StartToCloseTimeout: 10 * time.Second,
+	}
+
+	shardActivityOptions = workflow.ActivityOptions{
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval: 1 * time.Second,
+			MaximumInterval: 1 * time.Minute,
+		},
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    1 * time.Minute,
+	}
+)
+
+// HistoryShardMigrationWorkflow increases the history shard count of the cluster while it stays available.
+//
+// Source shards are migrated in batches. Each source shard is first marked as migrating, which makes
+// history hosts reject requests for the workflows leaving the shard. Once all history hosts observed the
+// change, the executions of those workflows are copied into their target shards, where their history tasks
+// are regenerated, and removed from the source shard. The source shard is then marked as migrated, which
+// makes history hosts serve the workflows from their target shards. The history shard count is updated once
+// all source shards are migrated.
+func HistoryShardMigrationWorkflow(ctx workflow.Context, params WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Workflow started.", tag.WorkflowType(WorkflowName))
+
+	if params.LayoutPropagationDelay <= 0 {
+		params.LayoutPropagationDelay = defaultLayoutPropagationDelay
+	}
+	if params.ShardBatchSize <= 0 {
+		params.ShardBatchSize = defaultShardBatchSize
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+
+	var a *activities
+	metadataCtx := workflow.WithActivityOptions(ctx, clusterMetadataActivityOptions)
+	shardCtx := workflow.WithActivityOptions(ctx, shardActivityOptions)
+
+	var result startMigrationResult
+	if err := workflow.ExecuteActivity(metadataCtx, a.StartMigrationActivity, params.TargetShardCount).Get(ctx, &result); err != nil {
+		return fmt.Errorf("StartMigrationActivity: %w", err)
+	}
+
+	for batchStart := int32(1); batchStart <= result.ShardCount; batchStart += int32(params.ShardBatchSize) {
+		var shardIDs []int32
+		for shardID := batchStart; shardID < batchStart+int32(params.ShardBatchSize) && shardID <= result.ShardCount; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+		if err := migrateShards(ctx, metadataCtx, shardCtx, params, shardIDs); err != nil {
+			return err
+		}
+		logger.Info("History shards migrated.", tag.NewInt32("shard-id-begin", shardIDs[0]), tag.NewInt32("shard-id-end", shardIDs[len(shardIDs)-1]))
+	}
+
+	if err := workflow.ExecuteActivity(metadataCtx, a.FinishMigrationActivity, params.TargetShardCount).Get(ctx, nil); err != nil {
+		return fmt.Errorf("FinishMigrationActivity: %w", err)
+	}
+
+	logger.Info("Workflow finished successfully.", tag.WorkflowType(WorkflowName))
+	return nil
+}
+
+func migrateShards(
+	ctx workflow.Context,
+	metadataCtx workflow.Context,
+	shardCtx workflow.Context,
+	params WorkflowParams,
+	shardIDs []int32,
+) error {
+	var a *activities
+
+	if err := workflow.ExecuteActivity(metadataCtx, a.UpdateShardsActivity, updateShardsRequest{
+		TargetShardCount: params.TargetShardCount,
+		ShardIDs:         shardIDs,
+		Migrated:         false,
+	}).Get(ctx, nil); err != nil {
+		return fmt.Errorf("UpdateShardsActivity: %w", err)
+	}
+
+	// wait until no history host serves the workflows leaving the shards
+	if err := workflow.Sleep(ctx, params.LayoutPropagationDelay); err != nil {
+		return err
+	}
+
+	futures := make([]workflow.Future, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		futures = append(futures, workflow.ExecuteActivity(shardCtx, a.MigrateShardActivity, migrateShardRequest{
+			ShardID:          shardID,
+			TargetShardCount: params.TargetShardCount,
+			PageSize:         params.PageSize,
+		}))
+	}
+	for _, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			return fmt.Errorf("MigrateShardActivity: %w", err)
+		}
+	}
+
+	if err := workflow.ExecuteActivity(metadataCtx, a.UpdateShardsActivity, updateShardsRequest{
+		TargetShardCount: params.TargetShardCount,
+		ShardIDs:         shardIDs,
+		Migrated:         true,
+	}).Get(ctx, nil); err != nil {
+		return fmt.Errorf("UpdateShardsActivity: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shardmigration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_HistoryShardMigrationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+
+	env.OnActivity(a.StartMigrationActivity, mock.Anything, int32(8)).Return(startMigrationResult{ShardCount: 4}, nil).Once()
+	env.OnActivity(a.UpdateShardsActivity, mock.Anything, updateShardsRequest{TargetShardCount: 8, ShardIDs: []int32{1, 2, 3}, Migrated: false}).Return(nil).Once()
+	env.OnActivity(a.UpdateShardsActivity, mock.Anything, updateShardsRequest{TargetShardCount: 8, ShardIDs: []int32{1, 2, 3}, Migrated: true}).Return(nil).Once()
+	env.OnActivity(a.UpdateShardsActivity, mock.Anything, updateShardsRequest{TargetShardCount: 8, ShardIDs: []int32{4}, Migrated: false}).Return(nil).Once()
+	env.OnActivity(a.UpdateShardsActivity, mock.Anything, updateShardsRequest{TargetShardCount: 8, ShardIDs: []int32{4}, Migrated: true}).Return(nil).Once()
+	for shardID := int32(1); shardID <= 4; shardID++ {
+		env.OnActivity(a.MigrateShardActivity, mock.Anything, migrateShardRequest{ShardID: shardID, TargetShardCount: 8, PageSize: 10}).Return(nil).Once()
+	}
+	env.OnActivity(a.FinishMigrationActivity, mock.Anything, int32(8)).Return(nil).Once()
+
+	env.ExecuteWorkflow(HistoryShardMigrationWorkflow, WorkflowParams{
+		TargetShardCount:       8,
+		LayoutPropagationDelay: time.Minute,
+		ShardBatchSize:         3,
+		PageSize:               10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
@@ -25,7 +25,10 @@
 package tdbg
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"time"
@@ -33,6 +36,7 @@ import (
 	"github.com/temporalio/tctl-kit/pkg/color"
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
@@ -46,6 +50,8 @@ import (
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AdminShowWorkflow shows history
@@ -127,6 +133,223 @@ func AdminShowWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AdminWatchWorkflow streams workflow history events as they are committed
+func AdminWatchWorkflow(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	var eventTypes []enumspb.EventType
+	for _, eventTypeName := range c.StringSlice(FlagEventType) {
+		eventType, err := stringToEnum(eventTypeName, enumspb.EventType_value)
+		if err != nil {
+			return fmt.Errorf("invalid event type: %s", err)
+		}
+		eventTypes = append(eventTypes, enumspb.EventType(eventType))
+	}
+
+	client := cFactory.AdminClient(c)
+
+	// the stream lasts until the workflow execution is closed unless a timeout is set
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if c.IsSet(FlagContextTimeout) {
+		ctx, cancel = newContext(c)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	defer cancel()
+
+	request := &adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		FirstEventId: c.Int64(FlagMinEventID),
+		EventTypes:   eventTypes,
+	}
+	for {
+		err := watchWorkflowHistory(ctx, client, request)
+		// the server closes streams after a maximum duration, resume them after the last received event batch
+		if status.Code(err) == codes.DeadlineExceeded && ctx.Err() == nil {
+			continue
+		}
+		return err
+	}
+}
+
+// watchWorkflowHistory prints the streamed history events and updates the request to resume the stream
+// after the last received event batch
+func watchWorkflowHistory(
+	ctx context.Context,
+	client adminservice.AdminServiceClient,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+) error {
+	stream, err := client.StreamWorkflowExecutionHistory(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to stream workflow history: %w", err)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to stream workflow history: %w", err)
+		}
+		data, err := encoder.EncodeHistoryEvents(resp.GetHistory().GetEvents())
+		if err != nil {
+			return fmt.Errorf("unable to encode History Events: %s", err)
+		}
+		fmt.Println(string(data))
+		fmt.Printf("======== run ID %v, next event ID %v ======\n", resp.GetRunId(), resp.GetNextEventId())
+		request.Execution.RunId = resp.GetRunId()
+		request.FirstEventId = resp.GetNextEventId()
+	}
+}
+
+// AdminExportWorkflow exports a workflow execution and the other runs of its continued as new chain into a bundle file
+func AdminExportWorkflow(c *cli.Context) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	outputFileName, err := getRequiredOption(c, FlagOutputFilename)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(outputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to create workflow execution bundle file: %s", err)
+	}
+	defer func() { _ = file.Close() }()
+	if err := exportWorkflow(c, nsName, wid, file); err != nil {
+		_ = os.Remove(outputFileName)
+		return err
+	}
+	return nil
+}
+
+// exportWorkflow writes the exported bundle to the file page by page. Serialized messages which are concatenated
+// are merged when they are deserialized, so the bundle without its runs and then each of its runs are appended
+// to the file as a single bundle. A run is only held in memory until all of its pages are received.
+func exportWorkflow(c *cli.Context, nsName string, wid string, file *os.File) error {
+	client := cFactory.AdminClient(c)
+	request := &adminservice.ExportWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      c.String(FlagRunID),
+		},
+	}
+
+	writeBundle := func(bundle *adminservice.WorkflowExecutionBundle) error {
+		data, err := bundle.Marshal()
+		if err != nil {
+			return fmt.Errorf("unable to serialize workflow execution bundle: %s", err)
+		}
+		if _, err := file.Write(data); err != nil {
+			return fmt.Errorf("unable to write workflow execution bundle file: %s", err)
+		}
+		return nil
+	}
+	var run *adminservice.WorkflowRunBundle
+	writeRun := func() error {
+		if run == nil {
+			return nil
+		}
+		if err := writeBundle(&adminservice.WorkflowExecutionBundle{Runs: []*adminservice.WorkflowRunBundle{run}}); err != nil {
+			return err
+		}
+		fmt.Printf("exported run %v with %v history batches\n", run.GetExecution().GetRunId(), len(run.GetHistoryBatches()))
+		run = nil
+		return nil
+	}
+
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := client.ExportWorkflowExecution(ctx, request)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to export workflow execution: %s", err)
+		}
+		if resp.GetBundle() != nil {
+			if err := writeBundle(resp.GetBundle()); err != nil {
+				return err
+			}
+		}
+		if page := resp.GetRun(); page != nil {
+			if run != nil && run.GetExecution().GetRunId() == page.GetExecution().GetRunId() {
+				run.HistoryBatches = append(run.HistoryBatches, page.GetHistoryBatches()...)
+			} else {
+				if err := writeRun(); err != nil {
+					return err
+				}
+				run = page
+			}
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			return writeRun()
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+	}
+}
+
+// AdminImportWorkflow imports a workflow execution bundle file created by AdminExportWorkflow
+func AdminImportWorkflow(c *cli.Context) error {
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(inputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to read workflow execution bundle file: %s", err)
+	}
+	bundle := &adminservice.WorkflowExecutionBundle{}
+	if err := bundle.Unmarshal(data); err != nil {
+		return fmt.Errorf("unable to deserialize workflow execution bundle: %s", err)
+	}
+	if bundle.GetVersion() > common.WorkflowExecutionBundleVersion {
+		return fmt.Errorf("workflow execution bundle version %v is not supported", bundle.GetVersion())
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	// the namespace of the bundle is used unless a namespace is explicitly set
+	var nsName string
+	if c.IsSet(FlagNamespace) {
+		nsName = c.String(FlagNamespace)
+	}
+	if _, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace: nsName,
+		Bundle:    bundle,
+	}); err != nil {
+		return fmt.Errorf("unable to import workflow execution: %s", err)
+	}
+	fmt.Printf("imported workflow %v with %v runs, current run %v\n",
+		bundle.GetExecution().GetWorkflowId(),
+		len(bundle.GetRuns()),
+		bundle.GetRuns()[len(bundle.GetRuns())-1].GetExecution().GetRunId(),
+	)
+	return nil
+}
+
 // AdminDescribeWorkflow describe a new workflow execution for admin
 func AdminDescribeWorkflow(c *cli.Context) error {
 	resp, err := describeMutableState(c)
@@ -503,6 +726,63 @@ func AdminDescribeHistoryHost(c *cli.Context) error {
 	return nil
 }
 
+// AdminListFaultInjectionScenarios lists the persistence fault injection scenarios of a history host
+func AdminListFaultInjectionScenarios(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ListFaultInjectionScenarios(ctx, &adminservice.ListFaultInjectionScenariosRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list fault injection scenarios: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminUpdateFaultInjectionScenario enables or disables a persistence fault injection scenario of a history host
+func AdminUpdateFaultInjectionScenario(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	req := &adminservice.UpdateFaultInjectionScenarioRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+		Name:    c.String(FlagScenario),
+		Enabled: c.Bool(FlagEnabled),
+	}
+	if c.IsSet(FlagDuration) {
+		req.Duration = timestamp.DurationPtr(c.Duration(FlagDuration))
+	}
+	resp, err := adminClient.UpdateFaultInjectionScenario(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to update fault injection scenario: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminStartHistoryShardCountMigration starts increasing the history shard count
+func AdminStartHistoryShardCountMigration(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.StartHistoryShardCountMigration(ctx, &adminservice.StartHistoryShardCountMigrationRequest{
+		TargetShardCount: int32(c.Int(FlagTargetShardCount)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start history shard count migration: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
 // AdminRefreshWorkflowTasks refreshes all the tasks of a workflow
 func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	adminClient := cFactory.AdminClient(c)
@@ -541,6 +821,197 @@ func AdminRefreshWorkflowTasks(c *cli.Context) error {
 	return nil
 }
 
+// AdminPauseWorkflow pauses a workflow execution
+func AdminPauseWorkflow(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+		Reason:   c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause workflow execution: %s", err)
+	}
+	fmt.Println("Pause workflow execution succeeded.")
+	return nil
+}
+
+// AdminUnpauseWorkflow unpauses a paused workflow execution
+func AdminUnpauseWorkflow(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpause workflow execution: %s", err)
+	}
+	fmt.Println("Unpause workflow execution succeeded.")
+	return nil
+}
+
+// AdminUpdateActivityOptions updates the options of a pending activity
+func AdminUpdateActivityOptions(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	options := &history.ActivityOptions{
+		TaskQueue: c.String(FlagTaskQueue),
+	}
+	if c.IsSet(FlagScheduleToCloseTimeout) {
+		options.ScheduleToCloseTimeout = timestamp.DurationPtr(c.Duration(FlagScheduleToCloseTimeout))
+	}
+	if c.IsSet(FlagScheduleToStartTimeout) {
+		options.ScheduleToStartTimeout = timestamp.DurationPtr(c.Duration(FlagScheduleToStartTimeout))
+	}
+	if c.IsSet(FlagStartToCloseTimeout) {
+		options.StartToCloseTimeout = timestamp.DurationPtr(c.Duration(FlagStartToCloseTimeout))
+	}
+	if c.IsSet(FlagHeartbeatTimeout) {
+		options.HeartbeatTimeout = timestamp.DurationPtr(c.Duration(FlagHeartbeatTimeout))
+	}
+	if c.IsSet(FlagRetryInitialInterval) ||
+		c.IsSet(FlagRetryMaximumInterval) ||
+		c.IsSet(FlagRetryBackoffCoefficient) ||
+		c.IsSet(FlagRetryMaximumAttempts) {
+		options.RetryPolicy = &commonpb.RetryPolicy{
+			InitialInterval:    timestamp.DurationPtr(c.Duration(FlagRetryInitialInterval)),
+			MaximumInterval:    timestamp.DurationPtr(c.Duration(FlagRetryMaximumInterval)),
+			BackoffCoefficient: c.Float64(FlagRetryBackoffCoefficient),
+			MaximumAttempts:    int32(c.Int(FlagRetryMaximumAttempts)),
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.UpdateActivityOptions(ctx, &adminservice.UpdateActivityOptionsRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId: c.String(FlagActivityID),
+		Options:    options,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update activity options: %s", err)
+	}
+	prettyPrintJSONObject(resp.GetOptions())
+	return nil
+}
+
+// AdminResetActivity retries pending activities waiting for their next retry immediately
+func AdminResetActivity(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.ResetActivity(ctx, &adminservice.ResetActivityRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId:    c.String(FlagActivityID),
+		ResetAttempts: c.Bool(FlagResetAttempts),
+		Identity:      getCliIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to reset activity: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminStartResetActivitiesBatchOperation starts a batch operation resetting activities of workflows
+func AdminStartResetActivitiesBatchOperation(c *cli.Context) error {
+	adminClient := cFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.StartResetActivitiesBatchOperation(ctx, &adminservice.StartResetActivitiesBatchOperationRequest{
+		Namespace:       nsName,
+		JobId:           c.String(FlagJobID),
+		VisibilityQuery: c.String(FlagQuery),
+		Reason:          c.String(FlagReason),
+		ResetAttempts:   c.Bool(FlagResetAttempts),
+		Identity:        getCliIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start batch operation: %s", err)
+	}
+	fmt.Println("Batch operation started, job ID:", c.String(FlagJobID))
+	return nil
+}
+
 // AdminRebuildMutableState rebuild a workflow mutable state using persisted history events
 func AdminRebuildMutableState(c *cli.Context) error {
 	adminClient := cFactory.AdminClient(c)
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+const (
+	consistencyCheckTypePrefix = "CONSISTENCY_CHECK_TYPE_"
+	taskCategoryPrefix         = "TASK_CATEGORY_"
+)
+
+// AdminCheckPersistenceConsistency reports, and optionally repairs, records in one persistence store
+// which are not backed by the corresponding records in another store
+func AdminCheckPersistenceConsistency(c *cli.Context) error {
+	checkType, err := parseConsistencyCheckType(c.String(FlagCheckType))
+	if err != nil {
+		return err
+	}
+
+	request := &adminservice.CheckPersistenceConsistencyRequest{
+		CheckType: checkType,
+		Namespace: c.String(FlagNamespace),
+		PageSize:  int32(c.Int(FlagPageSize)),
+		Repair:    c.Bool(FlagRepair),
+	}
+	if checkType == enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_TASK {
+		categoryValue, err := stringToEnum(enumName(taskCategoryPrefix, c.String(FlagTaskType)), enumsspb.TaskCategory_value)
+		if err != nil {
+			return fmt.Errorf("invalid task category: %v", err)
+		}
+		request.Category = enumsspb.TaskCategory(categoryValue)
+		if request.Category == enumsspb.TASK_CATEGORY_UNSPECIFIED {
+			return fmt.Errorf("option is required: %s", FlagTaskType)
+		}
+	}
+	if request.Repair {
+		prompt(fmt.Sprintf("Repair all %v issues found?[Yes/No]", checkType), c.Bool(FlagYes))
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	var shardIDs []int32
+	switch checkType {
+	case enumsspb.CONSISTENCY_CHECK_TYPE_CURRENT_EXECUTION,
+		enumsspb.CONSISTENCY_CHECK_TYPE_HISTORY_TASK:
+		if c.IsSet(FlagShardID) {
+			shardIDs = []int32{int32(c.Int(FlagShardID))}
+			break
+		}
+		ctx, cancel := newContext(c)
+		defer cancel()
+		resp, err := adminClient.DescribeCluster(ctx, &adminservice.DescribeClusterRequest{})
+		if err != nil {
+			return fmt.Errorf("unable to describe cluster: %s", err)
+		}
+		for shardID := int32(1); shardID <= resp.GetHistoryShardCount(); shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+	default:
+		shardIDs = []int32{0}
+	}
+
+	var checkedCount, issueCount, repairedCount int
+	for _, shardID := range shardIDs {
+		request.ShardId = shardID
+		request.NextPageToken = nil
+		for {
+			ctx, cancel := newContext(c)
+			resp, err := adminClient.CheckPersistenceConsistency(ctx, request)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("unable to check persistence consistency: %s", err)
+			}
+
+			checkedCount += int(resp.GetCheckedCount())
+			for _, issue := range resp.GetIssues() {
+				issueCount++
+				if issue.GetRepaired() {
+					repairedCount++
+				}
+				prettyPrintJSONObject(issue)
+			}
+
+			if len(resp.GetNextPageToken()) == 0 {
+				break
+			}
+			request.NextPageToken = resp.GetNextPageToken()
+		}
+	}
+
+	fmt.Printf("Checked %d records, found %d issues, repaired %d issues.\n", checkedCount, issueCount, repairedCount)
+	return nil
+}
+
+func parseConsistencyCheckType(value string) (enumsspb.ConsistencyCheckType, error) {
+	checkTypeValue, err := stringToEnum(enumName(consistencyCheckTypePrefix, value), enumsspb.ConsistencyCheckType_value)
+	if err != nil {
+		return enumsspb.CONSISTENCY_CHECK_TYPE_UNSPECIFIED, fmt.Errorf("invalid check type: %v", err)
+	}
+	checkType := enumsspb.ConsistencyCheckType(checkTypeValue)
+	if checkType == enumsspb.CONSISTENCY_CHECK_TYPE_UNSPECIFIED {
+		return checkType, fmt.Errorf("option is required: %s", FlagCheckType)
+	}
+	return checkType, nil
+}
+
+// enumName converts short names like "history-branch" to the full enum value name.
+func enumName(prefix string, value string) string {
+	if value == "" {
+		return ""
+	}
+	name := strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+	return name
+}
@@ -34,8 +34,10 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
 
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 func AdminDecodeProto(c *cli.Context) error {
@@ -83,6 +85,17 @@ func AdminDecodeProto(c *cli.Context) error {
 		return fmt.Errorf("missing required parameter data flag")
 	}
 
+	encoding := c.String(FlagEncoding)
+	encodingType, ok := serialization.EncodingTypeFromString(encoding)
+	if !ok {
+		return fmt.Errorf("unknown encoding %s", encoding)
+	}
+	blob, err := serialization.DecompressBlob(&commonpb.DataBlob{EncodingType: encodingType, Data: protoData})
+	if err != nil {
+		return fmt.Errorf("unable to decompress %s data: %s", encoding, err)
+	}
+	protoData = blob.Data
+
 	messageType := proto.MessageType(protoType)
 	if messageType == nil {
 		return fmt.Errorf("unable to find %s type", protoType)
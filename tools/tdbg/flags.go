@@ -50,6 +50,7 @@ var (
 	FlagPrintJSON                  = "print-json"
 	FlagHeartbeatedWithin          = "heartbeated-within"
 	FlagOutputFilename             = "output-filename"
+	FlagInputFilename              = "input-filename"
 	FlagClusterMembershipRole      = "role"
 	FlagSkipErrorMode              = "skip-errors"
 	FlagTaskID                     = "task-id"
@@ -78,4 +79,31 @@ var (
 	FlagBinaryFile                 = "binary-file"
 	FlagBase64Data                 = "base64-data"
 	FlagBase64File                 = "base64-file"
+	FlagEncoding                   = "encoding"
+	FlagScenario                   = "scenario"
+	FlagEnabled                    = "enabled"
+	FlagDuration                   = "duration"
+	FlagTargetShardCount           = "target-shard-count"
+	FlagCheckType                  = "check-type"
+	FlagRepair                     = "repair"
+	FlagDestinationTaskQueue       = "destination-task-queue"
+	FlagWorkflowType               = "workflow-type"
+	FlagCreatedBefore              = "created-before"
+	FlagBatchSize                  = "batch-size"
+	FlagBuildID                    = "build-id"
+	FlagRampPercentage             = "ramp-percentage"
+	FlagReason                     = "reason"
+	FlagActivityID                 = "activity-id"
+	FlagScheduleToCloseTimeout     = "schedule-to-close-timeout"
+	FlagScheduleToStartTimeout     = "schedule-to-start-timeout"
+	FlagStartToCloseTimeout        = "start-to-close-timeout"
+	FlagHeartbeatTimeout           = "heartbeat-timeout"
+	FlagRetryInitialInterval       = "retry-initial-interval"
+	FlagRetryMaximumInterval       = "retry-maximum-interval"
+	FlagRetryBackoffCoefficient    = "retry-backoff-coefficient"
+	FlagRetryMaximumAttempts       = "retry-maximum-attempts"
+	FlagResetAttempts              = "reset-attempts"
+	FlagQuery                      = "query"
+	FlagJobID                      = "job-id"
+	FlagEventType                  = "event-type"
 )
@@ -26,10 +26,13 @@ package tdbg
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -105,3 +108,205 @@ func AdminListTaskQueueTasks(c *cli.Context) error {
 	}
 	return nil
 }
+
+// AdminDescribeTaskQueueBacklog displays a summary of the backlog of a task queue
+func AdminDescribeTaskQueueBacklog(c *cli.Context) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeTaskQueueBacklog(ctx, &adminservice.DescribeTaskQueueBacklogRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Task Queue backlog: %v", err)
+	}
+	prettyPrintJSONObject(resp.GetSummary())
+	return nil
+}
+
+// AdminMoveTaskQueueTasks moves backlog tasks matching a filter to another task queue
+func AdminMoveTaskQueueTasks(c *cli.Context) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+	destination, err := getRequiredOption(c, FlagDestinationTaskQueue)
+	if err != nil {
+		return err
+	}
+	filter, err := getBacklogTaskFilter(c)
+	if err != nil {
+		return err
+	}
+	prompt(fmt.Sprintf("Move backlog tasks of %v task queue %v to %v?[Yes/No]", tqType, tqName, destination), c.Bool(FlagYes))
+
+	client := cFactory.AdminClient(c)
+	batchSize := int32(c.Int(FlagBatchSize))
+	var moved int64
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := client.MoveTaskQueueTasks(ctx, &adminservice.MoveTaskQueueTasksRequest{
+			Namespace:            namespace,
+			TaskQueue:            tqName,
+			TaskQueueType:        tqType,
+			DestinationTaskQueue: destination,
+			Filter:               filter,
+			MaxTasks:             batchSize,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to move Task Queue tasks after moving %d tasks: %v", moved, err)
+		}
+		moved += resp.GetMovedTaskCount()
+		if resp.GetMovedTaskCount() < int64(batchSize) {
+			break
+		}
+	}
+	fmt.Printf("Moved %d tasks.\n", moved)
+	return nil
+}
+
+// AdminPurgeTaskQueueTasks deletes backlog tasks matching a filter
+func AdminPurgeTaskQueueTasks(c *cli.Context) error {
+	namespace, tqName, tqType, err := getTaskQueueOptions(c)
+	if err != nil {
+		return err
+	}
+	filter, err := getBacklogTaskFilter(c)
+	if err != nil {
+		return err
+	}
+	prompt(fmt.Sprintf("Purge backlog tasks of %v task queue %v?[Yes/No]", tqType, tqName), c.Bool(FlagYes))
+
+	client := cFactory.AdminClient(c)
+	batchSize := int32(c.Int(FlagBatchSize))
+	var purged int64
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := client.PurgeTaskQueueTasks(ctx, &adminservice.PurgeTaskQueueTasksRequest{
+			Namespace:     namespace,
+			TaskQueue:     tqName,
+			TaskQueueType: tqType,
+			Filter:        filter,
+			MaxTasks:      batchSize,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to purge Task Queue tasks after purging %d tasks: %v", purged, err)
+		}
+		purged += resp.GetPurgedTaskCount()
+		if resp.GetPurgedTaskCount() < int64(batchSize) {
+			break
+		}
+	}
+	fmt.Printf("Purged %d tasks.\n", purged)
+	return nil
+}
+
+// AdminListWorkers lists the workers which polled a task queue, or any task queue of the namespace, recently
+func AdminListWorkers(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	request := &adminservice.ListWorkersRequest{Namespace: namespace}
+	if c.String(FlagTaskQueue) != "" {
+		_, request.TaskQueue, request.TaskQueueType, err = getTaskQueueOptions(c)
+		if err != nil {
+			return err
+		}
+	}
+
+	request.PageSize = int32(c.Int(FlagPageSize))
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	// pages of task queues without recent pollers have no workers, keep reading until some are found
+	var listed int
+	for {
+		resp, err := client.ListWorkers(ctx, request)
+		if err != nil {
+			return fmt.Errorf("unable to list workers: %v", err)
+		}
+		if len(resp.GetWorkers()) > 0 {
+			prettyPrintJSONObject(resp.GetWorkers())
+			listed += len(resp.GetWorkers())
+		}
+		if len(resp.GetNextPageToken()) == 0 || listed > 0 && !c.Bool(FlagMore) {
+			break
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+	}
+	return nil
+}
+
+// AdminUpdateBuildIdRamp sets, adjusts or removes the gradual rollout of a build ID for the new workflow executions of
+// a task queue
+func AdminUpdateBuildIdRamp(c *cli.Context) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	taskQueue, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+
+	client := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.UpdateBuildIdRamp(ctx, &adminservice.UpdateBuildIdRampRequest{
+		Namespace:      namespace,
+		TaskQueue:      taskQueue,
+		BuildId:        c.String(FlagBuildID),
+		RampPercentage: float32(c.Float64(FlagRampPercentage)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update build ID ramp: %v", err)
+	}
+	return nil
+}
+
+func getTaskQueueOptions(c *cli.Context) (string, string, enumspb.TaskQueueType, error) {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, err
+	}
+	tlTypeInt, err := stringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return "", "", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return "", "", tqType, fmt.Errorf("missing Task Queue type")
+	}
+	return namespace, tqName, tqType, nil
+}
+
+// getBacklogTaskFilter builds the backlog task filter from the command options. Tasks created after the command
+// started are never selected, so that moving or purging an active task queue terminates.
+func getBacklogTaskFilter(c *cli.Context) (*taskqueuespb.BacklogTaskFilter, error) {
+	now := time.Now().UTC()
+	createdBefore, err := parseTime(c.String(FlagCreatedBefore), now, now)
+	if err != nil {
+		return nil, err
+	}
+	return &taskqueuespb.BacklogTaskFilter{
+		WorkflowId:       c.String(FlagWorkflowID),
+		RunId:            c.String(FlagRunID),
+		WorkflowTypeName: c.String(FlagWorkflowType),
+		CreatedBefore:    timestamp.TimePtr(createdBefore),
+	}, nil
+}
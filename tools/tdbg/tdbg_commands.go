@@ -69,6 +69,11 @@ var commands = []*cli.Command{
 		Usage:       "Decode payload",
 		Subcommands: newDecodeCommands(),
 	},
+	{
+		Name:        "consistency",
+		Usage:       "Run admin operation on persistence consistency",
+		Subcommands: newAdminConsistencyCommands(),
+	},
 }
 
 func newAdminWorkflowCommands() []*cli.Command {
@@ -112,6 +117,69 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminShowWorkflow(c)
 			},
 		},
+		{
+			Name:  "watch",
+			Usage: "stream workflow history events as they are committed until the workflow is closed",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID, the current run is streamed if not set",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinEventID,
+					Usage: "First event ID to be streamed, set to the last printed next event ID to resume a stream",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagEventType,
+					Usage: "Only stream events of this type, e.g. WorkflowExecutionSignaled (can be specified multiple times)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminWatchWorkflow(c)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "export workflow history and mutable state, including the other runs of its continued as new chain, into a bundle file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID, the current run is exported if not set",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "Bundle file to write",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExportWorkflow(c)
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "import a workflow bundle file into a development server, so that its history can be replayed",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "Bundle file to import",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminImportWorkflow(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"d"},
@@ -192,6 +260,164 @@ func newAdminWorkflowCommands() []*cli.Command {
 				return AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "Pause a workflow execution, no workflow or activity task is dispatched and no timer is fired until it is unpaused",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for pausing the workflow execution",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a paused workflow execution",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "update-activity-options",
+			Usage: "Update the task queue, timeouts or retry policy of a pending activity, only the options which are set are updated",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:     FlagActivityID,
+					Usage:    "Activity ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task queue the activity is dispatched to",
+				},
+				&cli.DurationFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Schedule to close timeout of the activity",
+				},
+				&cli.DurationFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Schedule to start timeout of the activity",
+				},
+				&cli.DurationFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Start to close timeout of the activity",
+				},
+				&cli.DurationFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Heartbeat timeout of the activity",
+				},
+				&cli.DurationFlag{
+					Name:  FlagRetryInitialInterval,
+					Usage: "Initial interval of the retry policy. Setting any of the retry flags replaces the whole retry policy, unset retry options use the namespace defaults",
+				},
+				&cli.DurationFlag{
+					Name:  FlagRetryMaximumInterval,
+					Usage: "Maximum interval of the retry policy",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRetryBackoffCoefficient,
+					Usage: "Backoff coefficient of the retry policy",
+				},
+				&cli.IntFlag{
+					Name:  FlagRetryMaximumAttempts,
+					Usage: "Maximum attempts of the retry policy, 0 means unlimited",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateActivityOptions(c)
+			},
+		},
+		{
+			Name:  "reset-activity",
+			Usage: "Retry pending activities waiting for their next retry immediately",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagActivityID,
+					Usage: "Activity ID, all activities waiting for their next retry are reset if not set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Reset the attempt of the activities to 1",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResetActivity(c)
+			},
+		},
+		{
+			Name:  "reset-activities-batch",
+			Usage: "Start a batch operation retrying the pending activities waiting for their next retry of the workflows matching the query immediately",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Workflow ID of the batch operation",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Aliases:  []string{"q"},
+					Usage:    "Visibility query of the workflows to reset activities of",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagReason,
+					Usage:    "Reason of the batch operation",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Reset the attempt of the activities to 1",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartResetActivitiesBatchOperation(c)
+			},
+		},
 	}
 }
 
@@ -399,6 +625,61 @@ func newAdminHistoryHostCommands() []*cli.Command {
 				return AdminGetShardID(c)
 			},
 		},
+		{
+			Name:  "list-fault-scenarios",
+			Usage: "List persistence fault injection scenarios of the history host owning a shard",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of a shard owned by the history host",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListFaultInjectionScenarios(c)
+			},
+		},
+		{
+			Name:  "update-fault-scenario",
+			Usage: "Enable or disable a persistence fault injection scenario of the history host owning a shard",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of a shard owned by the history host",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagScenario,
+					Usage:    "Name of the fault injection scenario",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagEnabled,
+					Usage: "Enable the scenario, the scenario is disabled if not set",
+				},
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Usage: "Disable the scenario automatically after this duration, defaults to the duration in the scenario config",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateFaultInjectionScenario(c)
+			},
+		},
+		{
+			Name:  "migrate-shard-count",
+			Usage: "Start increasing the history shard count of the cluster while it stays available",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagTargetShardCount,
+					Usage:    "The new history shard count, must be a multiple of the current history shard count",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartHistoryShardCountMigration(c)
+			},
+		},
 	}
 }
 
@@ -444,6 +725,142 @@ func newAdminTaskQueueCommands() []*cli.Command {
 				return AdminListTaskQueueTasks(c)
 			},
 		},
+		{
+			Name:  "describe-backlog",
+			Usage: "Summarize the backlog of a task queue by workflow type and task age",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueueBacklog(c)
+			},
+		},
+		{
+			Name:  "move-tasks",
+			Usage: "Move backlog tasks of a task queue to another task queue",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+				&cli.StringFlag{
+					Name:  FlagDestinationTaskQueue,
+					Usage: "Task Queue to move the tasks to",
+				},
+			}, newBacklogTaskFilterFlags()...),
+			Action: func(c *cli.Context) error {
+				return AdminMoveTaskQueueTasks(c)
+			},
+		},
+		{
+			Name:  "purge-tasks",
+			Usage: "Delete backlog tasks of a task queue",
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+			}, newBacklogTaskFilterFlags()...),
+			Action: func(c *cli.Context) error {
+				return AdminPurgeTaskQueueTasks(c)
+			},
+		},
+		{
+			Name:  "list-workers",
+			Usage: "List the workers which polled a task queue, or any task queue of the namespace, recently",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name, lists the workers of all task queues if not set",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of task queues read per request if no task queue is set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List the workers of all task queues, default is to stop after the first page with workers",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListWorkers(c)
+			},
+		},
+		{
+			Name:  "update-build-id-ramp",
+			Usage: "Route a percentage of the new workflow executions of a task queue to a build ID other than the default one",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID to ramp to, removes the ramp if not set",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRampPercentage,
+					Usage: "Percentage of new workflow executions, in [0, 100], routed to the build ID",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateBuildIdRamp(c)
+			},
+		},
+	}
+}
+
+func newBacklogTaskFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: FlagWorkflowIDAlias,
+			Usage:   "Only select tasks of this Workflow Id",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: FlagRunIDAlias,
+			Usage:   "Only select tasks of this Run Id",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowType,
+			Usage: "Only select tasks of this Workflow type",
+		},
+		&cli.StringFlag{
+			Name: FlagCreatedBefore,
+			Usage: "Only select tasks created before this time, in UTC format '2006-01-02T15:04:05', " +
+				"time range (e.g. '1h' for one hour ago) or raw UnixNano. Defaults to the time the command starts",
+		},
+		&cli.IntFlag{
+			Name:  FlagBatchSize,
+			Value: 1000,
+			Usage: "Number of tasks changed per request",
+		},
 	}
 }
 
@@ -456,7 +873,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,
@@ -490,7 +907,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,
@@ -516,7 +933,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,
@@ -538,6 +955,42 @@ func newAdminDLQCommands() []*cli.Command {
 	}
 }
 
+func newAdminConsistencyCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "check",
+			Usage: "Check persisted data for records which are not backed by the corresponding records in another store",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagCheckType,
+					Usage:    "Check type: history-branch, current-execution, history-task, visibility, task-queue",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "The ID of the shard to check for current-execution and history-task checks, default is all shards",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskType,
+					Usage: "Task category to check for history-task check: transfer, timer, replication, visibility, archival",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of records checked per request",
+				},
+				&cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Repair the issues found instead of only reporting them",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCheckPersistenceConsistency(c)
+			},
+		},
+	}
+}
+
 func newDecodeCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -560,6 +1013,11 @@ func newDecodeCommands() []*cli.Command {
 					Name:  FlagBinaryFile,
 					Usage: "file with data in binary format.",
 				},
+				&cli.StringFlag{
+					Name:  FlagEncoding,
+					Usage: "encoding of the data as stored in persistence (i.e. Proto3Zstd).",
+					Value: "Proto3",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				return AdminDecodeProto(c)
@@ -314,6 +314,15 @@ func showNextPage() bool {
 	return strings.Trim(input, " ") == ""
 }
 
+// getCliIdentity returns the identity recorded for operations issued by tdbg
+func getCliIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("tdbg@%s", hostname)
+}
+
 func getNamespaceID(c *cli.Context, nsName namespace.Name) (namespace.ID, error) {
 	wfClient := cFactory.WorkflowClient(c)
 